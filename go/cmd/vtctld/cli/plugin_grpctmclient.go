@@ -20,4 +20,11 @@ package cli
 
 import (
 	_ "vitess.io/vitess/go/vt/vttablet/grpctmclient"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
 )
+
+func init() {
+	// vtctld has no healthcheck, so its own RPCs to the tablets tell the
+	// EmergencyReparentShard operations that fail fast which tablets are down.
+	tmclient.RegisterInterceptor(tmclient.ReportTabletHealth)
+}
@@ -46,6 +46,8 @@ var (
 	plannerName       string
 	resilientServer   *srvtopo.ResilientServer
 
+	validateIdentifiers bool
+
 	Main = &cobra.Command{
 		Use:   "vtgate",
 		Short: "VTGate is a stateless proxy responsible for accepting requests from applications and routing them to the appropriate tablet server(s) for query execution. It speaks both the MySQL Protocol and a gRPC protocol.",
@@ -176,6 +178,8 @@ func run(cmd *cobra.Command, args []string) error {
 		MySQLServerVersion: servenv.MySQLServerVersion(),
 		TruncateUILen:      servenv.TruncateUILen,
 		TruncateErrLen:     servenv.TruncateErrLen,
+
+		ValidateIdentifiers: validateIdentifiers,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to initialize env: %v", err)
@@ -210,6 +214,7 @@ func init() {
 	Main.Flags().StringVar(&cell, "cell", cell, "cell to use")
 	Main.Flags().Var((*topoproto.TabletTypeListFlag)(&tabletTypesToWait), "tablet_types_to_wait", "Wait till connected for specified tablet types during Gateway initialization. Should be provided as a comma-separated set of tablet types.")
 	Main.Flags().StringVar(&plannerName, "planner-version", plannerName, "Sets the default planner to use when the session has not changed it. Valid values are: Gen4, Gen4Greedy, Gen4Left2Right")
+	Main.Flags().BoolVar(&validateIdentifiers, "validate-ddl-identifiers", validateIdentifiers, "Reject the DDL statements that create objects with names MySQL would reject, e.g. longer than 64 characters, instead of sending them to the tablets.")
 
 	Main.MarkFlagRequired("tablet_types_to_wait")
 }
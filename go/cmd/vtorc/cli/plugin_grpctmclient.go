@@ -20,4 +20,11 @@ package cli
 
 import (
 	_ "vitess.io/vitess/go/vt/vttablet/grpctmclient"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
 )
+
+func init() {
+	// VTOrc has no healthcheck, so the RPCs with which it polls the tablets tell the
+	// EmergencyReparentShard operations that fail fast which tablets are down.
+	tmclient.RegisterInterceptor(tmclient.ReportTabletHealth)
+}
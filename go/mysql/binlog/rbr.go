@@ -25,6 +25,7 @@ import (
 	"strings"
 	"time"
 
+	"vitess.io/vitess/go/mysql/decimal"
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
@@ -171,6 +172,44 @@ func printTimestamp(v uint32) *bytes.Buffer {
 	return result
 }
 
+// decimalBufferSize is the size of the buffers that AppendDecimalCellValue
+// allocates for the DECIMAL values.
+const decimalBufferSize = 1024
+
+// AppendDecimalCellValue is like CellValue for a DECIMAL cell, but it appends the
+// formatted decimal to buf, and returns the value, which points into the extended
+// buffer, the extended buffer and how many bytes the cell takes. If buf doesn't
+// have room for the cell, a new buffer is allocated instead, with room for many
+// more cells. The DECIMAL values of many rows can then share the same buffers,
+// instead of each of them being allocated. The bytes already in buf are never
+// modified.
+func AppendDecimalCellValue(buf, data []byte, pos int, metadata uint16) (sqltypes.Value, []byte, int, error) {
+	dec, scale, l, err := decimalCell(data, pos, metadata)
+	if err != nil {
+		return sqltypes.NULL, buf, 0, err
+	}
+	// The formatted decimal has at most all the digits of its precision, its
+	// sign, a period and a leading zero.
+	if size := int(metadata>>8) + 3; cap(buf)-len(buf) < size {
+		buf = make([]byte, 0, max(decimalBufferSize, size))
+	}
+	start := len(buf)
+	buf = dec.AppendFormat(buf, scale)
+	return sqltypes.MakeTrusted(querypb.Type_DECIMAL, buf[start:len(buf):len(buf)]), buf, l, nil
+}
+
+// decimalCell decodes a DECIMAL cell, and returns it with its scale and how
+// many bytes it takes.
+func decimalCell(data []byte, pos int, metadata uint16) (decimal.Decimal, int32, int, error) {
+	precision := int(metadata >> 8) // total digits number
+	scale := int(metadata & 0xff)   // number of fractional digits
+	dec, l, err := decimal.NewFromBinlog(data[pos:], precision, scale)
+	if err != nil {
+		return decimal.Decimal{}, 0, 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "%v (data: %v pos: %v)", err, data, pos)
+	}
+	return dec, int32(scale), l, nil
+}
+
 // CellValue returns the data for a cell as a sqltypes.Value, and how
 // many bytes it takes. It uses source type in querypb.Type and vitess type
 // byte to determine general shared aspects of types and the querypb.Field to
@@ -485,152 +524,11 @@ func CellValue(data []byte, pos int, typ byte, metadata uint16, field *querypb.F
 			[]byte(fmt.Sprintf("%v%02d:%02d:%02d%v", sign, hour, minute, second, fracStr))), 3 + (int(metadata)+1)/2, nil
 
 	case TypeNewDecimal:
-		precision := int(metadata >> 8) // total digits number
-		scale := int(metadata & 0xff)   // number of fractional digits
-		intg := precision - scale       // number of full digits
-		intg0 := intg / 9               // number of 32-bits digits
-		intg0x := intg - intg0*9        // leftover full digits
-		frac0 := scale / 9              // number of 32 bits fractionals
-		frac0x := scale - frac0*9       // leftover fractionals
-
-		l := intg0*4 + dig2bytes[intg0x] + frac0*4 + dig2bytes[frac0x]
-
-		// Copy the data so we can change it. Otherwise
-		// decoding is just too hard.
-		d := make([]byte, l)
-		copy(d, data[pos:pos+l])
-
-		txt := &bytes.Buffer{}
-
-		isNegative := (d[0] & 0x80) == 0
-		d[0] ^= 0x80 // First bit is inverted.
-		if isNegative {
-			// Negative numbers are just inverted bytes.
-			txt.WriteByte('-')
-			for i := range d {
-				d[i] ^= 0xff
-			}
-		}
-
-		// first we have the leftover full digits
-		var val uint32
-		switch dig2bytes[intg0x] {
-		case 0:
-			// nothing to do
-		case 1:
-			// one byte, up to two digits
-			val = uint32(d[0])
-		case 2:
-			// two bytes, up to 4 digits
-			val = uint32(d[0])<<8 +
-				uint32(d[1])
-		case 3:
-			// 3 bytes, up to 6 digits
-			val = uint32(d[0])<<16 +
-				uint32(d[1])<<8 +
-				uint32(d[2])
-		case 4:
-			// 4 bytes, up to 8 digits (9 digits would be a full)
-			val = uint32(d[0])<<24 +
-				uint32(d[1])<<16 +
-				uint32(d[2])<<8 +
-				uint32(d[3])
-		}
-		pos = dig2bytes[intg0x]
-		if val > 0 {
-			txt.Write(strconv.AppendUint(nil, uint64(val), 10))
-		}
-
-		// now the full digits, 32 bits each, 9 digits
-		for i := 0; i < intg0; i++ {
-			val = binary.BigEndian.Uint32(d[pos : pos+4])
-			fmt.Fprintf(txt, "%09d", val)
-			pos += 4
-		}
-
-		// now see if we have a fraction
-		if scale == 0 {
-			// When the field is a DECIMAL using a scale of 0, e.g.
-			// DECIMAL(5,0), a binlogged value of 0 is almost treated
-			// like the NULL byte and we get a 0 byte length value.
-			// In this case let's return the correct value of 0.
-			if txt.Len() == 0 {
-				txt.WriteRune('0')
-			}
-
-			return sqltypes.MakeTrusted(querypb.Type_DECIMAL,
-				txt.Bytes()), l, nil
-		}
-		txt.WriteByte('.')
-
-		// now the full fractional digits
-		for i := 0; i < frac0; i++ {
-			val = binary.BigEndian.Uint32(d[pos : pos+4])
-			fmt.Fprintf(txt, "%09d", val)
-			pos += 4
-		}
-
-		// then the partial fractional digits
-		switch dig2bytes[frac0x] {
-		case 0:
-			// Nothing to do
-			return sqltypes.MakeTrusted(querypb.Type_DECIMAL,
-				txt.Bytes()), l, nil
-		case 1:
-			// one byte, 1 or 2 digits
-			val = uint32(d[pos])
-			if frac0x == 1 {
-				fmt.Fprintf(txt, "%1d", val)
-			} else {
-				fmt.Fprintf(txt, "%02d", val)
-			}
-		case 2:
-			// two bytes, 3 or 4 digits
-			val = uint32(d[pos])<<8 +
-				uint32(d[pos+1])
-			if frac0x == 3 {
-				fmt.Fprintf(txt, "%03d", val)
-			} else {
-				fmt.Fprintf(txt, "%04d", val)
-			}
-		case 3:
-			// 3 bytes, 5 or 6 digits
-			val = uint32(d[pos])<<16 +
-				uint32(d[pos+1])<<8 +
-				uint32(d[pos+2])
-			if frac0x == 5 {
-				fmt.Fprintf(txt, "%05d", val)
-			} else {
-				fmt.Fprintf(txt, "%06d", val)
-			}
-		case 4:
-			// 4 bytes, 7 or 8 digits (9 digits would be a full)
-			val = uint32(d[pos])<<24 +
-				uint32(d[pos+1])<<16 +
-				uint32(d[pos+2])<<8 +
-				uint32(d[pos+3])
-			if frac0x == 7 {
-				fmt.Fprintf(txt, "%07d", val)
-			} else {
-				fmt.Fprintf(txt, "%08d", val)
-			}
-		}
-
-		// remove preceding 0s from the integral part, otherwise we get "000000000001.23" instead of "1.23"
-		trimPrecedingZeroes := func(b []byte) []byte {
-			s := string(b)
-			isNegative := false
-			if s[0] == '-' {
-				isNegative = true
-				s = s[1:]
-			}
-			s = strings.TrimLeft(s, "0")
-			if isNegative {
-				s = fmt.Sprintf("-%s", s)
-			}
-			return []byte(s)
+		dec, scale, l, err := decimalCell(data, pos, metadata)
+		if err != nil {
+			return sqltypes.NULL, 0, err
 		}
-		return sqltypes.MakeTrusted(querypb.Type_DECIMAL, trimPrecedingZeroes(txt.Bytes())), l, nil
+		return sqltypes.MakeTrusted(querypb.Type_DECIMAL, dec.FormatMySQL(scale)), l, nil
 
 	case TypeEnum:
 		switch metadata & 0xff {
@@ -474,6 +474,12 @@ func TestCellLengthAndData(t *testing.T) {
 		data:     []byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0, 0x01, 0x0a},
 		out: sqltypes.MakeTrusted(querypb.Type_DECIMAL,
 			[]byte("1.10")),
+	}, {
+		typ:      TypeNewDecimal,
+		metadata: 5<<8 | 2, // DECIMAL(5,2)
+		data:     []byte{0x7f, 0xff, 0xf5},
+		out: sqltypes.MakeTrusted(querypb.Type_DECIMAL,
+			[]byte("-0.10")),
 	}, {
 		typ:      TypeBlob,
 		metadata: 1,
@@ -557,3 +563,69 @@ func TestCellLengthAndData(t *testing.T) {
 		}
 	}
 }
+
+// decimalRow is a row of DECIMAL(14,4) cells in the binlog format.
+var decimalRow = [][]byte{
+	{0x81, 0x0D, 0xFB, 0x38, 0xD2, 0x04, 0xD2},
+	{0x7E, 0xF2, 0x04, 0xC7, 0x2D, 0xFB, 0x2D},
+	{0x81, 0x0D, 0xFB, 0x38, 0xD2, 0x00, 0x01},
+	{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+}
+
+const decimalRowMetadata = 14<<8 | 4
+
+func TestAppendDecimalCellValue(t *testing.T) {
+	expected := []string{"1234567890.1234", "-1234567890.1234", "1234567890.0001", "0.0000"}
+
+	// The values of the row share the buffer, and the bytes that were already
+	// in it are left untouched.
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "prefix"...)
+	var values []sqltypes.Value
+	for _, data := range decimalRow {
+		var value sqltypes.Value
+		var l int
+		var err error
+		value, buf, l, err = AppendDecimalCellValue(buf, data, 0, decimalRowMetadata)
+		if err != nil || l != len(data) {
+			t.Fatalf("AppendDecimalCellValue(%v) returned unexpected result: %v %v", data, l, err)
+		}
+		values = append(values, value)
+	}
+	if !bytes.Equal(buf[:6], []byte("prefix")) || cap(buf) != 128 {
+		t.Errorf("AppendDecimalCellValue modified the buffer: %q", buf)
+	}
+	for i, value := range values {
+		if value.Type() != querypb.Type_DECIMAL || value.ToString() != expected[i] {
+			t.Errorf("value %d: got %v, expected DECIMAL(%s)", i, value, expected[i])
+		}
+	}
+}
+
+func BenchmarkDecimalCellValue(b *testing.B) {
+	field := &querypb.Field{Type: querypb.Type_DECIMAL}
+
+	b.Run("CellValue", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			for _, data := range decimalRow {
+				if _, _, err := CellValue(data, 0, TypeNewDecimal, decimalRowMetadata, field); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("AppendDecimalCellValue", func(b *testing.B) {
+		b.ReportAllocs()
+		var buf []byte
+		for range b.N {
+			for _, data := range decimalRow {
+				var err error
+				if _, buf, _, err = AppendDecimalCellValue(buf, data, 0, decimalRowMetadata); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
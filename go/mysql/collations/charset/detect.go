@@ -0,0 +1,164 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package charset
+
+import (
+	"math"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Detection is the confidence, between 0 and 1, that some data is text encoded
+// in a character set.
+type Detection struct {
+	Charset    Charset
+	Confidence float64
+}
+
+// Detect guesses the character set of data whose encoding is unknown, such as the
+// contents of a BINARY or BLOB column. It returns utf8mb4 and latin1, the character
+// sets that untagged data is usually encoded in, with the confidence that the data
+// is text in each of them, most likely first.
+//
+// The detection is a heuristic: UTF-8 is recognized by its validity, since text in
+// other encodings is rarely valid UTF-8 once it contains non-ASCII characters, and
+// latin1 is scored by how much the data looks like text once decoded. ASCII text is
+// valid in both with full confidence.
+func Detect(input []byte) []Detection {
+	utf8Confidence := detectUTF8(input)
+	detections := []Detection{
+		{Charset: Charset_utf8mb4{}, Confidence: utf8Confidence},
+		{Charset: Charset_latin1{}, Confidence: detectLatin1(input, utf8Confidence)},
+	}
+	sort.SliceStable(detections, func(i, j int) bool {
+		return detections[i].Confidence > detections[j].Confidence
+	})
+	return detections
+}
+
+// DetectConfidence returns the confidence, between 0 and 1, that data whose encoding
+// is unknown is text in the given character set, i.e. how safe it is to convert it
+// to a text column in that character set. See Detect for the heuristics; character
+// sets other than utf8mb4, utf8mb3 and latin1 only check that the data is valid and
+// doesn't contain control characters.
+func DetectConfidence(charset Charset, input []byte) float64 {
+	switch charset.(type) {
+	case Charset_binary:
+		return 1
+	case Charset_utf8mb4:
+		return detectUTF8(input)
+	case Charset_utf8mb3:
+		for _, r := range string(input) {
+			if r > 0xFFFF {
+				return 0
+			}
+		}
+		return detectUTF8(input)
+	case Charset_latin1:
+		return detectLatin1(input, detectUTF8(input))
+	}
+
+	var runes, controls int
+	for len(input) > 0 {
+		r, size := charset.DecodeRune(input)
+		if r == RuneError {
+			return 0
+		}
+		if isControl(r) {
+			controls++
+		}
+		runes++
+		input = input[size:]
+	}
+	return textRatio(runes, controls)
+}
+
+// detectUTF8 returns the confidence that input is UTF-8 text. Invalid UTF-8 has
+// no confidence at all, and every non-ASCII character makes it less likely that
+// the input is valid UTF-8 by chance.
+func detectUTF8(input []byte) float64 {
+	if !utf8.Valid(input) {
+		return 0
+	}
+	var runes, controls, multibyte int
+	for len(input) > 0 {
+		r, size := utf8.DecodeRune(input)
+		if isControl(r) {
+			controls++
+		}
+		if size > 1 {
+			multibyte++
+		}
+		runes++
+		input = input[size:]
+	}
+	confidence := textRatio(runes, controls)
+	if multibyte > 0 {
+		// Roughly one in eight non-ASCII characters of a single-byte encoding
+		// is followed by bytes that make it a valid UTF-8 sequence.
+		confidence *= 1 - math.Pow(0.125, float64(multibyte))
+	}
+	return confidence
+}
+
+// detectLatin1 returns the confidence that input is latin1 text, given the confidence
+// that it is UTF-8 text: non-ASCII UTF-8 is always valid latin1, but it's far more
+// likely to be UTF-8 than mojibake.
+func detectLatin1(input []byte, utf8Confidence float64) float64 {
+	var controls, high int
+	for _, b := range input {
+		switch {
+		case b < 0x80:
+			if isControl(rune(b)) {
+				controls++
+			}
+		case b == 0x81 || b == 0x8D || b == 0x8F || b == 0x90 || b == 0x9D:
+			// These are not mapped by MySQL's latin1, which is really cp1252
+			controls++
+			high++
+		default:
+			high++
+		}
+	}
+	if high == 0 {
+		// ASCII is just as valid in latin1 as it is in UTF-8
+		return textRatio(len(input), controls)
+	}
+	confidence := textRatio(len(input), controls)
+	// Text in the languages written with latin1 is mostly ASCII
+	if ratio := float64(high) / float64(len(input)); ratio > 0.5 {
+		confidence *= 2 * (1 - ratio)
+	}
+	return confidence * (1 - utf8Confidence)
+}
+
+// isControl returns true for the control characters that are not found in text.
+func isControl(r rune) bool {
+	switch r {
+	case '\t', '\n', '\r':
+		return false
+	}
+	return unicode.IsControl(r)
+}
+
+func textRatio(runes, controls int) float64 {
+	if runes == 0 {
+		return 1
+	}
+	return float64(runes-controls) / float64(runes)
+}
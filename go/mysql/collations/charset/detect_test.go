@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package charset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	testCases := []struct {
+		name   string
+		in     string
+		best   string
+		utf8   float64
+		latin1 float64
+	}{
+		{name: "empty", in: "", best: "utf8mb4", utf8: 1, latin1: 1},
+		{name: "ascii", in: "hello world\n", best: "utf8mb4", utf8: 1, latin1: 1},
+		{name: "utf8", in: "café, déjà vu", best: "utf8mb4", utf8: 0.99, latin1: 0.01},
+		{name: "utf8 single character", in: "café", best: "utf8mb4", utf8: 0.87, latin1: 0.12},
+		{name: "latin1", in: "caf\xe9, d\xe9j\xe0 vu", best: "latin1", utf8: 0, latin1: 1},
+		{name: "latin1 with undefined bytes", in: "caf\xe9\x81", best: "latin1", utf8: 0, latin1: 0.8},
+		{name: "binary", in: "\x00\x01\x02\xff\xfe\x81", best: "latin1", utf8: 0, latin1: 0.33},
+		{name: "ascii with control characters", in: "\x00\x00ab", best: "utf8mb4", utf8: 0.5, latin1: 0.5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			detections := Detect([]byte(tc.in))
+			assert.Len(t, detections, 2)
+			assert.Equal(t, tc.best, detections[0].Charset.Name())
+			for _, d := range detections {
+				switch d.Charset.Name() {
+				case "utf8mb4":
+					assert.InDelta(t, tc.utf8, d.Confidence, 0.01)
+				case "latin1":
+					assert.InDelta(t, tc.latin1, d.Confidence, 0.01)
+				}
+			}
+		})
+	}
+}
+
+func TestDetectConfidence(t *testing.T) {
+	testCases := []struct {
+		cs   Charset
+		in   string
+		want float64
+	}{
+		{cs: Charset_binary{}, in: "\x00\xff", want: 1},
+		{cs: Charset_utf8mb4{}, in: "😊", want: 0.87},
+		{cs: Charset_utf8mb3{}, in: "😊", want: 0},
+		{cs: Charset_utf8mb3{}, in: "café", want: 0.87},
+		{cs: Charset_latin1{}, in: "caf\xe9", want: 1},
+		{cs: Charset_utf16{}, in: "\x00a\x00b", want: 1},
+		{cs: Charset_utf16{}, in: "\x00a\x00", want: 0},
+		{cs: Charset_utf16{}, in: "\x00\x00\x00b", want: 0.5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.cs.Name()+"/"+tc.in, func(t *testing.T) {
+			assert.InDelta(t, tc.want, DetectConfidence(tc.cs, []byte(tc.in)), 0.01)
+		})
+	}
+}
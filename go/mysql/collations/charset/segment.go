@@ -0,0 +1,148 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package charset
+
+import (
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// MySQL counts the characters of a string as code points, so functions such as
+// LEFT or RPAD can split a user-perceived character that is made of several
+// code points, e.g. an emoji with a skin tone modifier or a letter followed by
+// a combining mark. The helpers below find the boundaries of the grapheme
+// clusters and of the words of a string, as defined by Unicode (UAX #29), so
+// that text can be truncated without splitting them where the semantics of
+// MySQL don't apply, e.g. when shortening a value for display.
+
+// segmenter returns the first segment of the UTF-8 text b.
+type segmenter func(b []byte, state int) (segment, rest []byte, newState int)
+
+func firstGrapheme(b []byte, state int) ([]byte, []byte, int) {
+	cluster, rest, _, state := uniseg.FirstGraphemeCluster(b, state)
+	return cluster, rest, state
+}
+
+func firstWord(b []byte, state int) ([]byte, []byte, int) {
+	return uniseg.FirstWord(b, state)
+}
+
+// segments calls yield with the end offset in input, and the amount of
+// characters up to that offset, of every segment of the text until yield
+// returns false. Text that is not in UTF-8 is converted to UTF-8 to find the
+// boundaries, which are then mapped back to the original encoding. Binary
+// strings have no text segmentation: every byte is a segment.
+func segments(cs Charset, input []byte, next segmenter, yield func(end, chars int) bool) {
+	if _, ok := cs.(Charset_binary); ok {
+		for i := range input {
+			if !yield(i+1, i+1) {
+				return
+			}
+		}
+		return
+	}
+
+	text := input
+	var offsets []int
+	switch cs.(type) {
+	case Charset_utf8mb3, Charset_utf8mb4:
+	default:
+		// offsets maps the offsets of the runes in text to their offsets in input
+		text = make([]byte, 0, len(input))
+		offsets = make([]int, 0, len(input)+1)
+		for pos := 0; pos < len(input); {
+			r, size := cs.DecodeRune(input[pos:])
+			if size < 1 {
+				size = 1
+			}
+			for n := utf8.RuneLen(r); n > 0; n-- {
+				offsets = append(offsets, pos)
+			}
+			text = utf8.AppendRune(text, r)
+			pos += size
+		}
+		offsets = append(offsets, len(input))
+	}
+
+	var end, chars int
+	state := -1
+	for rest := text; len(rest) > 0; {
+		var segment []byte
+		segment, rest, state = next(rest, state)
+		end += len(segment)
+		chars += utf8.RuneCount(segment)
+		offset := end
+		if offsets != nil {
+			offset = offsets[end]
+		}
+		if !yield(offset, chars) {
+			return
+		}
+	}
+}
+
+func boundaries(cs Charset, input []byte, next segmenter) []int {
+	var ends []int
+	segments(cs, input, next, func(end, _ int) bool {
+		ends = append(ends, end)
+		return true
+	})
+	return ends
+}
+
+// GraphemeBoundaries returns the end offsets of the grapheme clusters of the
+// input, which is encoded in the given charset.
+func GraphemeBoundaries(cs Charset, input []byte) []int {
+	return boundaries(cs, input, firstGrapheme)
+}
+
+// WordBoundaries returns the end offsets of the words of the input, which is
+// encoded in the given charset. The spaces and punctuation between the words
+// are segments of their own.
+func WordBoundaries(cs Charset, input []byte) []int {
+	return boundaries(cs, input, firstWord)
+}
+
+// TruncateGraphemes returns the longest prefix of the input that has at most
+// maxChars characters, counted as MySQL does, and that doesn't split any
+// grapheme cluster.
+func TruncateGraphemes(cs Charset, input []byte, maxChars int) []byte {
+	var prefix int
+	segments(cs, input, firstGrapheme, func(end, chars int) bool {
+		if chars > maxChars {
+			return false
+		}
+		prefix = end
+		return true
+	})
+	return input[:prefix]
+}
+
+// TruncateGraphemesToSize returns the longest prefix of the input that has at
+// most maxBytes bytes and that doesn't split any grapheme cluster.
+func TruncateGraphemesToSize(cs Charset, input []byte, maxBytes int) []byte {
+	var prefix int
+	segments(cs, input, firstGrapheme, func(end, _ int) bool {
+		if end > maxBytes {
+			return false
+		}
+		prefix = end
+		return true
+	})
+	return input[:prefix]
+}
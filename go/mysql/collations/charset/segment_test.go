@@ -0,0 +1,111 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package charset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphemeBoundaries(t *testing.T) {
+	testCases := []struct {
+		in   string
+		cs   Charset
+		want []int
+	}{
+		{"abc", Charset_utf8mb4{}, []int{1, 2, 3}},
+		// e + combining acute accent
+		{"e\u0301a", Charset_utf8mb4{}, []int{3, 4}},
+		// thumbs up with a skin tone modifier, and a family made of several emoji
+		{"👍🏽👨‍👩‍👧", Charset_utf8mb4{}, []int{8, 26}},
+		{"\r\nx", Charset_utf8mb4{}, []int{2, 3}},
+		{"e\u0301a", Charset_binary{}, []int{1, 2, 3, 4}},
+		{"", Charset_utf8mb4{}, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			assert.Equal(t, tc.want, GraphemeBoundaries(tc.cs, []byte(tc.in)))
+		})
+	}
+}
+
+func TestGraphemeBoundariesNonUTF8(t *testing.T) {
+	// e + combining acute accent + a, in UTF-16
+	in := []byte{0x00, 'e', 0x03, 0x01, 0x00, 'a'}
+	assert.Equal(t, []int{4, 6}, GraphemeBoundaries(Charset_utf16{}, in))
+
+	// thumbs up with a skin tone modifier, in UTF-32
+	in = []byte{0x00, 0x01, 0xF4, 0x4D, 0x00, 0x01, 0xF3, 0xFD, 0x00, 0x00, 0x00, 'x'}
+	assert.Equal(t, []int{8, 12}, GraphemeBoundaries(Charset_utf32{}, in))
+
+	// every latin1 character is a grapheme cluster
+	in = []byte{'a', 0xE9, 'b'}
+	assert.Equal(t, []int{1, 2, 3}, GraphemeBoundaries(Charset_latin1{}, in))
+}
+
+func TestWordBoundaries(t *testing.T) {
+	in := "héllo, wörld!"
+	ends := WordBoundaries(Charset_utf8mb4{}, []byte(in))
+	var words []string
+	start := 0
+	for _, end := range ends {
+		words = append(words, in[start:end])
+		start = end
+	}
+	assert.Equal(t, []string{"héllo", ",", " ", "wörld", "!"}, words)
+}
+
+func TestTruncateGraphemes(t *testing.T) {
+	testCases := []struct {
+		in       string
+		cs       Charset
+		maxChars int
+		want     string
+	}{
+		{"abcdef", Charset_utf8mb4{}, 3, "abc"},
+		{"abc", Charset_utf8mb4{}, 10, "abc"},
+		{"abc", Charset_utf8mb4{}, 0, ""},
+		// the accent is a character of its own for MySQL, but is never
+		// separated from the letter that it modifies
+		{"ae\u0301b", Charset_utf8mb4{}, 2, "a"},
+		{"ae\u0301b", Charset_utf8mb4{}, 3, "ae\u0301"},
+		{"x👍🏽y", Charset_utf8mb4{}, 2, "x"},
+		{"x👍🏽y", Charset_utf8mb4{}, 3, "x👍🏽"},
+		{"x👍🏽y", Charset_utf8mb3{}, 3, "x👍🏽"},
+		{"ae\u0301b", Charset_binary{}, 2, "ae"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			assert.Equal(t, tc.want, string(TruncateGraphemes(tc.cs, []byte(tc.in), tc.maxChars)))
+		})
+	}
+}
+
+func TestTruncateGraphemesToSize(t *testing.T) {
+	in := []byte("ae\u0301👍🏽")
+	assert.Equal(t, "a", string(TruncateGraphemesToSize(Charset_utf8mb4{}, in, 3)))
+	assert.Equal(t, "ae\u0301", string(TruncateGraphemesToSize(Charset_utf8mb4{}, in, 4)))
+	assert.Equal(t, "ae\u0301", string(TruncateGraphemesToSize(Charset_utf8mb4{}, in, 11)))
+	assert.Equal(t, string(in), string(TruncateGraphemesToSize(Charset_utf8mb4{}, in, 12)))
+
+	// the sizes are those of the original encoding
+	utf16 := []byte{0x00, 'e', 0x03, 0x01, 0x00, 'a'}
+	require.Equal(t, []byte{}, TruncateGraphemesToSize(Charset_utf16{}, utf16, 3))
+	require.Equal(t, utf16[:4], TruncateGraphemesToSize(Charset_utf16{}, utf16, 5))
+}
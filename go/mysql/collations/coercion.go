@@ -105,13 +105,25 @@ func (tc TypedCollation) Valid() bool {
 	return tc.Collation != Unknown
 }
 
+// EnsureCollate returns an error if a string with the collation fromID can't
+// be collated with toID, because their character sets are different. The
+// collations that this package doesn't support, but this version of MySQL
+// knows, are checked too.
 func (env *Environment) EnsureCollate(fromID, toID ID) error {
-	// these two lookups should never fail
-	fromCharsetName := env.LookupCharsetName(fromID)
-	toCharsetName := env.LookupCharsetName(toID)
+	_, fromCharsetName := env.anyName(fromID)
+	toCollName, toCharsetName := env.anyName(toID)
 	if fromCharsetName != toCharsetName {
-		toCollName := env.LookupName(toID)
 		return fmt.Errorf("COLLATION '%s' is not valid for CHARACTER SET '%s'", toCollName, fromCharsetName)
 	}
 	return nil
 }
+
+// anyName returns the name of the given collation and of its character set,
+// like LookupName and LookupCharsetName, or the ones it has in this version of
+// MySQL if this package doesn't support it.
+func (env *Environment) anyName(id ID) (name, charset string) {
+	if name, ok := env.byID[id]; ok {
+		return name, env.byCharsetName[id]
+	}
+	return env.versionName(id)
+}
@@ -164,11 +164,32 @@ type TinyWeightCollation interface {
 	TinyWeightString(src []byte) uint32
 }
 
+// Lookup returns the collation with the given ID, or nil if it's not supported
+// and no Fallback provided it, or if it's a UCA collation and the weight tables
+// cannot be loaded (see LookupWithError).
 func Lookup(id collations.ID) Collation {
-	if int(id) >= len(collationsById) {
-		return nil
+	coll, _ := LookupWithError(id)
+	return coll
+}
+
+// LookupWithError is like Lookup, but it returns the error that prevents a UCA
+// collation from being used: the weight tables are loaded the first time a UCA
+// collation is looked up, which fails in the binaries built without the embedded
+// data if the external data cannot be loaded.
+func LookupWithError(id collations.ID) (Collation, error) {
+	if int(id) < len(collationsById) {
+		if coll := collationsById[id]; coll != nil {
+			if !ucaData.ready.Load() {
+				if _, lazy := coll.(preloader); lazy {
+					if err := ensureWeightsUCA(); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return coll, nil
+		}
 	}
-	return collationsById[id]
+	return lookupFallback(id), nil
 }
 
 // All returns a slice with all known collations in Vitess.
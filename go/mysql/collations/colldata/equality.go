@@ -0,0 +1,248 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colldata
+
+import (
+	"bytes"
+	"sync"
+
+	"vitess.io/vitess/go/mysql/collations/charset"
+	"vitess.io/vitess/go/mysql/collations/internal/uca"
+)
+
+// EqualityComparer tells whether two strings are equal in a collation, without
+// finding out how they sort. This is all that is needed to confirm the matches
+// of a hash lookup, e.g. when probing the table of a hash join, and it is a lot
+// cheaper than collating the strings or comparing their weight strings for the
+// most common collations: the binary collations compare the raw bytes, and the
+// case-insensitive ones compare ASCII characters with a precomputed table of
+// their case folds, which only leaves the other characters to be weighted.
+//
+// Strings that are equal according to an EqualityComparer always have the same
+// Collation.Hash.
+type EqualityComparer interface {
+	// Collation returns the collation in which the strings are compared.
+	Collation() Collation
+	// Equal returns whether left and right are equal, i.e. the same as
+	// Collation().Collate(left, right, false) == 0.
+	Equal(left, right []byte) bool
+}
+
+var equalityComparers sync.Map // collations.ID -> EqualityComparer
+
+// EqualityComparerFor returns the EqualityComparer of the given collation. The
+// tables of the comparers are built the first time they're requested, and then
+// shared by all the callers.
+func EqualityComparerFor(coll Collation) EqualityComparer {
+	if eq, ok := equalityComparers.Load(coll.ID()); ok {
+		return eq.(EqualityComparer)
+	}
+	eq, _ := equalityComparers.LoadOrStore(coll.ID(), newEqualityComparer(coll))
+	return eq.(EqualityComparer)
+}
+
+func newEqualityComparer(coll Collation) EqualityComparer {
+	switch coll := coll.(type) {
+	case *Collation_binary, *Collation_8bit_bin, *Collation_unicode_bin, *Collation_utf8mb4_0900_bin:
+		return &binaryEquality{coll: coll}
+	case *Collation_8bit_simple_ci:
+		return &simpleEquality{coll: coll, sort: coll.sort}
+	case *Collation_unicode_general_ci:
+		return newGeneralEquality(coll)
+	case *Collation_utf8mb4_uca_0900:
+		if eq := newUCAEquality(coll); eq != nil {
+			return eq
+		}
+	}
+	return &collateEquality{coll: coll}
+}
+
+// binaryEquality compares strings in the binary collations, where they are only
+// equal when they have the same bytes.
+type binaryEquality struct {
+	coll Collation
+}
+
+func (eq *binaryEquality) Collation() Collation {
+	return eq.coll
+}
+
+func (eq *binaryEquality) Equal(left, right []byte) bool {
+	return bytes.Equal(left, right)
+}
+
+// simpleEquality compares strings in the 8-bit case-insensitive collations,
+// where each byte has a single weight.
+type simpleEquality struct {
+	coll Collation
+	sort *[256]byte
+}
+
+func (eq *simpleEquality) Collation() Collation {
+	return eq.coll
+}
+
+func (eq *simpleEquality) Equal(left, right []byte) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	sortOrder := eq.sort
+	for i := range left {
+		if left[i] != right[i] && sortOrder[left[i]] != sortOrder[right[i]] {
+			return false
+		}
+	}
+	return true
+}
+
+// generalEquality compares strings in the *_general_ci collations of the
+// Unicode charsets, where each codepoint has a single weight. The weights of
+// the ASCII characters are precomputed for the charsets that encode them as
+// single bytes.
+type generalEquality struct {
+	coll  *Collation_unicode_general_ci
+	ascii *[128]rune
+}
+
+func newGeneralEquality(coll *Collation_unicode_general_ci) *generalEquality {
+	eq := &generalEquality{coll: coll}
+	switch coll.charset.(type) {
+	case charset.Charset_utf8mb3, charset.Charset_utf8mb4:
+		eq.ascii = new([128]rune)
+		for ch := range eq.ascii {
+			eq.ascii[ch] = coll.unicase.unicodeSort(rune(ch))
+		}
+	}
+	return eq
+}
+
+func (eq *generalEquality) Collation() Collation {
+	return eq.coll
+}
+
+func (eq *generalEquality) Equal(left, right []byte) bool {
+	unicaseInfo := eq.coll.unicase
+	cs := eq.coll.charset
+	ascii := eq.ascii
+
+	for len(left) > 0 && len(right) > 0 {
+		if ascii != nil && left[0] < 0x80 && right[0] < 0x80 {
+			if left[0] != right[0] && ascii[left[0]] != ascii[right[0]] {
+				return false
+			}
+			left = left[1:]
+			right = right[1:]
+			continue
+		}
+
+		l, lWidth := cs.DecodeRune(left)
+		r, rWidth := cs.DecodeRune(right)
+		if (l == charset.RuneError && lWidth < 3) || (r == charset.RuneError && rWidth < 3) {
+			return bytes.Equal(left, right)
+		}
+		if l != r && unicaseInfo.unicodeSort(l) != unicaseInfo.unicodeSort(r) {
+			return false
+		}
+		left = left[lWidth:]
+		right = right[rWidth:]
+	}
+	return len(left) == len(right)
+}
+
+// ucaEquality compares strings in the UCA 9.0.0 collations that have no
+// tailoring, whose ASCII characters have at most a single weight per level
+// (see uca.FastIterator900). The ASCII characters are precomputed into classes
+// of characters with the same weights, and strings that only have ASCII
+// characters are equal if they have the same sequence of classes once the
+// ignorable characters are skipped. The other strings are collated.
+type ucaEquality struct {
+	coll *Collation_utf8mb4_uca_0900
+	// ascii is the class of each ASCII character, 0 being the class of the
+	// ignorable characters.
+	ascii [128]uint8
+}
+
+func newUCAEquality(coll *Collation_utf8mb4_uca_0900) *ucaEquality {
+	it := coll.uca.get().Iterator(nil)
+	_, fast := it.(*uca.FastIterator900)
+	it.Done()
+	if !fast {
+		return nil
+	}
+
+	eq := &ucaEquality{coll: coll}
+	classes := map[string]uint8{string(coll.WeightString(nil, nil, 0)): 0}
+	for ch := range eq.ascii {
+		weights := string(coll.WeightString(nil, []byte{byte(ch)}, 0))
+		class, ok := classes[weights]
+		if !ok {
+			class = uint8(len(classes))
+			classes[weights] = class
+		}
+		eq.ascii[ch] = class
+	}
+	return eq
+}
+
+func (eq *ucaEquality) Collation() Collation {
+	return eq.coll
+}
+
+func (eq *ucaEquality) Equal(left, right []byte) bool {
+	var i, j int
+	for {
+		for i < len(left) && left[i] < 0x80 && eq.ascii[left[i]] == 0 {
+			i++
+		}
+		for j < len(right) && right[j] < 0x80 && eq.ascii[right[j]] == 0 {
+			j++
+		}
+		if i == len(left) || j == len(right) {
+			if i == len(left) && j == len(right) {
+				return true
+			}
+			break
+		}
+		if left[i] >= 0x80 || right[j] >= 0x80 {
+			break
+		}
+		if eq.ascii[left[i]] != eq.ascii[right[j]] {
+			return false
+		}
+		i++
+		j++
+	}
+
+	// One of the strings has a character outside of ASCII, or one of them has
+	// no characters left that aren't ignorable while the other one may still
+	// have only ignorable characters outside of ASCII.
+	return eq.coll.Collate(left, right, false) == 0
+}
+
+// collateEquality compares strings in the collations that have no faster way
+// to do it than collating them.
+type collateEquality struct {
+	coll Collation
+}
+
+func (eq *collateEquality) Collation() Collation {
+	return eq.coll
+}
+
+func (eq *collateEquality) Equal(left, right []byte) bool {
+	return eq.coll.Collate(left, right, false) == 0
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colldata
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/mysql/collations/charset"
+	"vitess.io/vitess/go/vt/vthash"
+)
+
+func TestEqualityComparers(t *testing.T) {
+	var strings = []string{
+		"", " ", "\t", "\x00", "a", "A", "a ", "a\t", "\ta", "a\x00", "á", "ä", "aa", "aA", "aá",
+		"á", "ab", "a b", "a-b", "ab-", "abc", "ABC", "Abc", "abc ", "ǍḄÇ", "ÁḆĈ", "Ꜻ", "Ꜹ",
+		"가", "㉮", "ß", "ss", "SS", "æ", "ae", "ﬁ", "fi", "é", "é", "ı", "i", "I", "İ",
+		ExampleString, JapaneseString, WhitespaceString, HungarianString, SpanishString, EnglishString,
+	}
+	// ASCII strings made of characters that are equal, ignorable or padding in
+	// most of the collations.
+	const alphabet = "aAbB \t-_\x00\x1f.'"
+	rng := rand.New(rand.NewPCG(1, 2))
+	for range 50 {
+		b := make([]byte, rng.IntN(5))
+		for i := range b {
+			b[i] = alphabet[rng.IntN(len(alphabet))]
+		}
+		strings = append(strings, string(b))
+	}
+
+	for _, coll := range testall() {
+		var inputs [][]byte
+		for _, s := range strings {
+			if input, err := charset.ConvertFromUTF8(nil, coll.Charset(), []byte(s)); err == nil {
+				inputs = append(inputs, input)
+			}
+		}
+		switch coll.Charset().(type) {
+		case charset.Charset_utf8mb3, charset.Charset_utf8mb4:
+			// invalid encodings
+			inputs = append(inputs, []byte("a\xff"), []byte("A\xff"), []byte("a\xfe"))
+		}
+
+		eq := EqualityComparerFor(coll)
+		assert.Same(t, eq, EqualityComparerFor(coll))
+		assert.Equal(t, coll, eq.Collation())
+
+		for _, left := range inputs {
+			for _, right := range inputs {
+				want := coll.Collate(left, right, false) == 0
+				if !assert.Equal(t, want, eq.Equal(left, right), "[%s] %q vs %q", coll.Name(), left, right) || !want {
+					continue
+				}
+				hleft, hright := vthash.New(), vthash.New()
+				coll.Hash(&hleft, left, 0)
+				coll.Hash(&hright, right, 0)
+				assert.Equal(t, hleft.Sum128(), hright.Sum128(), "[%s] %q vs %q: equal strings with different hashes", coll.Name(), left, right)
+			}
+		}
+	}
+}
+
+func TestEqualityComparersFastPaths(t *testing.T) {
+	testCases := []struct {
+		collation string
+		want      EqualityComparer
+	}{
+		{"binary", &binaryEquality{}},
+		{"utf8mb4_bin", &binaryEquality{}},
+		{"utf8mb4_0900_bin", &binaryEquality{}},
+		{"latin1_swedish_ci", &simpleEquality{}},
+		{"utf8mb4_general_ci", &generalEquality{}},
+		{"utf8mb4_0900_ai_ci", &ucaEquality{}},
+		{"utf8mb4_0900_as_cs", &ucaEquality{}},
+		{"utf8mb4_es_0900_ai_ci", &collateEquality{}},
+		{"utf8mb4_unicode_ci", &collateEquality{}},
+	}
+	for _, tc := range testCases {
+		eq := EqualityComparerFor(testcollation(t, tc.collation))
+		assert.IsType(t, tc.want, eq, tc.collation)
+	}
+
+	general := EqualityComparerFor(testcollation(t, "utf8mb4_general_ci")).(*generalEquality)
+	assert.NotNil(t, general.ascii)
+	general = EqualityComparerFor(testcollation(t, "utf16_general_ci")).(*generalEquality)
+	assert.Nil(t, general.ascii)
+}
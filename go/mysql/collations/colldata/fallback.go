@@ -0,0 +1,99 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colldata
+
+import (
+	"fmt"
+	"maps"
+	"sync"
+	"sync/atomic"
+
+	"vitess.io/vitess/go/mysql/collations"
+)
+
+// The collations of MySQL that this package doesn't implement can be provided
+// by a Fallback, e.g. remote.NewFallback, which compares the strings on a MySQL
+// server, so that they are evaluated correctly, only more slowly, instead of
+// failing. The fallback collations are only created for the queries that ask
+// for them explicitly, with LookupFallback, after which Lookup returns them
+// like any other collation.
+
+// Fallback returns the implementation of a collation that this package doesn't
+// support, with the given ID and name.
+type Fallback func(id collations.ID, name string) (Collation, error)
+
+var fallback struct {
+	mu       sync.Mutex
+	provider Fallback
+	// byID are the collations created by the provider. It's only replaced,
+	// never modified, so that Lookup doesn't need to lock.
+	byID atomic.Pointer[map[collations.ID]Collation]
+}
+
+// SetFallback sets the Fallback of the collations that this package doesn't
+// support, or disables it if nil. The collations created by the previous one
+// are forgotten.
+func SetFallback(provider Fallback) {
+	fallback.mu.Lock()
+	defer fallback.mu.Unlock()
+	fallback.provider = provider
+	fallback.byID.Store(nil)
+}
+
+// LookupFallback returns the collation with the given name, which the given
+// environment knows but this package doesn't support, as implemented by the
+// Fallback, which is called the first time the collation is needed. It returns
+// nil if there is no Fallback, or if the collation is supported or unknown.
+func LookupFallback(env *collations.Environment, name string) (Collation, error) {
+	id, _, ok := env.LookupUnsupported(name)
+	if !ok {
+		return nil, nil
+	}
+	if coll := lookupFallback(id); coll != nil {
+		return coll, nil
+	}
+
+	fallback.mu.Lock()
+	defer fallback.mu.Unlock()
+	if fallback.provider == nil {
+		return nil, nil
+	}
+	var byID map[collations.ID]Collation
+	if current := fallback.byID.Load(); current != nil {
+		if coll := (*current)[id]; coll != nil {
+			return coll, nil
+		}
+		byID = maps.Clone(*current)
+	} else {
+		byID = make(map[collations.ID]Collation)
+	}
+
+	coll, err := fallback.provider(id, name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot provide unsupported collation %q: %w", name, err)
+	}
+	byID[id] = coll
+	fallback.byID.Store(&byID)
+	return coll, nil
+}
+
+func lookupFallback(id collations.ID) Collation {
+	if byID := fallback.byID.Load(); byID != nil {
+		return (*byID)[id]
+	}
+	return nil
+}
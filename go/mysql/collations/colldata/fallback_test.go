@@ -0,0 +1,88 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colldata
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+)
+
+// renamedCollation implements a collation with another one.
+type renamedCollation struct {
+	Collation
+	id   collations.ID
+	name string
+}
+
+func (c *renamedCollation) ID() collations.ID {
+	return c.id
+}
+
+func (c *renamedCollation) Name() string {
+	return c.name
+}
+
+func TestLookupFallback(t *testing.T) {
+	t.Cleanup(func() { SetFallback(nil) })
+
+	env := collations.MySQL8()
+	german2, _, ok := env.LookupUnsupported("latin1_german2_ci")
+	require.True(t, ok)
+
+	coll, err := LookupFallback(env, "latin1_german2_ci")
+	require.NoError(t, err)
+	assert.Nil(t, coll)
+	assert.Nil(t, Lookup(german2))
+
+	var calls int
+	SetFallback(func(id collations.ID, name string) (Collation, error) {
+		calls++
+		if name == "tis620_thai_ci" {
+			return nil, errors.New("no thai")
+		}
+		return &renamedCollation{Collation: Lookup(collations.CollationLatin1Swedish), id: id, name: name}, nil
+	})
+
+	for range 2 {
+		coll, err = LookupFallback(env, "latin1_german2_ci")
+		require.NoError(t, err)
+		assert.Equal(t, german2, coll.ID())
+		assert.Equal(t, "latin1_german2_ci", coll.Name())
+		assert.Equal(t, 0, coll.Collate([]byte("A"), []byte("a"), false))
+		assert.Same(t, coll, Lookup(german2))
+	}
+	assert.Equal(t, 1, calls)
+
+	_, err = LookupFallback(env, "tis620_thai_ci")
+	assert.EqualError(t, err, `cannot provide unsupported collation "tis620_thai_ci": no thai`)
+
+	// the supported and unknown collations are never provided by the fallback
+	for _, name := range []string{"latin1_swedish_ci", "latin1_klingon_ci"} {
+		coll, err = LookupFallback(env, name)
+		require.NoError(t, err)
+		assert.Nil(t, coll)
+	}
+	assert.Equal(t, 2, calls)
+
+	SetFallback(nil)
+	assert.Nil(t, Lookup(german2))
+}
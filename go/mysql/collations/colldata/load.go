@@ -0,0 +1,318 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colldata
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+)
+
+// ExternalDataEnv is the environment variable with the path of the external collation
+// data, used when SetExternalDataPath has not been called.
+const ExternalDataEnv = "VT_COLLDATA_PATH"
+
+// externalDataFile is the name of the collation data file when the external path is a directory.
+const externalDataFile = "mysqlucadata.bin"
+
+// The collation data files, and the data embedded in the binary, start with a header
+// of weightsUCA_headerLen bytes, as written by makecolldata: weightsUCA_magic, the
+// version of the format of the file and the number of weights, as little-endian
+// uint32s, and the SHA-256 checksum of the weights. An external file can only be
+// loaded if its checksum is weightsUCA_checksum, i.e. if it was generated with the
+// same weight tables as the binary.
+const (
+	weightsUCA_magic     = "VTUCADAT"
+	weightsUCA_version   = 1
+	weightsUCA_headerLen = 48
+)
+
+// The sources of the weight tables of the UCA collations, as reported by DataSource.
+const (
+	DataSourceNone     = "none"
+	DataSourceEmbedded = "embedded"
+	DataSourceExternal = "external"
+)
+
+var ucaData struct {
+	mu     sync.Mutex
+	path   string
+	loaded bool
+	source string
+	err    error
+
+	// ready is set once the weight tables are loaded, so that Lookup can check
+	// it without locking
+	ready atomic.Bool
+
+	// collations are the names of the UCA collations that have been built
+	collations map[string]bool
+}
+
+var (
+	statsCollationsLoaded = stats.NewGaugesFuncWithMultiLabels(
+		"CollationsLoaded",
+		"UCA collations whose weight tables have been loaded",
+		[]string{"Collation"},
+		func() map[string]int64 {
+			loaded := make(map[string]int64)
+			for _, name := range LoadedCollations() {
+				loaded[name] = 1
+			}
+			return loaded
+		})
+	statsCollationDataSource = stats.NewString("CollationDataSource")
+)
+
+// SetExternalDataPath sets the path of the file with the weight tables of the UCA
+// collations, or of a directory with a mysqlucadata.bin file. When no path is set,
+// the one in $VT_COLLDATA_PATH is used, and when neither is, the weight tables are
+// the data embedded in the binary. Binaries built with the `colldata_external` tag
+// do not embed the weight tables, which makes them significantly smaller, and they
+// require the external data.
+//
+// SetExternalDataPath must be called before the weight tables are loaded; it returns
+// an error if they have already been.
+func SetExternalDataPath(path string) error {
+	ucaData.mu.Lock()
+	defer ucaData.mu.Unlock()
+	if ucaData.loaded {
+		return fmt.Errorf("collation data has already been loaded from %s data", ucaData.source)
+	}
+	ucaData.path = path
+	return nil
+}
+
+// LoadWeights loads the weight tables of the UCA collations, if they haven't been
+// loaded yet, and returns an error if the external data cannot be read or is not
+// valid, or if there is none and the binary does not embed the data. Servers call
+// it on startup, so that they don't fail on the first query that uses a UCA
+// collation; otherwise the weight tables are loaded then.
+func LoadWeights() error {
+	ucaData.mu.Lock()
+	defer ucaData.mu.Unlock()
+	if ucaData.loaded {
+		return nil
+	}
+
+	path := ucaData.path
+	if path == "" {
+		path = os.Getenv(ExternalDataEnv)
+	}
+	source, data := DataSourceEmbedded, embeddedWeightsUCA()
+	if path != "" {
+		external, err := readWeightsUCA(path)
+		if err != nil {
+			ucaData.err = fmt.Errorf("cannot load collation data: %w", err)
+			return ucaData.err
+		}
+		source, data = DataSourceExternal, external
+	}
+	if data == "" {
+		ucaData.err = fmt.Errorf("this binary does not embed collation data; set its path with $%s", ExternalDataEnv)
+		return ucaData.err
+	}
+
+	loadWeightsUCA(data)
+	ucaData.loaded = true
+	ucaData.source = source
+	ucaData.err = nil
+	ucaData.ready.Store(true)
+	statsCollationDataSource.Set(source)
+	return nil
+}
+
+// DataSource returns where the weight tables of the UCA collations have been loaded
+// from, or DataSourceNone, and the error that prevented loading them, if any. The
+// error is also returned when the embedded data has been used because the external
+// data could not be loaded, see ensureWeightsUCA.
+func DataSource() (string, error) {
+	ucaData.mu.Lock()
+	defer ucaData.mu.Unlock()
+	if !ucaData.loaded {
+		return DataSourceNone, ucaData.err
+	}
+	return ucaData.source, ucaData.err
+}
+
+// LoadedCollations returns the sorted names of the UCA collations that have been
+// used, and whose weight tables are therefore in memory.
+func LoadedCollations() []string {
+	ucaData.mu.Lock()
+	defer ucaData.mu.Unlock()
+	names := make([]string, 0, len(ucaData.collations))
+	for name := range ucaData.collations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ensureWeightsUCA loads the weight tables of the UCA collations, if they haven't
+// been loaded yet, the first time a UCA collation is looked up in the programs that
+// don't call LoadWeights on startup. When the external data cannot be loaded, the
+// error is logged and the embedded data is used instead; an error is only returned
+// by the binaries that don't embed the data, for Lookup to refuse the collation.
+func ensureWeightsUCA() error {
+	err := LoadWeights()
+	if err == nil {
+		return nil
+	}
+
+	ucaData.mu.Lock()
+	defer ucaData.mu.Unlock()
+	if ucaData.loaded {
+		return nil
+	}
+	embedded := embeddedWeightsUCA()
+	if embedded == "" {
+		return err
+	}
+	log.Errorf("%v; using the embedded collation data instead", err)
+	loadWeightsUCA(embedded)
+	ucaData.loaded = true
+	ucaData.source = DataSourceEmbedded
+	ucaData.ready.Store(true)
+	statsCollationDataSource.Set(DataSourceEmbedded)
+	return nil
+}
+
+// embeddedWeightsUCA returns the weights of the data embedded in the binary, without
+// its header, or an empty string if the binary doesn't embed the data.
+func embeddedWeightsUCA() string {
+	if weightsUCA_embed_data == "" {
+		return ""
+	}
+	return weightsUCA_embed_data[weightsUCA_headerLen:]
+}
+
+// readWeightsUCA reads the weights of the UCA collations from the given file, or from
+// the mysqlucadata.bin file in the given directory, after checking its header and
+// the checksum of its weights.
+func readWeightsUCA(path string) (string, error) {
+	if st, err := os.Stat(path); err == nil && st.IsDir() {
+		path = filepath.Join(path, externalDataFile)
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	if len(raw) < weightsUCA_headerLen || string(raw[:len(weightsUCA_magic)]) != weightsUCA_magic {
+		return "", fmt.Errorf("%s is not a collation data file", path)
+	}
+	header := raw[len(weightsUCA_magic):weightsUCA_headerLen]
+	if version := binary.LittleEndian.Uint32(header[0:4]); version != weightsUCA_version {
+		return "", fmt.Errorf("%s has collation data in format version %d, expected %d", path, version, weightsUCA_version)
+	}
+	checksum := hex.EncodeToString(header[8:])
+	if checksum != weightsUCA_checksum {
+		return "", fmt.Errorf("%s has collation data for other weight tables than this binary (checksum %s, expected %s)", path, checksum, weightsUCA_checksum)
+	}
+	weights := raw[weightsUCA_headerLen:]
+	if count := binary.LittleEndian.Uint32(header[4:8]); count != weightsUCA_len || len(weights) != weightsUCA_len*2 {
+		return "", fmt.Errorf("%s has %d bytes of collation data, expected %d", path, len(weights), weightsUCA_len*2)
+	}
+	if sum := sha256.Sum256(weights); hex.EncodeToString(sum[:]) != checksum {
+		return "", fmt.Errorf("%s is corrupted: its collation data doesn't match its checksum", path)
+	}
+	// The weight tables point directly into the data, which is never modified
+	return unsafe.String(unsafe.SliceData(weights), len(weights)), nil
+}
+
+// lazyCollation builds the implementation of a UCA collation the first time it's
+// used, so that the weight tables of collations that are never used (in particular
+// the tailored ones, which are patched copies of the base tables) are not loaded.
+//
+// The collation is looked up on every comparison, so once it's built, get is a
+// single atomic load, without any locking.
+type lazyCollation[T any] struct {
+	coll atomic.Pointer[T]
+
+	mu   sync.Mutex
+	name string
+	new  func() *T
+}
+
+func newLazyCollation[T any](name string, new func() *T) *lazyCollation[T] {
+	return &lazyCollation[T]{name: name, new: new}
+}
+
+func (l *lazyCollation[T]) get() *T {
+	if coll := l.coll.Load(); coll != nil {
+		return coll
+	}
+	return l.build()
+}
+
+// build is the slow path of get, which is kept apart so that get can be inlined.
+func (l *lazyCollation[T]) build() *T {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if coll := l.coll.Load(); coll != nil {
+		return coll
+	}
+
+	// Lookup doesn't return the UCA collations whose weights can't be loaded
+	if err := ensureWeightsUCA(); err != nil {
+		panic(fmt.Sprintf("colldata: %v", err))
+	}
+	coll := l.new()
+	l.new = nil
+
+	ucaData.mu.Lock()
+	if ucaData.collations == nil {
+		ucaData.collations = make(map[string]bool)
+	}
+	ucaData.collations[l.name] = true
+	ucaData.mu.Unlock()
+
+	l.coll.Store(coll)
+	return coll
+}
+
+// preloader is implemented by the collations that are built lazily.
+type preloader interface {
+	preload()
+}
+
+// PreloadAll loads the weight tables of the UCA collations and builds all the
+// collations, instead of building each of them the first time it's used. This
+// trades a longer startup, and the memory of the collations that are never used,
+// for the latency of the first queries that use each collation.
+//
+// PreloadAll panics if the weight tables cannot be loaded, instead of using the
+// embedded data like the collations that are built lazily do.
+func PreloadAll() {
+	if err := LoadWeights(); err != nil {
+		panic(fmt.Sprintf("colldata: %v", err))
+	}
+	for _, coll := range collationsById {
+		if p, ok := coll.(preloader); ok {
+			p.preload()
+		}
+	}
+}
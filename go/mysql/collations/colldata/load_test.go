@@ -0,0 +1,231 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colldata
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+)
+
+func TestReadWeightsUCA(t *testing.T) {
+	embedded, err := os.ReadFile("mysqlucadata.bin")
+	require.NoError(t, err)
+	weights := string(embedded[weightsUCA_headerLen:])
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, externalDataFile), embedded, 0644))
+
+	data, err := readWeightsUCA(dir)
+	require.NoError(t, err)
+	assert.Equal(t, weights, data)
+
+	data, err = readWeightsUCA(filepath.Join(dir, externalDataFile))
+	require.NoError(t, err)
+	assert.Equal(t, weights, data)
+
+	corrupt := func(name string, corrupt func(data []byte) []byte) string {
+		path := filepath.Join(dir, name)
+		data := corrupt(append([]byte(nil), embedded...))
+		require.NoError(t, os.WriteFile(path, data, 0644))
+		return path
+	}
+	testCases := []struct {
+		name    string
+		corrupt func(data []byte) []byte
+		err     string
+	}{{
+		name:    "headerless",
+		corrupt: func(data []byte) []byte { return data[weightsUCA_headerLen:] },
+		err:     "is not a collation data file",
+	}, {
+		name: "version",
+		corrupt: func(data []byte) []byte {
+			data[len(weightsUCA_magic)] = weightsUCA_version + 1
+			return data
+		},
+		err: "has collation data in format version 2, expected 1",
+	}, {
+		name: "tables",
+		corrupt: func(data []byte) []byte {
+			data[weightsUCA_headerLen-1] ^= 0xff
+			return data
+		},
+		err: "has collation data for other weight tables than this binary",
+	}, {
+		name:    "truncated",
+		corrupt: func(data []byte) []byte { return data[:weightsUCA_headerLen+1024] },
+		err:     "expected",
+	}, {
+		name: "weights",
+		corrupt: func(data []byte) []byte {
+			data[len(data)-1] ^= 0xff
+			return data
+		},
+		err: "is corrupted",
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := readWeightsUCA(corrupt(tc.name+".bin", tc.corrupt))
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	_, err = readWeightsUCA(filepath.Join(dir, "missing.bin"))
+	assert.Error(t, err)
+}
+
+func TestLoadWeights(t *testing.T) {
+	require.NoError(t, LoadWeights())
+
+	// Load the weight tables again, as if they had not been loaded yet.
+	unload := func() {
+		ucaData.mu.Lock()
+		defer ucaData.mu.Unlock()
+		ucaData.loaded = false
+		ucaData.ready.Store(false)
+	}
+	defer func() {
+		unload()
+		require.NoError(t, SetExternalDataPath(""))
+		require.NoError(t, LoadWeights())
+	}()
+
+	unload()
+	dir := t.TempDir()
+	require.NoError(t, SetExternalDataPath(filepath.Join(dir, "missing.bin")))
+	err := LoadWeights()
+	assert.ErrorContains(t, err, "cannot load collation data")
+	source, sourceErr := DataSource()
+	assert.Equal(t, DataSourceNone, source)
+	assert.Equal(t, err, sourceErr)
+
+	embedded, err := os.ReadFile("mysqlucadata.bin")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, externalDataFile), embedded, 0644))
+	require.NoError(t, SetExternalDataPath(dir))
+	require.NoError(t, LoadWeights())
+	source, err = DataSource()
+	assert.NoError(t, err)
+	assert.Equal(t, DataSourceExternal, source)
+	assert.Error(t, SetExternalDataPath(""))
+}
+
+func TestEnsureWeightsFallback(t *testing.T) {
+	require.NoError(t, LoadWeights())
+
+	unload := func() {
+		ucaData.mu.Lock()
+		defer ucaData.mu.Unlock()
+		ucaData.loaded = false
+		ucaData.ready.Store(false)
+	}
+	defer func() {
+		unload()
+		require.NoError(t, SetExternalDataPath(""))
+		require.NoError(t, LoadWeights())
+	}()
+
+	// The collations built lazily use the embedded data when the external data
+	// cannot be loaded.
+	unload()
+	require.NoError(t, SetExternalDataPath(filepath.Join(t.TempDir(), "missing.bin")))
+	assert.NoError(t, ensureWeightsUCA())
+	source, err := DataSource()
+	assert.Equal(t, DataSourceEmbedded, source)
+	assert.ErrorContains(t, err, "cannot load collation data")
+
+	// The binaries without embedded data can't look the UCA collations up.
+	unload()
+	defer func(embedded string) {
+		weightsUCA_embed_data = embedded
+	}(weightsUCA_embed_data)
+	weightsUCA_embed_data = ""
+	_, err = LookupWithError(collations.CollationUtf8mb4ID)
+	assert.ErrorContains(t, err, "cannot load collation data")
+	assert.Nil(t, Lookup(collations.CollationUtf8mb4ID))
+	coll, err := LookupWithError(collations.CollationBinaryID)
+	assert.NoError(t, err)
+	assert.NotNil(t, coll)
+
+	// Preloading them fails hard instead.
+	unload()
+	assert.Panics(t, PreloadAll)
+	source, _ = DataSource()
+	assert.Equal(t, DataSourceNone, source)
+}
+
+func TestLazyCollations(t *testing.T) {
+	coll := Lookup(collations.ID(0xe0)).(*Collation_uca_legacy) // utf8mb4_unicode_ci
+	assert.Equal(t, "utf8mb4_unicode_ci", coll.Name())
+
+	assert.Zero(t, coll.Collate([]byte("a"), []byte("A"), false))
+	assert.Contains(t, LoadedCollations(), "utf8mb4_unicode_ci")
+
+	source, err := DataSource()
+	assert.NoError(t, err)
+	assert.Equal(t, DataSourceEmbedded, source)
+
+	assert.Error(t, SetExternalDataPath(t.TempDir()))
+}
+
+func TestLazyCollationConcurrent(t *testing.T) {
+	type built struct{ n int }
+	var calls atomic.Int32
+	l := newLazyCollation("test_lazy_collation", func() *built {
+		calls.Add(1)
+		return &built{n: 42}
+	})
+
+	var wg sync.WaitGroup
+	results := make([]*built, 16)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = l.get()
+		}()
+	}
+	wg.Wait()
+
+	assert.EqualValues(t, 1, calls.Load())
+	for _, res := range results {
+		assert.Same(t, results[0], res)
+	}
+	assert.Equal(t, 42, results[0].n)
+	assert.Contains(t, LoadedCollations(), "test_lazy_collation")
+}
+
+func TestPreloadAll(t *testing.T) {
+	PreloadAll()
+
+	loaded := LoadedCollations()
+	for _, coll := range collationsById {
+		if _, ok := coll.(preloader); ok {
+			assert.Contains(t, loaded, coll.Name())
+		}
+	}
+	assert.Contains(t, loaded, "utf8mb4_0900_ai_ci")
+	assert.Contains(t, loaded, "utf8mb4_unicode_ci")
+}
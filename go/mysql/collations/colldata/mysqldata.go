@@ -3612,6 +3612,9 @@ func (contractor_utf8mb4_ja_0900_as_cs) FindContextual(cp1, cp0 rune) []uint16 {
 	}
 	return contractor_utf8mb4_ja_0900_as_cs_weights[uint32(cp1)<<16|uint32(cp0)]
 }
+func (contractor_utf8mb4_ja_0900_as_cs) ContextualWeights() map[uint32][]uint16 {
+	return contractor_utf8mb4_ja_0900_as_cs_weights
+}
 
 var reorder_utf8mb4_ja_0900_as_cs = []uca.Reorder{{FromMin: 0x1c47, FromMax: 0x1fb5, ToMin: 0x1c47, ToMax: 0x1fb5}, {FromMin: 0x3d5a, FromMax: 0x3d8b, ToMin: 0x1fb6, ToMax: 0x1fe7}, {FromMin: 0x1fb6, FromMax: 0x3d59, ToMin: 0x0, ToMax: 0x0}, {FromMin: 0x3d8c, FromMax: 0x54a3, ToMin: 0x0, ToMax: 0x0}}
 
@@ -4744,917 +4747,1283 @@ var collationsById = [...]Collation{
 	0x65: &Collation_uca_legacy{
 		name: "utf16_unicode_ci",
 		id:   0x65,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, nil, nil, 0xffff),
+		uca: newLazyCollation("utf16_unicode_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, nil, nil, 0xffff)
+		}),
 	},
 	0x66: &Collation_uca_legacy{
 		name: "utf16_icelandic_ci",
 		id:   0x66,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_icelandic_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_icelandic_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_icelandic_ci, nil, 0xffff)
+		}),
 	},
 	0x67: &Collation_uca_legacy{
 		name: "utf16_latvian_ci",
 		id:   0x67,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_latvian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_latvian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_latvian_ci, nil, 0xffff)
+		}),
 	},
 	0x68: &Collation_uca_legacy{
 		name: "utf16_romanian_ci",
 		id:   0x68,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_romanian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_romanian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_romanian_ci, nil, 0xffff)
+		}),
 	},
 	0x69: &Collation_uca_legacy{
 		name: "utf16_slovenian_ci",
 		id:   0x69,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_slovenian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_slovenian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_slovenian_ci, nil, 0xffff)
+		}),
 	},
 	0x6a: &Collation_uca_legacy{
 		name: "utf16_polish_ci",
 		id:   0x6a,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_polish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_polish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_polish_ci, nil, 0xffff)
+		}),
 	},
 	0x6b: &Collation_uca_legacy{
 		name: "utf16_estonian_ci",
 		id:   0x6b,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_estonian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_estonian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_estonian_ci, nil, 0xffff)
+		}),
 	},
 	0x6c: &Collation_uca_legacy{
 		name: "utf16_spanish_ci",
 		id:   0x6c,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_spanish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, nil, 0xffff)
+		}),
 	},
 	0x6d: &Collation_uca_legacy{
 		name: "utf16_swedish_ci",
 		id:   0x6d,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_swedish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_swedish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_swedish_ci, nil, 0xffff)
+		}),
 	},
 	0x6e: &Collation_uca_legacy{
 		name: "utf16_turkish_ci",
 		id:   0x6e,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_turkish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_turkish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_turkish_ci, nil, 0xffff)
+		}),
 	},
 	0x6f: &Collation_uca_legacy{
 		name: "utf16_czech_ci",
 		id:   0x6f,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_czech_ci, contractor_utf16_czech_ci{}, 0xffff),
+		uca: newLazyCollation("utf16_czech_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_czech_ci, contractor_utf16_czech_ci{}, 0xffff)
+		}),
 	},
 	0x70: &Collation_uca_legacy{
 		name: "utf16_danish_ci",
 		id:   0x70,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_danish_ci, contractor_utf16_danish_ci{}, 0xffff),
+		uca: newLazyCollation("utf16_danish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_danish_ci, contractor_utf16_danish_ci{}, 0xffff)
+		}),
 	},
 	0x71: &Collation_uca_legacy{
 		name: "utf16_lithuanian_ci",
 		id:   0x71,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_lithuanian_ci, contractor_utf16_lithuanian_ci{}, 0xffff),
+		uca: newLazyCollation("utf16_lithuanian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_lithuanian_ci, contractor_utf16_lithuanian_ci{}, 0xffff)
+		}),
 	},
 	0x72: &Collation_uca_legacy{
 		name: "utf16_slovak_ci",
 		id:   0x72,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_slovak_ci, contractor_utf16_czech_ci{}, 0xffff),
+		uca: newLazyCollation("utf16_slovak_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_slovak_ci, contractor_utf16_czech_ci{}, 0xffff)
+		}),
 	},
 	0x73: &Collation_uca_legacy{
 		name: "utf16_spanish2_ci",
 		id:   0x73,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, contractor_utf16_spanish2_ci{}, 0xffff),
+		uca: newLazyCollation("utf16_spanish2_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, contractor_utf16_spanish2_ci{}, 0xffff)
+		}),
 	},
 	0x74: &Collation_uca_legacy{
 		name: "utf16_roman_ci",
 		id:   0x74,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_roman_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_roman_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_roman_ci, nil, 0xffff)
+		}),
 	},
 	0x75: &Collation_uca_legacy{
 		name: "utf16_persian_ci",
 		id:   0x75,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_persian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_persian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_persian_ci, nil, 0xffff)
+		}),
 	},
 	0x76: &Collation_uca_legacy{
 		name: "utf16_esperanto_ci",
 		id:   0x76,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_esperanto_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_esperanto_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_esperanto_ci, nil, 0xffff)
+		}),
 	},
 	0x77: &Collation_uca_legacy{
 		name: "utf16_hungarian_ci",
 		id:   0x77,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_hungarian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_hungarian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_hungarian_ci, nil, 0xffff)
+		}),
 	},
 	0x78: &Collation_uca_legacy{
 		name: "utf16_sinhala_ci",
 		id:   0x78,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_sinhala_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_sinhala_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_sinhala_ci, nil, 0xffff)
+		}),
 	},
 	0x79: &Collation_uca_legacy{
 		name: "utf16_german2_ci",
 		id:   0x79,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_german2_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_german2_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_german2_ci, nil, 0xffff)
+		}),
 	},
 	0x7a: &Collation_uca_legacy{
 		name: "utf16_croatian_ci",
 		id:   0x7a,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_croatian_ci, contractor_utf16_croatian_ci{}, 0xffff),
+		uca: newLazyCollation("utf16_croatian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_croatian_ci, contractor_utf16_croatian_ci{}, 0xffff)
+		}),
 	},
 	0x7b: &Collation_uca_legacy{
 		name: "utf16_unicode_520_ci",
 		id:   0x7b,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca520, nil, nil, 0x10ffff),
+		uca: newLazyCollation("utf16_unicode_520_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA520, charset.Charset_utf16{}, weightTable_uca520, nil, nil, 0x10ffff)
+		}),
 	},
 	0x7c: &Collation_uca_legacy{
 		name: "utf16_vietnamese_ci",
 		id:   0x7c,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_vietnamese_ci, nil, 0xffff),
+		uca: newLazyCollation("utf16_vietnamese_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf16{}, weightTable_uca400, weightTailoring_utf16_vietnamese_ci, nil, 0xffff)
+		}),
 	},
 	0x80: &Collation_uca_legacy{
 		name: "ucs2_unicode_ci",
 		id:   0x80,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, nil, nil, 0xffff),
+		uca: newLazyCollation("ucs2_unicode_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, nil, nil, 0xffff)
+		}),
 	},
 	0x81: &Collation_uca_legacy{
 		name: "ucs2_icelandic_ci",
 		id:   0x81,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_icelandic_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_icelandic_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_icelandic_ci, nil, 0xffff)
+		}),
 	},
 	0x82: &Collation_uca_legacy{
 		name: "ucs2_latvian_ci",
 		id:   0x82,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_latvian_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_latvian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_latvian_ci, nil, 0xffff)
+		}),
 	},
 	0x83: &Collation_uca_legacy{
 		name: "ucs2_romanian_ci",
 		id:   0x83,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_romanian_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_romanian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_romanian_ci, nil, 0xffff)
+		}),
 	},
 	0x84: &Collation_uca_legacy{
 		name: "ucs2_slovenian_ci",
 		id:   0x84,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_slovenian_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_slovenian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_slovenian_ci, nil, 0xffff)
+		}),
 	},
 	0x85: &Collation_uca_legacy{
 		name: "ucs2_polish_ci",
 		id:   0x85,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_polish_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_polish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_polish_ci, nil, 0xffff)
+		}),
 	},
 	0x86: &Collation_uca_legacy{
 		name: "ucs2_estonian_ci",
 		id:   0x86,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_estonian_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_estonian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_estonian_ci, nil, 0xffff)
+		}),
 	},
 	0x87: &Collation_uca_legacy{
 		name: "ucs2_spanish_ci",
 		id:   0x87,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_spanish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, nil, 0xffff)
+		}),
 	},
 	0x88: &Collation_uca_legacy{
 		name: "ucs2_swedish_ci",
 		id:   0x88,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_swedish_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_swedish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_swedish_ci, nil, 0xffff)
+		}),
 	},
 	0x89: &Collation_uca_legacy{
 		name: "ucs2_turkish_ci",
 		id:   0x89,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_turkish_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_turkish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_turkish_ci, nil, 0xffff)
+		}),
 	},
 	0x8a: &Collation_uca_legacy{
 		name: "ucs2_czech_ci",
 		id:   0x8a,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_czech_ci, contractor_utf16_czech_ci{}, 0xffff),
+		uca: newLazyCollation("ucs2_czech_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_czech_ci, contractor_utf16_czech_ci{}, 0xffff)
+		}),
 	},
 	0x8b: &Collation_uca_legacy{
 		name: "ucs2_danish_ci",
 		id:   0x8b,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_danish_ci, contractor_utf16_danish_ci{}, 0xffff),
+		uca: newLazyCollation("ucs2_danish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_danish_ci, contractor_utf16_danish_ci{}, 0xffff)
+		}),
 	},
 	0x8c: &Collation_uca_legacy{
 		name: "ucs2_lithuanian_ci",
 		id:   0x8c,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_lithuanian_ci, contractor_utf16_lithuanian_ci{}, 0xffff),
+		uca: newLazyCollation("ucs2_lithuanian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_lithuanian_ci, contractor_utf16_lithuanian_ci{}, 0xffff)
+		}),
 	},
 	0x8d: &Collation_uca_legacy{
 		name: "ucs2_slovak_ci",
 		id:   0x8d,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_slovak_ci, contractor_utf16_czech_ci{}, 0xffff),
+		uca: newLazyCollation("ucs2_slovak_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_slovak_ci, contractor_utf16_czech_ci{}, 0xffff)
+		}),
 	},
 	0x8e: &Collation_uca_legacy{
 		name: "ucs2_spanish2_ci",
 		id:   0x8e,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, contractor_utf16_spanish2_ci{}, 0xffff),
+		uca: newLazyCollation("ucs2_spanish2_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, contractor_utf16_spanish2_ci{}, 0xffff)
+		}),
 	},
 	0x8f: &Collation_uca_legacy{
 		name: "ucs2_roman_ci",
 		id:   0x8f,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_roman_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_roman_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_roman_ci, nil, 0xffff)
+		}),
 	},
 	0x90: &Collation_uca_legacy{
 		name: "ucs2_persian_ci",
 		id:   0x90,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_persian_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_persian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_persian_ci, nil, 0xffff)
+		}),
 	},
 	0x91: &Collation_uca_legacy{
 		name: "ucs2_esperanto_ci",
 		id:   0x91,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_esperanto_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_esperanto_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_esperanto_ci, nil, 0xffff)
+		}),
 	},
 	0x92: &Collation_uca_legacy{
 		name: "ucs2_hungarian_ci",
 		id:   0x92,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_hungarian_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_hungarian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_hungarian_ci, nil, 0xffff)
+		}),
 	},
 	0x93: &Collation_uca_legacy{
 		name: "ucs2_sinhala_ci",
 		id:   0x93,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_sinhala_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_sinhala_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_sinhala_ci, nil, 0xffff)
+		}),
 	},
 	0x94: &Collation_uca_legacy{
 		name: "ucs2_german2_ci",
 		id:   0x94,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_german2_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_german2_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_german2_ci, nil, 0xffff)
+		}),
 	},
 	0x95: &Collation_uca_legacy{
 		name: "ucs2_croatian_ci",
 		id:   0x95,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_croatian_ci, contractor_utf16_croatian_ci{}, 0xffff),
+		uca: newLazyCollation("ucs2_croatian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_croatian_ci, contractor_utf16_croatian_ci{}, 0xffff)
+		}),
 	},
 	0x96: &Collation_uca_legacy{
 		name: "ucs2_unicode_520_ci",
 		id:   0x96,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca520, nil, nil, 0x10ffff),
+		uca: newLazyCollation("ucs2_unicode_520_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA520, charset.Charset_ucs2{}, weightTable_uca520, nil, nil, 0x10ffff)
+		}),
 	},
 	0x97: &Collation_uca_legacy{
 		name: "ucs2_vietnamese_ci",
 		id:   0x97,
-		uca:  uca.NewCollationLegacy(charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_vietnamese_ci, nil, 0xffff),
+		uca: newLazyCollation("ucs2_vietnamese_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_ucs2{}, weightTable_uca400, weightTailoring_utf16_vietnamese_ci, nil, 0xffff)
+		}),
 	},
 	0xa0: &Collation_uca_legacy{
 		name: "utf32_unicode_ci",
 		id:   0xa0,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, nil, nil, 0xffff),
+		uca: newLazyCollation("utf32_unicode_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, nil, nil, 0xffff)
+		}),
 	},
 	0xa1: &Collation_uca_legacy{
 		name: "utf32_icelandic_ci",
 		id:   0xa1,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_icelandic_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_icelandic_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_icelandic_ci, nil, 0xffff)
+		}),
 	},
 	0xa2: &Collation_uca_legacy{
 		name: "utf32_latvian_ci",
 		id:   0xa2,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_latvian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_latvian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_latvian_ci, nil, 0xffff)
+		}),
 	},
 	0xa3: &Collation_uca_legacy{
 		name: "utf32_romanian_ci",
 		id:   0xa3,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_romanian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_romanian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_romanian_ci, nil, 0xffff)
+		}),
 	},
 	0xa4: &Collation_uca_legacy{
 		name: "utf32_slovenian_ci",
 		id:   0xa4,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_slovenian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_slovenian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_slovenian_ci, nil, 0xffff)
+		}),
 	},
 	0xa5: &Collation_uca_legacy{
 		name: "utf32_polish_ci",
 		id:   0xa5,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_polish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_polish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_polish_ci, nil, 0xffff)
+		}),
 	},
 	0xa6: &Collation_uca_legacy{
 		name: "utf32_estonian_ci",
 		id:   0xa6,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_estonian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_estonian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_estonian_ci, nil, 0xffff)
+		}),
 	},
 	0xa7: &Collation_uca_legacy{
 		name: "utf32_spanish_ci",
 		id:   0xa7,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_spanish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, nil, 0xffff)
+		}),
 	},
 	0xa8: &Collation_uca_legacy{
 		name: "utf32_swedish_ci",
 		id:   0xa8,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_swedish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_swedish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_swedish_ci, nil, 0xffff)
+		}),
 	},
 	0xa9: &Collation_uca_legacy{
 		name: "utf32_turkish_ci",
 		id:   0xa9,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_turkish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_turkish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_turkish_ci, nil, 0xffff)
+		}),
 	},
 	0xaa: &Collation_uca_legacy{
 		name: "utf32_czech_ci",
 		id:   0xaa,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_czech_ci, contractor_utf16_czech_ci{}, 0xffff),
+		uca: newLazyCollation("utf32_czech_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_czech_ci, contractor_utf16_czech_ci{}, 0xffff)
+		}),
 	},
 	0xab: &Collation_uca_legacy{
 		name: "utf32_danish_ci",
 		id:   0xab,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_danish_ci, contractor_utf16_danish_ci{}, 0xffff),
+		uca: newLazyCollation("utf32_danish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_danish_ci, contractor_utf16_danish_ci{}, 0xffff)
+		}),
 	},
 	0xac: &Collation_uca_legacy{
 		name: "utf32_lithuanian_ci",
 		id:   0xac,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_lithuanian_ci, contractor_utf16_lithuanian_ci{}, 0xffff),
+		uca: newLazyCollation("utf32_lithuanian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_lithuanian_ci, contractor_utf16_lithuanian_ci{}, 0xffff)
+		}),
 	},
 	0xad: &Collation_uca_legacy{
 		name: "utf32_slovak_ci",
 		id:   0xad,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_slovak_ci, contractor_utf16_czech_ci{}, 0xffff),
+		uca: newLazyCollation("utf32_slovak_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_slovak_ci, contractor_utf16_czech_ci{}, 0xffff)
+		}),
 	},
 	0xae: &Collation_uca_legacy{
 		name: "utf32_spanish2_ci",
 		id:   0xae,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, contractor_utf16_spanish2_ci{}, 0xffff),
+		uca: newLazyCollation("utf32_spanish2_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, contractor_utf16_spanish2_ci{}, 0xffff)
+		}),
 	},
 	0xaf: &Collation_uca_legacy{
 		name: "utf32_roman_ci",
 		id:   0xaf,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_roman_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_roman_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_roman_ci, nil, 0xffff)
+		}),
 	},
 	0xb0: &Collation_uca_legacy{
 		name: "utf32_persian_ci",
 		id:   0xb0,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_persian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_persian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_persian_ci, nil, 0xffff)
+		}),
 	},
 	0xb1: &Collation_uca_legacy{
 		name: "utf32_esperanto_ci",
 		id:   0xb1,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_esperanto_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_esperanto_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_esperanto_ci, nil, 0xffff)
+		}),
 	},
 	0xb2: &Collation_uca_legacy{
 		name: "utf32_hungarian_ci",
 		id:   0xb2,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_hungarian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_hungarian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_hungarian_ci, nil, 0xffff)
+		}),
 	},
 	0xb3: &Collation_uca_legacy{
 		name: "utf32_sinhala_ci",
 		id:   0xb3,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_sinhala_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_sinhala_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_sinhala_ci, nil, 0xffff)
+		}),
 	},
 	0xb4: &Collation_uca_legacy{
 		name: "utf32_german2_ci",
 		id:   0xb4,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_german2_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_german2_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_german2_ci, nil, 0xffff)
+		}),
 	},
 	0xb5: &Collation_uca_legacy{
 		name: "utf32_croatian_ci",
 		id:   0xb5,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_croatian_ci, contractor_utf16_croatian_ci{}, 0xffff),
+		uca: newLazyCollation("utf32_croatian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_croatian_ci, contractor_utf16_croatian_ci{}, 0xffff)
+		}),
 	},
 	0xb6: &Collation_uca_legacy{
 		name: "utf32_unicode_520_ci",
 		id:   0xb6,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca520, nil, nil, 0x10ffff),
+		uca: newLazyCollation("utf32_unicode_520_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA520, charset.Charset_utf32{}, weightTable_uca520, nil, nil, 0x10ffff)
+		}),
 	},
 	0xb7: &Collation_uca_legacy{
 		name: "utf32_vietnamese_ci",
 		id:   0xb7,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_vietnamese_ci, nil, 0xffff),
+		uca: newLazyCollation("utf32_vietnamese_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf32{}, weightTable_uca400, weightTailoring_utf16_vietnamese_ci, nil, 0xffff)
+		}),
 	},
 	0xc0: &Collation_uca_legacy{
 		name: "utf8mb3_unicode_ci",
 		id:   0xc0,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, nil, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_unicode_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, nil, nil, 0xffff)
+		}),
 	},
 	0xc1: &Collation_uca_legacy{
 		name: "utf8mb3_icelandic_ci",
 		id:   0xc1,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_icelandic_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_icelandic_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_icelandic_ci, nil, 0xffff)
+		}),
 	},
 	0xc2: &Collation_uca_legacy{
 		name: "utf8mb3_latvian_ci",
 		id:   0xc2,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_latvian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_latvian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_latvian_ci, nil, 0xffff)
+		}),
 	},
 	0xc3: &Collation_uca_legacy{
 		name: "utf8mb3_romanian_ci",
 		id:   0xc3,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_romanian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_romanian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_romanian_ci, nil, 0xffff)
+		}),
 	},
 	0xc4: &Collation_uca_legacy{
 		name: "utf8mb3_slovenian_ci",
 		id:   0xc4,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_slovenian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_slovenian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_slovenian_ci, nil, 0xffff)
+		}),
 	},
 	0xc5: &Collation_uca_legacy{
 		name: "utf8mb3_polish_ci",
 		id:   0xc5,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_polish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_polish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_polish_ci, nil, 0xffff)
+		}),
 	},
 	0xc6: &Collation_uca_legacy{
 		name: "utf8mb3_estonian_ci",
 		id:   0xc6,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_estonian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_estonian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_estonian_ci, nil, 0xffff)
+		}),
 	},
 	0xc7: &Collation_uca_legacy{
 		name: "utf8mb3_spanish_ci",
 		id:   0xc7,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_spanish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, nil, 0xffff)
+		}),
 	},
 	0xc8: &Collation_uca_legacy{
 		name: "utf8mb3_swedish_ci",
 		id:   0xc8,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_swedish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_swedish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_swedish_ci, nil, 0xffff)
+		}),
 	},
 	0xc9: &Collation_uca_legacy{
 		name: "utf8mb3_turkish_ci",
 		id:   0xc9,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_turkish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_turkish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_turkish_ci, nil, 0xffff)
+		}),
 	},
 	0xca: &Collation_uca_legacy{
 		name: "utf8mb3_czech_ci",
 		id:   0xca,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_czech_ci, contractor_utf16_czech_ci{}, 0xffff),
+		uca: newLazyCollation("utf8mb3_czech_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_czech_ci, contractor_utf16_czech_ci{}, 0xffff)
+		}),
 	},
 	0xcb: &Collation_uca_legacy{
 		name: "utf8mb3_danish_ci",
 		id:   0xcb,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_danish_ci, contractor_utf16_danish_ci{}, 0xffff),
+		uca: newLazyCollation("utf8mb3_danish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_danish_ci, contractor_utf16_danish_ci{}, 0xffff)
+		}),
 	},
 	0xcc: &Collation_uca_legacy{
 		name: "utf8mb3_lithuanian_ci",
 		id:   0xcc,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_lithuanian_ci, contractor_utf16_lithuanian_ci{}, 0xffff),
+		uca: newLazyCollation("utf8mb3_lithuanian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_lithuanian_ci, contractor_utf16_lithuanian_ci{}, 0xffff)
+		}),
 	},
 	0xcd: &Collation_uca_legacy{
 		name: "utf8mb3_slovak_ci",
 		id:   0xcd,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_slovak_ci, contractor_utf16_czech_ci{}, 0xffff),
+		uca: newLazyCollation("utf8mb3_slovak_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_slovak_ci, contractor_utf16_czech_ci{}, 0xffff)
+		}),
 	},
 	0xce: &Collation_uca_legacy{
 		name: "utf8mb3_spanish2_ci",
 		id:   0xce,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, contractor_utf16_spanish2_ci{}, 0xffff),
+		uca: newLazyCollation("utf8mb3_spanish2_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, contractor_utf16_spanish2_ci{}, 0xffff)
+		}),
 	},
 	0xcf: &Collation_uca_legacy{
 		name: "utf8mb3_roman_ci",
 		id:   0xcf,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_roman_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_roman_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_roman_ci, nil, 0xffff)
+		}),
 	},
 	0xd0: &Collation_uca_legacy{
 		name: "utf8mb3_persian_ci",
 		id:   0xd0,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_persian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_persian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_persian_ci, nil, 0xffff)
+		}),
 	},
 	0xd1: &Collation_uca_legacy{
 		name: "utf8mb3_esperanto_ci",
 		id:   0xd1,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_esperanto_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_esperanto_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_esperanto_ci, nil, 0xffff)
+		}),
 	},
 	0xd2: &Collation_uca_legacy{
 		name: "utf8mb3_hungarian_ci",
 		id:   0xd2,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_hungarian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_hungarian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_hungarian_ci, nil, 0xffff)
+		}),
 	},
 	0xd3: &Collation_uca_legacy{
 		name: "utf8mb3_sinhala_ci",
 		id:   0xd3,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_sinhala_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_sinhala_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_sinhala_ci, nil, 0xffff)
+		}),
 	},
 	0xd4: &Collation_uca_legacy{
 		name: "utf8mb3_german2_ci",
 		id:   0xd4,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_german2_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_german2_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_german2_ci, nil, 0xffff)
+		}),
 	},
 	0xd5: &Collation_uca_legacy{
 		name: "utf8mb3_croatian_ci",
 		id:   0xd5,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_croatian_ci, contractor_utf16_croatian_ci{}, 0xffff),
+		uca: newLazyCollation("utf8mb3_croatian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_croatian_ci, contractor_utf16_croatian_ci{}, 0xffff)
+		}),
 	},
 	0xd6: &Collation_uca_legacy{
 		name: "utf8mb3_unicode_520_ci",
 		id:   0xd6,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca520, nil, nil, 0x10ffff),
+		uca: newLazyCollation("utf8mb3_unicode_520_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA520, charset.Charset_utf8mb3{}, weightTable_uca520, nil, nil, 0x10ffff)
+		}),
 	},
 	0xd7: &Collation_uca_legacy{
 		name: "utf8mb3_vietnamese_ci",
 		id:   0xd7,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_vietnamese_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb3_vietnamese_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb3{}, weightTable_uca400, weightTailoring_utf16_vietnamese_ci, nil, 0xffff)
+		}),
 	},
 	0xe0: &Collation_uca_legacy{
 		name: "utf8mb4_unicode_ci",
 		id:   0xe0,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, nil, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_unicode_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, nil, nil, 0xffff)
+		}),
 	},
 	0xe1: &Collation_uca_legacy{
 		name: "utf8mb4_icelandic_ci",
 		id:   0xe1,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_icelandic_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_icelandic_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_icelandic_ci, nil, 0xffff)
+		}),
 	},
 	0xe2: &Collation_uca_legacy{
 		name: "utf8mb4_latvian_ci",
 		id:   0xe2,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_latvian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_latvian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_latvian_ci, nil, 0xffff)
+		}),
 	},
 	0xe3: &Collation_uca_legacy{
 		name: "utf8mb4_romanian_ci",
 		id:   0xe3,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_romanian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_romanian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_romanian_ci, nil, 0xffff)
+		}),
 	},
 	0xe4: &Collation_uca_legacy{
 		name: "utf8mb4_slovenian_ci",
 		id:   0xe4,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_slovenian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_slovenian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_slovenian_ci, nil, 0xffff)
+		}),
 	},
 	0xe5: &Collation_uca_legacy{
 		name: "utf8mb4_polish_ci",
 		id:   0xe5,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_polish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_polish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_polish_ci, nil, 0xffff)
+		}),
 	},
 	0xe6: &Collation_uca_legacy{
 		name: "utf8mb4_estonian_ci",
 		id:   0xe6,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_estonian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_estonian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_estonian_ci, nil, 0xffff)
+		}),
 	},
 	0xe7: &Collation_uca_legacy{
 		name: "utf8mb4_spanish_ci",
 		id:   0xe7,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_spanish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, nil, 0xffff)
+		}),
 	},
 	0xe8: &Collation_uca_legacy{
 		name: "utf8mb4_swedish_ci",
 		id:   0xe8,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_swedish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_swedish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_swedish_ci, nil, 0xffff)
+		}),
 	},
 	0xe9: &Collation_uca_legacy{
 		name: "utf8mb4_turkish_ci",
 		id:   0xe9,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_turkish_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_turkish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_turkish_ci, nil, 0xffff)
+		}),
 	},
 	0xea: &Collation_uca_legacy{
 		name: "utf8mb4_czech_ci",
 		id:   0xea,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_czech_ci, contractor_utf16_czech_ci{}, 0xffff),
+		uca: newLazyCollation("utf8mb4_czech_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_czech_ci, contractor_utf16_czech_ci{}, 0xffff)
+		}),
 	},
 	0xeb: &Collation_uca_legacy{
 		name: "utf8mb4_danish_ci",
 		id:   0xeb,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_danish_ci, contractor_utf16_danish_ci{}, 0xffff),
+		uca: newLazyCollation("utf8mb4_danish_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_danish_ci, contractor_utf16_danish_ci{}, 0xffff)
+		}),
 	},
 	0xec: &Collation_uca_legacy{
 		name: "utf8mb4_lithuanian_ci",
 		id:   0xec,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_lithuanian_ci, contractor_utf16_lithuanian_ci{}, 0xffff),
+		uca: newLazyCollation("utf8mb4_lithuanian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_lithuanian_ci, contractor_utf16_lithuanian_ci{}, 0xffff)
+		}),
 	},
 	0xed: &Collation_uca_legacy{
 		name: "utf8mb4_slovak_ci",
 		id:   0xed,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_slovak_ci, contractor_utf16_czech_ci{}, 0xffff),
+		uca: newLazyCollation("utf8mb4_slovak_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_slovak_ci, contractor_utf16_czech_ci{}, 0xffff)
+		}),
 	},
 	0xee: &Collation_uca_legacy{
 		name: "utf8mb4_spanish2_ci",
 		id:   0xee,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, contractor_utf16_spanish2_ci{}, 0xffff),
+		uca: newLazyCollation("utf8mb4_spanish2_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_spanish_ci, contractor_utf16_spanish2_ci{}, 0xffff)
+		}),
 	},
 	0xef: &Collation_uca_legacy{
 		name: "utf8mb4_roman_ci",
 		id:   0xef,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_roman_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_roman_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_roman_ci, nil, 0xffff)
+		}),
 	},
 	0xf0: &Collation_uca_legacy{
 		name: "utf8mb4_persian_ci",
 		id:   0xf0,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_persian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_persian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_persian_ci, nil, 0xffff)
+		}),
 	},
 	0xf1: &Collation_uca_legacy{
 		name: "utf8mb4_esperanto_ci",
 		id:   0xf1,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_esperanto_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_esperanto_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_esperanto_ci, nil, 0xffff)
+		}),
 	},
 	0xf2: &Collation_uca_legacy{
 		name: "utf8mb4_hungarian_ci",
 		id:   0xf2,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_hungarian_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_hungarian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_hungarian_ci, nil, 0xffff)
+		}),
 	},
 	0xf3: &Collation_uca_legacy{
 		name: "utf8mb4_sinhala_ci",
 		id:   0xf3,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_sinhala_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_sinhala_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_sinhala_ci, nil, 0xffff)
+		}),
 	},
 	0xf4: &Collation_uca_legacy{
 		name: "utf8mb4_german2_ci",
 		id:   0xf4,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_german2_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_german2_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_german2_ci, nil, 0xffff)
+		}),
 	},
 	0xf5: &Collation_uca_legacy{
 		name: "utf8mb4_croatian_ci",
 		id:   0xf5,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_croatian_ci, contractor_utf16_croatian_ci{}, 0xffff),
+		uca: newLazyCollation("utf8mb4_croatian_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_croatian_ci, contractor_utf16_croatian_ci{}, 0xffff)
+		}),
 	},
 	0xf6: &Collation_uca_legacy{
 		name: "utf8mb4_unicode_520_ci",
 		id:   0xf6,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca520, nil, nil, 0x10ffff),
+		uca: newLazyCollation("utf8mb4_unicode_520_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA520, charset.Charset_utf8mb4{}, weightTable_uca520, nil, nil, 0x10ffff)
+		}),
 	},
 	0xf7: &Collation_uca_legacy{
 		name: "utf8mb4_vietnamese_ci",
 		id:   0xf7,
-		uca:  uca.NewCollationLegacy(charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_vietnamese_ci, nil, 0xffff),
+		uca: newLazyCollation("utf8mb4_vietnamese_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA400, charset.Charset_utf8mb4{}, weightTable_uca400, weightTailoring_utf16_vietnamese_ci, nil, 0xffff)
+		}),
 	},
 	0xfa: &Collation_uca_legacy{
 		name: "gb18030_unicode_520_ci",
 		id:   0xfa,
-		uca:  uca.NewCollationLegacy(charset.Charset_gb18030{}, weightTable_uca520, nil, nil, 0x10ffff),
+		uca: newLazyCollation("gb18030_unicode_520_ci", func() *uca.CollationLegacy {
+			return uca.NewCollationLegacy(uca.UCA520, charset.Charset_gb18030{}, weightTable_uca520, nil, nil, 0x10ffff)
+		}),
 	},
 	0xff: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_0900_ai_ci",
 		id:   0xff,
-		uca:  uca.NewCollation("utf8mb4_0900_ai_ci", weightTable_uca900, nil, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_0900_ai_ci", weightTable_uca900, nil, nil, nil, false, 1)
+		}),
 	},
 	0x100: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_de_pb_0900_ai_ci",
 		id:   0x100,
-		uca:  uca.NewCollation("utf8mb4_de_pb_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_de_pb_0900_ai_ci, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_de_pb_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_de_pb_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_de_pb_0900_ai_ci, nil, nil, false, 1)
+		}),
 	},
 	0x101: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_is_0900_ai_ci",
 		id:   0x101,
-		uca:  uca.NewCollation("utf8mb4_is_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_is_0900_ai_ci, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_is_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_is_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_is_0900_ai_ci, nil, nil, false, 1)
+		}),
 	},
 	0x102: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_lv_0900_ai_ci",
 		id:   0x102,
-		uca:  uca.NewCollation("utf8mb4_lv_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_lv_0900_ai_ci, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_lv_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_lv_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_lv_0900_ai_ci, nil, nil, false, 1)
+		}),
 	},
 	0x103: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_ro_0900_ai_ci",
 		id:   0x103,
-		uca:  uca.NewCollation("utf8mb4_ro_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_ro_0900_ai_ci, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_ro_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_ro_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_ro_0900_ai_ci, nil, nil, false, 1)
+		}),
 	},
 	0x104: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_sl_0900_ai_ci",
 		id:   0x104,
-		uca:  uca.NewCollation("utf8mb4_sl_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_sl_0900_ai_ci, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_sl_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_sl_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_sl_0900_ai_ci, nil, nil, false, 1)
+		}),
 	},
 	0x105: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_pl_0900_ai_ci",
 		id:   0x105,
-		uca:  uca.NewCollation("utf8mb4_pl_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_pl_0900_ai_ci, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_pl_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_pl_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_pl_0900_ai_ci, nil, nil, false, 1)
+		}),
 	},
 	0x106: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_et_0900_ai_ci",
 		id:   0x106,
-		uca:  uca.NewCollation("utf8mb4_et_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_et_0900_ai_ci, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_et_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_et_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_et_0900_ai_ci, nil, nil, false, 1)
+		}),
 	},
 	0x107: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_es_0900_ai_ci",
 		id:   0x107,
-		uca:  uca.NewCollation("utf8mb4_es_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_es_0900_ai_ci, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_es_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_es_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_es_0900_ai_ci, nil, nil, false, 1)
+		}),
 	},
 	0x108: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_sv_0900_ai_ci",
 		id:   0x108,
-		uca:  uca.NewCollation("utf8mb4_sv_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_sv_0900_ai_ci, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_sv_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_sv_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_sv_0900_ai_ci, nil, nil, false, 1)
+		}),
 	},
 	0x109: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_tr_0900_ai_ci",
 		id:   0x109,
-		uca:  uca.NewCollation("utf8mb4_tr_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_tr_0900_ai_ci, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_tr_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_tr_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_tr_0900_ai_ci, nil, nil, false, 1)
+		}),
 	},
 	0x10a: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_cs_0900_ai_ci",
 		id:   0x10a,
-		uca:  uca.NewCollation("utf8mb4_cs_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_cs_0900_ai_ci, nil, contractor_utf8mb4_cs_0900_ai_ci{}, false, 1),
+		uca: newLazyCollation("utf8mb4_cs_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_cs_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_cs_0900_ai_ci, nil, contractor_utf8mb4_cs_0900_ai_ci{}, false, 1)
+		}),
 	},
 	0x10b: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_da_0900_ai_ci",
 		id:   0x10b,
-		uca:  uca.NewCollation("utf8mb4_da_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_da_0900_ai_ci, nil, contractor_utf8mb4_da_0900_ai_ci{}, false, 1),
+		uca: newLazyCollation("utf8mb4_da_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_da_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_da_0900_ai_ci, nil, contractor_utf8mb4_da_0900_ai_ci{}, false, 1)
+		}),
 	},
 	0x10c: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_lt_0900_ai_ci",
 		id:   0x10c,
-		uca:  uca.NewCollation("utf8mb4_lt_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_lt_0900_ai_ci, nil, contractor_utf8mb4_lt_0900_ai_ci{}, false, 1),
+		uca: newLazyCollation("utf8mb4_lt_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_lt_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_lt_0900_ai_ci, nil, contractor_utf8mb4_lt_0900_ai_ci{}, false, 1)
+		}),
 	},
 	0x10d: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_sk_0900_ai_ci",
 		id:   0x10d,
-		uca:  uca.NewCollation("utf8mb4_sk_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_sk_0900_ai_ci, nil, contractor_utf8mb4_cs_0900_ai_ci{}, false, 1),
+		uca: newLazyCollation("utf8mb4_sk_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_sk_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_sk_0900_ai_ci, nil, contractor_utf8mb4_cs_0900_ai_ci{}, false, 1)
+		}),
 	},
 	0x10e: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_es_trad_0900_ai_ci",
 		id:   0x10e,
-		uca:  uca.NewCollation("utf8mb4_es_trad_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_es_0900_ai_ci, nil, contractor_utf8mb4_es_trad_0900_ai_ci{}, false, 1),
+		uca: newLazyCollation("utf8mb4_es_trad_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_es_trad_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_es_0900_ai_ci, nil, contractor_utf8mb4_es_trad_0900_ai_ci{}, false, 1)
+		}),
 	},
 	0x10f: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_la_0900_ai_ci",
 		id:   0x10f,
-		uca:  uca.NewCollation("utf8mb4_la_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_la_0900_ai_ci, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_la_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_la_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_la_0900_ai_ci, nil, nil, false, 1)
+		}),
 	},
 	0x111: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_eo_0900_ai_ci",
 		id:   0x111,
-		uca:  uca.NewCollation("utf8mb4_eo_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_eo_0900_ai_ci, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_eo_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_eo_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_eo_0900_ai_ci, nil, nil, false, 1)
+		}),
 	},
 	0x112: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_hu_0900_ai_ci",
 		id:   0x112,
-		uca:  uca.NewCollation("utf8mb4_hu_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_hu_0900_ai_ci, nil, contractor_utf8mb4_hu_0900_ai_ci{}, false, 1),
+		uca: newLazyCollation("utf8mb4_hu_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_hu_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_hu_0900_ai_ci, nil, contractor_utf8mb4_hu_0900_ai_ci{}, false, 1)
+		}),
 	},
 	0x113: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_hr_0900_ai_ci",
 		id:   0x113,
-		uca:  uca.NewCollation("utf8mb4_hr_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_hr_0900_ai_ci, reorder_utf8mb4_hr_0900_ai_ci, contractor_utf8mb4_hr_0900_ai_ci{}, false, 1),
+		uca: newLazyCollation("utf8mb4_hr_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_hr_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_hr_0900_ai_ci, reorder_utf8mb4_hr_0900_ai_ci, contractor_utf8mb4_hr_0900_ai_ci{}, false, 1)
+		}),
 	},
 	0x115: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_vi_0900_ai_ci",
 		id:   0x115,
-		uca:  uca.NewCollation("utf8mb4_vi_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_vi_0900_ai_ci, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_vi_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_vi_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_vi_0900_ai_ci, nil, nil, false, 1)
+		}),
 	},
 	0x116: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_0900_as_cs",
 		id:   0x116,
-		uca:  uca.NewCollation("utf8mb4_0900_as_cs", weightTable_uca900, nil, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_0900_as_cs", weightTable_uca900, nil, nil, nil, false, 3)
+		}),
 	},
 	0x117: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_de_pb_0900_as_cs",
 		id:   0x117,
-		uca:  uca.NewCollation("utf8mb4_de_pb_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_de_pb_0900_ai_ci, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_de_pb_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_de_pb_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_de_pb_0900_ai_ci, nil, nil, false, 3)
+		}),
 	},
 	0x118: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_is_0900_as_cs",
 		id:   0x118,
-		uca:  uca.NewCollation("utf8mb4_is_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_is_0900_ai_ci, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_is_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_is_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_is_0900_ai_ci, nil, nil, false, 3)
+		}),
 	},
 	0x119: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_lv_0900_as_cs",
 		id:   0x119,
-		uca:  uca.NewCollation("utf8mb4_lv_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_lv_0900_ai_ci, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_lv_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_lv_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_lv_0900_ai_ci, nil, nil, false, 3)
+		}),
 	},
 	0x11a: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_ro_0900_as_cs",
 		id:   0x11a,
-		uca:  uca.NewCollation("utf8mb4_ro_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_ro_0900_ai_ci, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_ro_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_ro_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_ro_0900_ai_ci, nil, nil, false, 3)
+		}),
 	},
 	0x11b: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_sl_0900_as_cs",
 		id:   0x11b,
-		uca:  uca.NewCollation("utf8mb4_sl_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_sl_0900_ai_ci, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_sl_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_sl_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_sl_0900_ai_ci, nil, nil, false, 3)
+		}),
 	},
 	0x11c: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_pl_0900_as_cs",
 		id:   0x11c,
-		uca:  uca.NewCollation("utf8mb4_pl_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_pl_0900_ai_ci, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_pl_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_pl_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_pl_0900_ai_ci, nil, nil, false, 3)
+		}),
 	},
 	0x11d: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_et_0900_as_cs",
 		id:   0x11d,
-		uca:  uca.NewCollation("utf8mb4_et_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_et_0900_ai_ci, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_et_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_et_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_et_0900_ai_ci, nil, nil, false, 3)
+		}),
 	},
 	0x11e: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_es_0900_as_cs",
 		id:   0x11e,
-		uca:  uca.NewCollation("utf8mb4_es_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_es_0900_ai_ci, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_es_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_es_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_es_0900_ai_ci, nil, nil, false, 3)
+		}),
 	},
 	0x11f: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_sv_0900_as_cs",
 		id:   0x11f,
-		uca:  uca.NewCollation("utf8mb4_sv_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_sv_0900_ai_ci, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_sv_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_sv_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_sv_0900_ai_ci, nil, nil, false, 3)
+		}),
 	},
 	0x120: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_tr_0900_as_cs",
 		id:   0x120,
-		uca:  uca.NewCollation("utf8mb4_tr_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_tr_0900_ai_ci, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_tr_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_tr_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_tr_0900_ai_ci, nil, nil, false, 3)
+		}),
 	},
 	0x121: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_cs_0900_as_cs",
 		id:   0x121,
-		uca:  uca.NewCollation("utf8mb4_cs_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_cs_0900_ai_ci, nil, contractor_utf8mb4_cs_0900_ai_ci{}, false, 3),
+		uca: newLazyCollation("utf8mb4_cs_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_cs_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_cs_0900_ai_ci, nil, contractor_utf8mb4_cs_0900_ai_ci{}, false, 3)
+		}),
 	},
 	0x122: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_da_0900_as_cs",
 		id:   0x122,
-		uca:  uca.NewCollation("utf8mb4_da_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_da_0900_as_cs, nil, contractor_utf8mb4_da_0900_as_cs{}, true, 3),
+		uca: newLazyCollation("utf8mb4_da_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_da_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_da_0900_as_cs, nil, contractor_utf8mb4_da_0900_as_cs{}, true, 3)
+		}),
 	},
 	0x123: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_lt_0900_as_cs",
 		id:   0x123,
-		uca:  uca.NewCollation("utf8mb4_lt_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_lt_0900_ai_ci, nil, contractor_utf8mb4_lt_0900_ai_ci{}, false, 3),
+		uca: newLazyCollation("utf8mb4_lt_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_lt_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_lt_0900_ai_ci, nil, contractor_utf8mb4_lt_0900_ai_ci{}, false, 3)
+		}),
 	},
 	0x124: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_sk_0900_as_cs",
 		id:   0x124,
-		uca:  uca.NewCollation("utf8mb4_sk_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_sk_0900_ai_ci, nil, contractor_utf8mb4_cs_0900_ai_ci{}, false, 3),
+		uca: newLazyCollation("utf8mb4_sk_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_sk_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_sk_0900_ai_ci, nil, contractor_utf8mb4_cs_0900_ai_ci{}, false, 3)
+		}),
 	},
 	0x125: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_es_trad_0900_as_cs",
 		id:   0x125,
-		uca:  uca.NewCollation("utf8mb4_es_trad_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_es_0900_ai_ci, nil, contractor_utf8mb4_es_trad_0900_ai_ci{}, false, 3),
+		uca: newLazyCollation("utf8mb4_es_trad_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_es_trad_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_es_0900_ai_ci, nil, contractor_utf8mb4_es_trad_0900_ai_ci{}, false, 3)
+		}),
 	},
 	0x126: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_la_0900_as_cs",
 		id:   0x126,
-		uca:  uca.NewCollation("utf8mb4_la_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_la_0900_ai_ci, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_la_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_la_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_la_0900_ai_ci, nil, nil, false, 3)
+		}),
 	},
 	0x128: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_eo_0900_as_cs",
 		id:   0x128,
-		uca:  uca.NewCollation("utf8mb4_eo_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_eo_0900_ai_ci, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_eo_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_eo_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_eo_0900_ai_ci, nil, nil, false, 3)
+		}),
 	},
 	0x129: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_hu_0900_as_cs",
 		id:   0x129,
-		uca:  uca.NewCollation("utf8mb4_hu_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_hu_0900_ai_ci, nil, contractor_utf8mb4_hu_0900_ai_ci{}, false, 3),
+		uca: newLazyCollation("utf8mb4_hu_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_hu_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_hu_0900_ai_ci, nil, contractor_utf8mb4_hu_0900_ai_ci{}, false, 3)
+		}),
 	},
 	0x12a: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_hr_0900_as_cs",
 		id:   0x12a,
-		uca:  uca.NewCollation("utf8mb4_hr_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_hr_0900_ai_ci, reorder_utf8mb4_hr_0900_ai_ci, contractor_utf8mb4_hr_0900_ai_ci{}, false, 3),
+		uca: newLazyCollation("utf8mb4_hr_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_hr_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_hr_0900_ai_ci, reorder_utf8mb4_hr_0900_ai_ci, contractor_utf8mb4_hr_0900_ai_ci{}, false, 3)
+		}),
 	},
 	0x12c: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_vi_0900_as_cs",
 		id:   0x12c,
-		uca:  uca.NewCollation("utf8mb4_vi_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_vi_0900_as_cs, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_vi_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_vi_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_vi_0900_as_cs, nil, nil, false, 3)
+		}),
 	},
 	0x12f: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_ja_0900_as_cs",
 		id:   0x12f,
-		uca:  uca.NewCollation("utf8mb4_ja_0900_as_cs", weightTable_uca900_ja, nil, reorder_utf8mb4_ja_0900_as_cs, contractor_utf8mb4_ja_0900_as_cs{}, false, 3),
+		uca: newLazyCollation("utf8mb4_ja_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_ja_0900_as_cs", weightTable_uca900_ja, nil, reorder_utf8mb4_ja_0900_as_cs, contractor_utf8mb4_ja_0900_as_cs{}, false, 3)
+		}),
 	},
 	0x130: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_ja_0900_as_cs_ks",
 		id:   0x130,
-		uca:  uca.NewCollation("utf8mb4_ja_0900_as_cs_ks", weightTable_uca900_ja, nil, reorder_utf8mb4_ja_0900_as_cs, contractor_utf8mb4_ja_0900_as_cs{}, false, 4),
+		uca: newLazyCollation("utf8mb4_ja_0900_as_cs_ks", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_ja_0900_as_cs_ks", weightTable_uca900_ja, nil, reorder_utf8mb4_ja_0900_as_cs, contractor_utf8mb4_ja_0900_as_cs{}, false, 4)
+		}),
 	},
 	0x131: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_0900_as_ci",
 		id:   0x131,
-		uca:  uca.NewCollation("utf8mb4_0900_as_ci", weightTable_uca900, nil, nil, nil, false, 2),
+		uca: newLazyCollation("utf8mb4_0900_as_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_0900_as_ci", weightTable_uca900, nil, nil, nil, false, 2)
+		}),
 	},
 	0x132: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_ru_0900_ai_ci",
 		id:   0x132,
-		uca:  uca.NewCollation("utf8mb4_ru_0900_ai_ci", weightTable_uca900, nil, reorder_utf8mb4_ru_0900_ai_ci, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_ru_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_ru_0900_ai_ci", weightTable_uca900, nil, reorder_utf8mb4_ru_0900_ai_ci, nil, false, 1)
+		}),
 	},
 	0x133: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_ru_0900_as_cs",
 		id:   0x133,
-		uca:  uca.NewCollation("utf8mb4_ru_0900_as_cs", weightTable_uca900, nil, reorder_utf8mb4_ru_0900_ai_ci, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_ru_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_ru_0900_as_cs", weightTable_uca900, nil, reorder_utf8mb4_ru_0900_ai_ci, nil, false, 3)
+		}),
 	},
 	0x134: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_zh_0900_as_cs",
 		id:   0x134,
-		uca:  uca.NewCollation("utf8mb4_zh_0900_as_cs", weightTable_uca900_zh, nil, nil, contractor_utf8mb4_zh_0900_as_cs{}, false, 3),
+		uca: newLazyCollation("utf8mb4_zh_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_zh_0900_as_cs", weightTable_uca900_zh, nil, nil, contractor_utf8mb4_zh_0900_as_cs{}, false, 3)
+		}),
 	},
 	0x135: &Collation_utf8mb4_0900_bin{},
 	0x136: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_nb_0900_ai_ci",
 		id:   0x136,
-		uca:  uca.NewCollation("utf8mb4_nb_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_da_0900_ai_ci, nil, contractor_utf8mb4_da_0900_ai_ci{}, false, 1),
+		uca: newLazyCollation("utf8mb4_nb_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_nb_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_da_0900_ai_ci, nil, contractor_utf8mb4_da_0900_ai_ci{}, false, 1)
+		}),
 	},
 	0x137: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_nb_0900_as_cs",
 		id:   0x137,
-		uca:  uca.NewCollation("utf8mb4_nb_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_da_0900_ai_ci, nil, contractor_utf8mb4_da_0900_ai_ci{}, false, 3),
+		uca: newLazyCollation("utf8mb4_nb_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_nb_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_da_0900_ai_ci, nil, contractor_utf8mb4_da_0900_ai_ci{}, false, 3)
+		}),
 	},
 	0x138: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_nn_0900_ai_ci",
 		id:   0x138,
-		uca:  uca.NewCollation("utf8mb4_nn_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_da_0900_ai_ci, nil, contractor_utf8mb4_da_0900_ai_ci{}, false, 1),
+		uca: newLazyCollation("utf8mb4_nn_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_nn_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_da_0900_ai_ci, nil, contractor_utf8mb4_da_0900_ai_ci{}, false, 1)
+		}),
 	},
 	0x139: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_nn_0900_as_cs",
 		id:   0x139,
-		uca:  uca.NewCollation("utf8mb4_nn_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_da_0900_ai_ci, nil, contractor_utf8mb4_da_0900_ai_ci{}, false, 3),
+		uca: newLazyCollation("utf8mb4_nn_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_nn_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_da_0900_ai_ci, nil, contractor_utf8mb4_da_0900_ai_ci{}, false, 3)
+		}),
 	},
 	0x13a: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_sr_latn_0900_ai_ci",
 		id:   0x13a,
-		uca:  uca.NewCollation("utf8mb4_sr_latn_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_hr_0900_ai_ci, reorder_utf8mb4_hr_0900_ai_ci, contractor_utf8mb4_hr_0900_ai_ci{}, false, 1),
+		uca: newLazyCollation("utf8mb4_sr_latn_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_sr_latn_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_hr_0900_ai_ci, reorder_utf8mb4_hr_0900_ai_ci, contractor_utf8mb4_hr_0900_ai_ci{}, false, 1)
+		}),
 	},
 	0x13b: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_sr_latn_0900_as_cs",
 		id:   0x13b,
-		uca:  uca.NewCollation("utf8mb4_sr_latn_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_hr_0900_ai_ci, reorder_utf8mb4_hr_0900_ai_ci, contractor_utf8mb4_hr_0900_ai_ci{}, false, 3),
+		uca: newLazyCollation("utf8mb4_sr_latn_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_sr_latn_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_hr_0900_ai_ci, reorder_utf8mb4_hr_0900_ai_ci, contractor_utf8mb4_hr_0900_ai_ci{}, false, 3)
+		}),
 	},
 	0x13c: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_bs_0900_ai_ci",
 		id:   0x13c,
-		uca:  uca.NewCollation("utf8mb4_bs_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_hr_0900_ai_ci, reorder_utf8mb4_hr_0900_ai_ci, contractor_utf8mb4_hr_0900_ai_ci{}, false, 1),
+		uca: newLazyCollation("utf8mb4_bs_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_bs_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_hr_0900_ai_ci, reorder_utf8mb4_hr_0900_ai_ci, contractor_utf8mb4_hr_0900_ai_ci{}, false, 1)
+		}),
 	},
 	0x13d: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_bs_0900_as_cs",
 		id:   0x13d,
-		uca:  uca.NewCollation("utf8mb4_bs_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_hr_0900_ai_ci, reorder_utf8mb4_hr_0900_ai_ci, contractor_utf8mb4_hr_0900_ai_ci{}, false, 3),
+		uca: newLazyCollation("utf8mb4_bs_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_bs_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_hr_0900_ai_ci, reorder_utf8mb4_hr_0900_ai_ci, contractor_utf8mb4_hr_0900_ai_ci{}, false, 3)
+		}),
 	},
 	0x13e: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_bg_0900_ai_ci",
 		id:   0x13e,
-		uca:  uca.NewCollation("utf8mb4_bg_0900_ai_ci", weightTable_uca900, nil, reorder_utf8mb4_ru_0900_ai_ci, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_bg_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_bg_0900_ai_ci", weightTable_uca900, nil, reorder_utf8mb4_ru_0900_ai_ci, nil, false, 1)
+		}),
 	},
 	0x13f: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_bg_0900_as_cs",
 		id:   0x13f,
-		uca:  uca.NewCollation("utf8mb4_bg_0900_as_cs", weightTable_uca900, nil, reorder_utf8mb4_ru_0900_ai_ci, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_bg_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_bg_0900_as_cs", weightTable_uca900, nil, reorder_utf8mb4_ru_0900_ai_ci, nil, false, 3)
+		}),
 	},
 	0x140: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_gl_0900_ai_ci",
 		id:   0x140,
-		uca:  uca.NewCollation("utf8mb4_gl_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_es_0900_ai_ci, nil, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_gl_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_gl_0900_ai_ci", weightTable_uca900, weightTailoring_utf8mb4_es_0900_ai_ci, nil, nil, false, 1)
+		}),
 	},
 	0x141: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_gl_0900_as_cs",
 		id:   0x141,
-		uca:  uca.NewCollation("utf8mb4_gl_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_es_0900_ai_ci, nil, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_gl_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_gl_0900_as_cs", weightTable_uca900, weightTailoring_utf8mb4_es_0900_ai_ci, nil, nil, false, 3)
+		}),
 	},
 	0x142: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_mn_cyrl_0900_ai_ci",
 		id:   0x142,
-		uca:  uca.NewCollation("utf8mb4_mn_cyrl_0900_ai_ci", weightTable_uca900, nil, reorder_utf8mb4_ru_0900_ai_ci, nil, false, 1),
+		uca: newLazyCollation("utf8mb4_mn_cyrl_0900_ai_ci", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_mn_cyrl_0900_ai_ci", weightTable_uca900, nil, reorder_utf8mb4_ru_0900_ai_ci, nil, false, 1)
+		}),
 	},
 	0x143: &Collation_utf8mb4_uca_0900{
 		name: "utf8mb4_mn_cyrl_0900_as_cs",
 		id:   0x143,
-		uca:  uca.NewCollation("utf8mb4_mn_cyrl_0900_as_cs", weightTable_uca900, nil, reorder_utf8mb4_ru_0900_ai_ci, nil, false, 3),
+		uca: newLazyCollation("utf8mb4_mn_cyrl_0900_as_cs", func() *uca.Collation900 {
+			return uca.NewCollation(uca.UCA900, "utf8mb4_mn_cyrl_0900_as_cs", weightTable_uca900, nil, reorder_utf8mb4_ru_0900_ai_ci, nil, false, 3)
+		}),
 	},
 }
@@ -19,159 +19,158 @@ limitations under the License.
 package colldata
 
 import (
-	_ "embed"
 	"unsafe"
 )
 
-var weightTable_uca900_page000 = weightsUCA_embed(0, 2560)
-var weightTable_uca900_page001 = weightsUCA_embed(2560, 3328)
-var weightTable_uca900_page002 = weightsUCA_embed(5888, 2560)
-var weightTable_uca900_page003 = weightsUCA_embed(8448, 2560)
-var weightTable_uca900_page004 = weightsUCA_embed(11008, 1792)
-var weightTable_uca900_page005 = weightsUCA_embed(12800, 1792)
-var weightTable_uca900_page006 = weightsUCA_embed(14592, 1792)
-var weightTable_uca900_page007 = weightsUCA_embed(16384, 1792)
-var weightTable_uca900_page008 = weightsUCA_embed(18176, 1792)
-var weightTable_uca900_page009 = weightsUCA_embed(19968, 1792)
-var weightTable_uca900_page00A = weightsUCA_embed(21760, 1792)
-var weightTable_uca900_page00B = weightsUCA_embed(23552, 1792)
-var weightTable_uca900_page00C = weightsUCA_embed(25344, 1792)
-var weightTable_uca900_page00D = weightsUCA_embed(27136, 1792)
-var weightTable_uca900_page00E = weightsUCA_embed(28928, 1792)
-var weightTable_uca900_page00F = weightsUCA_embed(30720, 2560)
-var weightTable_uca900_page010 = weightsUCA_embed(33280, 2560)
-var weightTable_uca900_page011 = weightsUCA_embed(35840, 1024)
-var weightTable_uca900_page012 = weightsUCA_embed(36864, 1792)
-var weightTable_uca900_page013 = weightsUCA_embed(38656, 1792)
-var weightTable_uca900_page014 = weightsUCA_embed(40448, 1024)
-var weightTable_uca900_page015 = weightsUCA_embed(41472, 1024)
-var weightTable_uca900_page016 = weightsUCA_embed(42496, 3328)
-var weightTable_uca900_page017 = weightsUCA_embed(45824, 1792)
-var weightTable_uca900_page018 = weightsUCA_embed(47616, 1792)
-var weightTable_uca900_page019 = weightsUCA_embed(49408, 2560)
-var weightTable_uca900_page01A = weightsUCA_embed(51968, 2560)
-var weightTable_uca900_page01B = weightsUCA_embed(54528, 1792)
-var weightTable_uca900_page01C = weightsUCA_embed(56320, 1792)
-var weightTable_uca900_page01D = weightsUCA_embed(58112, 2560)
-var weightTable_uca900_page01E = weightsUCA_embed(60672, 2560)
-var weightTable_uca900_page01F = weightsUCA_embed(63232, 3328)
-var weightTable_uca900_page020 = weightsUCA_embed(66560, 3328)
-var weightTable_uca900_page021 = weightsUCA_embed(69888, 3328)
-var weightTable_uca900_page022 = weightsUCA_embed(73216, 2560)
-var weightTable_uca900_page023 = weightsUCA_embed(75776, 1792)
-var weightTable_uca900_page024 = weightsUCA_embed(77568, 3328)
-var weightTable_uca900_page025 = weightsUCA_embed(80896, 1024)
-var weightTable_uca900_page026 = weightsUCA_embed(81920, 1024)
-var weightTable_uca900_page027 = weightsUCA_embed(82944, 1792)
-var weightTable_uca900_page028 = weightsUCA_embed(84736, 1024)
-var weightTable_uca900_page029 = weightsUCA_embed(85760, 1024)
-var weightTable_uca900_page02A = weightsUCA_embed(86784, 3328)
-var weightTable_uca900_page02B = weightsUCA_embed(90112, 1792)
-var weightTable_uca900_page02C = weightsUCA_embed(91904, 2560)
-var weightTable_uca900_page02D = weightsUCA_embed(94464, 1792)
-var weightTable_uca900_page02E = weightsUCA_embed(96256, 2560)
-var weightTable_uca900_page02F = weightsUCA_embed(98816, 1792)
-var weightTable_uca900_page030 = weightsUCA_embed(100608, 1792)
-var weightTable_uca900_page031 = weightsUCA_embed(102400, 1792)
-var weightTable_uca900_page032 = weightsUCA_embed(104192, 5632)
-var weightTable_uca900_page033 = weightsUCA_embed(109824, 6400)
-var weightTable_uca900_page04D = weightsUCA_embed(116224, 1792)
-var weightTable_uca900_page0A0 = weightsUCA_embed(118016, 1024)
-var weightTable_uca900_page0A1 = weightsUCA_embed(119040, 1024)
-var weightTable_uca900_page0A2 = weightsUCA_embed(120064, 1024)
-var weightTable_uca900_page0A3 = weightsUCA_embed(121088, 1024)
-var weightTable_uca900_page0A4 = weightsUCA_embed(122112, 1792)
-var weightTable_uca900_page0A5 = weightsUCA_embed(123904, 1024)
-var weightTable_uca900_page0A6 = weightsUCA_embed(124928, 1792)
-var weightTable_uca900_page0A7 = weightsUCA_embed(126720, 2560)
-var weightTable_uca900_page0A8 = weightsUCA_embed(129280, 1792)
-var weightTable_uca900_page0A9 = weightsUCA_embed(131072, 1792)
-var weightTable_uca900_page0AA = weightsUCA_embed(132864, 1792)
-var weightTable_uca900_page0AB = weightsUCA_embed(134656, 1792)
-var weightTable_uca900_page0D7 = weightsUCA_embed(136448, 2560)
-var weightTable_uca900_page0F9 = weightsUCA_embed(139008, 1792)
-var weightTable_uca900_page0FA = weightsUCA_embed(140800, 1792)
-var weightTable_uca900_page0FB = weightsUCA_embed(142592, 2560)
-var weightTable_uca900_page0FC = weightsUCA_embed(145152, 1792)
-var weightTable_uca900_page0FD = weightsUCA_embed(146944, 6400)
-var weightTable_uca900_page0FE = weightsUCA_embed(153344, 2560)
-var weightTable_uca900_page0FF = weightsUCA_embed(155904, 1792)
-var weightTable_uca900_page100 = weightsUCA_embed(157696, 1792)
-var weightTable_uca900_page101 = weightsUCA_embed(159488, 1792)
-var weightTable_uca900_page102 = weightsUCA_embed(161280, 1792)
-var weightTable_uca900_page103 = weightsUCA_embed(163072, 1792)
-var weightTable_uca900_page104 = weightsUCA_embed(164864, 1792)
-var weightTable_uca900_page105 = weightsUCA_embed(166656, 1792)
-var weightTable_uca900_page106 = weightsUCA_embed(168448, 1024)
-var weightTable_uca900_page107 = weightsUCA_embed(169472, 1792)
-var weightTable_uca900_page108 = weightsUCA_embed(171264, 1792)
-var weightTable_uca900_page109 = weightsUCA_embed(173056, 1792)
-var weightTable_uca900_page10A = weightsUCA_embed(174848, 1792)
-var weightTable_uca900_page10B = weightsUCA_embed(176640, 1792)
-var weightTable_uca900_page10C = weightsUCA_embed(178432, 1792)
-var weightTable_uca900_page10E = weightsUCA_embed(180224, 1792)
-var weightTable_uca900_page110 = weightsUCA_embed(182016, 1792)
-var weightTable_uca900_page111 = weightsUCA_embed(183808, 1792)
-var weightTable_uca900_page112 = weightsUCA_embed(185600, 1792)
-var weightTable_uca900_page113 = weightsUCA_embed(187392, 1792)
-var weightTable_uca900_page114 = weightsUCA_embed(189184, 1792)
-var weightTable_uca900_page115 = weightsUCA_embed(190976, 1792)
-var weightTable_uca900_page116 = weightsUCA_embed(192768, 1792)
-var weightTable_uca900_page117 = weightsUCA_embed(194560, 1792)
-var weightTable_uca900_page118 = weightsUCA_embed(196352, 1792)
-var weightTable_uca900_page11A = weightsUCA_embed(198144, 1792)
-var weightTable_uca900_page11C = weightsUCA_embed(199936, 1792)
-var weightTable_uca900_page120 = weightsUCA_embed(201728, 1024)
-var weightTable_uca900_page121 = weightsUCA_embed(202752, 1024)
-var weightTable_uca900_page122 = weightsUCA_embed(203776, 1024)
-var weightTable_uca900_page123 = weightsUCA_embed(204800, 1792)
-var weightTable_uca900_page124 = weightsUCA_embed(206592, 1792)
-var weightTable_uca900_page125 = weightsUCA_embed(208384, 1792)
-var weightTable_uca900_page130 = weightsUCA_embed(210176, 1024)
-var weightTable_uca900_page131 = weightsUCA_embed(211200, 1024)
-var weightTable_uca900_page132 = weightsUCA_embed(212224, 1024)
-var weightTable_uca900_page133 = weightsUCA_embed(213248, 1024)
-var weightTable_uca900_page134 = weightsUCA_embed(214272, 1792)
-var weightTable_uca900_page144 = weightsUCA_embed(216064, 1024)
-var weightTable_uca900_page145 = weightsUCA_embed(217088, 1024)
-var weightTable_uca900_page146 = weightsUCA_embed(218112, 1792)
-var weightTable_uca900_page168 = weightsUCA_embed(219904, 1024)
-var weightTable_uca900_page169 = weightsUCA_embed(220928, 1024)
-var weightTable_uca900_page16A = weightsUCA_embed(221952, 1792)
-var weightTable_uca900_page16B = weightsUCA_embed(223744, 1792)
-var weightTable_uca900_page16F = weightsUCA_embed(225536, 1792)
-var weightTable_uca900_page1B0 = weightsUCA_embed(227328, 1792)
-var weightTable_uca900_page1BC = weightsUCA_embed(229120, 1792)
-var weightTable_uca900_page1D0 = weightsUCA_embed(230912, 1792)
-var weightTable_uca900_page1D1 = weightsUCA_embed(232704, 2560)
-var weightTable_uca900_page1D2 = weightsUCA_embed(235264, 1792)
-var weightTable_uca900_page1D3 = weightsUCA_embed(237056, 1792)
-var weightTable_uca900_page1D4 = weightsUCA_embed(238848, 1792)
-var weightTable_uca900_page1D5 = weightsUCA_embed(240640, 1792)
-var weightTable_uca900_page1D6 = weightsUCA_embed(242432, 1792)
-var weightTable_uca900_page1D7 = weightsUCA_embed(244224, 1792)
-var weightTable_uca900_page1D8 = weightsUCA_embed(246016, 1024)
-var weightTable_uca900_page1D9 = weightsUCA_embed(247040, 1024)
-var weightTable_uca900_page1DA = weightsUCA_embed(248064, 1792)
-var weightTable_uca900_page1E0 = weightsUCA_embed(249856, 1792)
-var weightTable_uca900_page1E8 = weightsUCA_embed(251648, 1792)
-var weightTable_uca900_page1E9 = weightsUCA_embed(253440, 1792)
-var weightTable_uca900_page1EE = weightsUCA_embed(255232, 1792)
-var weightTable_uca900_page1F0 = weightsUCA_embed(257024, 1792)
-var weightTable_uca900_page1F1 = weightsUCA_embed(258816, 6400)
-var weightTable_uca900_page1F2 = weightsUCA_embed(265216, 3328)
-var weightTable_uca900_page1F3 = weightsUCA_embed(268544, 1024)
-var weightTable_uca900_page1F4 = weightsUCA_embed(269568, 1024)
-var weightTable_uca900_page1F5 = weightsUCA_embed(270592, 1024)
-var weightTable_uca900_page1F6 = weightsUCA_embed(271616, 1792)
-var weightTable_uca900_page1F7 = weightsUCA_embed(273408, 1792)
-var weightTable_uca900_page1F8 = weightsUCA_embed(275200, 1792)
-var weightTable_uca900_page1F9 = weightsUCA_embed(276992, 1792)
-var weightTable_uca900_page2F8 = weightsUCA_embed(278784, 1792)
-var weightTable_uca900_page2F9 = weightsUCA_embed(280576, 1792)
-var weightTable_uca900_page2FA = weightsUCA_embed(282368, 1792)
-var weightTable_uca900_pageE00 = weightsUCA_embed(284160, 1792)
-var weightTable_uca900_pageE01 = weightsUCA_embed(285952, 1792)
+var weightTable_uca900_page000 []uint16
+var weightTable_uca900_page001 []uint16
+var weightTable_uca900_page002 []uint16
+var weightTable_uca900_page003 []uint16
+var weightTable_uca900_page004 []uint16
+var weightTable_uca900_page005 []uint16
+var weightTable_uca900_page006 []uint16
+var weightTable_uca900_page007 []uint16
+var weightTable_uca900_page008 []uint16
+var weightTable_uca900_page009 []uint16
+var weightTable_uca900_page00A []uint16
+var weightTable_uca900_page00B []uint16
+var weightTable_uca900_page00C []uint16
+var weightTable_uca900_page00D []uint16
+var weightTable_uca900_page00E []uint16
+var weightTable_uca900_page00F []uint16
+var weightTable_uca900_page010 []uint16
+var weightTable_uca900_page011 []uint16
+var weightTable_uca900_page012 []uint16
+var weightTable_uca900_page013 []uint16
+var weightTable_uca900_page014 []uint16
+var weightTable_uca900_page015 []uint16
+var weightTable_uca900_page016 []uint16
+var weightTable_uca900_page017 []uint16
+var weightTable_uca900_page018 []uint16
+var weightTable_uca900_page019 []uint16
+var weightTable_uca900_page01A []uint16
+var weightTable_uca900_page01B []uint16
+var weightTable_uca900_page01C []uint16
+var weightTable_uca900_page01D []uint16
+var weightTable_uca900_page01E []uint16
+var weightTable_uca900_page01F []uint16
+var weightTable_uca900_page020 []uint16
+var weightTable_uca900_page021 []uint16
+var weightTable_uca900_page022 []uint16
+var weightTable_uca900_page023 []uint16
+var weightTable_uca900_page024 []uint16
+var weightTable_uca900_page025 []uint16
+var weightTable_uca900_page026 []uint16
+var weightTable_uca900_page027 []uint16
+var weightTable_uca900_page028 []uint16
+var weightTable_uca900_page029 []uint16
+var weightTable_uca900_page02A []uint16
+var weightTable_uca900_page02B []uint16
+var weightTable_uca900_page02C []uint16
+var weightTable_uca900_page02D []uint16
+var weightTable_uca900_page02E []uint16
+var weightTable_uca900_page02F []uint16
+var weightTable_uca900_page030 []uint16
+var weightTable_uca900_page031 []uint16
+var weightTable_uca900_page032 []uint16
+var weightTable_uca900_page033 []uint16
+var weightTable_uca900_page04D []uint16
+var weightTable_uca900_page0A0 []uint16
+var weightTable_uca900_page0A1 []uint16
+var weightTable_uca900_page0A2 []uint16
+var weightTable_uca900_page0A3 []uint16
+var weightTable_uca900_page0A4 []uint16
+var weightTable_uca900_page0A5 []uint16
+var weightTable_uca900_page0A6 []uint16
+var weightTable_uca900_page0A7 []uint16
+var weightTable_uca900_page0A8 []uint16
+var weightTable_uca900_page0A9 []uint16
+var weightTable_uca900_page0AA []uint16
+var weightTable_uca900_page0AB []uint16
+var weightTable_uca900_page0D7 []uint16
+var weightTable_uca900_page0F9 []uint16
+var weightTable_uca900_page0FA []uint16
+var weightTable_uca900_page0FB []uint16
+var weightTable_uca900_page0FC []uint16
+var weightTable_uca900_page0FD []uint16
+var weightTable_uca900_page0FE []uint16
+var weightTable_uca900_page0FF []uint16
+var weightTable_uca900_page100 []uint16
+var weightTable_uca900_page101 []uint16
+var weightTable_uca900_page102 []uint16
+var weightTable_uca900_page103 []uint16
+var weightTable_uca900_page104 []uint16
+var weightTable_uca900_page105 []uint16
+var weightTable_uca900_page106 []uint16
+var weightTable_uca900_page107 []uint16
+var weightTable_uca900_page108 []uint16
+var weightTable_uca900_page109 []uint16
+var weightTable_uca900_page10A []uint16
+var weightTable_uca900_page10B []uint16
+var weightTable_uca900_page10C []uint16
+var weightTable_uca900_page10E []uint16
+var weightTable_uca900_page110 []uint16
+var weightTable_uca900_page111 []uint16
+var weightTable_uca900_page112 []uint16
+var weightTable_uca900_page113 []uint16
+var weightTable_uca900_page114 []uint16
+var weightTable_uca900_page115 []uint16
+var weightTable_uca900_page116 []uint16
+var weightTable_uca900_page117 []uint16
+var weightTable_uca900_page118 []uint16
+var weightTable_uca900_page11A []uint16
+var weightTable_uca900_page11C []uint16
+var weightTable_uca900_page120 []uint16
+var weightTable_uca900_page121 []uint16
+var weightTable_uca900_page122 []uint16
+var weightTable_uca900_page123 []uint16
+var weightTable_uca900_page124 []uint16
+var weightTable_uca900_page125 []uint16
+var weightTable_uca900_page130 []uint16
+var weightTable_uca900_page131 []uint16
+var weightTable_uca900_page132 []uint16
+var weightTable_uca900_page133 []uint16
+var weightTable_uca900_page134 []uint16
+var weightTable_uca900_page144 []uint16
+var weightTable_uca900_page145 []uint16
+var weightTable_uca900_page146 []uint16
+var weightTable_uca900_page168 []uint16
+var weightTable_uca900_page169 []uint16
+var weightTable_uca900_page16A []uint16
+var weightTable_uca900_page16B []uint16
+var weightTable_uca900_page16F []uint16
+var weightTable_uca900_page1B0 []uint16
+var weightTable_uca900_page1BC []uint16
+var weightTable_uca900_page1D0 []uint16
+var weightTable_uca900_page1D1 []uint16
+var weightTable_uca900_page1D2 []uint16
+var weightTable_uca900_page1D3 []uint16
+var weightTable_uca900_page1D4 []uint16
+var weightTable_uca900_page1D5 []uint16
+var weightTable_uca900_page1D6 []uint16
+var weightTable_uca900_page1D7 []uint16
+var weightTable_uca900_page1D8 []uint16
+var weightTable_uca900_page1D9 []uint16
+var weightTable_uca900_page1DA []uint16
+var weightTable_uca900_page1E0 []uint16
+var weightTable_uca900_page1E8 []uint16
+var weightTable_uca900_page1E9 []uint16
+var weightTable_uca900_page1EE []uint16
+var weightTable_uca900_page1F0 []uint16
+var weightTable_uca900_page1F1 []uint16
+var weightTable_uca900_page1F2 []uint16
+var weightTable_uca900_page1F3 []uint16
+var weightTable_uca900_page1F4 []uint16
+var weightTable_uca900_page1F5 []uint16
+var weightTable_uca900_page1F6 []uint16
+var weightTable_uca900_page1F7 []uint16
+var weightTable_uca900_page1F8 []uint16
+var weightTable_uca900_page1F9 []uint16
+var weightTable_uca900_page2F8 []uint16
+var weightTable_uca900_page2F9 []uint16
+var weightTable_uca900_page2FA []uint16
+var weightTable_uca900_pageE00 []uint16
+var weightTable_uca900_pageE01 []uint16
 var weightTable_uca900 = []*[]uint16{
 	&weightTable_uca900_page000, &weightTable_uca900_page001, &weightTable_uca900_page002, &weightTable_uca900_page003, &weightTable_uca900_page004, &weightTable_uca900_page005, &weightTable_uca900_page006, &weightTable_uca900_page007, &weightTable_uca900_page008, &weightTable_uca900_page009, &weightTable_uca900_page00A, &weightTable_uca900_page00B, &weightTable_uca900_page00C, &weightTable_uca900_page00D, &weightTable_uca900_page00E, &weightTable_uca900_page00F, &weightTable_uca900_page010, &weightTable_uca900_page011, &weightTable_uca900_page012, &weightTable_uca900_page013, &weightTable_uca900_page014, &weightTable_uca900_page015, &weightTable_uca900_page016, &weightTable_uca900_page017, &weightTable_uca900_page018, &weightTable_uca900_page019, &weightTable_uca900_page01A, &weightTable_uca900_page01B, &weightTable_uca900_page01C, &weightTable_uca900_page01D, &weightTable_uca900_page01E, &weightTable_uca900_page01F,
 	&weightTable_uca900_page020, &weightTable_uca900_page021, &weightTable_uca900_page022, &weightTable_uca900_page023, &weightTable_uca900_page024, &weightTable_uca900_page025, &weightTable_uca900_page026, &weightTable_uca900_page027, &weightTable_uca900_page028, &weightTable_uca900_page029, &weightTable_uca900_page02A, &weightTable_uca900_page02B, &weightTable_uca900_page02C, &weightTable_uca900_page02D, &weightTable_uca900_page02E, &weightTable_uca900_page02F, &weightTable_uca900_page030, &weightTable_uca900_page031, &weightTable_uca900_page032, &weightTable_uca900_page033, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
@@ -309,91 +308,91 @@ var weightTable_uca900 = []*[]uint16{
 	nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 	nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 	nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil}
-var weightTable_uca900_ja_page030 = weightsUCA_embed(287744, 2560)
-var weightTable_uca900_ja_page031 = weightsUCA_embed(290304, 1792)
-var weightTable_uca900_ja_page04E = weightsUCA_embed(292096, 1792)
-var weightTable_uca900_ja_page04F = weightsUCA_embed(293888, 1792)
-var weightTable_uca900_ja_page050 = weightsUCA_embed(295680, 1792)
-var weightTable_uca900_ja_page051 = weightsUCA_embed(297472, 1792)
-var weightTable_uca900_ja_page052 = weightsUCA_embed(299264, 1792)
-var weightTable_uca900_ja_page053 = weightsUCA_embed(301056, 1792)
-var weightTable_uca900_ja_page054 = weightsUCA_embed(302848, 1792)
-var weightTable_uca900_ja_page055 = weightsUCA_embed(304640, 1792)
-var weightTable_uca900_ja_page056 = weightsUCA_embed(306432, 1792)
-var weightTable_uca900_ja_page057 = weightsUCA_embed(308224, 1792)
-var weightTable_uca900_ja_page058 = weightsUCA_embed(310016, 1792)
-var weightTable_uca900_ja_page059 = weightsUCA_embed(311808, 1792)
-var weightTable_uca900_ja_page05A = weightsUCA_embed(313600, 1792)
-var weightTable_uca900_ja_page05B = weightsUCA_embed(315392, 1792)
-var weightTable_uca900_ja_page05C = weightsUCA_embed(317184, 1792)
-var weightTable_uca900_ja_page05D = weightsUCA_embed(318976, 1792)
-var weightTable_uca900_ja_page05E = weightsUCA_embed(320768, 1792)
-var weightTable_uca900_ja_page05F = weightsUCA_embed(322560, 1792)
-var weightTable_uca900_ja_page060 = weightsUCA_embed(324352, 1792)
-var weightTable_uca900_ja_page061 = weightsUCA_embed(326144, 1792)
-var weightTable_uca900_ja_page062 = weightsUCA_embed(327936, 1792)
-var weightTable_uca900_ja_page063 = weightsUCA_embed(329728, 1792)
-var weightTable_uca900_ja_page064 = weightsUCA_embed(331520, 1792)
-var weightTable_uca900_ja_page065 = weightsUCA_embed(333312, 1792)
-var weightTable_uca900_ja_page066 = weightsUCA_embed(335104, 1792)
-var weightTable_uca900_ja_page067 = weightsUCA_embed(336896, 1792)
-var weightTable_uca900_ja_page068 = weightsUCA_embed(338688, 1792)
-var weightTable_uca900_ja_page069 = weightsUCA_embed(340480, 1792)
-var weightTable_uca900_ja_page06A = weightsUCA_embed(342272, 1792)
-var weightTable_uca900_ja_page06B = weightsUCA_embed(344064, 1792)
-var weightTable_uca900_ja_page06C = weightsUCA_embed(345856, 1792)
-var weightTable_uca900_ja_page06D = weightsUCA_embed(347648, 1792)
-var weightTable_uca900_ja_page06E = weightsUCA_embed(349440, 1792)
-var weightTable_uca900_ja_page06F = weightsUCA_embed(351232, 1792)
-var weightTable_uca900_ja_page070 = weightsUCA_embed(353024, 1792)
-var weightTable_uca900_ja_page071 = weightsUCA_embed(354816, 1792)
-var weightTable_uca900_ja_page072 = weightsUCA_embed(356608, 1792)
-var weightTable_uca900_ja_page073 = weightsUCA_embed(358400, 1792)
-var weightTable_uca900_ja_page074 = weightsUCA_embed(360192, 1792)
-var weightTable_uca900_ja_page075 = weightsUCA_embed(361984, 1792)
-var weightTable_uca900_ja_page076 = weightsUCA_embed(363776, 1792)
-var weightTable_uca900_ja_page077 = weightsUCA_embed(365568, 1792)
-var weightTable_uca900_ja_page078 = weightsUCA_embed(367360, 1792)
-var weightTable_uca900_ja_page079 = weightsUCA_embed(369152, 1792)
-var weightTable_uca900_ja_page07A = weightsUCA_embed(370944, 1792)
-var weightTable_uca900_ja_page07B = weightsUCA_embed(372736, 1792)
-var weightTable_uca900_ja_page07C = weightsUCA_embed(374528, 1792)
-var weightTable_uca900_ja_page07D = weightsUCA_embed(376320, 1792)
-var weightTable_uca900_ja_page07E = weightsUCA_embed(378112, 1792)
-var weightTable_uca900_ja_page07F = weightsUCA_embed(379904, 1792)
-var weightTable_uca900_ja_page080 = weightsUCA_embed(381696, 1792)
-var weightTable_uca900_ja_page081 = weightsUCA_embed(383488, 1792)
-var weightTable_uca900_ja_page082 = weightsUCA_embed(385280, 1792)
-var weightTable_uca900_ja_page083 = weightsUCA_embed(387072, 1792)
-var weightTable_uca900_ja_page084 = weightsUCA_embed(388864, 1792)
-var weightTable_uca900_ja_page085 = weightsUCA_embed(390656, 1792)
-var weightTable_uca900_ja_page086 = weightsUCA_embed(392448, 1792)
-var weightTable_uca900_ja_page087 = weightsUCA_embed(394240, 1792)
-var weightTable_uca900_ja_page088 = weightsUCA_embed(396032, 1792)
-var weightTable_uca900_ja_page089 = weightsUCA_embed(397824, 1792)
-var weightTable_uca900_ja_page08A = weightsUCA_embed(399616, 1792)
-var weightTable_uca900_ja_page08B = weightsUCA_embed(401408, 1792)
-var weightTable_uca900_ja_page08C = weightsUCA_embed(403200, 1792)
-var weightTable_uca900_ja_page08D = weightsUCA_embed(404992, 1792)
-var weightTable_uca900_ja_page08E = weightsUCA_embed(406784, 1792)
-var weightTable_uca900_ja_page08F = weightsUCA_embed(408576, 1792)
-var weightTable_uca900_ja_page090 = weightsUCA_embed(410368, 1792)
-var weightTable_uca900_ja_page091 = weightsUCA_embed(412160, 1792)
-var weightTable_uca900_ja_page092 = weightsUCA_embed(413952, 1792)
-var weightTable_uca900_ja_page093 = weightsUCA_embed(415744, 1792)
-var weightTable_uca900_ja_page094 = weightsUCA_embed(417536, 1792)
-var weightTable_uca900_ja_page095 = weightsUCA_embed(419328, 1792)
-var weightTable_uca900_ja_page096 = weightsUCA_embed(421120, 1792)
-var weightTable_uca900_ja_page097 = weightsUCA_embed(422912, 1792)
-var weightTable_uca900_ja_page098 = weightsUCA_embed(424704, 1792)
-var weightTable_uca900_ja_page099 = weightsUCA_embed(426496, 1792)
-var weightTable_uca900_ja_page09A = weightsUCA_embed(428288, 1792)
-var weightTable_uca900_ja_page09B = weightsUCA_embed(430080, 1792)
-var weightTable_uca900_ja_page09C = weightsUCA_embed(431872, 1792)
-var weightTable_uca900_ja_page09D = weightsUCA_embed(433664, 1792)
-var weightTable_uca900_ja_page09E = weightsUCA_embed(435456, 1792)
-var weightTable_uca900_ja_page09F = weightsUCA_embed(437248, 1792)
-var weightTable_uca900_ja_page0FF = weightsUCA_embed(439040, 1792)
+var weightTable_uca900_ja_page030 []uint16
+var weightTable_uca900_ja_page031 []uint16
+var weightTable_uca900_ja_page04E []uint16
+var weightTable_uca900_ja_page04F []uint16
+var weightTable_uca900_ja_page050 []uint16
+var weightTable_uca900_ja_page051 []uint16
+var weightTable_uca900_ja_page052 []uint16
+var weightTable_uca900_ja_page053 []uint16
+var weightTable_uca900_ja_page054 []uint16
+var weightTable_uca900_ja_page055 []uint16
+var weightTable_uca900_ja_page056 []uint16
+var weightTable_uca900_ja_page057 []uint16
+var weightTable_uca900_ja_page058 []uint16
+var weightTable_uca900_ja_page059 []uint16
+var weightTable_uca900_ja_page05A []uint16
+var weightTable_uca900_ja_page05B []uint16
+var weightTable_uca900_ja_page05C []uint16
+var weightTable_uca900_ja_page05D []uint16
+var weightTable_uca900_ja_page05E []uint16
+var weightTable_uca900_ja_page05F []uint16
+var weightTable_uca900_ja_page060 []uint16
+var weightTable_uca900_ja_page061 []uint16
+var weightTable_uca900_ja_page062 []uint16
+var weightTable_uca900_ja_page063 []uint16
+var weightTable_uca900_ja_page064 []uint16
+var weightTable_uca900_ja_page065 []uint16
+var weightTable_uca900_ja_page066 []uint16
+var weightTable_uca900_ja_page067 []uint16
+var weightTable_uca900_ja_page068 []uint16
+var weightTable_uca900_ja_page069 []uint16
+var weightTable_uca900_ja_page06A []uint16
+var weightTable_uca900_ja_page06B []uint16
+var weightTable_uca900_ja_page06C []uint16
+var weightTable_uca900_ja_page06D []uint16
+var weightTable_uca900_ja_page06E []uint16
+var weightTable_uca900_ja_page06F []uint16
+var weightTable_uca900_ja_page070 []uint16
+var weightTable_uca900_ja_page071 []uint16
+var weightTable_uca900_ja_page072 []uint16
+var weightTable_uca900_ja_page073 []uint16
+var weightTable_uca900_ja_page074 []uint16
+var weightTable_uca900_ja_page075 []uint16
+var weightTable_uca900_ja_page076 []uint16
+var weightTable_uca900_ja_page077 []uint16
+var weightTable_uca900_ja_page078 []uint16
+var weightTable_uca900_ja_page079 []uint16
+var weightTable_uca900_ja_page07A []uint16
+var weightTable_uca900_ja_page07B []uint16
+var weightTable_uca900_ja_page07C []uint16
+var weightTable_uca900_ja_page07D []uint16
+var weightTable_uca900_ja_page07E []uint16
+var weightTable_uca900_ja_page07F []uint16
+var weightTable_uca900_ja_page080 []uint16
+var weightTable_uca900_ja_page081 []uint16
+var weightTable_uca900_ja_page082 []uint16
+var weightTable_uca900_ja_page083 []uint16
+var weightTable_uca900_ja_page084 []uint16
+var weightTable_uca900_ja_page085 []uint16
+var weightTable_uca900_ja_page086 []uint16
+var weightTable_uca900_ja_page087 []uint16
+var weightTable_uca900_ja_page088 []uint16
+var weightTable_uca900_ja_page089 []uint16
+var weightTable_uca900_ja_page08A []uint16
+var weightTable_uca900_ja_page08B []uint16
+var weightTable_uca900_ja_page08C []uint16
+var weightTable_uca900_ja_page08D []uint16
+var weightTable_uca900_ja_page08E []uint16
+var weightTable_uca900_ja_page08F []uint16
+var weightTable_uca900_ja_page090 []uint16
+var weightTable_uca900_ja_page091 []uint16
+var weightTable_uca900_ja_page092 []uint16
+var weightTable_uca900_ja_page093 []uint16
+var weightTable_uca900_ja_page094 []uint16
+var weightTable_uca900_ja_page095 []uint16
+var weightTable_uca900_ja_page096 []uint16
+var weightTable_uca900_ja_page097 []uint16
+var weightTable_uca900_ja_page098 []uint16
+var weightTable_uca900_ja_page099 []uint16
+var weightTable_uca900_ja_page09A []uint16
+var weightTable_uca900_ja_page09B []uint16
+var weightTable_uca900_ja_page09C []uint16
+var weightTable_uca900_ja_page09D []uint16
+var weightTable_uca900_ja_page09E []uint16
+var weightTable_uca900_ja_page09F []uint16
+var weightTable_uca900_ja_page0FF []uint16
 var weightTable_uca900_ja = []*[]uint16{
 	&weightTable_uca900_page000, &weightTable_uca900_page001, &weightTable_uca900_page002, &weightTable_uca900_page003, &weightTable_uca900_page004, &weightTable_uca900_page005, &weightTable_uca900_page006, &weightTable_uca900_page007, &weightTable_uca900_page008, &weightTable_uca900_page009, &weightTable_uca900_page00A, &weightTable_uca900_page00B, &weightTable_uca900_page00C, &weightTable_uca900_page00D, &weightTable_uca900_page00E, &weightTable_uca900_page00F, &weightTable_uca900_page010, &weightTable_uca900_page011, &weightTable_uca900_page012, &weightTable_uca900_page013, &weightTable_uca900_page014, &weightTable_uca900_page015, &weightTable_uca900_page016, &weightTable_uca900_page017, &weightTable_uca900_page018, &weightTable_uca900_page019, &weightTable_uca900_page01A, &weightTable_uca900_page01B, &weightTable_uca900_page01C, &weightTable_uca900_page01D, &weightTable_uca900_page01E, &weightTable_uca900_page01F,
 	&weightTable_uca900_page020, &weightTable_uca900_page021, &weightTable_uca900_page022, &weightTable_uca900_page023, &weightTable_uca900_page024, &weightTable_uca900_page025, &weightTable_uca900_page026, &weightTable_uca900_page027, &weightTable_uca900_page028, &weightTable_uca900_page029, &weightTable_uca900_page02A, &weightTable_uca900_page02B, &weightTable_uca900_page02C, &weightTable_uca900_page02D, &weightTable_uca900_page02E, &weightTable_uca900_page02F, &weightTable_uca900_ja_page030, &weightTable_uca900_ja_page031, &weightTable_uca900_page032, &weightTable_uca900_page033, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
@@ -531,435 +530,435 @@ var weightTable_uca900_ja = []*[]uint16{
 	nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 	nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 	nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil}
-var weightTable_uca900_zh_page000 = weightsUCA_embed(440832, 2560)
-var weightTable_uca900_zh_page001 = weightsUCA_embed(443392, 3328)
-var weightTable_uca900_zh_page002 = weightsUCA_embed(446720, 2560)
-var weightTable_uca900_zh_page003 = weightsUCA_embed(449280, 2560)
-var weightTable_uca900_zh_page004 = weightsUCA_embed(451840, 1792)
-var weightTable_uca900_zh_page005 = weightsUCA_embed(453632, 1792)
-var weightTable_uca900_zh_page006 = weightsUCA_embed(455424, 1792)
-var weightTable_uca900_zh_page007 = weightsUCA_embed(457216, 1792)
-var weightTable_uca900_zh_page008 = weightsUCA_embed(459008, 1792)
-var weightTable_uca900_zh_page009 = weightsUCA_embed(460800, 1792)
-var weightTable_uca900_zh_page00A = weightsUCA_embed(462592, 1792)
-var weightTable_uca900_zh_page00B = weightsUCA_embed(464384, 1792)
-var weightTable_uca900_zh_page00C = weightsUCA_embed(466176, 1792)
-var weightTable_uca900_zh_page00D = weightsUCA_embed(467968, 1792)
-var weightTable_uca900_zh_page00E = weightsUCA_embed(469760, 1792)
-var weightTable_uca900_zh_page00F = weightsUCA_embed(471552, 2560)
-var weightTable_uca900_zh_page010 = weightsUCA_embed(474112, 2560)
-var weightTable_uca900_zh_page011 = weightsUCA_embed(476672, 1024)
-var weightTable_uca900_zh_page012 = weightsUCA_embed(477696, 1792)
-var weightTable_uca900_zh_page013 = weightsUCA_embed(479488, 1792)
-var weightTable_uca900_zh_page014 = weightsUCA_embed(481280, 1024)
-var weightTable_uca900_zh_page015 = weightsUCA_embed(482304, 1024)
-var weightTable_uca900_zh_page016 = weightsUCA_embed(483328, 3328)
-var weightTable_uca900_zh_page017 = weightsUCA_embed(486656, 1792)
-var weightTable_uca900_zh_page018 = weightsUCA_embed(488448, 1792)
-var weightTable_uca900_zh_page019 = weightsUCA_embed(490240, 2560)
-var weightTable_uca900_zh_page01A = weightsUCA_embed(492800, 2560)
-var weightTable_uca900_zh_page01B = weightsUCA_embed(495360, 1792)
-var weightTable_uca900_zh_page01C = weightsUCA_embed(497152, 1792)
-var weightTable_uca900_zh_page01D = weightsUCA_embed(498944, 2560)
-var weightTable_uca900_zh_page01E = weightsUCA_embed(501504, 2560)
-var weightTable_uca900_zh_page01F = weightsUCA_embed(504064, 3328)
-var weightTable_uca900_zh_page020 = weightsUCA_embed(507392, 3328)
-var weightTable_uca900_zh_page021 = weightsUCA_embed(510720, 3328)
-var weightTable_uca900_zh_page023 = weightsUCA_embed(514048, 1792)
-var weightTable_uca900_zh_page024 = weightsUCA_embed(515840, 3328)
-var weightTable_uca900_zh_page02B = weightsUCA_embed(519168, 1792)
-var weightTable_uca900_zh_page02C = weightsUCA_embed(520960, 2560)
-var weightTable_uca900_zh_page02D = weightsUCA_embed(523520, 1792)
-var weightTable_uca900_zh_page02E = weightsUCA_embed(525312, 2560)
-var weightTable_uca900_zh_page02F = weightsUCA_embed(527872, 1792)
-var weightTable_uca900_zh_page030 = weightsUCA_embed(529664, 1792)
-var weightTable_uca900_zh_page031 = weightsUCA_embed(531456, 1792)
-var weightTable_uca900_zh_page032 = weightsUCA_embed(533248, 5632)
-var weightTable_uca900_zh_page033 = weightsUCA_embed(538880, 4864)
-var weightTable_uca900_zh_page034 = weightsUCA_embed(543744, 1792)
-var weightTable_uca900_zh_page035 = weightsUCA_embed(545536, 1792)
-var weightTable_uca900_zh_page036 = weightsUCA_embed(547328, 1792)
-var weightTable_uca900_zh_page037 = weightsUCA_embed(549120, 1792)
-var weightTable_uca900_zh_page038 = weightsUCA_embed(550912, 1792)
-var weightTable_uca900_zh_page039 = weightsUCA_embed(552704, 1792)
-var weightTable_uca900_zh_page03A = weightsUCA_embed(554496, 1792)
-var weightTable_uca900_zh_page03B = weightsUCA_embed(556288, 1792)
-var weightTable_uca900_zh_page03C = weightsUCA_embed(558080, 1792)
-var weightTable_uca900_zh_page03D = weightsUCA_embed(559872, 1792)
-var weightTable_uca900_zh_page03E = weightsUCA_embed(561664, 1792)
-var weightTable_uca900_zh_page03F = weightsUCA_embed(563456, 1792)
-var weightTable_uca900_zh_page040 = weightsUCA_embed(565248, 1792)
-var weightTable_uca900_zh_page041 = weightsUCA_embed(567040, 1792)
-var weightTable_uca900_zh_page042 = weightsUCA_embed(568832, 1792)
-var weightTable_uca900_zh_page043 = weightsUCA_embed(570624, 1792)
-var weightTable_uca900_zh_page044 = weightsUCA_embed(572416, 1792)
-var weightTable_uca900_zh_page045 = weightsUCA_embed(574208, 1792)
-var weightTable_uca900_zh_page046 = weightsUCA_embed(576000, 1792)
-var weightTable_uca900_zh_page047 = weightsUCA_embed(577792, 1792)
-var weightTable_uca900_zh_page048 = weightsUCA_embed(579584, 1792)
-var weightTable_uca900_zh_page049 = weightsUCA_embed(581376, 1792)
-var weightTable_uca900_zh_page04A = weightsUCA_embed(583168, 1792)
-var weightTable_uca900_zh_page04B = weightsUCA_embed(584960, 1792)
-var weightTable_uca900_zh_page04C = weightsUCA_embed(586752, 1792)
-var weightTable_uca900_zh_page04D = weightsUCA_embed(588544, 1792)
-var weightTable_uca900_zh_page04E = weightsUCA_embed(590336, 1024)
-var weightTable_uca900_zh_page04F = weightsUCA_embed(591360, 1024)
-var weightTable_uca900_zh_page050 = weightsUCA_embed(592384, 1024)
-var weightTable_uca900_zh_page051 = weightsUCA_embed(593408, 1792)
-var weightTable_uca900_zh_page052 = weightsUCA_embed(595200, 1024)
-var weightTable_uca900_zh_page053 = weightsUCA_embed(596224, 1024)
-var weightTable_uca900_zh_page054 = weightsUCA_embed(597248, 1024)
-var weightTable_uca900_zh_page055 = weightsUCA_embed(598272, 1792)
-var weightTable_uca900_zh_page056 = weightsUCA_embed(600064, 1024)
-var weightTable_uca900_zh_page057 = weightsUCA_embed(601088, 1024)
-var weightTable_uca900_zh_page058 = weightsUCA_embed(602112, 1024)
-var weightTable_uca900_zh_page059 = weightsUCA_embed(603136, 1024)
-var weightTable_uca900_zh_page05A = weightsUCA_embed(604160, 1024)
-var weightTable_uca900_zh_page05B = weightsUCA_embed(605184, 1024)
-var weightTable_uca900_zh_page05C = weightsUCA_embed(606208, 1024)
-var weightTable_uca900_zh_page05D = weightsUCA_embed(607232, 1024)
-var weightTable_uca900_zh_page05E = weightsUCA_embed(608256, 1024)
-var weightTable_uca900_zh_page05F = weightsUCA_embed(609280, 1024)
-var weightTable_uca900_zh_page060 = weightsUCA_embed(610304, 1024)
-var weightTable_uca900_zh_page061 = weightsUCA_embed(611328, 1024)
-var weightTable_uca900_zh_page062 = weightsUCA_embed(612352, 1024)
-var weightTable_uca900_zh_page063 = weightsUCA_embed(613376, 1024)
-var weightTable_uca900_zh_page064 = weightsUCA_embed(614400, 1024)
-var weightTable_uca900_zh_page065 = weightsUCA_embed(615424, 1024)
-var weightTable_uca900_zh_page066 = weightsUCA_embed(616448, 1024)
-var weightTable_uca900_zh_page067 = weightsUCA_embed(617472, 1024)
-var weightTable_uca900_zh_page068 = weightsUCA_embed(618496, 1792)
-var weightTable_uca900_zh_page069 = weightsUCA_embed(620288, 1024)
-var weightTable_uca900_zh_page06A = weightsUCA_embed(621312, 1024)
-var weightTable_uca900_zh_page06B = weightsUCA_embed(622336, 1024)
-var weightTable_uca900_zh_page06C = weightsUCA_embed(623360, 1024)
-var weightTable_uca900_zh_page06D = weightsUCA_embed(624384, 1024)
-var weightTable_uca900_zh_page06E = weightsUCA_embed(625408, 1024)
-var weightTable_uca900_zh_page06F = weightsUCA_embed(626432, 1024)
-var weightTable_uca900_zh_page070 = weightsUCA_embed(627456, 1792)
-var weightTable_uca900_zh_page071 = weightsUCA_embed(629248, 1024)
-var weightTable_uca900_zh_page072 = weightsUCA_embed(630272, 1024)
-var weightTable_uca900_zh_page073 = weightsUCA_embed(631296, 1024)
-var weightTable_uca900_zh_page074 = weightsUCA_embed(632320, 1792)
-var weightTable_uca900_zh_page075 = weightsUCA_embed(634112, 1792)
-var weightTable_uca900_zh_page076 = weightsUCA_embed(635904, 1024)
-var weightTable_uca900_zh_page077 = weightsUCA_embed(636928, 1024)
-var weightTable_uca900_zh_page078 = weightsUCA_embed(637952, 1024)
-var weightTable_uca900_zh_page079 = weightsUCA_embed(638976, 1024)
-var weightTable_uca900_zh_page07A = weightsUCA_embed(640000, 1024)
-var weightTable_uca900_zh_page07B = weightsUCA_embed(641024, 1024)
-var weightTable_uca900_zh_page07C = weightsUCA_embed(642048, 1024)
-var weightTable_uca900_zh_page07D = weightsUCA_embed(643072, 1024)
-var weightTable_uca900_zh_page07E = weightsUCA_embed(644096, 1024)
-var weightTable_uca900_zh_page07F = weightsUCA_embed(645120, 1024)
-var weightTable_uca900_zh_page080 = weightsUCA_embed(646144, 1024)
-var weightTable_uca900_zh_page081 = weightsUCA_embed(647168, 1024)
-var weightTable_uca900_zh_page082 = weightsUCA_embed(648192, 1024)
-var weightTable_uca900_zh_page083 = weightsUCA_embed(649216, 1024)
-var weightTable_uca900_zh_page084 = weightsUCA_embed(650240, 1024)
-var weightTable_uca900_zh_page085 = weightsUCA_embed(651264, 1024)
-var weightTable_uca900_zh_page086 = weightsUCA_embed(652288, 1024)
-var weightTable_uca900_zh_page087 = weightsUCA_embed(653312, 1024)
-var weightTable_uca900_zh_page088 = weightsUCA_embed(654336, 1024)
-var weightTable_uca900_zh_page089 = weightsUCA_embed(655360, 1024)
-var weightTable_uca900_zh_page08A = weightsUCA_embed(656384, 1024)
-var weightTable_uca900_zh_page08B = weightsUCA_embed(657408, 1024)
-var weightTable_uca900_zh_page08C = weightsUCA_embed(658432, 1024)
-var weightTable_uca900_zh_page08D = weightsUCA_embed(659456, 1024)
-var weightTable_uca900_zh_page08E = weightsUCA_embed(660480, 1024)
-var weightTable_uca900_zh_page08F = weightsUCA_embed(661504, 1024)
-var weightTable_uca900_zh_page090 = weightsUCA_embed(662528, 1024)
-var weightTable_uca900_zh_page091 = weightsUCA_embed(663552, 1024)
-var weightTable_uca900_zh_page092 = weightsUCA_embed(664576, 1024)
-var weightTable_uca900_zh_page093 = weightsUCA_embed(665600, 1024)
-var weightTable_uca900_zh_page094 = weightsUCA_embed(666624, 1024)
-var weightTable_uca900_zh_page095 = weightsUCA_embed(667648, 1024)
-var weightTable_uca900_zh_page096 = weightsUCA_embed(668672, 1024)
-var weightTable_uca900_zh_page097 = weightsUCA_embed(669696, 1024)
-var weightTable_uca900_zh_page098 = weightsUCA_embed(670720, 1024)
-var weightTable_uca900_zh_page099 = weightsUCA_embed(671744, 1024)
-var weightTable_uca900_zh_page09A = weightsUCA_embed(672768, 1024)
-var weightTable_uca900_zh_page09B = weightsUCA_embed(673792, 1024)
-var weightTable_uca900_zh_page09C = weightsUCA_embed(674816, 1024)
-var weightTable_uca900_zh_page09D = weightsUCA_embed(675840, 1024)
-var weightTable_uca900_zh_page09E = weightsUCA_embed(676864, 1024)
-var weightTable_uca900_zh_page09F = weightsUCA_embed(677888, 1792)
-var weightTable_uca900_zh_page0A0 = weightsUCA_embed(679680, 1024)
-var weightTable_uca900_zh_page0A1 = weightsUCA_embed(680704, 1024)
-var weightTable_uca900_zh_page0A2 = weightsUCA_embed(681728, 1024)
-var weightTable_uca900_zh_page0A3 = weightsUCA_embed(682752, 1024)
-var weightTable_uca900_zh_page0A4 = weightsUCA_embed(683776, 1792)
-var weightTable_uca900_zh_page0A5 = weightsUCA_embed(685568, 1024)
-var weightTable_uca900_zh_page0A6 = weightsUCA_embed(686592, 1792)
-var weightTable_uca900_zh_page0A7 = weightsUCA_embed(688384, 2560)
-var weightTable_uca900_zh_page0A8 = weightsUCA_embed(690944, 1792)
-var weightTable_uca900_zh_page0A9 = weightsUCA_embed(692736, 1792)
-var weightTable_uca900_zh_page0AA = weightsUCA_embed(694528, 1792)
-var weightTable_uca900_zh_page0AB = weightsUCA_embed(696320, 1792)
-var weightTable_uca900_zh_page0D7 = weightsUCA_embed(698112, 2560)
-var weightTable_uca900_zh_page0F9 = weightsUCA_embed(700672, 1792)
-var weightTable_uca900_zh_page0FA = weightsUCA_embed(702464, 1792)
-var weightTable_uca900_zh_page0FB = weightsUCA_embed(704256, 2560)
-var weightTable_uca900_zh_page0FC = weightsUCA_embed(706816, 1792)
-var weightTable_uca900_zh_page0FD = weightsUCA_embed(708608, 6400)
-var weightTable_uca900_zh_page0FE = weightsUCA_embed(715008, 2560)
-var weightTable_uca900_zh_page0FF = weightsUCA_embed(717568, 1792)
-var weightTable_uca900_zh_page100 = weightsUCA_embed(719360, 1792)
-var weightTable_uca900_zh_page101 = weightsUCA_embed(721152, 1792)
-var weightTable_uca900_zh_page102 = weightsUCA_embed(722944, 1792)
-var weightTable_uca900_zh_page103 = weightsUCA_embed(724736, 1792)
-var weightTable_uca900_zh_page104 = weightsUCA_embed(726528, 1792)
-var weightTable_uca900_zh_page105 = weightsUCA_embed(728320, 1792)
-var weightTable_uca900_zh_page106 = weightsUCA_embed(730112, 1024)
-var weightTable_uca900_zh_page107 = weightsUCA_embed(731136, 1792)
-var weightTable_uca900_zh_page108 = weightsUCA_embed(732928, 1792)
-var weightTable_uca900_zh_page109 = weightsUCA_embed(734720, 1792)
-var weightTable_uca900_zh_page10A = weightsUCA_embed(736512, 1792)
-var weightTable_uca900_zh_page10B = weightsUCA_embed(738304, 1792)
-var weightTable_uca900_zh_page10C = weightsUCA_embed(740096, 1792)
-var weightTable_uca900_zh_page10E = weightsUCA_embed(741888, 1792)
-var weightTable_uca900_zh_page110 = weightsUCA_embed(743680, 1792)
-var weightTable_uca900_zh_page111 = weightsUCA_embed(745472, 1792)
-var weightTable_uca900_zh_page112 = weightsUCA_embed(747264, 1792)
-var weightTable_uca900_zh_page113 = weightsUCA_embed(749056, 1792)
-var weightTable_uca900_zh_page114 = weightsUCA_embed(750848, 1792)
-var weightTable_uca900_zh_page115 = weightsUCA_embed(752640, 1792)
-var weightTable_uca900_zh_page116 = weightsUCA_embed(754432, 1792)
-var weightTable_uca900_zh_page117 = weightsUCA_embed(756224, 1792)
-var weightTable_uca900_zh_page118 = weightsUCA_embed(758016, 1792)
-var weightTable_uca900_zh_page11A = weightsUCA_embed(759808, 1792)
-var weightTable_uca900_zh_page11C = weightsUCA_embed(761600, 1792)
-var weightTable_uca900_zh_page120 = weightsUCA_embed(763392, 1024)
-var weightTable_uca900_zh_page121 = weightsUCA_embed(764416, 1024)
-var weightTable_uca900_zh_page122 = weightsUCA_embed(765440, 1024)
-var weightTable_uca900_zh_page123 = weightsUCA_embed(766464, 1792)
-var weightTable_uca900_zh_page124 = weightsUCA_embed(768256, 1792)
-var weightTable_uca900_zh_page125 = weightsUCA_embed(770048, 1792)
-var weightTable_uca900_zh_page130 = weightsUCA_embed(771840, 1024)
-var weightTable_uca900_zh_page131 = weightsUCA_embed(772864, 1024)
-var weightTable_uca900_zh_page132 = weightsUCA_embed(773888, 1024)
-var weightTable_uca900_zh_page133 = weightsUCA_embed(774912, 1024)
-var weightTable_uca900_zh_page134 = weightsUCA_embed(775936, 1792)
-var weightTable_uca900_zh_page144 = weightsUCA_embed(777728, 1024)
-var weightTable_uca900_zh_page145 = weightsUCA_embed(778752, 1024)
-var weightTable_uca900_zh_page146 = weightsUCA_embed(779776, 1792)
-var weightTable_uca900_zh_page168 = weightsUCA_embed(781568, 1024)
-var weightTable_uca900_zh_page169 = weightsUCA_embed(782592, 1024)
-var weightTable_uca900_zh_page16A = weightsUCA_embed(783616, 1792)
-var weightTable_uca900_zh_page16B = weightsUCA_embed(785408, 1792)
-var weightTable_uca900_zh_page16F = weightsUCA_embed(787200, 1792)
-var weightTable_uca900_zh_page1B0 = weightsUCA_embed(788992, 1792)
-var weightTable_uca900_zh_page1BC = weightsUCA_embed(790784, 1792)
-var weightTable_uca900_zh_page1D0 = weightsUCA_embed(792576, 1792)
-var weightTable_uca900_zh_page1D1 = weightsUCA_embed(794368, 2560)
-var weightTable_uca900_zh_page1D2 = weightsUCA_embed(796928, 1792)
-var weightTable_uca900_zh_page1D3 = weightsUCA_embed(798720, 1792)
-var weightTable_uca900_zh_page1D4 = weightsUCA_embed(800512, 1792)
-var weightTable_uca900_zh_page1D5 = weightsUCA_embed(802304, 1792)
-var weightTable_uca900_zh_page1D6 = weightsUCA_embed(804096, 1792)
-var weightTable_uca900_zh_page1D7 = weightsUCA_embed(805888, 1792)
-var weightTable_uca900_zh_page1DA = weightsUCA_embed(807680, 1792)
-var weightTable_uca900_zh_page1E0 = weightsUCA_embed(809472, 1792)
-var weightTable_uca900_zh_page1E8 = weightsUCA_embed(811264, 1792)
-var weightTable_uca900_zh_page1E9 = weightsUCA_embed(813056, 1792)
-var weightTable_uca900_zh_page1EE = weightsUCA_embed(814848, 1792)
-var weightTable_uca900_zh_page1F0 = weightsUCA_embed(816640, 1792)
-var weightTable_uca900_zh_page1F1 = weightsUCA_embed(818432, 6400)
-var weightTable_uca900_zh_page1F2 = weightsUCA_embed(824832, 3328)
-var weightTable_uca900_zh_page1F6 = weightsUCA_embed(828160, 1792)
-var weightTable_uca900_zh_page1F7 = weightsUCA_embed(829952, 1792)
-var weightTable_uca900_zh_page1F8 = weightsUCA_embed(831744, 1792)
-var weightTable_uca900_zh_page1F9 = weightsUCA_embed(833536, 1792)
-var weightTable_uca900_zh_page200 = weightsUCA_embed(835328, 1792)
-var weightTable_uca900_zh_page201 = weightsUCA_embed(837120, 1792)
-var weightTable_uca900_zh_page202 = weightsUCA_embed(838912, 1792)
-var weightTable_uca900_zh_page203 = weightsUCA_embed(840704, 1792)
-var weightTable_uca900_zh_page204 = weightsUCA_embed(842496, 1792)
-var weightTable_uca900_zh_page205 = weightsUCA_embed(844288, 1792)
-var weightTable_uca900_zh_page206 = weightsUCA_embed(846080, 1792)
-var weightTable_uca900_zh_page207 = weightsUCA_embed(847872, 1792)
-var weightTable_uca900_zh_page208 = weightsUCA_embed(849664, 1792)
-var weightTable_uca900_zh_page209 = weightsUCA_embed(851456, 1792)
-var weightTable_uca900_zh_page20A = weightsUCA_embed(853248, 1792)
-var weightTable_uca900_zh_page20B = weightsUCA_embed(855040, 1792)
-var weightTable_uca900_zh_page20C = weightsUCA_embed(856832, 1792)
-var weightTable_uca900_zh_page20D = weightsUCA_embed(858624, 1792)
-var weightTable_uca900_zh_page20E = weightsUCA_embed(860416, 1792)
-var weightTable_uca900_zh_page20F = weightsUCA_embed(862208, 1792)
-var weightTable_uca900_zh_page210 = weightsUCA_embed(864000, 1792)
-var weightTable_uca900_zh_page211 = weightsUCA_embed(865792, 1792)
-var weightTable_uca900_zh_page212 = weightsUCA_embed(867584, 1792)
-var weightTable_uca900_zh_page213 = weightsUCA_embed(869376, 1792)
-var weightTable_uca900_zh_page214 = weightsUCA_embed(871168, 1792)
-var weightTable_uca900_zh_page215 = weightsUCA_embed(872960, 1792)
-var weightTable_uca900_zh_page216 = weightsUCA_embed(874752, 1792)
-var weightTable_uca900_zh_page217 = weightsUCA_embed(876544, 1792)
-var weightTable_uca900_zh_page218 = weightsUCA_embed(878336, 1792)
-var weightTable_uca900_zh_page219 = weightsUCA_embed(880128, 1792)
-var weightTable_uca900_zh_page21A = weightsUCA_embed(881920, 1792)
-var weightTable_uca900_zh_page21B = weightsUCA_embed(883712, 1792)
-var weightTable_uca900_zh_page21C = weightsUCA_embed(885504, 1792)
-var weightTable_uca900_zh_page21D = weightsUCA_embed(887296, 1792)
-var weightTable_uca900_zh_page21E = weightsUCA_embed(889088, 1792)
-var weightTable_uca900_zh_page21F = weightsUCA_embed(890880, 1792)
-var weightTable_uca900_zh_page220 = weightsUCA_embed(892672, 1792)
-var weightTable_uca900_zh_page221 = weightsUCA_embed(894464, 1792)
-var weightTable_uca900_zh_page222 = weightsUCA_embed(896256, 1792)
-var weightTable_uca900_zh_page223 = weightsUCA_embed(898048, 1792)
-var weightTable_uca900_zh_page224 = weightsUCA_embed(899840, 1792)
-var weightTable_uca900_zh_page225 = weightsUCA_embed(901632, 1792)
-var weightTable_uca900_zh_page226 = weightsUCA_embed(903424, 1792)
-var weightTable_uca900_zh_page227 = weightsUCA_embed(905216, 1792)
-var weightTable_uca900_zh_page228 = weightsUCA_embed(907008, 1792)
-var weightTable_uca900_zh_page229 = weightsUCA_embed(908800, 1792)
-var weightTable_uca900_zh_page22A = weightsUCA_embed(910592, 1792)
-var weightTable_uca900_zh_page22B = weightsUCA_embed(912384, 1792)
-var weightTable_uca900_zh_page22C = weightsUCA_embed(914176, 1792)
-var weightTable_uca900_zh_page22D = weightsUCA_embed(915968, 1792)
-var weightTable_uca900_zh_page22E = weightsUCA_embed(917760, 1792)
-var weightTable_uca900_zh_page22F = weightsUCA_embed(919552, 1792)
-var weightTable_uca900_zh_page230 = weightsUCA_embed(921344, 1792)
-var weightTable_uca900_zh_page231 = weightsUCA_embed(923136, 1792)
-var weightTable_uca900_zh_page232 = weightsUCA_embed(924928, 1792)
-var weightTable_uca900_zh_page233 = weightsUCA_embed(926720, 1792)
-var weightTable_uca900_zh_page234 = weightsUCA_embed(928512, 1792)
-var weightTable_uca900_zh_page235 = weightsUCA_embed(930304, 1792)
-var weightTable_uca900_zh_page236 = weightsUCA_embed(932096, 1792)
-var weightTable_uca900_zh_page237 = weightsUCA_embed(933888, 1792)
-var weightTable_uca900_zh_page238 = weightsUCA_embed(935680, 1792)
-var weightTable_uca900_zh_page239 = weightsUCA_embed(937472, 1792)
-var weightTable_uca900_zh_page23A = weightsUCA_embed(939264, 1792)
-var weightTable_uca900_zh_page23B = weightsUCA_embed(941056, 1792)
-var weightTable_uca900_zh_page23C = weightsUCA_embed(942848, 1792)
-var weightTable_uca900_zh_page23D = weightsUCA_embed(944640, 1792)
-var weightTable_uca900_zh_page23E = weightsUCA_embed(946432, 1792)
-var weightTable_uca900_zh_page23F = weightsUCA_embed(948224, 1792)
-var weightTable_uca900_zh_page240 = weightsUCA_embed(950016, 1792)
-var weightTable_uca900_zh_page241 = weightsUCA_embed(951808, 1792)
-var weightTable_uca900_zh_page242 = weightsUCA_embed(953600, 1792)
-var weightTable_uca900_zh_page243 = weightsUCA_embed(955392, 1792)
-var weightTable_uca900_zh_page244 = weightsUCA_embed(957184, 1792)
-var weightTable_uca900_zh_page245 = weightsUCA_embed(958976, 1792)
-var weightTable_uca900_zh_page246 = weightsUCA_embed(960768, 1792)
-var weightTable_uca900_zh_page247 = weightsUCA_embed(962560, 1792)
-var weightTable_uca900_zh_page248 = weightsUCA_embed(964352, 1792)
-var weightTable_uca900_zh_page249 = weightsUCA_embed(966144, 1792)
-var weightTable_uca900_zh_page24A = weightsUCA_embed(967936, 1792)
-var weightTable_uca900_zh_page24B = weightsUCA_embed(969728, 1792)
-var weightTable_uca900_zh_page24C = weightsUCA_embed(971520, 1792)
-var weightTable_uca900_zh_page24D = weightsUCA_embed(973312, 1792)
-var weightTable_uca900_zh_page24E = weightsUCA_embed(975104, 1792)
-var weightTable_uca900_zh_page24F = weightsUCA_embed(976896, 1792)
-var weightTable_uca900_zh_page250 = weightsUCA_embed(978688, 1792)
-var weightTable_uca900_zh_page251 = weightsUCA_embed(980480, 1792)
-var weightTable_uca900_zh_page252 = weightsUCA_embed(982272, 1792)
-var weightTable_uca900_zh_page253 = weightsUCA_embed(984064, 1792)
-var weightTable_uca900_zh_page254 = weightsUCA_embed(985856, 1792)
-var weightTable_uca900_zh_page255 = weightsUCA_embed(987648, 1792)
-var weightTable_uca900_zh_page256 = weightsUCA_embed(989440, 1792)
-var weightTable_uca900_zh_page257 = weightsUCA_embed(991232, 1792)
-var weightTable_uca900_zh_page258 = weightsUCA_embed(993024, 1792)
-var weightTable_uca900_zh_page259 = weightsUCA_embed(994816, 1792)
-var weightTable_uca900_zh_page25A = weightsUCA_embed(996608, 1792)
-var weightTable_uca900_zh_page25B = weightsUCA_embed(998400, 1792)
-var weightTable_uca900_zh_page25C = weightsUCA_embed(1000192, 1792)
-var weightTable_uca900_zh_page25D = weightsUCA_embed(1001984, 1792)
-var weightTable_uca900_zh_page25E = weightsUCA_embed(1003776, 1792)
-var weightTable_uca900_zh_page25F = weightsUCA_embed(1005568, 1792)
-var weightTable_uca900_zh_page260 = weightsUCA_embed(1007360, 1792)
-var weightTable_uca900_zh_page261 = weightsUCA_embed(1009152, 1792)
-var weightTable_uca900_zh_page262 = weightsUCA_embed(1010944, 1792)
-var weightTable_uca900_zh_page263 = weightsUCA_embed(1012736, 1792)
-var weightTable_uca900_zh_page264 = weightsUCA_embed(1014528, 1792)
-var weightTable_uca900_zh_page265 = weightsUCA_embed(1016320, 1792)
-var weightTable_uca900_zh_page266 = weightsUCA_embed(1018112, 1792)
-var weightTable_uca900_zh_page267 = weightsUCA_embed(1019904, 1792)
-var weightTable_uca900_zh_page268 = weightsUCA_embed(1021696, 1792)
-var weightTable_uca900_zh_page269 = weightsUCA_embed(1023488, 1792)
-var weightTable_uca900_zh_page26A = weightsUCA_embed(1025280, 1792)
-var weightTable_uca900_zh_page26B = weightsUCA_embed(1027072, 1792)
-var weightTable_uca900_zh_page26C = weightsUCA_embed(1028864, 1792)
-var weightTable_uca900_zh_page26D = weightsUCA_embed(1030656, 1792)
-var weightTable_uca900_zh_page26E = weightsUCA_embed(1032448, 1792)
-var weightTable_uca900_zh_page26F = weightsUCA_embed(1034240, 1792)
-var weightTable_uca900_zh_page270 = weightsUCA_embed(1036032, 1792)
-var weightTable_uca900_zh_page271 = weightsUCA_embed(1037824, 1792)
-var weightTable_uca900_zh_page272 = weightsUCA_embed(1039616, 1792)
-var weightTable_uca900_zh_page273 = weightsUCA_embed(1041408, 1792)
-var weightTable_uca900_zh_page274 = weightsUCA_embed(1043200, 1792)
-var weightTable_uca900_zh_page275 = weightsUCA_embed(1044992, 1792)
-var weightTable_uca900_zh_page276 = weightsUCA_embed(1046784, 1792)
-var weightTable_uca900_zh_page277 = weightsUCA_embed(1048576, 1792)
-var weightTable_uca900_zh_page278 = weightsUCA_embed(1050368, 1792)
-var weightTable_uca900_zh_page279 = weightsUCA_embed(1052160, 1792)
-var weightTable_uca900_zh_page27A = weightsUCA_embed(1053952, 1792)
-var weightTable_uca900_zh_page27B = weightsUCA_embed(1055744, 1792)
-var weightTable_uca900_zh_page27C = weightsUCA_embed(1057536, 1792)
-var weightTable_uca900_zh_page27D = weightsUCA_embed(1059328, 1792)
-var weightTable_uca900_zh_page27E = weightsUCA_embed(1061120, 1792)
-var weightTable_uca900_zh_page27F = weightsUCA_embed(1062912, 1792)
-var weightTable_uca900_zh_page280 = weightsUCA_embed(1064704, 1792)
-var weightTable_uca900_zh_page281 = weightsUCA_embed(1066496, 1792)
-var weightTable_uca900_zh_page282 = weightsUCA_embed(1068288, 1792)
-var weightTable_uca900_zh_page283 = weightsUCA_embed(1070080, 1792)
-var weightTable_uca900_zh_page284 = weightsUCA_embed(1071872, 1792)
-var weightTable_uca900_zh_page285 = weightsUCA_embed(1073664, 1792)
-var weightTable_uca900_zh_page286 = weightsUCA_embed(1075456, 1792)
-var weightTable_uca900_zh_page287 = weightsUCA_embed(1077248, 1792)
-var weightTable_uca900_zh_page288 = weightsUCA_embed(1079040, 1792)
-var weightTable_uca900_zh_page289 = weightsUCA_embed(1080832, 1792)
-var weightTable_uca900_zh_page28A = weightsUCA_embed(1082624, 1792)
-var weightTable_uca900_zh_page28B = weightsUCA_embed(1084416, 1792)
-var weightTable_uca900_zh_page28C = weightsUCA_embed(1086208, 1792)
-var weightTable_uca900_zh_page28D = weightsUCA_embed(1088000, 1792)
-var weightTable_uca900_zh_page28E = weightsUCA_embed(1089792, 1792)
-var weightTable_uca900_zh_page28F = weightsUCA_embed(1091584, 1792)
-var weightTable_uca900_zh_page290 = weightsUCA_embed(1093376, 1792)
-var weightTable_uca900_zh_page291 = weightsUCA_embed(1095168, 1792)
-var weightTable_uca900_zh_page292 = weightsUCA_embed(1096960, 1792)
-var weightTable_uca900_zh_page293 = weightsUCA_embed(1098752, 1792)
-var weightTable_uca900_zh_page294 = weightsUCA_embed(1100544, 1792)
-var weightTable_uca900_zh_page295 = weightsUCA_embed(1102336, 1792)
-var weightTable_uca900_zh_page296 = weightsUCA_embed(1104128, 1792)
-var weightTable_uca900_zh_page297 = weightsUCA_embed(1105920, 1792)
-var weightTable_uca900_zh_page298 = weightsUCA_embed(1107712, 1792)
-var weightTable_uca900_zh_page299 = weightsUCA_embed(1109504, 1792)
-var weightTable_uca900_zh_page29A = weightsUCA_embed(1111296, 1792)
-var weightTable_uca900_zh_page29B = weightsUCA_embed(1113088, 1792)
-var weightTable_uca900_zh_page29C = weightsUCA_embed(1114880, 1792)
-var weightTable_uca900_zh_page29D = weightsUCA_embed(1116672, 1792)
-var weightTable_uca900_zh_page29E = weightsUCA_embed(1118464, 1792)
-var weightTable_uca900_zh_page29F = weightsUCA_embed(1120256, 1792)
-var weightTable_uca900_zh_page2A0 = weightsUCA_embed(1122048, 1792)
-var weightTable_uca900_zh_page2A1 = weightsUCA_embed(1123840, 1792)
-var weightTable_uca900_zh_page2A2 = weightsUCA_embed(1125632, 1792)
-var weightTable_uca900_zh_page2A3 = weightsUCA_embed(1127424, 1792)
-var weightTable_uca900_zh_page2A4 = weightsUCA_embed(1129216, 1792)
-var weightTable_uca900_zh_page2A5 = weightsUCA_embed(1131008, 1792)
-var weightTable_uca900_zh_page2A6 = weightsUCA_embed(1132800, 1792)
-var weightTable_uca900_zh_page2A7 = weightsUCA_embed(1134592, 1792)
-var weightTable_uca900_zh_page2A8 = weightsUCA_embed(1136384, 1792)
-var weightTable_uca900_zh_page2AA = weightsUCA_embed(1138176, 1792)
-var weightTable_uca900_zh_page2AE = weightsUCA_embed(1139968, 1792)
-var weightTable_uca900_zh_page2AF = weightsUCA_embed(1141760, 1792)
-var weightTable_uca900_zh_page2B0 = weightsUCA_embed(1143552, 1792)
-var weightTable_uca900_zh_page2B1 = weightsUCA_embed(1145344, 1792)
-var weightTable_uca900_zh_page2B2 = weightsUCA_embed(1147136, 1792)
-var weightTable_uca900_zh_page2B3 = weightsUCA_embed(1148928, 1792)
-var weightTable_uca900_zh_page2B4 = weightsUCA_embed(1150720, 1792)
-var weightTable_uca900_zh_page2B5 = weightsUCA_embed(1152512, 1792)
-var weightTable_uca900_zh_page2B6 = weightsUCA_embed(1154304, 1792)
-var weightTable_uca900_zh_page2B8 = weightsUCA_embed(1156096, 1792)
-var weightTable_uca900_zh_page2C4 = weightsUCA_embed(1157888, 1792)
-var weightTable_uca900_zh_page2C6 = weightsUCA_embed(1159680, 1792)
-var weightTable_uca900_zh_page2C9 = weightsUCA_embed(1161472, 1792)
-var weightTable_uca900_zh_page2CB = weightsUCA_embed(1163264, 1792)
-var weightTable_uca900_zh_page2CE = weightsUCA_embed(1165056, 1792)
-var weightTable_uca900_zh_page2F8 = weightsUCA_embed(1166848, 1792)
-var weightTable_uca900_zh_page2F9 = weightsUCA_embed(1168640, 1792)
-var weightTable_uca900_zh_page2FA = weightsUCA_embed(1170432, 1792)
-var weightTable_uca900_zh_pageE00 = weightsUCA_embed(1172224, 1792)
-var weightTable_uca900_zh_pageE01 = weightsUCA_embed(1174016, 1792)
+var weightTable_uca900_zh_page000 []uint16
+var weightTable_uca900_zh_page001 []uint16
+var weightTable_uca900_zh_page002 []uint16
+var weightTable_uca900_zh_page003 []uint16
+var weightTable_uca900_zh_page004 []uint16
+var weightTable_uca900_zh_page005 []uint16
+var weightTable_uca900_zh_page006 []uint16
+var weightTable_uca900_zh_page007 []uint16
+var weightTable_uca900_zh_page008 []uint16
+var weightTable_uca900_zh_page009 []uint16
+var weightTable_uca900_zh_page00A []uint16
+var weightTable_uca900_zh_page00B []uint16
+var weightTable_uca900_zh_page00C []uint16
+var weightTable_uca900_zh_page00D []uint16
+var weightTable_uca900_zh_page00E []uint16
+var weightTable_uca900_zh_page00F []uint16
+var weightTable_uca900_zh_page010 []uint16
+var weightTable_uca900_zh_page011 []uint16
+var weightTable_uca900_zh_page012 []uint16
+var weightTable_uca900_zh_page013 []uint16
+var weightTable_uca900_zh_page014 []uint16
+var weightTable_uca900_zh_page015 []uint16
+var weightTable_uca900_zh_page016 []uint16
+var weightTable_uca900_zh_page017 []uint16
+var weightTable_uca900_zh_page018 []uint16
+var weightTable_uca900_zh_page019 []uint16
+var weightTable_uca900_zh_page01A []uint16
+var weightTable_uca900_zh_page01B []uint16
+var weightTable_uca900_zh_page01C []uint16
+var weightTable_uca900_zh_page01D []uint16
+var weightTable_uca900_zh_page01E []uint16
+var weightTable_uca900_zh_page01F []uint16
+var weightTable_uca900_zh_page020 []uint16
+var weightTable_uca900_zh_page021 []uint16
+var weightTable_uca900_zh_page023 []uint16
+var weightTable_uca900_zh_page024 []uint16
+var weightTable_uca900_zh_page02B []uint16
+var weightTable_uca900_zh_page02C []uint16
+var weightTable_uca900_zh_page02D []uint16
+var weightTable_uca900_zh_page02E []uint16
+var weightTable_uca900_zh_page02F []uint16
+var weightTable_uca900_zh_page030 []uint16
+var weightTable_uca900_zh_page031 []uint16
+var weightTable_uca900_zh_page032 []uint16
+var weightTable_uca900_zh_page033 []uint16
+var weightTable_uca900_zh_page034 []uint16
+var weightTable_uca900_zh_page035 []uint16
+var weightTable_uca900_zh_page036 []uint16
+var weightTable_uca900_zh_page037 []uint16
+var weightTable_uca900_zh_page038 []uint16
+var weightTable_uca900_zh_page039 []uint16
+var weightTable_uca900_zh_page03A []uint16
+var weightTable_uca900_zh_page03B []uint16
+var weightTable_uca900_zh_page03C []uint16
+var weightTable_uca900_zh_page03D []uint16
+var weightTable_uca900_zh_page03E []uint16
+var weightTable_uca900_zh_page03F []uint16
+var weightTable_uca900_zh_page040 []uint16
+var weightTable_uca900_zh_page041 []uint16
+var weightTable_uca900_zh_page042 []uint16
+var weightTable_uca900_zh_page043 []uint16
+var weightTable_uca900_zh_page044 []uint16
+var weightTable_uca900_zh_page045 []uint16
+var weightTable_uca900_zh_page046 []uint16
+var weightTable_uca900_zh_page047 []uint16
+var weightTable_uca900_zh_page048 []uint16
+var weightTable_uca900_zh_page049 []uint16
+var weightTable_uca900_zh_page04A []uint16
+var weightTable_uca900_zh_page04B []uint16
+var weightTable_uca900_zh_page04C []uint16
+var weightTable_uca900_zh_page04D []uint16
+var weightTable_uca900_zh_page04E []uint16
+var weightTable_uca900_zh_page04F []uint16
+var weightTable_uca900_zh_page050 []uint16
+var weightTable_uca900_zh_page051 []uint16
+var weightTable_uca900_zh_page052 []uint16
+var weightTable_uca900_zh_page053 []uint16
+var weightTable_uca900_zh_page054 []uint16
+var weightTable_uca900_zh_page055 []uint16
+var weightTable_uca900_zh_page056 []uint16
+var weightTable_uca900_zh_page057 []uint16
+var weightTable_uca900_zh_page058 []uint16
+var weightTable_uca900_zh_page059 []uint16
+var weightTable_uca900_zh_page05A []uint16
+var weightTable_uca900_zh_page05B []uint16
+var weightTable_uca900_zh_page05C []uint16
+var weightTable_uca900_zh_page05D []uint16
+var weightTable_uca900_zh_page05E []uint16
+var weightTable_uca900_zh_page05F []uint16
+var weightTable_uca900_zh_page060 []uint16
+var weightTable_uca900_zh_page061 []uint16
+var weightTable_uca900_zh_page062 []uint16
+var weightTable_uca900_zh_page063 []uint16
+var weightTable_uca900_zh_page064 []uint16
+var weightTable_uca900_zh_page065 []uint16
+var weightTable_uca900_zh_page066 []uint16
+var weightTable_uca900_zh_page067 []uint16
+var weightTable_uca900_zh_page068 []uint16
+var weightTable_uca900_zh_page069 []uint16
+var weightTable_uca900_zh_page06A []uint16
+var weightTable_uca900_zh_page06B []uint16
+var weightTable_uca900_zh_page06C []uint16
+var weightTable_uca900_zh_page06D []uint16
+var weightTable_uca900_zh_page06E []uint16
+var weightTable_uca900_zh_page06F []uint16
+var weightTable_uca900_zh_page070 []uint16
+var weightTable_uca900_zh_page071 []uint16
+var weightTable_uca900_zh_page072 []uint16
+var weightTable_uca900_zh_page073 []uint16
+var weightTable_uca900_zh_page074 []uint16
+var weightTable_uca900_zh_page075 []uint16
+var weightTable_uca900_zh_page076 []uint16
+var weightTable_uca900_zh_page077 []uint16
+var weightTable_uca900_zh_page078 []uint16
+var weightTable_uca900_zh_page079 []uint16
+var weightTable_uca900_zh_page07A []uint16
+var weightTable_uca900_zh_page07B []uint16
+var weightTable_uca900_zh_page07C []uint16
+var weightTable_uca900_zh_page07D []uint16
+var weightTable_uca900_zh_page07E []uint16
+var weightTable_uca900_zh_page07F []uint16
+var weightTable_uca900_zh_page080 []uint16
+var weightTable_uca900_zh_page081 []uint16
+var weightTable_uca900_zh_page082 []uint16
+var weightTable_uca900_zh_page083 []uint16
+var weightTable_uca900_zh_page084 []uint16
+var weightTable_uca900_zh_page085 []uint16
+var weightTable_uca900_zh_page086 []uint16
+var weightTable_uca900_zh_page087 []uint16
+var weightTable_uca900_zh_page088 []uint16
+var weightTable_uca900_zh_page089 []uint16
+var weightTable_uca900_zh_page08A []uint16
+var weightTable_uca900_zh_page08B []uint16
+var weightTable_uca900_zh_page08C []uint16
+var weightTable_uca900_zh_page08D []uint16
+var weightTable_uca900_zh_page08E []uint16
+var weightTable_uca900_zh_page08F []uint16
+var weightTable_uca900_zh_page090 []uint16
+var weightTable_uca900_zh_page091 []uint16
+var weightTable_uca900_zh_page092 []uint16
+var weightTable_uca900_zh_page093 []uint16
+var weightTable_uca900_zh_page094 []uint16
+var weightTable_uca900_zh_page095 []uint16
+var weightTable_uca900_zh_page096 []uint16
+var weightTable_uca900_zh_page097 []uint16
+var weightTable_uca900_zh_page098 []uint16
+var weightTable_uca900_zh_page099 []uint16
+var weightTable_uca900_zh_page09A []uint16
+var weightTable_uca900_zh_page09B []uint16
+var weightTable_uca900_zh_page09C []uint16
+var weightTable_uca900_zh_page09D []uint16
+var weightTable_uca900_zh_page09E []uint16
+var weightTable_uca900_zh_page09F []uint16
+var weightTable_uca900_zh_page0A0 []uint16
+var weightTable_uca900_zh_page0A1 []uint16
+var weightTable_uca900_zh_page0A2 []uint16
+var weightTable_uca900_zh_page0A3 []uint16
+var weightTable_uca900_zh_page0A4 []uint16
+var weightTable_uca900_zh_page0A5 []uint16
+var weightTable_uca900_zh_page0A6 []uint16
+var weightTable_uca900_zh_page0A7 []uint16
+var weightTable_uca900_zh_page0A8 []uint16
+var weightTable_uca900_zh_page0A9 []uint16
+var weightTable_uca900_zh_page0AA []uint16
+var weightTable_uca900_zh_page0AB []uint16
+var weightTable_uca900_zh_page0D7 []uint16
+var weightTable_uca900_zh_page0F9 []uint16
+var weightTable_uca900_zh_page0FA []uint16
+var weightTable_uca900_zh_page0FB []uint16
+var weightTable_uca900_zh_page0FC []uint16
+var weightTable_uca900_zh_page0FD []uint16
+var weightTable_uca900_zh_page0FE []uint16
+var weightTable_uca900_zh_page0FF []uint16
+var weightTable_uca900_zh_page100 []uint16
+var weightTable_uca900_zh_page101 []uint16
+var weightTable_uca900_zh_page102 []uint16
+var weightTable_uca900_zh_page103 []uint16
+var weightTable_uca900_zh_page104 []uint16
+var weightTable_uca900_zh_page105 []uint16
+var weightTable_uca900_zh_page106 []uint16
+var weightTable_uca900_zh_page107 []uint16
+var weightTable_uca900_zh_page108 []uint16
+var weightTable_uca900_zh_page109 []uint16
+var weightTable_uca900_zh_page10A []uint16
+var weightTable_uca900_zh_page10B []uint16
+var weightTable_uca900_zh_page10C []uint16
+var weightTable_uca900_zh_page10E []uint16
+var weightTable_uca900_zh_page110 []uint16
+var weightTable_uca900_zh_page111 []uint16
+var weightTable_uca900_zh_page112 []uint16
+var weightTable_uca900_zh_page113 []uint16
+var weightTable_uca900_zh_page114 []uint16
+var weightTable_uca900_zh_page115 []uint16
+var weightTable_uca900_zh_page116 []uint16
+var weightTable_uca900_zh_page117 []uint16
+var weightTable_uca900_zh_page118 []uint16
+var weightTable_uca900_zh_page11A []uint16
+var weightTable_uca900_zh_page11C []uint16
+var weightTable_uca900_zh_page120 []uint16
+var weightTable_uca900_zh_page121 []uint16
+var weightTable_uca900_zh_page122 []uint16
+var weightTable_uca900_zh_page123 []uint16
+var weightTable_uca900_zh_page124 []uint16
+var weightTable_uca900_zh_page125 []uint16
+var weightTable_uca900_zh_page130 []uint16
+var weightTable_uca900_zh_page131 []uint16
+var weightTable_uca900_zh_page132 []uint16
+var weightTable_uca900_zh_page133 []uint16
+var weightTable_uca900_zh_page134 []uint16
+var weightTable_uca900_zh_page144 []uint16
+var weightTable_uca900_zh_page145 []uint16
+var weightTable_uca900_zh_page146 []uint16
+var weightTable_uca900_zh_page168 []uint16
+var weightTable_uca900_zh_page169 []uint16
+var weightTable_uca900_zh_page16A []uint16
+var weightTable_uca900_zh_page16B []uint16
+var weightTable_uca900_zh_page16F []uint16
+var weightTable_uca900_zh_page1B0 []uint16
+var weightTable_uca900_zh_page1BC []uint16
+var weightTable_uca900_zh_page1D0 []uint16
+var weightTable_uca900_zh_page1D1 []uint16
+var weightTable_uca900_zh_page1D2 []uint16
+var weightTable_uca900_zh_page1D3 []uint16
+var weightTable_uca900_zh_page1D4 []uint16
+var weightTable_uca900_zh_page1D5 []uint16
+var weightTable_uca900_zh_page1D6 []uint16
+var weightTable_uca900_zh_page1D7 []uint16
+var weightTable_uca900_zh_page1DA []uint16
+var weightTable_uca900_zh_page1E0 []uint16
+var weightTable_uca900_zh_page1E8 []uint16
+var weightTable_uca900_zh_page1E9 []uint16
+var weightTable_uca900_zh_page1EE []uint16
+var weightTable_uca900_zh_page1F0 []uint16
+var weightTable_uca900_zh_page1F1 []uint16
+var weightTable_uca900_zh_page1F2 []uint16
+var weightTable_uca900_zh_page1F6 []uint16
+var weightTable_uca900_zh_page1F7 []uint16
+var weightTable_uca900_zh_page1F8 []uint16
+var weightTable_uca900_zh_page1F9 []uint16
+var weightTable_uca900_zh_page200 []uint16
+var weightTable_uca900_zh_page201 []uint16
+var weightTable_uca900_zh_page202 []uint16
+var weightTable_uca900_zh_page203 []uint16
+var weightTable_uca900_zh_page204 []uint16
+var weightTable_uca900_zh_page205 []uint16
+var weightTable_uca900_zh_page206 []uint16
+var weightTable_uca900_zh_page207 []uint16
+var weightTable_uca900_zh_page208 []uint16
+var weightTable_uca900_zh_page209 []uint16
+var weightTable_uca900_zh_page20A []uint16
+var weightTable_uca900_zh_page20B []uint16
+var weightTable_uca900_zh_page20C []uint16
+var weightTable_uca900_zh_page20D []uint16
+var weightTable_uca900_zh_page20E []uint16
+var weightTable_uca900_zh_page20F []uint16
+var weightTable_uca900_zh_page210 []uint16
+var weightTable_uca900_zh_page211 []uint16
+var weightTable_uca900_zh_page212 []uint16
+var weightTable_uca900_zh_page213 []uint16
+var weightTable_uca900_zh_page214 []uint16
+var weightTable_uca900_zh_page215 []uint16
+var weightTable_uca900_zh_page216 []uint16
+var weightTable_uca900_zh_page217 []uint16
+var weightTable_uca900_zh_page218 []uint16
+var weightTable_uca900_zh_page219 []uint16
+var weightTable_uca900_zh_page21A []uint16
+var weightTable_uca900_zh_page21B []uint16
+var weightTable_uca900_zh_page21C []uint16
+var weightTable_uca900_zh_page21D []uint16
+var weightTable_uca900_zh_page21E []uint16
+var weightTable_uca900_zh_page21F []uint16
+var weightTable_uca900_zh_page220 []uint16
+var weightTable_uca900_zh_page221 []uint16
+var weightTable_uca900_zh_page222 []uint16
+var weightTable_uca900_zh_page223 []uint16
+var weightTable_uca900_zh_page224 []uint16
+var weightTable_uca900_zh_page225 []uint16
+var weightTable_uca900_zh_page226 []uint16
+var weightTable_uca900_zh_page227 []uint16
+var weightTable_uca900_zh_page228 []uint16
+var weightTable_uca900_zh_page229 []uint16
+var weightTable_uca900_zh_page22A []uint16
+var weightTable_uca900_zh_page22B []uint16
+var weightTable_uca900_zh_page22C []uint16
+var weightTable_uca900_zh_page22D []uint16
+var weightTable_uca900_zh_page22E []uint16
+var weightTable_uca900_zh_page22F []uint16
+var weightTable_uca900_zh_page230 []uint16
+var weightTable_uca900_zh_page231 []uint16
+var weightTable_uca900_zh_page232 []uint16
+var weightTable_uca900_zh_page233 []uint16
+var weightTable_uca900_zh_page234 []uint16
+var weightTable_uca900_zh_page235 []uint16
+var weightTable_uca900_zh_page236 []uint16
+var weightTable_uca900_zh_page237 []uint16
+var weightTable_uca900_zh_page238 []uint16
+var weightTable_uca900_zh_page239 []uint16
+var weightTable_uca900_zh_page23A []uint16
+var weightTable_uca900_zh_page23B []uint16
+var weightTable_uca900_zh_page23C []uint16
+var weightTable_uca900_zh_page23D []uint16
+var weightTable_uca900_zh_page23E []uint16
+var weightTable_uca900_zh_page23F []uint16
+var weightTable_uca900_zh_page240 []uint16
+var weightTable_uca900_zh_page241 []uint16
+var weightTable_uca900_zh_page242 []uint16
+var weightTable_uca900_zh_page243 []uint16
+var weightTable_uca900_zh_page244 []uint16
+var weightTable_uca900_zh_page245 []uint16
+var weightTable_uca900_zh_page246 []uint16
+var weightTable_uca900_zh_page247 []uint16
+var weightTable_uca900_zh_page248 []uint16
+var weightTable_uca900_zh_page249 []uint16
+var weightTable_uca900_zh_page24A []uint16
+var weightTable_uca900_zh_page24B []uint16
+var weightTable_uca900_zh_page24C []uint16
+var weightTable_uca900_zh_page24D []uint16
+var weightTable_uca900_zh_page24E []uint16
+var weightTable_uca900_zh_page24F []uint16
+var weightTable_uca900_zh_page250 []uint16
+var weightTable_uca900_zh_page251 []uint16
+var weightTable_uca900_zh_page252 []uint16
+var weightTable_uca900_zh_page253 []uint16
+var weightTable_uca900_zh_page254 []uint16
+var weightTable_uca900_zh_page255 []uint16
+var weightTable_uca900_zh_page256 []uint16
+var weightTable_uca900_zh_page257 []uint16
+var weightTable_uca900_zh_page258 []uint16
+var weightTable_uca900_zh_page259 []uint16
+var weightTable_uca900_zh_page25A []uint16
+var weightTable_uca900_zh_page25B []uint16
+var weightTable_uca900_zh_page25C []uint16
+var weightTable_uca900_zh_page25D []uint16
+var weightTable_uca900_zh_page25E []uint16
+var weightTable_uca900_zh_page25F []uint16
+var weightTable_uca900_zh_page260 []uint16
+var weightTable_uca900_zh_page261 []uint16
+var weightTable_uca900_zh_page262 []uint16
+var weightTable_uca900_zh_page263 []uint16
+var weightTable_uca900_zh_page264 []uint16
+var weightTable_uca900_zh_page265 []uint16
+var weightTable_uca900_zh_page266 []uint16
+var weightTable_uca900_zh_page267 []uint16
+var weightTable_uca900_zh_page268 []uint16
+var weightTable_uca900_zh_page269 []uint16
+var weightTable_uca900_zh_page26A []uint16
+var weightTable_uca900_zh_page26B []uint16
+var weightTable_uca900_zh_page26C []uint16
+var weightTable_uca900_zh_page26D []uint16
+var weightTable_uca900_zh_page26E []uint16
+var weightTable_uca900_zh_page26F []uint16
+var weightTable_uca900_zh_page270 []uint16
+var weightTable_uca900_zh_page271 []uint16
+var weightTable_uca900_zh_page272 []uint16
+var weightTable_uca900_zh_page273 []uint16
+var weightTable_uca900_zh_page274 []uint16
+var weightTable_uca900_zh_page275 []uint16
+var weightTable_uca900_zh_page276 []uint16
+var weightTable_uca900_zh_page277 []uint16
+var weightTable_uca900_zh_page278 []uint16
+var weightTable_uca900_zh_page279 []uint16
+var weightTable_uca900_zh_page27A []uint16
+var weightTable_uca900_zh_page27B []uint16
+var weightTable_uca900_zh_page27C []uint16
+var weightTable_uca900_zh_page27D []uint16
+var weightTable_uca900_zh_page27E []uint16
+var weightTable_uca900_zh_page27F []uint16
+var weightTable_uca900_zh_page280 []uint16
+var weightTable_uca900_zh_page281 []uint16
+var weightTable_uca900_zh_page282 []uint16
+var weightTable_uca900_zh_page283 []uint16
+var weightTable_uca900_zh_page284 []uint16
+var weightTable_uca900_zh_page285 []uint16
+var weightTable_uca900_zh_page286 []uint16
+var weightTable_uca900_zh_page287 []uint16
+var weightTable_uca900_zh_page288 []uint16
+var weightTable_uca900_zh_page289 []uint16
+var weightTable_uca900_zh_page28A []uint16
+var weightTable_uca900_zh_page28B []uint16
+var weightTable_uca900_zh_page28C []uint16
+var weightTable_uca900_zh_page28D []uint16
+var weightTable_uca900_zh_page28E []uint16
+var weightTable_uca900_zh_page28F []uint16
+var weightTable_uca900_zh_page290 []uint16
+var weightTable_uca900_zh_page291 []uint16
+var weightTable_uca900_zh_page292 []uint16
+var weightTable_uca900_zh_page293 []uint16
+var weightTable_uca900_zh_page294 []uint16
+var weightTable_uca900_zh_page295 []uint16
+var weightTable_uca900_zh_page296 []uint16
+var weightTable_uca900_zh_page297 []uint16
+var weightTable_uca900_zh_page298 []uint16
+var weightTable_uca900_zh_page299 []uint16
+var weightTable_uca900_zh_page29A []uint16
+var weightTable_uca900_zh_page29B []uint16
+var weightTable_uca900_zh_page29C []uint16
+var weightTable_uca900_zh_page29D []uint16
+var weightTable_uca900_zh_page29E []uint16
+var weightTable_uca900_zh_page29F []uint16
+var weightTable_uca900_zh_page2A0 []uint16
+var weightTable_uca900_zh_page2A1 []uint16
+var weightTable_uca900_zh_page2A2 []uint16
+var weightTable_uca900_zh_page2A3 []uint16
+var weightTable_uca900_zh_page2A4 []uint16
+var weightTable_uca900_zh_page2A5 []uint16
+var weightTable_uca900_zh_page2A6 []uint16
+var weightTable_uca900_zh_page2A7 []uint16
+var weightTable_uca900_zh_page2A8 []uint16
+var weightTable_uca900_zh_page2AA []uint16
+var weightTable_uca900_zh_page2AE []uint16
+var weightTable_uca900_zh_page2AF []uint16
+var weightTable_uca900_zh_page2B0 []uint16
+var weightTable_uca900_zh_page2B1 []uint16
+var weightTable_uca900_zh_page2B2 []uint16
+var weightTable_uca900_zh_page2B3 []uint16
+var weightTable_uca900_zh_page2B4 []uint16
+var weightTable_uca900_zh_page2B5 []uint16
+var weightTable_uca900_zh_page2B6 []uint16
+var weightTable_uca900_zh_page2B8 []uint16
+var weightTable_uca900_zh_page2C4 []uint16
+var weightTable_uca900_zh_page2C6 []uint16
+var weightTable_uca900_zh_page2C9 []uint16
+var weightTable_uca900_zh_page2CB []uint16
+var weightTable_uca900_zh_page2CE []uint16
+var weightTable_uca900_zh_page2F8 []uint16
+var weightTable_uca900_zh_page2F9 []uint16
+var weightTable_uca900_zh_page2FA []uint16
+var weightTable_uca900_zh_pageE00 []uint16
+var weightTable_uca900_zh_pageE01 []uint16
 var weightTable_uca900_zh = []*[]uint16{
 	&weightTable_uca900_zh_page000, &weightTable_uca900_zh_page001, &weightTable_uca900_zh_page002, &weightTable_uca900_zh_page003, &weightTable_uca900_zh_page004, &weightTable_uca900_zh_page005, &weightTable_uca900_zh_page006, &weightTable_uca900_zh_page007, &weightTable_uca900_zh_page008, &weightTable_uca900_zh_page009, &weightTable_uca900_zh_page00A, &weightTable_uca900_zh_page00B, &weightTable_uca900_zh_page00C, &weightTable_uca900_zh_page00D, &weightTable_uca900_zh_page00E, &weightTable_uca900_zh_page00F, &weightTable_uca900_zh_page010, &weightTable_uca900_zh_page011, &weightTable_uca900_zh_page012, &weightTable_uca900_zh_page013, &weightTable_uca900_zh_page014, &weightTable_uca900_zh_page015, &weightTable_uca900_zh_page016, &weightTable_uca900_zh_page017, &weightTable_uca900_zh_page018, &weightTable_uca900_zh_page019, &weightTable_uca900_zh_page01A, &weightTable_uca900_zh_page01B, &weightTable_uca900_zh_page01C, &weightTable_uca900_zh_page01D, &weightTable_uca900_zh_page01E, &weightTable_uca900_zh_page01F,
 	&weightTable_uca900_zh_page020, &weightTable_uca900_zh_page021, &weightTable_uca900_page022, &weightTable_uca900_zh_page023, &weightTable_uca900_zh_page024, &weightTable_uca900_page025, &weightTable_uca900_page026, &weightTable_uca900_page027, &weightTable_uca900_page028, &weightTable_uca900_page029, &weightTable_uca900_page02A, &weightTable_uca900_zh_page02B, &weightTable_uca900_zh_page02C, &weightTable_uca900_zh_page02D, &weightTable_uca900_zh_page02E, &weightTable_uca900_zh_page02F, &weightTable_uca900_zh_page030, &weightTable_uca900_zh_page031, &weightTable_uca900_zh_page032, &weightTable_uca900_zh_page033, &weightTable_uca900_zh_page034, &weightTable_uca900_zh_page035, &weightTable_uca900_zh_page036, &weightTable_uca900_zh_page037, &weightTable_uca900_zh_page038, &weightTable_uca900_zh_page039, &weightTable_uca900_zh_page03A, &weightTable_uca900_zh_page03B, &weightTable_uca900_zh_page03C, &weightTable_uca900_zh_page03D, &weightTable_uca900_zh_page03E, &weightTable_uca900_zh_page03F,
@@ -1097,65 +1096,65 @@ var weightTable_uca900_zh = []*[]uint16{
 	nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 	nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 	nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil}
-var weightTable_uca400_page000 = weightsUCA_embed(1175808, 769)
-var weightTable_uca400_page001 = weightsUCA_embed(1176577, 513)
-var weightTable_uca400_page002 = weightsUCA_embed(1177090, 513)
-var weightTable_uca400_page003 = weightsUCA_embed(1177603, 769)
-var weightTable_uca400_page004 = weightsUCA_embed(1178372, 513)
-var weightTable_uca400_page005 = weightsUCA_embed(1178885, 513)
-var weightTable_uca400_page006 = weightsUCA_embed(1179398, 513)
-var weightTable_uca400_page007 = weightsUCA_embed(1179911, 513)
-var weightTable_uca400_page009 = weightsUCA_embed(1180424, 513)
-var weightTable_uca400_page00A = weightsUCA_embed(1180937, 513)
-var weightTable_uca400_page00B = weightsUCA_embed(1181450, 513)
-var weightTable_uca400_page00C = weightsUCA_embed(1181963, 513)
-var weightTable_uca400_page00D = weightsUCA_embed(1182476, 513)
-var weightTable_uca400_page00E = weightsUCA_embed(1182989, 513)
-var weightTable_uca400_page00F = weightsUCA_embed(1183502, 513)
-var weightTable_uca400_page010 = weightsUCA_embed(1184015, 513)
-var weightTable_uca400_page011 = weightsUCA_embed(1184528, 513)
-var weightTable_uca400_page012 = weightsUCA_embed(1185041, 513)
-var weightTable_uca400_page013 = weightsUCA_embed(1185554, 513)
-var weightTable_uca400_page014 = weightsUCA_embed(1186067, 513)
-var weightTable_uca400_page015 = weightsUCA_embed(1186580, 257)
-var weightTable_uca400_page016 = weightsUCA_embed(1186837, 513)
-var weightTable_uca400_page017 = weightsUCA_embed(1187350, 513)
-var weightTable_uca400_page018 = weightsUCA_embed(1187863, 513)
-var weightTable_uca400_page019 = weightsUCA_embed(1188376, 513)
-var weightTable_uca400_page01D = weightsUCA_embed(1188889, 513)
-var weightTable_uca400_page01E = weightsUCA_embed(1189402, 513)
-var weightTable_uca400_page01F = weightsUCA_embed(1189915, 513)
-var weightTable_uca400_page020 = weightsUCA_embed(1190428, 1025)
-var weightTable_uca400_page021 = weightsUCA_embed(1191453, 1025)
-var weightTable_uca400_page022 = weightsUCA_embed(1192478, 769)
-var weightTable_uca400_page023 = weightsUCA_embed(1193247, 513)
-var weightTable_uca400_page024 = weightsUCA_embed(1193760, 1025)
-var weightTable_uca400_page025 = weightsUCA_embed(1194785, 257)
-var weightTable_uca400_page026 = weightsUCA_embed(1195042, 513)
-var weightTable_uca400_page027 = weightsUCA_embed(1195555, 513)
-var weightTable_uca400_page028 = weightsUCA_embed(1196068, 257)
-var weightTable_uca400_page029 = weightsUCA_embed(1196325, 257)
-var weightTable_uca400_page02A = weightsUCA_embed(1196582, 1025)
-var weightTable_uca400_page02B = weightsUCA_embed(1197607, 513)
-var weightTable_uca400_page02E = weightsUCA_embed(1198120, 513)
-var weightTable_uca400_page02F = weightsUCA_embed(1198633, 513)
-var weightTable_uca400_page030 = weightsUCA_embed(1199146, 513)
-var weightTable_uca400_page031 = weightsUCA_embed(1199659, 513)
-var weightTable_uca400_page032 = weightsUCA_embed(1200172, 1793)
-var weightTable_uca400_page033 = weightsUCA_embed(1201965, 2049)
-var weightTable_uca400_page04D = weightsUCA_embed(1204014, 513)
-var weightTable_uca400_page0A0 = weightsUCA_embed(1204527, 257)
-var weightTable_uca400_page0A1 = weightsUCA_embed(1204784, 257)
-var weightTable_uca400_page0A2 = weightsUCA_embed(1205041, 257)
-var weightTable_uca400_page0A3 = weightsUCA_embed(1205298, 257)
-var weightTable_uca400_page0A4 = weightsUCA_embed(1205555, 513)
-var weightTable_uca400_page0F9 = weightsUCA_embed(1206068, 513)
-var weightTable_uca400_page0FA = weightsUCA_embed(1206581, 513)
-var weightTable_uca400_page0FB = weightsUCA_embed(1207094, 769)
-var weightTable_uca400_page0FC = weightsUCA_embed(1207863, 513)
-var weightTable_uca400_page0FD = weightsUCA_embed(1208376, 2049)
-var weightTable_uca400_page0FE = weightsUCA_embed(1210425, 513)
-var weightTable_uca400_page0FF = weightsUCA_embed(1210938, 513)
+var weightTable_uca400_page000 []uint16
+var weightTable_uca400_page001 []uint16
+var weightTable_uca400_page002 []uint16
+var weightTable_uca400_page003 []uint16
+var weightTable_uca400_page004 []uint16
+var weightTable_uca400_page005 []uint16
+var weightTable_uca400_page006 []uint16
+var weightTable_uca400_page007 []uint16
+var weightTable_uca400_page009 []uint16
+var weightTable_uca400_page00A []uint16
+var weightTable_uca400_page00B []uint16
+var weightTable_uca400_page00C []uint16
+var weightTable_uca400_page00D []uint16
+var weightTable_uca400_page00E []uint16
+var weightTable_uca400_page00F []uint16
+var weightTable_uca400_page010 []uint16
+var weightTable_uca400_page011 []uint16
+var weightTable_uca400_page012 []uint16
+var weightTable_uca400_page013 []uint16
+var weightTable_uca400_page014 []uint16
+var weightTable_uca400_page015 []uint16
+var weightTable_uca400_page016 []uint16
+var weightTable_uca400_page017 []uint16
+var weightTable_uca400_page018 []uint16
+var weightTable_uca400_page019 []uint16
+var weightTable_uca400_page01D []uint16
+var weightTable_uca400_page01E []uint16
+var weightTable_uca400_page01F []uint16
+var weightTable_uca400_page020 []uint16
+var weightTable_uca400_page021 []uint16
+var weightTable_uca400_page022 []uint16
+var weightTable_uca400_page023 []uint16
+var weightTable_uca400_page024 []uint16
+var weightTable_uca400_page025 []uint16
+var weightTable_uca400_page026 []uint16
+var weightTable_uca400_page027 []uint16
+var weightTable_uca400_page028 []uint16
+var weightTable_uca400_page029 []uint16
+var weightTable_uca400_page02A []uint16
+var weightTable_uca400_page02B []uint16
+var weightTable_uca400_page02E []uint16
+var weightTable_uca400_page02F []uint16
+var weightTable_uca400_page030 []uint16
+var weightTable_uca400_page031 []uint16
+var weightTable_uca400_page032 []uint16
+var weightTable_uca400_page033 []uint16
+var weightTable_uca400_page04D []uint16
+var weightTable_uca400_page0A0 []uint16
+var weightTable_uca400_page0A1 []uint16
+var weightTable_uca400_page0A2 []uint16
+var weightTable_uca400_page0A3 []uint16
+var weightTable_uca400_page0A4 []uint16
+var weightTable_uca400_page0F9 []uint16
+var weightTable_uca400_page0FA []uint16
+var weightTable_uca400_page0FB []uint16
+var weightTable_uca400_page0FC []uint16
+var weightTable_uca400_page0FD []uint16
+var weightTable_uca400_page0FE []uint16
+var weightTable_uca400_page0FF []uint16
 var weightTable_uca400 = []*[]uint16{
 	&weightTable_uca400_page000, &weightTable_uca400_page001, &weightTable_uca400_page002, &weightTable_uca400_page003, &weightTable_uca400_page004, &weightTable_uca400_page005, &weightTable_uca400_page006, &weightTable_uca400_page007, nil, &weightTable_uca400_page009, &weightTable_uca400_page00A, &weightTable_uca400_page00B, &weightTable_uca400_page00C, &weightTable_uca400_page00D, &weightTable_uca400_page00E, &weightTable_uca400_page00F, &weightTable_uca400_page010, &weightTable_uca400_page011, &weightTable_uca400_page012, &weightTable_uca400_page013, &weightTable_uca400_page014, &weightTable_uca400_page015, &weightTable_uca400_page016, &weightTable_uca400_page017, &weightTable_uca400_page018, &weightTable_uca400_page019, nil, nil, nil, &weightTable_uca400_page01D, &weightTable_uca400_page01E, &weightTable_uca400_page01F,
 	&weightTable_uca400_page020, &weightTable_uca400_page021, &weightTable_uca400_page022, &weightTable_uca400_page023, &weightTable_uca400_page024, &weightTable_uca400_page025, &weightTable_uca400_page026, &weightTable_uca400_page027, &weightTable_uca400_page028, &weightTable_uca400_page029, &weightTable_uca400_page02A, &weightTable_uca400_page02B, nil, nil, &weightTable_uca400_page02E, &weightTable_uca400_page02F, &weightTable_uca400_page030, &weightTable_uca400_page031, &weightTable_uca400_page032, &weightTable_uca400_page033, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
@@ -1165,116 +1164,116 @@ var weightTable_uca400 = []*[]uint16{
 	&weightTable_uca400_page0A0, &weightTable_uca400_page0A1, &weightTable_uca400_page0A2, &weightTable_uca400_page0A3, &weightTable_uca400_page0A4, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 	nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 	nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, &weightTable_uca400_page0F9, &weightTable_uca400_page0FA, &weightTable_uca400_page0FB, &weightTable_uca400_page0FC, &weightTable_uca400_page0FD, &weightTable_uca400_page0FE, &weightTable_uca400_page0FF}
-var weightTable_uca520_page000 = weightsUCA_embed(1211451, 769)
-var weightTable_uca520_page001 = weightsUCA_embed(1212220, 513)
-var weightTable_uca520_page002 = weightsUCA_embed(1212733, 513)
-var weightTable_uca520_page003 = weightsUCA_embed(1213246, 769)
-var weightTable_uca520_page004 = weightsUCA_embed(1214015, 257)
-var weightTable_uca520_page005 = weightsUCA_embed(1214272, 513)
-var weightTable_uca520_page006 = weightsUCA_embed(1214785, 513)
-var weightTable_uca520_page007 = weightsUCA_embed(1215298, 513)
-var weightTable_uca520_page008 = weightsUCA_embed(1215811, 513)
-var weightTable_uca520_page009 = weightsUCA_embed(1216324, 513)
-var weightTable_uca520_page00A = weightsUCA_embed(1216837, 513)
-var weightTable_uca520_page00B = weightsUCA_embed(1217350, 513)
-var weightTable_uca520_page00C = weightsUCA_embed(1217863, 513)
-var weightTable_uca520_page00D = weightsUCA_embed(1218376, 513)
-var weightTable_uca520_page00E = weightsUCA_embed(1218889, 513)
-var weightTable_uca520_page00F = weightsUCA_embed(1219402, 513)
-var weightTable_uca520_page010 = weightsUCA_embed(1219915, 769)
-var weightTable_uca520_page011 = weightsUCA_embed(1220684, 257)
-var weightTable_uca520_page012 = weightsUCA_embed(1220941, 513)
-var weightTable_uca520_page013 = weightsUCA_embed(1221454, 513)
-var weightTable_uca520_page014 = weightsUCA_embed(1221967, 257)
-var weightTable_uca520_page015 = weightsUCA_embed(1222224, 257)
-var weightTable_uca520_page016 = weightsUCA_embed(1222481, 513)
-var weightTable_uca520_page017 = weightsUCA_embed(1222994, 513)
-var weightTable_uca520_page018 = weightsUCA_embed(1223507, 513)
-var weightTable_uca520_page019 = weightsUCA_embed(1224020, 769)
-var weightTable_uca520_page01A = weightsUCA_embed(1224789, 769)
-var weightTable_uca520_page01B = weightsUCA_embed(1225558, 513)
-var weightTable_uca520_page01C = weightsUCA_embed(1226071, 513)
-var weightTable_uca520_page01D = weightsUCA_embed(1226584, 513)
-var weightTable_uca520_page01E = weightsUCA_embed(1227097, 513)
-var weightTable_uca520_page01F = weightsUCA_embed(1227610, 513)
-var weightTable_uca520_page020 = weightsUCA_embed(1228123, 1025)
-var weightTable_uca520_page021 = weightsUCA_embed(1229148, 1025)
-var weightTable_uca520_page022 = weightsUCA_embed(1230173, 769)
-var weightTable_uca520_page023 = weightsUCA_embed(1230942, 513)
-var weightTable_uca520_page024 = weightsUCA_embed(1231455, 1025)
-var weightTable_uca520_page025 = weightsUCA_embed(1232480, 257)
-var weightTable_uca520_page026 = weightsUCA_embed(1232737, 513)
-var weightTable_uca520_page027 = weightsUCA_embed(1233250, 513)
-var weightTable_uca520_page028 = weightsUCA_embed(1233763, 257)
-var weightTable_uca520_page029 = weightsUCA_embed(1234020, 257)
-var weightTable_uca520_page02A = weightsUCA_embed(1234277, 1025)
-var weightTable_uca520_page02B = weightsUCA_embed(1235302, 513)
-var weightTable_uca520_page02C = weightsUCA_embed(1235815, 769)
-var weightTable_uca520_page02D = weightsUCA_embed(1236584, 513)
-var weightTable_uca520_page02E = weightsUCA_embed(1237097, 513)
-var weightTable_uca520_page02F = weightsUCA_embed(1237610, 513)
-var weightTable_uca520_page030 = weightsUCA_embed(1238123, 513)
-var weightTable_uca520_page031 = weightsUCA_embed(1238636, 513)
-var weightTable_uca520_page032 = weightsUCA_embed(1239149, 1793)
-var weightTable_uca520_page033 = weightsUCA_embed(1240942, 2049)
-var weightTable_uca520_page04D = weightsUCA_embed(1242991, 513)
-var weightTable_uca520_page0A0 = weightsUCA_embed(1243504, 257)
-var weightTable_uca520_page0A1 = weightsUCA_embed(1243761, 257)
-var weightTable_uca520_page0A2 = weightsUCA_embed(1244018, 257)
-var weightTable_uca520_page0A3 = weightsUCA_embed(1244275, 257)
-var weightTable_uca520_page0A4 = weightsUCA_embed(1244532, 513)
-var weightTable_uca520_page0A5 = weightsUCA_embed(1245045, 257)
-var weightTable_uca520_page0A6 = weightsUCA_embed(1245302, 513)
-var weightTable_uca520_page0A7 = weightsUCA_embed(1245815, 513)
-var weightTable_uca520_page0A8 = weightsUCA_embed(1246328, 513)
-var weightTable_uca520_page0A9 = weightsUCA_embed(1246841, 513)
-var weightTable_uca520_page0AA = weightsUCA_embed(1247354, 513)
-var weightTable_uca520_page0AB = weightsUCA_embed(1247867, 513)
-var weightTable_uca520_page0D7 = weightsUCA_embed(1248380, 513)
-var weightTable_uca520_page0FA = weightsUCA_embed(1248893, 513)
-var weightTable_uca520_page0FB = weightsUCA_embed(1249406, 769)
-var weightTable_uca520_page0FC = weightsUCA_embed(1250175, 513)
-var weightTable_uca520_page0FD = weightsUCA_embed(1250688, 2049)
-var weightTable_uca520_page0FE = weightsUCA_embed(1252737, 769)
-var weightTable_uca520_page0FF = weightsUCA_embed(1253506, 513)
-var weightTable_uca520_page100 = weightsUCA_embed(1254019, 513)
-var weightTable_uca520_page101 = weightsUCA_embed(1254532, 513)
-var weightTable_uca520_page102 = weightsUCA_embed(1255045, 513)
-var weightTable_uca520_page103 = weightsUCA_embed(1255558, 513)
-var weightTable_uca520_page104 = weightsUCA_embed(1256071, 513)
-var weightTable_uca520_page108 = weightsUCA_embed(1256584, 513)
-var weightTable_uca520_page109 = weightsUCA_embed(1257097, 513)
-var weightTable_uca520_page10A = weightsUCA_embed(1257610, 513)
-var weightTable_uca520_page10B = weightsUCA_embed(1258123, 513)
-var weightTable_uca520_page10C = weightsUCA_embed(1258636, 513)
-var weightTable_uca520_page10E = weightsUCA_embed(1259149, 513)
-var weightTable_uca520_page110 = weightsUCA_embed(1259662, 513)
-var weightTable_uca520_page120 = weightsUCA_embed(1260175, 257)
-var weightTable_uca520_page121 = weightsUCA_embed(1260432, 257)
-var weightTable_uca520_page122 = weightsUCA_embed(1260689, 257)
-var weightTable_uca520_page123 = weightsUCA_embed(1260946, 513)
-var weightTable_uca520_page124 = weightsUCA_embed(1261459, 513)
-var weightTable_uca520_page130 = weightsUCA_embed(1261972, 257)
-var weightTable_uca520_page131 = weightsUCA_embed(1262229, 257)
-var weightTable_uca520_page132 = weightsUCA_embed(1262486, 257)
-var weightTable_uca520_page133 = weightsUCA_embed(1262743, 257)
-var weightTable_uca520_page134 = weightsUCA_embed(1263000, 513)
-var weightTable_uca520_page1D0 = weightsUCA_embed(1263513, 513)
-var weightTable_uca520_page1D1 = weightsUCA_embed(1264026, 513)
-var weightTable_uca520_page1D2 = weightsUCA_embed(1264539, 513)
-var weightTable_uca520_page1D3 = weightsUCA_embed(1265052, 513)
-var weightTable_uca520_page1D4 = weightsUCA_embed(1265565, 513)
-var weightTable_uca520_page1D5 = weightsUCA_embed(1266078, 513)
-var weightTable_uca520_page1D6 = weightsUCA_embed(1266591, 513)
-var weightTable_uca520_page1D7 = weightsUCA_embed(1267104, 513)
-var weightTable_uca520_page1F0 = weightsUCA_embed(1267617, 513)
-var weightTable_uca520_page1F1 = weightsUCA_embed(1268130, 769)
-var weightTable_uca520_page1F2 = weightsUCA_embed(1268899, 1025)
-var weightTable_uca520_page2F8 = weightsUCA_embed(1269924, 513)
-var weightTable_uca520_page2F9 = weightsUCA_embed(1270437, 513)
-var weightTable_uca520_page2FA = weightsUCA_embed(1270950, 513)
-var weightTable_uca520_pageE00 = weightsUCA_embed(1271463, 513)
-var weightTable_uca520_pageE01 = weightsUCA_embed(1271976, 513)
+var weightTable_uca520_page000 []uint16
+var weightTable_uca520_page001 []uint16
+var weightTable_uca520_page002 []uint16
+var weightTable_uca520_page003 []uint16
+var weightTable_uca520_page004 []uint16
+var weightTable_uca520_page005 []uint16
+var weightTable_uca520_page006 []uint16
+var weightTable_uca520_page007 []uint16
+var weightTable_uca520_page008 []uint16
+var weightTable_uca520_page009 []uint16
+var weightTable_uca520_page00A []uint16
+var weightTable_uca520_page00B []uint16
+var weightTable_uca520_page00C []uint16
+var weightTable_uca520_page00D []uint16
+var weightTable_uca520_page00E []uint16
+var weightTable_uca520_page00F []uint16
+var weightTable_uca520_page010 []uint16
+var weightTable_uca520_page011 []uint16
+var weightTable_uca520_page012 []uint16
+var weightTable_uca520_page013 []uint16
+var weightTable_uca520_page014 []uint16
+var weightTable_uca520_page015 []uint16
+var weightTable_uca520_page016 []uint16
+var weightTable_uca520_page017 []uint16
+var weightTable_uca520_page018 []uint16
+var weightTable_uca520_page019 []uint16
+var weightTable_uca520_page01A []uint16
+var weightTable_uca520_page01B []uint16
+var weightTable_uca520_page01C []uint16
+var weightTable_uca520_page01D []uint16
+var weightTable_uca520_page01E []uint16
+var weightTable_uca520_page01F []uint16
+var weightTable_uca520_page020 []uint16
+var weightTable_uca520_page021 []uint16
+var weightTable_uca520_page022 []uint16
+var weightTable_uca520_page023 []uint16
+var weightTable_uca520_page024 []uint16
+var weightTable_uca520_page025 []uint16
+var weightTable_uca520_page026 []uint16
+var weightTable_uca520_page027 []uint16
+var weightTable_uca520_page028 []uint16
+var weightTable_uca520_page029 []uint16
+var weightTable_uca520_page02A []uint16
+var weightTable_uca520_page02B []uint16
+var weightTable_uca520_page02C []uint16
+var weightTable_uca520_page02D []uint16
+var weightTable_uca520_page02E []uint16
+var weightTable_uca520_page02F []uint16
+var weightTable_uca520_page030 []uint16
+var weightTable_uca520_page031 []uint16
+var weightTable_uca520_page032 []uint16
+var weightTable_uca520_page033 []uint16
+var weightTable_uca520_page04D []uint16
+var weightTable_uca520_page0A0 []uint16
+var weightTable_uca520_page0A1 []uint16
+var weightTable_uca520_page0A2 []uint16
+var weightTable_uca520_page0A3 []uint16
+var weightTable_uca520_page0A4 []uint16
+var weightTable_uca520_page0A5 []uint16
+var weightTable_uca520_page0A6 []uint16
+var weightTable_uca520_page0A7 []uint16
+var weightTable_uca520_page0A8 []uint16
+var weightTable_uca520_page0A9 []uint16
+var weightTable_uca520_page0AA []uint16
+var weightTable_uca520_page0AB []uint16
+var weightTable_uca520_page0D7 []uint16
+var weightTable_uca520_page0FA []uint16
+var weightTable_uca520_page0FB []uint16
+var weightTable_uca520_page0FC []uint16
+var weightTable_uca520_page0FD []uint16
+var weightTable_uca520_page0FE []uint16
+var weightTable_uca520_page0FF []uint16
+var weightTable_uca520_page100 []uint16
+var weightTable_uca520_page101 []uint16
+var weightTable_uca520_page102 []uint16
+var weightTable_uca520_page103 []uint16
+var weightTable_uca520_page104 []uint16
+var weightTable_uca520_page108 []uint16
+var weightTable_uca520_page109 []uint16
+var weightTable_uca520_page10A []uint16
+var weightTable_uca520_page10B []uint16
+var weightTable_uca520_page10C []uint16
+var weightTable_uca520_page10E []uint16
+var weightTable_uca520_page110 []uint16
+var weightTable_uca520_page120 []uint16
+var weightTable_uca520_page121 []uint16
+var weightTable_uca520_page122 []uint16
+var weightTable_uca520_page123 []uint16
+var weightTable_uca520_page124 []uint16
+var weightTable_uca520_page130 []uint16
+var weightTable_uca520_page131 []uint16
+var weightTable_uca520_page132 []uint16
+var weightTable_uca520_page133 []uint16
+var weightTable_uca520_page134 []uint16
+var weightTable_uca520_page1D0 []uint16
+var weightTable_uca520_page1D1 []uint16
+var weightTable_uca520_page1D2 []uint16
+var weightTable_uca520_page1D3 []uint16
+var weightTable_uca520_page1D4 []uint16
+var weightTable_uca520_page1D5 []uint16
+var weightTable_uca520_page1D6 []uint16
+var weightTable_uca520_page1D7 []uint16
+var weightTable_uca520_page1F0 []uint16
+var weightTable_uca520_page1F1 []uint16
+var weightTable_uca520_page1F2 []uint16
+var weightTable_uca520_page2F8 []uint16
+var weightTable_uca520_page2F9 []uint16
+var weightTable_uca520_page2FA []uint16
+var weightTable_uca520_pageE00 []uint16
+var weightTable_uca520_pageE01 []uint16
 var weightTable_uca520 = []*[]uint16{
 	&weightTable_uca520_page000, &weightTable_uca520_page001, &weightTable_uca520_page002, &weightTable_uca520_page003, &weightTable_uca520_page004, &weightTable_uca520_page005, &weightTable_uca520_page006, &weightTable_uca520_page007, &weightTable_uca520_page008, &weightTable_uca520_page009, &weightTable_uca520_page00A, &weightTable_uca520_page00B, &weightTable_uca520_page00C, &weightTable_uca520_page00D, &weightTable_uca520_page00E, &weightTable_uca520_page00F, &weightTable_uca520_page010, &weightTable_uca520_page011, &weightTable_uca520_page012, &weightTable_uca520_page013, &weightTable_uca520_page014, &weightTable_uca520_page015, &weightTable_uca520_page016, &weightTable_uca520_page017, &weightTable_uca520_page018, &weightTable_uca520_page019, &weightTable_uca520_page01A, &weightTable_uca520_page01B, &weightTable_uca520_page01C, &weightTable_uca520_page01D, &weightTable_uca520_page01E, &weightTable_uca520_page01F,
 	&weightTable_uca520_page020, &weightTable_uca520_page021, &weightTable_uca520_page022, &weightTable_uca520_page023, &weightTable_uca520_page024, &weightTable_uca520_page025, &weightTable_uca520_page026, &weightTable_uca520_page027, &weightTable_uca520_page028, &weightTable_uca520_page029, &weightTable_uca520_page02A, &weightTable_uca520_page02B, &weightTable_uca520_page02C, &weightTable_uca520_page02D, &weightTable_uca520_page02E, &weightTable_uca520_page02F, &weightTable_uca520_page030, &weightTable_uca520_page031, &weightTable_uca520_page032, &weightTable_uca520_page033, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
@@ -1413,9 +1412,849 @@ var weightTable_uca520 = []*[]uint16{
 	nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 	nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil}
 
-//go:embed mysqlucadata.bin
-var weightsUCA_embed_data string
+// weightsUCA_len is the number of weights in mysqlucadata.bin
+const weightsUCA_len = 1272489
 
-func weightsUCA_embed(pos, length int) []uint16 {
-	return (*[0x3fffffff]uint16)(unsafe.Pointer(unsafe.StringData(weightsUCA_embed_data)))[pos : pos+length]
+// weightsUCA_checksum is the SHA-256 checksum of the weights in mysqlucadata.bin
+const weightsUCA_checksum = "721b1fef4eff28a5c24de38ba92bbb8053ae7c8405d00da37f1d6565a0398a9b"
+
+// loadWeightsUCA points all the weight table pages to the weights in data, which
+// must have the contents of mysqlucadata.bin after its header
+func loadWeightsUCA(data string) {
+	weightTable_uca900_page000 = weightsUCA_slice(data, 0, 2560)
+	weightTable_uca900_page001 = weightsUCA_slice(data, 2560, 3328)
+	weightTable_uca900_page002 = weightsUCA_slice(data, 5888, 2560)
+	weightTable_uca900_page003 = weightsUCA_slice(data, 8448, 2560)
+	weightTable_uca900_page004 = weightsUCA_slice(data, 11008, 1792)
+	weightTable_uca900_page005 = weightsUCA_slice(data, 12800, 1792)
+	weightTable_uca900_page006 = weightsUCA_slice(data, 14592, 1792)
+	weightTable_uca900_page007 = weightsUCA_slice(data, 16384, 1792)
+	weightTable_uca900_page008 = weightsUCA_slice(data, 18176, 1792)
+	weightTable_uca900_page009 = weightsUCA_slice(data, 19968, 1792)
+	weightTable_uca900_page00A = weightsUCA_slice(data, 21760, 1792)
+	weightTable_uca900_page00B = weightsUCA_slice(data, 23552, 1792)
+	weightTable_uca900_page00C = weightsUCA_slice(data, 25344, 1792)
+	weightTable_uca900_page00D = weightsUCA_slice(data, 27136, 1792)
+	weightTable_uca900_page00E = weightsUCA_slice(data, 28928, 1792)
+	weightTable_uca900_page00F = weightsUCA_slice(data, 30720, 2560)
+	weightTable_uca900_page010 = weightsUCA_slice(data, 33280, 2560)
+	weightTable_uca900_page011 = weightsUCA_slice(data, 35840, 1024)
+	weightTable_uca900_page012 = weightsUCA_slice(data, 36864, 1792)
+	weightTable_uca900_page013 = weightsUCA_slice(data, 38656, 1792)
+	weightTable_uca900_page014 = weightsUCA_slice(data, 40448, 1024)
+	weightTable_uca900_page015 = weightsUCA_slice(data, 41472, 1024)
+	weightTable_uca900_page016 = weightsUCA_slice(data, 42496, 3328)
+	weightTable_uca900_page017 = weightsUCA_slice(data, 45824, 1792)
+	weightTable_uca900_page018 = weightsUCA_slice(data, 47616, 1792)
+	weightTable_uca900_page019 = weightsUCA_slice(data, 49408, 2560)
+	weightTable_uca900_page01A = weightsUCA_slice(data, 51968, 2560)
+	weightTable_uca900_page01B = weightsUCA_slice(data, 54528, 1792)
+	weightTable_uca900_page01C = weightsUCA_slice(data, 56320, 1792)
+	weightTable_uca900_page01D = weightsUCA_slice(data, 58112, 2560)
+	weightTable_uca900_page01E = weightsUCA_slice(data, 60672, 2560)
+	weightTable_uca900_page01F = weightsUCA_slice(data, 63232, 3328)
+	weightTable_uca900_page020 = weightsUCA_slice(data, 66560, 3328)
+	weightTable_uca900_page021 = weightsUCA_slice(data, 69888, 3328)
+	weightTable_uca900_page022 = weightsUCA_slice(data, 73216, 2560)
+	weightTable_uca900_page023 = weightsUCA_slice(data, 75776, 1792)
+	weightTable_uca900_page024 = weightsUCA_slice(data, 77568, 3328)
+	weightTable_uca900_page025 = weightsUCA_slice(data, 80896, 1024)
+	weightTable_uca900_page026 = weightsUCA_slice(data, 81920, 1024)
+	weightTable_uca900_page027 = weightsUCA_slice(data, 82944, 1792)
+	weightTable_uca900_page028 = weightsUCA_slice(data, 84736, 1024)
+	weightTable_uca900_page029 = weightsUCA_slice(data, 85760, 1024)
+	weightTable_uca900_page02A = weightsUCA_slice(data, 86784, 3328)
+	weightTable_uca900_page02B = weightsUCA_slice(data, 90112, 1792)
+	weightTable_uca900_page02C = weightsUCA_slice(data, 91904, 2560)
+	weightTable_uca900_page02D = weightsUCA_slice(data, 94464, 1792)
+	weightTable_uca900_page02E = weightsUCA_slice(data, 96256, 2560)
+	weightTable_uca900_page02F = weightsUCA_slice(data, 98816, 1792)
+	weightTable_uca900_page030 = weightsUCA_slice(data, 100608, 1792)
+	weightTable_uca900_page031 = weightsUCA_slice(data, 102400, 1792)
+	weightTable_uca900_page032 = weightsUCA_slice(data, 104192, 5632)
+	weightTable_uca900_page033 = weightsUCA_slice(data, 109824, 6400)
+	weightTable_uca900_page04D = weightsUCA_slice(data, 116224, 1792)
+	weightTable_uca900_page0A0 = weightsUCA_slice(data, 118016, 1024)
+	weightTable_uca900_page0A1 = weightsUCA_slice(data, 119040, 1024)
+	weightTable_uca900_page0A2 = weightsUCA_slice(data, 120064, 1024)
+	weightTable_uca900_page0A3 = weightsUCA_slice(data, 121088, 1024)
+	weightTable_uca900_page0A4 = weightsUCA_slice(data, 122112, 1792)
+	weightTable_uca900_page0A5 = weightsUCA_slice(data, 123904, 1024)
+	weightTable_uca900_page0A6 = weightsUCA_slice(data, 124928, 1792)
+	weightTable_uca900_page0A7 = weightsUCA_slice(data, 126720, 2560)
+	weightTable_uca900_page0A8 = weightsUCA_slice(data, 129280, 1792)
+	weightTable_uca900_page0A9 = weightsUCA_slice(data, 131072, 1792)
+	weightTable_uca900_page0AA = weightsUCA_slice(data, 132864, 1792)
+	weightTable_uca900_page0AB = weightsUCA_slice(data, 134656, 1792)
+	weightTable_uca900_page0D7 = weightsUCA_slice(data, 136448, 2560)
+	weightTable_uca900_page0F9 = weightsUCA_slice(data, 139008, 1792)
+	weightTable_uca900_page0FA = weightsUCA_slice(data, 140800, 1792)
+	weightTable_uca900_page0FB = weightsUCA_slice(data, 142592, 2560)
+	weightTable_uca900_page0FC = weightsUCA_slice(data, 145152, 1792)
+	weightTable_uca900_page0FD = weightsUCA_slice(data, 146944, 6400)
+	weightTable_uca900_page0FE = weightsUCA_slice(data, 153344, 2560)
+	weightTable_uca900_page0FF = weightsUCA_slice(data, 155904, 1792)
+	weightTable_uca900_page100 = weightsUCA_slice(data, 157696, 1792)
+	weightTable_uca900_page101 = weightsUCA_slice(data, 159488, 1792)
+	weightTable_uca900_page102 = weightsUCA_slice(data, 161280, 1792)
+	weightTable_uca900_page103 = weightsUCA_slice(data, 163072, 1792)
+	weightTable_uca900_page104 = weightsUCA_slice(data, 164864, 1792)
+	weightTable_uca900_page105 = weightsUCA_slice(data, 166656, 1792)
+	weightTable_uca900_page106 = weightsUCA_slice(data, 168448, 1024)
+	weightTable_uca900_page107 = weightsUCA_slice(data, 169472, 1792)
+	weightTable_uca900_page108 = weightsUCA_slice(data, 171264, 1792)
+	weightTable_uca900_page109 = weightsUCA_slice(data, 173056, 1792)
+	weightTable_uca900_page10A = weightsUCA_slice(data, 174848, 1792)
+	weightTable_uca900_page10B = weightsUCA_slice(data, 176640, 1792)
+	weightTable_uca900_page10C = weightsUCA_slice(data, 178432, 1792)
+	weightTable_uca900_page10E = weightsUCA_slice(data, 180224, 1792)
+	weightTable_uca900_page110 = weightsUCA_slice(data, 182016, 1792)
+	weightTable_uca900_page111 = weightsUCA_slice(data, 183808, 1792)
+	weightTable_uca900_page112 = weightsUCA_slice(data, 185600, 1792)
+	weightTable_uca900_page113 = weightsUCA_slice(data, 187392, 1792)
+	weightTable_uca900_page114 = weightsUCA_slice(data, 189184, 1792)
+	weightTable_uca900_page115 = weightsUCA_slice(data, 190976, 1792)
+	weightTable_uca900_page116 = weightsUCA_slice(data, 192768, 1792)
+	weightTable_uca900_page117 = weightsUCA_slice(data, 194560, 1792)
+	weightTable_uca900_page118 = weightsUCA_slice(data, 196352, 1792)
+	weightTable_uca900_page11A = weightsUCA_slice(data, 198144, 1792)
+	weightTable_uca900_page11C = weightsUCA_slice(data, 199936, 1792)
+	weightTable_uca900_page120 = weightsUCA_slice(data, 201728, 1024)
+	weightTable_uca900_page121 = weightsUCA_slice(data, 202752, 1024)
+	weightTable_uca900_page122 = weightsUCA_slice(data, 203776, 1024)
+	weightTable_uca900_page123 = weightsUCA_slice(data, 204800, 1792)
+	weightTable_uca900_page124 = weightsUCA_slice(data, 206592, 1792)
+	weightTable_uca900_page125 = weightsUCA_slice(data, 208384, 1792)
+	weightTable_uca900_page130 = weightsUCA_slice(data, 210176, 1024)
+	weightTable_uca900_page131 = weightsUCA_slice(data, 211200, 1024)
+	weightTable_uca900_page132 = weightsUCA_slice(data, 212224, 1024)
+	weightTable_uca900_page133 = weightsUCA_slice(data, 213248, 1024)
+	weightTable_uca900_page134 = weightsUCA_slice(data, 214272, 1792)
+	weightTable_uca900_page144 = weightsUCA_slice(data, 216064, 1024)
+	weightTable_uca900_page145 = weightsUCA_slice(data, 217088, 1024)
+	weightTable_uca900_page146 = weightsUCA_slice(data, 218112, 1792)
+	weightTable_uca900_page168 = weightsUCA_slice(data, 219904, 1024)
+	weightTable_uca900_page169 = weightsUCA_slice(data, 220928, 1024)
+	weightTable_uca900_page16A = weightsUCA_slice(data, 221952, 1792)
+	weightTable_uca900_page16B = weightsUCA_slice(data, 223744, 1792)
+	weightTable_uca900_page16F = weightsUCA_slice(data, 225536, 1792)
+	weightTable_uca900_page1B0 = weightsUCA_slice(data, 227328, 1792)
+	weightTable_uca900_page1BC = weightsUCA_slice(data, 229120, 1792)
+	weightTable_uca900_page1D0 = weightsUCA_slice(data, 230912, 1792)
+	weightTable_uca900_page1D1 = weightsUCA_slice(data, 232704, 2560)
+	weightTable_uca900_page1D2 = weightsUCA_slice(data, 235264, 1792)
+	weightTable_uca900_page1D3 = weightsUCA_slice(data, 237056, 1792)
+	weightTable_uca900_page1D4 = weightsUCA_slice(data, 238848, 1792)
+	weightTable_uca900_page1D5 = weightsUCA_slice(data, 240640, 1792)
+	weightTable_uca900_page1D6 = weightsUCA_slice(data, 242432, 1792)
+	weightTable_uca900_page1D7 = weightsUCA_slice(data, 244224, 1792)
+	weightTable_uca900_page1D8 = weightsUCA_slice(data, 246016, 1024)
+	weightTable_uca900_page1D9 = weightsUCA_slice(data, 247040, 1024)
+	weightTable_uca900_page1DA = weightsUCA_slice(data, 248064, 1792)
+	weightTable_uca900_page1E0 = weightsUCA_slice(data, 249856, 1792)
+	weightTable_uca900_page1E8 = weightsUCA_slice(data, 251648, 1792)
+	weightTable_uca900_page1E9 = weightsUCA_slice(data, 253440, 1792)
+	weightTable_uca900_page1EE = weightsUCA_slice(data, 255232, 1792)
+	weightTable_uca900_page1F0 = weightsUCA_slice(data, 257024, 1792)
+	weightTable_uca900_page1F1 = weightsUCA_slice(data, 258816, 6400)
+	weightTable_uca900_page1F2 = weightsUCA_slice(data, 265216, 3328)
+	weightTable_uca900_page1F3 = weightsUCA_slice(data, 268544, 1024)
+	weightTable_uca900_page1F4 = weightsUCA_slice(data, 269568, 1024)
+	weightTable_uca900_page1F5 = weightsUCA_slice(data, 270592, 1024)
+	weightTable_uca900_page1F6 = weightsUCA_slice(data, 271616, 1792)
+	weightTable_uca900_page1F7 = weightsUCA_slice(data, 273408, 1792)
+	weightTable_uca900_page1F8 = weightsUCA_slice(data, 275200, 1792)
+	weightTable_uca900_page1F9 = weightsUCA_slice(data, 276992, 1792)
+	weightTable_uca900_page2F8 = weightsUCA_slice(data, 278784, 1792)
+	weightTable_uca900_page2F9 = weightsUCA_slice(data, 280576, 1792)
+	weightTable_uca900_page2FA = weightsUCA_slice(data, 282368, 1792)
+	weightTable_uca900_pageE00 = weightsUCA_slice(data, 284160, 1792)
+	weightTable_uca900_pageE01 = weightsUCA_slice(data, 285952, 1792)
+	weightTable_uca900_ja_page030 = weightsUCA_slice(data, 287744, 2560)
+	weightTable_uca900_ja_page031 = weightsUCA_slice(data, 290304, 1792)
+	weightTable_uca900_ja_page04E = weightsUCA_slice(data, 292096, 1792)
+	weightTable_uca900_ja_page04F = weightsUCA_slice(data, 293888, 1792)
+	weightTable_uca900_ja_page050 = weightsUCA_slice(data, 295680, 1792)
+	weightTable_uca900_ja_page051 = weightsUCA_slice(data, 297472, 1792)
+	weightTable_uca900_ja_page052 = weightsUCA_slice(data, 299264, 1792)
+	weightTable_uca900_ja_page053 = weightsUCA_slice(data, 301056, 1792)
+	weightTable_uca900_ja_page054 = weightsUCA_slice(data, 302848, 1792)
+	weightTable_uca900_ja_page055 = weightsUCA_slice(data, 304640, 1792)
+	weightTable_uca900_ja_page056 = weightsUCA_slice(data, 306432, 1792)
+	weightTable_uca900_ja_page057 = weightsUCA_slice(data, 308224, 1792)
+	weightTable_uca900_ja_page058 = weightsUCA_slice(data, 310016, 1792)
+	weightTable_uca900_ja_page059 = weightsUCA_slice(data, 311808, 1792)
+	weightTable_uca900_ja_page05A = weightsUCA_slice(data, 313600, 1792)
+	weightTable_uca900_ja_page05B = weightsUCA_slice(data, 315392, 1792)
+	weightTable_uca900_ja_page05C = weightsUCA_slice(data, 317184, 1792)
+	weightTable_uca900_ja_page05D = weightsUCA_slice(data, 318976, 1792)
+	weightTable_uca900_ja_page05E = weightsUCA_slice(data, 320768, 1792)
+	weightTable_uca900_ja_page05F = weightsUCA_slice(data, 322560, 1792)
+	weightTable_uca900_ja_page060 = weightsUCA_slice(data, 324352, 1792)
+	weightTable_uca900_ja_page061 = weightsUCA_slice(data, 326144, 1792)
+	weightTable_uca900_ja_page062 = weightsUCA_slice(data, 327936, 1792)
+	weightTable_uca900_ja_page063 = weightsUCA_slice(data, 329728, 1792)
+	weightTable_uca900_ja_page064 = weightsUCA_slice(data, 331520, 1792)
+	weightTable_uca900_ja_page065 = weightsUCA_slice(data, 333312, 1792)
+	weightTable_uca900_ja_page066 = weightsUCA_slice(data, 335104, 1792)
+	weightTable_uca900_ja_page067 = weightsUCA_slice(data, 336896, 1792)
+	weightTable_uca900_ja_page068 = weightsUCA_slice(data, 338688, 1792)
+	weightTable_uca900_ja_page069 = weightsUCA_slice(data, 340480, 1792)
+	weightTable_uca900_ja_page06A = weightsUCA_slice(data, 342272, 1792)
+	weightTable_uca900_ja_page06B = weightsUCA_slice(data, 344064, 1792)
+	weightTable_uca900_ja_page06C = weightsUCA_slice(data, 345856, 1792)
+	weightTable_uca900_ja_page06D = weightsUCA_slice(data, 347648, 1792)
+	weightTable_uca900_ja_page06E = weightsUCA_slice(data, 349440, 1792)
+	weightTable_uca900_ja_page06F = weightsUCA_slice(data, 351232, 1792)
+	weightTable_uca900_ja_page070 = weightsUCA_slice(data, 353024, 1792)
+	weightTable_uca900_ja_page071 = weightsUCA_slice(data, 354816, 1792)
+	weightTable_uca900_ja_page072 = weightsUCA_slice(data, 356608, 1792)
+	weightTable_uca900_ja_page073 = weightsUCA_slice(data, 358400, 1792)
+	weightTable_uca900_ja_page074 = weightsUCA_slice(data, 360192, 1792)
+	weightTable_uca900_ja_page075 = weightsUCA_slice(data, 361984, 1792)
+	weightTable_uca900_ja_page076 = weightsUCA_slice(data, 363776, 1792)
+	weightTable_uca900_ja_page077 = weightsUCA_slice(data, 365568, 1792)
+	weightTable_uca900_ja_page078 = weightsUCA_slice(data, 367360, 1792)
+	weightTable_uca900_ja_page079 = weightsUCA_slice(data, 369152, 1792)
+	weightTable_uca900_ja_page07A = weightsUCA_slice(data, 370944, 1792)
+	weightTable_uca900_ja_page07B = weightsUCA_slice(data, 372736, 1792)
+	weightTable_uca900_ja_page07C = weightsUCA_slice(data, 374528, 1792)
+	weightTable_uca900_ja_page07D = weightsUCA_slice(data, 376320, 1792)
+	weightTable_uca900_ja_page07E = weightsUCA_slice(data, 378112, 1792)
+	weightTable_uca900_ja_page07F = weightsUCA_slice(data, 379904, 1792)
+	weightTable_uca900_ja_page080 = weightsUCA_slice(data, 381696, 1792)
+	weightTable_uca900_ja_page081 = weightsUCA_slice(data, 383488, 1792)
+	weightTable_uca900_ja_page082 = weightsUCA_slice(data, 385280, 1792)
+	weightTable_uca900_ja_page083 = weightsUCA_slice(data, 387072, 1792)
+	weightTable_uca900_ja_page084 = weightsUCA_slice(data, 388864, 1792)
+	weightTable_uca900_ja_page085 = weightsUCA_slice(data, 390656, 1792)
+	weightTable_uca900_ja_page086 = weightsUCA_slice(data, 392448, 1792)
+	weightTable_uca900_ja_page087 = weightsUCA_slice(data, 394240, 1792)
+	weightTable_uca900_ja_page088 = weightsUCA_slice(data, 396032, 1792)
+	weightTable_uca900_ja_page089 = weightsUCA_slice(data, 397824, 1792)
+	weightTable_uca900_ja_page08A = weightsUCA_slice(data, 399616, 1792)
+	weightTable_uca900_ja_page08B = weightsUCA_slice(data, 401408, 1792)
+	weightTable_uca900_ja_page08C = weightsUCA_slice(data, 403200, 1792)
+	weightTable_uca900_ja_page08D = weightsUCA_slice(data, 404992, 1792)
+	weightTable_uca900_ja_page08E = weightsUCA_slice(data, 406784, 1792)
+	weightTable_uca900_ja_page08F = weightsUCA_slice(data, 408576, 1792)
+	weightTable_uca900_ja_page090 = weightsUCA_slice(data, 410368, 1792)
+	weightTable_uca900_ja_page091 = weightsUCA_slice(data, 412160, 1792)
+	weightTable_uca900_ja_page092 = weightsUCA_slice(data, 413952, 1792)
+	weightTable_uca900_ja_page093 = weightsUCA_slice(data, 415744, 1792)
+	weightTable_uca900_ja_page094 = weightsUCA_slice(data, 417536, 1792)
+	weightTable_uca900_ja_page095 = weightsUCA_slice(data, 419328, 1792)
+	weightTable_uca900_ja_page096 = weightsUCA_slice(data, 421120, 1792)
+	weightTable_uca900_ja_page097 = weightsUCA_slice(data, 422912, 1792)
+	weightTable_uca900_ja_page098 = weightsUCA_slice(data, 424704, 1792)
+	weightTable_uca900_ja_page099 = weightsUCA_slice(data, 426496, 1792)
+	weightTable_uca900_ja_page09A = weightsUCA_slice(data, 428288, 1792)
+	weightTable_uca900_ja_page09B = weightsUCA_slice(data, 430080, 1792)
+	weightTable_uca900_ja_page09C = weightsUCA_slice(data, 431872, 1792)
+	weightTable_uca900_ja_page09D = weightsUCA_slice(data, 433664, 1792)
+	weightTable_uca900_ja_page09E = weightsUCA_slice(data, 435456, 1792)
+	weightTable_uca900_ja_page09F = weightsUCA_slice(data, 437248, 1792)
+	weightTable_uca900_ja_page0FF = weightsUCA_slice(data, 439040, 1792)
+	weightTable_uca900_zh_page000 = weightsUCA_slice(data, 440832, 2560)
+	weightTable_uca900_zh_page001 = weightsUCA_slice(data, 443392, 3328)
+	weightTable_uca900_zh_page002 = weightsUCA_slice(data, 446720, 2560)
+	weightTable_uca900_zh_page003 = weightsUCA_slice(data, 449280, 2560)
+	weightTable_uca900_zh_page004 = weightsUCA_slice(data, 451840, 1792)
+	weightTable_uca900_zh_page005 = weightsUCA_slice(data, 453632, 1792)
+	weightTable_uca900_zh_page006 = weightsUCA_slice(data, 455424, 1792)
+	weightTable_uca900_zh_page007 = weightsUCA_slice(data, 457216, 1792)
+	weightTable_uca900_zh_page008 = weightsUCA_slice(data, 459008, 1792)
+	weightTable_uca900_zh_page009 = weightsUCA_slice(data, 460800, 1792)
+	weightTable_uca900_zh_page00A = weightsUCA_slice(data, 462592, 1792)
+	weightTable_uca900_zh_page00B = weightsUCA_slice(data, 464384, 1792)
+	weightTable_uca900_zh_page00C = weightsUCA_slice(data, 466176, 1792)
+	weightTable_uca900_zh_page00D = weightsUCA_slice(data, 467968, 1792)
+	weightTable_uca900_zh_page00E = weightsUCA_slice(data, 469760, 1792)
+	weightTable_uca900_zh_page00F = weightsUCA_slice(data, 471552, 2560)
+	weightTable_uca900_zh_page010 = weightsUCA_slice(data, 474112, 2560)
+	weightTable_uca900_zh_page011 = weightsUCA_slice(data, 476672, 1024)
+	weightTable_uca900_zh_page012 = weightsUCA_slice(data, 477696, 1792)
+	weightTable_uca900_zh_page013 = weightsUCA_slice(data, 479488, 1792)
+	weightTable_uca900_zh_page014 = weightsUCA_slice(data, 481280, 1024)
+	weightTable_uca900_zh_page015 = weightsUCA_slice(data, 482304, 1024)
+	weightTable_uca900_zh_page016 = weightsUCA_slice(data, 483328, 3328)
+	weightTable_uca900_zh_page017 = weightsUCA_slice(data, 486656, 1792)
+	weightTable_uca900_zh_page018 = weightsUCA_slice(data, 488448, 1792)
+	weightTable_uca900_zh_page019 = weightsUCA_slice(data, 490240, 2560)
+	weightTable_uca900_zh_page01A = weightsUCA_slice(data, 492800, 2560)
+	weightTable_uca900_zh_page01B = weightsUCA_slice(data, 495360, 1792)
+	weightTable_uca900_zh_page01C = weightsUCA_slice(data, 497152, 1792)
+	weightTable_uca900_zh_page01D = weightsUCA_slice(data, 498944, 2560)
+	weightTable_uca900_zh_page01E = weightsUCA_slice(data, 501504, 2560)
+	weightTable_uca900_zh_page01F = weightsUCA_slice(data, 504064, 3328)
+	weightTable_uca900_zh_page020 = weightsUCA_slice(data, 507392, 3328)
+	weightTable_uca900_zh_page021 = weightsUCA_slice(data, 510720, 3328)
+	weightTable_uca900_zh_page023 = weightsUCA_slice(data, 514048, 1792)
+	weightTable_uca900_zh_page024 = weightsUCA_slice(data, 515840, 3328)
+	weightTable_uca900_zh_page02B = weightsUCA_slice(data, 519168, 1792)
+	weightTable_uca900_zh_page02C = weightsUCA_slice(data, 520960, 2560)
+	weightTable_uca900_zh_page02D = weightsUCA_slice(data, 523520, 1792)
+	weightTable_uca900_zh_page02E = weightsUCA_slice(data, 525312, 2560)
+	weightTable_uca900_zh_page02F = weightsUCA_slice(data, 527872, 1792)
+	weightTable_uca900_zh_page030 = weightsUCA_slice(data, 529664, 1792)
+	weightTable_uca900_zh_page031 = weightsUCA_slice(data, 531456, 1792)
+	weightTable_uca900_zh_page032 = weightsUCA_slice(data, 533248, 5632)
+	weightTable_uca900_zh_page033 = weightsUCA_slice(data, 538880, 4864)
+	weightTable_uca900_zh_page034 = weightsUCA_slice(data, 543744, 1792)
+	weightTable_uca900_zh_page035 = weightsUCA_slice(data, 545536, 1792)
+	weightTable_uca900_zh_page036 = weightsUCA_slice(data, 547328, 1792)
+	weightTable_uca900_zh_page037 = weightsUCA_slice(data, 549120, 1792)
+	weightTable_uca900_zh_page038 = weightsUCA_slice(data, 550912, 1792)
+	weightTable_uca900_zh_page039 = weightsUCA_slice(data, 552704, 1792)
+	weightTable_uca900_zh_page03A = weightsUCA_slice(data, 554496, 1792)
+	weightTable_uca900_zh_page03B = weightsUCA_slice(data, 556288, 1792)
+	weightTable_uca900_zh_page03C = weightsUCA_slice(data, 558080, 1792)
+	weightTable_uca900_zh_page03D = weightsUCA_slice(data, 559872, 1792)
+	weightTable_uca900_zh_page03E = weightsUCA_slice(data, 561664, 1792)
+	weightTable_uca900_zh_page03F = weightsUCA_slice(data, 563456, 1792)
+	weightTable_uca900_zh_page040 = weightsUCA_slice(data, 565248, 1792)
+	weightTable_uca900_zh_page041 = weightsUCA_slice(data, 567040, 1792)
+	weightTable_uca900_zh_page042 = weightsUCA_slice(data, 568832, 1792)
+	weightTable_uca900_zh_page043 = weightsUCA_slice(data, 570624, 1792)
+	weightTable_uca900_zh_page044 = weightsUCA_slice(data, 572416, 1792)
+	weightTable_uca900_zh_page045 = weightsUCA_slice(data, 574208, 1792)
+	weightTable_uca900_zh_page046 = weightsUCA_slice(data, 576000, 1792)
+	weightTable_uca900_zh_page047 = weightsUCA_slice(data, 577792, 1792)
+	weightTable_uca900_zh_page048 = weightsUCA_slice(data, 579584, 1792)
+	weightTable_uca900_zh_page049 = weightsUCA_slice(data, 581376, 1792)
+	weightTable_uca900_zh_page04A = weightsUCA_slice(data, 583168, 1792)
+	weightTable_uca900_zh_page04B = weightsUCA_slice(data, 584960, 1792)
+	weightTable_uca900_zh_page04C = weightsUCA_slice(data, 586752, 1792)
+	weightTable_uca900_zh_page04D = weightsUCA_slice(data, 588544, 1792)
+	weightTable_uca900_zh_page04E = weightsUCA_slice(data, 590336, 1024)
+	weightTable_uca900_zh_page04F = weightsUCA_slice(data, 591360, 1024)
+	weightTable_uca900_zh_page050 = weightsUCA_slice(data, 592384, 1024)
+	weightTable_uca900_zh_page051 = weightsUCA_slice(data, 593408, 1792)
+	weightTable_uca900_zh_page052 = weightsUCA_slice(data, 595200, 1024)
+	weightTable_uca900_zh_page053 = weightsUCA_slice(data, 596224, 1024)
+	weightTable_uca900_zh_page054 = weightsUCA_slice(data, 597248, 1024)
+	weightTable_uca900_zh_page055 = weightsUCA_slice(data, 598272, 1792)
+	weightTable_uca900_zh_page056 = weightsUCA_slice(data, 600064, 1024)
+	weightTable_uca900_zh_page057 = weightsUCA_slice(data, 601088, 1024)
+	weightTable_uca900_zh_page058 = weightsUCA_slice(data, 602112, 1024)
+	weightTable_uca900_zh_page059 = weightsUCA_slice(data, 603136, 1024)
+	weightTable_uca900_zh_page05A = weightsUCA_slice(data, 604160, 1024)
+	weightTable_uca900_zh_page05B = weightsUCA_slice(data, 605184, 1024)
+	weightTable_uca900_zh_page05C = weightsUCA_slice(data, 606208, 1024)
+	weightTable_uca900_zh_page05D = weightsUCA_slice(data, 607232, 1024)
+	weightTable_uca900_zh_page05E = weightsUCA_slice(data, 608256, 1024)
+	weightTable_uca900_zh_page05F = weightsUCA_slice(data, 609280, 1024)
+	weightTable_uca900_zh_page060 = weightsUCA_slice(data, 610304, 1024)
+	weightTable_uca900_zh_page061 = weightsUCA_slice(data, 611328, 1024)
+	weightTable_uca900_zh_page062 = weightsUCA_slice(data, 612352, 1024)
+	weightTable_uca900_zh_page063 = weightsUCA_slice(data, 613376, 1024)
+	weightTable_uca900_zh_page064 = weightsUCA_slice(data, 614400, 1024)
+	weightTable_uca900_zh_page065 = weightsUCA_slice(data, 615424, 1024)
+	weightTable_uca900_zh_page066 = weightsUCA_slice(data, 616448, 1024)
+	weightTable_uca900_zh_page067 = weightsUCA_slice(data, 617472, 1024)
+	weightTable_uca900_zh_page068 = weightsUCA_slice(data, 618496, 1792)
+	weightTable_uca900_zh_page069 = weightsUCA_slice(data, 620288, 1024)
+	weightTable_uca900_zh_page06A = weightsUCA_slice(data, 621312, 1024)
+	weightTable_uca900_zh_page06B = weightsUCA_slice(data, 622336, 1024)
+	weightTable_uca900_zh_page06C = weightsUCA_slice(data, 623360, 1024)
+	weightTable_uca900_zh_page06D = weightsUCA_slice(data, 624384, 1024)
+	weightTable_uca900_zh_page06E = weightsUCA_slice(data, 625408, 1024)
+	weightTable_uca900_zh_page06F = weightsUCA_slice(data, 626432, 1024)
+	weightTable_uca900_zh_page070 = weightsUCA_slice(data, 627456, 1792)
+	weightTable_uca900_zh_page071 = weightsUCA_slice(data, 629248, 1024)
+	weightTable_uca900_zh_page072 = weightsUCA_slice(data, 630272, 1024)
+	weightTable_uca900_zh_page073 = weightsUCA_slice(data, 631296, 1024)
+	weightTable_uca900_zh_page074 = weightsUCA_slice(data, 632320, 1792)
+	weightTable_uca900_zh_page075 = weightsUCA_slice(data, 634112, 1792)
+	weightTable_uca900_zh_page076 = weightsUCA_slice(data, 635904, 1024)
+	weightTable_uca900_zh_page077 = weightsUCA_slice(data, 636928, 1024)
+	weightTable_uca900_zh_page078 = weightsUCA_slice(data, 637952, 1024)
+	weightTable_uca900_zh_page079 = weightsUCA_slice(data, 638976, 1024)
+	weightTable_uca900_zh_page07A = weightsUCA_slice(data, 640000, 1024)
+	weightTable_uca900_zh_page07B = weightsUCA_slice(data, 641024, 1024)
+	weightTable_uca900_zh_page07C = weightsUCA_slice(data, 642048, 1024)
+	weightTable_uca900_zh_page07D = weightsUCA_slice(data, 643072, 1024)
+	weightTable_uca900_zh_page07E = weightsUCA_slice(data, 644096, 1024)
+	weightTable_uca900_zh_page07F = weightsUCA_slice(data, 645120, 1024)
+	weightTable_uca900_zh_page080 = weightsUCA_slice(data, 646144, 1024)
+	weightTable_uca900_zh_page081 = weightsUCA_slice(data, 647168, 1024)
+	weightTable_uca900_zh_page082 = weightsUCA_slice(data, 648192, 1024)
+	weightTable_uca900_zh_page083 = weightsUCA_slice(data, 649216, 1024)
+	weightTable_uca900_zh_page084 = weightsUCA_slice(data, 650240, 1024)
+	weightTable_uca900_zh_page085 = weightsUCA_slice(data, 651264, 1024)
+	weightTable_uca900_zh_page086 = weightsUCA_slice(data, 652288, 1024)
+	weightTable_uca900_zh_page087 = weightsUCA_slice(data, 653312, 1024)
+	weightTable_uca900_zh_page088 = weightsUCA_slice(data, 654336, 1024)
+	weightTable_uca900_zh_page089 = weightsUCA_slice(data, 655360, 1024)
+	weightTable_uca900_zh_page08A = weightsUCA_slice(data, 656384, 1024)
+	weightTable_uca900_zh_page08B = weightsUCA_slice(data, 657408, 1024)
+	weightTable_uca900_zh_page08C = weightsUCA_slice(data, 658432, 1024)
+	weightTable_uca900_zh_page08D = weightsUCA_slice(data, 659456, 1024)
+	weightTable_uca900_zh_page08E = weightsUCA_slice(data, 660480, 1024)
+	weightTable_uca900_zh_page08F = weightsUCA_slice(data, 661504, 1024)
+	weightTable_uca900_zh_page090 = weightsUCA_slice(data, 662528, 1024)
+	weightTable_uca900_zh_page091 = weightsUCA_slice(data, 663552, 1024)
+	weightTable_uca900_zh_page092 = weightsUCA_slice(data, 664576, 1024)
+	weightTable_uca900_zh_page093 = weightsUCA_slice(data, 665600, 1024)
+	weightTable_uca900_zh_page094 = weightsUCA_slice(data, 666624, 1024)
+	weightTable_uca900_zh_page095 = weightsUCA_slice(data, 667648, 1024)
+	weightTable_uca900_zh_page096 = weightsUCA_slice(data, 668672, 1024)
+	weightTable_uca900_zh_page097 = weightsUCA_slice(data, 669696, 1024)
+	weightTable_uca900_zh_page098 = weightsUCA_slice(data, 670720, 1024)
+	weightTable_uca900_zh_page099 = weightsUCA_slice(data, 671744, 1024)
+	weightTable_uca900_zh_page09A = weightsUCA_slice(data, 672768, 1024)
+	weightTable_uca900_zh_page09B = weightsUCA_slice(data, 673792, 1024)
+	weightTable_uca900_zh_page09C = weightsUCA_slice(data, 674816, 1024)
+	weightTable_uca900_zh_page09D = weightsUCA_slice(data, 675840, 1024)
+	weightTable_uca900_zh_page09E = weightsUCA_slice(data, 676864, 1024)
+	weightTable_uca900_zh_page09F = weightsUCA_slice(data, 677888, 1792)
+	weightTable_uca900_zh_page0A0 = weightsUCA_slice(data, 679680, 1024)
+	weightTable_uca900_zh_page0A1 = weightsUCA_slice(data, 680704, 1024)
+	weightTable_uca900_zh_page0A2 = weightsUCA_slice(data, 681728, 1024)
+	weightTable_uca900_zh_page0A3 = weightsUCA_slice(data, 682752, 1024)
+	weightTable_uca900_zh_page0A4 = weightsUCA_slice(data, 683776, 1792)
+	weightTable_uca900_zh_page0A5 = weightsUCA_slice(data, 685568, 1024)
+	weightTable_uca900_zh_page0A6 = weightsUCA_slice(data, 686592, 1792)
+	weightTable_uca900_zh_page0A7 = weightsUCA_slice(data, 688384, 2560)
+	weightTable_uca900_zh_page0A8 = weightsUCA_slice(data, 690944, 1792)
+	weightTable_uca900_zh_page0A9 = weightsUCA_slice(data, 692736, 1792)
+	weightTable_uca900_zh_page0AA = weightsUCA_slice(data, 694528, 1792)
+	weightTable_uca900_zh_page0AB = weightsUCA_slice(data, 696320, 1792)
+	weightTable_uca900_zh_page0D7 = weightsUCA_slice(data, 698112, 2560)
+	weightTable_uca900_zh_page0F9 = weightsUCA_slice(data, 700672, 1792)
+	weightTable_uca900_zh_page0FA = weightsUCA_slice(data, 702464, 1792)
+	weightTable_uca900_zh_page0FB = weightsUCA_slice(data, 704256, 2560)
+	weightTable_uca900_zh_page0FC = weightsUCA_slice(data, 706816, 1792)
+	weightTable_uca900_zh_page0FD = weightsUCA_slice(data, 708608, 6400)
+	weightTable_uca900_zh_page0FE = weightsUCA_slice(data, 715008, 2560)
+	weightTable_uca900_zh_page0FF = weightsUCA_slice(data, 717568, 1792)
+	weightTable_uca900_zh_page100 = weightsUCA_slice(data, 719360, 1792)
+	weightTable_uca900_zh_page101 = weightsUCA_slice(data, 721152, 1792)
+	weightTable_uca900_zh_page102 = weightsUCA_slice(data, 722944, 1792)
+	weightTable_uca900_zh_page103 = weightsUCA_slice(data, 724736, 1792)
+	weightTable_uca900_zh_page104 = weightsUCA_slice(data, 726528, 1792)
+	weightTable_uca900_zh_page105 = weightsUCA_slice(data, 728320, 1792)
+	weightTable_uca900_zh_page106 = weightsUCA_slice(data, 730112, 1024)
+	weightTable_uca900_zh_page107 = weightsUCA_slice(data, 731136, 1792)
+	weightTable_uca900_zh_page108 = weightsUCA_slice(data, 732928, 1792)
+	weightTable_uca900_zh_page109 = weightsUCA_slice(data, 734720, 1792)
+	weightTable_uca900_zh_page10A = weightsUCA_slice(data, 736512, 1792)
+	weightTable_uca900_zh_page10B = weightsUCA_slice(data, 738304, 1792)
+	weightTable_uca900_zh_page10C = weightsUCA_slice(data, 740096, 1792)
+	weightTable_uca900_zh_page10E = weightsUCA_slice(data, 741888, 1792)
+	weightTable_uca900_zh_page110 = weightsUCA_slice(data, 743680, 1792)
+	weightTable_uca900_zh_page111 = weightsUCA_slice(data, 745472, 1792)
+	weightTable_uca900_zh_page112 = weightsUCA_slice(data, 747264, 1792)
+	weightTable_uca900_zh_page113 = weightsUCA_slice(data, 749056, 1792)
+	weightTable_uca900_zh_page114 = weightsUCA_slice(data, 750848, 1792)
+	weightTable_uca900_zh_page115 = weightsUCA_slice(data, 752640, 1792)
+	weightTable_uca900_zh_page116 = weightsUCA_slice(data, 754432, 1792)
+	weightTable_uca900_zh_page117 = weightsUCA_slice(data, 756224, 1792)
+	weightTable_uca900_zh_page118 = weightsUCA_slice(data, 758016, 1792)
+	weightTable_uca900_zh_page11A = weightsUCA_slice(data, 759808, 1792)
+	weightTable_uca900_zh_page11C = weightsUCA_slice(data, 761600, 1792)
+	weightTable_uca900_zh_page120 = weightsUCA_slice(data, 763392, 1024)
+	weightTable_uca900_zh_page121 = weightsUCA_slice(data, 764416, 1024)
+	weightTable_uca900_zh_page122 = weightsUCA_slice(data, 765440, 1024)
+	weightTable_uca900_zh_page123 = weightsUCA_slice(data, 766464, 1792)
+	weightTable_uca900_zh_page124 = weightsUCA_slice(data, 768256, 1792)
+	weightTable_uca900_zh_page125 = weightsUCA_slice(data, 770048, 1792)
+	weightTable_uca900_zh_page130 = weightsUCA_slice(data, 771840, 1024)
+	weightTable_uca900_zh_page131 = weightsUCA_slice(data, 772864, 1024)
+	weightTable_uca900_zh_page132 = weightsUCA_slice(data, 773888, 1024)
+	weightTable_uca900_zh_page133 = weightsUCA_slice(data, 774912, 1024)
+	weightTable_uca900_zh_page134 = weightsUCA_slice(data, 775936, 1792)
+	weightTable_uca900_zh_page144 = weightsUCA_slice(data, 777728, 1024)
+	weightTable_uca900_zh_page145 = weightsUCA_slice(data, 778752, 1024)
+	weightTable_uca900_zh_page146 = weightsUCA_slice(data, 779776, 1792)
+	weightTable_uca900_zh_page168 = weightsUCA_slice(data, 781568, 1024)
+	weightTable_uca900_zh_page169 = weightsUCA_slice(data, 782592, 1024)
+	weightTable_uca900_zh_page16A = weightsUCA_slice(data, 783616, 1792)
+	weightTable_uca900_zh_page16B = weightsUCA_slice(data, 785408, 1792)
+	weightTable_uca900_zh_page16F = weightsUCA_slice(data, 787200, 1792)
+	weightTable_uca900_zh_page1B0 = weightsUCA_slice(data, 788992, 1792)
+	weightTable_uca900_zh_page1BC = weightsUCA_slice(data, 790784, 1792)
+	weightTable_uca900_zh_page1D0 = weightsUCA_slice(data, 792576, 1792)
+	weightTable_uca900_zh_page1D1 = weightsUCA_slice(data, 794368, 2560)
+	weightTable_uca900_zh_page1D2 = weightsUCA_slice(data, 796928, 1792)
+	weightTable_uca900_zh_page1D3 = weightsUCA_slice(data, 798720, 1792)
+	weightTable_uca900_zh_page1D4 = weightsUCA_slice(data, 800512, 1792)
+	weightTable_uca900_zh_page1D5 = weightsUCA_slice(data, 802304, 1792)
+	weightTable_uca900_zh_page1D6 = weightsUCA_slice(data, 804096, 1792)
+	weightTable_uca900_zh_page1D7 = weightsUCA_slice(data, 805888, 1792)
+	weightTable_uca900_zh_page1DA = weightsUCA_slice(data, 807680, 1792)
+	weightTable_uca900_zh_page1E0 = weightsUCA_slice(data, 809472, 1792)
+	weightTable_uca900_zh_page1E8 = weightsUCA_slice(data, 811264, 1792)
+	weightTable_uca900_zh_page1E9 = weightsUCA_slice(data, 813056, 1792)
+	weightTable_uca900_zh_page1EE = weightsUCA_slice(data, 814848, 1792)
+	weightTable_uca900_zh_page1F0 = weightsUCA_slice(data, 816640, 1792)
+	weightTable_uca900_zh_page1F1 = weightsUCA_slice(data, 818432, 6400)
+	weightTable_uca900_zh_page1F2 = weightsUCA_slice(data, 824832, 3328)
+	weightTable_uca900_zh_page1F6 = weightsUCA_slice(data, 828160, 1792)
+	weightTable_uca900_zh_page1F7 = weightsUCA_slice(data, 829952, 1792)
+	weightTable_uca900_zh_page1F8 = weightsUCA_slice(data, 831744, 1792)
+	weightTable_uca900_zh_page1F9 = weightsUCA_slice(data, 833536, 1792)
+	weightTable_uca900_zh_page200 = weightsUCA_slice(data, 835328, 1792)
+	weightTable_uca900_zh_page201 = weightsUCA_slice(data, 837120, 1792)
+	weightTable_uca900_zh_page202 = weightsUCA_slice(data, 838912, 1792)
+	weightTable_uca900_zh_page203 = weightsUCA_slice(data, 840704, 1792)
+	weightTable_uca900_zh_page204 = weightsUCA_slice(data, 842496, 1792)
+	weightTable_uca900_zh_page205 = weightsUCA_slice(data, 844288, 1792)
+	weightTable_uca900_zh_page206 = weightsUCA_slice(data, 846080, 1792)
+	weightTable_uca900_zh_page207 = weightsUCA_slice(data, 847872, 1792)
+	weightTable_uca900_zh_page208 = weightsUCA_slice(data, 849664, 1792)
+	weightTable_uca900_zh_page209 = weightsUCA_slice(data, 851456, 1792)
+	weightTable_uca900_zh_page20A = weightsUCA_slice(data, 853248, 1792)
+	weightTable_uca900_zh_page20B = weightsUCA_slice(data, 855040, 1792)
+	weightTable_uca900_zh_page20C = weightsUCA_slice(data, 856832, 1792)
+	weightTable_uca900_zh_page20D = weightsUCA_slice(data, 858624, 1792)
+	weightTable_uca900_zh_page20E = weightsUCA_slice(data, 860416, 1792)
+	weightTable_uca900_zh_page20F = weightsUCA_slice(data, 862208, 1792)
+	weightTable_uca900_zh_page210 = weightsUCA_slice(data, 864000, 1792)
+	weightTable_uca900_zh_page211 = weightsUCA_slice(data, 865792, 1792)
+	weightTable_uca900_zh_page212 = weightsUCA_slice(data, 867584, 1792)
+	weightTable_uca900_zh_page213 = weightsUCA_slice(data, 869376, 1792)
+	weightTable_uca900_zh_page214 = weightsUCA_slice(data, 871168, 1792)
+	weightTable_uca900_zh_page215 = weightsUCA_slice(data, 872960, 1792)
+	weightTable_uca900_zh_page216 = weightsUCA_slice(data, 874752, 1792)
+	weightTable_uca900_zh_page217 = weightsUCA_slice(data, 876544, 1792)
+	weightTable_uca900_zh_page218 = weightsUCA_slice(data, 878336, 1792)
+	weightTable_uca900_zh_page219 = weightsUCA_slice(data, 880128, 1792)
+	weightTable_uca900_zh_page21A = weightsUCA_slice(data, 881920, 1792)
+	weightTable_uca900_zh_page21B = weightsUCA_slice(data, 883712, 1792)
+	weightTable_uca900_zh_page21C = weightsUCA_slice(data, 885504, 1792)
+	weightTable_uca900_zh_page21D = weightsUCA_slice(data, 887296, 1792)
+	weightTable_uca900_zh_page21E = weightsUCA_slice(data, 889088, 1792)
+	weightTable_uca900_zh_page21F = weightsUCA_slice(data, 890880, 1792)
+	weightTable_uca900_zh_page220 = weightsUCA_slice(data, 892672, 1792)
+	weightTable_uca900_zh_page221 = weightsUCA_slice(data, 894464, 1792)
+	weightTable_uca900_zh_page222 = weightsUCA_slice(data, 896256, 1792)
+	weightTable_uca900_zh_page223 = weightsUCA_slice(data, 898048, 1792)
+	weightTable_uca900_zh_page224 = weightsUCA_slice(data, 899840, 1792)
+	weightTable_uca900_zh_page225 = weightsUCA_slice(data, 901632, 1792)
+	weightTable_uca900_zh_page226 = weightsUCA_slice(data, 903424, 1792)
+	weightTable_uca900_zh_page227 = weightsUCA_slice(data, 905216, 1792)
+	weightTable_uca900_zh_page228 = weightsUCA_slice(data, 907008, 1792)
+	weightTable_uca900_zh_page229 = weightsUCA_slice(data, 908800, 1792)
+	weightTable_uca900_zh_page22A = weightsUCA_slice(data, 910592, 1792)
+	weightTable_uca900_zh_page22B = weightsUCA_slice(data, 912384, 1792)
+	weightTable_uca900_zh_page22C = weightsUCA_slice(data, 914176, 1792)
+	weightTable_uca900_zh_page22D = weightsUCA_slice(data, 915968, 1792)
+	weightTable_uca900_zh_page22E = weightsUCA_slice(data, 917760, 1792)
+	weightTable_uca900_zh_page22F = weightsUCA_slice(data, 919552, 1792)
+	weightTable_uca900_zh_page230 = weightsUCA_slice(data, 921344, 1792)
+	weightTable_uca900_zh_page231 = weightsUCA_slice(data, 923136, 1792)
+	weightTable_uca900_zh_page232 = weightsUCA_slice(data, 924928, 1792)
+	weightTable_uca900_zh_page233 = weightsUCA_slice(data, 926720, 1792)
+	weightTable_uca900_zh_page234 = weightsUCA_slice(data, 928512, 1792)
+	weightTable_uca900_zh_page235 = weightsUCA_slice(data, 930304, 1792)
+	weightTable_uca900_zh_page236 = weightsUCA_slice(data, 932096, 1792)
+	weightTable_uca900_zh_page237 = weightsUCA_slice(data, 933888, 1792)
+	weightTable_uca900_zh_page238 = weightsUCA_slice(data, 935680, 1792)
+	weightTable_uca900_zh_page239 = weightsUCA_slice(data, 937472, 1792)
+	weightTable_uca900_zh_page23A = weightsUCA_slice(data, 939264, 1792)
+	weightTable_uca900_zh_page23B = weightsUCA_slice(data, 941056, 1792)
+	weightTable_uca900_zh_page23C = weightsUCA_slice(data, 942848, 1792)
+	weightTable_uca900_zh_page23D = weightsUCA_slice(data, 944640, 1792)
+	weightTable_uca900_zh_page23E = weightsUCA_slice(data, 946432, 1792)
+	weightTable_uca900_zh_page23F = weightsUCA_slice(data, 948224, 1792)
+	weightTable_uca900_zh_page240 = weightsUCA_slice(data, 950016, 1792)
+	weightTable_uca900_zh_page241 = weightsUCA_slice(data, 951808, 1792)
+	weightTable_uca900_zh_page242 = weightsUCA_slice(data, 953600, 1792)
+	weightTable_uca900_zh_page243 = weightsUCA_slice(data, 955392, 1792)
+	weightTable_uca900_zh_page244 = weightsUCA_slice(data, 957184, 1792)
+	weightTable_uca900_zh_page245 = weightsUCA_slice(data, 958976, 1792)
+	weightTable_uca900_zh_page246 = weightsUCA_slice(data, 960768, 1792)
+	weightTable_uca900_zh_page247 = weightsUCA_slice(data, 962560, 1792)
+	weightTable_uca900_zh_page248 = weightsUCA_slice(data, 964352, 1792)
+	weightTable_uca900_zh_page249 = weightsUCA_slice(data, 966144, 1792)
+	weightTable_uca900_zh_page24A = weightsUCA_slice(data, 967936, 1792)
+	weightTable_uca900_zh_page24B = weightsUCA_slice(data, 969728, 1792)
+	weightTable_uca900_zh_page24C = weightsUCA_slice(data, 971520, 1792)
+	weightTable_uca900_zh_page24D = weightsUCA_slice(data, 973312, 1792)
+	weightTable_uca900_zh_page24E = weightsUCA_slice(data, 975104, 1792)
+	weightTable_uca900_zh_page24F = weightsUCA_slice(data, 976896, 1792)
+	weightTable_uca900_zh_page250 = weightsUCA_slice(data, 978688, 1792)
+	weightTable_uca900_zh_page251 = weightsUCA_slice(data, 980480, 1792)
+	weightTable_uca900_zh_page252 = weightsUCA_slice(data, 982272, 1792)
+	weightTable_uca900_zh_page253 = weightsUCA_slice(data, 984064, 1792)
+	weightTable_uca900_zh_page254 = weightsUCA_slice(data, 985856, 1792)
+	weightTable_uca900_zh_page255 = weightsUCA_slice(data, 987648, 1792)
+	weightTable_uca900_zh_page256 = weightsUCA_slice(data, 989440, 1792)
+	weightTable_uca900_zh_page257 = weightsUCA_slice(data, 991232, 1792)
+	weightTable_uca900_zh_page258 = weightsUCA_slice(data, 993024, 1792)
+	weightTable_uca900_zh_page259 = weightsUCA_slice(data, 994816, 1792)
+	weightTable_uca900_zh_page25A = weightsUCA_slice(data, 996608, 1792)
+	weightTable_uca900_zh_page25B = weightsUCA_slice(data, 998400, 1792)
+	weightTable_uca900_zh_page25C = weightsUCA_slice(data, 1000192, 1792)
+	weightTable_uca900_zh_page25D = weightsUCA_slice(data, 1001984, 1792)
+	weightTable_uca900_zh_page25E = weightsUCA_slice(data, 1003776, 1792)
+	weightTable_uca900_zh_page25F = weightsUCA_slice(data, 1005568, 1792)
+	weightTable_uca900_zh_page260 = weightsUCA_slice(data, 1007360, 1792)
+	weightTable_uca900_zh_page261 = weightsUCA_slice(data, 1009152, 1792)
+	weightTable_uca900_zh_page262 = weightsUCA_slice(data, 1010944, 1792)
+	weightTable_uca900_zh_page263 = weightsUCA_slice(data, 1012736, 1792)
+	weightTable_uca900_zh_page264 = weightsUCA_slice(data, 1014528, 1792)
+	weightTable_uca900_zh_page265 = weightsUCA_slice(data, 1016320, 1792)
+	weightTable_uca900_zh_page266 = weightsUCA_slice(data, 1018112, 1792)
+	weightTable_uca900_zh_page267 = weightsUCA_slice(data, 1019904, 1792)
+	weightTable_uca900_zh_page268 = weightsUCA_slice(data, 1021696, 1792)
+	weightTable_uca900_zh_page269 = weightsUCA_slice(data, 1023488, 1792)
+	weightTable_uca900_zh_page26A = weightsUCA_slice(data, 1025280, 1792)
+	weightTable_uca900_zh_page26B = weightsUCA_slice(data, 1027072, 1792)
+	weightTable_uca900_zh_page26C = weightsUCA_slice(data, 1028864, 1792)
+	weightTable_uca900_zh_page26D = weightsUCA_slice(data, 1030656, 1792)
+	weightTable_uca900_zh_page26E = weightsUCA_slice(data, 1032448, 1792)
+	weightTable_uca900_zh_page26F = weightsUCA_slice(data, 1034240, 1792)
+	weightTable_uca900_zh_page270 = weightsUCA_slice(data, 1036032, 1792)
+	weightTable_uca900_zh_page271 = weightsUCA_slice(data, 1037824, 1792)
+	weightTable_uca900_zh_page272 = weightsUCA_slice(data, 1039616, 1792)
+	weightTable_uca900_zh_page273 = weightsUCA_slice(data, 1041408, 1792)
+	weightTable_uca900_zh_page274 = weightsUCA_slice(data, 1043200, 1792)
+	weightTable_uca900_zh_page275 = weightsUCA_slice(data, 1044992, 1792)
+	weightTable_uca900_zh_page276 = weightsUCA_slice(data, 1046784, 1792)
+	weightTable_uca900_zh_page277 = weightsUCA_slice(data, 1048576, 1792)
+	weightTable_uca900_zh_page278 = weightsUCA_slice(data, 1050368, 1792)
+	weightTable_uca900_zh_page279 = weightsUCA_slice(data, 1052160, 1792)
+	weightTable_uca900_zh_page27A = weightsUCA_slice(data, 1053952, 1792)
+	weightTable_uca900_zh_page27B = weightsUCA_slice(data, 1055744, 1792)
+	weightTable_uca900_zh_page27C = weightsUCA_slice(data, 1057536, 1792)
+	weightTable_uca900_zh_page27D = weightsUCA_slice(data, 1059328, 1792)
+	weightTable_uca900_zh_page27E = weightsUCA_slice(data, 1061120, 1792)
+	weightTable_uca900_zh_page27F = weightsUCA_slice(data, 1062912, 1792)
+	weightTable_uca900_zh_page280 = weightsUCA_slice(data, 1064704, 1792)
+	weightTable_uca900_zh_page281 = weightsUCA_slice(data, 1066496, 1792)
+	weightTable_uca900_zh_page282 = weightsUCA_slice(data, 1068288, 1792)
+	weightTable_uca900_zh_page283 = weightsUCA_slice(data, 1070080, 1792)
+	weightTable_uca900_zh_page284 = weightsUCA_slice(data, 1071872, 1792)
+	weightTable_uca900_zh_page285 = weightsUCA_slice(data, 1073664, 1792)
+	weightTable_uca900_zh_page286 = weightsUCA_slice(data, 1075456, 1792)
+	weightTable_uca900_zh_page287 = weightsUCA_slice(data, 1077248, 1792)
+	weightTable_uca900_zh_page288 = weightsUCA_slice(data, 1079040, 1792)
+	weightTable_uca900_zh_page289 = weightsUCA_slice(data, 1080832, 1792)
+	weightTable_uca900_zh_page28A = weightsUCA_slice(data, 1082624, 1792)
+	weightTable_uca900_zh_page28B = weightsUCA_slice(data, 1084416, 1792)
+	weightTable_uca900_zh_page28C = weightsUCA_slice(data, 1086208, 1792)
+	weightTable_uca900_zh_page28D = weightsUCA_slice(data, 1088000, 1792)
+	weightTable_uca900_zh_page28E = weightsUCA_slice(data, 1089792, 1792)
+	weightTable_uca900_zh_page28F = weightsUCA_slice(data, 1091584, 1792)
+	weightTable_uca900_zh_page290 = weightsUCA_slice(data, 1093376, 1792)
+	weightTable_uca900_zh_page291 = weightsUCA_slice(data, 1095168, 1792)
+	weightTable_uca900_zh_page292 = weightsUCA_slice(data, 1096960, 1792)
+	weightTable_uca900_zh_page293 = weightsUCA_slice(data, 1098752, 1792)
+	weightTable_uca900_zh_page294 = weightsUCA_slice(data, 1100544, 1792)
+	weightTable_uca900_zh_page295 = weightsUCA_slice(data, 1102336, 1792)
+	weightTable_uca900_zh_page296 = weightsUCA_slice(data, 1104128, 1792)
+	weightTable_uca900_zh_page297 = weightsUCA_slice(data, 1105920, 1792)
+	weightTable_uca900_zh_page298 = weightsUCA_slice(data, 1107712, 1792)
+	weightTable_uca900_zh_page299 = weightsUCA_slice(data, 1109504, 1792)
+	weightTable_uca900_zh_page29A = weightsUCA_slice(data, 1111296, 1792)
+	weightTable_uca900_zh_page29B = weightsUCA_slice(data, 1113088, 1792)
+	weightTable_uca900_zh_page29C = weightsUCA_slice(data, 1114880, 1792)
+	weightTable_uca900_zh_page29D = weightsUCA_slice(data, 1116672, 1792)
+	weightTable_uca900_zh_page29E = weightsUCA_slice(data, 1118464, 1792)
+	weightTable_uca900_zh_page29F = weightsUCA_slice(data, 1120256, 1792)
+	weightTable_uca900_zh_page2A0 = weightsUCA_slice(data, 1122048, 1792)
+	weightTable_uca900_zh_page2A1 = weightsUCA_slice(data, 1123840, 1792)
+	weightTable_uca900_zh_page2A2 = weightsUCA_slice(data, 1125632, 1792)
+	weightTable_uca900_zh_page2A3 = weightsUCA_slice(data, 1127424, 1792)
+	weightTable_uca900_zh_page2A4 = weightsUCA_slice(data, 1129216, 1792)
+	weightTable_uca900_zh_page2A5 = weightsUCA_slice(data, 1131008, 1792)
+	weightTable_uca900_zh_page2A6 = weightsUCA_slice(data, 1132800, 1792)
+	weightTable_uca900_zh_page2A7 = weightsUCA_slice(data, 1134592, 1792)
+	weightTable_uca900_zh_page2A8 = weightsUCA_slice(data, 1136384, 1792)
+	weightTable_uca900_zh_page2AA = weightsUCA_slice(data, 1138176, 1792)
+	weightTable_uca900_zh_page2AE = weightsUCA_slice(data, 1139968, 1792)
+	weightTable_uca900_zh_page2AF = weightsUCA_slice(data, 1141760, 1792)
+	weightTable_uca900_zh_page2B0 = weightsUCA_slice(data, 1143552, 1792)
+	weightTable_uca900_zh_page2B1 = weightsUCA_slice(data, 1145344, 1792)
+	weightTable_uca900_zh_page2B2 = weightsUCA_slice(data, 1147136, 1792)
+	weightTable_uca900_zh_page2B3 = weightsUCA_slice(data, 1148928, 1792)
+	weightTable_uca900_zh_page2B4 = weightsUCA_slice(data, 1150720, 1792)
+	weightTable_uca900_zh_page2B5 = weightsUCA_slice(data, 1152512, 1792)
+	weightTable_uca900_zh_page2B6 = weightsUCA_slice(data, 1154304, 1792)
+	weightTable_uca900_zh_page2B8 = weightsUCA_slice(data, 1156096, 1792)
+	weightTable_uca900_zh_page2C4 = weightsUCA_slice(data, 1157888, 1792)
+	weightTable_uca900_zh_page2C6 = weightsUCA_slice(data, 1159680, 1792)
+	weightTable_uca900_zh_page2C9 = weightsUCA_slice(data, 1161472, 1792)
+	weightTable_uca900_zh_page2CB = weightsUCA_slice(data, 1163264, 1792)
+	weightTable_uca900_zh_page2CE = weightsUCA_slice(data, 1165056, 1792)
+	weightTable_uca900_zh_page2F8 = weightsUCA_slice(data, 1166848, 1792)
+	weightTable_uca900_zh_page2F9 = weightsUCA_slice(data, 1168640, 1792)
+	weightTable_uca900_zh_page2FA = weightsUCA_slice(data, 1170432, 1792)
+	weightTable_uca900_zh_pageE00 = weightsUCA_slice(data, 1172224, 1792)
+	weightTable_uca900_zh_pageE01 = weightsUCA_slice(data, 1174016, 1792)
+	weightTable_uca400_page000 = weightsUCA_slice(data, 1175808, 769)
+	weightTable_uca400_page001 = weightsUCA_slice(data, 1176577, 513)
+	weightTable_uca400_page002 = weightsUCA_slice(data, 1177090, 513)
+	weightTable_uca400_page003 = weightsUCA_slice(data, 1177603, 769)
+	weightTable_uca400_page004 = weightsUCA_slice(data, 1178372, 513)
+	weightTable_uca400_page005 = weightsUCA_slice(data, 1178885, 513)
+	weightTable_uca400_page006 = weightsUCA_slice(data, 1179398, 513)
+	weightTable_uca400_page007 = weightsUCA_slice(data, 1179911, 513)
+	weightTable_uca400_page009 = weightsUCA_slice(data, 1180424, 513)
+	weightTable_uca400_page00A = weightsUCA_slice(data, 1180937, 513)
+	weightTable_uca400_page00B = weightsUCA_slice(data, 1181450, 513)
+	weightTable_uca400_page00C = weightsUCA_slice(data, 1181963, 513)
+	weightTable_uca400_page00D = weightsUCA_slice(data, 1182476, 513)
+	weightTable_uca400_page00E = weightsUCA_slice(data, 1182989, 513)
+	weightTable_uca400_page00F = weightsUCA_slice(data, 1183502, 513)
+	weightTable_uca400_page010 = weightsUCA_slice(data, 1184015, 513)
+	weightTable_uca400_page011 = weightsUCA_slice(data, 1184528, 513)
+	weightTable_uca400_page012 = weightsUCA_slice(data, 1185041, 513)
+	weightTable_uca400_page013 = weightsUCA_slice(data, 1185554, 513)
+	weightTable_uca400_page014 = weightsUCA_slice(data, 1186067, 513)
+	weightTable_uca400_page015 = weightsUCA_slice(data, 1186580, 257)
+	weightTable_uca400_page016 = weightsUCA_slice(data, 1186837, 513)
+	weightTable_uca400_page017 = weightsUCA_slice(data, 1187350, 513)
+	weightTable_uca400_page018 = weightsUCA_slice(data, 1187863, 513)
+	weightTable_uca400_page019 = weightsUCA_slice(data, 1188376, 513)
+	weightTable_uca400_page01D = weightsUCA_slice(data, 1188889, 513)
+	weightTable_uca400_page01E = weightsUCA_slice(data, 1189402, 513)
+	weightTable_uca400_page01F = weightsUCA_slice(data, 1189915, 513)
+	weightTable_uca400_page020 = weightsUCA_slice(data, 1190428, 1025)
+	weightTable_uca400_page021 = weightsUCA_slice(data, 1191453, 1025)
+	weightTable_uca400_page022 = weightsUCA_slice(data, 1192478, 769)
+	weightTable_uca400_page023 = weightsUCA_slice(data, 1193247, 513)
+	weightTable_uca400_page024 = weightsUCA_slice(data, 1193760, 1025)
+	weightTable_uca400_page025 = weightsUCA_slice(data, 1194785, 257)
+	weightTable_uca400_page026 = weightsUCA_slice(data, 1195042, 513)
+	weightTable_uca400_page027 = weightsUCA_slice(data, 1195555, 513)
+	weightTable_uca400_page028 = weightsUCA_slice(data, 1196068, 257)
+	weightTable_uca400_page029 = weightsUCA_slice(data, 1196325, 257)
+	weightTable_uca400_page02A = weightsUCA_slice(data, 1196582, 1025)
+	weightTable_uca400_page02B = weightsUCA_slice(data, 1197607, 513)
+	weightTable_uca400_page02E = weightsUCA_slice(data, 1198120, 513)
+	weightTable_uca400_page02F = weightsUCA_slice(data, 1198633, 513)
+	weightTable_uca400_page030 = weightsUCA_slice(data, 1199146, 513)
+	weightTable_uca400_page031 = weightsUCA_slice(data, 1199659, 513)
+	weightTable_uca400_page032 = weightsUCA_slice(data, 1200172, 1793)
+	weightTable_uca400_page033 = weightsUCA_slice(data, 1201965, 2049)
+	weightTable_uca400_page04D = weightsUCA_slice(data, 1204014, 513)
+	weightTable_uca400_page0A0 = weightsUCA_slice(data, 1204527, 257)
+	weightTable_uca400_page0A1 = weightsUCA_slice(data, 1204784, 257)
+	weightTable_uca400_page0A2 = weightsUCA_slice(data, 1205041, 257)
+	weightTable_uca400_page0A3 = weightsUCA_slice(data, 1205298, 257)
+	weightTable_uca400_page0A4 = weightsUCA_slice(data, 1205555, 513)
+	weightTable_uca400_page0F9 = weightsUCA_slice(data, 1206068, 513)
+	weightTable_uca400_page0FA = weightsUCA_slice(data, 1206581, 513)
+	weightTable_uca400_page0FB = weightsUCA_slice(data, 1207094, 769)
+	weightTable_uca400_page0FC = weightsUCA_slice(data, 1207863, 513)
+	weightTable_uca400_page0FD = weightsUCA_slice(data, 1208376, 2049)
+	weightTable_uca400_page0FE = weightsUCA_slice(data, 1210425, 513)
+	weightTable_uca400_page0FF = weightsUCA_slice(data, 1210938, 513)
+	weightTable_uca520_page000 = weightsUCA_slice(data, 1211451, 769)
+	weightTable_uca520_page001 = weightsUCA_slice(data, 1212220, 513)
+	weightTable_uca520_page002 = weightsUCA_slice(data, 1212733, 513)
+	weightTable_uca520_page003 = weightsUCA_slice(data, 1213246, 769)
+	weightTable_uca520_page004 = weightsUCA_slice(data, 1214015, 257)
+	weightTable_uca520_page005 = weightsUCA_slice(data, 1214272, 513)
+	weightTable_uca520_page006 = weightsUCA_slice(data, 1214785, 513)
+	weightTable_uca520_page007 = weightsUCA_slice(data, 1215298, 513)
+	weightTable_uca520_page008 = weightsUCA_slice(data, 1215811, 513)
+	weightTable_uca520_page009 = weightsUCA_slice(data, 1216324, 513)
+	weightTable_uca520_page00A = weightsUCA_slice(data, 1216837, 513)
+	weightTable_uca520_page00B = weightsUCA_slice(data, 1217350, 513)
+	weightTable_uca520_page00C = weightsUCA_slice(data, 1217863, 513)
+	weightTable_uca520_page00D = weightsUCA_slice(data, 1218376, 513)
+	weightTable_uca520_page00E = weightsUCA_slice(data, 1218889, 513)
+	weightTable_uca520_page00F = weightsUCA_slice(data, 1219402, 513)
+	weightTable_uca520_page010 = weightsUCA_slice(data, 1219915, 769)
+	weightTable_uca520_page011 = weightsUCA_slice(data, 1220684, 257)
+	weightTable_uca520_page012 = weightsUCA_slice(data, 1220941, 513)
+	weightTable_uca520_page013 = weightsUCA_slice(data, 1221454, 513)
+	weightTable_uca520_page014 = weightsUCA_slice(data, 1221967, 257)
+	weightTable_uca520_page015 = weightsUCA_slice(data, 1222224, 257)
+	weightTable_uca520_page016 = weightsUCA_slice(data, 1222481, 513)
+	weightTable_uca520_page017 = weightsUCA_slice(data, 1222994, 513)
+	weightTable_uca520_page018 = weightsUCA_slice(data, 1223507, 513)
+	weightTable_uca520_page019 = weightsUCA_slice(data, 1224020, 769)
+	weightTable_uca520_page01A = weightsUCA_slice(data, 1224789, 769)
+	weightTable_uca520_page01B = weightsUCA_slice(data, 1225558, 513)
+	weightTable_uca520_page01C = weightsUCA_slice(data, 1226071, 513)
+	weightTable_uca520_page01D = weightsUCA_slice(data, 1226584, 513)
+	weightTable_uca520_page01E = weightsUCA_slice(data, 1227097, 513)
+	weightTable_uca520_page01F = weightsUCA_slice(data, 1227610, 513)
+	weightTable_uca520_page020 = weightsUCA_slice(data, 1228123, 1025)
+	weightTable_uca520_page021 = weightsUCA_slice(data, 1229148, 1025)
+	weightTable_uca520_page022 = weightsUCA_slice(data, 1230173, 769)
+	weightTable_uca520_page023 = weightsUCA_slice(data, 1230942, 513)
+	weightTable_uca520_page024 = weightsUCA_slice(data, 1231455, 1025)
+	weightTable_uca520_page025 = weightsUCA_slice(data, 1232480, 257)
+	weightTable_uca520_page026 = weightsUCA_slice(data, 1232737, 513)
+	weightTable_uca520_page027 = weightsUCA_slice(data, 1233250, 513)
+	weightTable_uca520_page028 = weightsUCA_slice(data, 1233763, 257)
+	weightTable_uca520_page029 = weightsUCA_slice(data, 1234020, 257)
+	weightTable_uca520_page02A = weightsUCA_slice(data, 1234277, 1025)
+	weightTable_uca520_page02B = weightsUCA_slice(data, 1235302, 513)
+	weightTable_uca520_page02C = weightsUCA_slice(data, 1235815, 769)
+	weightTable_uca520_page02D = weightsUCA_slice(data, 1236584, 513)
+	weightTable_uca520_page02E = weightsUCA_slice(data, 1237097, 513)
+	weightTable_uca520_page02F = weightsUCA_slice(data, 1237610, 513)
+	weightTable_uca520_page030 = weightsUCA_slice(data, 1238123, 513)
+	weightTable_uca520_page031 = weightsUCA_slice(data, 1238636, 513)
+	weightTable_uca520_page032 = weightsUCA_slice(data, 1239149, 1793)
+	weightTable_uca520_page033 = weightsUCA_slice(data, 1240942, 2049)
+	weightTable_uca520_page04D = weightsUCA_slice(data, 1242991, 513)
+	weightTable_uca520_page0A0 = weightsUCA_slice(data, 1243504, 257)
+	weightTable_uca520_page0A1 = weightsUCA_slice(data, 1243761, 257)
+	weightTable_uca520_page0A2 = weightsUCA_slice(data, 1244018, 257)
+	weightTable_uca520_page0A3 = weightsUCA_slice(data, 1244275, 257)
+	weightTable_uca520_page0A4 = weightsUCA_slice(data, 1244532, 513)
+	weightTable_uca520_page0A5 = weightsUCA_slice(data, 1245045, 257)
+	weightTable_uca520_page0A6 = weightsUCA_slice(data, 1245302, 513)
+	weightTable_uca520_page0A7 = weightsUCA_slice(data, 1245815, 513)
+	weightTable_uca520_page0A8 = weightsUCA_slice(data, 1246328, 513)
+	weightTable_uca520_page0A9 = weightsUCA_slice(data, 1246841, 513)
+	weightTable_uca520_page0AA = weightsUCA_slice(data, 1247354, 513)
+	weightTable_uca520_page0AB = weightsUCA_slice(data, 1247867, 513)
+	weightTable_uca520_page0D7 = weightsUCA_slice(data, 1248380, 513)
+	weightTable_uca520_page0FA = weightsUCA_slice(data, 1248893, 513)
+	weightTable_uca520_page0FB = weightsUCA_slice(data, 1249406, 769)
+	weightTable_uca520_page0FC = weightsUCA_slice(data, 1250175, 513)
+	weightTable_uca520_page0FD = weightsUCA_slice(data, 1250688, 2049)
+	weightTable_uca520_page0FE = weightsUCA_slice(data, 1252737, 769)
+	weightTable_uca520_page0FF = weightsUCA_slice(data, 1253506, 513)
+	weightTable_uca520_page100 = weightsUCA_slice(data, 1254019, 513)
+	weightTable_uca520_page101 = weightsUCA_slice(data, 1254532, 513)
+	weightTable_uca520_page102 = weightsUCA_slice(data, 1255045, 513)
+	weightTable_uca520_page103 = weightsUCA_slice(data, 1255558, 513)
+	weightTable_uca520_page104 = weightsUCA_slice(data, 1256071, 513)
+	weightTable_uca520_page108 = weightsUCA_slice(data, 1256584, 513)
+	weightTable_uca520_page109 = weightsUCA_slice(data, 1257097, 513)
+	weightTable_uca520_page10A = weightsUCA_slice(data, 1257610, 513)
+	weightTable_uca520_page10B = weightsUCA_slice(data, 1258123, 513)
+	weightTable_uca520_page10C = weightsUCA_slice(data, 1258636, 513)
+	weightTable_uca520_page10E = weightsUCA_slice(data, 1259149, 513)
+	weightTable_uca520_page110 = weightsUCA_slice(data, 1259662, 513)
+	weightTable_uca520_page120 = weightsUCA_slice(data, 1260175, 257)
+	weightTable_uca520_page121 = weightsUCA_slice(data, 1260432, 257)
+	weightTable_uca520_page122 = weightsUCA_slice(data, 1260689, 257)
+	weightTable_uca520_page123 = weightsUCA_slice(data, 1260946, 513)
+	weightTable_uca520_page124 = weightsUCA_slice(data, 1261459, 513)
+	weightTable_uca520_page130 = weightsUCA_slice(data, 1261972, 257)
+	weightTable_uca520_page131 = weightsUCA_slice(data, 1262229, 257)
+	weightTable_uca520_page132 = weightsUCA_slice(data, 1262486, 257)
+	weightTable_uca520_page133 = weightsUCA_slice(data, 1262743, 257)
+	weightTable_uca520_page134 = weightsUCA_slice(data, 1263000, 513)
+	weightTable_uca520_page1D0 = weightsUCA_slice(data, 1263513, 513)
+	weightTable_uca520_page1D1 = weightsUCA_slice(data, 1264026, 513)
+	weightTable_uca520_page1D2 = weightsUCA_slice(data, 1264539, 513)
+	weightTable_uca520_page1D3 = weightsUCA_slice(data, 1265052, 513)
+	weightTable_uca520_page1D4 = weightsUCA_slice(data, 1265565, 513)
+	weightTable_uca520_page1D5 = weightsUCA_slice(data, 1266078, 513)
+	weightTable_uca520_page1D6 = weightsUCA_slice(data, 1266591, 513)
+	weightTable_uca520_page1D7 = weightsUCA_slice(data, 1267104, 513)
+	weightTable_uca520_page1F0 = weightsUCA_slice(data, 1267617, 513)
+	weightTable_uca520_page1F1 = weightsUCA_slice(data, 1268130, 769)
+	weightTable_uca520_page1F2 = weightsUCA_slice(data, 1268899, 1025)
+	weightTable_uca520_page2F8 = weightsUCA_slice(data, 1269924, 513)
+	weightTable_uca520_page2F9 = weightsUCA_slice(data, 1270437, 513)
+	weightTable_uca520_page2FA = weightsUCA_slice(data, 1270950, 513)
+	weightTable_uca520_pageE00 = weightsUCA_slice(data, 1271463, 513)
+	weightTable_uca520_pageE01 = weightsUCA_slice(data, 1271976, 513)
+}
+
+func weightsUCA_slice(data string, pos, length int) []uint16 {
+	return (*[0x3fffffff]uint16)(unsafe.Pointer(unsafe.StringData(data)))[pos : pos+length]
 }
@@ -0,0 +1,29 @@
+//go:build !colldata_external
+
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colldata
+
+import (
+	_ "embed"
+)
+
+// weightsUCA_embed_data are the weight tables of the UCA collations embedded in the
+// binary; they are used unless they are loaded from an external file.
+//
+//go:embed mysqlucadata.bin
+var weightsUCA_embed_data string
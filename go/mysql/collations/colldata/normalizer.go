@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colldata
+
+import (
+	"bytes"
+	"fmt"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/mysql/collations/charset"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// Normalizer normalizes the values of a column so that all the values that are
+// equal in the collation of the column have the same normal form. It is meant to
+// be used by functional vindexes that must map equal values to the same keyspace
+// ID, e.g. a lookup vindex on a case-insensitive column, as declared in the
+// VSchema with the type and collation of the column.
+//
+// A Normalizer is immutable and safe for concurrent use.
+type Normalizer struct {
+	coll Collation
+	// trimSpace is set if trailing spaces are not significant for the column:
+	// either the collation is PAD SPACE, or MySQL strips them from CHAR columns.
+	trimSpace bool
+	// fold is set if the collation is case-insensitive and can lower its strings.
+	fold bool
+}
+
+// NewNormalizer returns the Normalizer for a column of the given type and
+// collation, as they are declared in a VSchema. The collation name may be spelled
+// in the ways that collations.Environment.LookupAlias accepts, and an empty name
+// means the default collation of the environment. Binary columns always use the
+// binary collation.
+func NewNormalizer(env *collations.Environment, typ sqltypes.Type, collationName string) (*Normalizer, error) {
+	var id collations.ID
+	switch {
+	case sqltypes.IsBinary(typ):
+		id = collations.CollationBinaryID
+	case sqltypes.IsText(typ):
+		if collationName == "" {
+			id = env.DefaultConnectionCharset()
+			break
+		}
+		var err error
+		if id, _, err = env.LookupAlias(collationName); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("cannot normalize values of type %s", typ.String())
+	}
+
+	coll, err := LookupWithError(id)
+	if err != nil {
+		return nil, err
+	}
+	if coll == nil {
+		return nil, fmt.Errorf("unsupported collation: %q", env.LookupName(id))
+	}
+	return &Normalizer{
+		coll:      coll,
+		trimSpace: typ == sqltypes.Char || !noPad(coll),
+		fold:      caseInsensitive(coll),
+	}, nil
+}
+
+// caseInsensitive returns whether coll ignores the case of its strings and
+// knows how to lower them.
+func caseInsensitive(coll Collation) bool {
+	if _, ok := coll.(CaseAwareCollation); !ok || coll.IsBinary() {
+		return false
+	}
+	lower, err := charset.ConvertFromUTF8(nil, coll.Charset(), []byte("a"))
+	if err != nil {
+		return false
+	}
+	upper, err := charset.ConvertFromUTF8(nil, coll.Charset(), []byte("A"))
+	if err != nil {
+		return false
+	}
+	return coll.Collate(lower, upper, false) == 0
+}
+
+// Collation returns the collation with which the values are normalized.
+func (n *Normalizer) Collation() Collation {
+	return n.coll
+}
+
+// CaseInsensitive returns whether Fold lowers the values, i.e. if the collation
+// is case-insensitive and implements CaseAwareCollation.
+func (n *Normalizer) CaseInsensitive() bool {
+	return n.fold
+}
+
+func (n *Normalizer) trim(src []byte) []byte {
+	if n.trimSpace {
+		return bytes.TrimRight(src, " ")
+	}
+	return src
+}
+
+// WeightString appends to dst the weight string of src, which must be encoded in
+// the charset of the collation. Two values have the same weight string if and
+// only if they are equal in the collation, which makes it the exact normal form
+// for a vindex, but the weight string is an opaque binary value.
+func (n *Normalizer) WeightString(dst, src []byte) []byte {
+	return n.coll.WeightString(dst, n.trim(src), 0)
+}
+
+// Fold appends to dst the case-folded form of src, which must be encoded in the
+// charset of the collation: the trailing spaces that are not significant are
+// removed, and the value is lowered if CaseInsensitive returns true. Unlike
+// the weight string, the folded value is readable, but it is only an exact normal
+// form for collations that don't ignore anything but case: e.g. accents are not
+// folded for an accent-insensitive collation.
+func (n *Normalizer) Fold(dst, src []byte) []byte {
+	src = n.trim(src)
+	if n.fold {
+		return n.coll.(CaseAwareCollation).ToLower(dst, src)
+	}
+	return append(dst, src...)
+}
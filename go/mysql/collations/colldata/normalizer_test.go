@@ -0,0 +1,124 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colldata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestNormalizer(t *testing.T) {
+	env := collations.MySQL8()
+
+	testCases := []struct {
+		typ       sqltypes.Type
+		collation string
+		expected  string
+		// equal and different are values that must have the same and
+		// a different normal form than the value "Abc"
+		equal     []string
+		different []string
+		folded    string
+	}{
+		{
+			typ:       sqltypes.VarChar,
+			collation: "utf8mb4_0900_ai_ci",
+			expected:  "utf8mb4_0900_ai_ci",
+			equal:     []string{"abc", "ABC", "ábc"},
+			different: []string{"abd", "abc ", "ab"},
+			folded:    "abc",
+		},
+		{
+			typ:       sqltypes.Char,
+			collation: "utf8mb4_0900_ai_ci",
+			expected:  "utf8mb4_0900_ai_ci",
+			equal:     []string{"abc", "abc  "},
+			different: []string{" abc"},
+			folded:    "abc",
+		},
+		{
+			typ:       sqltypes.VarChar,
+			collation: "utf8mb4_0900_as_cs",
+			expected:  "utf8mb4_0900_as_cs",
+			equal:     []string{"Abc"},
+			different: []string{"abc", "ABC"},
+			folded:    "Abc",
+		},
+		{
+			typ:       sqltypes.VarChar,
+			collation: "LATIN1_SWEDISH_CI",
+			expected:  "latin1_swedish_ci",
+			equal:     []string{"abc", "ABC", "abc  "},
+			different: []string{"abd"},
+			folded:    "abc",
+		},
+		{
+			typ:       sqltypes.VarChar,
+			collation: "utf8_general_ci",
+			expected:  "utf8mb3_general_ci",
+			equal:     []string{"abc", "ABC", "abc "},
+			different: []string{"abd"},
+			folded:    "Abc",
+		},
+		{
+			typ:      sqltypes.VarChar,
+			expected: "utf8mb4_0900_ai_ci",
+			equal:    []string{"abc"},
+			folded:   "abc",
+		},
+		{
+			typ:       sqltypes.VarBinary,
+			collation: "utf8mb4_0900_ai_ci",
+			expected:  "binary",
+			equal:     []string{"Abc"},
+			different: []string{"abc", "Abc "},
+			folded:    "Abc",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.typ.String()+"/"+tc.collation, func(t *testing.T) {
+			n, err := NewNormalizer(env, tc.typ, tc.collation)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, n.Collation().Name())
+			assert.Equal(t, tc.folded, string(n.Fold(nil, []byte("Abc"))))
+
+			want := n.WeightString(nil, []byte("Abc"))
+			for _, v := range tc.equal {
+				assert.Equal(t, want, n.WeightString(nil, []byte(v)), "%q should be equal to \"Abc\"", v)
+			}
+			for _, v := range tc.different {
+				assert.NotEqual(t, want, n.WeightString(nil, []byte(v)), "%q should be different from \"Abc\"", v)
+			}
+		})
+	}
+}
+
+func TestNormalizerErrors(t *testing.T) {
+	env := collations.MySQL8()
+
+	_, err := NewNormalizer(env, sqltypes.Int64, "")
+	assert.EqualError(t, err, "cannot normalize values of type INT64")
+
+	_, err = NewNormalizer(env, sqltypes.VarChar, "foo_bar_ci")
+	assert.EqualError(t, err, `unknown collation: "foo_bar_ci"`)
+}
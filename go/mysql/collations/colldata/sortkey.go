@@ -0,0 +1,100 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colldata
+
+import "bytes"
+
+// SortKey is a prefix of bounded length of the weight string of a string. Sorting
+// with SortKeys only requires collating the full strings when their keys collide,
+// which allows using short keys for large sorts while still returning the exact
+// same order as MySQL.
+//
+// Two SortKeys computed with the same collation and maximum length are compared
+// as follows, see CompareSortKeys:
+//
+//   - Weight strings compare byte-wise like the strings they were computed from (see
+//     Collation.WeightString). If the keys differ at a byte that both of them have,
+//     the full weight strings differ at that same byte, which decides the order of
+//     the strings.
+//   - Otherwise the shorter key is a prefix of the longer one. If the shorter key is
+//     the whole weight string of its string, and the collation is NO PAD, that string
+//     sorts first: its weight string is a proper prefix of the other one (or they're
+//     equal, if both keys are whole). With PAD SPACE collations, a string is compared
+//     as if it were padded with spaces, which can sort before or after the remaining
+//     weights of the other string, so only two whole and equal keys are conclusive.
+//   - In all other cases the keys collide, and the strings must be collated.
+type SortKey struct {
+	// Weights is the prefix of the weight string of the string.
+	Weights []byte
+	// Complete is true if Weights is the whole weight string of the string.
+	Complete bool
+}
+
+// NewSortKey returns the SortKey of src with at most maxLen bytes of weights.
+func NewSortKey(coll Collation, src []byte, maxLen int) SortKey {
+	weights := coll.WeightString(nil, src, 0)
+	if len(weights) <= maxLen {
+		return SortKey{Weights: weights, Complete: true}
+	}
+	// don't keep the full weight string alive
+	return SortKey{Weights: bytes.Clone(weights[:maxLen]), Complete: false}
+}
+
+// CompareSortKeys compares the SortKeys of two strings in the given collation. It
+// returns the result of collating the two strings and true if the keys are enough
+// to know it, or false if the keys collide and the strings must be collated.
+func CompareSortKeys(coll Collation, left, right SortKey) (int, bool) {
+	n := min(len(left.Weights), len(right.Weights))
+	if cmp := bytes.Compare(left.Weights[:n], right.Weights[:n]); cmp != 0 {
+		return cmp, true
+	}
+
+	if left.Complete && right.Complete && len(left.Weights) == len(right.Weights) {
+		return 0, true
+	}
+	if !noPad(coll) {
+		return 0, false
+	}
+	switch {
+	case left.Complete && len(left.Weights) <= len(right.Weights):
+		return -1, true
+	case right.Complete && len(right.Weights) <= len(left.Weights):
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// CollateSortKeys collates two strings given their SortKeys, only collating the
+// full strings if their keys collide.
+func CollateSortKeys(coll Collation, left, right []byte, leftKey, rightKey SortKey) int {
+	if cmp, ok := CompareSortKeys(coll, leftKey, rightKey); ok {
+		return cmp
+	}
+	return coll.Collate(left, right, false)
+}
+
+// noPad returns whether the collation is NO PAD, i.e. if trailing spaces are
+// significant when comparing strings.
+func noPad(coll Collation) bool {
+	switch coll.(type) {
+	case *Collation_utf8mb4_uca_0900, *Collation_utf8mb4_0900_bin, *Collation_binary:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colldata
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations/charset"
+)
+
+func sign(cmp int) int {
+	switch {
+	case cmp < 0:
+		return -1
+	case cmp > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func TestSortKeys(t *testing.T) {
+	var strings = []string{
+		"", " ", "\t", "a", "A", "a ", "a\t", "a  ", "á", "ä", "aa", "aA", "aá", "ab", "a b",
+		"abc", "abc ", "abcd", "ABCD", "abcdefghijklmnopqrstuvwxyz", "abcdefghijklmnopqrstuvwxyZ",
+		"abcdefghijklmnopqrstuvwxyz ", "abcdefghijklmnopqrstuvwxyz\t",
+		"Abc", "aBC", "ǍḄÇ", "ÁḆĈ", "Ꜻ", "Ꜹ", "가", "㉮",
+		"ß", "ss", "SS", "æ", "ae", "ﬁ", "fi", "é", "é",
+		ExampleString, ExampleStringLong, JapaneseString, WhitespaceString, HungarianString,
+		JapaneseString2, ChineseString, ChineseString2, SpanishString, EnglishString,
+	}
+
+	for _, name := range []string{
+		"utf8mb4_0900_ai_ci",
+		"utf8mb4_0900_as_cs",
+		"utf8mb4_0900_bin",
+		"utf8mb4_general_ci",
+		"utf8mb4_unicode_ci",
+		"utf8mb4_bin",
+		"latin1_swedish_ci",
+		"binary",
+	} {
+		coll := testcollation(t, name)
+		var inputs [][]byte
+		for _, s := range strings {
+			if input, err := charset.ConvertFromUTF8(nil, coll.Charset(), []byte(s)); err == nil {
+				inputs = append(inputs, input)
+			}
+		}
+
+		for _, maxLen := range []int{0, 1, 2, 3, 4, 8, 16, 64, 1024} {
+			keys := make([]SortKey, 0, len(inputs))
+			for _, input := range inputs {
+				key := NewSortKey(coll, input, maxLen)
+				require.LessOrEqual(t, len(key.Weights), maxLen)
+				keys = append(keys, key)
+			}
+
+			for i, left := range inputs {
+				for j, right := range inputs {
+					want := sign(coll.Collate(left, right, false))
+					if cmp, ok := CompareSortKeys(coll, keys[i], keys[j]); ok {
+						assert.Equal(t, want, sign(cmp), "[%s/%d] %q vs %q: wrong result from the keys", name, maxLen, left, right)
+					}
+					assert.Equal(t, want, sign(CollateSortKeys(coll, left, right, keys[i], keys[j])), "[%s/%d] %q vs %q", name, maxLen, left, right)
+				}
+			}
+		}
+	}
+}
+
+// TestSortKeysUTF8mb4_0900_ai_ci sorts random strings with short keys, like the
+// merge-sort of the results of a LIMIT query, and makes sure the order is exactly
+// the one of the collation.
+func TestSortKeysUTF8mb4_0900_ai_ci(t *testing.T) {
+	coll := testcollation(t, "utf8mb4_0900_ai_ci")
+
+	// few distinct characters, so that many strings share long prefixes,
+	// differ only by case, accents or trailing spaces, and keys collide
+	alphabet := []rune("aAáÄbB  \t")
+	var inputs [][]byte
+	for i := 0; i < 2000; i++ {
+		var s []rune
+		for n := rand.IntN(12); n > 0; n-- {
+			s = append(s, alphabet[rand.IntN(len(alphabet))])
+		}
+		inputs = append(inputs, []byte(string(s)))
+	}
+
+	expected := slices.Clone(inputs)
+	slices.SortStableFunc(expected, func(a, b []byte) int {
+		return coll.Collate(a, b, false)
+	})
+
+	for _, maxLen := range []int{2, 4, 8} {
+		type keyed struct {
+			input []byte
+			key   SortKey
+		}
+		var sorted []keyed
+		for _, input := range inputs {
+			sorted = append(sorted, keyed{input: input, key: NewSortKey(coll, input, maxLen)})
+		}
+
+		var collisions int
+		slices.SortStableFunc(sorted, func(a, b keyed) int {
+			if _, ok := CompareSortKeys(coll, a.key, b.key); !ok {
+				collisions++
+			}
+			return CollateSortKeys(coll, a.input, b.input, a.key, b.key)
+		})
+
+		for i := range sorted {
+			require.Equal(t, string(expected[i]), string(sorted[i].input), "maxLen=%d: wrong order at %d", maxLen, i)
+		}
+		t.Logf("maxLen=%d: %d collisions", maxLen, collisions)
+	}
+}
@@ -27,10 +27,41 @@ import (
 	"vitess.io/vitess/go/vt/vthash"
 )
 
+// UCAVersion is the version of the Unicode Collation Algorithm implemented
+// by a collation. Collations of the same family that are built on different
+// versions of the UCA weight tables do not sort strings the same way.
+type UCAVersion = uca.Version
+
+const (
+	UCAUnknown = uca.UCAUnknown
+	UCA400     = uca.UCA400
+	UCA520     = uca.UCA520
+	UCA900     = uca.UCA900
+	UCA1400    = uca.UCA1400
+)
+
+// UCAVersionOf returns the version of the Unicode Collation Algorithm that the
+// given collation implements, or UCAUnknown if the collation is not based on
+// the UCA weight tables.
+func UCAVersionOf(coll Collation) UCAVersion {
+	switch coll := coll.(type) {
+	case *Collation_utf8mb4_uca_0900:
+		return coll.UCAVersion()
+	case *Collation_uca_legacy:
+		return coll.UCAVersion()
+	default:
+		return UCAUnknown
+	}
+}
+
 type Collation_utf8mb4_uca_0900 struct {
 	name string
 	id   collations.ID
-	uca  *uca.Collation900
+	uca  *lazyCollation[uca.Collation900]
+}
+
+func (c *Collation_utf8mb4_uca_0900) preload() {
+	c.uca.get()
 }
 
 func (c *Collation_utf8mb4_uca_0900) Name() string {
@@ -49,14 +80,19 @@ func (c *Collation_utf8mb4_uca_0900) IsBinary() bool {
 	return false
 }
 
+func (c *Collation_utf8mb4_uca_0900) UCAVersion() UCAVersion {
+	return c.uca.get().Version()
+}
+
 func (c *Collation_utf8mb4_uca_0900) Collate(left, right []byte, rightIsPrefix bool) int {
 	var (
 		l, r            uint16
 		lok, rok        bool
 		level           int
-		levelsToCompare = c.uca.MaxLevel()
-		itleft          = c.uca.Iterator(left)
-		itright         = c.uca.Iterator(right)
+		coll            = c.uca.get()
+		levelsToCompare = coll.MaxLevel()
+		itleft          = coll.Iterator(left)
+		itright         = coll.Iterator(right)
 
 		fastleft, _  = itleft.(*uca.FastIterator900)
 		fastright, _ = itright.(*uca.FastIterator900)
@@ -121,7 +157,7 @@ nextLevel:
 }
 
 func (c *Collation_utf8mb4_uca_0900) TinyWeightString(src []byte) uint32 {
-	it := c.uca.Iterator(src)
+	it := c.uca.get().Iterator(src)
 	defer it.Done()
 
 	if fast, ok := it.(*uca.FastIterator900); ok {
@@ -143,7 +179,7 @@ func (c *Collation_utf8mb4_uca_0900) TinyWeightString(src []byte) uint32 {
 }
 
 func (c *Collation_utf8mb4_uca_0900) WeightString(dst, src []byte, numCodepoints int) []byte {
-	it := c.uca.Iterator(src)
+	it := c.uca.get().Iterator(src)
 	defer it.Done()
 
 	if fast, ok := it.(*uca.FastIterator900); ok {
@@ -185,7 +221,7 @@ performPadding:
 func (c *Collation_utf8mb4_uca_0900) Hash(hasher *vthash.Hasher, src []byte, _ int) {
 	hasher.Write64(uint64(c.id))
 
-	it := c.uca.Iterator(src)
+	it := c.uca.get().Iterator(src)
 	defer it.Done()
 
 	if fast, ok := it.(*uca.FastIterator900); ok {
@@ -215,14 +251,14 @@ func (c *Collation_utf8mb4_uca_0900) WeightStringLen(numBytes int) int {
 	if numBytes%4 != 0 {
 		panic("WeightStringLen called with non-MOD4 length")
 	}
-	levels := int(c.uca.MaxLevel())
+	levels := int(c.uca.get().MaxLevel())
 	weights := (numBytes / 4) * uca.MaxCollationElementsPerCodepoint * levels
 	weights += levels - 1 // one NULL byte as a separator between levels
 	return weights * 2    // two bytes per weight
 }
 
 func (c *Collation_utf8mb4_uca_0900) Wildcard(pat []byte, matchOne rune, matchMany rune, escape rune) WildcardPattern {
-	return newUnicodeWildcardMatcher(charset.Charset_utf8mb4{}, c.uca.WeightsEqual, c.Collate, pat, matchOne, matchMany, escape)
+	return newUnicodeWildcardMatcher(charset.Charset_utf8mb4{}, c.uca.get().WeightsEqual, c.Collate, pat, matchOne, matchMany, escape)
 }
 
 func (c *Collation_utf8mb4_uca_0900) ToLower(dst, src []byte) []byte {
@@ -296,7 +332,11 @@ func (c *Collation_utf8mb4_0900_bin) ToUpper(dst, src []byte) []byte {
 type Collation_uca_legacy struct {
 	name string
 	id   collations.ID
-	uca  *uca.CollationLegacy
+	uca  *lazyCollation[uca.CollationLegacy]
+}
+
+func (c *Collation_uca_legacy) preload() {
+	c.uca.get()
 }
 
 func (c *Collation_uca_legacy) ID() collations.ID {
@@ -308,19 +348,24 @@ func (c *Collation_uca_legacy) Name() string {
 }
 
 func (c *Collation_uca_legacy) Charset() charset.Charset {
-	return c.uca.Charset()
+	return c.uca.get().Charset()
 }
 
 func (c *Collation_uca_legacy) IsBinary() bool {
 	return false
 }
 
+func (c *Collation_uca_legacy) UCAVersion() UCAVersion {
+	return c.uca.get().Version()
+}
+
 func (c *Collation_uca_legacy) Collate(left, right []byte, isPrefix bool) int {
 	var (
 		l, r     uint16
 		lok, rok bool
-		itleft   = c.uca.Iterator(left)
-		itright  = c.uca.Iterator(right)
+		coll     = c.uca.get()
+		itleft   = coll.Iterator(left)
+		itright  = coll.Iterator(right)
 	)
 
 	for {
@@ -338,7 +383,7 @@ func (c *Collation_uca_legacy) Collate(left, right []byte, isPrefix bool) int {
 }
 
 func (c *Collation_uca_legacy) WeightString(dst, src []byte, numCodepoints int) []byte {
-	it := c.uca.Iterator(src)
+	it := c.uca.get().Iterator(src)
 
 	for {
 		w, ok := it.Next()
@@ -349,7 +394,7 @@ func (c *Collation_uca_legacy) WeightString(dst, src []byte, numCodepoints int)
 	}
 
 	if numCodepoints > 0 {
-		weightForSpace := c.uca.WeightForSpace()
+		weightForSpace := c.uca.get().WeightForSpace()
 		w1, w2 := byte(weightForSpace>>8), byte(weightForSpace)
 
 		if numCodepoints == PadToMax {
@@ -372,7 +417,7 @@ func (c *Collation_uca_legacy) WeightString(dst, src []byte, numCodepoints int)
 }
 
 func (c *Collation_uca_legacy) Hash(hasher *vthash.Hasher, src []byte, numCodepoints int) {
-	it := c.uca.Iterator(src)
+	it := c.uca.get().Iterator(src)
 
 	hasher.Write64(uint64(c.id))
 	for {
@@ -384,7 +429,7 @@ func (c *Collation_uca_legacy) Hash(hasher *vthash.Hasher, src []byte, numCodepo
 	}
 
 	if numCodepoints > 0 {
-		weightForSpace := bits.ReverseBytes16(c.uca.WeightForSpace())
+		weightForSpace := bits.ReverseBytes16(c.uca.get().WeightForSpace())
 		numCodepoints -= it.Length()
 		for numCodepoints > 0 {
 			hasher.Write16(weightForSpace)
@@ -399,5 +444,5 @@ func (c *Collation_uca_legacy) WeightStringLen(numBytes int) int {
 }
 
 func (c *Collation_uca_legacy) Wildcard(pat []byte, matchOne rune, matchMany rune, escape rune) WildcardPattern {
-	return newUnicodeWildcardMatcher(c.uca.Charset(), c.uca.WeightsEqual, c.Collate, pat, matchOne, matchMany, escape)
+	return newUnicodeWildcardMatcher(c.uca.get().Charset(), c.uca.get().WeightsEqual, c.Collate, pat, matchOne, matchMany, escape)
 }
@@ -47,10 +47,10 @@ func findContractedCollations(t testing.TB, unique bool) (result []CollationWith
 	for _, collation := range testall() {
 		var contract uca.Contractor
 		if uca, ok := collation.(*Collation_utf8mb4_uca_0900); ok {
-			contract = uca.uca.Contractor()
+			contract = uca.uca.get().Contractor()
 		}
 		if uca, ok := collation.(*Collation_uca_legacy); ok {
-			contract = uca.uca.Contractor()
+			contract = uca.uca.get().Contractor()
 		}
 		if contract == nil {
 			continue
@@ -89,6 +89,7 @@ func loadExpectedWeights(t *testing.T, weights string) map[rune][]uint16 {
 
 func TestWeightsForAllCodepoints(t *testing.T) {
 	testWeightsFromMysql := loadExpectedWeights(t, "utf8mb4_0900_ai_ci")
+	ensureWeightsUCA()
 	verifyAllCodepoints(t, testWeightsFromMysql, weightTable_uca900, uca.Layout_uca900{})
 }
 
@@ -103,9 +104,9 @@ func TestWeightTablesAreDeduplicated(t *testing.T) {
 		var weights uca.Weights
 		switch col := col.(type) {
 		case *Collation_uca_legacy:
-			weights, _ = col.uca.Weights()
+			weights, _ = col.uca.get().Weights()
 		case *Collation_utf8mb4_uca_0900:
-			weights, _ = col.uca.Weights()
+			weights, _ = col.uca.get().Weights()
 		default:
 			continue
 		}
@@ -132,9 +133,9 @@ func TestTailoringPatchApplication(t *testing.T) {
 			if _, utf8 := col.Charset().(charset.Charset_utf8mb4); !utf8 {
 				continue
 			}
-			weightTable, tableLayout = col.uca.Weights()
+			weightTable, tableLayout = col.uca.get().Weights()
 		case *Collation_utf8mb4_uca_0900:
-			weightTable, tableLayout = col.uca.Weights()
+			weightTable, tableLayout = col.uca.get().Weights()
 		default:
 			continue
 		}
@@ -76,14 +76,14 @@ func TestWeightsForSpace(t *testing.T) {
 		var actual, expected uint16
 		switch coll := coll.(type) {
 		case *Collation_uca_legacy:
-			actual = coll.uca.WeightForSpace()
+			actual = coll.uca.get().WeightForSpace()
 			if strings.Contains(coll.name, "_520_") {
 				expected = 0x20A
 			} else {
 				expected = 0x209
 			}
 		case *Collation_utf8mb4_uca_0900:
-			actual = coll.uca.WeightForSpace()
+			actual = coll.uca.get().WeightForSpace()
 			expected = 0x209
 		default:
 			continue
@@ -116,6 +116,44 @@ func TestKanaSensitivity(t *testing.T) {
 	}
 }
 
+func TestUCAVersion(t *testing.T) {
+	var cases = []struct {
+		collation string
+		version   UCAVersion
+	}{
+		{"utf8mb4_unicode_ci", UCA400},
+		{"utf16_icelandic_ci", UCA400},
+		{"utf8mb4_unicode_520_ci", UCA520},
+		{"gb18030_unicode_520_ci", UCA520},
+		{"utf8mb4_0900_ai_ci", UCA900},
+		{"utf8mb4_ja_0900_as_cs_ks", UCA900},
+		{"utf8mb4_zh_0900_as_cs", UCA900},
+		{"utf8mb4_0900_bin", UCAUnknown},
+		{"utf8mb4_general_ci", UCAUnknown},
+		{"latin1_swedish_ci", UCAUnknown},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.collation, func(t *testing.T) {
+			collation := testcollation(t, tc.collation)
+			assert.Equal(t, tc.version, UCAVersionOf(collation))
+		})
+	}
+
+	for _, coll := range testall() {
+		switch coll := coll.(type) {
+		case *Collation_utf8mb4_uca_0900:
+			assert.Equal(t, UCA900, coll.UCAVersion(), "collation %s", coll.Name())
+		case *Collation_uca_legacy:
+			if strings.Contains(coll.name, "_520_") {
+				assert.Equal(t, UCA520, coll.UCAVersion(), "collation %s", coll.Name())
+			} else {
+				assert.Equal(t, UCA400, coll.UCAVersion(), "collation %s", coll.Name())
+			}
+		}
+	}
+}
+
 func TestContractions(t *testing.T) {
 	var cases = []struct {
 		collation string
@@ -193,7 +231,7 @@ func TestIsPrefix(t *testing.T) {
 
 func DebugUcaLegacyWeightString(t *testing.T, collname string, input, expected []byte) {
 	coll := testcollation(t, collname).(*Collation_uca_legacy)
-	iter := coll.uca.Iterator(input)
+	iter := coll.uca.get().Iterator(input)
 
 	for {
 		curcp, _ := iter.DebugCodepoint()
@@ -0,0 +1,189 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colldata
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/mysql/collations/internal/uca"
+)
+
+// WeightTable is a dump of the weights of a UCA collation, in the same format
+// as the dumps of MySQL's collations generated by `colldump`, so that they can
+// be cross-checked when debugging an ordering mismatch.
+type WeightTable struct {
+	Name string
+	// Weights are the collation elements of the codepoints that have weights
+	// in the table of the collation, keyed by their "U+XXXX" notation.
+	Weights map[string][]uint16
+	// Contractions are the sequences of codepoints with weights of their own,
+	// sorted by their paths.
+	Contractions []Contraction
+}
+
+// Contraction is a sequence of codepoints that is weighted as a whole. A
+// contextual contraction is the weight of its first codepoint when it follows
+// its second one.
+type Contraction struct {
+	Path       []rune
+	Weights    []uint16
+	Contextual bool
+}
+
+// DumpWeightTable returns the weights of the given UCA collation. It's only
+// meant for debugging, since it goes through every codepoint.
+func DumpWeightTable(coll Collation) (*WeightTable, error) {
+	table, layout, contract, ok := ucaTables(coll)
+	if !ok {
+		return nil, fmt.Errorf("collation %s has no UCA weight table", coll.Name())
+	}
+
+	wt := &WeightTable{Name: coll.Name(), Weights: make(map[string][]uint16)}
+	for cp := rune(0); cp <= layout.MaxCodepoint(); cp++ {
+		if weights := layout.DebugWeights(table, cp); len(weights) > 0 {
+			wt.Weights[fmt.Sprintf("U+%04X", cp)] = weights
+		}
+	}
+	wt.Contractions = contractions(contract, layout)
+	return wt, nil
+}
+
+func ucaTables(coll Collation) (uca.Weights, uca.Layout, uca.Contractor, bool) {
+	switch coll := coll.(type) {
+	case *Collation_utf8mb4_uca_0900:
+		u := coll.uca.get()
+		table, layout := u.Weights()
+		return table, layout, u.Contractor(), true
+	case *Collation_uca_legacy:
+		u := coll.uca.get()
+		table, layout := u.Weights()
+		return table, layout, u.Contractor(), true
+	default:
+		return nil, nil, nil, false
+	}
+}
+
+func contractions(contract uca.Contractor, layout uca.Layout) []Contraction {
+	if contract == nil {
+		return nil
+	}
+	var result []Contraction
+	for _, cnt := range uca.DebugContractions(contract, layout.MaxCodepoint()) {
+		result = append(result, Contraction(cnt))
+	}
+	return result
+}
+
+// WeightStringMismatch is an input whose weight strings differ between two
+// implementations of a collation.
+type WeightStringMismatch struct {
+	Input    []byte
+	Expected []byte
+	Actual   []byte
+}
+
+// CompareWeightStrings returns the inputs whose weight strings with coll
+// differ from those with reference, e.g. a remote.Collation that is computed
+// by a MySQL server with WEIGHT_STRING.
+func CompareWeightStrings(coll, reference Collation, inputs [][]byte) []WeightStringMismatch {
+	var mismatches []WeightStringMismatch
+	for _, input := range inputs {
+		expected := reference.WeightString(nil, input, 0)
+		actual := coll.WeightString(nil, input, 0)
+		if !bytes.Equal(expected, actual) {
+			mismatches = append(mismatches, WeightStringMismatch{
+				Input:    input,
+				Expected: expected,
+				Actual:   actual,
+			})
+		}
+	}
+	return mismatches
+}
+
+// SampleWeightStringInputs returns inputs to cross-check the weight strings of
+// coll, encoded in its charset: n single codepoints and short strings of them,
+// chosen at random with the given seed among the codepoints of the charset,
+// and then the contractions of the collation, if it has any. The samples are
+// the same for a given collation and seed.
+func SampleWeightStringInputs(coll Collation, n int, seed uint64) [][]byte {
+	cs := coll.Charset()
+	maxCodepoint := rune(0xFFFF)
+	if cs.SupportsSupplementaryChars() {
+		maxCodepoint = utf8.MaxRune
+	}
+
+	// Only the codepoints that round-trip through the charset are sampled.
+	var codepoints []rune
+	buf := make([]byte, cs.MaxWidth())
+	for cp := rune(0); cp <= maxCodepoint; cp++ {
+		if !utf8.ValidRune(cp) {
+			continue
+		}
+		width := cs.EncodeRune(buf, cp)
+		if width <= 0 {
+			continue
+		}
+		if decoded, _ := cs.DecodeRune(buf[:width]); decoded == cp {
+			codepoints = append(codepoints, cp)
+		}
+	}
+	if len(codepoints) == 0 {
+		return nil
+	}
+
+	rng := rand.New(rand.NewPCG(seed, uint64(coll.ID())))
+	samples := make([][]byte, 0, n)
+	for len(samples) < n {
+		length := 1
+		if len(samples)%2 == 1 {
+			length += rng.IntN(4)
+		}
+		var sample []byte
+		for range length {
+			width := cs.EncodeRune(buf, codepoints[rng.IntN(len(codepoints))])
+			sample = append(sample, buf[:width]...)
+		}
+		samples = append(samples, sample)
+	}
+
+	if _, layout, contract, ok := ucaTables(coll); ok {
+		for _, cnt := range contractions(contract, layout) {
+			path := cnt.Path
+			if cnt.Contextual {
+				// The path of a contextual contraction starts with its last codepoint.
+				path = []rune{path[1], path[0]}
+			}
+			var sample []byte
+			for _, cp := range path {
+				width := cs.EncodeRune(buf, cp)
+				if width <= 0 {
+					sample = nil
+					break
+				}
+				sample = append(sample, buf[:width]...)
+			}
+			if sample != nil {
+				samples = append(samples, sample)
+			}
+		}
+	}
+	return samples
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colldata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations/charset"
+)
+
+func TestDumpWeightTable(t *testing.T) {
+	wt, err := DumpWeightTable(testcollation(t, "utf8mb4_cs_0900_as_cs"))
+	require.NoError(t, err)
+	assert.Equal(t, "utf8mb4_cs_0900_as_cs", wt.Name)
+	assert.NotEmpty(t, wt.Weights["U+0041"])
+	assert.Empty(t, wt.Weights["U+D800"])
+
+	// The Czech "ch" is a contraction in all its cases, and the contractions
+	// weigh the same as in their contractor.
+	var paths []string
+	for _, cnt := range wt.Contractions {
+		paths = append(paths, string(cnt.Path))
+		assert.False(t, cnt.Contextual)
+
+		coll := testcollation(t, "utf8mb4_cs_0900_as_cs").(*Collation_utf8mb4_uca_0900)
+		weights, remainder, skip := coll.uca.get().Contractor().Find(charset.Charset_utf8mb4{}, cnt.Path[0], []byte(string(cnt.Path[1:])))
+		assert.Equal(t, cnt.Weights, weights)
+		assert.Empty(t, remainder)
+		assert.Equal(t, len(cnt.Path), skip)
+	}
+	assert.Equal(t, []string{"CH", "Ch", "cH", "ch"}, paths)
+
+	wt, err = DumpWeightTable(testcollation(t, "utf8mb4_ja_0900_as_cs"))
+	require.NoError(t, err)
+	require.NotEmpty(t, wt.Contractions)
+	for _, cnt := range wt.Contractions {
+		assert.True(t, cnt.Contextual)
+		assert.Len(t, cnt.Path, 2)
+	}
+
+	wt, err = DumpWeightTable(testcollation(t, "utf16_czech_ci"))
+	require.NoError(t, err)
+	assert.Len(t, wt.Contractions, 3)
+
+	_, err = DumpWeightTable(testcollation(t, "utf8mb4_bin"))
+	assert.ErrorContains(t, err, "collation utf8mb4_bin has no UCA weight table")
+}
+
+func TestCompareWeightStrings(t *testing.T) {
+	coll := testcollation(t, "utf8mb4_cs_0900_ai_ci")
+	inputs := SampleWeightStringInputs(coll, 200, 1)
+	assert.Equal(t, inputs, SampleWeightStringInputs(coll, 200, 1))
+	assert.Len(t, inputs, 200+4)
+	assert.Contains(t, inputs, []byte("ch"))
+	assert.Empty(t, CompareWeightStrings(coll, coll, inputs))
+
+	reference := testcollation(t, "utf8mb4_0900_ai_ci")
+	mismatches := CompareWeightStrings(coll, reference, inputs)
+	require.NotEmpty(t, mismatches)
+	for _, m := range mismatches {
+		assert.Equal(t, reference.WeightString(nil, m.Input, 0), m.Expected)
+		assert.Equal(t, coll.WeightString(nil, m.Input, 0), m.Actual)
+	}
+
+	latin1 := testcollation(t, "latin1_swedish_ci")
+	for _, input := range SampleWeightStringInputs(latin1, 100, 1) {
+		_, err := charset.Convert(nil, charset.Charset_utf8mb4{}, input, latin1.Charset())
+		assert.NoError(t, err)
+	}
+}
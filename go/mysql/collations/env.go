@@ -56,12 +56,114 @@ func (env *Environment) LookupID(name string) (ID, bool) {
 	return Unknown, false
 }
 
+// LookupAlias returns the collation with the given name, accepting the names
+// that only differ from its canonical name in case, or in the spelling of the
+// utf8mb3 charset as `utf8` or `utf8mb3`. Like LookupID, the collation may be one
+// that this package doesn't support.
+//
+// If the given name is not the canonical name of the returned collation, the
+// returned note describes how it was resolved, so that it can be surfaced to the
+// user. An error is returned if the name does not resolve to a known collation.
+func (env *Environment) LookupAlias(name string) (ID, string, error) {
+	if name == "" {
+		return Unknown, "", fmt.Errorf("empty collation name")
+	}
+	id, canonical, how := env.lookupAlias(strings.ToLower(name))
+	if id == Unknown {
+		return Unknown, "", fmt.Errorf("unknown collation: %q", name)
+	}
+	return id, aliasNote(name, canonical, how), nil
+}
+
+// lookupAlias returns the collation with the given lowercase name or with the
+// other spelling of its utf8mb3 charset, the name it was resolved to, and how.
+func (env *Environment) lookupAlias(lowered string) (ID, string, string) {
+	if id, ok := env.LookupID(lowered); id != Unknown {
+		// the unsupported collations keep the names they are known by
+		if ok && lowered != env.LookupName(id) {
+			return id, env.LookupName(id), "is an alias for"
+		}
+		return id, lowered, "was normalized to"
+	}
+	for _, alias := range [][2]string{{"utf8_", "utf8mb3_"}, {"utf8mb3_", "utf8_"}} {
+		if suffix, ok := strings.CutPrefix(lowered, alias[0]); ok {
+			if id, _ := env.LookupID(alias[1] + suffix); id != Unknown {
+				return id, alias[1] + suffix, "is an alias for"
+			}
+		}
+	}
+	return Unknown, "", ""
+}
+
+// aliasNote returns the note for a name that was resolved to the canonical one,
+// if they differ.
+func aliasNote(name, canonical, how string) string {
+	if canonical == name {
+		return ""
+	}
+	return fmt.Sprintf("%q %s %q", name, how, canonical)
+}
+
+// LookupTolerant returns the supported collation with the given name, accepting
+// the spellings that LookupAlias accepts, surrounding whitespace, and the name
+// of a charset (or a charset alias such as `utf8`), or the name of a charset
+// suffixed with `_default`, for the default collation of that charset.
+//
+// If the given name is not the canonical name of the returned collation, the
+// returned note describes how it was resolved, so that it can be surfaced to the
+// user. An error is returned if the name does not resolve to a supported collation.
+func (env *Environment) LookupTolerant(name string) (ID, string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	if normalized == "" {
+		return Unknown, "", fmt.Errorf("empty collation name")
+	}
+
+	if id, canonical, how := env.lookupAlias(normalized); id != Unknown {
+		if env.LookupName(id) == "" {
+			return Unknown, "", fmt.Errorf("unsupported collation: %q", name)
+		}
+		return id, aliasNote(name, canonical, how), nil
+	}
+	charset, isDefault := strings.CutSuffix(normalized, "_default")
+	if id := env.DefaultCollationForCharset(charset); id != Unknown {
+		return id, aliasNote(name, env.LookupName(id), "resolved to the default collation of its charset"), nil
+	}
+	if isDefault {
+		return Unknown, "", fmt.Errorf("unknown charset: %q", charset)
+	}
+	return Unknown, "", fmt.Errorf("unknown collation: %q", name)
+}
+
 // LookupName returns the collation name for the given ID and whether
 // the collation is supported by this package.
 func (env *Environment) LookupName(id ID) string {
 	return env.byID[id]
 }
 
+// versionName returns the name of the given collation in this environment and
+// the name of its character set, whether this package supports the collation
+// or not, or empty strings if this version of MySQL doesn't know it.
+func (env *Environment) versionName(id ID) (name, charset string) {
+	for _, alias := range globalVersionInfo[id].alias {
+		if alias.mask&env.version != 0 {
+			return alias.name, alias.charset
+		}
+	}
+	return "", ""
+}
+
+// LookupUnsupported returns the collation with the given name if this version
+// of MySQL knows it but this package doesn't support it, with the name of its
+// character set, e.g. so that the collation can be implemented elsewhere.
+func (env *Environment) LookupUnsupported(name string) (ID, string, bool) {
+	id, ok := env.unsupported[name]
+	if !ok {
+		return Unknown, "", false
+	}
+	_, charset := env.versionName(id)
+	return id, charset, true
+}
+
 // DefaultCollationForCharset returns the default collation for a charset
 func (env *Environment) DefaultCollationForCharset(charset string) ID {
 	if defaults, ok := env.byCharset[charset]; ok {
@@ -180,14 +282,30 @@ func makeEnv(version collver) *Environment {
 	return env
 }
 
-// A few interesting character set values.
+// The IDs of the collations that Vitess uses by name. The IDs are assigned by
+// MySQL and are the same in all its versions, but the default collations of
+// the charsets are not: use the Environment to look up a default collation.
 // See http://dev.mysql.com/doc/internals/en/character-set.html#packet-Protocol::CharacterSet
 const (
-	CollationUtf8mb3ID     = 33
-	CollationUtf8mb4ID     = 255
-	CollationBinaryID      = 63
-	CollationUtf8mb4BinID  = 46
-	CollationLatin1Swedish = 8
+	// CollationUtf8mb3ID is utf8mb3_general_ci, the default collation of
+	// utf8mb3, which is also the collation of the system tables.
+	CollationUtf8mb3ID ID = 33
+	// CollationUtf8mb4ID is utf8mb4_0900_ai_ci, the default collation of
+	// utf8mb4 since MySQL 8.0.
+	CollationUtf8mb4ID ID = 255
+	// CollationUtf8mb4GeneralCiID is utf8mb4_general_ci, the default collation
+	// of utf8mb4 before MySQL 8.0.
+	CollationUtf8mb4GeneralCiID ID = 45
+	// CollationUtf8mb4BinID is utf8mb4_bin, with which the evalengine compares
+	// JSON values. The JSON fields themselves are returned with the collation
+	// given by CollationForType.
+	CollationUtf8mb4BinID ID = 46
+	// CollationBinaryID is the binary collation, of the binary strings and of
+	// all the values that are not strings.
+	CollationBinaryID ID = 63
+	// CollationLatin1Swedish is latin1_swedish_ci, the default collation of
+	// latin1.
+	CollationLatin1Swedish ID = 8
 )
 
 // SystemCollation is the default collation for the system tables
@@ -246,7 +364,7 @@ func (env *Environment) DefaultConnectionCharset() ID {
 	case collverMySQL8:
 		return CollationUtf8mb4ID
 	default:
-		return 45
+		return CollationUtf8mb4GeneralCiID
 	}
 }
 
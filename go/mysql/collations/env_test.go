@@ -0,0 +1,140 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestLookupTolerant(t *testing.T) {
+	env := MySQL8()
+
+	testCases := []struct {
+		name      string
+		collation string
+		note      string
+	}{
+		{"utf8mb4_0900_ai_ci", "utf8mb4_0900_ai_ci", ""},
+		{"utf8mb3_general_ci", "utf8mb3_general_ci", ""},
+		{"UTF8MB4_BIN", "utf8mb4_bin", `"UTF8MB4_BIN" was normalized to "utf8mb4_bin"`},
+		{" utf8mb4_bin ", "utf8mb4_bin", `" utf8mb4_bin " was normalized to "utf8mb4_bin"`},
+		{"utf8_general_ci", "utf8mb3_general_ci", `"utf8_general_ci" is an alias for "utf8mb3_general_ci"`},
+		{"UTF8_bin", "utf8mb3_bin", `"UTF8_bin" is an alias for "utf8mb3_bin"`},
+		{"utf8mb4", "utf8mb4_0900_ai_ci", `"utf8mb4" resolved to the default collation of its charset "utf8mb4_0900_ai_ci"`},
+		{"utf8", "utf8mb3_general_ci", `"utf8" resolved to the default collation of its charset "utf8mb3_general_ci"`},
+		{"latin1_default", "latin1_swedish_ci", `"latin1_default" resolved to the default collation of its charset "latin1_swedish_ci"`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			id, note, err := env.LookupTolerant(tc.name)
+			require.NoError(t, err)
+			assert.Equal(t, tc.collation, env.LookupName(id))
+			assert.Equal(t, tc.note, note)
+		})
+	}
+
+	for name, want := range map[string]string{
+		"":                         "empty collation name",
+		"default":                  `unknown collation: "default"`,
+		"utf8mb4_klingon_ci":       `unknown collation: "utf8mb4_klingon_ci"`,
+		"klingon_default":          `unknown charset: "klingon"`,
+		"utf8mb3_tolower_ci":       `unsupported collation: "utf8mb3_tolower_ci"`,
+		"utf8_general_mysql500_ci": `unsupported collation: "utf8_general_mysql500_ci"`,
+	} {
+		_, _, err := env.LookupTolerant(name)
+		assert.EqualError(t, err, want, name)
+	}
+}
+
+func TestCollationIDs(t *testing.T) {
+	ids := map[ID]string{
+		CollationUtf8mb3ID:          "utf8mb3_general_ci",
+		CollationUtf8mb4ID:          "utf8mb4_0900_ai_ci",
+		CollationUtf8mb4GeneralCiID: "utf8mb4_general_ci",
+		CollationUtf8mb4BinID:       "utf8mb4_bin",
+		CollationBinaryID:           "binary",
+		CollationLatin1Swedish:      "latin1_swedish_ci",
+	}
+	env := MySQL8()
+	for id, name := range ids {
+		assert.Equal(t, name, env.LookupName(id))
+	}
+
+	assert.Equal(t, CollationUtf8mb4ID, env.DefaultConnectionCharset())
+	assert.Equal(t, CollationUtf8mb4GeneralCiID, NewEnvironment("5.7.9").DefaultConnectionCharset())
+}
+
+func TestCollationForField(t *testing.T) {
+	testCases := []struct {
+		field *querypb.Field
+		want  ID
+	}{
+		{&querypb.Field{Type: sqltypes.VarChar, Charset: uint32(CollationUtf8mb3ID)}, CollationUtf8mb3ID},
+		{&querypb.Field{Type: sqltypes.VarChar}, Unknown},
+		{&querypb.Field{Type: sqltypes.Int64, Charset: uint32(CollationBinaryID)}, CollationBinaryID},
+		{&querypb.Field{Type: sqltypes.Int64}, CollationBinaryID},
+		{&querypb.Field{Type: sqltypes.TypeJSON}, CollationUtf8mb4ID},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, CollationForField(tc.field), tc.field.String())
+	}
+}
+
+func TestLookupAlias(t *testing.T) {
+	env := MySQL8()
+
+	testCases := []struct {
+		name      string
+		collation string
+		note      string
+	}{
+		{"utf8mb4_0900_ai_ci", "utf8mb4_0900_ai_ci", ""},
+		{"UTF8MB4_BIN", "utf8mb4_bin", `"UTF8MB4_BIN" was normalized to "utf8mb4_bin"`},
+		{"utf8_general_ci", "utf8mb3_general_ci", `"utf8_general_ci" is an alias for "utf8mb3_general_ci"`},
+		{"UTF8_bin", "utf8mb3_bin", `"UTF8_bin" is an alias for "utf8mb3_bin"`},
+		{"utf8mb3_tolower_ci", "", ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			id, note, err := env.LookupAlias(tc.name)
+			require.NoError(t, err)
+			assert.NotEqual(t, Unknown, id)
+			assert.Equal(t, tc.collation, env.LookupName(id))
+			assert.Equal(t, tc.note, note)
+		})
+	}
+
+	// MySQL only accepts these spellings as charsets, not as collations.
+	for name, want := range map[string]string{
+		"":                   "empty collation name",
+		"utf8mb4":            `unknown collation: "utf8mb4"`,
+		"latin1_default":     `unknown collation: "latin1_default"`,
+		"default":            `unknown collation: "default"`,
+		" utf8mb4_bin":       `unknown collation: " utf8mb4_bin"`,
+		"utf8mb4_klingon_ci": `unknown collation: "utf8mb4_klingon_ci"`,
+	} {
+		_, _, err := env.LookupAlias(name)
+		assert.EqualError(t, err, want, name)
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import "fmt"
+
+// In the MySQL protocol handshake, the server advertises its default collation
+// and the client requests the collation of its session, both as a single byte
+// called the "character set" of the connection. A server accepts any byte: the
+// sessions of the clients that request a collation it doesn't know use its
+// default collation instead, and only the collations of the character sets that
+// its parser can't read are rejected.
+
+// clientUnsupportedCharsets are the character sets that MySQL doesn't accept as
+// the character set of a client.
+var clientUnsupportedCharsets = map[string]bool{
+	"ucs2":    true,
+	"utf16":   true,
+	"utf16le": true,
+	"utf32":   true,
+}
+
+// HandshakeCharset returns the character set that a server of this environment
+// advertises in its initial handshake packet.
+func (env *Environment) HandshakeCharset() uint8 {
+	return uint8(env.DefaultConnectionCharset())
+}
+
+// HandshakeCollation returns the collation of the session of a client that
+// requested the given character set in its handshake response. The clients
+// that requested a collation that this version of MySQL doesn't know, or that
+// this package doesn't support, get the default connection collation. An error
+// is returned for the collations of the character sets that can't be used by a
+// client, like utf16, which MySQL rejects.
+func (env *Environment) HandshakeCollation(charset uint8) (ID, error) {
+	id := ID(charset)
+	if _, cs := env.versionName(id); clientUnsupportedCharsets[cs] {
+		return Unknown, fmt.Errorf("Variable 'character_set_client' can't be set to the value of '%s'", cs)
+	}
+	if !env.IsSupported(id) {
+		return env.DefaultConnectionCharset(), nil
+	}
+	return id, nil
+}
+
+// HandshakeCollations returns the collation of the session of a client for all
+// the character sets it can request in its handshake response, as returned by
+// HandshakeCollation. The character sets that are rejected map to Unknown.
+func (env *Environment) HandshakeCollations() [256]ID {
+	var collations [256]ID
+	for charset := range collations {
+		collations[charset], _ = env.HandshakeCollation(uint8(charset))
+	}
+	return collations
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandshakeCollation(t *testing.T) {
+	testCases := []struct {
+		version   string
+		charset   uint8
+		collation string
+		err       string
+	}{
+		{version: "8.0.30", charset: uint8(CollationUtf8mb4ID), collation: "utf8mb4_0900_ai_ci"},
+		{version: "8.0.30", charset: 45, collation: "utf8mb4_general_ci"},
+		{version: "8.0.30", charset: uint8(CollationLatin1Swedish), collation: "latin1_swedish_ci"},
+		{version: "8.0.30", charset: uint8(CollationBinaryID), collation: "binary"},
+		{version: "8.0.30", charset: uint8(CollationUtf8mb3ID), collation: "utf8mb3_general_ci"},
+		{version: "8.0.30", charset: 0, collation: "utf8mb4_0900_ai_ci"},
+		{version: "8.0.30", charset: 100, collation: "utf8mb4_0900_ai_ci"},
+		{version: "8.0.30", charset: 35, err: "Variable 'character_set_client' can't be set to the value of 'ucs2'"},
+		{version: "8.0.30", charset: 54, err: "Variable 'character_set_client' can't be set to the value of 'utf16'"},
+		{version: "8.0.30", charset: 56, err: "Variable 'character_set_client' can't be set to the value of 'utf16le'"},
+		{version: "8.0.30", charset: 60, err: "Variable 'character_set_client' can't be set to the value of 'utf32'"},
+		{version: "5.7.31", charset: uint8(CollationUtf8mb4ID), collation: "utf8mb4_general_ci"},
+		{version: "5.7.31", charset: 0, collation: "utf8mb4_general_ci"},
+		{version: "5.7.31", charset: uint8(CollationUtf8mb3ID), collation: "utf8mb3_general_ci"},
+	}
+
+	for _, tc := range testCases {
+		env := NewEnvironment(tc.version)
+		id, err := env.HandshakeCollation(tc.charset)
+		if tc.err != "" {
+			assert.EqualError(t, err, tc.err, "%s: charset %d", tc.version, tc.charset)
+			assert.Equal(t, Unknown, id)
+			continue
+		}
+		require.NoError(t, err, "%s: charset %d", tc.version, tc.charset)
+		assert.Equal(t, tc.collation, env.LookupName(id), "%s: charset %d", tc.version, tc.charset)
+	}
+}
+
+func TestHandshakeCollations(t *testing.T) {
+	for _, version := range []string{"8.0.30", "5.7.31", "5.6.51", "10.3.39-MariaDB"} {
+		env := NewEnvironment(version)
+		assert.Equal(t, uint8(env.DefaultConnectionCharset()), env.HandshakeCharset(), version)
+
+		collations := env.HandshakeCollations()
+		for charset, id := range collations {
+			want, err := env.HandshakeCollation(uint8(charset))
+			assert.Equal(t, want, id, "%s: charset %d", version, charset)
+			if err != nil {
+				continue
+			}
+			// every session gets a collation that this package supports
+			assert.True(t, env.IsSupported(id), "%s: charset %d", version, charset)
+			if env.IsSupported(ID(charset)) {
+				assert.Equal(t, ID(charset), id, "%s: charset %d", version, charset)
+			} else {
+				assert.Equal(t, env.DefaultConnectionCharset(), id, "%s: charset %d", version, charset)
+			}
+		}
+	}
+}
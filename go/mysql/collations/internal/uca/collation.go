@@ -23,6 +23,7 @@ import (
 )
 
 type Collation interface {
+	Version() Version
 	Charset() charset.Charset
 	Weights() (Weights, Layout)
 	WeightForSpace() uint16
@@ -32,6 +33,7 @@ type Collation interface {
 var _ Collation = (*Collation900)(nil)
 
 type Collation900 struct {
+	version   Version
 	table     Weights
 	implicits func([]uint16, rune)
 	contract  Contractor
@@ -40,6 +42,10 @@ type Collation900 struct {
 	iterpool  sync.Pool
 }
 
+func (c *Collation900) Version() Version {
+	return c.version
+}
+
 func (c *Collation900) Charset() charset.Charset {
 	return charset.Charset_utf8mb4{}
 }
@@ -74,10 +80,11 @@ func (c *Collation900) WeightsEqual(left, right rune) bool {
 	return equalWeights900(c.table, c.maxLevel, left, right)
 }
 
-func NewCollation(name string, weights Weights, weightPatches []Patch, reorder []Reorder, contract Contractor, upperCaseFirst bool, levels int) *Collation900 {
+func NewCollation(version Version, name string, weights Weights, weightPatches []Patch, reorder []Reorder, contract Contractor, upperCaseFirst bool, levels int) *Collation900 {
 	coll := &Collation900{
+		version:   version,
 		table:     ApplyTailoring(Layout_uca900{}, weights, weightPatches),
-		implicits: UnicodeImplicitWeights900,
+		implicits: version.implicitWeights(),
 		contract:  contract,
 		maxLevel:  levels,
 		param:     newParametricTailoring(reorder, upperCaseFirst),
@@ -107,12 +114,17 @@ func NewCollation(name string, weights Weights, weightPatches []Patch, reorder [
 var _ Collation = (*CollationLegacy)(nil)
 
 type CollationLegacy struct {
+	version      Version
 	charset      charset.Charset
 	table        Weights
 	maxCodepoint rune
 	contract     Contractor
 }
 
+func (c *CollationLegacy) Version() Version {
+	return c.version
+}
+
 func (c *CollationLegacy) Charset() charset.Charset {
 	return c.charset
 }
@@ -145,8 +157,9 @@ func (c *CollationLegacy) WeightsEqual(left, right rune) bool {
 	return equalWeightsLegacy(c.table, left, right)
 }
 
-func NewCollationLegacy(cs charset.Charset, weights Weights, weightPatches []Patch, contract Contractor, maxCodepoint rune) *CollationLegacy {
+func NewCollationLegacy(version Version, cs charset.Charset, weights Weights, weightPatches []Patch, contract Contractor, maxCodepoint rune) *CollationLegacy {
 	return &CollationLegacy{
+		version:      version,
 		charset:      cs,
 		table:        ApplyTailoring(Layout_uca_legacy{}, weights, weightPatches),
 		maxCodepoint: maxCodepoint,
@@ -17,6 +17,8 @@ limitations under the License.
 package uca
 
 import (
+	"slices"
+
 	"vitess.io/vitess/go/mysql/collations/charset"
 )
 
@@ -30,3 +32,106 @@ type Contractor interface {
 	Find(cs charset.Charset, cp rune, remainder []byte) ([]uint16, []byte, int)
 	FindContextual(cp1, cp0 rune) []uint16
 }
+
+// ContextualContractor is implemented by the Contractors of contextual
+// contractions, which can list their weights: they're keyed by the current
+// codepoint in the upper 16 bits and by the previous one in the lower 16 bits.
+type ContextualContractor interface {
+	Contractor
+	ContextualWeights() map[uint32][]uint16
+}
+
+// DebugContractions lists the contractions of contract whose codepoints are
+// all lower or equal to maxCodepoint, sorted by path. It's only meant for
+// debugging: the Contractors are generated as tries that cannot be listed, so
+// they're explored by decoding the remainders of the inputs with a charset that
+// keeps track of how many codepoints were looked at.
+func DebugContractions(contract Contractor, maxCodepoint rune) []Contraction {
+	var result []Contraction
+	if ctx, ok := contract.(ContextualContractor); ok {
+		for mask, weights := range ctx.ContextualWeights() {
+			result = append(result, Contraction{
+				Path:       []rune{rune(mask >> 16), rune(mask & 0xFFFF)},
+				Weights:    weights,
+				Contextual: true,
+			})
+		}
+	}
+
+	probe := &probeCharset{}
+	var explore func(path []rune)
+	explore = func(path []rune) {
+		for cp := rune(0); cp <= maxCodepoint; cp++ {
+			path := append(path, cp)
+			weights, skip := probe.find(contract, path)
+			if weights != nil && skip == len(path) {
+				result = append(result, Contraction{Path: slices.Clone(path), Weights: weights})
+			}
+			if probe.decoded >= len(path) && len(path) < maxContractionLength {
+				explore(path)
+			}
+		}
+	}
+	for cp := rune(0); cp <= maxCodepoint; cp++ {
+		if _, _ = probe.find(contract, []rune{cp}); probe.decoded > 0 {
+			explore([]rune{cp})
+		}
+	}
+
+	slices.SortFunc(result, func(a, b Contraction) int {
+		return slices.Compare(a.Path, b.Path)
+	})
+	return result
+}
+
+// maxContractionLength is longer than any contraction of MySQL's collations.
+const maxContractionLength = 8
+
+// probeCharset decodes the codepoints of a path after its first one, each of
+// them from a single byte, and then codepoints that never match.
+type probeCharset struct {
+	path    []rune
+	input   []byte
+	decoded int
+}
+
+func (p *probeCharset) find(contract Contractor, path []rune) ([]uint16, int) {
+	p.path = path
+	p.decoded = 0
+	if p.input == nil {
+		p.input = make([]byte, maxContractionLength)
+	}
+	weights, _, skip := contract.Find(p, path[0], p.input)
+	return weights, skip
+}
+
+func (p *probeCharset) Name() string {
+	return "probe"
+}
+
+func (p *probeCharset) SupportsSupplementaryChars() bool {
+	return true
+}
+
+func (p *probeCharset) IsSuperset(charset.Charset) bool {
+	return false
+}
+
+func (p *probeCharset) MaxWidth() int {
+	return 1
+}
+
+func (p *probeCharset) EncodeRune([]byte, rune) int {
+	return -1
+}
+
+func (p *probeCharset) DecodeRune(b []byte) (rune, int) {
+	if len(b) == 0 {
+		return charset.RuneError, 0
+	}
+	p.decoded++
+	if pos := maxContractionLength - len(b) + 1; pos < len(p.path) {
+		return p.path[pos], 1
+	}
+	return -1, 1
+}
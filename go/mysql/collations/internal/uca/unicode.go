@@ -80,6 +80,54 @@ func UnicodeImplicitWeights900(weights []uint16, codepoint rune) {
 	weights[5] = 0x0000
 }
 
+// UnicodeImplicitWeights1400 generates the implicit weights for this codepoint.
+// This is a straight port of the algorithm in https://www.unicode.org/reports/tr10/tr10-45.html#Implicit_Weights
+// It only applies to the UCA Standard v14.0.0
+func UnicodeImplicitWeights1400(weights []uint16, codepoint rune) {
+	var aaaa, bbbb uint16
+
+	switch {
+	case (codepoint >= 0x17000 && codepoint <= 0x18AFF) ||
+		(codepoint >= 0x18D00 && codepoint <= 0x18D8F):
+		aaaa = 0xFB00
+		bbbb = uint16(codepoint-0x17000) | 0x8000
+
+	case codepoint >= 0x1B170 && codepoint <= 0x1B2FF:
+		aaaa = 0xFB01
+		bbbb = uint16(codepoint-0x1B170) | 0x8000
+
+	case codepoint >= 0x18B00 && codepoint <= 0x18CFF:
+		aaaa = 0xFB02
+		bbbb = uint16(codepoint-0x18B00) | 0x8000
+
+	case (codepoint >= 0x4E00 && codepoint <= 0x9FFF) ||
+		(codepoint >= 0xFA0E && codepoint <= 0xFA29):
+		aaaa = 0xFB40 + uint16(codepoint>>15)
+		bbbb = uint16(codepoint&0x7FFF) | 0x8000
+
+	case (codepoint >= 0x3400 && codepoint <= 0x4DBF) ||
+		(codepoint >= 0x20000 && codepoint <= 0x2A6DF) ||
+		(codepoint >= 0x2A700 && codepoint <= 0x2B738) ||
+		(codepoint >= 0x2B740 && codepoint <= 0x2B81D) ||
+		(codepoint >= 0x2B820 && codepoint <= 0x2CEA1) ||
+		(codepoint >= 0x2CEB0 && codepoint <= 0x2EBE0) ||
+		(codepoint >= 0x30000 && codepoint <= 0x3134A):
+		aaaa = 0xFB80 + uint16(codepoint>>15)
+		bbbb = uint16(codepoint&0x7FFF) | 0x8000
+
+	default:
+		aaaa = 0xFBC0 + uint16(codepoint>>15)
+		bbbb = uint16(codepoint&0x7FFF) | 0x8000
+	}
+
+	weights[0] = aaaa
+	weights[1] = 0x0020
+	weights[2] = 0x0002
+	weights[3] = bbbb
+	weights[4] = 0x0000
+	weights[5] = 0x0000
+}
+
 // UnicodeImplicitWeightsLegacy generates the implicit weights for this codepoint.
 // This is a straight port of the algorithm in https://www.unicode.org/reports/tr10/tr10-20.html#Implicit_Weights
 // It only applies to the UCA Standard v4.0.0 and v5.2.0
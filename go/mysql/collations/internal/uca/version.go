@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uca
+
+// Version is a revision of the Unicode Collation Algorithm. Each family of
+// MySQL UCA collations is built on the weight tables of a specific version,
+// and the version also decides how implicit weights are computed for the
+// codepoints that are not listed in those tables.
+type Version int
+
+const (
+	UCAUnknown Version = 0
+	UCA400     Version = 400
+	UCA520     Version = 520
+	UCA900     Version = 900
+	UCA1400    Version = 1400
+)
+
+func (v Version) String() string {
+	switch v {
+	case UCA400:
+		return "4.0.0"
+	case UCA520:
+		return "5.2.0"
+	case UCA900:
+		return "9.0.0"
+	case UCA1400:
+		return "14.0.0"
+	default:
+		return "unknown"
+	}
+}
+
+// implicitWeights returns the function that generates the implicit weights
+// for the given version of the UCA 9.0.0+ collations.
+func (v Version) implicitWeights() func([]uint16, rune) {
+	if v == UCA1400 {
+		return UnicodeImplicitWeights1400
+	}
+	return UnicodeImplicitWeights900
+}
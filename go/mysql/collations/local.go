@@ -20,6 +20,8 @@ package collations
 
 import (
 	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 // MySQL8 is the collation Environment for MySQL 8. This should
@@ -29,6 +31,9 @@ func MySQL8() *Environment {
 	return fetchCacheEnvironment(collverMySQL8)
 }
 
+// CollationForType returns the collation of the values of the given type: the
+// fallback collation for the text types, utf8mb4_0900_ai_ci for JSON, and the binary
+// collation for all the other types.
 func CollationForType(t sqltypes.Type, fallback ID) ID {
 	switch {
 	case sqltypes.IsText(t):
@@ -39,3 +44,13 @@ func CollationForType(t sqltypes.Type, fallback ID) ID {
 		return CollationBinaryID
 	}
 }
+
+// CollationForField returns the collation of the values of a field, which is
+// given by its charset. The fields without a charset get the collation of
+// their type, and the text ones the Unknown collation.
+func CollationForField(field *querypb.Field) ID {
+	if field.Charset != 0 {
+		return ID(field.Charset)
+	}
+	return CollationForType(field.Type, Unknown)
+}
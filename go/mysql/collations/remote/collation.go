@@ -79,6 +79,19 @@ func NewCollation(conn *mysql.Conn, collname string) *Collation {
 	return makeRemoteCollation(conn, collations.Unknown, collname)
 }
 
+// NewFallback returns a colldata.Fallback that implements the collations that
+// Vitess doesn't support on the MySQL server that connect connects to, with a
+// connection for each collation.
+func NewFallback(connect func() (*mysql.Conn, error)) colldata.Fallback {
+	return func(id collations.ID, name string) (colldata.Collation, error) {
+		conn, err := connect()
+		if err != nil {
+			return nil, err
+		}
+		return makeRemoteCollation(conn, id, name), nil
+	}
+}
+
 func (c *Collation) LastError() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -0,0 +1,115 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// collweights dumps the weights of a collation, or cross-checks its weight
+// strings with those of a MySQL server, to debug ordering mismatches:
+//
+//	collweights dump utf8mb4_cs_0900_ai_ci > utf8mb4_cs_0900_ai_ci.json
+//	collweights diff --socket /tmp/mysql.sock --user root utf8mb4_cs_0900_ai_ci
+//
+// The dumps have the format of the dumps of MySQL's collations generated by
+// colldump. The mismatches are printed as JSON lines with hex-encoded inputs
+// and weight strings.
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"vitess.io/vitess/go/internal/flag"
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/mysql/collations/colldata"
+	"vitess.io/vitess/go/mysql/collations/remote"
+)
+
+func main() {
+	var (
+		connParams mysql.ConnParams
+		samples    = 10000
+		seed       uint64
+	)
+
+	fs := pflag.NewFlagSet("collweights", pflag.ExitOnError)
+	fs.StringVar(&connParams.Host, "host", "", "host of the MySQL server to diff with")
+	fs.IntVar(&connParams.Port, "port", 3306, "port of the MySQL server to diff with")
+	fs.StringVar(&connParams.UnixSocket, "socket", "", "unix socket of the MySQL server to diff with")
+	fs.StringVar(&connParams.Uname, "user", "root", "user to connect to the MySQL server with")
+	fs.StringVar(&connParams.Pass, "password", "", "password to connect to the MySQL server with")
+	fs.IntVar(&samples, "samples", samples, "number of random inputs to diff, on top of the contractions of the collation")
+	fs.Uint64Var(&seed, "seed", seed, "seed of the random inputs to diff")
+	flag.Parse(fs)
+
+	args := fs.Args()
+	if len(args) != 2 {
+		log.Fatalf("usage: collweights [flags] (dump|diff) <collation>")
+	}
+
+	env := collations.MySQL8()
+	id := env.LookupByName(args[1])
+	coll := colldata.Lookup(id)
+	if coll == nil {
+		log.Fatalf("unknown collation: %s", args[1])
+	}
+
+	switch args[0] {
+	case "dump":
+		wt, err := colldata.DumpWeightTable(coll)
+		if err != nil {
+			log.Fatal(err)
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(wt); err != nil {
+			log.Fatal(err)
+		}
+	case "diff":
+		conn, err := mysql.Connect(context.Background(), &connParams)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		reference := remote.NewCollation(conn, coll.Name())
+		mismatches := colldata.CompareWeightStrings(coll, reference, colldata.SampleWeightStringInputs(coll, samples, seed))
+		if err := reference.LastError(); err != nil {
+			log.Fatal(err)
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+		for _, m := range mismatches {
+			if err := enc.Encode(map[string]string{
+				"input":    hex.EncodeToString(m.Input),
+				"expected": hex.EncodeToString(m.Expected),
+				"actual":   hex.EncodeToString(m.Actual),
+			}); err != nil {
+				log.Fatal(err)
+			}
+		}
+		if len(mismatches) > 0 {
+			fmt.Fprintf(os.Stderr, "%d weight strings of %s differ from MySQL's\n", len(mismatches), coll.Name())
+			os.Exit(1)
+		}
+	default:
+		log.Fatalf("unknown command %q: expected dump or diff", args[0])
+	}
+}
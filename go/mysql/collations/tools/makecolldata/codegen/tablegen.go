@@ -19,6 +19,7 @@ package codegen
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"log"
@@ -61,6 +62,12 @@ func WriteFastPage32(g *Generator, varname string, values []uint32) {
 type EmbedPageGenerator struct {
 	index map[string]string
 	raw   bytes.Buffer
+	pages []embedPage
+}
+
+type embedPage struct {
+	varname     string
+	pos, length int
 }
 
 func hashWeights(values []uint16) string {
@@ -79,7 +86,8 @@ func (pg *EmbedPageGenerator) WritePage16(g *Generator, varname string, values [
 
 	pg.index[hash] = varname
 
-	g.P("var ", varname, " = weightsUCA_embed(", pg.raw.Len()/2, ", ", len(values), ")")
+	g.P("var ", varname, " []uint16")
+	pg.pages = append(pg.pages, embedPage{varname: varname, pos: pg.raw.Len() / 2, length: len(values)})
 
 	for _, v := range values {
 		pg.raw.WriteByte(byte(v))
@@ -88,21 +96,54 @@ func (pg *EmbedPageGenerator) WritePage16(g *Generator, varname string, values [
 	return "&" + varname
 }
 
+// The collation data file starts with a header of weightsUCAHeaderLen bytes, which
+// colldata checks before it loads an external file: weightsUCAMagic, the version of
+// the format of the file and the number of weights, as little-endian uint32s, and
+// the SHA-256 checksum of the weights. They must match the constants of colldata.
+const (
+	weightsUCAMagic     = "VTUCADAT"
+	weightsUCAVersion   = 1
+	weightsUCAHeaderLen = 48
+)
+
+func (pg *EmbedPageGenerator) checksum() []byte {
+	sum := sha256.Sum256(pg.raw.Bytes())
+	return sum[:]
+}
+
+func (pg *EmbedPageGenerator) header() []byte {
+	header := make([]byte, 0, weightsUCAHeaderLen)
+	header = append(header, weightsUCAMagic...)
+	header = binary.LittleEndian.AppendUint32(header, weightsUCAVersion)
+	header = binary.LittleEndian.AppendUint32(header, uint32(pg.raw.Len()/2))
+	return append(header, pg.checksum()...)
+}
+
 func (pg *EmbedPageGenerator) WriteTrailer(g *Generator, embedfile string) {
 	unsafe := Package("unsafe")
-	g.UsePackage("embed")
 
 	g.P()
-	g.P("//go:embed ", embedfile)
-	g.P("var weightsUCA_embed_data string")
+	g.P("// weightsUCA_len is the number of weights in ", embedfile)
+	g.P("const weightsUCA_len = ", pg.raw.Len()/2)
+	g.P()
+	g.P("// weightsUCA_checksum is the SHA-256 checksum of the weights in ", embedfile)
+	g.P("const weightsUCA_checksum = \"", hex.EncodeToString(pg.checksum()), "\"")
+	g.P()
+	g.P("// loadWeightsUCA points all the weight table pages to the weights in data, which")
+	g.P("// must have the contents of ", embedfile, " after its header")
+	g.P("func loadWeightsUCA(data string) {")
+	for _, p := range pg.pages {
+		g.P(p.varname, " = weightsUCA_slice(data, ", p.pos, ", ", p.length, ")")
+	}
+	g.P("}")
 	g.P()
-	g.P("func weightsUCA_embed(pos, length int) []uint16 {")
-	g.P("return (*[0x7fff0000]uint16)(", unsafe, ".Pointer(", unsafe, ".StringData(weightsUCA_embed_data)))[pos:pos+length]")
+	g.P("func weightsUCA_slice(data string, pos, length int) []uint16 {")
+	g.P("return (*[0x3fffffff]uint16)(", unsafe, ".Pointer(", unsafe, ".StringData(data)))[pos:pos+length]")
 	g.P("}")
 }
 
 func (pg *EmbedPageGenerator) WriteToFile(out string) {
-	if err := os.WriteFile(out, pg.raw.Bytes(), 0644); err != nil {
+	if err := os.WriteFile(out, append(pg.header(), pg.raw.Bytes()...), 0644); err != nil {
 		log.Fatal(err)
 	}
 	log.Printf("written %q (%.02fkb)", out, float64(pg.raw.Len())/1024.0)
@@ -130,6 +130,9 @@ func (g *TableGenerator) printFastContractionsCtx(name string, allContractions [
 	g.P("}")
 	g.P("return ", name, "_weights[uint32(cp1) << 16 | uint32(cp0)]")
 	g.P("}")
+	g.P("func (", name, ") ContextualWeights() map[uint32][]uint16 {")
+	g.P("return ", name, "_weights")
+	g.P("}")
 }
 
 func (g *TableGenerator) printContractionsFast(name string, allContractions []uca.Contraction) {
@@ -96,16 +96,23 @@ func loadMysqlMetadata() (all AllMetadata) {
 	return
 }
 
-func (all AllMetadata) get(name string) *CollationMetadata {
+func (all AllMetadata) lookup(name string) *CollationMetadata {
 	for _, meta := range all {
 		if meta.Name == name {
 			return meta
 		}
 	}
-	log.Fatalf("missing collation: %s", name)
 	return nil
 }
 
+func (all AllMetadata) get(name string) *CollationMetadata {
+	meta := all.lookup(name)
+	if meta == nil {
+		log.Fatalf("missing collation: %s", name)
+	}
+	return meta
+}
+
 const PkgCollationsData codegen.Package = "vitess.io/vitess/go/mysql/collations/colldata"
 const PkgCharset codegen.Package = "vitess.io/vitess/go/mysql/collations/charset"
 
@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"log"
 	"path"
 	"strconv"
 
@@ -24,6 +25,44 @@ import (
 	"vitess.io/vitess/go/mysql/collations/tools/makecolldata/codegen"
 )
 
+// ucaTable is a UCA weight table that is shipped with the collations. The
+// weights are taken from the root collation of its UCA version; the optional
+// tables are only generated when the MySQL server that was dumped supports
+// their version.
+type ucaTable struct {
+	name      string
+	collation string
+	version   uca.Version
+	layout    uca.Layout
+	fast      bool
+	optional  bool
+}
+
+var ucaTables = []ucaTable{
+	{name: "uca900", collation: "utf8mb4_0900_ai_ci", version: uca.UCA900, layout: uca.Layout_uca900{}, fast: true},
+	{name: "uca900_ja", collation: "utf8mb4_ja_0900_as_cs", version: uca.UCA900, layout: uca.Layout_uca900{}},
+	{name: "uca900_zh", collation: "utf8mb4_zh_0900_as_cs", version: uca.UCA900, layout: uca.Layout_uca900{}},
+	{name: "uca1400", collation: "utf8mb4_unicode_1400_ai_ci", version: uca.UCA1400, layout: uca.Layout_uca900{}, optional: true},
+	{name: "uca400", collation: "utf8mb4_unicode_ci", version: uca.UCA400, layout: uca.Layout_uca_legacy{}},
+	{name: "uca520", collation: "utf8mb4_unicode_520_ci", version: uca.UCA520, layout: uca.Layout_uca_legacy{}},
+}
+
+// baseWeights returns the weights of the root collation for every UCA version
+// that has a weight table available, so that tailored collations can be
+// generated as patches on top of them.
+func baseWeights(metadata AllMetadata) map[uca.Version]TailoringWeights {
+	weights := make(map[uca.Version]TailoringWeights)
+	for _, table := range ucaTables {
+		if _, ok := weights[table.version]; ok {
+			continue
+		}
+		if meta := metadata.lookup(table.collation); meta != nil {
+			weights[table.version] = meta.Weights
+		}
+	}
+	return weights
+}
+
 func maketable(g *codegen.Generator, table string, collation *CollationMetadata, pages codegen.PageGenerator, layout uca.Layout) *codegen.TableGenerator {
 	tg := codegen.NewTableGenerator(table, pages)
 	for key, weights := range collation.Weights {
@@ -42,14 +81,21 @@ func maketables(embed bool, output string, metadata AllMetadata) {
 	var g = codegen.NewGenerator("vitess.io/vitess/go/mysql/collations/colldata")
 	var fastg = codegen.NewGenerator("vitess.io/vitess/go/mysql/collations/internal/uca")
 
-	tablegen := maketable(g, "uca900", metadata.get("utf8mb4_0900_ai_ci"), pages, uca.Layout_uca900{})
-	tablegen.WriteFastTables(fastg, uca.Layout_uca900{})
-
-	maketable(g, "uca900_ja", metadata.get("utf8mb4_ja_0900_as_cs"), pages, uca.Layout_uca900{})
-	maketable(g, "uca900_zh", metadata.get("utf8mb4_zh_0900_as_cs"), pages, uca.Layout_uca900{})
+	for _, table := range ucaTables {
+		meta := metadata.lookup(table.collation)
+		if meta == nil {
+			if !table.optional {
+				log.Fatalf("missing collation for weight table %s: %s", table.name, table.collation)
+			}
+			log.Printf("skipping weight table %s: collation %s is not available", table.name, table.collation)
+			continue
+		}
 
-	maketable(g, "uca400", metadata.get("utf8mb4_unicode_ci"), pages, uca.Layout_uca_legacy{})
-	maketable(g, "uca520", metadata.get("utf8mb4_unicode_520_ci"), pages, uca.Layout_uca_legacy{})
+		tablegen := maketable(g, table.name, meta, pages, table.layout)
+		if table.fast {
+			tablegen.WriteFastTables(fastg, table.layout)
+		}
+	}
 
 	if pages, ok := pages.(*codegen.EmbedPageGenerator); ok {
 		pages.WriteTrailer(g, "mysqlucadata.bin")
@@ -35,9 +35,7 @@ type TableGenerator struct {
 	*codegen.Generator
 	dedup map[string]string
 
-	baseWeightsUca400 TailoringWeights
-	baseWeightsUca520 TailoringWeights
-	baseWeightsUca900 TailoringWeights
+	baseWeights map[uca.Version]TailoringWeights
 }
 
 type Generator struct {
@@ -109,30 +107,26 @@ func (g *Generator) printCollationUcaLegacy(meta *CollationMetadata) {
 		g.Fail("invalid UCAVersion")
 	}
 
-	g.P("uca: uca.NewCollationLegacy(",
+	g.P("uca: newLazyCollation(", codegen.Quote(meta.Name), ", func() *uca.CollationLegacy {")
+	g.P("return uca.NewCollationLegacy(",
+		"uca.UCA", meta.UCAVersion, ",",
 		PkgCharset, ".Charset_", meta.Charset, "{},",
 		"weightTable_uca", meta.UCAVersion, ",",
 		or(tableWeightPatches, "nil"), ",",
 		or(tableContractions, "nil"), ",",
-		maxCodepoint, "),",
+		maxCodepoint, ")",
 	)
+	g.P("}),")
 	g.P("},")
 }
 
 func (g *TableGenerator) writeWeightPatches(meta *CollationMetadata) string {
 	var tableWeightPatches string
 	var dedup bool
-	var baseWeights TailoringWeights
 
-	switch meta.UCAVersion {
-	case 400:
-		baseWeights = g.baseWeightsUca400
-	case 520:
-		baseWeights = g.baseWeightsUca520
-	case 900:
-		baseWeights = g.baseWeightsUca900
-	default:
-		g.Fail("invalid UCAVersion")
+	baseWeights, ok := g.baseWeights[uca.Version(meta.UCAVersion)]
+	if !ok {
+		g.Fail(fmt.Sprintf("no weight table for UCAVersion %d", meta.UCAVersion))
 	}
 
 	diff := diffMaps(baseWeights, meta.Weights)
@@ -178,11 +172,13 @@ func (g *TableGenerator) writeReorders(meta *CollationMetadata) string {
 }
 
 func (g *Generator) printCollationUca900(meta *CollationMetadata) {
-	if meta.UCAVersion != 900 {
+	switch meta.UCAVersion {
+	case 900, 1400:
+	default:
 		g.Fail("unexpected UCA version for UCA900 collation")
 	}
 
-	tableWeights := "weightTable_uca900"
+	tableWeights := fmt.Sprintf("weightTable_uca%d", meta.UCAVersion)
 	switch meta.Name {
 	case "utf8mb4_zh_0900_as_cs":
 		// the chinese weights table is large enough that we don't apply weight patches
@@ -224,15 +220,18 @@ func (g *Generator) printCollationUca900(meta *CollationMetadata) {
 	default:
 		g.Fail(fmt.Sprintf("unknown levelsForCompare: %q", meta.Name))
 	}
-	g.P("uca: uca.NewCollation(",
+	g.P("uca: newLazyCollation(", name, ", func() *uca.Collation900 {")
+	g.P("return uca.NewCollation(",
+		"uca.UCA", meta.UCAVersion, ",",
 		name, ",",
 		tableWeights, ",",
 		or(tableWeightPatches, "nil"), ",",
 		or(tableReorder, "nil"), ",",
 		or(tableContractions, "nil"), ",",
 		meta.UpperCaseFirst, ",",
-		levels, "),",
+		levels, ")",
 	)
+	g.P("}),")
 	g.P("},")
 }
 
@@ -358,11 +357,9 @@ func makemysqldata(output string, supportedOutput string, metadata AllMetadata)
 	var g = Generator{
 		Generator: codegen.NewGenerator(PkgCollationsData),
 		Tables: TableGenerator{
-			Generator:         codegen.NewGenerator(PkgCollationsData),
-			dedup:             make(map[string]string),
-			baseWeightsUca400: metadata.get("utf8mb4_unicode_ci").Weights,
-			baseWeightsUca520: metadata.get("utf8mb4_unicode_520_ci").Weights,
-			baseWeightsUca900: metadata.get("utf8mb4_0900_ai_ci").Weights,
+			Generator:   codegen.NewGenerator(PkgCollationsData),
+			dedup:       make(map[string]string),
+			baseWeights: baseWeights(metadata),
 		},
 	}
 
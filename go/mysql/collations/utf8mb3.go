@@ -0,0 +1,100 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Utf8mb4Equivalent returns the utf8mb4 collation that is equivalent to the given
+// utf8mb3 collation in this environment. The returned boolean is false if the given
+// collation is not an utf8mb3 collation, or if there is no supported utf8mb4
+// collation with the same name suffix.
+func (env *Environment) Utf8mb4Equivalent(id ID) (ID, bool) {
+	if env.LookupCharsetName(id) != "utf8mb3" {
+		return Unknown, false
+	}
+	name := env.LookupName(id)
+	for _, prefix := range []string{"utf8mb3_", "utf8_"} {
+		suffix, ok := strings.CutPrefix(name, prefix)
+		if !ok {
+			continue
+		}
+		converted, ok := env.byName["utf8mb4_"+suffix]
+		return converted, ok
+	}
+	return Unknown, false
+}
+
+// Utf8mb3ToUtf8mb4 returns a mapping from every utf8mb3 collation supported in this
+// environment to its utf8mb4 equivalent. Collations without an equivalent are not
+// present in the mapping.
+func (env *Environment) Utf8mb3ToUtf8mb4() map[ID]ID {
+	mapping := make(map[ID]ID)
+	for id := range env.byID {
+		if converted, ok := env.Utf8mb4Equivalent(id); ok {
+			mapping[id] = converted
+		}
+	}
+	return mapping
+}
+
+// ConvertUtf8mb3Collations returns the collation IDs that the given collations
+// will have once their columns have been converted from utf8mb3 to utf8mb4.
+// Collations that are not utf8mb3 are returned unchanged. An utf8mb3 collation
+// without an utf8mb4 equivalent is converted to the default utf8mb4 collation
+// for this environment.
+func (env *Environment) ConvertUtf8mb3Collations(ids []ID) []ID {
+	converted := make([]ID, 0, len(ids))
+	for _, id := range ids {
+		if env.LookupCharsetName(id) != "utf8mb3" {
+			converted = append(converted, id)
+			continue
+		}
+		to, ok := env.Utf8mb4Equivalent(id)
+		if !ok {
+			to = env.DefaultCollationForCharset("utf8mb4")
+		}
+		converted = append(converted, to)
+	}
+	return converted
+}
+
+// Utf8mb3Safe returns whether the given input can be stored in an utf8mb3 column
+// without loss, i.e. whether it's valid UTF-8 that contains no 4-byte sequences.
+func Utf8mb3Safe(input []byte) bool {
+	return Utf8mb3UnsafeOffset(input) < 0
+}
+
+// Utf8mb3UnsafeOffset returns the byte offset of the first character in the input
+// that cannot be represented in utf8mb3, or -1 if the whole input is utf8mb3-safe.
+// Invalid UTF-8 sequences are considered unsafe.
+func Utf8mb3UnsafeOffset(input []byte) int {
+	for pos := 0; pos < len(input); {
+		if input[pos] < utf8.RuneSelf {
+			pos++
+			continue
+		}
+		r, size := utf8.DecodeRune(input[pos:])
+		if (r == utf8.RuneError && size == 1) || size > 3 {
+			return pos
+		}
+		pos += size
+	}
+	return -1
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtf8mb4Equivalent(t *testing.T) {
+	env := MySQL8()
+
+	testCases := []struct {
+		from string
+		to   string
+	}{
+		{"utf8mb3_general_ci", "utf8mb4_general_ci"},
+		{"utf8_general_ci", "utf8mb4_general_ci"},
+		{"utf8mb3_bin", "utf8mb4_bin"},
+		{"utf8mb3_unicode_520_ci", "utf8mb4_unicode_520_ci"},
+		{"utf8mb3_spanish2_ci", "utf8mb4_spanish2_ci"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.from, func(t *testing.T) {
+			to, ok := env.Utf8mb4Equivalent(env.LookupByName(tc.from))
+			require.True(t, ok)
+			assert.Equal(t, tc.to, env.LookupName(to))
+		})
+	}
+
+	_, ok := env.Utf8mb4Equivalent(Unknown)
+	assert.False(t, ok)
+	_, ok = env.Utf8mb4Equivalent(env.LookupByName("latin1_swedish_ci"))
+	assert.False(t, ok)
+}
+
+func TestUtf8mb3ToUtf8mb4(t *testing.T) {
+	for _, env := range []*Environment{MySQL8(), NewEnvironment("5.7.31"), NewEnvironment("10.3.1-MariaDB")} {
+		mapping := env.Utf8mb3ToUtf8mb4()
+		require.NotEmpty(t, mapping)
+		for from, to := range mapping {
+			assert.Equal(t, "utf8mb3", env.LookupCharsetName(from))
+			assert.Equal(t, "utf8mb4", env.LookupCharsetName(to))
+			assert.True(t, strings.HasPrefix(env.LookupName(to), "utf8mb4_"))
+		}
+	}
+}
+
+func TestConvertUtf8mb3Collations(t *testing.T) {
+	env := MySQL8()
+	ids := []ID{
+		env.LookupByName("utf8mb3_general_ci"),
+		env.LookupByName("latin1_swedish_ci"),
+		env.LookupByName("utf8mb3_unicode_520_ci"),
+		env.LookupByName("utf8mb4_0900_ai_ci"),
+	}
+	want := []ID{
+		env.LookupByName("utf8mb4_general_ci"),
+		env.LookupByName("latin1_swedish_ci"),
+		env.LookupByName("utf8mb4_unicode_520_ci"),
+		env.LookupByName("utf8mb4_0900_ai_ci"),
+	}
+	assert.Equal(t, want, env.ConvertUtf8mb3Collations(ids))
+}
+
+func TestUtf8mb3Safe(t *testing.T) {
+	testCases := []struct {
+		in     string
+		offset int
+	}{
+		{"", -1},
+		{"hello", -1},
+		{"ñandú 中文", -1},
+		{"ab😊", 2},
+		{"a\xffb", 1},
+		{"中\xe4\xb8", 3},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.offset, Utf8mb3UnsafeOffset([]byte(tc.in)), "offset for %q", tc.in)
+		assert.Equal(t, tc.offset < 0, Utf8mb3Safe([]byte(tc.in)), "safe for %q", tc.in)
+	}
+}
@@ -55,3 +55,81 @@ func TestFormattingFromMySQL(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatLocale(t *testing.T) {
+	const FormatString = `%a %W %b %M %d %Y`
+
+	dt, _, ok := ParseDateTime(`2024-03-04 10:11:12`, -1)
+	require.True(t, ok)
+
+	var cases = []struct {
+		locale string
+		output string
+	}{
+		{`en_US`, `Mon Monday Mar March 04 2024`},
+		{`de_DE`, `Mo Montag Mär März 04 2024`},
+		{`DE_de`, `Mo Montag Mär März 04 2024`},
+		{`es_ES`, `lun lunes mar marzo 04 2024`},
+		{`fr_FR`, `lun lundi mar mars 04 2024`},
+		{`it_IT`, `lun lunedì mar marzo 04 2024`},
+		{`nl_NL`, `ma maandag mrt maart 04 2024`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.locale, func(t *testing.T) {
+			locale := LookupLocale(tc.locale)
+			require.NotNil(t, locale)
+
+			eval, valid, err := FormatLocale(FormatString, dt, 6, locale)
+			require.NoError(t, err)
+			require.True(t, valid)
+			require.Equal(t, tc.output, string(eval))
+		})
+	}
+
+	require.Nil(t, LookupLocale("tlh_KL"))
+
+	// the names of the months and days of a zero date are NULL in MySQL
+	for _, format := range []string{`%M`, `%b`, `%W`, `%a`} {
+		_, valid, err := FormatLocale(format, DateTime{}, 0, LocaleEnUS)
+		require.NoError(t, err)
+		require.False(t, valid, format)
+		require.True(t, Localized("%Y "+format), format)
+	}
+	require.False(t, Localized(`%Y-%m-%d %H:%i %p %%M`))
+	eval, valid, err := FormatLocale(`%Y-%m-%d`, DateTime{}, 0, LocaleEnUS)
+	require.NoError(t, err)
+	require.True(t, valid)
+	require.Equal(t, `0000-00-00`, string(eval))
+}
+
+func TestFormatTime(t *testing.T) {
+	var cases = []struct {
+		time   string
+		format string
+		output string
+		valid  bool
+	}{
+		{`10:11:12`, `%H:%i:%s`, `10:11:12`, true},
+		{`100:11:12.5`, `%H %k %h %I %l %p %f`, `100 100 04 04 4 AM 500000`, true},
+		{`-14:00:00`, `%T %r`, `-14:00:00 02:00:00 PM`, true},
+		{`10:11:12`, `%Y-%m-%d %c %e`, `0000-00-00 0 0`, true},
+		{`10:11:12`, `%H %M`, ``, false},
+		{`10:11:12`, `%W`, ``, false},
+		{`10:11:12`, `%j`, ``, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.time+" "+tc.format, func(t *testing.T) {
+			tm, _, state := ParseTime(tc.time, -1)
+			require.Equal(t, TimeOK, state)
+
+			eval, valid, err := FormatTime(tc.format, tm, 6)
+			require.NoError(t, err)
+			require.Equal(t, tc.valid, valid)
+			if valid {
+				require.Equal(t, tc.output, string(eval))
+			}
+		})
+	}
+}
@@ -420,6 +420,7 @@ func ParseIntervalDecimal(dec decimal.Decimal, mysqlPrec int32, unit IntervalTyp
 		return parseIntervalInt(int(math.Round(f)), prec, unit, negate, false)
 	}
 
-	b := dec.FormatMySQL(mysqlPrec)
+	var buf [64]byte
+	b := dec.AppendFormat(buf[:0], mysqlPrec)
 	return parseIntervalFraction(hack.String(b), prec, unit, negate)
 }
@@ -0,0 +1,127 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datetime
+
+import "strings"
+
+// Locale holds the names of the months and of the days of the week of one of
+// the locales of MySQL's lc_time_names, which are used by the %M, %b, %W and
+// %a specifiers of DATE_FORMAT and by MONTHNAME.
+type Locale struct {
+	Name string
+	// MonthNames and ShortMonthNames start with January.
+	MonthNames      [12]string
+	ShortMonthNames [12]string
+	// DayNames and ShortDayNames start with Sunday, like time.Weekday.
+	DayNames      [7]string
+	ShortDayNames [7]string
+}
+
+func (l *Locale) monthName(t DateTime, short bool) (string, bool) {
+	m := t.Date.Month()
+	if m < 1 || m > 12 {
+		return "", false
+	}
+	if short {
+		return l.ShortMonthNames[m-1], true
+	}
+	return l.MonthNames[m-1], true
+}
+
+func (l *Locale) dayName(t DateTime, short bool) (string, bool) {
+	if t.Date.Year() == 0 && t.Date.Month() == 0 {
+		return "", false
+	}
+	if short {
+		return l.ShortDayNames[t.Date.Weekday()], true
+	}
+	return l.DayNames[t.Date.Weekday()], true
+}
+
+// MonthName returns the name of the month of t, or false if t has no month.
+func (l *Locale) MonthName(t DateTime) (string, bool) {
+	return l.monthName(t, false)
+}
+
+// LocaleEnUS is the default locale of MySQL.
+var LocaleEnUS = &Locale{
+	Name:            "en_US",
+	MonthNames:      [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	ShortMonthNames: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	DayNames:        [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	ShortDayNames:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+}
+
+// locales are the locales that are supported, by lower case name. MySQL
+// supports many more, which can't be evaluated by Vitess.
+var locales = map[string]*Locale{}
+
+func init() {
+	for _, l := range []*Locale{
+		LocaleEnUS,
+		{
+			Name:            "en_GB",
+			MonthNames:      LocaleEnUS.MonthNames,
+			ShortMonthNames: LocaleEnUS.ShortMonthNames,
+			DayNames:        LocaleEnUS.DayNames,
+			ShortDayNames:   LocaleEnUS.ShortDayNames,
+		},
+		{
+			Name:            "de_DE",
+			MonthNames:      [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+			ShortMonthNames: [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+			DayNames:        [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+			ShortDayNames:   [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+		},
+		{
+			Name:            "es_ES",
+			MonthNames:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+			ShortMonthNames: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sep", "oct", "nov", "dic"},
+			DayNames:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+			ShortDayNames:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		},
+		{
+			Name:            "fr_FR",
+			MonthNames:      [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+			ShortMonthNames: [12]string{"jan", "fév", "mar", "avr", "mai", "jun", "jui", "aoû", "sep", "oct", "nov", "déc"},
+			DayNames:        [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+			ShortDayNames:   [7]string{"dim", "lun", "mar", "mer", "jeu", "ven", "sam"},
+		},
+		{
+			Name:            "it_IT",
+			MonthNames:      [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+			ShortMonthNames: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+			DayNames:        [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+			ShortDayNames:   [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		},
+		{
+			Name:            "nl_NL",
+			MonthNames:      [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+			ShortMonthNames: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+			DayNames:        [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+			ShortDayNames:   [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+		},
+	} {
+		locales[strings.ToLower(l.Name)] = l
+	}
+}
+
+// LookupLocale returns the locale with the given lc_time_names, which is case
+// insensitive like in MySQL, or nil if it's not supported.
+func LookupLocale(name string) *Locale {
+	return locales[strings.ToLower(name)]
+}
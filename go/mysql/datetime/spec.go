@@ -38,6 +38,12 @@ type numeric interface {
 	numeric(t DateTime) (int, int)
 }
 
+// localized are the specifiers for the names of the months and of the days
+// of the week, which depend on the locale.
+type localized interface {
+	name(t DateTime, locale *Locale) (string, bool)
+}
+
 var shortDayNames = []string{
 	"Sun",
 	"Mon",
@@ -69,6 +75,10 @@ func (fmtWeekdayNameShort) format(dst []byte, t DateTime, prec uint8) []byte {
 	return append(dst, t.Date.Weekday().String()[:3]...)
 }
 
+func (fmtWeekdayNameShort) name(t DateTime, locale *Locale) (string, bool) {
+	return locale.dayName(t, true)
+}
+
 func (fmtWeekdayNameShort) parse(_ *timeparts, b string) (out string, ok bool) {
 	_, out, ok = lookup(shortDayNames, b)
 	return
@@ -80,6 +90,10 @@ func (fmtMonthNameShort) format(dst []byte, t DateTime, prec uint8) []byte {
 	return append(dst, time.Month(t.Date.Month()).String()[:3]...)
 }
 
+func (fmtMonthNameShort) name(t DateTime, locale *Locale) (string, bool) {
+	return locale.monthName(t, true)
+}
+
 func (fmtMonthNameShort) parse(tp *timeparts, b string) (out string, ok bool) {
 	tp.month, out, ok = lookup(shortMonthNames, b)
 	return
@@ -264,6 +278,10 @@ func (fmtMonthName) format(dst []byte, t DateTime, prec uint8) []byte {
 	return append(dst, time.Month(t.Date.Month()).String()...)
 }
 
+func (fmtMonthName) name(t DateTime, locale *Locale) (string, bool) {
+	return locale.monthName(t, false)
+}
+
 func (m fmtMonthName) parse(t *timeparts, bytes string) (string, bool) {
 	//TODO implement me
 	panic("implement me")
@@ -272,7 +290,7 @@ func (m fmtMonthName) parse(t *timeparts, bytes string) (string, bool) {
 type fmtAMorPM struct{}
 
 func (fmtAMorPM) format(dst []byte, t DateTime, prec uint8) []byte {
-	if t.Time.Hour() < 12 {
+	if t.Time.Hour()%24 < 12 {
 		return append(dst, "AM"...)
 	}
 	return append(dst, "PM"...)
@@ -415,6 +433,10 @@ type fmtWeekdayName struct{}
 func (fmtWeekdayName) format(dst []byte, t DateTime, prec uint8) []byte {
 	return append(dst, t.Date.Weekday().String()...)
 }
+
+func (fmtWeekdayName) name(t DateTime, locale *Locale) (string, bool) {
+	return locale.dayName(t, false)
+}
 func (w fmtWeekdayName) parse(t *timeparts, bytes string) (string, bool) {
 	//TODO implement me
 	panic("implement me")
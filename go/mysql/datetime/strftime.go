@@ -69,6 +69,62 @@ func Format(p string, t DateTime, prec uint8) ([]byte, error) {
 	return dst, err
 }
 
+// FormatLocale is like Format, but the names of the months and of the days of
+// the week are those of the given locale, like for DATE_FORMAT in MySQL. It
+// returns false if the format needs the name of a month or a day that t does
+// not have, e.g. for a zero date, for which MySQL returns NULL.
+func FormatLocale(p string, t DateTime, prec uint8, locale *Locale) ([]byte, bool, error) {
+	var dst []byte
+	valid := true
+	err := compile(DefaultMySQLStrftime, p, func(a Spec) {
+		if l, ok := a.(localized); ok {
+			var name string
+			name, ok = l.name(t, locale)
+			dst = append(dst, name...)
+			valid = valid && ok
+			return
+		}
+		dst = a.format(dst, t, prec)
+	})
+	return dst, valid, err
+}
+
+// Localized returns whether the format `p` has specifiers for the names of the
+// months or of the days of the week, whose formatting depends on the locale.
+func Localized(p string) bool {
+	var localizedSpec bool
+	_ = compile(DefaultMySQLStrftime, p, func(a Spec) {
+		if _, ok := a.(localized); ok {
+			localizedSpec = true
+		}
+	})
+	return localizedSpec
+}
+
+// FormatTime formats the time `t` with the format `p`, like TIME_FORMAT in
+// MySQL: the hours can be larger than 24, and negative times are prefixed
+// with a minus sign. It returns false if the format has specifiers for the
+// parts of a date that a time doesn't have, such as %M or %W, for which MySQL
+// returns NULL.
+func FormatTime(p string, t Time, prec uint8) ([]byte, bool, error) {
+	var dst []byte
+	if t.Neg() {
+		dst = append(dst, '-')
+	}
+	dt := DateTime{Time: t}
+	valid := true
+	err := compile(DefaultMySQLStrftime, p, func(a Spec) {
+		switch a.(type) {
+		case fmtWeekdayNameShort, fmtMonthNameShort, fmtMonthName, fmtWeekdayName, fmtMonthDaySuffix,
+			fmtZeroYearDay, fmtWeek0, fmtWeek1, fmtWeek2, fmtWeek3, fmtWeekday, fmtYearForWeek2, fmtYearForWeek3:
+			valid = false
+		default:
+			dst = a.format(dst, dt, prec)
+		}
+	})
+	return dst, valid, err
+}
+
 // Strftime is the object that represents a compiled strftime pattern
 type Strftime struct {
 	pattern  string
@@ -18,8 +18,10 @@ package datetime
 
 import (
 	"fmt"
-	"strconv"
 	"time"
+	// The tz database is embedded, so that the named time zones are known
+	// even if the system doesn't have one, like MySQL with its loaded tables.
+	_ "time/tzdata"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -29,6 +31,11 @@ func unknownTimeZone(tz string) error {
 	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.UnknownTimeZone, "Unknown or incorrect time zone: '%s'", tz)
 }
 
+// ParseTimeZone returns the time zone of a MySQL time zone value: either the
+// name of a zone of the tz database, e.g. 'Europe/Amsterdam', or an offset from
+// UTC in the form '[H]H:MM', prefixed with a + or -, e.g. '+05:30' or '-6:00'.
+// The names of the zones are resolved with the embedded tz database when the
+// system doesn't have one.
 func ParseTimeZone(tz string) (*time.Location, error) {
 	// Needs to be checked first since time.LoadLocation("") returns UTC.
 	if tz == "" {
@@ -41,39 +48,57 @@ func ParseTimeZone(tz string) (*time.Location, error) {
 
 	// MySQL also handles timezone formats in the form of the
 	// offset from UTC, so we'll try that if the above fails.
-	// This format is always something in the form of +HH:MM or -HH:MM.
-	if len(tz) != 6 {
+	offset, ok := parseTimeZoneOffset(tz)
+	if !ok {
 		return nil, unknownTimeZone(tz)
 	}
-	if tz[0] != '+' && tz[0] != '-' {
-		return nil, unknownTimeZone(tz)
-	}
-	if tz[3] != ':' {
-		return nil, unknownTimeZone(tz)
+	return time.FixedZone(fmt.Sprintf("UTC%s", tz), offset), nil
+}
+
+// parseTimeZoneOffset parses an offset from UTC in the form '[H]H:MM', prefixed
+// with a + or -, into seconds, like MySQL does: the hours and the minutes can
+// have any number of digits, as long as the offset is in the range supported
+// by MySQL.
+func parseTimeZoneOffset(tz string) (int, bool) {
+	if len(tz) < 4 {
+		return 0, false
 	}
 	neg := tz[0] == '-'
-	hours, err := strconv.ParseUint(tz[1:3], 10, 4)
-	if err != nil {
-		return nil, unknownTimeZone(tz)
+	if !neg && tz[0] != '+' {
+		return 0, false
 	}
-	minutes, err := strconv.ParseUint(tz[4:], 10, 6)
-	if err != nil {
-		return nil, unknownTimeZone(tz)
+
+	hours, rest := parseTimeZoneOffsetDigits(tz[1:])
+	if len(rest) < 2 || rest[0] != ':' {
+		return 0, false
 	}
-	if minutes > 59 {
-		return nil, unknownTimeZone(tz)
+	minutes, rest := parseTimeZoneOffsetDigits(rest[1:])
+	if len(rest) != 0 || minutes > 59 {
+		return 0, false
 	}
 
 	// MySQL only supports timezones in the range of -13:59 to +14:00.
 	if neg && hours > 13 {
-		return nil, unknownTimeZone(tz)
+		return 0, false
 	}
 	if !neg && (hours > 14 || hours == 14 && minutes > 0) {
-		return nil, unknownTimeZone(tz)
+		return 0, false
 	}
-	offset := int(hours)*60*60 + int(minutes)*60
+	offset := hours*60*60 + minutes*60
 	if neg {
 		offset = -offset
 	}
-	return time.FixedZone(fmt.Sprintf("UTC%s", tz), offset), nil
+	return offset, true
+}
+
+// parseTimeZoneOffsetDigits parses the leading digits of s, and returns the
+// rest of s. The value saturates instead of overflowing, since it's only
+// used if it's in range.
+func parseTimeZoneOffsetDigits(s string) (int, string) {
+	var n int
+	for len(s) > 0 && s[0] >= '0' && s[0] <= '9' {
+		n = min(n*10+int(s[0]-'0'), 100)
+		s = s[1:]
+	}
+	return n, s
 }
@@ -99,6 +99,34 @@ func TestParseTimeZone(t *testing.T) {
 			tz:   "-15:00",
 			want: "Unknown or incorrect time zone: '-15:00'",
 		},
+		{
+			tz:   "+5:30",
+			want: "UTC+5:30",
+		},
+		{
+			tz:   "-00:00",
+			want: "UTC-00:00",
+		},
+		{
+			tz:   "+005:03",
+			want: "UTC+005:03",
+		},
+		{
+			tz:   "+05:60",
+			want: "Unknown or incorrect time zone: '+05:60'",
+		},
+		{
+			tz:   "+05:",
+			want: "Unknown or incorrect time zone: '+05:'",
+		},
+		{
+			tz:   "05:00",
+			want: "Unknown or incorrect time zone: '05:00'",
+		},
+		{
+			tz:   "+99999999999999999999:00",
+			want: "Unknown or incorrect time zone: '+99999999999999999999:00'",
+		},
 		{
 			tz:   "foo",
 			want: "Unknown or incorrect time zone: 'foo'",
@@ -0,0 +1,189 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decimal
+
+import "math/big"
+
+// MaxOf returns the largest of the given decimals, and false if there are none.
+// The result is one of the values, not a copy of it.
+func MaxOf(values []Decimal) (Decimal, bool) {
+	return extremeOf(values, 1)
+}
+
+// MinOf returns the smallest of the given decimals, and false if there are none.
+// The result is one of the values, not a copy of it.
+func MinOf(values []Decimal) (Decimal, bool) {
+	return extremeOf(values, -1)
+}
+
+func extremeOf(values []Decimal, want int) (Decimal, bool) {
+	if len(values) == 0 {
+		return Decimal{}, false
+	}
+	best := values[0]
+	for _, v := range values[1:] {
+		if v.Cmp(best) == want {
+			best = v
+		}
+	}
+	return best, true
+}
+
+// SumOf returns the sum of the given decimals, which is zero if there are none.
+// Unlike adding the decimals one by one, the sum is accumulated in place with the
+// exponent of the most precise value, so that no intermediate decimals are allocated.
+func SumOf(values []Decimal) Decimal {
+	var exp int32
+	var found bool
+	for _, v := range values {
+		if v.value == nil {
+			continue
+		}
+		if !found || v.exp < exp {
+			exp = v.exp
+			found = true
+		}
+	}
+
+	sum := new(big.Int)
+	var scaled big.Int
+	for _, v := range values {
+		if v.value == nil {
+			continue
+		}
+		if v.exp == exp {
+			sum.Add(sum, v.value)
+		} else {
+			scaled.Mul(v.value, bigPow10(uint64(v.exp-exp)))
+			sum.Add(sum, &scaled)
+		}
+	}
+	return Decimal{value: sum, exp: exp}
+}
+
+// ClampInPlace clamps each of the given decimals to the largest value that can be
+// represented with the given amount of integral and fractional digits, like Clamp,
+// replacing them in the slice. It returns how many of the decimals were clamped.
+func ClampInPlace(values []Decimal, integral, fractional int32) (clamped int) {
+	for i := range values {
+		if values[i].value == nil {
+			continue
+		}
+		c := values[i].Clamp(integral, fractional)
+		if c.value != values[i].value {
+			clamped++
+		}
+		values[i] = c
+	}
+	return clamped
+}
+
+// Avg accumulates decimals to compute their average. The sum of the decimals is
+// kept exact, with the exponent of the most precise of them, and the average is
+// only divided and rounded once, by Result; this way the partial averages of a
+// group (e.g. one per shard) can be merged without compounding rounding errors.
+// The zero value is an empty Avg ready to use.
+type Avg struct {
+	sum   big.Int
+	exp   int32
+	count int64
+}
+
+// Reset empties the Avg so it can be reused.
+func (a *Avg) Reset() {
+	a.sum.SetInt64(0)
+	a.exp = 0
+	a.count = 0
+}
+
+// Add adds d to the average.
+func (a *Avg) Add(d Decimal) {
+	var value big.Int
+	if d.value != nil {
+		value.Set(d.value)
+	}
+	a.add(&value, d.exp, 1)
+}
+
+// Merge adds all the decimals accumulated by other to the average.
+func (a *Avg) Merge(other *Avg) {
+	if other.count == 0 {
+		return
+	}
+	var value big.Int
+	value.Set(&other.sum)
+	a.add(&value, other.exp, other.count)
+}
+
+// add adds value * 10^exp to the sum, accounting for count decimals. It takes ownership of value.
+func (a *Avg) add(value *big.Int, exp int32, count int64) {
+	switch {
+	case a.count == 0:
+		a.exp = exp
+	case exp < a.exp:
+		a.sum.Mul(&a.sum, bigPow10(uint64(a.exp-exp)))
+		a.exp = exp
+	case exp > a.exp:
+		value.Mul(value, bigPow10(uint64(exp-a.exp)))
+	}
+	a.sum.Add(&a.sum, value)
+	a.count += count
+}
+
+// Count returns how many decimals have been accumulated.
+func (a *Avg) Count() int64 {
+	return a.count
+}
+
+// Scale returns the largest amount of fractional digits of the accumulated decimals.
+func (a *Avg) Scale() int32 {
+	return max(-a.exp, 0)
+}
+
+// Sum returns the exact sum of the accumulated decimals.
+func (a *Avg) Sum() Decimal {
+	return Decimal{value: new(big.Int).Set(&a.sum), exp: a.exp}
+}
+
+// Result returns the average of the accumulated decimals rounded half away from zero
+// to Scale()+scaleIncr fractional digits, like MySQL rounds the result of AVG() with
+// div_precision_increment as scaleIncr. It returns false if no decimals were accumulated.
+func (a *Avg) Result(scaleIncr int32) (Decimal, bool) {
+	if a.count == 0 {
+		return Decimal{}, false
+	}
+	scale := a.Scale() + scaleIncr
+
+	num := new(big.Int).Set(&a.sum)
+	den := big.NewInt(a.count)
+	if shift := a.exp + scale; shift >= 0 {
+		num.Mul(num, bigPow10(uint64(shift)))
+	} else {
+		den.Mul(den, bigPow10(uint64(-shift)))
+	}
+
+	var rem big.Int
+	num.QuoRem(num, den, &rem)
+	if rem.Lsh(rem.Abs(&rem), 1).Cmp(den) >= 0 {
+		if a.sum.Sign() < 0 {
+			num.Sub(num, oneInt)
+		} else {
+			num.Add(num, oneInt)
+		}
+	}
+	return Decimal{value: num, exp: -scale}, true
+}
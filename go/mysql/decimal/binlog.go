@@ -0,0 +1,124 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// MySQL stores a DECIMAL(precision, scale), e.g. in the row images of the
+// binary log, as its integral and fractional digits in groups of 9 digits,
+// each of them a 32 bits big endian integer. The leftover digits of the
+// integral part, which come first, and of the fractional part, which come
+// last, use the least amount of bytes that can hold them. The first bit of
+// the value is inverted, and all the bits of the negative values too, so
+// that the values sort as bytes.
+
+// dig2bytes is the amount of bytes used to store a group of up to 9 digits.
+var dig2bytes = [10]int{0, 1, 1, 2, 2, 3, 3, 4, 4, 4}
+
+// BinlogSize returns the amount of bytes used by MySQL to store a value of a
+// DECIMAL(precision, scale) column.
+func BinlogSize(precision, scale int) int {
+	intg := precision - scale
+	return intg/9*4 + dig2bytes[intg%9] + scale/9*4 + dig2bytes[scale%9]
+}
+
+// NewFromBinlog decodes the value of a DECIMAL(precision, scale) column, as
+// stored by MySQL, from the beginning of data. It returns the value, with
+// the scale of the column, and the amount of bytes that were read.
+func NewFromBinlog(data []byte, precision, scale int) (Decimal, int, error) {
+	if scale < 0 || precision < 1 || precision > 65 || scale > 30 || scale > precision {
+		return Decimal{}, 0, fmt.Errorf("invalid DECIMAL(%d,%d) column", precision, scale)
+	}
+	size := BinlogSize(precision, scale)
+	if len(data) < size {
+		return Decimal{}, 0, fmt.Errorf("DECIMAL(%d,%d) value needs %d bytes, got %d", precision, scale, size, len(data))
+	}
+
+	r := binlogReader{data: data[:size]}
+	if r.data[0]&0x80 == 0 {
+		r.mask = 0xff
+	}
+
+	intg := precision - scale
+	r.read(intg % 9)
+	for i := 0; i < intg/9; i++ {
+		r.read(9)
+	}
+	for i := 0; i < scale/9; i++ {
+		r.read(9)
+	}
+	r.read(scale % 9)
+	if r.err != nil {
+		return Decimal{}, 0, fmt.Errorf("invalid DECIMAL(%d,%d) value: %w", precision, scale, r.err)
+	}
+
+	value := r.big
+	if value == nil {
+		value = new(big.Int).SetUint64(r.small)
+	}
+	if r.mask != 0 {
+		value.Neg(value)
+	}
+	return Decimal{value: value, exp: -int32(scale)}, size, nil
+}
+
+// binlogReader reads the groups of digits of a stored DECIMAL value. The
+// digits are accumulated in small for as long as they fit in an uint64,
+// i.e. up to 18 digits, and in big afterwards.
+type binlogReader struct {
+	data   []byte
+	pos    int
+	mask   byte
+	digits int
+	small  uint64
+	big    *big.Int
+	err    error
+}
+
+// read reads the next group of digits.
+func (r *binlogReader) read(digits int) {
+	if digits == 0 || r.err != nil {
+		return
+	}
+	var group uint64
+	for i := 0; i < dig2bytes[digits]; i++ {
+		b := r.data[r.pos] ^ r.mask
+		if r.pos == 0 {
+			b ^= 0x80
+		}
+		group = group<<8 | uint64(b)
+		r.pos++
+	}
+	if group >= pow10uint64tab[digits] {
+		r.err = fmt.Errorf("group of %d digits is %d", digits, group)
+		return
+	}
+
+	r.digits += digits
+	if r.big == nil && r.digits <= 18 {
+		r.small = r.small*pow10uint64tab[digits] + group
+		return
+	}
+	if r.big == nil {
+		r.big = new(big.Int).SetUint64(r.small)
+	}
+	r.big.Mul(r.big, pow10bigtab[digits])
+	r.big.Add(r.big, new(big.Int).SetUint64(group))
+}
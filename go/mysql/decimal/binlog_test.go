@@ -0,0 +1,174 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decimal
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// encodeBinlog stores a DECIMAL value the same way MySQL does. The digits
+// must have the length of the integral and fractional parts of the column.
+func encodeBinlog(negative bool, intDigits, fracDigits string) []byte {
+	var groups []string
+	if lead := len(intDigits) % 9; lead > 0 {
+		groups = append(groups, intDigits[:lead])
+	}
+	for i := len(intDigits) % 9; i < len(intDigits); i += 9 {
+		groups = append(groups, intDigits[i:i+9])
+	}
+	for i := 0; i+9 <= len(fracDigits); i += 9 {
+		groups = append(groups, fracDigits[i:i+9])
+	}
+	if trail := len(fracDigits) % 9; trail > 0 {
+		groups = append(groups, fracDigits[len(fracDigits)-trail:])
+	}
+
+	var buf []byte
+	for _, group := range groups {
+		n, err := strconv.ParseUint(group, 10, 32)
+		if err != nil {
+			panic(err)
+		}
+		for i := dig2bytes[len(group)] - 1; i >= 0; i-- {
+			buf = append(buf, byte(n>>(8*i)))
+		}
+	}
+	buf[0] ^= 0x80
+	if negative {
+		for i := range buf {
+			buf[i] ^= 0xff
+		}
+	}
+	return buf
+}
+
+func TestNewFromBinlog(t *testing.T) {
+	for _, tc := range []struct {
+		data             []byte
+		precision, scale int
+		expected         string
+	}{
+		{[]byte{0x81, 0x0D, 0xFB, 0x38, 0xD2, 0x04, 0xD2}, 14, 4, "1234567890.1234"},
+		{[]byte{0x7E, 0xF2, 0x04, 0xC7, 0x2D, 0xFB, 0x2D}, 14, 4, "-1234567890.1234"},
+		{[]byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x0A}, 20, 2, "1.10"},
+		{[]byte{0x80, 0x0A}, 3, 2, "0.10"},
+		{[]byte{0x7F, 0xF5}, 3, 2, "-0.10"},
+		{[]byte{0x80, 0x00, 0x00}, 5, 0, "0"},
+		{[]byte{0x80, 0x00, 0x00, 0x00}, 4, 4, "0.0000"},
+		{[]byte{0xBB, 0x9A, 0xC9, 0xFF, 0x3B, 0x9A, 0xC9, 0xFF, 0x3B, 0x9A, 0xC9, 0xFF}, 27, 0, "999999999999999999999999999"},
+		// the bytes after the value are ignored
+		{[]byte{0x81, 0x0D, 0xFB, 0x38, 0xD2, 0x04, 0xD2, 0xFF}, 14, 4, "1234567890.1234"},
+	} {
+		t.Run(tc.expected, func(t *testing.T) {
+			d, n, err := NewFromBinlog(tc.data, tc.precision, tc.scale)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != BinlogSize(tc.precision, tc.scale) {
+				t.Errorf("read %d bytes, expected %d", n, BinlogSize(tc.precision, tc.scale))
+			}
+			if got := string(d.FormatMySQL(int32(tc.scale))); got != tc.expected {
+				t.Errorf("got %q, expected %q", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNewFromBinlogErrors(t *testing.T) {
+	for _, tc := range []struct {
+		data             []byte
+		precision, scale int
+		expected         string
+	}{
+		{[]byte{0x81, 0x0D, 0xFB}, 14, 4, "DECIMAL(14,4) value needs 7 bytes, got 3"},
+		{[]byte{0x80, 0x00}, 2, 3, "invalid DECIMAL(2,3) column"},
+		{[]byte{0x80, 0x00}, 66, 0, "invalid DECIMAL(66,0) column"},
+		{[]byte{0xBB, 0x9A, 0xCA, 0x00}, 9, 0, "invalid DECIMAL(9,0) value: group of 9 digits is 1000000000"},
+		{[]byte{0xE4}, 2, 0, "invalid DECIMAL(2,0) value: group of 2 digits is 100"},
+	} {
+		t.Run(tc.expected, func(t *testing.T) {
+			_, _, err := NewFromBinlog(tc.data, tc.precision, tc.scale)
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("got error %v, expected %q", err, tc.expected)
+			}
+		})
+	}
+}
+
+func FuzzNewFromBinlog(f *testing.F) {
+	f.Add(uint8(14), uint8(4), uint64(1), false)
+	f.Add(uint8(65), uint8(30), uint64(2), true)
+	f.Add(uint8(18), uint8(0), uint64(3), true)
+	f.Add(uint8(19), uint8(9), uint64(4), false)
+	f.Add(uint8(1), uint8(1), uint64(5), true)
+
+	f.Fuzz(func(t *testing.T, precision, scale uint8, seed uint64, negative bool) {
+		precision = precision%65 + 1
+		scale = scale % (min(precision, 30) + 1)
+
+		rnd := rand.New(rand.NewPCG(seed, seed))
+		digits := func(n int) string {
+			var b strings.Builder
+			for i := 0; i < n; i++ {
+				// bias towards zeroes, to have leading zeroes and short values
+				if rnd.IntN(3) == 0 {
+					b.WriteByte('0')
+				} else {
+					b.WriteByte(byte('0' + rnd.IntN(10)))
+				}
+			}
+			return b.String()
+		}
+		intDigits := digits(int(precision - scale))
+		fracDigits := digits(int(scale))
+
+		expected := strings.TrimLeft(intDigits, "0")
+		if expected == "" {
+			expected = "0"
+		}
+		if scale > 0 {
+			expected += "." + fracDigits
+		}
+		if negative && strings.Trim(intDigits+fracDigits, "0") != "" {
+			expected = "-" + expected
+		}
+
+		data := encodeBinlog(negative, intDigits, fracDigits)
+		d, n, err := NewFromBinlog(data, int(precision), int(scale))
+		if err != nil {
+			t.Fatalf("DECIMAL(%d,%d) %s: %v", precision, scale, expected, err)
+		}
+		if n != len(data) {
+			t.Errorf("DECIMAL(%d,%d) %s: read %d bytes, expected %d", precision, scale, expected, n, len(data))
+		}
+		if got := string(d.FormatMySQL(int32(scale))); got != expected {
+			t.Errorf("DECIMAL(%d,%d): got %q, expected %q", precision, scale, got, expected)
+		}
+
+		// the value is the same as the one parsed from its text
+		parsed, err := NewFromMySQL([]byte(expected))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !d.Equal(parsed) {
+			t.Errorf("DECIMAL(%d,%d): %s is not equal to parsed %s", precision, scale, d.String(), parsed.String())
+		}
+	})
+}
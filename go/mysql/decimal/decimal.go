@@ -22,9 +22,11 @@ limitations under the License.
 package decimal
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"math/bits"
 	"strconv"
 )
 
@@ -34,6 +36,10 @@ const MyMaxScale = 30
 // MyMaxPrecision is the largest precision on a decimal that MySQL supports
 const MyMaxPrecision = 65
 
+// ErrOutOfRange is returned when a decimal has more integral digits than the
+// MyMaxPrecision digits that MySQL supports.
+var ErrOutOfRange = errors.New("DECIMAL value is out of range")
+
 // MyMaxBigDigits is the largest amount of "big digits" that MySQL supports
 // See: myBigDigits
 const MyMaxBigDigits = 9
@@ -262,7 +268,7 @@ func (d Decimal) rescale(exp int32) Decimal {
 	return Decimal{value: value, exp: exp}
 }
 
-// abs returns the absolute value of the decimal.
+// Abs returns the absolute value of the decimal.
 func (d Decimal) Abs() Decimal {
 	if d.Sign() >= 0 {
 		return d
@@ -314,6 +320,19 @@ func (d Decimal) Neg() Decimal {
 	}
 }
 
+// CopySign returns the absolute value of d with the sign of d2. Like in MySQL,
+// a DECIMAL zero has no sign, so a zero d2 gives the absolute value of d.
+func (d Decimal) CopySign(d2 Decimal) Decimal {
+	d.ensureInitialized()
+	if d2.Sign() >= 0 {
+		return d.Abs()
+	}
+	if d.Sign() > 0 {
+		return d.Neg()
+	}
+	return d
+}
+
 func (d Decimal) NegInPlace() Decimal {
 	d.ensureInitialized()
 	return Decimal{
@@ -348,24 +367,80 @@ func (d Decimal) Mul(d2 Decimal) Decimal {
 	return d.mul(d2)
 }
 
+// Div returns d / d2 the way MySQL divides decimals, where scaleIncr is the
+// div_precision_increment. MySQL stores the digits of a decimal in "big digits"
+// of 9 digits each, aligned on the decimal point, and computes the quotient up to
+// as many big digits after the decimal point as it takes to hold the scales of
+// both operands plus scaleIncr. The scales of the operands are rounded up to
+// whole big digits, and that rounding counts towards scaleIncr. The quotient only
+// has room for MyMaxBigDigits big digits though, so its fractional part is cut
+// short when its integral part is large. The quotient is truncated, not rounded.
 func (d Decimal) Div(d2 Decimal, scaleIncr int32) Decimal {
 	if d.Sign() == 0 {
 		return Zero
 	}
 
-	s1 := -d.exp
-	s2 := -d2.exp
-	fracLeft := myBigDigits(s1)
-	fracRight := myBigDigits(s2)
-	scaleIncr -= fracLeft - s1 + fracRight - s2
+	s1 := max(-d.exp, 0)
+	s2 := max(-d2.exp, 0)
+	frac1 := myBigDigits(s1) * 9
+	frac2 := myBigDigits(s2) * 9
+	scaleIncr -= frac1 - s1 + frac2 - s2
 	if scaleIncr < 0 {
 		scaleIncr = 0
 	}
-	scale := myBigDigits(fracLeft+fracRight+scaleIncr) * 9
-	q, _ := d.QuoRem(d2, scale)
+
+	fracWords := myBigDigits(frac1 + frac2 + scaleIncr)
+	intgWords := divIntegralBigDigits(d, d2)
+	if intgWords+fracWords > MyMaxBigDigits {
+		fracWords = max(MyMaxBigDigits-intgWords, 0)
+	}
+	q, _ := d.QuoRem(d2, fracWords*9)
 	return q
 }
 
+// divIntegralBigDigits returns how many big digits MySQL reserves for the
+// integral part of d / d2. It estimates the number of integral digits of the
+// quotient from the positions of the leading digits of the operands, and adds
+// one when the leading big digit of d is not smaller than the one of d2, which
+// is a coarser test than comparing their leading digits.
+func divIntegralBigDigits(d, d2 Decimal) int32 {
+	intg1, lead1 := d.leadingBigDigit()
+	intg2, lead2 := d2.leadingBigDigit()
+	intg := intg1 - intg2
+	if lead1 >= lead2 {
+		intg++
+	}
+	if intg < 0 {
+		return 0
+	}
+	return myBigDigits(intg)
+}
+
+// leadingBigDigit returns the position of the leading digit of d, as its number
+// of integral digits (e.g. 3 for 123.4 and -2 for 0.001), and the value of the
+// leading non-zero big digit of d in MySQL's representation (e.g. 1000000 for
+// 0.001, whose first big digit after the decimal point is 001000000).
+func (d Decimal) leadingBigDigit() (int32, uint32) {
+	d.ensureInitialized()
+	digits := d.value.Text(10)
+	if digits[0] == '-' {
+		digits = digits[1:]
+	}
+	intg := int32(len(digits)) + d.exp
+
+	// The leading big digit holds the digits up to the next boundary of big
+	// digits, which are aligned on the decimal point
+	n := ((intg-1)%9+9)%9 + 1
+	var lead uint32
+	for i := int32(0); i < n; i++ {
+		lead *= 10
+		if i < int32(len(digits)) {
+			lead += uint32(digits[i] - '0')
+		}
+	}
+	return intg, lead
+}
+
 // div returns d / d2. If it doesn't divide exactly, the result will have
 // divisionPrecision digits after the decimal point.
 func (d Decimal) div(d2 Decimal) Decimal {
@@ -496,6 +571,28 @@ func (d Decimal) Truncate(precision int32) Decimal {
 	return d
 }
 
+// TruncTowardZero truncates the decimal toward zero to the given number of
+// fractional digits, like TRUNCATE(d, scale) in MySQL. A negative scale zeroes
+// that many digits of the integral part, and returns an integer. The decimal
+// is returned as is if it doesn't have more digits than the scale.
+//
+// Example:
+//
+//	RequireFromString("-1.999").TruncTowardZero(1).String() // output: "-1.9"
+//	RequireFromString("1234.5").TruncTowardZero(-2).String() // output: "1200"
+func (d Decimal) TruncTowardZero(scale int32) Decimal {
+	d.ensureInitialized()
+	if d.exp >= -scale {
+		return d
+	}
+	// the quotient of a big.Int is truncated toward zero
+	t := d.rescale(-scale)
+	if scale < 0 {
+		t = t.rescale(0)
+	}
+	return t
+}
+
 // isInteger returns true when decimal can be represented as an integer value, otherwise, it returns false.
 func (d Decimal) isInteger() bool {
 	// The most typical case, all decimal with exponent higher or equal 0 can be represented as integer
@@ -529,26 +626,94 @@ func abs(n int32) int32 {
 //	 0 if d == d2
 //	+1 if d >  d2
 func (d Decimal) Cmp(d2 Decimal) int {
-	d.ensureInitialized()
-	d2.ensureInitialized()
-	if d.exp == d2.exp {
-		return d.value.Cmp(d2.value)
+	s, s2 := d.Sign(), d2.Sign()
+	switch {
+	case s < s2:
+		return -1
+	case s > s2:
+		return 1
+	case s == 0:
+		return 0
 	}
-	rd, rd2 := RescalePair(d, d2)
-	return rd.value.Cmp(rd2.value)
+	c := cmpAbsNonZero(d, d2)
+	if s < 0 {
+		return -c
+	}
+	return c
 }
 
 func (d Decimal) CmpAbs(d2 Decimal) int {
-	d.ensureInitialized()
-	d2.ensureInitialized()
+	zero, zero2 := d.Sign() == 0, d2.Sign() == 0
+	switch {
+	case zero && zero2:
+		return 0
+	case zero:
+		return -1
+	case zero2:
+		return 1
+	}
+	return cmpAbsNonZero(d, d2)
+}
+
+// cmpAbsNonZero compares the absolute values of two non-zero decimals. The
+// comparison doesn't allocate when both decimals have the same exponent, or
+// when their unscaled values fit in 64 bits, which is the case for most of the
+// decimals in a result set and for the literals they are compared to.
+func cmpAbsNonZero(d, d2 Decimal) int {
 	if d.exp == d2.exp {
 		return d.value.CmpAbs(d2.value)
 	}
+	if u, ok := smallAbs(d.value); ok {
+		if u2, ok := smallAbs(d2.value); ok {
+			if d.exp > d2.exp {
+				return cmpScaledUint64(u, uint64(int64(d.exp)-int64(d2.exp)), u2)
+			}
+			return -cmpScaledUint64(u2, uint64(int64(d2.exp)-int64(d.exp)), u)
+		}
+	}
 	rd, rd2 := RescalePair(d, d2)
 	return rd.value.CmpAbs(rd2.value)
 }
 
-// Equal returns whether the numbers represented by d and d2 are equal.
+// smallAbs returns the absolute value of v if it fits in an uint64.
+func smallAbs(v *big.Int) (uint64, bool) {
+	if v.BitLen() > 64 {
+		return 0, false
+	}
+	var u uint64
+	for i, w := range v.Bits() {
+		u |= uint64(w) << (uint(i) * bits.UintSize)
+	}
+	return u, true
+}
+
+// cmpScaledUint64 compares u * 10^scale with u2, where u is not zero.
+func cmpScaledUint64(u uint64, scale uint64, u2 uint64) int {
+	if scale >= uint64(len(pow10uint64tab)) {
+		// u * 10^scale is at least 10^20, which doesn't fit in an uint64
+		return 1
+	}
+	hi, lo := bits.Mul64(u, pow10uint64tab[scale])
+	switch {
+	case hi != 0 || lo > u2:
+		return 1
+	case lo < u2:
+		return -1
+	default:
+		return 0
+	}
+}
+
+var pow10uint64tab = [...]uint64{
+	1, 10, 100, 1000, 10000, 100000, 1000000, 10000000, 100000000, 1000000000,
+	10000000000, 100000000000, 1000000000000, 10000000000000, 100000000000000,
+	1000000000000000, 10000000000000000, 100000000000000000, 1000000000000000000,
+	10000000000000000000,
+}
+
+// Equal returns whether the numbers represented by d and d2 are equal. Like Cmp,
+// it doesn't allocate unless the decimals have different exponents and large
+// unscaled values.
 func (d Decimal) Equal(d2 Decimal) bool {
 	return d.Cmp(d2) == 0
 }
@@ -632,14 +797,26 @@ func (d Decimal) StringFixed(places int32) string {
 	return string(rounded.formatFast(0, false, false))
 }
 
+// StringMySQL returns the decimal formatted as MySQL does, with all the digits
+// of its scale and without an exponent.
 func (d Decimal) StringMySQL() string {
 	return string(d.formatFast(0, false, false))
 }
 
+// FormatMySQL returns the decimal formatted as MySQL does for a value with frac
+// fractional digits, e.g. for a DECIMAL(precision, frac) column: it is rounded
+// or padded with zeroes to have exactly frac digits after the period.
 func (d Decimal) FormatMySQL(frac int32) []byte {
 	return d.formatFast(int(frac), true, false)
 }
 
+// AppendFormat appends the same representation as FormatMySQL to buf and
+// returns the extended buffer. Reusing buf between calls avoids allocating
+// a new slice for every formatted value.
+func (d Decimal) AppendFormat(buf []byte, frac int32) []byte {
+	return d.appendFast(buf, int(frac), true, false)
+}
+
 // Round rounds the decimal to places decimal places.
 // If places < 0, it will round the integer part to the nearest 10^(-places).
 //
@@ -772,6 +949,10 @@ func (d Decimal) precision() int32 {
 	return int32(bigLength(d.value))
 }
 
+// Clamp returns the decimal, or the largest value of the same sign that can be
+// represented with the given amount of integral and fractional digits if the
+// decimal is larger than it, which is what MySQL stores in a DECIMAL column for
+// a value that is out of its range, e.g. 999.99 for DECIMAL(5,2).
 func (d Decimal) Clamp(integral, fractional int32) Decimal {
 	d.ensureInitialized()
 
@@ -793,3 +974,51 @@ func (d Decimal) Clamp(integral, fractional int32) Decimal {
 	return limit
 
 }
+
+// IntegralDigits returns the number of digits in the integral part of d, which
+// is 0 if the absolute value of d is lower than 1.
+func (d Decimal) IntegralDigits() int32 {
+	d.ensureInitialized()
+	if d.value.Sign() == 0 {
+		return 0
+	}
+	return max(d.exp+d.precision(), 0)
+}
+
+// Validate returns an error if d, with the given scale, can't be represented
+// by a MySQL DECIMAL: if the scale is larger than MyMaxScale, or if d has more
+// than MyMaxPrecision digits once rounded to the scale, in which case the error
+// is ErrOutOfRange.
+func (d Decimal) Validate(scale int32) error {
+	if scale < 0 || scale > MyMaxScale {
+		return fmt.Errorf("DECIMAL scale %d is out of range, the maximum is %d", scale, MyMaxScale)
+	}
+	d.ensureInitialized()
+	if d.Round(scale).IntegralDigits()+scale > MyMaxPrecision {
+		return ErrOutOfRange
+	}
+	return nil
+}
+
+// Coerce returns d, with the given scale, as it is held by MySQL: the scale is
+// limited to MyMaxScale, and to the digits that the integral part of d leaves
+// out of MyMaxPrecision, and d is rounded to it. It returns the rounded value
+// and its scale, or ErrOutOfRange if the integral part of d has more than
+// MyMaxPrecision digits.
+func (d Decimal) Coerce(scale int32) (Decimal, int32, error) {
+	d.ensureInitialized()
+	scale = max(min(scale, MyMaxScale), 0)
+	for {
+		integral := d.IntegralDigits()
+		if integral > MyMaxPrecision {
+			return Decimal{}, 0, ErrOutOfRange
+		}
+		if integral+scale <= MyMaxPrecision && -d.exp <= scale {
+			return d, scale, nil
+		}
+		// Rounding can carry into a new integral digit, e.g. 9.9 to 10, so
+		// the limits are checked again.
+		scale = min(scale, MyMaxPrecision-integral)
+		d = d.Round(scale)
+	}
+}
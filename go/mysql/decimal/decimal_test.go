@@ -945,6 +945,49 @@ func TestDecimal_Abs2(t *testing.T) {
 
 }
 
+func TestDecimal_CopySign(t *testing.T) {
+	tcs := []struct {
+		d, d2, want string
+	}{
+		{"1.5", "2", "1.5"},
+		{"1.5", "-2", "-1.5"},
+		{"-1.5", "2", "1.5"},
+		{"-1.5", "-0.01", "-1.5"},
+		{"-1.5", "0", "1.5"},
+		{"0", "-2", "0"},
+	}
+	for _, tc := range tcs {
+		d := RequireFromString(tc.d).CopySign(RequireFromString(tc.d2))
+		assert.Equal(t, tc.want, d.String(), "CopySign(%s, %s)", tc.d, tc.d2)
+	}
+	assert.Equal(t, "0", Decimal{}.CopySign(New(-1, 0)).String())
+}
+
+func TestDecimal_TruncTowardZero(t *testing.T) {
+	tcs := []struct {
+		d     string
+		scale int32
+		want  string
+	}{
+		{"1.999", 2, "1.99"},
+		{"-1.999", 1, "-1.9"},
+		{"-1.999", 0, "-1"},
+		{"1.5", 3, "1.5"},
+		{"-0.5", 0, "0"},
+		{"1234.5", -2, "1200"},
+		{"-1234.5", -2, "-1200"},
+		{"99.99", -2, "0"},
+		{"-99.99", -3, "0"},
+		{"12345678901234567890.123456789", 4, "12345678901234567890.1234"},
+	}
+	for _, tc := range tcs {
+		d := RequireFromString(tc.d).TruncTowardZero(tc.scale)
+		assert.Equal(t, tc.want, d.String(), "TruncTowardZero(%s, %d)", tc.d, tc.scale)
+		assert.LessOrEqual(t, d.Exponent(), int32(0))
+	}
+	assert.Equal(t, "0", Decimal{}.TruncTowardZero(2).String())
+}
+
 func TestDecimal_ScalesNotEqual(t *testing.T) {
 	a := New(1234, 2)
 	b := New(1234, 3)
@@ -1050,6 +1093,126 @@ func TestDecimal_Sign(t *testing.T) {
 
 }
 
+func TestMinMaxSumOf(t *testing.T) {
+	parse := func(values ...string) []Decimal {
+		var decs []Decimal
+		for _, v := range values {
+			d, err := NewFromString(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			decs = append(decs, d)
+		}
+		return decs
+	}
+
+	for _, tc := range []struct {
+		values        []string
+		min, max, sum string
+	}{
+		{[]string{"1"}, "1", "1", "1"},
+		{[]string{"1.5", "-2", "0", "3.25"}, "-2", "3.25", "2.75"},
+		{[]string{"-0.001", "-0.01", "-100"}, "-100", "-0.001", "-100.011"},
+		{[]string{"0", "0.000", "0"}, "0", "0", "0"},
+		{[]string{"99999999999999999999.99", "0.01", "-1e3"}, "-1000", "99999999999999999999.99", "99999999999999999000"},
+	} {
+		decs := parse(tc.values...)
+
+		want := parse(tc.min, tc.max, tc.sum)
+
+		min, ok := MinOf(decs)
+		assert.True(t, ok)
+		assert.Zero(t, want[0].Cmp(min), "MinOf(%v) = %v", tc.values, min)
+
+		max, ok := MaxOf(decs)
+		assert.True(t, ok)
+		assert.Zero(t, want[1].Cmp(max), "MaxOf(%v) = %v", tc.values, max)
+
+		sum := SumOf(decs)
+		assert.Zero(t, want[2].Cmp(sum), "SumOf(%v) = %v", tc.values, sum)
+	}
+
+	_, ok := MinOf(nil)
+	assert.False(t, ok)
+	_, ok = MaxOf(nil)
+	assert.False(t, ok)
+	assert.True(t, SumOf(nil).IsZero())
+}
+
+func TestAvg(t *testing.T) {
+	for _, tc := range []struct {
+		values []string
+		incr   int32
+		avg    string
+	}{
+		{[]string{"1", "2"}, 4, "1.5000"},
+		{[]string{"1.50", "2.25"}, 4, "1.875000"},
+		{[]string{"1", "1", "2"}, 4, "1.3333"},
+		{[]string{"2", "2", "1"}, 4, "1.6667"},
+		{[]string{"-2", "-2", "-1"}, 4, "-1.6667"},
+		{[]string{"0.00005", "0"}, 0, "0.00003"},
+		{[]string{"-0.00005", "0"}, 0, "-0.00003"},
+		{[]string{"1e3", "0.1"}, 4, "500.05000"},
+		{[]string{"99999999999999999999999999999999999999", "1"}, 4, "50000000000000000000000000000000000000.0000"},
+	} {
+		var avg Avg
+		for _, v := range tc.values {
+			avg.Add(RequireFromString(v))
+		}
+		assert.Equal(t, int64(len(tc.values)), avg.Count())
+
+		res, ok := avg.Result(tc.incr)
+		assert.True(t, ok)
+		assert.Equal(t, tc.avg, string(res.FormatMySQL(avg.Scale()+tc.incr)), "avg of %v", tc.values)
+
+		// merging partial averages must not lose any precision
+		for split := 0; split <= len(tc.values); split++ {
+			var left, right Avg
+			for _, v := range tc.values[:split] {
+				left.Add(RequireFromString(v))
+			}
+			for _, v := range tc.values[split:] {
+				right.Add(RequireFromString(v))
+			}
+			left.Merge(&right)
+
+			res, ok := left.Result(tc.incr)
+			assert.True(t, ok)
+			assert.Equal(t, tc.avg, string(res.FormatMySQL(left.Scale()+tc.incr)), "avg of %v split at %d", tc.values, split)
+		}
+	}
+
+	var avg Avg
+	_, ok := avg.Result(4)
+	assert.False(t, ok)
+
+	avg.Add(NewFromInt(1))
+	avg.Reset()
+	_, ok = avg.Result(4)
+	assert.False(t, ok)
+	assert.True(t, avg.Sum().IsZero())
+}
+
+func TestClampInPlace(t *testing.T) {
+	var decs []Decimal
+	for _, v := range []string{"1.5", "123.45", "-999", "-1000", "99.99"} {
+		d, err := NewFromString(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decs = append(decs, d)
+	}
+
+	clamped := ClampInPlace(decs, 2, 2)
+	assert.Equal(t, 3, clamped)
+
+	var got []string
+	for _, d := range decs {
+		got = append(got, d.StringFixed(2))
+	}
+	assert.Equal(t, []string{"1.50", "99.99", "-99.99", "-99.99", "99.99"}, got)
+}
+
 func didPanic(f func()) bool {
 	ret := false
 	func() {
@@ -1068,3 +1231,146 @@ func didPanic(f func()) bool {
 	return ret
 
 }
+
+func TestDecimal_CmpFastPaths(t *testing.T) {
+	slowCmp := func(d, d2 Decimal) int {
+		rd, rd2 := RescalePair(d, d2)
+		return rd.value.Cmp(rd2.value)
+	}
+
+	values := []Decimal{
+		{},
+		New(0, -2),
+		New(0, 5),
+		New(1, 0),
+		New(10, -1),
+		New(100, -2),
+		New(-1, 0),
+		New(-10, -1),
+		New(5, -3),
+		New(math.MaxInt64, 0),
+		New(math.MaxInt64, -19),
+		New(math.MinInt64, -1),
+		New(1, 19),
+		New(1, 20),
+		New(-1, 40),
+		RequireFromString("18446744073709551615"),
+		RequireFromString("18446744073709551616"),
+		RequireFromString("1844674407370955161.5"),
+		RequireFromString("-123456789012345678901234567890.123456789"),
+		RequireFromString("0.000000000000000000000000000001"),
+	}
+	for range 200 {
+		values = append(values, New(rand.Int64N(2000)-1000, rand.Int32N(40)-20))
+	}
+
+	for _, d := range values {
+		for _, d2 := range values {
+			want := slowCmp(d, d2)
+			assert.Equalf(t, want, d.Cmp(d2), "%v.Cmp(%v)", d, d2)
+			assert.Equalf(t, want == 0, d.Equal(d2), "%v.Equal(%v)", d, d2)
+
+			ra, ra2 := RescalePair(d.Abs(), d2.Abs())
+			assert.Equalf(t, ra.value.Cmp(ra2.value), d.CmpAbs(d2), "%v.CmpAbs(%v)", d, d2)
+		}
+	}
+}
+
+func TestDecimal_EqualDoesNotAllocate(t *testing.T) {
+	col := RequireFromString("1234.50")
+	tests := []struct {
+		d, d2 Decimal
+	}{
+		{Decimal{}, New(0, -2)},
+		{col, New(0, -2)},
+		{col, RequireFromString("1234.5")},
+		{col, RequireFromString("-1234.5")},
+		{col, RequireFromString("1234.5000001")},
+		{col, New(1, 3)},
+	}
+	for _, tt := range tests {
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = tt.d.Equal(tt.d2)
+			_ = tt.d.Cmp(tt.d2)
+			_ = tt.d.IsZero()
+		})
+		assert.Zerof(t, allocs, "comparing %v and %v allocates", tt.d, tt.d2)
+	}
+}
+
+func BenchmarkDecimal_Equal(b *testing.B) {
+	col := RequireFromString("1234.50")
+	zero := RequireFromString("0.00")
+	other := RequireFromString("1234.5")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = col.Equal(zero)
+		_ = col.Equal(other)
+	}
+}
+
+func TestPrepared_Cmp(t *testing.T) {
+	values := []Decimal{
+		{},
+		New(0, -2),
+		New(1, 0),
+		New(10, -1),
+		New(1000, -1),
+		New(-100, 0),
+		New(5, -3),
+		New(math.MaxInt64, 0),
+		New(math.MaxInt64, -19),
+		New(math.MinInt64, -1),
+		New(1, 19),
+		New(1, 20),
+		New(-1, 40),
+		RequireFromString("18446744073709551615"),
+		RequireFromString("18446744073709551616"),
+		RequireFromString("184467440737095516150"),
+		RequireFromString("1844674407370955161.5"),
+		RequireFromString("-123456789012345678901234567890.123456789"),
+		RequireFromString("0.000000000000000000000000000001"),
+	}
+	for range 200 {
+		values = append(values, New(rand.Int64N(2000)-1000, rand.Int32N(40)-20))
+	}
+
+	for _, d := range values {
+		p := d.Prepare()
+		assert.Same(t, d.value, p.Decimal().value)
+		for _, d2 := range values {
+			assert.Equalf(t, d.Cmp(d2), p.Cmp(d2), "%v.Prepare().Cmp(%v)", d, d2)
+			assert.Equalf(t, d.Equal(d2), p.Equal(d2), "%v.Prepare().Equal(%v)", d, d2)
+		}
+	}
+}
+
+func TestPrepared_CmpDoesNotAllocate(t *testing.T) {
+	bound := RequireFromString("1234.500").Prepare()
+	for _, d2 := range []Decimal{
+		New(0, -2),
+		RequireFromString("1234.5"),
+		RequireFromString("-1234.5"),
+		RequireFromString("1234.5000001"),
+		New(1, 3),
+		New(12345, -1),
+	} {
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = bound.Cmp(d2)
+		})
+		assert.Zerof(t, allocs, "comparing %v and %v allocates", bound.Decimal(), d2)
+	}
+}
+
+func BenchmarkPrepared_Cmp(b *testing.B) {
+	bound := RequireFromString("1234.500").Prepare()
+	col := RequireFromString("1234.49")
+	other := RequireFromString("99.5")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = bound.Cmp(col)
+		_ = bound.Cmp(other)
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package decimal implements the arbitrary precision decimals of MySQL, i.e.
+// the values of DECIMAL columns and of the exact arithmetic of the SQL
+// expressions. It is the implementation used by the evaluation engine of
+// vtgate, and should be used by any other part of Vitess that has to handle
+// DECIMAL values exactly (e.g. VReplication, the vstreamer or the encoding of
+// results) rather than going through floats or its own parsing.
+//
+// The following functions and methods follow the semantics of MySQL and are
+// kept stable:
+//
+//   - NewFromMySQL parses a DECIMAL value as MySQL sends it in the text protocol.
+//   - ParseForColumn parses a value for a DECIMAL(precision, scale) column,
+//     reporting truncation and overflow as MySQL does when storing it.
+//   - NewFromBinlog and BinlogSize decode the binary format of DECIMAL columns,
+//     as found e.g. in the row events of the binary log.
+//   - Decimal.FormatMySQL and Decimal.AppendFormat format a value with a given
+//     scale, the way MySQL returns it.
+//   - Decimal.Clamp and ClampInPlace limit a value to the largest one that fits
+//     in a DECIMAL column.
+//   - Decimal.WeightString returns the weight string of a value, so that
+//     decimals can be compared as bytes.
+//
+// The limits of MySQL, i.e. the largest precision and scale of a DECIMAL
+// column, are MyMaxPrecision and MyMaxScale. The rest of the API, e.g. the
+// arithmetic of Decimal, mostly comes from github.com/shopspring/decimal (see
+// the LICENSE file in this directory).
+package decimal
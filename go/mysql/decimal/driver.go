@@ -0,0 +1,95 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decimal
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+)
+
+var (
+	_ sql.Scanner   = (*Decimal)(nil)
+	_ driver.Valuer = Decimal{}
+	_ sql.Scanner   = (*NullDecimal)(nil)
+	_ driver.Valuer = NullDecimal{}
+)
+
+// Scan implements the sql.Scanner interface. MySQL drivers return DECIMAL columns
+// as text, which is parsed without going through a float so no precision is lost.
+// Integer and float values are converted exactly as NewFromInt and NewFromFloat do.
+// A NULL value cannot be scanned into a Decimal: use NullDecimal for nullable columns.
+func (d *Decimal) Scan(value any) error {
+	var err error
+	switch v := value.(type) {
+	case []byte:
+		*d, err = NewFromString(string(v))
+	case string:
+		*d, err = NewFromString(v)
+	case int64:
+		*d = NewFromInt(v)
+	case uint64:
+		*d = NewFromUint(v)
+	case float64:
+		*d = NewFromFloat(v)
+	case float32:
+		*d = NewFromFloat32(v)
+	case nil:
+		return fmt.Errorf("cannot scan NULL into a Decimal")
+	default:
+		return fmt.Errorf("cannot scan %T into a Decimal", value)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot scan into a Decimal: %w", err)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface. The decimal is sent to the
+// driver as its textual representation, keeping all of its fractional digits.
+func (d Decimal) Value() (driver.Value, error) {
+	d.ensureInitialized()
+	return d.StringMySQL(), nil
+}
+
+// NullDecimal is a Decimal that may be NULL, like the sql.Null* types.
+type NullDecimal struct {
+	Decimal Decimal
+	Valid   bool // Valid is true if Decimal is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullDecimal) Scan(value any) error {
+	if value == nil {
+		n.Decimal, n.Valid = Decimal{}, false
+		return nil
+	}
+	if err := n.Decimal.Scan(value); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullDecimal) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Decimal.Value()
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decimal
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimal_Scan(t *testing.T) {
+	testCases := []struct {
+		value    any
+		expected string
+		err      string
+	}{
+		{value: []byte("12345678901234567890.123456789012345678"), expected: "12345678901234567890.123456789012345678"},
+		{value: "-0.10", expected: "-0.10"},
+		{value: "1.5e3", expected: "1500"},
+		{value: int64(-42), expected: "-42"},
+		{value: uint64(18446744073709551615), expected: "18446744073709551615"},
+		{value: 0.1, expected: "0.1"},
+		{value: float32(2.5), expected: "2.5"},
+		{value: []byte("abc"), err: `cannot scan into a Decimal: invalid decimal string: "abc"`},
+		{value: nil, err: "cannot scan NULL into a Decimal"},
+		{value: true, err: "cannot scan bool into a Decimal"},
+	}
+
+	for _, tc := range testCases {
+		var d Decimal
+		err := d.Scan(tc.value)
+		if tc.err != "" {
+			assert.ErrorContains(t, err, tc.err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, d.StringMySQL())
+	}
+}
+
+func TestDecimal_Value(t *testing.T) {
+	v, err := RequireFromString("-12345678901234567890.1234567890").Value()
+	require.NoError(t, err)
+	assert.Equal(t, "-12345678901234567890.1234567890", v)
+
+	v, err = RequireFromString("1.500").Value()
+	require.NoError(t, err)
+	assert.Equal(t, "1.500", v)
+
+	v, err = Decimal{}.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "0", v)
+
+	// round trip through the driver
+	var d Decimal
+	in := RequireFromString("0.000000000000000000000000000001")
+	v, err = in.Value()
+	require.NoError(t, err)
+	require.NoError(t, d.Scan(v))
+	assert.True(t, in.Equal(d))
+}
+
+func TestNullDecimal(t *testing.T) {
+	var n NullDecimal
+	require.NoError(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+	v, err := n.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	require.NoError(t, n.Scan([]byte("3.14")))
+	assert.True(t, n.Valid)
+	assert.Equal(t, "3.14", n.Decimal.String())
+	v, err = n.Value()
+	require.NoError(t, err)
+	assert.Equal(t, driver.Value("3.14"), v)
+
+	assert.Error(t, n.Scan("foo"))
+	assert.False(t, n.Valid)
+}
@@ -16,6 +16,8 @@ limitations under the License.
 
 package decimal
 
+import "slices"
+
 func appendZeroes(buf []byte, n int) []byte {
 	const zeroes = "0000000000000000"
 	for n >= len(zeroes) {
@@ -151,6 +153,12 @@ func (d *Decimal) formatMantissa(buf []byte) []byte {
 // which must be >= 0
 // If trim is true, trailing zeroes after the decimal period will be stripped
 func (d *Decimal) formatFast(prec int, round bool, trim bool) []byte {
+	return d.appendFast(nil, prec, round, trim)
+}
+
+// appendFast is like formatFast, but appends the base10 representation of
+// this decimal to dst and returns the extended buffer.
+func (d *Decimal) appendFast(dst []byte, prec int, round bool, trim bool) []byte {
 	var (
 		buf      []byte
 		exp      int
@@ -212,10 +220,10 @@ func (d *Decimal) formatFast(prec int, round bool, trim bool) []byte {
 		prec = len(integral)
 	}
 
-	// alloc allocates the destination buf to the right size, and prepends a
+	// alloc grows the destination buf to the right size, and prepends a
 	// negative sign for negative numbers
 	alloc := func(length int) []byte {
-		buf := make([]byte, 0, length+1)
+		buf := slices.Grow(dst, length+1)
 		if sign < 0 {
 			buf = append(buf, '-')
 		}
@@ -18,6 +18,23 @@ package decimal
 
 import "vitess.io/vitess/go/vt/vthash"
 
+// Hash writes d to hasher in a normal form that doesn't depend on the exponent
+// of d: decimals that are numerically equal, like 1.1, 1.10 and 110e-2, have the
+// same hash, so it can be used to group values by their numeric value, e.g. in
+// a hash map keyed by the hasher's sum.
 func (d *Decimal) Hash(hasher *vthash.Hasher) {
-	_, _ = hasher.Write(d.formatFast(0, false, true))
+	var buf [maxUint64FormatSize + 4]byte
+	_, _ = hasher.Write(d.appendFast(buf[:0], 0, false, true))
+}
+
+// Hash writes n to hasher like Decimal.Hash. NULL is hashed to a value that no
+// decimal hashes to, so that all the NULLs are grouped together and apart from
+// the other values, like in a GROUP BY.
+func (n NullDecimal) Hash(hasher *vthash.Hasher) {
+	if !n.Valid {
+		// A decimal is hashed as its digits, so it never starts with 'N'.
+		_, _ = hasher.Write([]byte("NULL"))
+		return
+	}
+	n.Decimal.Hash(hasher)
 }
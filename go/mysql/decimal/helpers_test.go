@@ -0,0 +1,85 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decimal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/vthash"
+)
+
+func hashDecimal(d Decimal) vthash.Hash {
+	h := vthash.New()
+	d.Hash(&h)
+	return h.Sum128()
+}
+
+func TestDecimalHash(t *testing.T) {
+	// Each group holds representations of the same number, with different
+	// exponents, and the numbers of different groups are all different.
+	groups := [][]Decimal{
+		{RequireFromString("1.1"), RequireFromString("1.10"), RequireFromString("1.1000"), New(110, -2), New(11, -1)},
+		{RequireFromString("11"), New(11, 0), New(110, -1), New(1100, -2)},
+		{RequireFromString("0.11"), New(11, -2), New(1100, -4)},
+		{RequireFromString("-1.1"), RequireFromString("-1.100"), New(-110, -2)},
+		{RequireFromString("100"), RequireFromString("1e2"), RequireFromString("100.000"), New(1, 2), New(10, 1), NewFromInt(100)},
+		{RequireFromString("0"), RequireFromString("0.000"), RequireFromString("-0.00"), New(0, 5), New(0, -5), NewFromInt(0)},
+		{RequireFromString("0.001"), New(1, -3), New(1000, -6)},
+		{RequireFromString("1000"), New(1, 3)},
+		{RequireFromString("123456789012345678901234567890.5"), RequireFromString("123456789012345678901234567890.50000")},
+		{RequireFromString("-123456789012345678901234567890"), RequireFromString("-1234567890123456789012345678900e-1")},
+	}
+
+	seen := make(map[vthash.Hash]string)
+	for _, group := range groups {
+		want := hashDecimal(group[0])
+		for _, d := range group[1:] {
+			assert.Equal(t, want, hashDecimal(d), "hash of %s and %s", group[0].String(), d.String())
+		}
+		if other, ok := seen[want]; ok {
+			t.Errorf("hash of %s collides with the one of %s", group[0].String(), other)
+		}
+		seen[want] = group[0].String()
+	}
+}
+
+func TestDecimalHashDoesNotAllocate(t *testing.T) {
+	d := New(110, -2)
+	h := vthash.New()
+	allocs := testing.AllocsPerRun(100, func() {
+		d.Hash(&h)
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestNullDecimalHash(t *testing.T) {
+	hash := func(n NullDecimal) vthash.Hash {
+		h := vthash.New()
+		n.Hash(&h)
+		return h.Sum128()
+	}
+
+	null := hash(NullDecimal{})
+	assert.Equal(t, null, hash(NullDecimal{Decimal: NewFromInt(1)}))
+	assert.Equal(t, hashDecimal(New(110, -2)), hash(NewNullDecimal(RequireFromString("1.1"))))
+	for _, s := range []string{"0", "1", strings.Repeat("9", 65)} {
+		assert.NotEqual(t, null, hash(NewNullDecimal(RequireFromString(s))), "hash of %s", s)
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decimal
+
+import (
+	"math"
+	"math/big"
+)
+
+// The exponential and the logarithms of a decimal are irrational, so unlike
+// the rest of the arithmetic, Exp, Ln and Log10 round their results, to the
+// number of fractional digits given by their precision argument. The results
+// are computed with mathGuardDigits more digits before they are rounded, so
+// they are correctly rounded unless the exact result lies within
+// 10^-(precision+mathGuardDigits) of the middle of two rounded values.
+//
+// Note that MySQL doesn't compute EXP, LN, LOG, LOG2 and LOG10 with decimals:
+// it converts their DECIMAL arguments to DOUBLE, and returns a DOUBLE, so their
+// results only have the ~17 significant digits of a float64, and the digits
+// of a DECIMAL argument beyond those are ignored. The evaluation engine does
+// the same to match MySQL, so it doesn't use these methods for the SQL
+// functions; they are for the callers that need the exact digits.
+
+// mathGuardDigits is how many more digits than requested Exp, Ln and Log10
+// compute before rounding their results.
+const mathGuardDigits = 10
+
+// Exp returns e raised to the power of d, rounded to precision fractional
+// digits. It returns false if the result has more than MyMaxPrecision integral
+// digits, i.e. if d is larger than ~149.67.
+func (d Decimal) Exp(precision int32) (Decimal, bool) {
+	if d.IsZero() {
+		return New(1, 0).Round(precision), true
+	}
+
+	// The float64 value of d is only used to bound the computation.
+	f, _ := d.Float64()
+	if f > math.Ln10*(MyMaxPrecision+1) {
+		return Decimal{}, false
+	}
+	if f < -math.Ln10*float64(max(precision, 0)+2) {
+		// The result rounds to zero.
+		return New(0, 0).Round(precision), true
+	}
+
+	// e^d is computed as (e^(d/2^halvings))^(2^halvings), with the Taylor
+	// series of e^x for |x| < 1/2. The digits of the result, and the error
+	// amplified by each squaring, are added to the working scale.
+	halvings := 0
+	if a := math.Abs(f); a >= 0.5 {
+		halvings = int(math.Ceil(math.Log2(a))) + 1
+	}
+	integral := max(int32(math.Ceil(f/math.Ln10)), 0)
+	scale := max(precision, 0) + mathGuardDigits + integral + int32(halvings)
+	one := bigPow10(uint64(scale))
+
+	x := d.rescale(-scale).value
+	x.Rsh(x, uint(halvings))
+
+	sum := new(big.Int).Set(one)
+	term := new(big.Int).Set(one)
+	n := new(big.Int)
+	for i := int64(1); ; i++ {
+		term.Mul(term, x)
+		term.Quo(term, n.Mul(one, n.SetInt64(i)))
+		if term.Sign() == 0 {
+			break
+		}
+		sum.Add(sum, term)
+	}
+	for range halvings {
+		sum.Mul(sum, sum)
+		sum.Quo(sum, one)
+	}
+
+	res := Decimal{value: sum, exp: -scale}.Round(precision)
+	if res.IntegralDigits() > MyMaxPrecision {
+		return Decimal{}, false
+	}
+	return res, true
+}
+
+// Ln returns the natural logarithm of d, rounded to precision fractional
+// digits. It returns false if d is not positive.
+func (d Decimal) Ln(precision int32) (Decimal, bool) {
+	if d.Sign() <= 0 {
+		return Decimal{}, false
+	}
+	scale, ln := d.ln(precision)
+	return Decimal{value: ln, exp: -scale}.Round(precision), true
+}
+
+// Log10 returns the base-10 logarithm of d, rounded to precision fractional
+// digits. The logarithms of the powers of ten are exact. It returns false if d
+// is not positive.
+func (d Decimal) Log10(precision int32) (Decimal, bool) {
+	if d.Sign() <= 0 {
+		return Decimal{}, false
+	}
+	if k, ok := d.powerOfTen(); ok {
+		return New(int64(k), 0).Round(precision), true
+	}
+	scale, ln := d.ln(precision)
+	one := bigPow10(uint64(scale))
+	ln.Mul(ln, one)
+	ln.Quo(ln, fixedLn10(one))
+	return Decimal{value: ln, exp: -scale}.Round(precision), true
+}
+
+// powerOfTen returns k if d is 10^k.
+func (d Decimal) powerOfTen() (int32, bool) {
+	value := new(big.Int).Set(d.value)
+	k := d.exp
+	rem := new(big.Int)
+	for value.Cmp(oneInt) > 0 {
+		value.QuoRem(value, tenInt, rem)
+		if rem.Sign() != 0 {
+			return 0, false
+		}
+		k++
+	}
+	return k, value.Cmp(oneInt) == 0
+}
+
+// ln returns the natural logarithm of the positive d as a fixed-point number
+// with the returned scale, which has enough digits to round it to precision
+// fractional digits.
+func (d Decimal) ln(precision int32) (int32, *big.Int) {
+	// d = m * 10^k, with 1 <= m < 10, and ln(d) = ln(m) + k*ln(10). The error
+	// of ln(10) is multiplied by k, so the digits of k are added to the scale.
+	digits := int32(bigLength(d.value))
+	k := d.exp + digits - 1
+	scale := max(precision, 0) + mathGuardDigits + int32(bigLength(big.NewInt(int64(k))))
+	one := bigPow10(uint64(scale))
+
+	m := new(big.Int).Set(d.value)
+	if shift := scale - (digits - 1); shift >= 0 {
+		m.Mul(m, bigPow10(uint64(shift)))
+	} else {
+		m.Quo(m, bigPow10(uint64(-shift)))
+	}
+
+	// m = r * 2^j, with 1 <= r < 2, and ln(m) = j*ln(2) + 2*atanh((r-1)/(r+1)),
+	// where (r-1)/(r+1) < 1/3 so that the series of atanh converges quickly.
+	j := 0
+	for two := new(big.Int).Lsh(one, 1); m.Cmp(two) >= 0; j++ {
+		m.Rsh(m, 1)
+	}
+	z := new(big.Int).Sub(m, one)
+	z.Mul(z, one)
+	z.Quo(z, m.Add(m, one))
+	ln := fixedAtanh(z, one)
+	ln.Lsh(ln, 1)
+
+	ln2 := fixedLn2(one)
+	ln.Add(ln, ln2.Mul(ln2, big.NewInt(int64(j))))
+	ln10 := fixedLn10(one)
+	ln.Add(ln, ln10.Mul(ln10, big.NewInt(int64(k))))
+	return scale, ln
+}
+
+// fixedAtanh returns the inverse hyperbolic tangent of z, for |z| <= 1/3, with
+// its series z + z^3/3 + z^5/5 + ... All the numbers are fixed-point numbers
+// whose unit is one.
+func fixedAtanh(z, one *big.Int) *big.Int {
+	z2 := new(big.Int).Mul(z, z)
+	z2.Quo(z2, one)
+
+	sum := new(big.Int).Set(z)
+	pow := new(big.Int).Set(z)
+	term := new(big.Int)
+	for n := int64(3); ; n += 2 {
+		pow.Mul(pow, z2)
+		pow.Quo(pow, one)
+		term.Quo(pow, big.NewInt(n))
+		if term.Sign() == 0 {
+			return sum
+		}
+		sum.Add(sum, term)
+	}
+}
+
+// fixedLn2 returns ln(2) = 2*atanh(1/3) as a fixed-point number whose unit is
+// one.
+func fixedLn2(one *big.Int) *big.Int {
+	ln2 := fixedAtanh(new(big.Int).Quo(one, big.NewInt(3)), one)
+	return ln2.Lsh(ln2, 1)
+}
+
+// fixedLn10 returns ln(10) = 3*ln(2) + 2*atanh(1/9) as a fixed-point number
+// whose unit is one.
+func fixedLn10(one *big.Int) *big.Int {
+	ln10 := fixedAtanh(new(big.Int).Quo(one, big.NewInt(9)), one)
+	ln10.Lsh(ln10, 1)
+	ln2 := fixedLn2(one)
+	return ln10.Add(ln10, ln2.Mul(ln2, big.NewInt(3)))
+}
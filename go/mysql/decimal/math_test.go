@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decimal
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mathTestCase struct {
+	input     string
+	precision int32
+	expected  string // empty if the function fails
+}
+
+func testMathFunc(t *testing.T, fn func(Decimal, int32) (Decimal, bool), cases []mathTestCase) {
+	for _, tc := range cases {
+		res, ok := fn(RequireFromString(tc.input), tc.precision)
+		if tc.expected == "" {
+			assert.False(t, ok, "%s: got %s", tc.input, res)
+			continue
+		}
+		if assert.True(t, ok, tc.input) {
+			assert.Equal(t, tc.expected, res.StringFixed(tc.precision), tc.input)
+		}
+	}
+}
+
+// The expected values were computed with the decimal module of Python, with a
+// precision of 200 digits, and rounded half up.
+
+func TestDecimal_Exp(t *testing.T) {
+	testMathFunc(t, Decimal.Exp, []mathTestCase{
+		{"0", 2, "1.00"},
+		{"1", 30, "2.718281828459045235360287471353"},
+		{"-1", 20, "0.36787944117144232160"},
+		{"10", 10, "22026.4657948067"},
+		{"0.5", 25, "1.6487212707001281468486508"},
+		{"-0.000001", 20, "0.99999900000049999983"},
+		{"1.0000000000000000000000000001", 30, "2.718281828459045235360287471624"},
+		{"-50", 30, "0.000000000000000000000192874985"},
+		{"-50", 10, "0.0000000000"},
+		{"-1000000", 5, "0.00000"},
+		// The largest results have MyMaxPrecision integral digits.
+		{"149.6", 5, "93423147027259918425566364983705156857372914045886174329643027409.95740"},
+		{"149.7", 5, ""},
+		{"1000000", 5, ""},
+	})
+}
+
+func TestDecimal_Ln(t *testing.T) {
+	testMathFunc(t, Decimal.Ln, []mathTestCase{
+		{"1", 5, "0.00000"},
+		{"2", 30, "0.693147180559945309417232121458"},
+		{"0.5", 30, "-0.693147180559945309417232121458"},
+		{"10", 20, "2.30258509299404568402"},
+		{"0.001", 10, "-6.9077552790"},
+		{"1e40", 10, "92.1034037198"},
+		{"12345.6789", 15, "9.421061394191835"},
+		{"1.0000000000000000000000000001", 35, "0.00000000000000000000000000010000000"},
+		{"99999999999999999999999999999999999.99", 10, "80.5904782548"},
+		{"0", 5, ""},
+		{"-1", 5, ""},
+	})
+}
+
+func TestDecimal_Log10(t *testing.T) {
+	testMathFunc(t, Decimal.Log10, []mathTestCase{
+		{"1", 3, "0.000"},
+		{"1000", 5, "3.00000"},
+		{"0.001", 2, "-3.00"},
+		{"1e-40", 0, "-40"},
+		{"2", 25, "0.3010299956639811952137389"},
+		{"0.5", 20, "-0.30102999566398119521"},
+		{"12345.6789", 15, "4.091514977169270"},
+		{"3.1622776601683793319988935444327", 30, "0.500000000000000000000000000000"},
+		{"0", 5, ""},
+		{"-10", 5, ""},
+	})
+}
+
+func TestDecimal_MathFloat64(t *testing.T) {
+	// The results agree with the float64 functions, up to the precision of
+	// the float64s, or to the 30 fractional digits of the results.
+	for range 1000 {
+		f := (rand.Float64() - 0.5) * 200
+		d := NewFromFloat(f)
+
+		exp, ok := d.Exp(30)
+		require.True(t, ok, d.String())
+		expf, _ := exp.Float64()
+		assert.InDelta(t, math.Exp(f), expf, max(1e-14*expf, 1e-30), d.String())
+
+		d = d.Abs()
+		f = math.Abs(f)
+
+		ln, ok := d.Ln(30)
+		require.True(t, ok, d.String())
+		lnf, _ := ln.Float64()
+		assert.InDelta(t, math.Log(f), lnf, 1e-14*math.Max(1, math.Abs(lnf)), d.String())
+
+		log10, ok := d.Log10(30)
+		require.True(t, ok, d.String())
+		log10f, _ := log10.Float64()
+		assert.InDelta(t, math.Log10(f), log10f, 1e-14*math.Max(1, math.Abs(log10f)), d.String())
+	}
+}
@@ -126,6 +126,21 @@ func TestDecimalDiv(t *testing.T) {
 		{"0.0123456789012345678912345", "9999999999", "0.000000000001234567890246913578148141", 5},
 		{"10.333000000", "12.34500", "0.837019036046982584042122316", 5},
 		{"10.000000000060", "2", "5.000000000030000000", 5},
+		// The scales of the operands are rounded up to 9 digits each
+		{"0.4", "0.3", "1.333333333333333333", 4},
+		{"1.5", "-0.25", "-6.000000000000000000", 4},
+		{"0.7", "1919147056.60", "0.000000000364745368", 4},
+		{"-3.29303", "0.11", "-29.936636363636363636", 0},
+		{"1.000000001", "3.000000000", "0.333333333666666666666666666", 4},
+		{"1", "0.000000001", "1000000000.000000000000000000", 4},
+		// The quotient only has room for 81 digits, and its integral part takes precedence
+		{"12345678901234567890.123456789012345678901234567890", "3.000000000000000000000000000001", "4115226300411522630.041152263002743484200274348419986282578999085505266575", 4},
+		{"185622777495187952515612794569.505371475957382539344391603813", "14139.000000000000000000000000000060", "13128423332285731134847782.344543785541449709696263972044477850440120766179886712", 5},
+		{"-719518451217732848574698931088.027526694630182247920095500643", "0.00087", "-827032702549118216752527506997732.789304172623273471374138670114942528735632183", 12},
+		{"99999999999999999999999999999999999.999999999999999999999999999999", "0.000000000000000000000000000001", "99999999999999999999999999999999999999999999999999999999999999999.000000000", 4},
+		// The integral part is estimated from the leading 9-digit words of the operands
+		{"123456789.000000000000000000000000000001", "123456789.000000000000000000000000000002", "0.999999999999999999999999999999999999991899999926289999329238993896074844", 30},
+		{"123456789.000000000000000000000000000002", "123456789.000000000000000000000000000001", "1.000000000000000000000000000000000000008100000073710000670761006103925155", 30},
 	} {
 		left := RequireFromString(tc.lhs)
 		right := RequireFromString(tc.rhs)
@@ -378,6 +393,25 @@ func TestRoundtripStress(t *testing.T) {
 	}
 }
 
+func TestAppendFormat(t *testing.T) {
+	var buf []byte
+	for _, in := range decimals {
+		d, err := NewFromMySQL([]byte(in))
+		if err != nil {
+			continue
+		}
+		for _, frac := range []int32{0, 2, 8, 30} {
+			buf = append(buf[:0], "prefix:"...)
+			buf = d.AppendFormat(buf, frac)
+
+			expected := "prefix:" + string(d.FormatMySQL(frac))
+			if string(buf) != expected {
+				t.Errorf("AppendFormat(%q, %d) = %q, expected %q", in, frac, buf, expected)
+			}
+		}
+	}
+}
+
 func BenchmarkFormatting(b *testing.B) {
 	const Count = 10000
 	var parsed = make([]Decimal, 0, Count)
@@ -403,6 +437,25 @@ func BenchmarkFormatting(b *testing.B) {
 		}
 	})
 
+	b.Run("FormatMySQL(8)", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			for _, dec := range parsed {
+				_ = dec.FormatMySQL(8)
+			}
+		}
+	})
+
+	b.Run("AppendFormat(8)", func(b *testing.B) {
+		b.ReportAllocs()
+		var buf []byte
+		for n := 0; n < b.N; n++ {
+			for _, dec := range parsed {
+				buf = dec.AppendFormat(buf[:0], 8)
+			}
+		}
+	})
+
 	b.Run("formatFast", func(b *testing.B) {
 		b.ReportAllocs()
 		for n := 0; n < b.N; n++ {
@@ -473,3 +526,169 @@ func TestFormatAndRound(t *testing.T) {
 		}
 	}
 }
+
+func TestParseForColumn(t *testing.T) {
+	var cases = []struct {
+		input            string
+		precision, scale int32
+		result           string
+		truncated        bool
+		overflow         bool
+	}{
+		{"1.5", 5, 2, "1.50", false, false},
+		{"-1.5", 5, 2, "-1.50", false, false},
+		{"12", 5, 0, "12", false, false},
+		{"1.005", 5, 2, "1.01", true, false},
+		{"-1.005", 5, 2, "-1.01", true, false},
+		{"1.001", 5, 2, "1.00", true, false},
+		{"1.000", 5, 2, "1.00", false, false},
+		{"999.99", 5, 2, "999.99", false, false},
+		{"1000", 5, 2, "999.99", false, true},
+		{"-1000", 5, 2, "-999.99", false, true},
+		{"999.999", 5, 2, "999.99", true, true},
+		{"0.5", 2, 2, "0.50", false, false},
+		{"1.5", 2, 2, "0.99", false, true},
+		{"123456789012345678901234567890.123", 65, 30, "123456789012345678901234567890.123000000000000000000000000000", false, false},
+		{"1234567890123456789012345678901234567890", 30, 0, "999999999999999999999999999999", false, true},
+	}
+
+	for _, tc := range cases {
+		d, truncated, overflow, err := ParseForColumn([]byte(tc.input), tc.precision, tc.scale)
+		if err != nil {
+			t.Errorf("ParseForColumn(%q, %d, %d) failed: %v", tc.input, tc.precision, tc.scale, err)
+			continue
+		}
+		if d.StringMySQL() != tc.result || truncated != tc.truncated || overflow != tc.overflow {
+			t.Errorf("ParseForColumn(%q, %d, %d) = %q, %v, %v (expected %q, %v, %v)",
+				tc.input, tc.precision, tc.scale, d.StringMySQL(), truncated, overflow, tc.result, tc.truncated, tc.overflow)
+		}
+	}
+
+	if _, _, _, err := ParseForColumn([]byte("1.2.3"), 5, 2); err == nil {
+		t.Errorf("ParseForColumn(%q) should fail", "1.2.3")
+	}
+}
+
+func TestValidateAndCoerce(t *testing.T) {
+	nines := func(n int) string { return strings.Repeat("9", n) }
+
+	var cases = []struct {
+		input       string
+		scale       int32
+		valid       bool
+		result      string
+		resultScale int32
+		outOfRange  bool
+	}{
+		{"1.5", 1, true, "1.5", 1, false},
+		{"1.5", 4, true, "1.5000", 4, false},
+		{"0.0000001", 30, true, "0.0000001" + strings.Repeat("0", 23), 30, false},
+		{"1.5", 31, false, "1.5" + strings.Repeat("0", 29), 30, false},
+		{"0." + strings.Repeat("1", 33), 33, false, "0." + strings.Repeat("1", 30), 30, false},
+		{"0." + strings.Repeat("5", 33), 33, false, "0." + strings.Repeat("5", 29) + "6", 30, false},
+		{nines(65), 0, true, nines(65), 0, false},
+		{"-" + nines(65), 0, true, "-" + nines(65), 0, false},
+		{nines(60) + ".12345678", 8, false, nines(60) + ".12346", 5, false},
+		{nines(60) + ".999999", 6, false, "1" + strings.Repeat("0", 60) + ".0000", 4, false},
+		{nines(65) + ".5", 1, false, "", 0, true},
+		{"1" + strings.Repeat("0", 65), 0, false, "", 0, true},
+		{"1e70", 0, false, "", 0, true},
+	}
+
+	for _, tc := range cases {
+		d := RequireFromString(tc.input)
+		if err := d.Validate(tc.scale); (err == nil) != tc.valid {
+			t.Errorf("%s.Validate(%d) = %v (expected valid: %v)", tc.input, tc.scale, err, tc.valid)
+		}
+
+		res, scale, err := d.Coerce(tc.scale)
+		if tc.outOfRange {
+			if err != ErrOutOfRange {
+				t.Errorf("%s.Coerce(%d) = %v (expected %v)", tc.input, tc.scale, err, ErrOutOfRange)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s.Coerce(%d) failed: %v", tc.input, tc.scale, err)
+			continue
+		}
+		if got := string(res.FormatMySQL(scale)); got != tc.result || scale != tc.resultScale {
+			t.Errorf("%s.Coerce(%d) = %s, %d (expected %s, %d)", tc.input, tc.scale, got, scale, tc.result, tc.resultScale)
+		}
+		if err := res.Validate(scale); err != nil {
+			t.Errorf("%s.Coerce(%d) = %s, %d is not valid: %v", tc.input, tc.scale, res.StringMySQL(), scale, err)
+		}
+	}
+}
+
+func TestParseForColumnMatchesRoundAndClamp(t *testing.T) {
+	// parseRoundAndClamp converts the value the way ParseForColumn does, in
+	// several steps.
+	parseRoundAndClamp := func(data []byte, precision, scale int32) (Decimal, bool, bool, error) {
+		parsed, err := NewFromMySQL(data)
+		if err != nil {
+			return Decimal{}, false, false, err
+		}
+		dec := parsed.Round(scale)
+		truncated := dec.Cmp(parsed) != 0
+		limit := largestForm(precision-scale, scale, dec.Sign() < 0)
+		if dec.CmpAbs(limit) > 0 {
+			return limit, truncated, true, nil
+		}
+		return dec, truncated, false, nil
+	}
+
+	randomDigits := func(n int) []byte {
+		digits := make([]byte, n)
+		for i := range digits {
+			// nines make the rounding carry more often
+			if rand.IntN(3) == 0 {
+				digits[i] = '9'
+			} else {
+				digits[i] = byte('0' + rand.IntN(10))
+			}
+		}
+		return digits
+	}
+
+	for range 20000 {
+		var input []byte
+		switch rand.IntN(3) {
+		case 0:
+			input = append(input, '-')
+		case 1:
+			input = append(input, '+')
+		}
+		input = append(input, randomDigits(rand.IntN(40))...)
+		if rand.IntN(4) != 0 {
+			input = append(input, '.')
+			input = append(input, randomDigits(rand.IntN(40))...)
+		}
+		if len(bytes.Trim(input, "+-.")) == 0 {
+			continue
+		}
+		precision := 1 + rand.Int32N(MyMaxPrecision)
+		scale := rand.Int32N(min(precision, MyMaxScale) + 1)
+
+		want, wantTruncated, wantOverflow, err := parseRoundAndClamp(input, precision, scale)
+		if err != nil {
+			t.Fatalf("parsing %q failed: %v", input, err)
+		}
+		got, truncated, overflow, err := ParseForColumn(input, precision, scale)
+		if err != nil {
+			t.Fatalf("ParseForColumn(%q, %d, %d) failed: %v", input, precision, scale, err)
+		}
+		if got.StringMySQL() != want.StringMySQL() || truncated != wantTruncated || overflow != wantOverflow {
+			t.Fatalf("ParseForColumn(%q, %d, %d) = %q, %v, %v (expected %q, %v, %v)",
+				input, precision, scale, got.StringMySQL(), truncated, overflow, want.StringMySQL(), wantTruncated, wantOverflow)
+		}
+	}
+}
+
+func BenchmarkParseForColumn(b *testing.B) {
+	input := []byte("-12345678901234.567890123")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _, _, _ = ParseForColumn(input, 20, 6)
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decimal
+
+// The arithmetic and comparisons of NullDecimal follow the semantics of NULL
+// in SQL: the result of an operation is NULL if any of its operands is NULL,
+// and a comparison with NULL is neither true nor false. Comparisons return
+// their result along with whether it is NULL, so that they can be used in
+// three-valued logic without checking the operands first.
+
+// NewNullDecimal returns a NullDecimal that is not NULL.
+func NewNullDecimal(d Decimal) NullDecimal {
+	return NullDecimal{Decimal: d, Valid: true}
+}
+
+// IsNull returns whether n is NULL.
+func (n NullDecimal) IsNull() bool {
+	return !n.Valid
+}
+
+// Add returns n + n2, or NULL if any of them is NULL.
+func (n NullDecimal) Add(n2 NullDecimal) NullDecimal {
+	if !n.Valid || !n2.Valid {
+		return NullDecimal{}
+	}
+	return NewNullDecimal(n.Decimal.Add(n2.Decimal))
+}
+
+// Sub returns n - n2, or NULL if any of them is NULL.
+func (n NullDecimal) Sub(n2 NullDecimal) NullDecimal {
+	if !n.Valid || !n2.Valid {
+		return NullDecimal{}
+	}
+	return NewNullDecimal(n.Decimal.Sub(n2.Decimal))
+}
+
+// Mul returns n * n2, or NULL if any of them is NULL.
+func (n NullDecimal) Mul(n2 NullDecimal) NullDecimal {
+	if !n.Valid || !n2.Valid {
+		return NullDecimal{}
+	}
+	return NewNullDecimal(n.Decimal.Mul(n2.Decimal))
+}
+
+// Div returns n / n2 like Decimal.Div, or NULL if any of them is NULL. Like
+// in MySQL, a division by zero is NULL too.
+func (n NullDecimal) Div(n2 NullDecimal, scaleIncr int32) NullDecimal {
+	if !n.Valid || !n2.Valid || n2.Decimal.IsZero() {
+		return NullDecimal{}
+	}
+	return NewNullDecimal(n.Decimal.Div(n2.Decimal, scaleIncr))
+}
+
+// Neg returns -n, or NULL if n is NULL.
+func (n NullDecimal) Neg() NullDecimal {
+	if !n.Valid {
+		return NullDecimal{}
+	}
+	return NewNullDecimal(n.Decimal.Neg())
+}
+
+// Cmp compares n and n2 like Decimal.Cmp. The second return value is true if
+// any of them is NULL, in which case the result of the comparison is unknown.
+func (n NullDecimal) Cmp(n2 NullDecimal) (int, bool) {
+	if !n.Valid || !n2.Valid {
+		return 0, true
+	}
+	return n.Decimal.Cmp(n2.Decimal), false
+}
+
+// Equal returns whether n = n2, and whether the result is NULL.
+func (n NullDecimal) Equal(n2 NullDecimal) (bool, bool) {
+	cmp, null := n.Cmp(n2)
+	return !null && cmp == 0, null
+}
+
+// NotEqual returns whether n != n2, and whether the result is NULL.
+func (n NullDecimal) NotEqual(n2 NullDecimal) (bool, bool) {
+	cmp, null := n.Cmp(n2)
+	return !null && cmp != 0, null
+}
+
+// LessThan returns whether n < n2, and whether the result is NULL.
+func (n NullDecimal) LessThan(n2 NullDecimal) (bool, bool) {
+	cmp, null := n.Cmp(n2)
+	return !null && cmp < 0, null
+}
+
+// LessThanOrEqual returns whether n <= n2, and whether the result is NULL.
+func (n NullDecimal) LessThanOrEqual(n2 NullDecimal) (bool, bool) {
+	cmp, null := n.Cmp(n2)
+	return !null && cmp <= 0, null
+}
+
+// GreaterThan returns whether n > n2, and whether the result is NULL.
+func (n NullDecimal) GreaterThan(n2 NullDecimal) (bool, bool) {
+	cmp, null := n.Cmp(n2)
+	return !null && cmp > 0, null
+}
+
+// GreaterThanOrEqual returns whether n >= n2, and whether the result is NULL.
+func (n NullDecimal) GreaterThanOrEqual(n2 NullDecimal) (bool, bool) {
+	cmp, null := n.Cmp(n2)
+	return !null && cmp >= 0, null
+}
+
+// NullSafeEqual returns whether n <=> n2: two NULLs are equal, and NULL is not
+// equal to any other value. The result is never NULL.
+func (n NullDecimal) NullSafeEqual(n2 NullDecimal) bool {
+	if !n.Valid || !n2.Valid {
+		return n.Valid == n2.Valid
+	}
+	return n.Decimal.Cmp(n2.Decimal) == 0
+}
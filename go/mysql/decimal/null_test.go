@@ -0,0 +1,106 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullDecimalArithmetic(t *testing.T) {
+	a := NewNullDecimal(RequireFromString("1.5"))
+	b := NewNullDecimal(RequireFromString("-0.25"))
+	zero := NewNullDecimal(Zero)
+	null := NullDecimal{}
+
+	testCases := []struct {
+		name     string
+		result   NullDecimal
+		expected string
+	}{
+		{name: "add", result: a.Add(b), expected: "1.25"},
+		{name: "sub", result: a.Sub(b), expected: "1.75"},
+		{name: "mul", result: a.Mul(b), expected: "-0.375"},
+		{name: "div", result: a.Div(b, 4), expected: "-6.000000000000000000"},
+		{name: "neg", result: b.Neg(), expected: "0.25"},
+		{name: "add null", result: a.Add(null)},
+		{name: "sub null", result: null.Sub(b)},
+		{name: "mul null", result: null.Mul(zero)},
+		{name: "div null", result: a.Div(null, 4)},
+		{name: "div by zero", result: a.Div(zero, 4)},
+		{name: "neg null", result: null.Neg()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expected == "" {
+				assert.True(t, tc.result.IsNull())
+				return
+			}
+			assert.False(t, tc.result.IsNull())
+			assert.Equal(t, tc.expected, tc.result.Decimal.StringMySQL())
+		})
+	}
+}
+
+func TestNullDecimalCompare(t *testing.T) {
+	one := NewNullDecimal(RequireFromString("1.0"))
+	two := NewNullDecimal(RequireFromString("2"))
+	null := NullDecimal{}
+
+	type op func(n, n2 NullDecimal) (bool, bool)
+	ops := map[string]op{
+		"=":  NullDecimal.Equal,
+		"!=": NullDecimal.NotEqual,
+		"<":  NullDecimal.LessThan,
+		"<=": NullDecimal.LessThanOrEqual,
+		">":  NullDecimal.GreaterThan,
+		">=": NullDecimal.GreaterThanOrEqual,
+	}
+
+	testCases := []struct {
+		left, right NullDecimal
+		expected    map[string]bool
+	}{
+		{left: one, right: two, expected: map[string]bool{"=": false, "!=": true, "<": true, "<=": true, ">": false, ">=": false}},
+		{left: two, right: one, expected: map[string]bool{"=": false, "!=": true, "<": false, "<=": false, ">": true, ">=": true}},
+		{left: one, right: NewNullDecimal(NewFromInt(1)), expected: map[string]bool{"=": true, "!=": false, "<": false, "<=": true, ">": false, ">=": true}},
+		{left: one, right: null},
+		{left: null, right: two},
+		{left: null, right: null},
+	}
+
+	for _, tc := range testCases {
+		for name, op := range ops {
+			result, isNull := op(tc.left, tc.right)
+			if tc.expected == nil {
+				assert.True(t, isNull, "%v %s %v", tc.left, name, tc.right)
+				assert.False(t, result, "%v %s %v", tc.left, name, tc.right)
+				continue
+			}
+			assert.False(t, isNull, "%v %s %v", tc.left, name, tc.right)
+			assert.Equal(t, tc.expected[name], result, "%v %s %v", tc.left, name, tc.right)
+		}
+	}
+
+	assert.True(t, one.NullSafeEqual(NewNullDecimal(NewFromInt(1))))
+	assert.False(t, one.NullSafeEqual(two))
+	assert.False(t, one.NullSafeEqual(null))
+	assert.False(t, null.NullSafeEqual(one))
+	assert.True(t, null.NullSafeEqual(null))
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decimal
+
+// Prepared is a Decimal along with a form of it that only serves to compare it
+// with other decimals. It's meant for the decimals that are compared many
+// times, e.g. a constant bound in a filter that is compared with the value of
+// every row: the comparisons of a Prepared with decimals whose unscaled value
+// fits in 64 bits only take a few machine-word operations, whatever their
+// exponents.
+type Prepared struct {
+	dec  Decimal
+	sign int
+	// small is whether the absolute value of the decimal is mag * 10^exp,
+	// where mag has no trailing zeros. Otherwise, the comparisons fall back to
+	// those of Decimal.
+	small bool
+	mag   uint64
+	exp   int32
+}
+
+// Prepare returns d prepared for repeated comparisons.
+func (d Decimal) Prepare() *Prepared {
+	p := &Prepared{dec: d, sign: d.Sign()}
+	if p.sign == 0 {
+		return p
+	}
+	p.mag, p.small = smallAbs(d.value)
+	p.exp = d.exp
+	for p.small && p.mag%10 == 0 {
+		p.mag /= 10
+		p.exp++
+	}
+	return p
+}
+
+// Decimal returns the prepared decimal.
+func (p *Prepared) Decimal() Decimal {
+	return p.dec
+}
+
+// Cmp compares the numbers represented by p and d2 and returns:
+//
+//	-1 if p <  d2
+//	 0 if p == d2
+//	+1 if p >  d2
+func (p *Prepared) Cmp(d2 Decimal) int {
+	s2 := d2.Sign()
+	switch {
+	case p.sign < s2:
+		return -1
+	case p.sign > s2:
+		return 1
+	case s2 == 0:
+		return 0
+	}
+	c := p.cmpAbsNonZero(d2)
+	if p.sign < 0 {
+		return -c
+	}
+	return c
+}
+
+// Equal returns whether the numbers represented by p and d2 are equal.
+func (p *Prepared) Equal(d2 Decimal) bool {
+	return p.Cmp(d2) == 0
+}
+
+func (p *Prepared) cmpAbsNonZero(d2 Decimal) int {
+	if !p.small {
+		return cmpAbsNonZero(p.dec, d2)
+	}
+	u2, ok := smallAbs(d2.value)
+	if !ok {
+		return cmpAbsNonZero(p.dec, d2)
+	}
+	if p.exp >= d2.exp {
+		return cmpScaledUint64(p.mag, uint64(int64(p.exp)-int64(d2.exp)), u2)
+	}
+	return -cmpScaledUint64(u2, uint64(int64(d2.exp)-int64(p.exp)), p.mag)
+}
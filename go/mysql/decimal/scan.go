@@ -86,6 +86,10 @@ func SizeAndScaleFromString(s string) (int32, int32) {
 	return int32(totalLen - 1), int32(totalLen - 1 - idx)
 }
 
+// NewFromMySQL parses a decimal in the format that MySQL uses for DECIMAL values
+// in the text protocol, e.g. "-12.340", with an optional sign and without an
+// exponent. The scale of the returned decimal is the number of digits after the
+// period. It fails if the value has more digits than MySQL supports.
 func NewFromMySQL(s []byte) (Decimal, error) {
 	var original = s
 	var neg bool
@@ -153,6 +157,134 @@ func NewFromMySQL(s []byte) (Decimal, error) {
 	return Decimal{value: value, exp: -int32(len(fractional))}, nil
 }
 
+// ParseForColumn parses a decimal in MySQL format and converts it to the value that
+// would be stored in a DECIMAL(precision, scale) column: it is rounded to the scale
+// of the column, and clamped to the largest value that fits in the column if its
+// integral part is too large. The returned truncated flag is set if the rounding
+// changed the value, and overflow is set if the value had to be clamped.
+//
+// The value is rounded and bounded while it is scanned, so that the digits that
+// don't fit in the column are never parsed.
+func ParseForColumn(data []byte, precision, scale int32) (dec Decimal, truncated, overflow bool, err error) {
+	s := data
+	var neg bool
+	if len(s) > 0 {
+		switch s[0] {
+		case '+':
+			s = s[1:]
+		case '-':
+			neg = true
+			s = s[1:]
+		}
+	}
+	if len(s) == 0 {
+		return Decimal{}, false, false, fmt.Errorf("can't convert %q to decimal: too short", data)
+	}
+
+	var (
+		acc         digitAccumulator
+		dot         bool
+		integral    int32 // the significant digits of the integral part
+		fractional  int32 // the digits of the fractional part
+		roundUp     bool
+		allNines    = true
+		integralMax = precision - scale
+	)
+	for _, c := range s {
+		switch {
+		case c == '.':
+			if dot {
+				return Decimal{}, false, false, fmt.Errorf("can't convert %s to decimal: too many .s", data)
+			}
+			dot = true
+		case '0' <= c && c <= '9':
+			d := c - '0'
+			switch {
+			case !dot:
+				if integral == 0 && d == 0 {
+					continue
+				}
+				integral++
+				if integral > integralMax {
+					overflow = true
+				} else {
+					acc.push(d)
+					allNines = allNines && d == 9
+				}
+			case fractional < scale:
+				fractional++
+				acc.push(d)
+				allNines = allNines && d == 9
+			default:
+				// the first digit that doesn't fit in the scale rounds the value
+				if fractional == scale {
+					roundUp = d >= 5
+				}
+				fractional++
+				truncated = truncated || d != 0
+			}
+		default:
+			return Decimal{}, false, false, fmt.Errorf("can't convert %s to decimal: unexpected character %q", data, c)
+		}
+	}
+
+	// the value only overflows when it's rounded up if all its digits are nines
+	if overflow || roundUp && allNines && acc.digits() == precision {
+		return largestForm(integralMax, scale, neg), truncated, true, nil
+	}
+	for ; fractional < scale; fractional++ {
+		acc.push(0)
+	}
+	value := acc.value()
+	if roundUp {
+		value.Add(value, oneInt)
+	}
+	if neg {
+		value.Neg(value)
+	}
+	return Decimal{value: value, exp: -scale}, truncated, false, nil
+}
+
+// digitAccumulator accumulates the digits of a decimal, in a uint64 until it
+// has more digits than a uint64 can hold.
+type digitAccumulator struct {
+	large      *big.Int
+	small      uint64
+	smallCount int32
+	largeCount int32
+}
+
+func (a *digitAccumulator) push(d byte) {
+	if a.smallCount == 19 {
+		a.flush()
+	}
+	a.small = a.small*10 + uint64(d)
+	a.smallCount++
+}
+
+func (a *digitAccumulator) flush() {
+	if a.large == nil {
+		a.large = new(big.Int)
+	}
+	a.large.Mul(a.large, bigPow10(uint64(a.smallCount)))
+	a.large.Add(a.large, new(big.Int).SetUint64(a.small))
+	a.largeCount += a.smallCount
+	a.small, a.smallCount = 0, 0
+}
+
+func (a *digitAccumulator) digits() int32 {
+	return a.largeCount + a.smallCount
+}
+
+// value returns the accumulated digits as an integer.
+func (a *digitAccumulator) value() *big.Int {
+	if a.large == nil {
+		return new(big.Int).SetUint64(a.small)
+	}
+	a.flush()
+	return a.large
+}
+
 const ExponentLimit = 1024
 
 // NewFromString returns a new Decimal from a string representation.
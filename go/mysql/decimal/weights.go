@@ -36,6 +36,8 @@ var weightStringLengths = []int{
 	23, 23, 23, 24, 24, 25, 25, 25, 26, 26, 27, 27, 27,
 }
 
+// WeightString appends to dst the weight string of the decimal as a value of a
+// DECIMAL(length, precision) column, i.e. bytes that compare as the values do.
 func (d Decimal) WeightString(dst []byte, length, precision int32) []byte {
 	dec := d.rescale(-precision)
 	dec = dec.Clamp(length-precision, precision)
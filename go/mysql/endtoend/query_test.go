@@ -89,7 +89,7 @@ func TestQueries(t *testing.T) {
 				Database:     "vttest",
 				OrgName:      "id",
 				ColumnLength: 11,
-				Charset:      collations.CollationBinaryID,
+				Charset:      uint32(collations.CollationBinaryID),
 				Flags: uint32(querypb.MySqlFlag_NOT_NULL_FLAG |
 					querypb.MySqlFlag_PRI_KEY_FLAG |
 					querypb.MySqlFlag_PART_KEY_FLAG |
@@ -190,7 +190,7 @@ func readRowsUsingStream(t *testing.T, conn *mysql.Conn, expectedCount int) {
 			Database:     "vttest",
 			OrgName:      "id",
 			ColumnLength: 11,
-			Charset:      collations.CollationBinaryID,
+			Charset:      uint32(collations.CollationBinaryID),
 			Flags: uint32(querypb.MySqlFlag_NOT_NULL_FLAG |
 				querypb.MySqlFlag_PRI_KEY_FLAG |
 				querypb.MySqlFlag_PART_KEY_FLAG |
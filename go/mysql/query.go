@@ -1118,7 +1118,7 @@ func (c *Conn) writePrepare(fld []*querypb.Field, prepare *PrepareData) error {
 			if err := c.writeColumnDefinition(&querypb.Field{
 				Name:    "?",
 				Type:    sqltypes.VarBinary,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 				Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 			}); err != nil {
 				return err
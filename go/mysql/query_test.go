@@ -54,7 +54,7 @@ func MockPrepareData(t *testing.T) (*PrepareData, *sqltypes.Result) {
 			{
 				Name:    "id",
 				Type:    querypb.Type_INT32,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 				Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 			},
 		},
@@ -404,7 +404,7 @@ func TestQueries(t *testing.T) {
 			{
 				Name:    "id",
 				Type:    querypb.Type_INT32,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 				Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 			},
 			{
@@ -430,37 +430,37 @@ func TestQueries(t *testing.T) {
 	// One row has all NULL values.
 	checkQuery(t, "all types", sConn, cConn, &sqltypes.Result{
 		Fields: []*querypb.Field{
-			{Name: "Type_INT8     ", Type: querypb.Type_INT8, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
-			{Name: "Type_UINT8    ", Type: querypb.Type_UINT8, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
-			{Name: "Type_INT16    ", Type: querypb.Type_INT16, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
-			{Name: "Type_UINT16   ", Type: querypb.Type_UINT16, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
-			{Name: "Type_INT24    ", Type: querypb.Type_INT24, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
-			{Name: "Type_UINT24   ", Type: querypb.Type_UINT24, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
-			{Name: "Type_INT32    ", Type: querypb.Type_INT32, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
-			{Name: "Type_UINT32   ", Type: querypb.Type_UINT32, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
-			{Name: "Type_INT64    ", Type: querypb.Type_INT64, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
-			{Name: "Type_UINT64   ", Type: querypb.Type_UINT64, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
-			{Name: "Type_FLOAT32  ", Type: querypb.Type_FLOAT32, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
-			{Name: "Type_FLOAT64  ", Type: querypb.Type_FLOAT64, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
-			{Name: "Type_TIMESTAMP", Type: querypb.Type_TIMESTAMP, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_BINARY_FLAG | querypb.MySqlFlag_TIMESTAMP_FLAG)},
-			{Name: "Type_DATE     ", Type: querypb.Type_DATE, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
-			{Name: "Type_TIME     ", Type: querypb.Type_TIME, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
-			{Name: "Type_DATETIME ", Type: querypb.Type_DATETIME, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
-			{Name: "Type_YEAR     ", Type: querypb.Type_YEAR, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_UNSIGNED_FLAG | querypb.MySqlFlag_NUM_FLAG)},
-			{Name: "Type_DECIMAL  ", Type: querypb.Type_DECIMAL, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
+			{Name: "Type_INT8     ", Type: querypb.Type_INT8, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
+			{Name: "Type_UINT8    ", Type: querypb.Type_UINT8, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
+			{Name: "Type_INT16    ", Type: querypb.Type_INT16, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
+			{Name: "Type_UINT16   ", Type: querypb.Type_UINT16, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
+			{Name: "Type_INT24    ", Type: querypb.Type_INT24, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
+			{Name: "Type_UINT24   ", Type: querypb.Type_UINT24, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
+			{Name: "Type_INT32    ", Type: querypb.Type_INT32, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
+			{Name: "Type_UINT32   ", Type: querypb.Type_UINT32, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
+			{Name: "Type_INT64    ", Type: querypb.Type_INT64, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
+			{Name: "Type_UINT64   ", Type: querypb.Type_UINT64, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
+			{Name: "Type_FLOAT32  ", Type: querypb.Type_FLOAT32, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
+			{Name: "Type_FLOAT64  ", Type: querypb.Type_FLOAT64, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
+			{Name: "Type_TIMESTAMP", Type: querypb.Type_TIMESTAMP, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_BINARY_FLAG | querypb.MySqlFlag_TIMESTAMP_FLAG)},
+			{Name: "Type_DATE     ", Type: querypb.Type_DATE, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
+			{Name: "Type_TIME     ", Type: querypb.Type_TIME, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
+			{Name: "Type_DATETIME ", Type: querypb.Type_DATETIME, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
+			{Name: "Type_YEAR     ", Type: querypb.Type_YEAR, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_UNSIGNED_FLAG | querypb.MySqlFlag_NUM_FLAG)},
+			{Name: "Type_DECIMAL  ", Type: querypb.Type_DECIMAL, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
 			{Name: "Type_TEXT     ", Type: querypb.Type_TEXT, Charset: uint32(collations.MySQL8().DefaultConnectionCharset())},
-			{Name: "Type_BLOB     ", Type: querypb.Type_BLOB, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
+			{Name: "Type_BLOB     ", Type: querypb.Type_BLOB, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
 			{Name: "Type_VARCHAR  ", Type: querypb.Type_VARCHAR, Charset: uint32(collations.MySQL8().DefaultConnectionCharset())},
-			{Name: "Type_VARBINARY", Type: querypb.Type_VARBINARY, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
+			{Name: "Type_VARBINARY", Type: querypb.Type_VARBINARY, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
 			{Name: "Type_CHAR     ", Type: querypb.Type_CHAR, Charset: uint32(collations.MySQL8().DefaultConnectionCharset())},
-			{Name: "Type_BINARY   ", Type: querypb.Type_BINARY, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
-			{Name: "Type_BIT      ", Type: querypb.Type_BIT, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
+			{Name: "Type_BINARY   ", Type: querypb.Type_BINARY, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
+			{Name: "Type_BIT      ", Type: querypb.Type_BIT, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
 			{Name: "Type_ENUM     ", Type: querypb.Type_ENUM, Charset: uint32(collations.MySQL8().DefaultConnectionCharset()), Flags: uint32(querypb.MySqlFlag_ENUM_FLAG)},
 			{Name: "Type_SET      ", Type: querypb.Type_SET, Charset: uint32(collations.MySQL8().DefaultConnectionCharset()), Flags: uint32(querypb.MySqlFlag_SET_FLAG)},
 			// Skip TUPLE, not possible in Result.
-			{Name: "Type_GEOMETRY ", Type: querypb.Type_GEOMETRY, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_BINARY_FLAG | querypb.MySqlFlag_BLOB_FLAG)},
-			{Name: "Type_JSON     ", Type: querypb.Type_JSON, Charset: collations.CollationUtf8mb4ID},
-			{Name: "Type_VECTOR   ", Type: querypb.Type_VECTOR, Charset: collations.CollationBinaryID},
+			{Name: "Type_GEOMETRY ", Type: querypb.Type_GEOMETRY, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_BINARY_FLAG | querypb.MySqlFlag_BLOB_FLAG)},
+			{Name: "Type_JSON     ", Type: querypb.Type_JSON, Charset: uint32(collations.CollationUtf8mb4ID)},
+			{Name: "Type_VECTOR   ", Type: querypb.Type_VECTOR, Charset: uint32(collations.CollationBinaryID)},
 		},
 		Rows: [][]sqltypes.Value{
 			{
@@ -555,7 +555,7 @@ func TestQueries(t *testing.T) {
 		Fields: []*querypb.Field{
 			{
 				Type:    querypb.Type_INT64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 				Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 			},
 		},
@@ -66,7 +66,7 @@ var BaseShowTablesFields = []*querypb.Field{{
 	Name:         "unix_timestamp(t.create_time)",
 	Type:         querypb.Type_INT64,
 	ColumnLength: 11,
-	Charset:      collations.CollationBinaryID,
+	Charset:      uint32(collations.CollationBinaryID),
 	Flags:        uint32(querypb.MySqlFlag_BINARY_FLAG | querypb.MySqlFlag_NUM_FLAG),
 }, {
 	Name:         "t.table_comment",
@@ -84,13 +84,13 @@ var BaseShowTablesWithSizesFields = append(BaseShowTablesFields, &querypb.Field{
 	Name:         "i.file_size",
 	Type:         querypb.Type_INT64,
 	ColumnLength: 11,
-	Charset:      collations.CollationBinaryID,
+	Charset:      uint32(collations.CollationBinaryID),
 	Flags:        uint32(querypb.MySqlFlag_BINARY_FLAG | querypb.MySqlFlag_NUM_FLAG),
 }, &querypb.Field{
 	Name:         "i.allocated_size",
 	Type:         querypb.Type_INT64,
 	ColumnLength: 11,
-	Charset:      collations.CollationBinaryID,
+	Charset:      uint32(collations.CollationBinaryID),
 	Flags:        uint32(querypb.MySqlFlag_BINARY_FLAG | querypb.MySqlFlag_NUM_FLAG),
 })
 
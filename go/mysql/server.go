@@ -217,8 +217,8 @@ type Listener struct {
 	// flushDelay is the delay after which buffered response will be flushed to the client.
 	flushDelay time.Duration
 
-	// charset is the default server side character set to use for the connection
-	charset collations.ID
+	// collationEnv is used to negotiate the collation of the connections.
+	collationEnv *collations.Environment
 	// parser to use for this listener, configured with the correct version.
 	truncateErrLen int
 }
@@ -315,7 +315,7 @@ func NewListenerWithConfig(cfg ListenerConfig) (*Listener, error) {
 		connKeepAlivePeriod: cfg.ConnKeepAlivePeriod,
 		flushDelay:          cfg.FlushDelay,
 		truncateErrLen:      cfg.Handler.Env().TruncateErrLen(),
-		charset:             cfg.Handler.Env().CollationEnv().DefaultConnectionCharset(),
+		collationEnv:        cfg.Handler.Env().CollationEnv(),
 	}, nil
 }
 
@@ -391,7 +391,7 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	defer connCount.Add(-1)
 
 	// First build and send the server handshake packet.
-	serverAuthPluginData, err := c.writeHandshakeV10(l.ServerVersion, l.authServer, uint8(l.charset), l.TLSConfig.Load() != nil)
+	serverAuthPluginData, err := c.writeHandshakeV10(l.ServerVersion, l.authServer, l.collationEnv.HandshakeCharset(), l.TLSConfig.Load() != nil)
 	if err != nil {
 		if err != io.EOF {
 			log.Errorf("Cannot send HandshakeV10 packet to %s: %v", c, err)
@@ -412,6 +412,11 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	user, clientAuthMethod, clientAuthResponse, err := l.parseClientHandshakePacket(c, true, response)
 	if err != nil {
 		log.Errorf("Cannot parse client handshake response from %s: %v", c, err)
+		if sqlErr, ok := err.(*sqlerror.SQLError); ok {
+			// e.g. the client requested a character set it can't use
+			c.recycleReadPacket()
+			c.writeErrorPacketFromError(sqlErr)
+		}
 		return
 	}
 
@@ -429,6 +434,11 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		user, clientAuthMethod, clientAuthResponse, err = l.parseClientHandshakePacket(c, false, response)
 		if err != nil {
 			log.Errorf("Cannot parse post-SSL client handshake response from %s: %v", c, err)
+			if sqlErr, ok := err.(*sqlerror.SQLError); ok {
+				// e.g. the client requested a character set it can't use
+				c.recycleReadPacket()
+				c.writeErrorPacketFromError(sqlErr)
+			}
 			return
 		}
 		c.recycleReadPacket()
@@ -726,7 +736,11 @@ func (l *Listener) parseClientHandshakePacket(c *Conn, firstTime bool, data []by
 	if !ok {
 		return "", "", nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "parseClientHandshakePacket: can't read characterSet")
 	}
-	c.CharacterSet = collations.ID(characterSet)
+	collation, err := l.collationEnv.HandshakeCollation(characterSet)
+	if err != nil {
+		return "", "", nil, sqlerror.NewSQLError(sqlerror.ERWrongValueForVar, sqlerror.SSClientError, "%v", err)
+	}
+	c.CharacterSet = collation
 
 	// 23x reserved zero bytes.
 	pos += 23
@@ -52,7 +52,7 @@ var selectRowsResult = &sqltypes.Result{
 		{
 			Name:    "id",
 			Type:    querypb.Type_INT32,
-			Charset: collations.CollationBinaryID,
+			Charset: uint32(collations.CollationBinaryID),
 			Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 		},
 		{
@@ -471,6 +471,56 @@ func TestClientFoundRows(t *testing.T) {
 	c.Close()
 }
 
+func TestServerHandshakeCollation(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic("", "", 0)
+	authServer.entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+		UserData: "userData1",
+	}}
+	defer authServer.close()
+	l, err := NewListener("tcp", "127.0.0.1:", authServer, th, 0, 0, false, false, 0, 0)
+	require.NoError(t, err, "NewListener failed")
+	defer l.Close()
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "password1",
+	}
+
+	testCases := []struct {
+		charset   collations.ID
+		collation collations.ID
+	}{
+		{charset: collations.CollationLatin1Swedish, collation: collations.CollationLatin1Swedish},
+		{charset: collations.CollationUtf8mb4BinID, collation: collations.CollationUtf8mb4BinID},
+		// unknown collations fall back to the default one
+		{charset: 0, collation: collations.CollationUtf8mb4ID},
+		{charset: 100, collation: collations.CollationUtf8mb4ID},
+	}
+	for _, tc := range testCases {
+		params.Charset = tc.charset
+		c, err := Connect(context.Background(), params)
+		require.NoError(t, err, "Connect failed with charset %d", tc.charset)
+		// the client reads the character set advertised by the server
+		assert.Equal(t, collations.ID(collations.CollationUtf8mb4ID), c.CharacterSet)
+		assert.Equal(t, tc.collation, th.LastConn().CharacterSet, "charset %d", tc.charset)
+		c.Close()
+	}
+
+	// utf16_general_ci can't be used by a client
+	params.Charset = 54
+	_, err = Connect(context.Background(), params)
+	require.Error(t, err)
+	assert.Equal(t, sqlerror.ERWrongValueForVar, sqlerror.NewSQLErrorFromError(err).(*sqlerror.SQLError).Number())
+	assert.ErrorContains(t, err, "Variable 'character_set_client' can't be set to the value of 'utf16'")
+}
+
 func TestConnCounts(t *testing.T) {
 	th := &testHandler{}
 
@@ -222,6 +222,9 @@ const (
 	ERDerivedMustHaveAlias         = ErrorCode(1248)
 	ERTableNameNotAllowedHere      = ErrorCode(1250)
 	ERCollationCharsetMismatch     = ErrorCode(1253)
+	ERTooBigForUncompress          = ErrorCode(1256)
+	ERZlibZBufError                = ErrorCode(1258)
+	ERZlibZDataError               = ErrorCode(1259)
 	ERWarnDataTruncated            = ErrorCode(1265)
 	ERCantAggregate2Collations     = ErrorCode(1267)
 	ERCantAggregate3Collations     = ErrorCode(1270)
@@ -238,6 +241,7 @@ const (
 	ERInvalidCharacterString       = ErrorCode(1300)
 	ERQueryInterrupted             = ErrorCode(1317)
 	ERViewWrongList                = ErrorCode(1353)
+	ERDivisionByZero               = ErrorCode(1365)
 	ERTruncatedWrongValueForField  = ErrorCode(1366)
 	ERIllegalValueForType          = ErrorCode(1367)
 	ERDataTooLong                  = ErrorCode(1406)
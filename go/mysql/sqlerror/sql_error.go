@@ -246,6 +246,14 @@ var stateToMysqlCode = map[vterrors.State]mysqlCode{
 	vterrors.BadNullError:                 {num: ERBadNullError, state: SSConstraintViolation},
 	vterrors.InvalidGroupFuncUse:          {num: ERInvalidGroupFuncUse, state: SSUnknownSQLState},
 	vterrors.VectorConversion:             {num: ERVectorConversion, state: SSUnknownSQLState},
+	vterrors.TooLongIdent:                 {num: ERTooLongIdent, state: SSClientError},
+	vterrors.WrongDbName:                  {num: ERWrongDbName, state: SSClientError},
+	vterrors.WrongTableName:               {num: ERWrongTableName, state: SSClientError},
+	vterrors.WrongColumnName:              {num: ERWrongColumnName, state: SSClientError},
+	vterrors.InvalidCharacterString:       {num: ERInvalidCharacterString, state: SSUnknownSQLState},
+	vterrors.ParseError:                   {num: ERParseError, state: SSClientError},
+	vterrors.UnknownCharacterSet:          {num: ERUnknownCharacterSet, state: SSClientError},
+	vterrors.UnknownCollation:             {num: ERUnknownCollation, state: SSUnknownSQLState},
 }
 
 func getStateToMySQLState(state vterrors.State) mysqlCode {
@@ -154,6 +154,21 @@ func TestNewSQLErrorFromError(t *testing.T) {
 			num: ERNoDb,
 			ss:  SSNoDB,
 		},
+		{
+			err: vterrors.NewErrorf(vtrpc.Code_INVALID_ARGUMENT, vterrors.ParseError, "syntax error at position 7 near 'form'"),
+			num: ERParseError,
+			ss:  SSClientError,
+		},
+		{
+			err: vterrors.NewErrorf(vtrpc.Code_INVALID_ARGUMENT, vterrors.UnknownCharacterSet, "Unknown character set: 'klingon'"),
+			num: ERUnknownCharacterSet,
+			ss:  SSClientError,
+		},
+		{
+			err: vterrors.NewErrorf(vtrpc.Code_INVALID_ARGUMENT, vterrors.UnknownCollation, "Unknown collation: 'klingon_ci'"),
+			num: ERUnknownCollation,
+			ss:  SSUnknownSQLState,
+		},
 		{
 			err: fmt.Errorf("just some random text here"),
 			num: ERUnknownError,
@@ -0,0 +1,368 @@
+/*
+Copyright (C) 1995-2022 Jean-loup Gailly and Mark Adler
+Copyright 2024 The Vitess Authors.
+
+This file contains code derived from the zlib library, version 1.2.13.
+License & terms of use for the original code: https://zlib.net/zlib_license.html
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package zlib compresses data exactly like the compress() function of the C
+// zlib library, which MySQL uses for its COMPRESS() function.
+//
+// The deflate format allows many encodings of the same data, and the encoder
+// of the Go standard library does not choose the same ones as the C library,
+// so its output is valid but different from MySQL's. This package is a port of
+// the parts of zlib's deflate that are used with the default compression
+// level, and its output is byte-for-byte the one of zlib. The compressed data
+// can be uncompressed with the compress/zlib package of the standard library.
+package zlib
+
+import (
+	"encoding/binary"
+	"hash/adler32"
+)
+
+const (
+	minMatch = 3
+	maxMatch = 258
+
+	// wBits is the base two logarithm of the size of the window, i.e. the
+	// windowBits of zlib's default.
+	wBits      = 15
+	wSize      = 1 << wBits
+	wMask      = wSize - 1
+	windowSize = 2 * wSize
+
+	// hashBits is memLevel+7 for zlib's default memLevel of 8.
+	hashBits  = 15
+	hashSize  = 1 << hashBits
+	hashMask  = hashSize - 1
+	hashShift = (hashBits + minMatch - 1) / minMatch
+
+	// minLookahead is the minimum amount of lookahead, except at the end of
+	// the input, that ensures that there's enough lookahead for the next match.
+	minLookahead = maxMatch + minMatch + 1
+	// maxDist is the maximum distance of a match, which is a bit smaller than
+	// the size of the window so that the lookahead always fits in it.
+	maxDist = wSize - minLookahead
+
+	// tooFar is the distance above which matches of length 3 are discarded.
+	tooFar = 4096
+
+	// litBufSize is the number of symbols that can be buffered before a
+	// block is flushed, for zlib's default memLevel of 8.
+	litBufSize = 1 << (8 + 6)
+	symEnd     = (litBufSize - 1) * 3
+)
+
+// The parameters of zlib's default compression level, 6.
+const (
+	goodLength = 8
+	maxLazy    = 16
+	niceLength = 128
+	maxChain   = 128
+)
+
+// The zlib header of a stream compressed with a 32K window and the default
+// compression level.
+var header = []byte{0x78, 0x9c}
+
+// Compress appends to dst the zlib stream that compress() of the C zlib
+// library returns for src.
+func Compress(dst, src []byte) []byte {
+	d := &deflater{in: src}
+	d.out = append(dst, header...)
+	d.trInit()
+	d.deflateSlow()
+	return binary.BigEndian.AppendUint32(d.out, adler32.Checksum(src))
+}
+
+// deflater is the state of zlib's deflate_state that is used to compress a
+// stream in a single call with Z_FINISH.
+type deflater struct {
+	in  []byte
+	out []byte
+
+	// window holds the last 32K of input, that matches can refer to, and the
+	// lookahead. It is slid down by 32K when the lookahead reaches its end.
+	window [windowSize]byte
+	// prev links the strings with the same hash, by their position in the
+	// window, and head holds the most recent string of each hash.
+	prev [wSize]uint16
+	head [hashSize]uint16
+	insH uint32
+
+	blockStart int64
+	strstart   uint32
+	lookahead  uint32
+	insert     uint32
+	matchStart uint32
+	prevMatch  uint32
+
+	matchLength    uint32
+	prevLength     uint32
+	matchAvailable bool
+
+	trees
+}
+
+func (d *deflater) updateHash(c byte) {
+	d.insH = ((d.insH << hashShift) ^ uint32(c)) & hashMask
+}
+
+// insertString inserts the string at str in the hash table, and returns the
+// previous head of its hash chain.
+func (d *deflater) insertString(str uint32) uint32 {
+	d.updateHash(d.window[str+minMatch-1])
+	h := d.head[d.insH]
+	d.prev[str&wMask] = h
+	d.head[d.insH] = uint16(str)
+	return uint32(h)
+}
+
+// slideHash updates the hash table after the window was slid down by wSize.
+func (d *deflater) slideHash() {
+	for i, m := range d.head {
+		if m >= wSize {
+			d.head[i] = m - wSize
+		} else {
+			d.head[i] = 0
+		}
+	}
+	for i, m := range d.prev {
+		if m >= wSize {
+			d.prev[i] = m - wSize
+		} else {
+			d.prev[i] = 0
+		}
+	}
+}
+
+// fillWindow reads new input when the lookahead becomes insufficient, after
+// sliding the window down if it is full.
+func (d *deflater) fillWindow() {
+	for {
+		more := windowSize - d.lookahead - d.strstart
+
+		if d.strstart >= wSize+maxDist {
+			copy(d.window[:wSize-more], d.window[wSize:windowSize-more])
+			d.matchStart -= wSize
+			d.strstart -= wSize
+			d.blockStart -= wSize
+			if d.insert > d.strstart {
+				d.insert = d.strstart
+			}
+			d.slideHash()
+			more += wSize
+		}
+		if len(d.in) == 0 {
+			break
+		}
+
+		n := copy(d.window[d.strstart+d.lookahead:][:more], d.in)
+		d.in = d.in[n:]
+		d.lookahead += uint32(n)
+
+		// Initialize the hash value now that we have some input.
+		if d.lookahead+d.insert >= minMatch {
+			str := d.strstart - d.insert
+			d.insH = uint32(d.window[str])
+			d.updateHash(d.window[str+1])
+			for d.insert != 0 {
+				d.updateHash(d.window[str+minMatch-1])
+				d.prev[str&wMask] = d.head[d.insH]
+				d.head[d.insH] = uint16(str)
+				str++
+				d.insert--
+				if d.lookahead+d.insert < minMatch {
+					break
+				}
+			}
+		}
+
+		if d.lookahead >= minLookahead || len(d.in) == 0 {
+			break
+		}
+	}
+}
+
+// longestMatch returns the length of the longest match of the string at
+// strstart in the hash chain starting at curMatch, and sets matchStart to its
+// position. Matches that are not longer than prevLength are ignored.
+//
+// The bytes at offset 2 are not compared, since they are equal when the two
+// first bytes and the hashes are; like in zlib, this must be kept for the
+// output to be the same.
+func (d *deflater) longestMatch(curMatch uint32) uint32 {
+	w := d.window[:]
+	chainLength := uint32(maxChain)
+	scan := d.strstart
+	bestLen := d.prevLength
+	niceMatch := uint32(niceLength)
+
+	var limit uint32
+	if d.strstart > maxDist {
+		limit = d.strstart - maxDist
+	}
+
+	scanEnd1 := w[scan+bestLen-1]
+	scanEnd := w[scan+bestLen]
+
+	// Do not waste too much time if we already have a good match.
+	if d.prevLength >= goodLength {
+		chainLength >>= 2
+	}
+	// Do not look for matches beyond the end of the input.
+	if niceMatch > d.lookahead {
+		niceMatch = d.lookahead
+	}
+
+	for {
+		match := curMatch
+		if w[match+bestLen] == scanEnd && w[match+bestLen-1] == scanEnd1 &&
+			w[match] == w[scan] && w[match+1] == w[scan+1] {
+			length := uint32(minMatch)
+			for length < maxMatch && w[scan+length] == w[match+length] {
+				length++
+			}
+
+			if length > bestLen {
+				d.matchStart = curMatch
+				bestLen = length
+				if length >= niceMatch {
+					break
+				}
+				scanEnd1 = w[scan+bestLen-1]
+				scanEnd = w[scan+bestLen]
+			}
+		}
+
+		curMatch = uint32(d.prev[curMatch&wMask])
+		if curMatch <= limit {
+			break
+		}
+		chainLength--
+		if chainLength == 0 {
+			break
+		}
+	}
+
+	if bestLen <= d.lookahead {
+		return bestLen
+	}
+	return d.lookahead
+}
+
+// flushBlock flushes the symbols since blockStart as a block of the output.
+func (d *deflater) flushBlock(last bool) {
+	var buf []byte
+	if d.blockStart >= 0 {
+		buf = d.window[d.blockStart:]
+	}
+	d.trFlushBlock(buf, uint64(int64(d.strstart)-d.blockStart), last)
+	d.blockStart = int64(d.strstart)
+}
+
+// deflateSlow compresses all the input with lazy evaluation of matches: a match
+// is finally adopted only if there is no better match at the next window
+// position.
+func (d *deflater) deflateSlow() {
+	d.matchLength = minMatch - 1
+	d.prevLength = minMatch - 1
+
+	for {
+		// Make sure that we always have enough lookahead, except at the end
+		// of the input.
+		if d.lookahead < minLookahead {
+			d.fillWindow()
+			if d.lookahead == 0 {
+				break
+			}
+		}
+
+		// Insert the string window[strstart .. strstart+2] in the dictionary,
+		// and set hashHead to the head of the hash chain.
+		var hashHead uint32
+		if d.lookahead >= minMatch {
+			hashHead = d.insertString(d.strstart)
+		}
+
+		// Find the longest match, discarding those <= prevLength.
+		d.prevLength = d.matchLength
+		d.prevMatch = d.matchStart
+		d.matchLength = minMatch - 1
+
+		if hashHead != 0 && d.prevLength < maxLazy && d.strstart-hashHead <= maxDist {
+			d.matchLength = d.longestMatch(hashHead)
+			if d.matchLength == minMatch && d.strstart-d.matchStart > tooFar {
+				// A match of length 3 is not worth it if it is too distant.
+				d.matchLength = minMatch - 1
+			}
+		}
+
+		// If there was a match at the previous step and the current match is
+		// not better, output the previous match.
+		switch {
+		case d.prevLength >= minMatch && d.matchLength <= d.prevLength:
+			maxInsert := d.strstart + d.lookahead - minMatch
+
+			flush := d.tallyDist(d.strstart-1-d.prevMatch, d.prevLength-minMatch)
+
+			// Insert in the hash table all the strings up to the end of the
+			// match. strstart-1 and strstart are already inserted.
+			d.lookahead -= d.prevLength - 1
+			d.prevLength -= 2
+			for {
+				d.strstart++
+				if d.strstart <= maxInsert {
+					d.insertString(d.strstart)
+				}
+				d.prevLength--
+				if d.prevLength == 0 {
+					break
+				}
+			}
+			d.matchAvailable = false
+			d.matchLength = minMatch - 1
+			d.strstart++
+
+			if flush {
+				d.flushBlock(false)
+			}
+
+		case d.matchAvailable:
+			// If there was no match at the previous position, output a
+			// single literal.
+			if d.tallyLit(d.window[d.strstart-1]) {
+				d.flushBlock(false)
+			}
+			d.strstart++
+			d.lookahead--
+
+		default:
+			// There is no previous match to compare with, wait for the next
+			// step to decide.
+			d.matchAvailable = true
+			d.strstart++
+			d.lookahead--
+		}
+	}
+
+	if d.matchAvailable {
+		d.tallyLit(d.window[d.strstart-1])
+		d.matchAvailable = false
+	}
+	d.flushBlock(true)
+}
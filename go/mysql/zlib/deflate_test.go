@@ -0,0 +1,111 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package zlib
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lcg returns a generator of deterministic pseudo-random numbers.
+func lcg() func() uint32 {
+	x := uint32(1)
+	return func() uint32 {
+		x = (x*1103515245 + 12345) & 0x7fffffff
+		return x >> 16
+	}
+}
+
+func genText(n int) []byte {
+	words := []string{"the ", "quick ", "brown ", "fox ", "jumps ", "over ", "lazy ", "dog ", "\n"}
+	next := lcg()
+	var b []byte
+	for len(b) < n {
+		b = append(b, words[next()%uint32(len(words))]...)
+	}
+	return b[:n]
+}
+
+func genBytes(n int, k uint32) []byte {
+	next := lcg()
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(next() % k)
+	}
+	return b
+}
+
+func TestCompress(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "", expected: "789c030000000001"},
+		{input: "a", expected: "789c4b040000620062"},
+		{input: "hello world", expected: "789ccb48cdc9c95728cf2fca4901001a0b045d"},
+		{input: strings.Repeat("a", 1000), expected: "789c4b4c1c05a360140c770000f9d87af8"},
+	}
+
+	for _, tc := range testCases {
+		out := Compress(nil, []byte(tc.input))
+		assert.Equal(t, tc.expected, hex.EncodeToString(out), "Compress(%q)", tc.input)
+	}
+}
+
+// TestCompressLarge checks the output of the inputs that span several blocks
+// and slides of the window against the one of the C zlib library.
+func TestCompressLarge(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  []byte
+		length int
+		sha256 string
+	}{
+		{name: "text 100", input: genText(100), length: 63, sha256: "3db9948f4e467f3183cf7d45c022dae910e5ed5e2ed2a475fbdcb047670cb3b5"},
+		{name: "text 100000", input: genText(100000), length: 15104, sha256: "885bd6c3b05f47739045b324b95671e7fa94275c2fbb5fe11e8bfbb9b0e01c83"},
+		{name: "text 1000000", input: genText(1000000), length: 149155, sha256: "8349e294d90a950decef54e875c23287b6fc12254702de78322e0fa46534c1b8"},
+		{name: "bytes 5000 of 4", input: genBytes(5000, 4), length: 1634, sha256: "427396ab3acb2b9302c5d72eed14a2a0dc501e22e8b482b9ca845c1f66bc4bf2"},
+		{name: "bytes 300000 of 256", input: genBytes(300000, 256), length: 300101, sha256: "a3005626f3ba6bde54dd19eb07d0f039269bbc0bcd119c77218d8989edf91690"},
+		{name: "bytes 200000 of 2", input: genBytes(200000, 2), length: 31902, sha256: "8df3213943593a054a6a8aae2f120018669b931c7ca3d2a241f06dbd353c2caa"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := Compress([]byte("prefix"), tc.input)
+			require.True(t, bytes.HasPrefix(out, []byte("prefix")))
+			out = out[len("prefix"):]
+
+			sum := sha256.Sum256(out)
+			assert.Equal(t, tc.length, len(out))
+			assert.Equal(t, tc.sha256, hex.EncodeToString(sum[:]))
+
+			r, err := zlib.NewReader(bytes.NewReader(out))
+			require.NoError(t, err)
+			uncompressed, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, tc.input, uncompressed)
+		})
+	}
+}
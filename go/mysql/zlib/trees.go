@@ -0,0 +1,681 @@
+/*
+Copyright (C) 1995-2022 Jean-loup Gailly and Mark Adler
+Copyright 2024 The Vitess Authors.
+
+This file contains code derived from the zlib library, version 1.2.13.
+License & terms of use for the original code: https://zlib.net/zlib_license.html
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package zlib
+
+import (
+	"math/bits"
+)
+
+const (
+	maxBits   = 15
+	maxBLBits = 7
+
+	lengthCodes = 29
+	literals    = 256
+	lCodes      = literals + 1 + lengthCodes
+	dCodes      = 30
+	blCodes     = 19
+	heapSize    = 2*lCodes + 1
+	endBlock    = 256
+
+	// The codes of the bit length tree that repeat lengths.
+	rep3To6     = 16
+	repZ3To10   = 17
+	repZ11To138 = 18
+
+	// The block types.
+	storedBlock = 0
+	staticTrees = 1
+	dynTrees    = 2
+
+	// smallest is the index of the smallest element of the heap.
+	smallest = 1
+)
+
+var extraLBits = [lengthCodes]int{0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 4, 5, 5, 5, 5, 0}
+
+var extraDBits = [dCodes]int{0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13}
+
+var extraBLBits = [blCodes]int{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 3, 7}
+
+// blOrder is the order in which the lengths of the bit length codes are sent.
+var blOrder = [blCodes]int{16, 17, 18, 0, 8, 7, 9, 6, 10, 5, 11, 4, 12, 3, 13, 2, 14, 1, 15}
+
+// ctData is a node of a Huffman tree. Like in zlib, the fields are reused:
+// fc is the frequency of the node while the tree is built, and then its code;
+// dl is the father of the node while the tree is built, and then its length.
+type ctData struct {
+	fc uint16
+	dl uint16
+}
+
+var (
+	staticLTree [lCodes + 2]ctData
+	staticDTree [dCodes]ctData
+	// distCode maps the distances 0..255 to their code, and the distances
+	// 256..32K to their code with their 7 lowest bits dropped.
+	distCode [512]uint8
+	// lengthCode maps the match lengths 3..258 to their code.
+	lengthCode [maxMatch - minMatch + 1]uint8
+	baseLength [lengthCodes]int
+	baseDist   [dCodes]int
+)
+
+func init() {
+	length := 0
+	code := 0
+	for ; code < lengthCodes-1; code++ {
+		baseLength[code] = length
+		for n := 0; n < 1<<extraLBits[code]; n++ {
+			lengthCode[length] = uint8(code)
+			length++
+		}
+	}
+	// The length 258 can be represented by code 284 with 5 extra bits or by
+	// code 285, which is the best encoding.
+	lengthCode[length-1] = uint8(code)
+
+	dist := 0
+	for code = 0; code < 16; code++ {
+		baseDist[code] = dist
+		for n := 0; n < 1<<extraDBits[code]; n++ {
+			distCode[dist] = uint8(code)
+			dist++
+		}
+	}
+	dist >>= 7
+	for ; code < dCodes; code++ {
+		baseDist[code] = dist << 7
+		for n := 0; n < 1<<(extraDBits[code]-7); n++ {
+			distCode[256+dist] = uint8(code)
+			dist++
+		}
+	}
+
+	var blCount [maxBits + 1]uint16
+	n := 0
+	for ; n <= 143; n++ {
+		staticLTree[n].dl = 8
+		blCount[8]++
+	}
+	for ; n <= 255; n++ {
+		staticLTree[n].dl = 9
+		blCount[9]++
+	}
+	for ; n <= 279; n++ {
+		staticLTree[n].dl = 7
+		blCount[7]++
+	}
+	for ; n <= 287; n++ {
+		staticLTree[n].dl = 8
+		blCount[8]++
+	}
+	genCodes(staticLTree[:], lCodes+1, &blCount)
+
+	for n := range staticDTree {
+		staticDTree[n].dl = 5
+		staticDTree[n].fc = biReverse(uint16(n), 5)
+	}
+}
+
+type staticTreeDesc struct {
+	staticTree []ctData
+	extraBits  []int
+	extraBase  int
+	elems      int
+	maxLength  int
+}
+
+var (
+	staticLDesc  = staticTreeDesc{staticLTree[:], extraLBits[:], literals + 1, lCodes, maxBits}
+	staticDDesc  = staticTreeDesc{staticDTree[:], extraDBits[:], 0, dCodes, maxBits}
+	staticBLDesc = staticTreeDesc{nil, extraBLBits[:], 0, blCodes, maxBLBits}
+)
+
+type treeDesc struct {
+	dynTree []ctData
+	maxCode int
+	stat    *staticTreeDesc
+}
+
+// trees is the state of the Huffman encoding of the blocks.
+type trees struct {
+	dynLTree [heapSize]ctData
+	dynDTree [2*dCodes + 1]ctData
+	blTree   [2*blCodes + 1]ctData
+
+	lDesc  treeDesc
+	dDesc  treeDesc
+	blDesc treeDesc
+
+	blCount [maxBits + 1]uint16
+
+	// heap is used to build the Huffman trees. heap[0] is not used, and the
+	// sorted nodes are stored from heapMax at the end of the array.
+	heap    [2*lCodes + 1]int
+	heapLen int
+	heapMax int
+	// depth is the depth of each subtree, used as a tie breaker for the
+	// trees of equal frequency.
+	depth [2*lCodes + 1]uint8
+
+	// symBuf buffers the literals and matches of the current block, as the
+	// distance on two bytes (0 for literals) followed by the literal or the
+	// match length.
+	symBuf  [litBufSize * 3]byte
+	symNext int
+
+	optLen    uint64
+	staticLen uint64
+
+	biBuf   uint32
+	biValid int
+}
+
+func (d *deflater) trInit() {
+	d.lDesc = treeDesc{dynTree: d.dynLTree[:], stat: &staticLDesc}
+	d.dDesc = treeDesc{dynTree: d.dynDTree[:], stat: &staticDDesc}
+	d.blDesc = treeDesc{dynTree: d.blTree[:], stat: &staticBLDesc}
+	d.initBlock()
+}
+
+func (d *deflater) initBlock() {
+	for n := 0; n < lCodes; n++ {
+		d.dynLTree[n].fc = 0
+	}
+	for n := 0; n < dCodes; n++ {
+		d.dynDTree[n].fc = 0
+	}
+	for n := 0; n < blCodes; n++ {
+		d.blTree[n].fc = 0
+	}
+	d.dynLTree[endBlock].fc = 1
+	d.optLen = 0
+	d.staticLen = 0
+	d.symNext = 0
+}
+
+func dCode(dist uint32) uint8 {
+	if dist < 256 {
+		return distCode[dist]
+	}
+	return distCode[256+(dist>>7)]
+}
+
+// tallyLit buffers a literal, and returns whether the block must be flushed.
+func (d *deflater) tallyLit(c byte) bool {
+	d.symBuf[d.symNext] = 0
+	d.symBuf[d.symNext+1] = 0
+	d.symBuf[d.symNext+2] = c
+	d.symNext += 3
+	d.dynLTree[c].fc++
+	return d.symNext == symEnd
+}
+
+// tallyDist buffers a match of the given distance, and length minus minMatch,
+// and returns whether the block must be flushed.
+func (d *deflater) tallyDist(distance, length uint32) bool {
+	dist := uint16(distance)
+	d.symBuf[d.symNext] = byte(dist)
+	d.symBuf[d.symNext+1] = byte(dist >> 8)
+	d.symBuf[d.symNext+2] = byte(length)
+	d.symNext += 3
+	dist--
+	d.dynLTree[int(lengthCode[byte(length)])+literals+1].fc++
+	d.dynDTree[dCode(uint32(dist))].fc++
+	return d.symNext == symEnd
+}
+
+func biReverse(code uint16, length uint16) uint16 {
+	return bits.Reverse16(code) >> (16 - length)
+}
+
+func (d *deflater) sendBits(value int, length int) {
+	d.biBuf |= uint32(value) << d.biValid
+	d.biValid += length
+	for d.biValid >= 8 {
+		d.out = append(d.out, byte(d.biBuf))
+		d.biBuf >>= 8
+		d.biValid -= 8
+	}
+}
+
+func (d *deflater) sendCode(c int, tree []ctData) {
+	d.sendBits(int(tree[c].fc), int(tree[c].dl))
+}
+
+// biWindup flushes the remaining bits, padded to a byte boundary.
+func (d *deflater) biWindup() {
+	if d.biValid > 0 {
+		d.out = append(d.out, byte(d.biBuf))
+	}
+	d.biBuf = 0
+	d.biValid = 0
+}
+
+func smaller(tree []ctData, n, m int, depth *[2*lCodes + 1]uint8) bool {
+	return tree[n].fc < tree[m].fc || (tree[n].fc == tree[m].fc && depth[n] <= depth[m])
+}
+
+// pqdownheap restores the heap property by moving down the tree the node k,
+// exchanging it with the smallest of its two sons if necessary.
+func (d *deflater) pqdownheap(tree []ctData, k int) {
+	v := d.heap[k]
+	j := k << 1
+	for j <= d.heapLen {
+		if j < d.heapLen && smaller(tree, d.heap[j+1], d.heap[j], &d.depth) {
+			j++
+		}
+		if smaller(tree, v, d.heap[j], &d.depth) {
+			break
+		}
+		d.heap[k] = d.heap[j]
+		k = j
+		j <<= 1
+	}
+	d.heap[k] = v
+}
+
+// pqremove removes the smallest element from the heap and returns it.
+func (d *deflater) pqremove(tree []ctData) int {
+	top := d.heap[smallest]
+	d.heap[smallest] = d.heap[d.heapLen]
+	d.heapLen--
+	d.pqdownheap(tree, smallest)
+	return top
+}
+
+// genBitlen computes the optimal bit lengths of a tree, limited to the maximum
+// length of its descriptor, and updates the lengths of the current block.
+func (d *deflater) genBitlen(desc *treeDesc) {
+	tree := desc.dynTree
+	maxCode := desc.maxCode
+	stree := desc.stat.staticTree
+	extra := desc.stat.extraBits
+	base := desc.stat.extraBase
+	maxLength := desc.stat.maxLength
+	overflow := 0
+
+	for i := range d.blCount {
+		d.blCount[i] = 0
+	}
+
+	// The optimal bit lengths are computed from the root of the tree, whose
+	// length is 0, and may overflow the maximum length.
+	tree[d.heap[d.heapMax]].dl = 0
+
+	h := d.heapMax + 1
+	for ; h < heapSize; h++ {
+		n := d.heap[h]
+		bits := int(tree[tree[n].dl].dl) + 1
+		if bits > maxLength {
+			bits = maxLength
+			overflow++
+		}
+		tree[n].dl = uint16(bits)
+
+		if n > maxCode {
+			// Not a leaf node.
+			continue
+		}
+
+		d.blCount[bits]++
+		xbits := 0
+		if n >= base {
+			xbits = extra[n-base]
+		}
+		f := uint64(tree[n].fc)
+		d.optLen += f * uint64(bits+xbits)
+		if stree != nil {
+			d.staticLen += f * uint64(int(stree[n].dl)+xbits)
+		}
+	}
+	if overflow == 0 {
+		return
+	}
+
+	// Find the first bit length which could increase.
+	for overflow > 0 {
+		bits := maxLength - 1
+		for d.blCount[bits] == 0 {
+			bits--
+		}
+		d.blCount[bits]--      // move one leaf down the tree
+		d.blCount[bits+1] += 2 // move one overflow item as its brother
+		d.blCount[maxLength]--
+		overflow -= 2
+	}
+
+	// Recompute all the bit lengths, scanning in increasing frequency.
+	for bits := maxLength; bits != 0; bits-- {
+		n := d.blCount[bits]
+		for n != 0 {
+			h--
+			m := d.heap[h]
+			if m > maxCode {
+				continue
+			}
+			if int(tree[m].dl) != bits {
+				d.optLen += (uint64(bits) - uint64(tree[m].dl)) * uint64(tree[m].fc)
+				tree[m].dl = uint16(bits)
+			}
+			n--
+		}
+	}
+}
+
+// genCodes generates the codes of a tree whose bit lengths are set.
+func genCodes(tree []ctData, maxCode int, blCount *[maxBits + 1]uint16) {
+	var nextCode [maxBits + 1]uint16
+	code := uint32(0)
+	for bits := 1; bits <= maxBits; bits++ {
+		code = (code + uint32(blCount[bits-1])) << 1
+		nextCode[bits] = uint16(code)
+	}
+	for n := 0; n <= maxCode; n++ {
+		length := tree[n].dl
+		if length == 0 {
+			continue
+		}
+		tree[n].fc = biReverse(nextCode[length], length)
+		nextCode[length]++
+	}
+}
+
+// buildTree builds the Huffman tree of a descriptor, and sets its codes and
+// bit lengths.
+func (d *deflater) buildTree(desc *treeDesc) {
+	tree := desc.dynTree
+	stree := desc.stat.staticTree
+	elems := desc.stat.elems
+	maxCode := -1
+
+	d.heapLen = 0
+	d.heapMax = heapSize
+
+	for n := 0; n < elems; n++ {
+		if tree[n].fc != 0 {
+			d.heapLen++
+			d.heap[d.heapLen] = n
+			maxCode = n
+			d.depth[n] = 0
+		} else {
+			tree[n].dl = 0
+		}
+	}
+
+	// The deflate format requires at least one distance code, and at least
+	// two codes of non zero frequency are needed to build a tree.
+	for d.heapLen < 2 {
+		node := 0
+		if maxCode < 2 {
+			maxCode++
+			node = maxCode
+		}
+		d.heapLen++
+		d.heap[d.heapLen] = node
+		tree[node].fc = 1
+		d.depth[node] = 0
+		d.optLen--
+		if stree != nil {
+			d.staticLen -= uint64(stree[node].dl)
+		}
+	}
+	desc.maxCode = maxCode
+
+	for n := d.heapLen / 2; n >= 1; n-- {
+		d.pqdownheap(tree, n)
+	}
+
+	// Build the tree by repeatedly combining the two least frequent nodes.
+	node := elems
+	for {
+		n := d.pqremove(tree)
+		m := d.heap[smallest]
+
+		// Keep the nodes sorted by frequency.
+		d.heapMax--
+		d.heap[d.heapMax] = n
+		d.heapMax--
+		d.heap[d.heapMax] = m
+
+		tree[node].fc = tree[n].fc + tree[m].fc
+		d.depth[node] = max(d.depth[n], d.depth[m]) + 1
+		tree[n].dl = uint16(node)
+		tree[m].dl = uint16(node)
+
+		d.heap[smallest] = node
+		node++
+		d.pqdownheap(tree, smallest)
+
+		if d.heapLen < 2 {
+			break
+		}
+	}
+
+	d.heapMax--
+	d.heap[d.heapMax] = d.heap[smallest]
+
+	d.genBitlen(desc)
+	genCodes(tree, maxCode, &d.blCount)
+}
+
+// scanTree counts the frequencies of the codes of the bit length tree that
+// send the lengths of tree.
+func (d *deflater) scanTree(tree []ctData, maxCode int) {
+	prevLen := -1
+	nextLen := int(tree[0].dl)
+	count := 0
+	maxCount := 7
+	minCount := 4
+
+	if nextLen == 0 {
+		maxCount, minCount = 138, 3
+	}
+	tree[maxCode+1].dl = 0xffff // guard
+
+	for n := 0; n <= maxCode; n++ {
+		curLen := nextLen
+		nextLen = int(tree[n+1].dl)
+		count++
+		if count < maxCount && curLen == nextLen {
+			continue
+		}
+		switch {
+		case count < minCount:
+			d.blTree[curLen].fc += uint16(count)
+		case curLen != 0:
+			if curLen != prevLen {
+				d.blTree[curLen].fc++
+			}
+			d.blTree[rep3To6].fc++
+		case count <= 10:
+			d.blTree[repZ3To10].fc++
+		default:
+			d.blTree[repZ11To138].fc++
+		}
+		count = 0
+		prevLen = curLen
+		switch {
+		case nextLen == 0:
+			maxCount, minCount = 138, 3
+		case curLen == nextLen:
+			maxCount, minCount = 6, 3
+		default:
+			maxCount, minCount = 7, 4
+		}
+	}
+}
+
+// sendTree sends the lengths of tree, with the codes of the bit length tree.
+func (d *deflater) sendTree(tree []ctData, maxCode int) {
+	prevLen := -1
+	nextLen := int(tree[0].dl)
+	count := 0
+	maxCount := 7
+	minCount := 4
+
+	if nextLen == 0 {
+		maxCount, minCount = 138, 3
+	}
+
+	for n := 0; n <= maxCode; n++ {
+		curLen := nextLen
+		nextLen = int(tree[n+1].dl)
+		count++
+		if count < maxCount && curLen == nextLen {
+			continue
+		}
+		switch {
+		case count < minCount:
+			for ; count != 0; count-- {
+				d.sendCode(curLen, d.blTree[:])
+			}
+		case curLen != 0:
+			if curLen != prevLen {
+				d.sendCode(curLen, d.blTree[:])
+				count--
+			}
+			d.sendCode(rep3To6, d.blTree[:])
+			d.sendBits(count-3, 2)
+		case count <= 10:
+			d.sendCode(repZ3To10, d.blTree[:])
+			d.sendBits(count-3, 3)
+		default:
+			d.sendCode(repZ11To138, d.blTree[:])
+			d.sendBits(count-11, 7)
+		}
+		count = 0
+		prevLen = curLen
+		switch {
+		case nextLen == 0:
+			maxCount, minCount = 138, 3
+		case curLen == nextLen:
+			maxCount, minCount = 6, 3
+		default:
+			maxCount, minCount = 7, 4
+		}
+	}
+}
+
+// buildBLTree builds the bit length tree of the current block, and returns
+// the index in blOrder of the last bit length code to send.
+func (d *deflater) buildBLTree() int {
+	d.scanTree(d.dynLTree[:], d.lDesc.maxCode)
+	d.scanTree(d.dynDTree[:], d.dDesc.maxCode)
+
+	d.buildTree(&d.blDesc)
+
+	// At least 4 bit length codes are sent.
+	maxBLIndex := blCodes - 1
+	for ; maxBLIndex >= 3; maxBLIndex-- {
+		if d.blTree[blOrder[maxBLIndex]].dl != 0 {
+			break
+		}
+	}
+	d.optLen += 3*(uint64(maxBLIndex)+1) + 5 + 5 + 4
+	return maxBLIndex
+}
+
+// sendAllTrees sends the header of a block using dynamic Huffman trees.
+func (d *deflater) sendAllTrees(lcodes, dcodes, blcodes int) {
+	d.sendBits(lcodes-257, 5)
+	d.sendBits(dcodes-1, 5)
+	d.sendBits(blcodes-4, 4)
+	for rank := 0; rank < blcodes; rank++ {
+		d.sendBits(int(d.blTree[blOrder[rank]].dl), 3)
+	}
+	d.sendTree(d.dynLTree[:], lcodes-1)
+	d.sendTree(d.dynDTree[:], dcodes-1)
+}
+
+// compressBlock sends the buffered symbols of the block with the given trees.
+func (d *deflater) compressBlock(ltree, dtree []ctData) {
+	for sx := 0; sx < d.symNext; sx += 3 {
+		dist := uint32(d.symBuf[sx]) | uint32(d.symBuf[sx+1])<<8
+		lc := int(d.symBuf[sx+2])
+		if dist == 0 {
+			d.sendCode(lc, ltree)
+			continue
+		}
+
+		// lc is the match length minus minMatch.
+		code := int(lengthCode[lc])
+		d.sendCode(code+literals+1, ltree)
+		if extra := extraLBits[code]; extra != 0 {
+			d.sendBits(lc-baseLength[code], extra)
+		}
+		dist--
+		code = int(dCode(dist))
+		d.sendCode(code, dtree)
+		if extra := extraDBits[code]; extra != 0 {
+			d.sendBits(int(dist)-baseDist[code], extra)
+		}
+	}
+	d.sendCode(endBlock, ltree)
+}
+
+// trStoredBlock sends a block of stored bytes.
+func (d *deflater) trStoredBlock(buf []byte, last int) {
+	d.sendBits(storedBlock<<1+last, 3)
+	d.biWindup()
+	d.out = append(d.out, byte(len(buf)), byte(len(buf)>>8), ^byte(len(buf)), ^byte(len(buf)>>8))
+	d.out = append(d.out, buf...)
+}
+
+// trFlushBlock determines the best encoding of the current block, a stored
+// block or a block with static or dynamic trees, and writes it to the output.
+// buf holds the bytes of the block, or is nil if they are no longer available
+// in the window.
+func (d *deflater) trFlushBlock(buf []byte, storedLen uint64, last bool) {
+	lastBit := 0
+	if last {
+		lastBit = 1
+	}
+
+	d.buildTree(&d.lDesc)
+	d.buildTree(&d.dDesc)
+	maxBLIndex := d.buildBLTree()
+
+	// The lengths of the block in bytes, with the 3 bits of its header.
+	optLenb := (d.optLen + 3 + 7) >> 3
+	staticLenb := (d.staticLen + 3 + 7) >> 3
+	if staticLenb <= optLenb {
+		optLenb = staticLenb
+	}
+
+	switch {
+	case storedLen+4 <= optLenb && buf != nil:
+		d.trStoredBlock(buf[:storedLen], lastBit)
+	case staticLenb == optLenb:
+		d.sendBits(staticTrees<<1+lastBit, 3)
+		d.compressBlock(staticLTree[:], staticDTree[:])
+	default:
+		d.sendBits(dynTrees<<1+lastBit, 3)
+		d.sendAllTrees(d.lDesc.maxCode+1, d.dDesc.maxCode+1, maxBLIndex+1)
+		d.compressBlock(d.dynLTree[:], d.dynDTree[:])
+	}
+	d.initBlock()
+
+	if last {
+		d.biWindup()
+	}
+}
@@ -397,7 +397,7 @@ func (fs *fuzzStore) callDemotePrimary() error {
 	if err != nil {
 		return err
 	}
-	_, _ = fs.client.DemotePrimary(context.Background(), tablet)
+	_, _ = fs.client.DemotePrimary(context.Background(), tablet, nil)
 	return nil
 }
 
@@ -441,7 +441,7 @@ func (fs *fuzzStore) callPromoteReplica() error {
 	if err != nil {
 		return err
 	}
-	_, _ = fs.client.PromoteReplica(context.Background(), tablet, false)
+	_, _ = fs.client.PromoteReplica(context.Background(), tablet, false, nil)
 	return nil
 }
 
@@ -79,14 +79,15 @@ func (c *cowGen) sliceMethod(t types.Type, slice *types.Slice, spi generatorSPI)
 	var visitElements *jen.Statement
 
 	if types.Implements(slice.Elem(), spi.iface()) {
+		// The slice is only copied once an element changes, so that visiting a
+		// slice without changing it doesn't allocate.
 		visitElements = ifPreNotNilOrReturnsTrue().Block(
-			jen.Id(fieldVar).Op(":=").Id("make").Params(jen.Id(typeString), jen.Id("len").Params(jen.Id("n"))), // _Foo := make([]Typ, len(n))
+			jen.Id(fieldVar).Op(":=").Id("n"), // res := n
 			jen.For(jen.List(jen.Id("x"), jen.Id("el")).Op(":=").Id("range n")).Block(
 				c.visitFieldOrElement("this", "change", slice.Elem(), jen.Id("el"), spi),
-				// jen.Id(fieldVar).Index(jen.Id("x")).Op("=").Id("this").Op(".").Params(jen.Id(types.TypeString(elemTyp, noQualifier))),
-				jen.Id(fieldVar).Index(jen.Id("x")).Op("=").Id("this").Op(".").Params(jen.Id(elemTyp)),
 				jen.If(jen.Id("change")).Block(
-					jen.Id(changedVarName).Op("=").True(),
+					copySliceOnce(changedVarName, fieldVar, typeString, jen.Id("n")),
+					jen.Id(fieldVar).Index(jen.Id("x")).Op("=").Id("this").Op(".").Params(jen.Id(elemTyp)),
 				),
 			),
 			jen.If(jen.Id("changed")).Block(
@@ -132,6 +133,22 @@ func (c *cowGen) basicMethod(t types.Type, basic *types.Basic, spi generatorSPI)
 	return nil
 }
 
+// copySliceOnce copies the slice src into dst the first time one of its
+// elements changes, as recorded by the changed variable:
+//
+//	if !changed {
+//		dst = make(typ, len(src))
+//		copy(dst, src)
+//		changed = true
+//	}
+func copySliceOnce(changed, dst, typ string, src *jen.Statement) *jen.Statement {
+	return jen.If(jen.Op("!").Id(changed)).Block(
+		jen.Id(dst).Op("=").Id("make").Params(jen.Id(typ), jen.Id("len").Params(src.Clone())),
+		jen.Id("copy").Params(jen.Id(dst), src.Clone()),
+		jen.Id(changed).Op("=").True(),
+	)
+}
+
 func ifNotNil(id string, stmts ...jen.Code) *jen.Statement {
 	return jen.If(jen.Id(id).Op("!=").Nil()).Block(stmts...)
 }
@@ -290,14 +307,18 @@ func (c *cowGen) visitStruct(t types.Type, strct *types.Struct, spi generatorSPI
 			changedVariables = append(changedVariables, changedVarName)
 			fieldSetters = append(fieldSetters, jen.List(jen.Id(changed).Dot(field), jen.Op("_")).Op("=").Id(fieldVar).Op(".").Params(jen.Id(fieldType)))
 		} else {
-			// _Foo := make([]*Type, len(n.Foo))
+			// _Foo := n.Foo
 			// var changedFoo bool
 			// for x, el := range n.Foo {
-			// 	c, changed := c.COWSliceOfRefOfType(el, n)
+			// 	this, changed := c.COWSliceOfRefOfType(el, n)
 			// 	if changed {
-			// 		changedFoo = true
+			// 		if !changedFoo {
+			// 			_Foo = make([]*Type, len(n.Foo))
+			// 			copy(_Foo, n.Foo)
+			// 			changedFoo = true
+			// 		}
+			// 		_Foo[x] = this.(*Type)
 			// 	}
-			// 	_Foo[i] = c.(*Type)
 			// }
 
 			slice, isSlice := typ.(*types.Slice)
@@ -308,13 +329,13 @@ func (c *cowGen) visitStruct(t types.Type, strct *types.Struct, spi generatorSPI
 				el := jen.Id("el")
 				// 	changed := jen.Id("changed")
 				fields = append(fields,
-					jen.Var().Id(changedVarName).Bool(), // var changedFoo bool
-					jen.Id(fieldVar).Op(":=").Id("make").Params(jen.Id(fieldType), jen.Id("len").Params(jen.Id("n").Dot(field))), // _Foo := make([]Typ, len(n.Foo))
+					jen.Id(fieldVar).Op(":=").Id("n").Dot(field), // _Foo := n.Foo
+					jen.Var().Id(changedVarName).Bool(),          // var changedFoo bool
 					jen.For(jen.List(x, el).Op(":=").Id("range n").Dot(field)).Block(
 						c.visitFieldOrElement("this", "changed", elemTyp, jen.Id("el"), spi),
-						jen.Id(fieldVar).Index(jen.Id("x")).Op("=").Id("this").Op(".").Params(jen.Id(types.TypeString(elemTyp, noQualifier))),
 						jen.If(jen.Id("changed")).Block(
-							jen.Id(changedVarName).Op("=").True(),
+							copySliceOnce(changedVarName, fieldVar, fieldType, jen.Id("n").Dot(field)),
+							jen.Id(fieldVar).Index(jen.Id("x")).Op("=").Id("this").Op(".").Params(jen.Id(types.TypeString(elemTyp, noQualifier))),
 						),
 					),
 				)
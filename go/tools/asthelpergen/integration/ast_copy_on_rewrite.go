@@ -79,12 +79,16 @@ func (c *cow) copyOnRewriteInterfaceSlice(n InterfaceSlice, parent AST) (out AST
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(InterfaceSlice, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteAST(el, n)
-			res[x] = this.(AST)
 			if change {
-				changed = true
+				if !changed {
+					res = make(InterfaceSlice, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(AST)
 			}
 		}
 		if changed {
@@ -114,12 +118,16 @@ func (c *cow) copyOnRewriteLeafSlice(n LeafSlice, parent AST) (out AST, changed
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(LeafSlice, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteRefOfLeaf(el, n)
-			res[x] = this.(*Leaf)
 			if change {
-				changed = true
+				if !changed {
+					res = make(LeafSlice, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(*Leaf)
 			}
 		}
 		if changed {
@@ -173,22 +181,30 @@ func (c *cow) copyOnRewriteRefOfRefSliceContainer(n *RefSliceContainer, parent A
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
+		_ASTElements := n.ASTElements
 		var changedASTElements bool
-		_ASTElements := make([]AST, len(n.ASTElements))
 		for x, el := range n.ASTElements {
 			this, changed := c.copyOnRewriteAST(el, n)
-			_ASTElements[x] = this.(AST)
 			if changed {
-				changedASTElements = true
+				if !changedASTElements {
+					_ASTElements = make([]AST, len(n.ASTElements))
+					copy(_ASTElements, n.ASTElements)
+					changedASTElements = true
+				}
+				_ASTElements[x] = this.(AST)
 			}
 		}
+		_ASTImplementationElements := n.ASTImplementationElements
 		var changedASTImplementationElements bool
-		_ASTImplementationElements := make([]*Leaf, len(n.ASTImplementationElements))
 		for x, el := range n.ASTImplementationElements {
 			this, changed := c.copyOnRewriteRefOfLeaf(el, n)
-			_ASTImplementationElements[x] = this.(*Leaf)
 			if changed {
-				changedASTImplementationElements = true
+				if !changedASTImplementationElements {
+					_ASTImplementationElements = make([]*Leaf, len(n.ASTImplementationElements))
+					copy(_ASTImplementationElements, n.ASTImplementationElements)
+					changedASTImplementationElements = true
+				}
+				_ASTImplementationElements[x] = this.(*Leaf)
 			}
 		}
 		if changedASTElements || changedASTImplementationElements {
@@ -253,22 +269,30 @@ func (c *cow) copyOnRewriteValueContainer(n ValueContainer, parent AST) (out AST
 func (c *cow) copyOnRewriteValueSliceContainer(n ValueSliceContainer, parent AST) (out AST, changed bool) {
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
+		_ASTElements := n.ASTElements
 		var changedASTElements bool
-		_ASTElements := make([]AST, len(n.ASTElements))
 		for x, el := range n.ASTElements {
 			this, changed := c.copyOnRewriteAST(el, n)
-			_ASTElements[x] = this.(AST)
 			if changed {
-				changedASTElements = true
+				if !changedASTElements {
+					_ASTElements = make([]AST, len(n.ASTElements))
+					copy(_ASTElements, n.ASTElements)
+					changedASTElements = true
+				}
+				_ASTElements[x] = this.(AST)
 			}
 		}
+		_ASTImplementationElements := n.ASTImplementationElements
 		var changedASTImplementationElements bool
-		_ASTImplementationElements := make([]*Leaf, len(n.ASTImplementationElements))
 		for x, el := range n.ASTImplementationElements {
 			this, changed := c.copyOnRewriteRefOfLeaf(el, n)
-			_ASTImplementationElements[x] = this.(*Leaf)
 			if changed {
-				changedASTImplementationElements = true
+				if !changedASTImplementationElements {
+					_ASTImplementationElements = make([]*Leaf, len(n.ASTImplementationElements))
+					copy(_ASTImplementationElements, n.ASTImplementationElements)
+					changedASTImplementationElements = true
+				}
+				_ASTImplementationElements[x] = this.(*Leaf)
 			}
 		}
 		if changedASTElements || changedASTImplementationElements {
@@ -355,22 +379,30 @@ func (c *cow) copyOnRewriteRefOfValueSliceContainer(n *ValueSliceContainer, pare
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
+		_ASTElements := n.ASTElements
 		var changedASTElements bool
-		_ASTElements := make([]AST, len(n.ASTElements))
 		for x, el := range n.ASTElements {
 			this, changed := c.copyOnRewriteAST(el, n)
-			_ASTElements[x] = this.(AST)
 			if changed {
-				changedASTElements = true
+				if !changedASTElements {
+					_ASTElements = make([]AST, len(n.ASTElements))
+					copy(_ASTElements, n.ASTElements)
+					changedASTElements = true
+				}
+				_ASTElements[x] = this.(AST)
 			}
 		}
+		_ASTImplementationElements := n.ASTImplementationElements
 		var changedASTImplementationElements bool
-		_ASTImplementationElements := make([]*Leaf, len(n.ASTImplementationElements))
 		for x, el := range n.ASTImplementationElements {
 			this, changed := c.copyOnRewriteRefOfLeaf(el, n)
-			_ASTImplementationElements[x] = this.(*Leaf)
 			if changed {
-				changedASTImplementationElements = true
+				if !changedASTImplementationElements {
+					_ASTImplementationElements = make([]*Leaf, len(n.ASTImplementationElements))
+					copy(_ASTImplementationElements, n.ASTImplementationElements)
+					changedASTImplementationElements = true
+				}
+				_ASTImplementationElements[x] = this.(*Leaf)
 			}
 		}
 		if changedASTElements || changedASTImplementationElements {
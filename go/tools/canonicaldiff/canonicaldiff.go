@@ -0,0 +1,132 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+// canonicaldiff compares the canonical SQL output of two Vitess releases. It is run
+// with the first release to record the canonical output of a set of queries:
+//
+//	go run ./go/tools/canonicaldiff --queries queries.sql --corpus corpus.json
+//
+// and then with the second release to print all the queries whose canonical output
+// changed since:
+//
+//	go run ./go/tools/canonicaldiff --corpus corpus.json
+//
+// The queries file has one query per line; empty lines and lines starting with '#'
+// or '--' are ignored.
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func main() {
+	var queriesFile, corpusFile string
+	pflag.StringVar(&queriesFile, "queries", "", "record the canonical output of the queries in this file into the corpus, instead of comparing it with the corpus")
+	pflag.StringVar(&corpusFile, "corpus", "", "path of the corpus with the recorded canonical output")
+	pflag.Parse()
+
+	if corpusFile == "" {
+		log.Fatal("--corpus is required")
+	}
+
+	parser, err := sqlparser.New(sqlparser.Options{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if queriesFile != "" {
+		if err := record(parser, queriesFile, corpusFile); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	changed, err := diff(parser, corpusFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if changed {
+		os.Exit(1)
+	}
+}
+
+func record(parser *sqlparser.Parser, queriesFile, corpusFile string) error {
+	queries, err := readQueries(queriesFile)
+	if err != nil {
+		return err
+	}
+	corpus, err := parser.NewCanonicalCorpus(queries)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(corpusFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return sqlparser.WriteCanonicalCorpus(f, corpus)
+}
+
+func diff(parser *sqlparser.Parser, corpusFile string) (bool, error) {
+	f, err := os.Open(corpusFile)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	corpus, err := sqlparser.ReadCanonicalCorpus(f)
+	if err != nil {
+		return false, err
+	}
+
+	diffs := parser.DiffCanonicalCorpus(corpus)
+	for _, d := range diffs {
+		fmt.Printf("query: %s\n- %s\n+ %s\n\n", d.Query, d.Expected, d.Actual)
+	}
+	fmt.Printf("%d of %d queries changed between canonical format versions %d and %d\n",
+		len(diffs), len(corpus.Entries), corpus.Version, sqlparser.CanonicalFormatVersion)
+	return len(diffs) > 0, nil
+}
+
+func readQueries(queriesFile string) ([]string, error) {
+	f, err := os.Open(queriesFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var queries []string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "--") {
+			continue
+		}
+		queries = append(queries, line)
+	}
+	return queries, scanner.Err()
+}
@@ -49,7 +49,7 @@ func TestStreamerParseRBREvents(t *testing.T) {
 		Fields: []*querypb.Field{{
 			Name:    "id",
 			Type:    querypb.Type_INT64,
-			Charset: collations.CollationBinaryID,
+			Charset: uint32(collations.CollationBinaryID),
 			Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 		}, {
 			Name:    "message",
@@ -287,7 +287,7 @@ func TestStreamerParseRBRNameEscapes(t *testing.T) {
 		Fields: []*querypb.Field{{
 			Name:    "update",
 			Type:    querypb.Type_INT64,
-			Charset: collations.CollationBinaryID,
+			Charset: uint32(collations.CollationBinaryID),
 			Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 		}, {
 			Name:    "delete",
@@ -117,6 +117,10 @@ type Stats struct {
 	ThrottledCounts *stats.CountersWithMultiLabels // By throttler and component
 
 	DDLEventActions *stats.CountersWithSingleLabel
+
+	// RiskyCharsetConversions counts, by table, the binary values written to text
+	// columns that are unlikely to be text in the character set of the column.
+	RiskyCharsetConversions *stats.CountersWithSingleLabel
 }
 
 // RecordHeartbeat updates the time the last heartbeat from vstreamer was seen
@@ -188,6 +192,7 @@ func NewStats() *Stats {
 	bps.PartialQueryCount = stats.NewCountersWithMultiLabels("", "", []string{"type"})
 	bps.ThrottledCounts = stats.NewCountersWithMultiLabels("", "", []string{"throttler", "component"})
 	bps.DDLEventActions = stats.NewCountersWithSingleLabel("", "", "action")
+	bps.RiskyCharsetConversions = stats.NewCountersWithSingleLabel("", "", "Table")
 	return bps
 }
 
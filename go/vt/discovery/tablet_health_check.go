@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
@@ -305,7 +306,13 @@ func (thc *tabletHealthCheck) checkConn(hc *HealthCheckImpl) {
 			case servingStatus <- shr.Serving:
 			default:
 			}
-			return thc.processResponse(hc, shr)
+			if err := thc.processResponse(hc, shr); err != nil {
+				return err
+			}
+			// The tablet is reachable, let the tablet manager RPCs that fail
+			// fast reach it again.
+			tmclient.ReportTabletUp(thc.Tablet.Alias)
+			return nil
 		})
 
 		// streamCancel to make sure the watcher goroutine terminates.
@@ -323,6 +330,9 @@ func (thc *tabletHealthCheck) checkConn(hc *HealthCheckImpl) {
 				hc.deleteTablet(thc.Tablet)
 				return
 			}
+			if thc.ctx.Err() == nil {
+				tmclient.ReportTabletDown(thc.Tablet.Alias, "healthcheck stream error: "+err.Error())
+			}
 			// trivialUpdate = false because this is an error
 			// up = false because we did not get a healthy response
 			hc.updateHealth(thc.SimpleCopy(), thc.Target, false, false)
@@ -333,6 +343,7 @@ func (thc *tabletHealthCheck) checkConn(hc *HealthCheckImpl) {
 		if timedout.Load() {
 			thc.LastError = fmt.Errorf("healthcheck timed out (latest %v)", thc.lastResponseTimestamp)
 			thc.setServingState(false, thc.LastError.Error())
+			tmclient.ReportTabletDown(thc.Tablet.Alias, thc.LastError.Error())
 			hcErrorCounters.Add([]string{thc.Target.Keyspace, thc.Target.Shard, topoproto.TabletTypeLString(thc.Target.TabletType)}, 1)
 			// trivialUpdate = false because this is an error
 			// up = false because we did not get a healthy response within the timeout
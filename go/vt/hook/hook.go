@@ -147,25 +147,48 @@ func (hook *Hook) ExecuteContext(ctx context.Context) (result *HookResult) {
 
 	// Run it.
 	var stdout, stderr strings.Builder
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	start := time.Now()
-	err = cmd.Run()
-	duration := time.Since(start)
-
+	result.ExitStatus = run(ctx, cmd, &stdout, &stderr)
 	result.Stdout = stdout.String()
 	result.Stderr = stderr.String()
+	log.Infof("hook: result is %v", result.String())
+	return result
+}
 
-	defer func() {
-		log.Infof("hook: result is %v", result.String())
-	}()
+// ExecuteStreaming tries to execute the Hook with the given context, like ExecuteContext,
+// but writes its output to the provided writers while it runs instead of buffering it.
+// The returned HookResult only has the exit status: the errors that ExecuteContext adds
+// to Stderr are written to stderr. The writers may be called concurrently.
+func (hook *Hook) ExecuteStreaming(ctx context.Context, stdout, stderr io.Writer) (result *HookResult) {
+	result = &HookResult{}
 
-	if err == nil {
-		result.ExitStatus = HOOK_SUCCESS
+	// Find the hook.
+	cmd, status, err := hook.findHook(ctx)
+	if err != nil {
+		result.ExitStatus = status
+		fmt.Fprintf(stderr, "%v\n", err)
 		return result
 	}
 
+	// Run it.
+	result.ExitStatus = run(ctx, cmd, stdout, stderr)
+	log.Infof("hook: result is %v", result.String())
+	return result
+}
+
+// run runs the hook command, writing its output to stdout and stderr, and returns
+// its exit status.
+func run(ctx context.Context, cmd *exec.Cmd, stdout, stderr io.Writer) int {
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	start := time.Now()
+	err := cmd.Run()
+	duration := time.Since(start)
+
+	if err == nil {
+		return HOOK_SUCCESS
+	}
+
 	if ctx.Err() != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
 		// When (exec.Cmd).Run hits a context cancelled, the process is killed via SIGTERM.
 		// This means:
@@ -173,23 +196,20 @@ func (hook *Hook) ExecuteContext(ctx context.Context) (result *HookResult) {
 		//	2. cmd.ProcessState.ExitCode() is -1.
 		// [ref]: https://golang.org/pkg/os/#ProcessState.ExitCode
 		//
-		// Therefore, we need to catch this error specifically, and set result.ExitStatus to
-		// HOOK_TIMEOUT_ERROR, because just using ExitStatus will result in HOOK_DOES_NOT_EXIST,
-		// which would be wrong. Since we're already doing some custom handling, we'll also include
-		// the amount of time the command was running in the error string, in case that is helpful.
-		result.ExitStatus = HOOK_TIMEOUT_ERROR
-		result.Stderr += fmt.Sprintf("ERROR: (after %s) %s\n", duration, err)
-		return result
+		// Therefore, we need to catch this error specifically, and return HOOK_TIMEOUT_ERROR,
+		// because just using ExitStatus will result in HOOK_DOES_NOT_EXIST, which would be
+		// wrong. Since we're already doing some custom handling, we'll also include the amount
+		// of time the command was running in the error string, in case that is helpful.
+		fmt.Fprintf(stderr, "ERROR: (after %s) %s\n", duration, err)
+		return HOOK_TIMEOUT_ERROR
 	}
 
+	status := HOOK_CANNOT_GET_EXIT_STATUS
 	if cmd.ProcessState != nil && cmd.ProcessState.Sys() != nil {
-		result.ExitStatus = cmd.ProcessState.Sys().(syscall.WaitStatus).ExitStatus()
-	} else {
-		result.ExitStatus = HOOK_CANNOT_GET_EXIT_STATUS
+		status = cmd.ProcessState.Sys().(syscall.WaitStatus).ExitStatus()
 	}
-	result.Stderr += "ERROR: " + err.Error() + "\n"
-
-	return result
+	fmt.Fprintf(stderr, "ERROR: %v\n", err)
+	return status
 }
 
 // Execute tries to execute the Hook and returns a HookResult.
@@ -112,6 +112,38 @@ func TestExecuteOptional(t *testing.T) {
 	}
 }
 
+func TestExecuteStreaming(t *testing.T) {
+	vtroot, err := vtenv.VtRoot()
+	require.NoError(t, err)
+
+	echo, err := exec.LookPath("echo")
+	require.NoError(t, err)
+
+	echoHookPath := path.Join(vtroot, "vthook", "echo")
+
+	if _, err := os.Lstat(echoHookPath); err == nil {
+		require.NoError(t, os.Remove(echoHookPath))
+	}
+
+	require.NoError(t, os.Symlink(echo, echoHookPath))
+	defer func() {
+		require.NoError(t, os.Remove(echoHookPath))
+	}()
+
+	var stdout, stderr strings.Builder
+	hr := NewHook("echo", []string{"test"}).ExecuteStreaming(context.Background(), &stdout, &stderr)
+	assert.Equal(t, HOOK_SUCCESS, hr.ExitStatus)
+	assert.Equal(t, "test\n", stdout.String())
+	assert.Empty(t, stderr.String())
+	assert.Empty(t, hr.Stdout)
+
+	stdout.Reset()
+	hr = NewHook("nonexistent-hook", nil).ExecuteStreaming(context.Background(), &stdout, &stderr)
+	assert.Equal(t, HOOK_DOES_NOT_EXIST, hr.ExitStatus)
+	assert.Empty(t, stdout.String())
+	assert.Contains(t, stderr.String(), "missing hook")
+}
+
 func TestNewHook(t *testing.T) {
 	h := NewHook("test-hook", []string{"arg1", "arg2"})
 	assert.Equal(t, "test-hook", h.Name)
@@ -176,6 +176,11 @@ type FakeMysqlDaemon struct {
 	// FetchSuperQueryResults is used by FetchSuperQuery.
 	FetchSuperQueryMap map[string]*sqltypes.Result
 
+	// BinaryLogs is returned by GetBinaryLogs.
+	BinaryLogs []string
+	// PreviousGTIDs are returned by GetPreviousGTIDs, by binary log.
+	PreviousGTIDs map[string]string
+
 	// SemiSyncPrimaryEnabled represents the state of rpl_semi_sync_source_enabled.
 	SemiSyncPrimaryEnabled bool
 	// SemiSyncReplicaEnabled represents the state of rpl_semi_sync_replica_enabled.
@@ -380,14 +385,21 @@ func (fmd *FakeMysqlDaemon) FlushBinaryLogs(ctx context.Context) (err error) {
 
 // GetBinaryLogs is part of the MysqlDaemon interface.
 func (fmd *FakeMysqlDaemon) GetBinaryLogs(ctx context.Context) (binaryLogs []string, err error) {
-	return []string{}, fmd.ExecuteSuperQueryList(ctx, []string{
+	return append([]string{}, fmd.BinaryLogs...), fmd.ExecuteSuperQueryList(ctx, []string{
 		"FAKE SHOW BINARY LOGS",
 	})
 }
 
+// PurgeBinaryLogs is part of the MysqlDaemon interface.
+func (fmd *FakeMysqlDaemon) PurgeBinaryLogs(ctx context.Context, toLog string) (err error) {
+	return fmd.ExecuteSuperQueryList(ctx, []string{
+		fmt.Sprintf("FAKE PURGE BINARY LOGS TO '%s'", toLog),
+	})
+}
+
 // GetPreviousGTIDs is part of the MysqlDaemon interface.
 func (fmd *FakeMysqlDaemon) GetPreviousGTIDs(ctx context.Context, binlog string) (previousGtids string, err error) {
-	return "", fmd.ExecuteSuperQueryList(ctx, []string{
+	return fmd.PreviousGTIDs[binlog], fmd.ExecuteSuperQueryList(ctx, []string{
 		fmt.Sprintf("FAKE SHOW BINLOG EVENTS IN '%s' LIMIT 2", binlog),
 	})
 }
@@ -712,6 +724,11 @@ func (fmd *FakeMysqlDaemon) SemiSyncClients(ctx context.Context) uint32 {
 	return 0
 }
 
+// SemiSyncWaitSessions is part of the MysqlDaemon interface.
+func (fmd *FakeMysqlDaemon) SemiSyncWaitSessions(ctx context.Context) uint32 {
+	return 0
+}
+
 // SemiSyncExtensionLoaded is part of the MysqlDaemon interface.
 func (fmd *FakeMysqlDaemon) SemiSyncExtensionLoaded(ctx context.Context) (mysql.SemiSyncType, error) {
 	return mysql.SemiSyncTypeSource, nil
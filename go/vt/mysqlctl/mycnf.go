@@ -129,6 +129,13 @@ func (cnf *Mycnf) lookup(key string) string {
 	return cnf.mycnfMap[key]
 }
 
+// Lookup returns the value of a variable in the my.cnf file, and whether it is set.
+// Hyphens and underscores are interchangeable in the name of the variable.
+func (cnf *Mycnf) Lookup(name string) (string, bool) {
+	val, ok := cnf.mycnfMap[normKey([]byte(name))]
+	return val, ok
+}
+
 func (cnf *Mycnf) lookupWithDefault(key, defaultVal string) (string, error) {
 	val := cnf.lookup(key)
 	if val == "" {
@@ -72,6 +72,7 @@ type MysqlDaemon interface {
 	SemiSyncExtensionLoaded(ctx context.Context) (mysql.SemiSyncType, error)
 	SemiSyncStatus(ctx context.Context) (source, replica bool)
 	SemiSyncClients(ctx context.Context) (count uint32)
+	SemiSyncWaitSessions(ctx context.Context) (count uint32)
 	SemiSyncSettings(ctx context.Context) (timeout uint64, numReplicas uint32)
 	SemiSyncReplicationStatus(ctx context.Context) (bool, error)
 	ResetReplicationParameters(ctx context.Context) error
@@ -79,6 +80,7 @@ type MysqlDaemon interface {
 	GetGTIDMode(ctx context.Context) (gtidMode string, err error)
 	FlushBinaryLogs(ctx context.Context) (err error)
 	GetBinaryLogs(ctx context.Context) (binaryLogs []string, err error)
+	PurgeBinaryLogs(ctx context.Context, toLog string) (err error)
 	GetPreviousGTIDs(ctx context.Context, binlog string) (previousGtids string, err error)
 
 	// reparenting related methods
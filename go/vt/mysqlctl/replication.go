@@ -627,6 +627,13 @@ func (mysqld *Mysqld) GetBinaryLogs(ctx context.Context) (binaryLogs []string, e
 	return binaryLogs, err
 }
 
+// PurgeBinaryLogs is part of the MysqlDaemon interface. It deletes the binary
+// logs before toLog.
+func (mysqld *Mysqld) PurgeBinaryLogs(ctx context.Context, toLog string) (err error) {
+	_, err = mysqld.FetchSuperQuery(ctx, "PURGE BINARY LOGS TO "+sqltypes.EncodeStringSQL(toLog))
+	return err
+}
+
 // GetPreviousGTIDs is part of the MysqlDaemon interface.
 func (mysqld *Mysqld) GetPreviousGTIDs(ctx context.Context, binlog string) (previousGtids string, err error) {
 	query := fmt.Sprintf("SHOW BINLOG EVENTS IN '%s' LIMIT 2", binlog)
@@ -764,6 +771,24 @@ func (mysqld *Mysqld) SemiSyncClients(ctx context.Context) uint32 {
 	return uint32(count)
 }
 
+// SemiSyncWaitSessions returns the number of sessions of the primary that are
+// waiting for the acknowledgement of a semi-sync replica.
+func (mysqld *Mysqld) SemiSyncWaitSessions(ctx context.Context) uint32 {
+	vars, err := mysqld.fetchStatuses(ctx, "Rpl_semi_sync_%_wait_sessions")
+	if err != nil {
+		return 0
+	}
+	var countStr string
+	switch mysqld.SemiSyncType(ctx) {
+	case mysql.SemiSyncTypeSource:
+		countStr = vars["Rpl_semi_sync_source_wait_sessions"]
+	case mysql.SemiSyncTypeMaster:
+		countStr = vars["Rpl_semi_sync_master_wait_sessions"]
+	}
+	count, _ := strconv.ParseUint(countStr, 10, 32)
+	return uint32(count)
+}
+
 // SemiSyncSettings returns the settings of semi-sync which includes the timeout and the number of replicas to wait for.
 func (mysqld *Mysqld) SemiSyncSettings(ctx context.Context) (timeout uint64, numReplicas uint32) {
 	vars, err := mysqld.fetchVariables(ctx, "rpl_semi_sync_%")
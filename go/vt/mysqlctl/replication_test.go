@@ -686,6 +686,25 @@ func TestSemiSyncClients(t *testing.T) {
 	assert.Equal(t, uint32(12), res)
 }
 
+func TestSemiSyncWaitSessions(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+
+	params := db.ConnParams()
+	cp := *params
+	dbc := dbconfigs.NewTestDBConfigs(cp, cp, "fakesqldb")
+
+	db.AddQuery("SELECT 1", &sqltypes.Result{})
+	db.AddQuery("SHOW VARIABLES LIKE 'rpl_semi_sync_%_enabled'", sqltypes.MakeTestResult(sqltypes.MakeTestFields("field1|field2", "varchar|varchar"), "rpl_semi_sync_source_enabled|ON", "rpl_semi_sync_replica_enabled|ON"))
+	db.AddQuery("SHOW STATUS LIKE 'Rpl_semi_sync_%_wait_sessions'", sqltypes.MakeTestResult(sqltypes.MakeTestFields("field1|field2", "varchar|varchar"), "Rpl_semi_sync_source_wait_sessions|7"))
+
+	testMysqld := NewMysqld(dbc)
+	defer testMysqld.Close()
+
+	res := testMysqld.SemiSyncWaitSessions(context.Background())
+	assert.Equal(t, uint32(7), res)
+}
+
 func TestSemiSyncSettings(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
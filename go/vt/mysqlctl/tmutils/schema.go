@@ -17,8 +17,12 @@ limitations under the License.
 package tmutils
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"google.golang.org/protobuf/proto"
@@ -297,6 +301,36 @@ func DiffSchemaToArray(leftName string, left *tabletmanagerdatapb.SchemaDefiniti
 	return nil
 }
 
+// SchemaHash returns a hash of the parts of a SchemaDefinition that are
+// compared by DiffSchema, so that two schemas with the same hash have no
+// difference. Like in DiffSchema, Vitess internal tables are ignored, and so
+// is the column information of the tables.
+func SchemaHash(sd *tabletmanagerdatapb.SchemaDefinition) string {
+	h := sha256.New()
+	var buf []byte
+	write := func(s string) {
+		buf = binary.AppendUvarint(buf[:0], uint64(len(s)))
+		buf = append(buf, s...)
+		h.Write(buf)
+	}
+	if sd != nil {
+		write(sd.DatabaseSchema)
+		tds := slices.Clone(sd.TableDefinitions)
+		slices.SortStableFunc(tds, func(a, b *tabletmanagerdatapb.TableDefinition) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+		for _, td := range tds {
+			if schema.IsInternalOperationTableName(td.Name) {
+				continue
+			}
+			write(td.Name)
+			write(td.Type)
+			write(td.Schema)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 // SchemaChange contains all necessary information to apply a schema change.
 // It should not be sent over the wire, it's just a set of parameters.
 type SchemaChange struct {
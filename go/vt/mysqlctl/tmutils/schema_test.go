@@ -250,6 +250,44 @@ func TestSchemaDiff(t *testing.T) {
 	testDiff(t, sd1, sd2, "sd1", "sd2", []string{"schemas differ on table table2:\nsd1: schema2\n differs from:\nsd2: schema3"})
 }
 
+func TestSchemaHash(t *testing.T) {
+	sd := &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema:   "CREATE DATABASE {{.DatabaseName}}",
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{basicTable1, basicTable2, view1},
+	}
+	hash := SchemaHash(sd)
+	assert.Len(t, hash, 64)
+
+	// the column information and the order of the tables are not compared
+	same := proto.Clone(sd).(*tabletmanagerdatapb.SchemaDefinition)
+	same.TableDefinitions = []*tabletmanagerdatapb.TableDefinition{view1, basicTable2, basicTable1}
+	same.TableDefinitions[1] = proto.Clone(basicTable2).(*tabletmanagerdatapb.TableDefinition)
+	same.TableDefinitions[1].Columns = []string{"id"}
+	assert.Equal(t, hash, SchemaHash(same))
+
+	// neither are the Vitess internal tables
+	same.TableDefinitions = append(same.TableDefinitions, &tabletmanagerdatapb.TableDefinition{
+		Name:   "_vt_hld_6ace8bcef73211ea87e9f875a4d24e90_20200915120410_",
+		Schema: "internal table schema",
+		Type:   TableBaseTable,
+	})
+	assert.Equal(t, hash, SchemaHash(same))
+
+	different := []*tabletmanagerdatapb.SchemaDefinition{
+		{DatabaseSchema: "CREATE DATABASE {{.DatabaseName}}", TableDefinitions: []*tabletmanagerdatapb.TableDefinition{basicTable1, view1}},
+		{DatabaseSchema: "CREATE DATABASE {{.DatabaseName}}", TableDefinitions: []*tabletmanagerdatapb.TableDefinition{basicTable1, table3, view1}},
+		{DatabaseSchema: "CREATE DATABASE {{.DatabaseName}}", TableDefinitions: []*tabletmanagerdatapb.TableDefinition{basicTable1, basicTable2, view2}},
+		{DatabaseSchema: "DONT CREATE DATABASE {{.DatabaseName}}", TableDefinitions: sd.TableDefinitions},
+		{DatabaseSchema: "CREATE DATABASE {{.DatabaseName}}", TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			basicTable1, basicTable2, {Name: view1.Name, Schema: view1.Schema, Type: TableBaseTable},
+		}},
+		nil,
+	}
+	for _, d := range different {
+		assert.NotEqual(t, hash, SchemaHash(d), "%v", d)
+	}
+}
+
 func TestTableFilter(t *testing.T) {
 	includedTable := "t1"
 	includedTable2 := "t2"
@@ -149,10 +149,13 @@ func (exec *TabletExecutor) Validate(ctx context.Context, sqls []string) error {
 
 func (exec *TabletExecutor) parseDDLs(sqls []string) error {
 	for _, sql := range sqls {
-		stmt, err := exec.parser.Parse(sql)
+		stmt, comments, err := exec.parser.ParseWithConditionalComments(sql)
 		if err != nil {
 			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "failed to parse sql: %s, got error: %v", sql, err)
 		}
+		if isNoopConditionalComment(sql, stmt, comments) {
+			continue
+		}
 		switch stmt.(type) {
 		case sqlparser.DDLStatement:
 		case sqlparser.DBDDLStatement:
@@ -167,6 +170,39 @@ func (exec *TabletExecutor) parseDDLs(sqls []string) error {
 	return nil
 }
 
+// isNoopConditionalComment returns true for the statements made of MySQL conditional
+// comments that have no effect on the schema, like the ones written by mysqldump,
+// so that dumps can be applied without being edited first. These are:
+//   - statements whose conditional comments are all excluded for the MySQL version
+//     of the parser, e.g. `/*!80030 SET ... */` when parsing for MySQL 5.7
+//   - session SET statements that are wrapped in a conditional comment, e.g.
+//     `/*!40101 SET character_set_client = utf8mb4 */`, since each statement of a
+//     schema change runs on its own connection and the setting wouldn't apply to
+//     the other statements anyway.
+func isNoopConditionalComment(sql string, stmt sqlparser.Statement, comments []sqlparser.ConditionalComment) bool {
+	if len(comments) == 0 {
+		return false
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.CommentOnly:
+		return true
+	case *sqlparser.Set:
+		if len(comments) != 1 || !comments[0].Included || comments[0].Raw != strings.TrimSpace(sql) {
+			return false
+		}
+		for _, expr := range stmt.Exprs {
+			switch expr.Var.Scope {
+			case sqlparser.SessionScope, sqlparser.VariableScope, sqlparser.NoScope:
+			default:
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
 // isDirectStrategy returns 'true' when the ddl_strategy configuration implies 'direct'
 func (exec *TabletExecutor) isDirectStrategy() (isDirect bool) {
 	if exec.ddlStrategySetting == nil {
@@ -287,10 +323,14 @@ func (exec *TabletExecutor) executeSQL(ctx context.Context, sql string, provided
 		return executeViaFetch()
 	}
 	// Analyze what type of query this is:
-	stmt, err := exec.parser.Parse(sql)
+	stmt, comments, err := exec.parser.ParseWithConditionalComments(sql)
 	if err != nil {
 		return false, err
 	}
+	if isNoopConditionalComment(sql, stmt, comments) {
+		exec.logger.Infof("Skipping statement with no effect on the schema: %s", sql)
+		return false, nil
+	}
 	switch stmt := stmt.(type) {
 	case sqlparser.DDLStatement:
 		if exec.isOnlineSchemaDDL(stmt) {
@@ -143,6 +143,46 @@ func TestTabletExecutorValidate(t *testing.T) {
 		"DROP TABLE test_table_04",
 	})
 	require.NoError(t, err, "executor.Validate should succeed, drop a table with more than 2,000,000 rows is allowed")
+
+	// the session settings of mysqldump have no effect on the schema and are allowed
+	err = executor.Validate(ctx, []string{
+		"/*!40101 SET @saved_cs_client = @@character_set_client */",
+		"/*!50503 SET character_set_client = utf8mb4 */",
+		"CREATE TABLE test_table_05 (pk int)",
+		"/*!40101 SET character_set_client = @saved_cs_client */",
+	})
+	require.NoError(t, err, "executor.Validate should succeed, session settings in conditional comments are allowed")
+
+	err = executor.Validate(ctx, []string{
+		"/*!40101 SET GLOBAL read_only = 1 */",
+	})
+	require.Error(t, err, "global settings are not schema changes")
+}
+
+func TestIsNoopConditionalComment(t *testing.T) {
+	parser, err := sqlparser.New(sqlparser.Options{MySQLServerVersion: "5.7.9"})
+	require.NoError(t, err)
+
+	tcases := []struct {
+		sql    string
+		expect bool
+	}{
+		{"/*!40101 SET NAMES utf8mb4 */", true},
+		{"/*!40101 SET @OLD_SQL_MODE = @@SQL_MODE, SQL_MODE = 'NO_AUTO_VALUE_ON_ZERO' */", true},
+		{"/*!80030 SET GLOBAL innodb_redo_log_capacity = 1073741824 */", true},
+		{"/*!40101 SET GLOBAL read_only = 1 */", false},
+		{"SET character_set_client = utf8mb4", false},
+		{"SET /*!40101 character_set_client = utf8mb4 */", false},
+		{"/* regular comment */", false},
+		{"CREATE TABLE t (id int) /*!50100 PARTITION BY HASH (id) */", false},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.sql, func(t *testing.T) {
+			stmt, comments, err := parser.ParseWithConditionalComments(tcase.sql)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.expect, isNoopConditionalComment(tcase.sql, stmt, comments))
+		})
+	}
 }
 
 func TestTabletExecutorDML(t *testing.T) {
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servenv
+
+import (
+	"github.com/spf13/pflag"
+
+	"vitess.io/vitess/go/mysql/collations/colldata"
+	"vitess.io/vitess/go/vt/log"
+)
+
+var (
+	// collationDataPath is the path of the weight tables of the UCA collations.
+	collationDataPath string
+	// loadCollationData is set for the binaries that evaluate collations, which
+	// load their weight tables on startup.
+	loadCollationData bool
+)
+
+func registerCollationFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&collationDataPath, "collation-data-path", collationDataPath, "path of the file with the weight tables of the UCA collations, or of a directory with a mysqlucadata.bin file (default $"+colldata.ExternalDataEnv+", or the data embedded in the binary)")
+	loadCollationData = true
+}
+
+// loadCollations loads the weight tables of the UCA collations, so that a
+// missing or invalid collation data file fails the startup rather than the
+// queries that use a UCA collation.
+func loadCollations() {
+	if !loadCollationData {
+		return
+	}
+	if collationDataPath != "" {
+		if err := colldata.SetExternalDataPath(collationDataPath); err != nil {
+			log.Exitf("--collation-data-path: %v", err)
+		}
+	}
+	if err := colldata.LoadWeights(); err != nil {
+		log.Exitf("failed to load the collation data: %v", err)
+	}
+	source, _ := colldata.DataSource()
+	log.Infof("loaded the collation data from %v data", source)
+}
+
+func init() {
+	for _, cmd := range []string{
+		"vtcombo",
+		"vtctld",
+		"vtexplain",
+		"vtgate",
+		"vttablet",
+		"vttestserver",
+	} {
+		OnParseFor(cmd, registerCollationFlags)
+	}
+	OnInit(loadCollations)
+}
@@ -313,6 +313,8 @@ type (
 	}
 
 	// VStream represents a VSTREAM statement.
+	// The comparisons of its WHERE clause set options of the stream rather than
+	// filter its rows, e.g. pos > '<position>' sets the position to stream from.
 	VStream struct {
 		Comments   *ParsedComments
 		SelectExpr SelectExpr
@@ -568,8 +570,46 @@ type (
 	// DDLAction is an enum for DDL.Action
 	DDLAction int8
 
-	// Load represents a LOAD statement
+	// Load represents a LOAD DATA statement, or a LOAD DATA FROM S3 statement
+	// of Aurora, whose Table can be empty.
 	Load struct {
+		Priority    LoadPriority
+		Local       bool
+		Source      LoadSource
+		FileName    string
+		Duplicate   LoadDuplicate
+		Table       TableName
+		Partitions  Partitions
+		Charset     ColumnCharset
+		Fields      *LoadFields
+		Lines       *LoadLines
+		IgnoreLines *Literal
+		// Columns are the columns or user variables the fields are assigned to.
+		Columns  Exprs
+		SetExprs UpdateExprs
+	}
+
+	// LoadPriority is an enum for Load.Priority
+	LoadPriority int8
+
+	// LoadDuplicate is an enum for Load.Duplicate
+	LoadDuplicate int8
+
+	// LoadSource is an enum for Load.Source
+	LoadSource int8
+
+	// LoadFields represents the FIELDS or COLUMNS clause of a LOAD DATA statement
+	LoadFields struct {
+		TerminatedBy *Literal
+		Optionally   bool
+		EnclosedBy   *Literal
+		EscapedBy    *Literal
+	}
+
+	// LoadLines represents the LINES clause of a LOAD DATA statement
+	LoadLines struct {
+		StartingBy   *Literal
+		TerminatedBy *Literal
 	}
 
 	// PurgeBinaryLogs represents a PURGE BINARY LOGS statement
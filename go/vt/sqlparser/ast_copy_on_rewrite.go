@@ -306,6 +306,10 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfLiteral(n, parent)
 	case *Load:
 		return c.copyOnRewriteRefOfLoad(n, parent)
+	case *LoadFields:
+		return c.copyOnRewriteRefOfLoadFields(n, parent)
+	case *LoadLines:
+		return c.copyOnRewriteRefOfLoadLines(n, parent)
 	case *LocateExpr:
 		return c.copyOnRewriteRefOfLocateExpr(n, parent)
 	case *LockOption:
@@ -573,13 +577,17 @@ func (c *cow) copyOnRewriteRefOfAddColumns(n *AddColumns, parent SQLNode) (out S
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
+		_Columns := n.Columns
 		var changedColumns bool
-		_Columns := make([]*ColumnDefinition, len(n.Columns))
 		for x, el := range n.Columns {
 			this, changed := c.copyOnRewriteRefOfColumnDefinition(el, n)
-			_Columns[x] = this.(*ColumnDefinition)
 			if changed {
-				changedColumns = true
+				if !changedColumns {
+					_Columns = make([]*ColumnDefinition, len(n.Columns))
+					copy(_Columns, n.Columns)
+					changedColumns = true
+				}
+				_Columns[x] = this.(*ColumnDefinition)
 			}
 		}
 		_After, changedAfter := c.copyOnRewriteRefOfColName(n.After, n)
@@ -828,13 +836,17 @@ func (c *cow) copyOnRewriteRefOfAlterTable(n *AlterTable, parent SQLNode) (out S
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
 		_Table, changedTable := c.copyOnRewriteTableName(n.Table, n)
+		_AlterOptions := n.AlterOptions
 		var changedAlterOptions bool
-		_AlterOptions := make([]AlterOption, len(n.AlterOptions))
 		for x, el := range n.AlterOptions {
 			this, changed := c.copyOnRewriteAlterOption(el, n)
-			_AlterOptions[x] = this.(AlterOption)
 			if changed {
-				changedAlterOptions = true
+				if !changedAlterOptions {
+					_AlterOptions = make([]AlterOption, len(n.AlterOptions))
+					copy(_AlterOptions, n.AlterOptions)
+					changedAlterOptions = true
+				}
+				_AlterOptions[x] = this.(AlterOption)
 			}
 		}
 		_PartitionSpec, changedPartitionSpec := c.copyOnRewriteRefOfPartitionSpec(n.PartitionSpec, n)
@@ -897,13 +909,17 @@ func (c *cow) copyOnRewriteRefOfAlterVschema(n *AlterVschema, parent SQLNode) (o
 	if c.pre == nil || c.pre(n, parent) {
 		_Table, changedTable := c.copyOnRewriteTableName(n.Table, n)
 		_VindexSpec, changedVindexSpec := c.copyOnRewriteRefOfVindexSpec(n.VindexSpec, n)
+		_VindexCols := n.VindexCols
 		var changedVindexCols bool
-		_VindexCols := make([]IdentifierCI, len(n.VindexCols))
 		for x, el := range n.VindexCols {
 			this, changed := c.copyOnRewriteIdentifierCI(el, n)
-			_VindexCols[x] = this.(IdentifierCI)
 			if changed {
-				changedVindexCols = true
+				if !changedVindexCols {
+					_VindexCols = make([]IdentifierCI, len(n.VindexCols))
+					copy(_VindexCols, n.VindexCols)
+					changedVindexCols = true
+				}
+				_VindexCols[x] = this.(IdentifierCI)
 			}
 		}
 		_AutoIncSpec, changedAutoIncSpec := c.copyOnRewriteRefOfAutoIncSpec(n.AutoIncSpec, n)
@@ -1264,13 +1280,17 @@ func (c *cow) copyOnRewriteRefOfCaseExpr(n *CaseExpr, parent SQLNode) (out SQLNo
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
 		_Expr, changedExpr := c.copyOnRewriteExpr(n.Expr, n)
+		_Whens := n.Whens
 		var changedWhens bool
-		_Whens := make([]*When, len(n.Whens))
 		for x, el := range n.Whens {
 			this, changed := c.copyOnRewriteRefOfWhen(el, n)
-			_Whens[x] = this.(*When)
 			if changed {
-				changedWhens = true
+				if !changedWhens {
+					_Whens = make([]*When, len(n.Whens))
+					copy(_Whens, n.Whens)
+					changedWhens = true
+				}
+				_Whens[x] = this.(*When)
 			}
 		}
 		_Else, changedElse := c.copyOnRewriteExpr(n.Else, n)
@@ -1473,12 +1493,16 @@ func (c *cow) copyOnRewriteColumns(n Columns, parent SQLNode) (out SQLNode, chan
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(Columns, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteIdentifierCI(el, n)
-			res[x] = this.(IdentifierCI)
 			if change {
-				changed = true
+				if !changed {
+					res = make(Columns, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(IdentifierCI)
 			}
 		}
 		if changed {
@@ -1854,13 +1878,17 @@ func (c *cow) copyOnRewriteRefOfDelete(n *Delete, parent SQLNode) (out SQLNode,
 	if c.pre == nil || c.pre(n, parent) {
 		_With, changedWith := c.copyOnRewriteRefOfWith(n.With, n)
 		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		_TableExprs := n.TableExprs
 		var changedTableExprs bool
-		_TableExprs := make([]TableExpr, len(n.TableExprs))
 		for x, el := range n.TableExprs {
 			this, changed := c.copyOnRewriteTableExpr(el, n)
-			_TableExprs[x] = this.(TableExpr)
 			if changed {
-				changedTableExprs = true
+				if !changedTableExprs {
+					_TableExprs = make([]TableExpr, len(n.TableExprs))
+					copy(_TableExprs, n.TableExprs)
+					changedTableExprs = true
+				}
+				_TableExprs[x] = this.(TableExpr)
 			}
 		}
 		_Targets, changedTargets := c.copyOnRewriteTableNames(n.Targets, n)
@@ -2036,13 +2064,17 @@ func (c *cow) copyOnRewriteRefOfExecuteStmt(n *ExecuteStmt, parent SQLNode) (out
 	if c.pre == nil || c.pre(n, parent) {
 		_Name, changedName := c.copyOnRewriteIdentifierCI(n.Name, n)
 		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		_Arguments := n.Arguments
 		var changedArguments bool
-		_Arguments := make([]*Variable, len(n.Arguments))
 		for x, el := range n.Arguments {
 			this, changed := c.copyOnRewriteRefOfVariable(el, n)
-			_Arguments[x] = this.(*Variable)
 			if changed {
-				changedArguments = true
+				if !changedArguments {
+					_Arguments = make([]*Variable, len(n.Arguments))
+					copy(_Arguments, n.Arguments)
+					changedArguments = true
+				}
+				_Arguments[x] = this.(*Variable)
 			}
 		}
 		if changedName || changedComments || changedArguments {
@@ -2136,12 +2168,16 @@ func (c *cow) copyOnRewriteExprs(n Exprs, parent SQLNode) (out SQLNode, changed
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(Exprs, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteExpr(el, n)
-			res[x] = this.(Expr)
 			if change {
-				changed = true
+				if !changed {
+					res = make(Exprs, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(Expr)
 			}
 		}
 		if changed {
@@ -2651,13 +2687,17 @@ func (c *cow) copyOnRewriteRefOfGroupBy(n *GroupBy, parent SQLNode) (out SQLNode
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
+		_Exprs := n.Exprs
 		var changedExprs bool
-		_Exprs := make([]Expr, len(n.Exprs))
 		for x, el := range n.Exprs {
 			this, changed := c.copyOnRewriteExpr(el, n)
-			_Exprs[x] = this.(Expr)
 			if changed {
-				changedExprs = true
+				if !changedExprs {
+					_Exprs = make([]Expr, len(n.Exprs))
+					copy(_Exprs, n.Exprs)
+					changedExprs = true
+				}
+				_Exprs[x] = this.(Expr)
 			}
 		}
 		if changedExprs {
@@ -2747,13 +2787,17 @@ func (c *cow) copyOnRewriteRefOfIndexHint(n *IndexHint, parent SQLNode) (out SQL
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
+		_Indexes := n.Indexes
 		var changedIndexes bool
-		_Indexes := make([]IdentifierCI, len(n.Indexes))
 		for x, el := range n.Indexes {
 			this, changed := c.copyOnRewriteIdentifierCI(el, n)
-			_Indexes[x] = this.(IdentifierCI)
 			if changed {
-				changedIndexes = true
+				if !changedIndexes {
+					_Indexes = make([]IdentifierCI, len(n.Indexes))
+					copy(_Indexes, n.Indexes)
+					changedIndexes = true
+				}
+				_Indexes[x] = this.(IdentifierCI)
 			}
 		}
 		if changedIndexes {
@@ -2777,12 +2821,16 @@ func (c *cow) copyOnRewriteIndexHints(n IndexHints, parent SQLNode) (out SQLNode
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(IndexHints, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteRefOfIndexHint(el, n)
-			res[x] = this.(*IndexHint)
 			if change {
-				changed = true
+				if !changed {
+					res = make(IndexHints, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(*IndexHint)
 			}
 		}
 		if changed {
@@ -3050,13 +3098,17 @@ func (c *cow) copyOnRewriteRefOfJSONContainsExpr(n *JSONContainsExpr, parent SQL
 	if c.pre == nil || c.pre(n, parent) {
 		_Target, changedTarget := c.copyOnRewriteExpr(n.Target, n)
 		_Candidate, changedCandidate := c.copyOnRewriteExpr(n.Candidate, n)
+		_PathList := n.PathList
 		var changedPathList bool
-		_PathList := make([]Expr, len(n.PathList))
 		for x, el := range n.PathList {
 			this, changed := c.copyOnRewriteExpr(el, n)
-			_PathList[x] = this.(Expr)
 			if changed {
-				changedPathList = true
+				if !changedPathList {
+					_PathList = make([]Expr, len(n.PathList))
+					copy(_PathList, n.PathList)
+					changedPathList = true
+				}
+				_PathList[x] = this.(Expr)
 			}
 		}
 		if changedTarget || changedCandidate || changedPathList {
@@ -3084,13 +3136,17 @@ func (c *cow) copyOnRewriteRefOfJSONContainsPathExpr(n *JSONContainsPathExpr, pa
 	if c.pre == nil || c.pre(n, parent) {
 		_JSONDoc, changedJSONDoc := c.copyOnRewriteExpr(n.JSONDoc, n)
 		_OneOrAll, changedOneOrAll := c.copyOnRewriteExpr(n.OneOrAll, n)
+		_PathList := n.PathList
 		var changedPathList bool
-		_PathList := make([]Expr, len(n.PathList))
 		for x, el := range n.PathList {
 			this, changed := c.copyOnRewriteExpr(el, n)
-			_PathList[x] = this.(Expr)
 			if changed {
-				changedPathList = true
+				if !changedPathList {
+					_PathList = make([]Expr, len(n.PathList))
+					copy(_PathList, n.PathList)
+					changedPathList = true
+				}
+				_PathList[x] = this.(Expr)
 			}
 		}
 		if changedJSONDoc || changedOneOrAll || changedPathList {
@@ -3117,13 +3173,17 @@ func (c *cow) copyOnRewriteRefOfJSONExtractExpr(n *JSONExtractExpr, parent SQLNo
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
 		_JSONDoc, changedJSONDoc := c.copyOnRewriteExpr(n.JSONDoc, n)
+		_PathList := n.PathList
 		var changedPathList bool
-		_PathList := make([]Expr, len(n.PathList))
 		for x, el := range n.PathList {
 			this, changed := c.copyOnRewriteExpr(el, n)
-			_PathList[x] = this.(Expr)
 			if changed {
-				changedPathList = true
+				if !changedPathList {
+					_PathList = make([]Expr, len(n.PathList))
+					copy(_PathList, n.PathList)
+					changedPathList = true
+				}
+				_PathList[x] = this.(Expr)
 			}
 		}
 		if changedJSONDoc || changedPathList {
@@ -3198,13 +3258,17 @@ func (c *cow) copyOnRewriteRefOfJSONObjectExpr(n *JSONObjectExpr, parent SQLNode
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
+		_Params := n.Params
 		var changedParams bool
-		_Params := make([]*JSONObjectParam, len(n.Params))
 		for x, el := range n.Params {
 			this, changed := c.copyOnRewriteRefOfJSONObjectParam(el, n)
-			_Params[x] = this.(*JSONObjectParam)
 			if changed {
-				changedParams = true
+				if !changedParams {
+					_Params = make([]*JSONObjectParam, len(n.Params))
+					copy(_Params, n.Params)
+					changedParams = true
+				}
+				_Params[x] = this.(*JSONObjectParam)
 			}
 		}
 		if changedParams {
@@ -3396,13 +3460,17 @@ func (c *cow) copyOnRewriteRefOfJSONSearchExpr(n *JSONSearchExpr, parent SQLNode
 		_OneOrAll, changedOneOrAll := c.copyOnRewriteExpr(n.OneOrAll, n)
 		_SearchStr, changedSearchStr := c.copyOnRewriteExpr(n.SearchStr, n)
 		_EscapeChar, changedEscapeChar := c.copyOnRewriteExpr(n.EscapeChar, n)
+		_PathList := n.PathList
 		var changedPathList bool
-		_PathList := make([]Expr, len(n.PathList))
 		for x, el := range n.PathList {
 			this, changed := c.copyOnRewriteExpr(el, n)
-			_PathList[x] = this.(Expr)
 			if changed {
-				changedPathList = true
+				if !changedPathList {
+					_PathList = make([]Expr, len(n.PathList))
+					copy(_PathList, n.PathList)
+					changedPathList = true
+				}
+				_PathList[x] = this.(Expr)
 			}
 		}
 		if changedJSONDoc || changedOneOrAll || changedSearchStr || changedEscapeChar || changedPathList {
@@ -3477,13 +3545,17 @@ func (c *cow) copyOnRewriteRefOfJSONTableExpr(n *JSONTableExpr, parent SQLNode)
 		_Expr, changedExpr := c.copyOnRewriteExpr(n.Expr, n)
 		_Alias, changedAlias := c.copyOnRewriteIdentifierCS(n.Alias, n)
 		_Filter, changedFilter := c.copyOnRewriteExpr(n.Filter, n)
+		_Columns := n.Columns
 		var changedColumns bool
-		_Columns := make([]*JtColumnDefinition, len(n.Columns))
 		for x, el := range n.Columns {
 			this, changed := c.copyOnRewriteRefOfJtColumnDefinition(el, n)
-			_Columns[x] = this.(*JtColumnDefinition)
 			if changed {
-				changedColumns = true
+				if !changedColumns {
+					_Columns = make([]*JtColumnDefinition, len(n.Columns))
+					copy(_Columns, n.Columns)
+					changedColumns = true
+				}
+				_Columns[x] = this.(*JtColumnDefinition)
 			}
 		}
 		if changedExpr || changedAlias || changedFilter || changedColumns {
@@ -3587,13 +3659,17 @@ func (c *cow) copyOnRewriteRefOfJSONValueModifierExpr(n *JSONValueModifierExpr,
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
 		_JSONDoc, changedJSONDoc := c.copyOnRewriteExpr(n.JSONDoc, n)
+		_Params := n.Params
 		var changedParams bool
-		_Params := make([]*JSONObjectParam, len(n.Params))
 		for x, el := range n.Params {
 			this, changed := c.copyOnRewriteRefOfJSONObjectParam(el, n)
-			_Params[x] = this.(*JSONObjectParam)
 			if changed {
-				changedParams = true
+				if !changedParams {
+					_Params = make([]*JSONObjectParam, len(n.Params))
+					copy(_Params, n.Params)
+					changedParams = true
+				}
+				_Params[x] = this.(*JSONObjectParam)
 			}
 		}
 		if changedJSONDoc || changedParams {
@@ -3838,6 +3914,78 @@ func (c *cow) copyOnRewriteRefOfLoad(n *Load, parent SQLNode) (out SQLNode, chan
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
+		_Table, changedTable := c.copyOnRewriteTableName(n.Table, n)
+		_Partitions, changedPartitions := c.copyOnRewritePartitions(n.Partitions, n)
+		_Fields, changedFields := c.copyOnRewriteRefOfLoadFields(n.Fields, n)
+		_Lines, changedLines := c.copyOnRewriteRefOfLoadLines(n.Lines, n)
+		_IgnoreLines, changedIgnoreLines := c.copyOnRewriteRefOfLiteral(n.IgnoreLines, n)
+		_Columns, changedColumns := c.copyOnRewriteExprs(n.Columns, n)
+		_SetExprs, changedSetExprs := c.copyOnRewriteUpdateExprs(n.SetExprs, n)
+		if changedTable || changedPartitions || changedFields || changedLines || changedIgnoreLines || changedColumns || changedSetExprs {
+			res := *n
+			res.Table, _ = _Table.(TableName)
+			res.Partitions, _ = _Partitions.(Partitions)
+			res.Fields, _ = _Fields.(*LoadFields)
+			res.Lines, _ = _Lines.(*LoadLines)
+			res.IgnoreLines, _ = _IgnoreLines.(*Literal)
+			res.Columns, _ = _Columns.(Exprs)
+			res.SetExprs, _ = _SetExprs.(UpdateExprs)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfLoadFields(n *LoadFields, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_TerminatedBy, changedTerminatedBy := c.copyOnRewriteRefOfLiteral(n.TerminatedBy, n)
+		_EnclosedBy, changedEnclosedBy := c.copyOnRewriteRefOfLiteral(n.EnclosedBy, n)
+		_EscapedBy, changedEscapedBy := c.copyOnRewriteRefOfLiteral(n.EscapedBy, n)
+		if changedTerminatedBy || changedEnclosedBy || changedEscapedBy {
+			res := *n
+			res.TerminatedBy, _ = _TerminatedBy.(*Literal)
+			res.EnclosedBy, _ = _EnclosedBy.(*Literal)
+			res.EscapedBy, _ = _EscapedBy.(*Literal)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfLoadLines(n *LoadLines, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_StartingBy, changedStartingBy := c.copyOnRewriteRefOfLiteral(n.StartingBy, n)
+		_TerminatedBy, changedTerminatedBy := c.copyOnRewriteRefOfLiteral(n.TerminatedBy, n)
+		if changedStartingBy || changedTerminatedBy {
+			res := *n
+			res.StartingBy, _ = _StartingBy.(*Literal)
+			res.TerminatedBy, _ = _TerminatedBy.(*Literal)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
 	}
 	if c.post != nil {
 		out, changed = c.postVisit(out, parent, changed)
@@ -3924,13 +4072,17 @@ func (c *cow) copyOnRewriteRefOfMatchExpr(n *MatchExpr, parent SQLNode) (out SQL
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
+		_Columns := n.Columns
 		var changedColumns bool
-		_Columns := make([]*ColName, len(n.Columns))
 		for x, el := range n.Columns {
 			this, changed := c.copyOnRewriteRefOfColName(el, n)
-			_Columns[x] = this.(*ColName)
 			if changed {
-				changedColumns = true
+				if !changedColumns {
+					_Columns = make([]*ColName, len(n.Columns))
+					copy(_Columns, n.Columns)
+					changedColumns = true
+				}
+				_Columns[x] = this.(*ColName)
 			}
 		}
 		_Expr, changedExpr := c.copyOnRewriteExpr(n.Expr, n)
@@ -4170,12 +4322,16 @@ func (c *cow) copyOnRewriteNamedWindows(n NamedWindows, parent SQLNode) (out SQL
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(NamedWindows, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteRefOfNamedWindow(el, n)
-			res[x] = this.(*NamedWindow)
 			if change {
-				changed = true
+				if !changed {
+					res = make(NamedWindows, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(*NamedWindow)
 			}
 		}
 		if changed {
@@ -4307,12 +4463,16 @@ func (c *cow) copyOnRewriteOnDup(n OnDup, parent SQLNode) (out SQLNode, changed
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(OnDup, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteRefOfUpdateExpr(el, n)
-			res[x] = this.(*UpdateExpr)
 			if change {
-				changed = true
+				if !changed {
+					res = make(OnDup, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(*UpdateExpr)
 			}
 		}
 		if changed {
@@ -4398,12 +4558,16 @@ func (c *cow) copyOnRewriteOrderBy(n OrderBy, parent SQLNode) (out SQLNode, chan
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(OrderBy, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteRefOfOrder(el, n)
-			res[x] = this.(*Order)
 			if change {
-				changed = true
+				if !changed {
+					res = make(OrderBy, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(*Order)
 			}
 		}
 		if changed {
@@ -4584,13 +4748,17 @@ func (c *cow) copyOnRewriteRefOfPartitionOption(n *PartitionOption, parent SQLNo
 		_ColList, changedColList := c.copyOnRewriteColumns(n.ColList, n)
 		_Expr, changedExpr := c.copyOnRewriteExpr(n.Expr, n)
 		_SubPartition, changedSubPartition := c.copyOnRewriteRefOfSubPartition(n.SubPartition, n)
+		_Definitions := n.Definitions
 		var changedDefinitions bool
-		_Definitions := make([]*PartitionDefinition, len(n.Definitions))
 		for x, el := range n.Definitions {
 			this, changed := c.copyOnRewriteRefOfPartitionDefinition(el, n)
-			_Definitions[x] = this.(*PartitionDefinition)
 			if changed {
-				changedDefinitions = true
+				if !changedDefinitions {
+					_Definitions = make([]*PartitionDefinition, len(n.Definitions))
+					copy(_Definitions, n.Definitions)
+					changedDefinitions = true
+				}
+				_Definitions[x] = this.(*PartitionDefinition)
 			}
 		}
 		if changedColList || changedExpr || changedSubPartition || changedDefinitions {
@@ -4620,13 +4788,17 @@ func (c *cow) copyOnRewriteRefOfPartitionSpec(n *PartitionSpec, parent SQLNode)
 		_Names, changedNames := c.copyOnRewritePartitions(n.Names, n)
 		_Number, changedNumber := c.copyOnRewriteRefOfLiteral(n.Number, n)
 		_TableName, changedTableName := c.copyOnRewriteTableName(n.TableName, n)
+		_Definitions := n.Definitions
 		var changedDefinitions bool
-		_Definitions := make([]*PartitionDefinition, len(n.Definitions))
 		for x, el := range n.Definitions {
 			this, changed := c.copyOnRewriteRefOfPartitionDefinition(el, n)
-			_Definitions[x] = this.(*PartitionDefinition)
 			if changed {
-				changedDefinitions = true
+				if !changedDefinitions {
+					_Definitions = make([]*PartitionDefinition, len(n.Definitions))
+					copy(_Definitions, n.Definitions)
+					changedDefinitions = true
+				}
+				_Definitions[x] = this.(*PartitionDefinition)
 			}
 		}
 		if changedNames || changedNumber || changedTableName || changedDefinitions {
@@ -4675,12 +4847,16 @@ func (c *cow) copyOnRewritePartitions(n Partitions, parent SQLNode) (out SQLNode
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(Partitions, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteIdentifierCI(el, n)
-			res[x] = this.(IdentifierCI)
 			if change {
-				changed = true
+				if !changed {
+					res = make(Partitions, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(IdentifierCI)
 			}
 		}
 		if changed {
@@ -5228,13 +5404,17 @@ func (c *cow) copyOnRewriteRefOfSelect(n *Select, parent SQLNode) (out SQLNode,
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
 		_With, changedWith := c.copyOnRewriteRefOfWith(n.With, n)
+		_From := n.From
 		var changedFrom bool
-		_From := make([]TableExpr, len(n.From))
 		for x, el := range n.From {
 			this, changed := c.copyOnRewriteTableExpr(el, n)
-			_From[x] = this.(TableExpr)
 			if changed {
-				changedFrom = true
+				if !changedFrom {
+					_From = make([]TableExpr, len(n.From))
+					copy(_From, n.From)
+					changedFrom = true
+				}
+				_From[x] = this.(TableExpr)
 			}
 		}
 		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
@@ -5277,12 +5457,16 @@ func (c *cow) copyOnRewriteSelectExprs(n SelectExprs, parent SQLNode) (out SQLNo
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(SelectExprs, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteSelectExpr(el, n)
-			res[x] = this.(SelectExpr)
 			if change {
-				changed = true
+				if !changed {
+					res = make(SelectExprs, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(SelectExpr)
 			}
 		}
 		if changed {
@@ -5360,12 +5544,16 @@ func (c *cow) copyOnRewriteSetExprs(n SetExprs, parent SQLNode) (out SQLNode, ch
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(SetExprs, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteRefOfSetExpr(el, n)
-			res[x] = this.(*SetExpr)
 			if change {
-				changed = true
+				if !changed {
+					res = make(SetExprs, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(*SetExpr)
 			}
 		}
 		if changed {
@@ -5765,12 +5953,16 @@ func (c *cow) copyOnRewriteSubPartitionDefinitions(n SubPartitionDefinitions, pa
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(SubPartitionDefinitions, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteRefOfSubPartitionDefinition(el, n)
-			res[x] = this.(*SubPartitionDefinition)
 			if change {
-				changed = true
+				if !changed {
+					res = make(SubPartitionDefinitions, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(*SubPartitionDefinition)
 			}
 		}
 		if changed {
@@ -5860,12 +6052,16 @@ func (c *cow) copyOnRewriteTableExprs(n TableExprs, parent SQLNode) (out SQLNode
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(TableExprs, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteTableExpr(el, n)
-			res[x] = this.(TableExpr)
 			if change {
-				changed = true
+				if !changed {
+					res = make(TableExprs, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(TableExpr)
 			}
 		}
 		if changed {
@@ -5904,12 +6100,16 @@ func (c *cow) copyOnRewriteTableNames(n TableNames, parent SQLNode) (out SQLNode
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(TableNames, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteTableName(el, n)
-			res[x] = this.(TableName)
 			if change {
-				changed = true
+				if !changed {
+					res = make(TableNames, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(TableName)
 			}
 		}
 		if changed {
@@ -5940,31 +6140,43 @@ func (c *cow) copyOnRewriteRefOfTableSpec(n *TableSpec, parent SQLNode) (out SQL
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
+		_Columns := n.Columns
 		var changedColumns bool
-		_Columns := make([]*ColumnDefinition, len(n.Columns))
 		for x, el := range n.Columns {
 			this, changed := c.copyOnRewriteRefOfColumnDefinition(el, n)
-			_Columns[x] = this.(*ColumnDefinition)
 			if changed {
-				changedColumns = true
+				if !changedColumns {
+					_Columns = make([]*ColumnDefinition, len(n.Columns))
+					copy(_Columns, n.Columns)
+					changedColumns = true
+				}
+				_Columns[x] = this.(*ColumnDefinition)
 			}
 		}
+		_Indexes := n.Indexes
 		var changedIndexes bool
-		_Indexes := make([]*IndexDefinition, len(n.Indexes))
 		for x, el := range n.Indexes {
 			this, changed := c.copyOnRewriteRefOfIndexDefinition(el, n)
-			_Indexes[x] = this.(*IndexDefinition)
 			if changed {
-				changedIndexes = true
+				if !changedIndexes {
+					_Indexes = make([]*IndexDefinition, len(n.Indexes))
+					copy(_Indexes, n.Indexes)
+					changedIndexes = true
+				}
+				_Indexes[x] = this.(*IndexDefinition)
 			}
 		}
+		_Constraints := n.Constraints
 		var changedConstraints bool
-		_Constraints := make([]*ConstraintDefinition, len(n.Constraints))
 		for x, el := range n.Constraints {
 			this, changed := c.copyOnRewriteRefOfConstraintDefinition(el, n)
-			_Constraints[x] = this.(*ConstraintDefinition)
 			if changed {
-				changedConstraints = true
+				if !changedConstraints {
+					_Constraints = make([]*ConstraintDefinition, len(n.Constraints))
+					copy(_Constraints, n.Constraints)
+					changedConstraints = true
+				}
+				_Constraints[x] = this.(*ConstraintDefinition)
 			}
 		}
 		_Options, changedOptions := c.copyOnRewriteTableOptions(n.Options, n)
@@ -6144,13 +6356,17 @@ func (c *cow) copyOnRewriteRefOfUpdate(n *Update, parent SQLNode) (out SQLNode,
 	if c.pre == nil || c.pre(n, parent) {
 		_With, changedWith := c.copyOnRewriteRefOfWith(n.With, n)
 		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		_TableExprs := n.TableExprs
 		var changedTableExprs bool
-		_TableExprs := make([]TableExpr, len(n.TableExprs))
 		for x, el := range n.TableExprs {
 			this, changed := c.copyOnRewriteTableExpr(el, n)
-			_TableExprs[x] = this.(TableExpr)
 			if changed {
-				changedTableExprs = true
+				if !changedTableExprs {
+					_TableExprs = make([]TableExpr, len(n.TableExprs))
+					copy(_TableExprs, n.TableExprs)
+					changedTableExprs = true
+				}
+				_TableExprs[x] = this.(TableExpr)
 			}
 		}
 		_Exprs, changedExprs := c.copyOnRewriteUpdateExprs(n.Exprs, n)
@@ -6208,12 +6424,16 @@ func (c *cow) copyOnRewriteUpdateExprs(n UpdateExprs, parent SQLNode) (out SQLNo
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(UpdateExprs, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteRefOfUpdateExpr(el, n)
-			res[x] = this.(*UpdateExpr)
 			if change {
-				changed = true
+				if !changed {
+					res = make(UpdateExprs, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(*UpdateExpr)
 			}
 		}
 		if changed {
@@ -6333,12 +6553,16 @@ func (c *cow) copyOnRewriteValTuple(n ValTuple, parent SQLNode) (out SQLNode, ch
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(ValTuple, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteExpr(el, n)
-			res[x] = this.(Expr)
 			if change {
-				changed = true
+				if !changed {
+					res = make(ValTuple, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(Expr)
 			}
 		}
 		if changed {
@@ -6368,12 +6592,16 @@ func (c *cow) copyOnRewriteValues(n Values, parent SQLNode) (out SQLNode, change
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(Values, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteValTuple(el, n)
-			res[x] = this.(ValTuple)
 			if change {
-				changed = true
+				if !changed {
+					res = make(Values, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(ValTuple)
 			}
 		}
 		if changed {
@@ -6528,13 +6756,17 @@ func (c *cow) copyOnRewriteRefOfVindexSpec(n *VindexSpec, parent SQLNode) (out S
 	if c.pre == nil || c.pre(n, parent) {
 		_Name, changedName := c.copyOnRewriteIdentifierCI(n.Name, n)
 		_Type, changedType := c.copyOnRewriteIdentifierCI(n.Type, n)
+		_Params := n.Params
 		var changedParams bool
-		_Params := make([]VindexParam, len(n.Params))
 		for x, el := range n.Params {
 			this, changed := c.copyOnRewriteVindexParam(el, n)
-			_Params[x] = this.(VindexParam)
 			if changed {
-				changedParams = true
+				if !changedParams {
+					_Params = make([]VindexParam, len(n.Params))
+					copy(_Params, n.Params)
+					changedParams = true
+				}
+				_Params[x] = this.(VindexParam)
 			}
 		}
 		if changedName || changedType || changedParams {
@@ -6654,12 +6886,16 @@ func (c *cow) copyOnRewriteWindowDefinitions(n WindowDefinitions, parent SQLNode
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		res := make(WindowDefinitions, len(n))
+		res := n
 		for x, el := range n {
 			this, change := c.copyOnRewriteRefOfWindowDefinition(el, n)
-			res[x] = this.(*WindowDefinition)
 			if change {
-				changed = true
+				if !changed {
+					res = make(WindowDefinitions, len(n))
+					copy(res, n)
+					changed = true
+				}
+				res[x] = this.(*WindowDefinition)
 			}
 		}
 		if changed {
@@ -6705,13 +6941,17 @@ func (c *cow) copyOnRewriteRefOfWith(n *With, parent SQLNode) (out SQLNode, chan
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
+		_CTEs := n.CTEs
 		var changedCTEs bool
-		_CTEs := make([]*CommonTableExpr, len(n.CTEs))
 		for x, el := range n.CTEs {
 			this, changed := c.copyOnRewriteRefOfCommonTableExpr(el, n)
-			_CTEs[x] = this.(*CommonTableExpr)
 			if changed {
-				changedCTEs = true
+				if !changedCTEs {
+					_CTEs = make([]*CommonTableExpr, len(n.CTEs))
+					copy(_CTEs, n.CTEs)
+					changedCTEs = true
+				}
+				_CTEs[x] = this.(*CommonTableExpr)
 			}
 		}
 		if changedCTEs {
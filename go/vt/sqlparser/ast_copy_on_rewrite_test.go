@@ -21,6 +21,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 func TestCopyOnRewrite(t *testing.T) {
@@ -126,3 +128,144 @@ func TestStopTreeWalkButStillVisit(t *testing.T) {
 	assert.Equal(t, original, String(expr))
 	assert.Equal(t, "1337 = 'johnny was here'", String(out)) // b + c are replaced
 }
+
+func TestCopyOnRewriteSlices(t *testing.T) {
+	parser := NewTestParser()
+	// the slices are only copied when one of their elements changes, and the copies keep the other elements
+	original := "insert into t(a, b, c) values (1, 2, 3), (4, x, 6), (7, 8, 9)"
+	stmt, err := parser.Parse(original)
+	require.NoError(t, err)
+	out := CopyOnRewrite(stmt, nil, func(cursor *CopyOnWriteCursor) {
+		col, ok := cursor.Node().(*ColName)
+		if ok && col.Name.EqualString("x") {
+			cursor.Replace(NewIntLiteral("5"))
+		}
+	}, nil)
+
+	assert.Equal(t, original, String(stmt))
+	assert.Equal(t, "insert into t(a, b, c) values (1, 2, 3), (4, 5, 6), (7, 8, 9)", String(out))
+
+	rows, outRows := stmt.(*Insert).Rows.(Values), out.(*Insert).Rows.(Values)
+	assert.Same(t, &rows[0][0], &outRows[0][0])
+	assert.NotSame(t, &rows[1][0], &outRows[1][0])
+	assert.Same(t, rows[1][0], outRows[1][0])
+	assert.Same(t, &rows[2][0], &outRows[2][0])
+	assert.Same(t, &stmt.(*Insert).Columns[0], &out.(*Insert).Columns[0])
+}
+
+func TestCopyOnReplaceArguments(t *testing.T) {
+	parser := NewTestParser()
+	original := "select a, b from t where id = :id and c in ::cs and d = :d order by a asc"
+	stmt, err := parser.Parse(original)
+	require.NoError(t, err)
+
+	out := CopyOnReplaceArguments(stmt, func(name string) Expr {
+		switch name {
+		case "id":
+			return NewIntLiteral("42")
+		case "cs":
+			return ValTuple{NewIntLiteral("1"), NewIntLiteral("2")}
+		}
+		return nil
+	})
+
+	assert.Equal(t, original, String(stmt))
+	assert.Equal(t, "select a, b from t where id = 42 and c in (1, 2) and d = :d order by a asc", String(out))
+
+	// only the ancestors of the replaced arguments are copied
+	sel, outSel := stmt.(*Select), out.(*Select)
+	assert.NotSame(t, sel, outSel)
+	assert.NotSame(t, sel.Where, outSel.Where)
+	assert.Same(t, sel.SelectExprs[0], outSel.SelectExprs[0])
+	assert.Same(t, sel.From[0], outSel.From[0])
+	assert.Same(t, sel.OrderBy[0], outSel.OrderBy[0])
+	right := sel.Where.Expr.(*AndExpr).Right
+	assert.Same(t, right, outSel.Where.Expr.(*AndExpr).Right)
+}
+
+// BenchmarkCopyOnReplaceArguments compares binding the arguments of cached normalized statements by
+// cloning and rewriting them with doing it with CopyOnReplaceArguments.
+func BenchmarkCopyOnReplaceArguments(b *testing.B) {
+	parser := NewTestParser()
+	normalize := func(b *testing.B, queries []string) (stmts []Statement) {
+		for _, q := range queries {
+			stmt, reservedVars, err := parser.Parse2(q)
+			require.NoError(b, err)
+			require.NoError(b, Normalize(stmt, NewReservedVars("", reservedVars), map[string]*querypb.BindVariable{}))
+			stmts = append(stmts, stmt)
+		}
+		return stmts
+	}
+
+	lobsters := loadQueries(b, "lobsters.sql.gz")
+	if len(lobsters) > 10000 {
+		lobsters = lobsters[:10000]
+	}
+	workloads := []struct {
+		name    string
+		queries []string
+	}{{
+		name: "point select",
+		queries: []string{
+			"select c_discount, c_last, c_credit, w_tax from customer1 as c join warehouse1 as w on c_w_id = w_id where w_id = 1 and c_d_id = 2 and c_id = 3",
+		},
+	}, {
+		name: "in list",
+		queries: []string{
+			"select i_price, i_name, i_data from item1 where i_id in (1, 2, 3, 4, 5, 6, 7, 8, 9, 10) and i_im_id > 0 order by i_price desc limit 10",
+		},
+	}, {
+		name: "update",
+		queries: []string{
+			"update customer1 set c_balance = 1.5, c_ytd_payment = 2.5, c_data = 'data' where c_w_id = 1 and c_d_id = 2 and c_id = 3",
+		},
+	}, {
+		name: "multi row insert",
+		queries: []string{
+			"insert into order_line1 (ol_o_id, ol_d_id, ol_w_id, ol_number, ol_i_id, ol_supply_w_id, ol_quantity, ol_amount, ol_dist_info) values " +
+				"(1, 2, 3, 4, 5, 6, 7, 8, 'a'), (1, 2, 3, 4, 5, 6, 7, 8, 'b'), (1, 2, 3, 4, 5, 6, 7, 8, 'c'), (1, 2, 3, 4, 5, 6, 7, 8, 'd')",
+		},
+	}, {
+		name:    "lobsters",
+		queries: lobsters,
+	}}
+
+	value := NewIntLiteral("1")
+	list := ValTuple{NewIntLiteral("1"), NewIntLiteral("2")}
+	replace := func(name string) Expr {
+		if name == "list" {
+			return list
+		}
+		return value
+	}
+
+	for _, workload := range workloads {
+		stmts := normalize(b, workload.queries)
+
+		b.Run(workload.name+"/clone", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, stmt := range stmts {
+					_ = Rewrite(CloneStatement(stmt), nil, func(cursor *Cursor) bool {
+						switch cursor.Node().(type) {
+						case *Argument:
+							cursor.Replace(value)
+						case ListArg:
+							cursor.Replace(list)
+						}
+						return true
+					})
+				}
+			}
+		})
+
+		b.Run(workload.name+"/copy-on-rewrite", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, stmt := range stmts {
+					_ = CopyOnReplaceArguments(stmt, replace)
+				}
+			}
+		})
+	}
+}
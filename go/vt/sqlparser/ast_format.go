@@ -2132,7 +2132,62 @@ func (node IdentifierCS) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *Load) Format(buf *TrackedBuffer) {
-	buf.literal("AST node missing for Load type")
+	buf.astPrintf(node, "load data %s", node.Priority.ToString())
+	if node.Local {
+		buf.literal("local ")
+	}
+	buf.astPrintf(node, "%s%#s%s", node.Source.ToString(), encodeSQLString(node.FileName), node.Duplicate.ToString())
+	if node.Table.IsEmpty() {
+		return
+	}
+	buf.astPrintf(node, " into table %v%v", node.Table, node.Partitions)
+	if node.Charset.Name != "" {
+		buf.astPrintf(node, " character set %#s", node.Charset.Name)
+	}
+	buf.astPrintf(node, "%v%v", node.Fields, node.Lines)
+	if node.IgnoreLines != nil {
+		buf.astPrintf(node, " ignore %v lines", node.IgnoreLines)
+	}
+	if len(node.Columns) > 0 {
+		buf.astPrintf(node, " (%v)", node.Columns)
+	}
+	if len(node.SetExprs) > 0 {
+		buf.astPrintf(node, " set %v", node.SetExprs)
+	}
+}
+
+// Format formats the node.
+func (node *LoadFields) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.literal(" fields")
+	if node.TerminatedBy != nil {
+		buf.astPrintf(node, " terminated by %v", node.TerminatedBy)
+	}
+	if node.EnclosedBy != nil {
+		if node.Optionally {
+			buf.literal(" optionally")
+		}
+		buf.astPrintf(node, " enclosed by %v", node.EnclosedBy)
+	}
+	if node.EscapedBy != nil {
+		buf.astPrintf(node, " escaped by %v", node.EscapedBy)
+	}
+}
+
+// Format formats the node.
+func (node *LoadLines) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.literal(" lines")
+	if node.StartingBy != nil {
+		buf.astPrintf(node, " starting by %v", node.StartingBy)
+	}
+	if node.TerminatedBy != nil {
+		buf.astPrintf(node, " terminated by %v", node.TerminatedBy)
+	}
 }
 
 // Format formats the node.
@@ -2810,7 +2810,80 @@ func (node IdentifierCS) FormatFast(buf *TrackedBuffer) {
 
 // FormatFast formats the node.
 func (node *Load) FormatFast(buf *TrackedBuffer) {
-	buf.WriteString("AST node missing for Load type")
+	buf.WriteString("load data ")
+	buf.WriteString(node.Priority.ToString())
+	if node.Local {
+		buf.WriteString("local ")
+	}
+	buf.WriteString(node.Source.ToString())
+	buf.WriteString(encodeSQLString(node.FileName))
+	buf.WriteString(node.Duplicate.ToString())
+	if node.Table.IsEmpty() {
+		return
+	}
+	buf.WriteString(" into table ")
+	node.Table.FormatFast(buf)
+	node.Partitions.FormatFast(buf)
+
+	if node.Charset.Name != "" {
+		buf.WriteString(" character set ")
+		buf.WriteString(node.Charset.Name)
+	}
+	node.Fields.FormatFast(buf)
+	node.Lines.FormatFast(buf)
+	if node.IgnoreLines != nil {
+		buf.WriteString(" ignore ")
+		node.IgnoreLines.FormatFast(buf)
+		buf.WriteString(" lines")
+	}
+	if len(node.Columns) > 0 {
+		buf.WriteString(" (")
+		node.Columns.FormatFast(buf)
+		buf.WriteByte(')')
+	}
+	if len(node.SetExprs) > 0 {
+		buf.WriteString(" set ")
+		node.SetExprs.FormatFast(buf)
+	}
+}
+
+// FormatFast formats the node.
+func (node *LoadFields) FormatFast(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString(" fields")
+	if node.TerminatedBy != nil {
+		buf.WriteString(" terminated by ")
+		node.TerminatedBy.FormatFast(buf)
+	}
+	if node.EnclosedBy != nil {
+		if node.Optionally {
+			buf.WriteString(" optionally")
+		}
+		buf.WriteString(" enclosed by ")
+		node.EnclosedBy.FormatFast(buf)
+	}
+	if node.EscapedBy != nil {
+		buf.WriteString(" escaped by ")
+		node.EscapedBy.FormatFast(buf)
+	}
+}
+
+// FormatFast formats the node.
+func (node *LoadLines) FormatFast(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString(" lines")
+	if node.StartingBy != nil {
+		buf.WriteString(" starting by ")
+		node.StartingBy.FormatFast(buf)
+	}
+	if node.TerminatedBy != nil {
+		buf.WriteString(" terminated by ")
+		node.TerminatedBy.FormatFast(buf)
+	}
 }
 
 // FormatFast formats the node.
@@ -1928,6 +1928,52 @@ func (sel SelectIntoType) ToString() string {
 	}
 }
 
+// ToString returns the priority as a string
+func (priority LoadPriority) ToString() string {
+	switch priority {
+	case NoLoadPriority:
+		return ""
+	case LowPriorityLoad:
+		return LowPriorityLoadStr
+	case ConcurrentLoad:
+		return ConcurrentLoadStr
+	default:
+		return "Unknown LoadPriority"
+	}
+}
+
+// ToString returns the handling of duplicate keys as a string
+func (duplicate LoadDuplicate) ToString() string {
+	switch duplicate {
+	case ErrorOnDuplicateLoad:
+		return ""
+	case ReplaceOnDuplicateLoad:
+		return ReplaceLoadStr
+	case IgnoreOnDuplicateLoad:
+		return IgnoreLoadStr
+	default:
+		return "Unknown LoadDuplicate"
+	}
+}
+
+// ToString returns the source as a string
+func (source LoadSource) ToString() string {
+	switch source {
+	case InfileLoad:
+		return InfileLoadStr
+	case S3Load:
+		return S3LoadStr
+	case S3FileLoad:
+		return S3FileLoadStr
+	case S3PrefixLoad:
+		return S3PrefixLoadStr
+	case S3ManifestLoad:
+		return S3ManifestLoadStr
+	default:
+		return "Unknown LoadSource"
+	}
+}
+
 // ToString returns the type as a string
 func (node DatabaseOptionType) ToString() string {
 	switch node {
@@ -398,6 +398,8 @@ func (er *astRewriter) sysVarRewrite(cursor *Cursor, node *Variable) {
 		sysvars.Version.Name,
 		sysvars.VersionComment.Name,
 		sysvars.QueryTimeout.Name,
+		sysvars.MaxShardsPerQuery.Name,
+		sysvars.MaxShardsPerQueryWarnOnly.Name,
 		sysvars.Workload.Name:
 		found = true
 	}
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// CanonicalFormatVersion identifies the output of CanonicalString. Anything that keys data
+// on the canonical text of a query, like a persisted plan cache, must key it on this version
+// too: the version is only incremented when a change in the parser or in the formatter
+// changes the canonical text of a query that was already supported, so caches only need to
+// be invalidated when it changes, and not on every release.
+//
+// The canonical output of the current version is pinned by the golden corpus in
+// testdata/canonical/v<CanonicalFormatVersion>.json. When a change breaks that corpus on
+// purpose, increment the version and add the corpus of the new version instead of editing
+// the existing one.
+const CanonicalFormatVersion = 1
+
+// CanonicalKey returns a key for the canonical form of the given statement that is stable
+// across Vitess releases sharing the same CanonicalFormatVersion.
+func CanonicalKey(node SQLNode) string {
+	return "v" + strconv.Itoa(CanonicalFormatVersion) + ":" + CanonicalString(node)
+}
+
+// CanonicalCorpus is a set of queries along with their canonical output, as produced by a
+// given CanonicalFormatVersion.
+type CanonicalCorpus struct {
+	Version int              `json:"version"`
+	Entries []CanonicalEntry `json:"queries"`
+}
+
+// CanonicalEntry is a single query of a CanonicalCorpus.
+type CanonicalEntry struct {
+	Query     string `json:"query"`
+	Canonical string `json:"canonical"`
+}
+
+// CanonicalDiff is a query whose canonical output differs from the one of a CanonicalCorpus.
+type CanonicalDiff struct {
+	Query    string
+	Expected string
+	Actual   string
+}
+
+// NewCanonicalCorpus returns the corpus for the given queries with the canonical output
+// of the current CanonicalFormatVersion.
+func (p *Parser) NewCanonicalCorpus(queries []string) (*CanonicalCorpus, error) {
+	corpus := &CanonicalCorpus{Version: CanonicalFormatVersion}
+	for _, query := range queries {
+		stmt, err := p.Parse(query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", query, err)
+		}
+		corpus.Entries = append(corpus.Entries, CanonicalEntry{Query: query, Canonical: CanonicalString(stmt)})
+	}
+	return corpus, nil
+}
+
+// DiffCanonicalCorpus returns all the queries of the corpus whose canonical output with the
+// current CanonicalFormatVersion is not the recorded one. A query that fails to parse is
+// reported with the parsing error as its actual output.
+func (p *Parser) DiffCanonicalCorpus(corpus *CanonicalCorpus) []CanonicalDiff {
+	var diffs []CanonicalDiff
+	for _, entry := range corpus.Entries {
+		var actual string
+		stmt, err := p.Parse(entry.Query)
+		if err != nil {
+			actual = "error: " + err.Error()
+		} else {
+			actual = CanonicalString(stmt)
+		}
+		if actual != entry.Canonical {
+			diffs = append(diffs, CanonicalDiff{Query: entry.Query, Expected: entry.Canonical, Actual: actual})
+		}
+	}
+	return diffs
+}
+
+// WriteCanonicalCorpus writes the corpus as indented JSON, in the format read by
+// ReadCanonicalCorpus.
+func WriteCanonicalCorpus(w io.Writer, corpus *CanonicalCorpus) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(corpus)
+}
+
+// ReadCanonicalCorpus reads a corpus written by WriteCanonicalCorpus.
+func ReadCanonicalCorpus(r io.Reader) (*CanonicalCorpus, error) {
+	var corpus CanonicalCorpus
+	if err := json.NewDecoder(r).Decode(&corpus); err != nil {
+		return nil, err
+	}
+	if corpus.Version == 0 {
+		return nil, fmt.Errorf("missing canonical format version")
+	}
+	return &corpus, nil
+}
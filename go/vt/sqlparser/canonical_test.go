@@ -0,0 +1,87 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCanonicalGolden makes sure that the canonical output of the queries in the golden
+// corpus of the current CanonicalFormatVersion never changes.
+func TestCanonicalGolden(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "canonical", fmt.Sprintf("v%d.json", CanonicalFormatVersion)))
+	require.NoError(t, err)
+	defer f.Close()
+
+	corpus, err := ReadCanonicalCorpus(f)
+	require.NoError(t, err)
+	require.Equal(t, CanonicalFormatVersion, corpus.Version)
+	require.NotEmpty(t, corpus.Entries)
+
+	for _, diff := range NewTestParser().DiffCanonicalCorpus(corpus) {
+		t.Errorf("canonical output of %q changed without incrementing CanonicalFormatVersion:\nexpected: %s\nactual:   %s", diff.Query, diff.Expected, diff.Actual)
+	}
+}
+
+func TestCanonicalCorpus(t *testing.T) {
+	parser := NewTestParser()
+	corpus, err := parser.NewCanonicalCorpus([]string{
+		"select a from t where b = 'x'",
+		"create table t (id int primary key)",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, CanonicalFormatVersion, corpus.Version)
+	assert.Equal(t, "SELECT `a` FROM `t` WHERE `b` = 'x'", corpus.Entries[0].Canonical)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCanonicalCorpus(&buf, corpus))
+	read, err := ReadCanonicalCorpus(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, corpus, read)
+	assert.Empty(t, parser.DiffCanonicalCorpus(read))
+
+	read.Entries[0].Canonical = "select `a` from `t` where `b` = 'x'"
+	read.Entries = append(read.Entries, CanonicalEntry{Query: "selec a", Canonical: "SELECT `a`"})
+	diffs := parser.DiffCanonicalCorpus(read)
+	require.Len(t, diffs, 2)
+	assert.Equal(t, "SELECT `a` FROM `t` WHERE `b` = 'x'", diffs[0].Actual)
+	assert.Contains(t, diffs[1].Actual, "error: syntax error")
+
+	_, err = parser.NewCanonicalCorpus([]string{"selec a"})
+	assert.ErrorContains(t, err, "failed to parse")
+
+	_, err = ReadCanonicalCorpus(bytes.NewBufferString(`{"queries": []}`))
+	assert.ErrorContains(t, err, "missing canonical format version")
+}
+
+func TestCanonicalKey(t *testing.T) {
+	parser := NewTestParser()
+	stmt1, err := parser.Parse("select a from t where b = 1")
+	require.NoError(t, err)
+	stmt2, err := parser.Parse("SELECT `a`  FROM t WHERE b=1")
+	require.NoError(t, err)
+
+	assert.Equal(t, CanonicalKey(stmt1), CanonicalKey(stmt2))
+	assert.Equal(t, fmt.Sprintf("v%d:SELECT `a` FROM `t` WHERE `b` = 1", CanonicalFormatVersion), CanonicalKey(stmt1))
+}
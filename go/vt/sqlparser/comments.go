@@ -199,6 +199,24 @@ func hasCommentPrefix(sql string) bool {
 	return len(sql) > 1 && ((sql[0] == '/' && sql[1] == '*') || (sql[0] == '-' && sql[1] == '-'))
 }
 
+// ConditionalComment is a MySQL conditional comment, such as `/*!50700 sql here */`.
+// Its contents are only executed by the MySQL servers with a version higher or equal
+// to the version of the comment, or by all of them when the comment has no version.
+type ConditionalComment struct {
+	// Raw is the full text of the comment.
+	Raw string
+	// Version is the version of the comment in the 5 digit format of MySQL, e.g.
+	// "50700", or empty when the comment has no version.
+	Version string
+	// SQL is the contents of the comment.
+	SQL string
+	// Included is true when the contents of the comment were parsed as part of the
+	// statement, because the MySQL version of the Parser is at least Version.
+	// Excluded comments are kept in the comments of the statement when they are
+	// at a position where the grammar accepts comments, and are skipped otherwise.
+	Included bool
+}
+
 // ExtractMysqlComment extracts the version and SQL from a comment-only query
 // such as /*!50708 sql here */
 func ExtractMysqlComment(sql string) (string, string) {
@@ -0,0 +1,302 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"sort"
+	"sync"
+)
+
+// KeywordInfo describes a keyword known to the parser, for SQL editors and other
+// tools that implement autocompletion.
+type KeywordInfo struct {
+	Name string `json:"name"`
+	// Reserved is true if the keyword cannot be used as an identifier without
+	// quoting it with backticks.
+	Reserved bool `json:"reserved"`
+}
+
+// FunctionInfo describes a builtin function that has its own grammar rules in the
+// parser, for SQL editors and other tools that implement autocompletion. Any other
+// identifier followed by a list of arguments is parsed as a call to a function with
+// that name, which is resolved later on by vtgate or by MySQL.
+type FunctionInfo struct {
+	Name string `json:"name"`
+	// MinArgs and MaxArgs are the number of arguments accepted by the parser.
+	// MaxArgs is -1 if there is no upper limit.
+	MinArgs int `json:"min_args"`
+	MaxArgs int `json:"max_args"`
+	// SpecialSyntax is true if the arguments are not a plain list of expressions
+	// separated by commas, e.g. CAST(expr AS type) or TRIM(LEADING x FROM y), or
+	// if the function needs more than its arguments, e.g. the OVER clause of the
+	// window functions.
+	SpecialSyntax bool `json:"special_syntax"`
+}
+
+// builtinFunctions are the builtin functions with dedicated grammar rules in sql.y,
+// by keyword id. A function that is known by several names, like SUBSTRING() and
+// SUBSTR(), is listed under all the keywords with its id.
+//
+// NOTE: If you add a function to sql.y, add it here as well so that it's known to
+// autocompletion.
+var builtinFunctions = []struct {
+	id               int
+	minArgs, maxArgs int
+	special          bool
+}{
+	// function_call_keyword
+	{id: LEFT, minArgs: 0, maxArgs: -1},
+	{id: RIGHT, minArgs: 0, maxArgs: -1},
+	{id: SUBSTRING, minArgs: 2, maxArgs: 3},
+	{id: MID, minArgs: 3, maxArgs: 3},
+	{id: VALUES, minArgs: 1, maxArgs: 1, special: true},
+	{id: INSERT, minArgs: 4, maxArgs: 4},
+	{id: CURRENT_USER, minArgs: 0, maxArgs: 0},
+	{id: CAST, minArgs: 1, maxArgs: 1, special: true},
+	{id: CONVERT, minArgs: 1, maxArgs: 1, special: true},
+	{id: INTERVAL, minArgs: 2, maxArgs: -1},
+	{id: MATCH, minArgs: 1, maxArgs: -1, special: true},
+
+	// function_call_nonkeyword
+	{id: UTC_DATE, minArgs: 0, maxArgs: 0},
+	{id: CURRENT_DATE, minArgs: 0, maxArgs: 0},
+	{id: CURDATE, minArgs: 0, maxArgs: 0},
+	{id: UTC_TIME, minArgs: 0, maxArgs: 1},
+	{id: CURTIME, minArgs: 0, maxArgs: 1},
+	{id: CURRENT_TIME, minArgs: 0, maxArgs: 1},
+	{id: CURRENT_TIMESTAMP, minArgs: 0, maxArgs: 1},
+	{id: LOCALTIME, minArgs: 0, maxArgs: 1},
+	{id: LOCALTIMESTAMP, minArgs: 0, maxArgs: 1},
+	{id: UTC_TIMESTAMP, minArgs: 0, maxArgs: 1},
+	{id: NOW, minArgs: 0, maxArgs: 1},
+	{id: COUNT, minArgs: 1, maxArgs: -1},
+	{id: MAX, minArgs: 1, maxArgs: 1},
+	{id: MIN, minArgs: 1, maxArgs: 1},
+	{id: SUM, minArgs: 1, maxArgs: 1},
+	{id: AVG, minArgs: 1, maxArgs: 1},
+	{id: BIT_AND, minArgs: 1, maxArgs: 1},
+	{id: BIT_OR, minArgs: 1, maxArgs: 1},
+	{id: BIT_XOR, minArgs: 1, maxArgs: 1},
+	{id: STD, minArgs: 1, maxArgs: 1},
+	{id: STDDEV, minArgs: 1, maxArgs: 1},
+	{id: STDDEV_POP, minArgs: 1, maxArgs: 1},
+	{id: STDDEV_SAMP, minArgs: 1, maxArgs: 1},
+	{id: VAR_POP, minArgs: 1, maxArgs: 1},
+	{id: VAR_SAMP, minArgs: 1, maxArgs: 1},
+	{id: VARIANCE, minArgs: 1, maxArgs: 1},
+	{id: GROUP_CONCAT, minArgs: 1, maxArgs: -1},
+	{id: ANY_VALUE, minArgs: 1, maxArgs: 1},
+	{id: TIMESTAMPADD, minArgs: 3, maxArgs: 3, special: true},
+	{id: TIMESTAMPDIFF, minArgs: 3, maxArgs: 3, special: true},
+	{id: EXTRACT, minArgs: 2, maxArgs: 2, special: true},
+	{id: WEIGHT_STRING, minArgs: 1, maxArgs: 1},
+	{id: JSON_PRETTY, minArgs: 1, maxArgs: 1},
+	{id: JSON_STORAGE_FREE, minArgs: 1, maxArgs: 1},
+	{id: JSON_STORAGE_SIZE, minArgs: 1, maxArgs: 1},
+	{id: JSON_ARRAYAGG, minArgs: 1, maxArgs: 1},
+	{id: JSON_OBJECTAGG, minArgs: 2, maxArgs: 2},
+	{id: LTRIM, minArgs: 1, maxArgs: 1},
+	{id: RTRIM, minArgs: 1, maxArgs: 1},
+	{id: TRIM, minArgs: 1, maxArgs: 2, special: true},
+	{id: CHAR, minArgs: 1, maxArgs: -1},
+	{id: LOCATE, minArgs: 2, maxArgs: 3},
+	{id: POSITION, minArgs: 2, maxArgs: 2, special: true},
+	{id: GET_LOCK, minArgs: 2, maxArgs: 2},
+	{id: IS_FREE_LOCK, minArgs: 1, maxArgs: 1},
+	{id: IS_USED_LOCK, minArgs: 1, maxArgs: 1},
+	{id: RELEASE_ALL_LOCKS, minArgs: 0, maxArgs: 0},
+	{id: RELEASE_LOCK, minArgs: 1, maxArgs: 1},
+	{id: JSON_SCHEMA_VALID, minArgs: 2, maxArgs: 2},
+	{id: JSON_SCHEMA_VALIDATION_REPORT, minArgs: 2, maxArgs: 2},
+	{id: JSON_ARRAY, minArgs: 0, maxArgs: -1},
+	{id: ST_AsBinary, minArgs: 1, maxArgs: 2},
+	{id: ST_AsText, minArgs: 1, maxArgs: 2},
+	{id: ST_IsEmpty, minArgs: 1, maxArgs: 1},
+	{id: ST_IsSimple, minArgs: 1, maxArgs: 1},
+	{id: ST_Dimension, minArgs: 1, maxArgs: 1},
+	{id: ST_Envelope, minArgs: 1, maxArgs: 1},
+	{id: ST_GeometryType, minArgs: 1, maxArgs: 1},
+	{id: ST_Latitude, minArgs: 1, maxArgs: 2},
+	{id: ST_Longitude, minArgs: 1, maxArgs: 2},
+	{id: ST_EndPoint, minArgs: 1, maxArgs: 1},
+	{id: ST_IsClosed, minArgs: 1, maxArgs: 1},
+	{id: ST_Length, minArgs: 1, maxArgs: 2},
+	{id: ST_NumPoints, minArgs: 1, maxArgs: 1},
+	{id: ST_PointN, minArgs: 2, maxArgs: 2},
+	{id: ST_StartPoint, minArgs: 1, maxArgs: 1},
+	{id: ST_X, minArgs: 1, maxArgs: 2},
+	{id: ST_Y, minArgs: 1, maxArgs: 2},
+	{id: ST_GeometryFromText, minArgs: 1, maxArgs: 3},
+	{id: ST_GeometryCollectionFromText, minArgs: 1, maxArgs: 3},
+	{id: ST_LineStringFromText, minArgs: 1, maxArgs: 3},
+	{id: ST_MultiLineStringFromText, minArgs: 1, maxArgs: 3},
+	{id: ST_MultiPointFromText, minArgs: 1, maxArgs: 3},
+	{id: ST_MultiPolygonFromText, minArgs: 1, maxArgs: 3},
+	{id: ST_PointFromText, minArgs: 1, maxArgs: 3},
+	{id: ST_PolygonFromText, minArgs: 1, maxArgs: 3},
+	{id: ST_GeometryFromWKB, minArgs: 1, maxArgs: 3},
+	{id: ST_GeometryCollectionFromWKB, minArgs: 1, maxArgs: 3},
+	{id: ST_LineStringFromWKB, minArgs: 1, maxArgs: 3},
+	{id: ST_MultiLineStringFromWKB, minArgs: 1, maxArgs: 3},
+	{id: ST_MultiPointFromWKB, minArgs: 1, maxArgs: 3},
+	{id: ST_MultiPolygonFromWKB, minArgs: 1, maxArgs: 3},
+	{id: ST_PointFromWKB, minArgs: 1, maxArgs: 3},
+	{id: ST_PolygonFromWKB, minArgs: 1, maxArgs: 3},
+	{id: ST_Area, minArgs: 1, maxArgs: 1},
+	{id: ST_Centroid, minArgs: 1, maxArgs: 1},
+	{id: ST_ExteriorRing, minArgs: 1, maxArgs: 1},
+	{id: ST_InteriorRingN, minArgs: 2, maxArgs: 2},
+	{id: ST_NumInteriorRings, minArgs: 1, maxArgs: 1},
+	{id: ST_GeometryN, minArgs: 2, maxArgs: 2},
+	{id: ST_NumGeometries, minArgs: 1, maxArgs: 1},
+	{id: ST_GeoHash, minArgs: 2, maxArgs: 3},
+	{id: ST_LatFromGeoHash, minArgs: 1, maxArgs: 1},
+	{id: ST_LongFromGeoHash, minArgs: 1, maxArgs: 1},
+	{id: ST_PointFromGeoHash, minArgs: 2, maxArgs: 2},
+	{id: ST_GeomFromGeoJSON, minArgs: 1, maxArgs: 3},
+	{id: ST_AsGeoJSON, minArgs: 1, maxArgs: 3},
+	{id: JSON_OBJECT, minArgs: 0, maxArgs: -1},
+	{id: JSON_QUOTE, minArgs: 1, maxArgs: 1},
+	{id: JSON_CONTAINS, minArgs: 2, maxArgs: -1},
+	{id: JSON_CONTAINS_PATH, minArgs: 3, maxArgs: -1},
+	{id: JSON_EXTRACT, minArgs: 2, maxArgs: -1},
+	{id: JSON_KEYS, minArgs: 1, maxArgs: 2},
+	{id: JSON_OVERLAPS, minArgs: 2, maxArgs: 2},
+	{id: JSON_SEARCH, minArgs: 3, maxArgs: -1},
+	{id: JSON_VALUE, minArgs: 2, maxArgs: 2},
+	{id: JSON_DEPTH, minArgs: 1, maxArgs: 1},
+	{id: JSON_VALID, minArgs: 1, maxArgs: 1},
+	{id: JSON_TYPE, minArgs: 1, maxArgs: 1},
+	{id: JSON_LENGTH, minArgs: 1, maxArgs: 2},
+	{id: JSON_ARRAY_APPEND, minArgs: 3, maxArgs: -1},
+	{id: JSON_ARRAY_INSERT, minArgs: 3, maxArgs: -1},
+	{id: JSON_INSERT, minArgs: 3, maxArgs: -1},
+	{id: JSON_REPLACE, minArgs: 3, maxArgs: -1},
+	{id: JSON_SET, minArgs: 3, maxArgs: -1},
+	{id: JSON_MERGE, minArgs: 2, maxArgs: -1},
+	{id: JSON_MERGE_PATCH, minArgs: 2, maxArgs: -1},
+	{id: JSON_MERGE_PRESERVE, minArgs: 2, maxArgs: -1},
+	{id: JSON_REMOVE, minArgs: 2, maxArgs: -1},
+	{id: JSON_UNQUOTE, minArgs: 1, maxArgs: 1},
+	{id: MULTIPOLYGON, minArgs: 1, maxArgs: -1},
+	{id: MULTIPOINT, minArgs: 1, maxArgs: -1},
+	{id: MULTILINESTRING, minArgs: 1, maxArgs: -1},
+	{id: POLYGON, minArgs: 1, maxArgs: -1},
+	{id: LINESTRING, minArgs: 1, maxArgs: -1},
+	{id: POINT, minArgs: 2, maxArgs: 2},
+	{id: CUME_DIST, minArgs: 0, maxArgs: 0, special: true},
+	{id: DENSE_RANK, minArgs: 0, maxArgs: 0, special: true},
+	{id: PERCENT_RANK, minArgs: 0, maxArgs: 0, special: true},
+	{id: RANK, minArgs: 0, maxArgs: 0, special: true},
+	{id: ROW_NUMBER, minArgs: 0, maxArgs: 0, special: true},
+	{id: FIRST_VALUE, minArgs: 1, maxArgs: 1, special: true},
+	{id: LAST_VALUE, minArgs: 1, maxArgs: 1, special: true},
+	{id: NTILE, minArgs: 1, maxArgs: 1, special: true},
+	{id: NTH_VALUE, minArgs: 2, maxArgs: 2, special: true},
+	{id: LAG, minArgs: 1, maxArgs: 3, special: true},
+	{id: LEAD, minArgs: 1, maxArgs: 3, special: true},
+	{id: ADDDATE, minArgs: 2, maxArgs: 2},
+	{id: DATE_ADD, minArgs: 2, maxArgs: 2, special: true},
+	{id: DATE_SUB, minArgs: 2, maxArgs: 2, special: true},
+	{id: SUBDATE, minArgs: 2, maxArgs: 2},
+
+	// regular_expressions
+	{id: REGEXP_INSTR, minArgs: 2, maxArgs: 6},
+	{id: REGEXP_LIKE, minArgs: 2, maxArgs: 3},
+	{id: REGEXP_REPLACE, minArgs: 3, maxArgs: 6},
+	{id: REGEXP_SUBSTR, minArgs: 2, maxArgs: 5},
+
+	// xml_expressions
+	{id: ExtractValue, minArgs: 2, maxArgs: 2},
+	{id: UpdateXML, minArgs: 3, maxArgs: 3},
+
+	// performance_schema_function_expressions
+	{id: FORMAT_BYTES, minArgs: 1, maxArgs: 1},
+	{id: FORMAT_PICO_TIME, minArgs: 1, maxArgs: 1},
+	{id: PS_CURRENT_THREAD_ID, minArgs: 0, maxArgs: 0},
+	{id: PS_THREAD_ID, minArgs: 1, maxArgs: 1},
+
+	// gtid_function_expressions
+	{id: GTID_SUBSET, minArgs: 2, maxArgs: 2},
+	{id: GTID_SUBTRACT, minArgs: 2, maxArgs: 2},
+	{id: WAIT_FOR_EXECUTED_GTID_SET, minArgs: 1, maxArgs: 2},
+	{id: WAIT_UNTIL_SQL_THREAD_AFTER_GTIDS, minArgs: 1, maxArgs: 3},
+
+	// function_call_conflict
+	{id: IF, minArgs: 1, maxArgs: -1},
+	{id: DATABASE, minArgs: 0, maxArgs: -1},
+	{id: SCHEMA, minArgs: 0, maxArgs: -1},
+	{id: MOD, minArgs: 1, maxArgs: -1},
+	{id: REPLACE, minArgs: 1, maxArgs: -1},
+}
+
+var completion struct {
+	once      sync.Once
+	keywords  []KeywordInfo
+	functions []FunctionInfo
+}
+
+// Keywords returns all the keywords known to the parser, sorted by name, and
+// whether they are reserved. The returned slice must not be modified.
+func Keywords() []KeywordInfo {
+	completion.once.Do(buildCompletion)
+	return completion.keywords
+}
+
+// BuiltinFunctions returns the builtin functions that have their own grammar rules
+// in the parser, sorted by name, with the number of arguments they accept. The
+// returned slice must not be modified.
+func BuiltinFunctions() []FunctionInfo {
+	completion.once.Do(buildCompletion)
+	return completion.functions
+}
+
+func buildCompletion() {
+	parser, err := New(Options{})
+	if err != nil {
+		panic(err)
+	}
+
+	ids := make(map[int][]string)
+	for _, kw := range keywords {
+		// A keyword is reserved if it can't be used as a table name, which
+		// accepts exactly the same keywords as the other identifiers.
+		_, err := parser.Parse("select 1 from " + kw.name)
+		completion.keywords = append(completion.keywords, KeywordInfo{
+			Name:     kw.name,
+			Reserved: err != nil,
+		})
+		ids[kw.id] = append(ids[kw.id], kw.name)
+	}
+	sort.Slice(completion.keywords, func(i, j int) bool {
+		return completion.keywords[i].Name < completion.keywords[j].Name
+	})
+
+	for _, fn := range builtinFunctions {
+		for _, name := range ids[fn.id] {
+			completion.functions = append(completion.functions, FunctionInfo{
+				Name:          name,
+				MinArgs:       fn.minArgs,
+				MaxArgs:       fn.maxArgs,
+				SpecialSyntax: fn.special,
+			})
+		}
+	}
+	sort.Slice(completion.functions, func(i, j int) bool {
+		return completion.functions[i].Name < completion.functions[j].Name
+	})
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompletionKeywords(t *testing.T) {
+	kws := Keywords()
+	require.Len(t, kws, len(keywords))
+	assert.True(t, sort.SliceIsSorted(kws, func(i, j int) bool { return kws[i].Name < kws[j].Name }))
+
+	reserved := make(map[string]bool)
+	for _, kw := range kws {
+		reserved[kw.Name] = kw.Reserved
+	}
+	assert.True(t, reserved["select"])
+	assert.True(t, reserved["from"])
+	assert.True(t, reserved["where"])
+	assert.False(t, reserved["status"])
+	assert.False(t, reserved["json_extract"])
+
+	parser := NewTestParser()
+	for _, kw := range kws {
+		if !kw.Reserved {
+			continue
+		}
+		_, err := parser.Parse(fmt.Sprintf("select `%s` from `%s`", kw.Name, kw.Name))
+		assert.NoErrorf(t, err, "reserved keyword %q cannot be quoted", kw.Name)
+	}
+}
+
+func TestCompletionBuiltinFunctions(t *testing.T) {
+	fns := BuiltinFunctions()
+	assert.True(t, sort.SliceIsSorted(fns, func(i, j int) bool { return fns[i].Name < fns[j].Name }))
+
+	names := make(map[string]bool)
+	for _, fn := range fns {
+		names[fn.Name] = true
+	}
+	// aliases of the same function are all listed
+	assert.True(t, names["substring"])
+	assert.True(t, names["substr"])
+	assert.True(t, names["st_geomfromtext"])
+	assert.True(t, names["st_geometryfromtext"])
+
+	call := func(name string, args int) string {
+		return fmt.Sprintf("select %s(%s) from dual", name, strings.TrimSuffix(strings.Repeat("1, ", args), ", "))
+	}
+
+	parser := NewTestParser()
+	for _, fn := range fns {
+		if fn.SpecialSyntax {
+			continue
+		}
+		t.Run(fn.Name, func(t *testing.T) {
+			_, err := parser.Parse(call(fn.Name, fn.MinArgs))
+			require.NoError(t, err)
+
+			if fn.MaxArgs < 0 {
+				_, err = parser.Parse(call(fn.Name, fn.MinArgs+2))
+				require.NoError(t, err)
+				return
+			}
+			_, err = parser.Parse(call(fn.Name, fn.MaxArgs))
+			require.NoError(t, err)
+
+			// With too many arguments, the call either doesn't parse, or it's
+			// parsed as a call to a non-builtin function with that name.
+			stmt, err := parser.Parse(call(fn.Name, fn.MaxArgs+1))
+			if err == nil {
+				expr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+				assert.IsType(t, &FuncExpr{}, expr)
+			}
+		})
+	}
+}
@@ -248,6 +248,17 @@ const (
 	IntoOutfileS3Str = " into outfile s3 "
 	IntoDumpfileStr  = " into dumpfile "
 
+	// LOAD DATA
+	LowPriorityLoadStr = "low_priority "
+	ConcurrentLoadStr  = "concurrent "
+	ReplaceLoadStr     = " replace"
+	IgnoreLoadStr      = " ignore"
+	InfileLoadStr      = "infile "
+	S3LoadStr          = "from s3 "
+	S3FileLoadStr      = "from s3 file "
+	S3PrefixLoadStr    = "from s3 prefix "
+	S3ManifestLoadStr  = "from s3 manifest "
+
 	// Order.Direction
 	AscScr  = "asc"
 	DescScr = "desc"
@@ -855,6 +866,29 @@ const (
 	IntoDumpfile
 )
 
+// Constant for Enum Type - LoadPriority
+const (
+	NoLoadPriority LoadPriority = iota
+	LowPriorityLoad
+	ConcurrentLoad
+)
+
+// Constant for Enum Type - LoadDuplicate
+const (
+	ErrorOnDuplicateLoad LoadDuplicate = iota
+	ReplaceOnDuplicateLoad
+	IgnoreOnDuplicateLoad
+)
+
+// Constant for Enum Type - LoadSource
+const (
+	InfileLoad LoadSource = iota
+	S3Load
+	S3FileLoad
+	S3PrefixLoad
+	S3ManifestLoad
+)
+
 // Constant for Enum Type - JtOnResponseType
 const (
 	ErrorJSONType JtOnResponseType = iota
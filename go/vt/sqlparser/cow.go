@@ -53,6 +53,36 @@ func CopyOnRewrite(
 	return out
 }
 
+// CopyOnReplaceArguments returns a syntax tree where the arguments and list
+// arguments of node are replaced by the expressions that replace returns for
+// their names. The arguments for which replace returns nil are kept.
+//
+// node is not modified, and the returned syntax tree shares with it all the
+// subtrees that don't contain a replaced argument, so that a statement shared
+// by many queries, like the cached AST of a normalized query, can be rewritten
+// for each of them with a fraction of the allocations of cloning it.
+func CopyOnReplaceArguments(node SQLNode, replace func(name string) Expr) SQLNode {
+	return CopyOnRewrite(node, func(node, _ SQLNode) bool {
+		// Column names don't contain arguments, and skipping their value-typed
+		// identifiers saves the allocations of visiting them.
+		_, isCol := node.(*ColName)
+		return !isCol
+	}, func(cursor *CopyOnWriteCursor) {
+		var name string
+		switch arg := cursor.Node().(type) {
+		case *Argument:
+			name = arg.Name
+		case ListArg:
+			name = string(arg)
+		default:
+			return
+		}
+		if expr := replace(name); expr != nil {
+			cursor.Replace(expr)
+		}
+	}, nil)
+}
+
 // StopTreeWalk aborts the current tree walking. No more nodes will be visited, and the rewriter will exit out early
 func (c *CopyOnWriteCursor) StopTreeWalk() {
 	c.stop = true
@@ -0,0 +1,231 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"slices"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The directives of a statement are read from its /*vt+ */ comments, e.g.
+//
+//	select /*vt+ WORKLOAD_NAME=reports PRIORITY=50 */ * from t
+//
+// AddDirectives and RemoveDirectives let proxies and other middleware annotate
+// the queries they forward without formatting them again from their AST: only
+// the directive comments are changed, and the rest of the query text is kept
+// byte for byte.
+
+// directiveVerbs are the keywords that the comments of a statement can follow.
+var directiveVerbs = map[int]bool{
+	SELECT:  true,
+	INSERT:  true,
+	REPLACE: true,
+	UPDATE:  true,
+	DELETE:  true,
+	SET:     true,
+	CREATE:  true,
+	ALTER:   true,
+	DROP:    true,
+	STREAM:  true,
+	VSTREAM: true,
+}
+
+// directiveToken is a token of a query and its position in the query text.
+type directiveToken struct {
+	typ        int
+	val        string
+	start, end int
+}
+
+// directiveTokens returns the tokens of sql that are not part of a MySQL
+// conditional comment, whose position in sql isn't known.
+func (p *Parser) directiveTokens(sql string) ([]directiveToken, error) {
+	var tokens []directiveToken
+	tkn := p.NewStringTokenizer(sql)
+	for {
+		typ, val := tkn.Scan()
+		switch typ {
+		case 0:
+			return tokens, nil
+		case LEX_ERROR:
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error at position %d", tkn.Pos)
+		}
+		if tkn.specialComment != nil {
+			continue
+		}
+		start := tkn.Pos
+		if typ == COMMENT {
+			start -= len(val)
+		}
+		tokens = append(tokens, directiveToken{typ: typ, val: val, start: start, end: tkn.Pos})
+	}
+}
+
+// AddDirectives returns sql with the given directives added in a new /*vt+ */
+// comment, after the keyword that starts its statement, e.g. after the SELECT
+// of the main query of a WITH statement. The directives with the same name
+// already in the query are replaced. It fails if sql can't be parsed, if its
+// statement can't have directives, or if a directive is not a word, since the
+// directives are separated by whitespace.
+func (p *Parser) AddDirectives(sql string, directives map[string]string) (string, error) {
+	if len(directives) == 0 {
+		return sql, nil
+	}
+	keys := maps.Keys(directives)
+	slices.Sort(keys)
+
+	var comment strings.Builder
+	comment.WriteString(commentDirectivePreamble)
+	for _, key := range keys {
+		val := directives[key]
+		if key == "" || strings.ContainsAny(key, " \t\r\n=") || strings.ContainsAny(val, " \t\r\n") || strings.Contains(key+"="+val, "*/") {
+			return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid directive %q=%q", key, val)
+		}
+		comment.WriteString(" ")
+		comment.WriteString(key)
+		comment.WriteString("=")
+		comment.WriteString(val)
+	}
+	comment.WriteString(" */")
+
+	sql, err := p.RemoveDirectives(sql, keys...)
+	if err != nil {
+		return "", err
+	}
+	stmt, err := p.Parse(sql)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := stmt.(Commented); !ok {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s statements can't have directives", ASTToStatementType(stmt))
+	}
+
+	tokens, err := p.directiveTokens(sql)
+	if err != nil {
+		return "", err
+	}
+	// The keyword that the comments of the statement follow depends on the
+	// statement, and the ones before it may belong to a CTE or a subquery, so
+	// each one is tried until the statement has the directives.
+	for _, token := range tokens {
+		if !directiveVerbs[token.typ] {
+			continue
+		}
+		annotated := sql[:token.end] + " " + comment.String() + sql[token.end:]
+		stmt, err := p.Parse(annotated)
+		if err != nil {
+			continue
+		}
+		if hasDirectives(stmt, directives) {
+			return annotated, nil
+		}
+	}
+	return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "could not find where to add directives in %s statement", ASTToStatementType(stmt))
+}
+
+// hasDirectives returns whether the comments of stmt hold the given directives.
+func hasDirectives(stmt Statement, directives map[string]string) bool {
+	commented, ok := stmt.(Commented)
+	if !ok {
+		return false
+	}
+	d := commented.GetParsedComments().Directives()
+	if d == nil {
+		return false
+	}
+	for key, val := range directives {
+		if got, ok := d.m[strings.ToLower(key)]; !ok || got != val {
+			return false
+		}
+	}
+	return true
+}
+
+// RemoveDirectives returns sql without the directives with the given names,
+// which are case-insensitive, or without any directive if no name is given.
+// The /*vt+ */ comments that are left without directives are removed, with the
+// whitespace before them.
+func (p *Parser) RemoveDirectives(sql string, keys ...string) (string, error) {
+	tokens, err := p.directiveTokens(sql)
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	last := 0
+	for _, token := range tokens {
+		if token.typ != COMMENT || !strings.HasPrefix(token.val, commentDirectivePreamble) {
+			continue
+		}
+		comment, changed := removeCommentDirectives(token.val, keys)
+		if !changed {
+			continue
+		}
+		start, end := token.start, token.end
+		if comment == "" {
+			// Remove the whitespace before the comment, or after it if it
+			// starts the query.
+			if before := strings.TrimRight(sql[:start], " \t\r\n"); before != "" {
+				start = len(before)
+			} else {
+				end = len(sql) - len(strings.TrimLeft(sql[end:], " \t\r\n"))
+			}
+		}
+		buf.WriteString(sql[last:start])
+		buf.WriteString(comment)
+		last = end
+	}
+	if last == 0 {
+		return sql, nil
+	}
+	buf.WriteString(sql[last:])
+	return buf.String(), nil
+}
+
+// removeCommentDirectives returns the given /*vt+ */ comment without the
+// directives with the given names, or without any if no name is given, and
+// whether it changed. An empty comment is returned if no directive is left.
+func removeCommentDirectives(comment string, keys []string) (string, bool) {
+	// Like in ParsedComments.Directives, the first and last fields are the
+	// ones with the comment start and end.
+	fields := strings.Fields(comment)
+	if len(fields) < 2 {
+		return comment, false
+	}
+	directives := fields[1 : len(fields)-1]
+	kept := slices.DeleteFunc(slices.Clone(directives), func(directive string) bool {
+		if len(keys) == 0 {
+			return true
+		}
+		name, _, _ := strings.Cut(directive, "=")
+		return slices.ContainsFunc(keys, func(key string) bool { return strings.EqualFold(key, name) })
+	})
+	if len(kept) == len(directives) {
+		return comment, false
+	}
+	if len(kept) == 0 && fields[0] == commentDirectivePreamble && fields[len(fields)-1] == "*/" {
+		return "", true
+	}
+	return strings.Join(append(append([]string{fields[0]}, kept...), fields[len(fields)-1]), " "), true
+}
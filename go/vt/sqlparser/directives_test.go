@@ -0,0 +1,129 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddDirectives(t *testing.T) {
+	testCases := []struct {
+		sql        string
+		directives map[string]string
+		want       string
+		wantErr    string
+	}{{
+		sql:        "select * from t",
+		directives: map[string]string{"WORKLOAD_NAME": "reports", "PRIORITY": "50"},
+		want:       "select /*vt+ PRIORITY=50 WORKLOAD_NAME=reports */ * from t",
+	}, {
+		sql:        "/* leading */ SELECT  a,b FROM t WHERE c = 'select /*vt+ X=1 */'   -- trailing",
+		directives: map[string]string{"PRIORITY": "50"},
+		want:       "/* leading */ SELECT /*vt+ PRIORITY=50 */  a,b FROM t WHERE c = 'select /*vt+ X=1 */'   -- trailing",
+	}, {
+		sql:        "select /*vt+ PRIORITY=10 ALLOW_SCATTER */ * from t",
+		directives: map[string]string{"priority": "50"},
+		want:       "select /*vt+ priority=50 */ /*vt+ ALLOW_SCATTER */ * from t",
+	}, {
+		sql:        "with cte as (select a from t) select /* x */ * from cte where a in (select a from u)",
+		directives: map[string]string{"PRIORITY": "50"},
+		want:       "with cte as (select a from t) select /*vt+ PRIORITY=50 */ /* x */ * from cte where a in (select a from u)",
+	}, {
+		sql:        "(select a from t) union (select a from u)",
+		directives: map[string]string{"PRIORITY": "50"},
+		want:       "(select /*vt+ PRIORITY=50 */ a from t) union (select a from u)",
+	}, {
+		sql:        "insert into t(a) select a from u",
+		directives: map[string]string{"QUERY_TIMEOUT_MS": "100"},
+		want:       "insert /*vt+ QUERY_TIMEOUT_MS=100 */ into t(a) select a from u",
+	}, {
+		sql:        "with cte as (select a from t) delete from u where a in (select a from cte)",
+		directives: map[string]string{"QUERY_TIMEOUT_MS": "100"},
+		want:       "with cte as (select a from t) delete /*vt+ QUERY_TIMEOUT_MS=100 */ from u where a in (select a from cte)",
+	}, {
+		sql:  "select 1",
+		want: "select 1",
+	}, {
+		sql:        "begin",
+		directives: map[string]string{"PRIORITY": "50"},
+		wantErr:    "BEGIN statements can't have directives",
+	}, {
+		sql:        "select * from t",
+		directives: map[string]string{"WORKLOAD_NAME": "nightly reports"},
+		wantErr:    `invalid directive "WORKLOAD_NAME"="nightly reports"`,
+	}, {
+		sql:        "select * from t",
+		directives: map[string]string{"WORKLOAD_NAME": "*/"},
+		wantErr:    `invalid directive "WORKLOAD_NAME"="*/"`,
+	}, {
+		sql:        "select * from",
+		directives: map[string]string{"PRIORITY": "50"},
+		wantErr:    "syntax error at position 14",
+	}}
+	parser := NewTestParser()
+	for _, tc := range testCases {
+		t.Run(tc.sql, func(t *testing.T) {
+			got, err := parser.AddDirectives(tc.sql, tc.directives)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestRemoveDirectives(t *testing.T) {
+	testCases := []struct {
+		sql  string
+		keys []string
+		want string
+	}{{
+		sql:  "select /*vt+ PRIORITY=50 WORKLOAD_NAME=reports */ * from t",
+		keys: []string{"priority"},
+		want: "select /*vt+ WORKLOAD_NAME=reports */ * from t",
+	}, {
+		sql:  "select /*vt+ PRIORITY=50 */ /* x */ * from t",
+		keys: []string{"PRIORITY"},
+		want: "select /* x */ * from t",
+	}, {
+		sql:  "SELECT\n\t/*vt+ PRIORITY=50 */ /*vt+ ALLOW_SCATTER */\n* from t",
+		want: "SELECT\n* from t",
+	}, {
+		sql:  "/*vt+ PRIORITY=50 */  select * from t",
+		want: "select * from t",
+	}, {
+		sql:  "select /*vt+ PRIORITY=50 */ * from t where a = '/*vt+ PRIORITY=50 */'",
+		keys: []string{"WORKLOAD_NAME"},
+		want: "select /*vt+ PRIORITY=50 */ * from t where a = '/*vt+ PRIORITY=50 */'",
+	}, {
+		sql:  "select /* PRIORITY=50 */ * from t",
+		want: "select /* PRIORITY=50 */ * from t",
+	}}
+	parser := NewTestParser()
+	for _, tc := range testCases {
+		t.Run(tc.sql, func(t *testing.T) {
+			got, err := parser.RemoveDirectives(tc.sql, tc.keys...)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
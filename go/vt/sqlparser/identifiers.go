@@ -0,0 +1,251 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	// MaxIdentifierLength is the maximum length, in characters, of the names of
+	// the databases, tables, columns, indexes, constraints and views in MySQL.
+	MaxIdentifierLength = 64
+
+	// maxIdentifierBytes is the maximum length, in bytes, of a database or table
+	// name: MySQL stores identifiers in utf8mb3, whose characters take up to 3
+	// bytes.
+	maxIdentifierBytes = MaxIdentifierLength * 3
+)
+
+// The validation of identifiers follows the checks of MySQL, in the same order,
+// and returns the same errors. An identifier must be valid utf8mb3, i.e. valid
+// UTF-8 without NUL or supplementary characters, and at most 64 characters
+// long. The names of databases, tables and columns can't be empty nor end with
+// a space.
+
+func errTooLongIdent(name string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.TooLongIdent, "Identifier name '%.100s' is too long", name)
+}
+
+// validateCharset checks that name can be stored in utf8mb3.
+func validateCharset(name string) error {
+	for i := 0; i < len(name); {
+		r, size := utf8.DecodeRuneInString(name[i:])
+		if r == 0 || (r == utf8.RuneError && size <= 1) || r > 0xFFFF {
+			end := min(i+max(size, 1), len(name))
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.InvalidCharacterString, "Invalid utf8mb3 character string: '%X'", name[i:end])
+		}
+		i += size
+	}
+	return nil
+}
+
+func endsWithSpace(name string) bool {
+	switch name[len(name)-1] {
+	case ' ', '\t', '\n', '\v', '\f', '\r':
+		return true
+	}
+	return false
+}
+
+// ValidateIdentifier checks that name can be used as the name of an index, a
+// constraint or any other object whose name has no additional restriction in
+// MySQL. An empty name is valid, since such objects can be unnamed.
+func ValidateIdentifier(name string) error {
+	if err := validateCharset(name); err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(name) > MaxIdentifierLength {
+		return errTooLongIdent(name)
+	}
+	return nil
+}
+
+// checkTableName is the check of MySQL for the database and table names. It
+// returns whether name is wrong, or else whether it is too long.
+func checkTableName(name string) (wrong bool, tooLong bool) {
+	if len(name) == 0 || len(name) > maxIdentifierBytes || endsWithSpace(name) {
+		return true, false
+	}
+	return false, utf8.RuneCountInString(name) > MaxIdentifierLength
+}
+
+// ValidateDatabaseName checks that name is a valid database name.
+func ValidateDatabaseName(name string) error {
+	if err := validateCharset(name); err != nil {
+		return err
+	}
+	wrong, tooLong := checkTableName(name)
+	switch {
+	case wrong:
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongDbName, "Incorrect database name '%.100s'", name)
+	case tooLong:
+		return errTooLongIdent(name)
+	}
+	return nil
+}
+
+// ValidateTableName checks that name is a valid table or view name.
+func ValidateTableName(name string) error {
+	if err := validateCharset(name); err != nil {
+		return err
+	}
+	wrong, tooLong := checkTableName(name)
+	switch {
+	case wrong:
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongTableName, "Incorrect table name '%.100s'", name)
+	case tooLong:
+		return errTooLongIdent(name)
+	}
+	return nil
+}
+
+// ValidateColumnName checks that name is a valid column name.
+func ValidateColumnName(name string) error {
+	if err := ValidateIdentifier(name); err != nil {
+		return err
+	}
+	if len(name) == 0 || endsWithSpace(name) {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongColumnName, "Incorrect column name '%.100s'", name)
+	}
+	return nil
+}
+
+func validateQualifiedTableName(table TableName) error {
+	if !table.Qualifier.IsEmpty() {
+		if err := ValidateDatabaseName(table.Qualifier.String()); err != nil {
+			return err
+		}
+	}
+	return ValidateTableName(table.Name.String())
+}
+
+func validateColumnDefinitions(columns []*ColumnDefinition) error {
+	for _, col := range columns {
+		if err := ValidateColumnName(col.Name.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateIndexDefinition(idx *IndexDefinition) error {
+	if err := ValidateIdentifier(idx.Info.Name.String()); err != nil {
+		return err
+	}
+	return ValidateIdentifier(idx.Info.ConstraintName.String())
+}
+
+func validateTableSpec(spec *TableSpec) error {
+	if spec == nil {
+		return nil
+	}
+	if err := validateColumnDefinitions(spec.Columns); err != nil {
+		return err
+	}
+	for _, idx := range spec.Indexes {
+		if err := validateIndexDefinition(idx); err != nil {
+			return err
+		}
+	}
+	for _, c := range spec.Constraints {
+		if err := ValidateIdentifier(c.Name.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateAlterOption(option AlterOption) error {
+	switch option := option.(type) {
+	case *AddColumns:
+		return validateColumnDefinitions(option.Columns)
+	case *AddIndexDefinition:
+		return validateIndexDefinition(option.IndexDefinition)
+	case *AddConstraintDefinition:
+		return ValidateIdentifier(option.ConstraintDefinition.Name.String())
+	case *ChangeColumn:
+		return ValidateColumnName(option.NewColDefinition.Name.String())
+	case *ModifyColumn:
+		return ValidateColumnName(option.NewColDefinition.Name.String())
+	case *RenameColumn:
+		return ValidateColumnName(option.NewName.Name.String())
+	case *RenameIndex:
+		return ValidateIdentifier(option.NewName.String())
+	case *RenameTableName:
+		return validateQualifiedTableName(option.Table)
+	}
+	return nil
+}
+
+// ValidateDDLIdentifiers checks the names of the objects that stmt creates or
+// renames, and returns the error that MySQL would return for the first invalid
+// one. The statements other than CREATE, ALTER and RENAME are always valid.
+func ValidateDDLIdentifiers(stmt Statement) error {
+	switch stmt := stmt.(type) {
+	case *CreateDatabase:
+		return ValidateDatabaseName(stmt.DBName.String())
+	case *AlterDatabase:
+		if stmt.DBName.IsEmpty() {
+			return nil
+		}
+		return ValidateDatabaseName(stmt.DBName.String())
+	case *CreateTable:
+		if err := validateQualifiedTableName(stmt.Table); err != nil {
+			return err
+		}
+		return validateTableSpec(stmt.TableSpec)
+	case *AlterTable:
+		if err := validateQualifiedTableName(stmt.Table); err != nil {
+			return err
+		}
+		for _, option := range stmt.AlterOptions {
+			if err := validateAlterOption(option); err != nil {
+				return err
+			}
+		}
+	case *CreateView:
+		if err := validateQualifiedTableName(stmt.ViewName); err != nil {
+			return err
+		}
+		for _, col := range stmt.Columns {
+			if err := ValidateColumnName(col.String()); err != nil {
+				return err
+			}
+		}
+	case *AlterView:
+		if err := validateQualifiedTableName(stmt.ViewName); err != nil {
+			return err
+		}
+		for _, col := range stmt.Columns {
+			if err := ValidateColumnName(col.String()); err != nil {
+				return err
+			}
+		}
+	case *RenameTable:
+		for _, pair := range stmt.TablePairs {
+			if err := validateQualifiedTableName(pair.ToTable); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+func TestValidateIdentifiers(t *testing.T) {
+	long := strings.Repeat("a", 65)
+	// 64 characters, but 128 bytes.
+	wide := strings.Repeat("é", 64)
+
+	testCases := []struct {
+		name     string
+		validate func(string) error
+		input    string
+		state    vterrors.State
+		err      string
+	}{
+		{name: "identifier", validate: ValidateIdentifier, input: "idx_1"},
+		{name: "empty identifier", validate: ValidateIdentifier, input: ""},
+		{name: "wide identifier", validate: ValidateIdentifier, input: wide},
+		{name: "long identifier", validate: ValidateIdentifier, input: long, state: vterrors.TooLongIdent, err: "Identifier name '" + long + "' is too long"},
+		{name: "supplementary character", validate: ValidateIdentifier, input: "t😀", state: vterrors.InvalidCharacterString, err: "Invalid utf8mb3 character string: 'F09F9880'"},
+		{name: "invalid utf8", validate: ValidateIdentifier, input: "t\xff", state: vterrors.InvalidCharacterString, err: "Invalid utf8mb3 character string: 'FF'"},
+		{name: "nul", validate: ValidateIdentifier, input: "t\x00", state: vterrors.InvalidCharacterString, err: "Invalid utf8mb3 character string: '00'"},
+
+		{name: "database", validate: ValidateDatabaseName, input: "commerce"},
+		{name: "empty database", validate: ValidateDatabaseName, input: "", state: vterrors.WrongDbName, err: "Incorrect database name ''"},
+		{name: "database with trailing space", validate: ValidateDatabaseName, input: "db ", state: vterrors.WrongDbName, err: "Incorrect database name 'db '"},
+		{name: "long database", validate: ValidateDatabaseName, input: long, state: vterrors.TooLongIdent, err: "Identifier name '" + long + "' is too long"},
+
+		{name: "table", validate: ValidateTableName, input: "customer"},
+		{name: "wide table", validate: ValidateTableName, input: wide},
+		{name: "empty table", validate: ValidateTableName, input: "", state: vterrors.WrongTableName, err: "Incorrect table name ''"},
+		{name: "table with trailing tab", validate: ValidateTableName, input: "t\t", state: vterrors.WrongTableName, err: "Incorrect table name 't\t'"},
+		{name: "long table", validate: ValidateTableName, input: long, state: vterrors.TooLongIdent, err: "Identifier name '" + long + "' is too long"},
+		{name: "table longer than 192 bytes", validate: ValidateTableName, input: strings.Repeat("é", 97), state: vterrors.WrongTableName, err: "Incorrect table name '" + strings.Repeat("é", 97) + "'"},
+
+		{name: "column", validate: ValidateColumnName, input: "id"},
+		{name: "empty column", validate: ValidateColumnName, input: "", state: vterrors.WrongColumnName, err: "Incorrect column name ''"},
+		{name: "column with trailing space", validate: ValidateColumnName, input: "id ", state: vterrors.WrongColumnName, err: "Incorrect column name 'id '"},
+		{name: "long column", validate: ValidateColumnName, input: long, state: vterrors.TooLongIdent, err: "Identifier name '" + long + "' is too long"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.validate(tc.input)
+			if tc.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.err)
+			assert.Equal(t, tc.state, vterrors.ErrState(err))
+		})
+	}
+}
+
+func TestValidateDDLIdentifiers(t *testing.T) {
+	long := strings.Repeat("x", 65)
+
+	testCases := []struct {
+		sql   string
+		state vterrors.State
+	}{
+		{sql: "create table t (id int primary key, name varchar(10), key idx_name (name), constraint chk check (id > 0))"},
+		{sql: "create table " + long + " (id int)", state: vterrors.TooLongIdent},
+		{sql: "create table " + long + ".t (id int)", state: vterrors.TooLongIdent},
+		{sql: "create table `t ` (id int)", state: vterrors.WrongTableName},
+		{sql: "create table t (`" + long + "` int)", state: vterrors.TooLongIdent},
+		{sql: "create table t (`id ` int)", state: vterrors.WrongColumnName},
+		{sql: "create table t (id int, key " + long + " (id))", state: vterrors.TooLongIdent},
+		{sql: "create table t (id int, constraint " + long + " check (id > 0))", state: vterrors.TooLongIdent},
+		{sql: "alter table t add column `" + long + "` int", state: vterrors.TooLongIdent},
+		{sql: "alter table t add index " + long + " (id)", state: vterrors.TooLongIdent},
+		{sql: "alter table t change column id `id ` int", state: vterrors.WrongColumnName},
+		{sql: "alter table t rename column id to " + long, state: vterrors.TooLongIdent},
+		{sql: "alter table t rename index idx to " + long, state: vterrors.TooLongIdent},
+		{sql: "alter table t rename to " + long, state: vterrors.TooLongIdent},
+		{sql: "rename table t to " + long, state: vterrors.TooLongIdent},
+		{sql: "create view " + long + " as select 1", state: vterrors.TooLongIdent},
+		{sql: "create view v (`a `) as select 1", state: vterrors.WrongColumnName},
+		{sql: "create database `db `", state: vterrors.WrongDbName},
+		{sql: "alter database " + long + " character set utf8mb4", state: vterrors.TooLongIdent},
+		{sql: "drop table " + long},
+		{sql: "select * from " + long},
+	}
+
+	parser := NewTestParser()
+	validating, err := New(Options{ValidateIdentifiers: true})
+	require.NoError(t, err)
+
+	for _, tc := range testCases {
+		t.Run(tc.sql, func(t *testing.T) {
+			stmt, err := parser.Parse(tc.sql)
+			require.NoError(t, err)
+
+			err = ValidateDDLIdentifiers(stmt)
+			_, parseErr := validating.Parse(tc.sql)
+			if tc.state == vterrors.Undefined {
+				assert.NoError(t, err)
+				assert.NoError(t, parseErr)
+				return
+			}
+			assert.Equal(t, tc.state, vterrors.ErrState(err))
+			assert.Equal(t, tc.state, vterrors.ErrState(parseErr))
+		})
+	}
+}
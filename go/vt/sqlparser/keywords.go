@@ -180,6 +180,7 @@ var keywords = []keyword{
 	{"complete", COMPLETE},
 	{"compressed", COMPRESSED},
 	{"compression", COMPRESSION},
+	{"concurrent", CONCURRENT},
 	{"condition", UNUSED},
 	{"connection", CONNECTION},
 	{"consistent", CONSISTENT},
@@ -327,7 +328,7 @@ var keywords = []keyword{
 	{"in", IN},
 	{"index", INDEX},
 	{"indexes", INDEXES},
-	{"infile", UNUSED},
+	{"infile", INFILE},
 	{"inout", UNUSED},
 	{"inner", INNER},
 	{"inplace", INPLACE},
@@ -0,0 +1,450 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// LineageColumn is a column of a physical table. Table is empty when the
+	// column is not qualified and the statement reads from more than one table,
+	// since the table it belongs to can't be known without a schema. Column is
+	// "*" when all the columns of the table are used.
+	LineageColumn struct {
+		Table  TableName
+		Column IdentifierCI
+	}
+
+	// OutputLineage describes a column returned by a statement, and the
+	// physical columns its value is computed from.
+	OutputLineage struct {
+		Name    string
+		Sources []LineageColumn
+	}
+
+	// Lineage describes which physical tables and columns a statement uses.
+	Lineage struct {
+		// Outputs contains one entry per column returned by the statement.
+		Outputs []OutputLineage
+		// Read contains all the columns read by the statement, including the ones
+		// only used for filtering, grouping or ordering.
+		Read []LineageColumn
+		// Written contains the columns changed by an INSERT or UPDATE.
+		// Columns deleted by a DELETE are not listed; only WrittenTables is set.
+		Written []LineageColumn
+
+		ReadTables    TableNames
+		WrittenTables TableNames
+	}
+
+	lineageTable struct {
+		alias string
+		// name is the physical table, and is empty for derived tables and CTEs,
+		// which have their columns in derived instead.
+		name    TableName
+		derived []OutputLineage
+	}
+
+	lineageScope struct {
+		parent *lineageScope
+		tables []*lineageTable
+	}
+
+	lineageBuilder struct {
+		lineage *Lineage
+		ctes    map[string][]OutputLineage
+		seen    map[string]bool
+	}
+)
+
+// ExtractLineage returns the tables and columns used by the given SELECT, UNION,
+// INSERT, UPDATE or DELETE statement. The analysis is purely syntactic: column
+// references are resolved through the aliases, derived tables and CTEs of the
+// statement down to the physical tables, but no schema information is used.
+func ExtractLineage(stmt Statement) (*Lineage, error) {
+	b := &lineageBuilder{
+		lineage: &Lineage{},
+		ctes:    map[string][]OutputLineage{},
+		seen:    map[string]bool{},
+	}
+
+	switch stmt := stmt.(type) {
+	case SelectStatement:
+		b.lineage.Outputs = b.selectStatement(stmt, nil)
+	case *Insert:
+		b.insert(stmt)
+	case *Update:
+		b.update(stmt)
+	case *Delete:
+		b.delete(stmt)
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot extract lineage from statement: %s", String(stmt))
+	}
+	return b.lineage, nil
+}
+
+func (lc LineageColumn) key() string {
+	return lc.Table.Qualifier.String() + "." + lc.Table.Name.String() + "." + lc.Column.Lowered()
+}
+
+func (b *lineageBuilder) read(cols ...LineageColumn) {
+	for _, col := range cols {
+		if k := "r:" + col.key(); !b.seen[k] {
+			b.seen[k] = true
+			b.lineage.Read = append(b.lineage.Read, col)
+		}
+	}
+}
+
+func (b *lineageBuilder) write(col LineageColumn) {
+	if k := "w:" + col.key(); !b.seen[k] {
+		b.seen[k] = true
+		b.lineage.Written = append(b.lineage.Written, col)
+	}
+	b.writeTable(col.Table)
+}
+
+func (b *lineageBuilder) readTable(tbl TableName) {
+	if k := "rt:" + String(tbl); !b.seen[k] {
+		b.seen[k] = true
+		b.lineage.ReadTables = append(b.lineage.ReadTables, tbl)
+	}
+}
+
+func (b *lineageBuilder) writeTable(tbl TableName) {
+	if tbl.IsEmpty() {
+		return
+	}
+	if k := "wt:" + String(tbl); !b.seen[k] {
+		b.seen[k] = true
+		b.lineage.WrittenTables = append(b.lineage.WrittenTables, tbl)
+	}
+}
+
+func (b *lineageBuilder) with(with *With, parent *lineageScope) {
+	if with == nil {
+		return
+	}
+	for _, cte := range with.CTEs {
+		outputs := b.selectStatement(cte.Subquery.Select, parent)
+		b.ctes[cte.ID.String()] = renameOutputs(outputs, cte.Columns)
+	}
+}
+
+func renameOutputs(outputs []OutputLineage, columns Columns) []OutputLineage {
+	for i, col := range columns {
+		if i < len(outputs) {
+			outputs[i].Name = col.String()
+		}
+	}
+	return outputs
+}
+
+func (b *lineageBuilder) selectStatement(stmt SelectStatement, parent *lineageScope) []OutputLineage {
+	switch stmt := stmt.(type) {
+	case *Select:
+		return b.selectLineage(stmt, parent)
+	case *Union:
+		b.with(stmt.With, parent)
+		outputs := b.selectStatement(stmt.Left, parent)
+		for i, out := range b.selectStatement(stmt.Right, parent) {
+			if i < len(outputs) {
+				outputs[i].Sources = append(outputs[i].Sources, out.Sources...)
+			}
+		}
+		for _, order := range stmt.OrderBy {
+			b.orderingExpr(order.Expr, outputs, &lineageScope{parent: parent})
+		}
+		return outputs
+	}
+	return nil
+}
+
+func (b *lineageBuilder) selectLineage(sel *Select, parent *lineageScope) []OutputLineage {
+	b.with(sel.With, parent)
+
+	scope := &lineageScope{parent: parent}
+	for _, te := range sel.From {
+		b.tableExpr(te, scope)
+	}
+
+	var outputs []OutputLineage
+	for _, expr := range sel.SelectExprs {
+		switch expr := expr.(type) {
+		case *StarExpr:
+			outputs = append(outputs, b.star(expr, scope)...)
+		case *AliasedExpr:
+			outputs = append(outputs, OutputLineage{
+				Name:    expr.ColumnName(),
+				Sources: b.expr(expr.Expr, scope),
+			})
+		}
+	}
+
+	if sel.Where != nil {
+		b.expr(sel.Where.Expr, scope)
+	}
+	if sel.GroupBy != nil {
+		for _, expr := range sel.GroupBy.Exprs {
+			b.orderingExpr(expr, outputs, scope)
+		}
+	}
+	if sel.Having != nil {
+		b.orderingExpr(sel.Having.Expr, outputs, scope)
+	}
+	for _, order := range sel.OrderBy {
+		b.orderingExpr(order.Expr, outputs, scope)
+	}
+	return outputs
+}
+
+// orderingExpr records the columns read by a GROUP BY, HAVING or ORDER BY
+// expression, which can also refer to the output columns by their alias.
+func (b *lineageBuilder) orderingExpr(expr Expr, outputs []OutputLineage, scope *lineageScope) {
+	if col, ok := expr.(*ColName); ok && col.Qualifier.IsEmpty() {
+		for _, out := range outputs {
+			if col.Name.EqualString(out.Name) {
+				return
+			}
+		}
+	}
+	b.expr(expr, scope)
+}
+
+func (b *lineageBuilder) star(star *StarExpr, scope *lineageScope) []OutputLineage {
+	var outputs []OutputLineage
+	for _, tbl := range scope.tables {
+		if star.TableName.NonEmpty() && star.TableName.Name.String() != tbl.alias {
+			continue
+		}
+		if tbl.name.IsEmpty() {
+			outputs = append(outputs, tbl.derived...)
+			continue
+		}
+		col := LineageColumn{Table: tbl.name, Column: NewIdentifierCI("*")}
+		b.read(col)
+		outputs = append(outputs, OutputLineage{Name: "*", Sources: []LineageColumn{col}})
+	}
+	return outputs
+}
+
+func (b *lineageBuilder) tableExpr(te TableExpr, scope *lineageScope) {
+	switch te := te.(type) {
+	case *AliasedTableExpr:
+		switch expr := te.Expr.(type) {
+		case TableName:
+			alias := expr.Name.String()
+			if te.As.NotEmpty() {
+				alias = te.As.String()
+			}
+			if cte, ok := b.ctes[expr.Name.String()]; ok && expr.Qualifier.IsEmpty() {
+				scope.tables = append(scope.tables, &lineageTable{alias: alias, derived: cte})
+				return
+			}
+			b.readTable(expr)
+			scope.tables = append(scope.tables, &lineageTable{alias: alias, name: expr})
+		case *DerivedTable:
+			parent := scope.parent
+			if expr.Lateral {
+				parent = scope
+			}
+			outputs := b.selectStatement(expr.Select, parent)
+			scope.tables = append(scope.tables, &lineageTable{
+				alias:   te.As.String(),
+				derived: renameOutputs(outputs, te.Columns),
+			})
+		}
+	case *JoinTableExpr:
+		b.tableExpr(te.LeftExpr, scope)
+		b.tableExpr(te.RightExpr, scope)
+		if te.Condition == nil {
+			return
+		}
+		if te.Condition.On != nil {
+			b.expr(te.Condition.On, scope)
+		}
+		for _, col := range te.Condition.Using {
+			b.expr(NewColName(col.String()), scope)
+		}
+	case *ParenTableExpr:
+		for _, expr := range te.Exprs {
+			b.tableExpr(expr, scope)
+		}
+	case *JSONTableExpr:
+		b.expr(te.Expr, scope)
+	}
+}
+
+// expr records the columns read by the given expression, and returns them.
+// Columns read by subqueries in the expression are included.
+func (b *lineageBuilder) expr(expr Expr, scope *lineageScope) []LineageColumn {
+	var cols []LineageColumn
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *ColName:
+			cols = append(cols, scope.resolve(node)...)
+		case *Subquery:
+			for _, out := range b.selectStatement(node.Select, scope) {
+				cols = append(cols, out.Sources...)
+			}
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	b.read(cols...)
+	return cols
+}
+
+func (scope *lineageScope) find(qualifier TableName) *lineageTable {
+	for s := scope; s != nil; s = s.parent {
+		for _, tbl := range s.tables {
+			if qualifier.Name.String() != tbl.alias {
+				continue
+			}
+			if qualifier.Qualifier.NotEmpty() && qualifier.Qualifier != tbl.name.Qualifier {
+				continue
+			}
+			return tbl
+		}
+	}
+	return nil
+}
+
+// resolve returns the physical columns the given column reference points to.
+func (scope *lineageScope) resolve(col *ColName) []LineageColumn {
+	if col.Qualifier.NonEmpty() {
+		if tbl := scope.find(col.Qualifier); tbl != nil {
+			return tbl.columns(col.Name)
+		}
+		return []LineageColumn{{Table: col.Qualifier, Column: col.Name}}
+	}
+
+	for s := scope; s != nil; s = s.parent {
+		if len(s.tables) == 0 {
+			continue
+		}
+		if len(s.tables) == 1 {
+			return s.tables[0].columns(col.Name)
+		}
+		for _, tbl := range s.tables {
+			if tbl.name.IsEmpty() && tbl.hasOutput(col.Name) {
+				return tbl.columns(col.Name)
+			}
+		}
+		break
+	}
+	return []LineageColumn{{Column: col.Name}}
+}
+
+func (tbl *lineageTable) hasOutput(name IdentifierCI) bool {
+	for _, out := range tbl.derived {
+		if name.EqualString(out.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (tbl *lineageTable) columns(name IdentifierCI) []LineageColumn {
+	if tbl.name.NonEmpty() {
+		return []LineageColumn{{Table: tbl.name, Column: name}}
+	}
+	for _, out := range tbl.derived {
+		if name.EqualString(out.Name) {
+			return out.Sources
+		}
+	}
+	return nil
+}
+
+func (b *lineageBuilder) insert(ins *Insert) {
+	tbl, ok := ins.Table.Expr.(TableName)
+	if !ok {
+		return
+	}
+	if len(ins.Columns) == 0 {
+		b.write(LineageColumn{Table: tbl, Column: NewIdentifierCI("*")})
+	}
+	for _, col := range ins.Columns {
+		b.write(LineageColumn{Table: tbl, Column: col})
+	}
+
+	switch rows := ins.Rows.(type) {
+	case SelectStatement:
+		b.selectStatement(rows, nil)
+	case Values:
+		for _, row := range rows {
+			for _, expr := range row {
+				b.expr(expr, &lineageScope{})
+			}
+		}
+	}
+
+	scope := &lineageScope{tables: []*lineageTable{{alias: tbl.Name.String(), name: tbl}}}
+	for _, ue := range ins.OnDup {
+		b.write(LineageColumn{Table: tbl, Column: ue.Name.Name})
+		b.expr(ue.Expr, scope)
+	}
+}
+
+func (b *lineageBuilder) update(upd *Update) {
+	b.with(upd.With, nil)
+
+	scope := &lineageScope{}
+	for _, te := range upd.TableExprs {
+		b.tableExpr(te, scope)
+	}
+	for _, ue := range upd.Exprs {
+		for _, col := range scope.resolve(ue.Name) {
+			b.write(col)
+		}
+		b.expr(ue.Expr, scope)
+	}
+	if upd.Where != nil {
+		b.expr(upd.Where.Expr, scope)
+	}
+	for _, order := range upd.OrderBy {
+		b.expr(order.Expr, scope)
+	}
+}
+
+func (b *lineageBuilder) delete(del *Delete) {
+	b.with(del.With, nil)
+
+	scope := &lineageScope{}
+	for _, te := range del.TableExprs {
+		b.tableExpr(te, scope)
+	}
+	if len(del.Targets) == 0 {
+		for _, tbl := range scope.tables {
+			b.writeTable(tbl.name)
+		}
+	}
+	for _, target := range del.Targets {
+		if tbl := scope.find(target); tbl != nil {
+			b.writeTable(tbl.name)
+		}
+	}
+	if del.Where != nil {
+		b.expr(del.Where.Expr, scope)
+	}
+	for _, order := range del.OrderBy {
+		b.expr(order.Expr, scope)
+	}
+}
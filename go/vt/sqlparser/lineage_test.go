@@ -0,0 +1,146 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lineageColumnsToStrings(cols []LineageColumn) []string {
+	var res []string
+	for _, col := range cols {
+		res = append(res, String(col.Table)+"."+col.Column.String())
+	}
+	return res
+}
+
+func lineageTablesToStrings(tables TableNames) []string {
+	var res []string
+	for _, tbl := range tables {
+		res = append(res, String(tbl))
+	}
+	return res
+}
+
+func TestExtractLineage(t *testing.T) {
+	testcases := []struct {
+		in            string
+		outputs       map[string][]string
+		read          []string
+		written       []string
+		readTables    []string
+		writtenTables []string
+	}{{
+		in:         "select a, b + 1 as c from t where d = 1",
+		outputs:    map[string][]string{"a": {"t.a"}, "c": {"t.b"}},
+		read:       []string{"t.a", "t.b", "t.d"},
+		readTables: []string{"t"},
+	}, {
+		in:         "select x.a, y.b from ks.t1 as x join t2 as y on x.id = y.id order by a",
+		outputs:    map[string][]string{"a": {"ks.t1.a"}, "b": {"t2.b"}},
+		read:       []string{"ks.t1.id", "t2.id", "ks.t1.a", "t2.b"},
+		readTables: []string{"ks.t1", "t2"},
+	}, {
+		in:         "select d.total from (select sum(price) as total from orders) as d",
+		outputs:    map[string][]string{"total": {"orders.price"}},
+		read:       []string{"orders.price"},
+		readTables: []string{"orders"},
+	}, {
+		in:         "with c (n) as (select name from users) select n from c",
+		outputs:    map[string][]string{"n": {"users.name"}},
+		read:       []string{"users.name"},
+		readTables: []string{"users"},
+	}, {
+		in:         "select a from t1 union select b from t2",
+		outputs:    map[string][]string{"a": {"t1.a", "t2.b"}},
+		read:       []string{"t1.a", "t2.b"},
+		readTables: []string{"t1", "t2"},
+	}, {
+		in:         "select id, (select max(v) from t2 where t2.id = t1.id) as m from t1",
+		outputs:    map[string][]string{"id": {"t1.id"}, "m": {"t2.v"}},
+		read:       []string{"t1.id", "t2.v", "t2.id"},
+		readTables: []string{"t1", "t2"},
+	}, {
+		in:         "select a from t1, t2",
+		outputs:    map[string][]string{"a": {".a"}},
+		read:       []string{".a"},
+		readTables: []string{"t1", "t2"},
+	}, {
+		in:         "select * from t",
+		outputs:    map[string][]string{"*": {"t.*"}},
+		read:       []string{"t.*"},
+		readTables: []string{"t"},
+	}, {
+		in:            "insert into t (a, b) select x, y from s where z > 0",
+		outputs:       map[string][]string{},
+		read:          []string{"s.x", "s.y", "s.z"},
+		written:       []string{"t.a", "t.b"},
+		readTables:    []string{"s"},
+		writtenTables: []string{"t"},
+	}, {
+		in:            "insert into t (a, b) values (1, 2) on duplicate key update b = b + 1",
+		outputs:       map[string][]string{},
+		read:          []string{"t.b"},
+		written:       []string{"t.a", "t.b"},
+		writtenTables: []string{"t"},
+	}, {
+		in:            "update t set a = b where c = 1",
+		outputs:       map[string][]string{},
+		read:          []string{"t.b", "t.c"},
+		written:       []string{"t.a"},
+		readTables:    []string{"t"},
+		writtenTables: []string{"t"},
+	}, {
+		in:            "delete x from t1 as x join t2 on x.id = t2.id where t2.v = 1",
+		outputs:       map[string][]string{},
+		read:          []string{"t1.id", "t2.id", "t2.v"},
+		readTables:    []string{"t1", "t2"},
+		writtenTables: []string{"t1"},
+	}}
+
+	parser := NewTestParser()
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			stmt, err := parser.Parse(tc.in)
+			require.NoError(t, err)
+
+			lineage, err := ExtractLineage(stmt)
+			require.NoError(t, err)
+
+			outputs := map[string][]string{}
+			for _, out := range lineage.Outputs {
+				outputs[out.Name] = lineageColumnsToStrings(out.Sources)
+			}
+			assert.Equal(t, tc.outputs, outputs)
+			assert.Equal(t, tc.read, lineageColumnsToStrings(lineage.Read))
+			assert.Equal(t, tc.written, lineageColumnsToStrings(lineage.Written))
+			assert.Equal(t, tc.readTables, lineageTablesToStrings(lineage.ReadTables))
+			assert.Equal(t, tc.writtenTables, lineageTablesToStrings(lineage.WrittenTables))
+		})
+	}
+}
+
+func TestExtractLineageUnsupported(t *testing.T) {
+	stmt, err := NewTestParser().Parse("create table t (id int)")
+	require.NoError(t, err)
+
+	_, err = ExtractLineage(stmt)
+	assert.Error(t, err)
+}
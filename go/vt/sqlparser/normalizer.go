@@ -65,7 +65,7 @@ func (nz *normalizer) walkStatementUp(cursor *Cursor) bool {
 		return false
 	}
 	node, isLiteral := cursor.Node().(*Literal)
-	if !isLiteral {
+	if !isLiteral || isArithmeticIdentity(node, cursor.Parent()) {
 		return true
 	}
 	nz.convertLiteral(node, cursor)
@@ -147,6 +147,9 @@ func (nz *normalizer) walkUpSelect(cursor *Cursor) bool {
 		return true
 	}
 	parent := cursor.Parent()
+	if isArithmeticIdentity(node, parent) {
+		return true
+	}
 	switch parent.(type) {
 	case *Order, *GroupBy:
 		return true
@@ -158,6 +161,28 @@ func (nz *normalizer) walkUpSelect(cursor *Cursor) bool {
 	return nz.err == nil // only continue if we haven't found any errors
 }
 
+// isArithmeticIdentity returns true if lit is the 0 that is added to or subtracted
+// from an expression, or the 1 it is multiplied by, like in `col + 0 = 5`. These
+// literals are not converted to bind vars, so that the planner can still remove
+// them from the columns they are applied to. They only ever have these values, so
+// they don't multiply the number of cached plans.
+func isArithmeticIdentity(lit *Literal, parent SQLNode) bool {
+	bin, ok := parent.(*BinaryExpr)
+	if !ok || lit.Type != IntVal {
+		return false
+	}
+	switch bin.Operator {
+	case PlusOp:
+		return lit.Val == "0"
+	case MinusOp:
+		return lit.Val == "0" && bin.Right == lit
+	case MultOp:
+		return lit.Val == "1"
+	default:
+		return false
+	}
+}
+
 func validateLiteral(node *Literal) error {
 	switch node.Type {
 	case DateVal:
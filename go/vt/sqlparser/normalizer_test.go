@@ -418,6 +418,26 @@ func TestNormalize(t *testing.T) {
 			"bv2": sqltypes.Int64BindVariable(2),
 			"bv3": sqltypes.TestBindVariable([]any{1, 2}),
 		},
+	}, {
+		// arithmetic identities are kept, so that the planner can remove them
+		in:      "select * from t where a + 0 = 1 and 0 + b - 0 = 2 and c * 1 = 3 and 1 * d = 4 and 0 - e = 5 and f + 1 = 6",
+		outstmt: "select * from t where a + 0 = :bv1 /* INT64 */ and 0 + b - 0 = :bv2 /* INT64 */ and c * 1 = :bv3 /* INT64 */ and 1 * d = :bv4 /* INT64 */ and :bv5 /* INT64 */ - e = :bv6 /* INT64 */ and f + :bv1 /* INT64 */ = :bv7 /* INT64 */",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+			"bv2": sqltypes.Int64BindVariable(2),
+			"bv3": sqltypes.Int64BindVariable(3),
+			"bv4": sqltypes.Int64BindVariable(4),
+			"bv5": sqltypes.Int64BindVariable(0),
+			"bv6": sqltypes.Int64BindVariable(5),
+			"bv7": sqltypes.Int64BindVariable(6),
+		},
+	}, {
+		// arithmetic identities outside of a select
+		in:      "delete from t where a * 1 = 1",
+		outstmt: "delete from t where a * 1 = :bv1 /* INT64 */",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+		},
 	}}
 	parser := NewTestParser()
 	for _, tc := range testcases {
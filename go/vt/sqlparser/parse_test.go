@@ -33,6 +33,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 var (
@@ -1310,6 +1313,21 @@ var (
 	}, {
 		input:  "insert into a values (1, 2, 3) as `a_values` (`foo`, bar, baz)",
 		output: "insert into a values (1, 2, 3) as a_values (foo, bar, baz)",
+	}, {
+		input:  "insert into a(a, b) values (1, 2) as new on duplicate key update b = new.a + new.b",
+		output: "insert into a(a, b) values (1, 2) as new on duplicate key update b = new.a + new.b",
+	}, {
+		input:  "insert into a set a = 1, b = 2 as new on duplicate key update b = new.a",
+		output: "insert into a(a, b) values (1, 2) as new on duplicate key update b = new.a",
+	}, {
+		input:  "insert into a set a = 1, b = 2 as new (x, y) on duplicate key update b = x + y",
+		output: "insert into a(a, b) values (1, 2) as new (x, y) on duplicate key update b = x + y",
+	}, {
+		// a row alias named like the table is only rejected by the semantic analysis
+		input: "insert into a values (1, 2) as a on duplicate key update b = a.a",
+	}, {
+		input:  "insert into a set a = 1 as a",
+		output: "insert into a(a) values (1) as a",
 	}, {
 		input: "insert /* bool expression on duplicate */ into a values (1, 2) on duplicate key update b = func(a), c = a > d",
 	}, {
@@ -2396,10 +2414,14 @@ var (
 		input: "show vitess_replication_status",
 	}, {
 		input: "show vitess_replication_status like '%'",
+	}, {
+		input: "show vitess_replication_status where ReplicationLag > 10",
 	}, {
 		input: "show vitess_shards",
 	}, {
 		input: "show vitess_shards like '%'",
+	}, {
+		input: "show vitess_shards where shards like 'ks/%'",
 	}, {
 		input: "show vitess_tablets",
 	}, {
@@ -2410,15 +2432,24 @@ var (
 		input: "show vitess_targets",
 	}, {
 		input: "show vschema tables",
+	}, {
+		input: "show vschema tables like 'user%'",
 	}, {
 		input: "show vschema keyspaces",
+	}, {
+		input: "show vschema keyspaces where sharded = 'true'",
 	}, {
 		input: "show vschema vindexes",
+	}, {
+		input: "show vschema vindexes where type = 'hash'",
 	}, {
 		input: "show vschema vindexes from t",
 	}, {
 		input:  "show vschema vindexes on t",
 		output: "show vschema vindexes from t",
+	}, {
+		input:  "show vschema vindexes on t like 'user%'",
+		output: "show vschema vindexes from t like 'user%'",
 	}, {
 		input: "show vitess_migrations",
 	}, {
@@ -4677,6 +4708,12 @@ func TestPositionedErr(t *testing.T) {
 		} else if posErr.Pos != tcase.output.Pos || posErr.Near != tcase.output.Near || err.Error() != tcase.output.Error() {
 			t.Errorf("%s: %v, want: %v", tcase.input, err, tcase.output)
 		}
+
+		// Parse returns the same error, which is a MySQL parse error.
+		_, err = parser.Parse(tcase.input)
+		assert.Equal(t, tcase.output, err)
+		assert.Equal(t, vterrors.ParseError, vterrors.ErrState(err))
+		assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
 	}
 }
 
@@ -4736,20 +4773,105 @@ func TestSubStr(t *testing.T) {
 }
 
 func TestLoadData(t *testing.T) {
-	validSQL := []string{
-		"load data from s3 'x.txt'",
-		"load data from s3 manifest 'x.txt'",
-		"load data from s3 file 'x.txt'",
-		"load data infile 'x.txt' into table 'c'",
-		"load data from s3 'x.txt' into table x"}
+	validSQL := []struct {
+		input  string
+		output string
+	}{{
+		input: "load data from s3 'x.txt'",
+	}, {
+		input: "load data from s3 manifest 'x.txt'",
+	}, {
+		input: "load data from s3 file 'x.txt'",
+	}, {
+		input: "load data from s3 'x.txt' into table x",
+	}, {
+		input:  "LOAD DATA FROM S3 PREFIX 's3://bucket/prefix' REPLACE INTO TABLE ks.c PARTITION (p0) FIELDS TERMINATED BY ',' IGNORE 1 LINES (a, @b) SET b = @b",
+		output: "load data from s3 prefix 's3://bucket/prefix' replace into table ks.c partition (p0) fields terminated by ',' ignore 1 lines (a, @b) set b = @b",
+	}, {
+		input: "load data infile 'x.txt' into table c",
+	}, {
+		input: "load data low_priority local infile '/tmp/x.txt' replace into table ks.c",
+	}, {
+		input: "load data concurrent infile 'x.txt' ignore into table c partition (p0, p1)",
+	}, {
+		input:  "LOAD DATA LOCAL INFILE 'x.csv' INTO TABLE c CHARACTER SET utf8mb4 COLUMNS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '\"' ESCAPED BY '\\\\' LINES STARTING BY 'xxx' TERMINATED BY '\\n' IGNORE 1 ROWS",
+		output: "load data local infile 'x.csv' into table c character set utf8mb4 fields terminated by ',' optionally enclosed by '\"' escaped by '\\\\' lines starting by 'xxx' terminated by '\\n' ignore 1 lines",
+	}, {
+		input:  "load data infile 'x.txt' into table c fields escaped by '' enclosed by '\\'' lines terminated by '\\r\\n' starting by 'a'",
+		output: "load data infile 'x.txt' into table c fields enclosed by '\\'' escaped by '' lines starting by 'a' terminated by '\\r\\n'",
+	}, {
+		input:  "load data infile 'x.txt' into table c ignore 10 lines (a, @b, `c`) set b = @b * 2, d = now()",
+		output: "load data infile 'x.txt' into table c ignore 10 lines (a, @b, c) set b = @b * 2, d = now()",
+	}, {
+		input:  "load data infile 'x.txt' into table c character set 'latin1' (`select`)",
+		output: "load data infile 'x.txt' into table c character set 'latin1' (`select`)",
+	}}
 
 	parser := NewTestParser()
 	for _, tcase := range validSQL {
-		_, err := parser.Parse(tcase)
-		require.NoError(t, err)
+		t.Run(tcase.input, func(t *testing.T) {
+			if tcase.output == "" {
+				tcase.output = tcase.input
+			}
+			tree, err := parser.Parse(tcase.input)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.output, String(tree))
+		})
+	}
+
+	invalidSQL := []string{
+		"load data infile 'x.txt' into table 'c'",
+		"load data infile x into table c",
+		"load data infile 'x.txt' into c",
+		"load data local low_priority infile 'x.txt' into table c",
+		"load data infile 'x.txt' into table c ignore lines",
+		"load data infile 'x.txt' into table c (1)",
+		"load data from s3 folder 'x.txt'",
+		"load data from 'x.txt' into table c",
+	}
+	for _, sql := range invalidSQL {
+		t.Run(sql, func(t *testing.T) {
+			_, err := parser.Parse(sql)
+			require.Error(t, err)
+		})
 	}
 }
 
+func TestLoadDataAST(t *testing.T) {
+	parser := NewTestParser()
+	stmt, err := parser.Parse("load data local infile '/tmp/t.csv' ignore into table ks.t fields terminated by ',' optionally enclosed by '\"' lines terminated by '\\n' ignore 1 lines (id, @name) set name = upper(@name)")
+	require.NoError(t, err)
+
+	load, ok := stmt.(*Load)
+	require.True(t, ok)
+	assert.Equal(t, NoLoadPriority, load.Priority)
+	assert.True(t, load.Local)
+	assert.Equal(t, InfileLoad, load.Source)
+	assert.Equal(t, "/tmp/t.csv", load.FileName)
+	assert.Equal(t, IgnoreOnDuplicateLoad, load.Duplicate)
+	assert.Equal(t, NewTableNameWithQualifier("t", "ks"), load.Table)
+	require.NotNil(t, load.Fields)
+	assert.Equal(t, NewStrLiteral(","), load.Fields.TerminatedBy)
+	assert.True(t, load.Fields.Optionally)
+	assert.Equal(t, NewStrLiteral(`"`), load.Fields.EnclosedBy)
+	assert.Nil(t, load.Fields.EscapedBy)
+	require.NotNil(t, load.Lines)
+	assert.Nil(t, load.Lines.StartingBy)
+	assert.Equal(t, NewStrLiteral("\n"), load.Lines.TerminatedBy)
+	assert.Equal(t, NewIntLiteral("1"), load.IgnoreLines)
+	assert.Equal(t, "id, @`name`", String(load.Columns))
+	assert.Equal(t, "`name` = upper(@`name`)", String(load.SetExprs))
+
+	stmt, err = parser.Parse("load data from s3 manifest 's3://bucket/manifest' into table t")
+	require.NoError(t, err)
+
+	load, ok = stmt.(*Load)
+	require.True(t, ok)
+	assert.Equal(t, S3ManifestLoad, load.Source)
+	assert.Equal(t, "s3://bucket/manifest", load.FileName)
+	assert.Equal(t, NewTableName("t"), load.Table)
+}
+
 func TestCreateTable(t *testing.T) {
 	createTableQueries := []struct {
 		input, output string
@@ -6314,6 +6436,69 @@ partition by range (id)
 	}
 }
 
+func TestParseWithConditionalComments(t *testing.T) {
+	testcases := []struct {
+		input        string
+		mysqlVersion string
+		output       string
+		comments     []ConditionalComment
+	}{
+		{
+			input:        "/*!40101 SET character_set_client = utf8mb4 */",
+			mysqlVersion: "8.0.30",
+			output:       "set @@character_set_client = utf8mb4",
+			comments: []ConditionalComment{{
+				Raw:      "/*!40101 SET character_set_client = utf8mb4 */",
+				Version:  "40101",
+				SQL:      "SET character_set_client = utf8mb4",
+				Included: true,
+			}},
+		}, {
+			input:        "/*!80030 SET GLOBAL innodb_redo_log_capacity = 1073741824 */",
+			mysqlVersion: "5.7.9",
+			output:       "/*!80030 SET GLOBAL innodb_redo_log_capacity = 1073741824 */",
+			comments: []ConditionalComment{{
+				Raw:     "/*!80030 SET GLOBAL innodb_redo_log_capacity = 1073741824 */",
+				Version: "80030",
+				SQL:     "SET GLOBAL innodb_redo_log_capacity = 1073741824",
+			}},
+		}, {
+			input:        "select /*!80000 SQL_NO_CACHE */ 1 from t",
+			mysqlVersion: "5.7.9",
+			output:       "select /*!80000 SQL_NO_CACHE */ 1 from t",
+			comments: []ConditionalComment{{
+				Raw:     "/*!80000 SQL_NO_CACHE */",
+				Version: "80000",
+				SQL:     "SQL_NO_CACHE",
+			}},
+		}, {
+			input:        "CREATE TABLE t (id int) /*!50100 PARTITION BY HASH (id) */",
+			mysqlVersion: "5.0.1",
+			output:       "create table t (\n\tid int\n)",
+			comments: []ConditionalComment{{
+				Raw:     "/*!50100 PARTITION BY HASH (id) */",
+				Version: "50100",
+				SQL:     "PARTITION BY HASH (id)",
+			}},
+		}, {
+			input:        "select 1 from t",
+			mysqlVersion: "8.0.30",
+			output:       "select 1 from t",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.input+":"+testcase.mysqlVersion, func(t *testing.T) {
+			parser, err := New(Options{MySQLServerVersion: testcase.mysqlVersion})
+			require.NoError(t, err)
+			tree, comments, err := parser.ParseWithConditionalComments(testcase.input)
+			require.NoError(t, err, testcase.input)
+			require.Equal(t, testcase.output, String(tree))
+			require.Equal(t, testcase.comments, comments)
+		})
+	}
+}
+
 func BenchmarkParseTraces(b *testing.B) {
 	parser := NewTestParser()
 	for _, trace := range []string{"django_queries.txt", "lobsters.sql.gz"} {
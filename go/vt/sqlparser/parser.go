@@ -76,11 +76,33 @@ func yyParsePooled(yylex yyLexer) int {
 // is partially parsed but still contains a syntax error, the
 // error is ignored and the DDL is returned anyway.
 func (p *Parser) Parse2(sql string) (Statement, BindVars, error) {
+	tokenizer, err := p.parse(sql)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tokenizer.ParseTree, tokenizer.BindVars, nil
+}
+
+// ParseWithConditionalComments behaves like Parse, and also returns the MySQL
+// conditional comments, `/*!50700 ... */`, that were found in the query. Depending
+// on the MySQL version of the Parser, their contents were either parsed as part of
+// the statement or excluded from it, as indicated by ConditionalComment.Included.
+// A query that only consists of excluded conditional comments is parsed as a
+// CommentOnly statement.
+func (p *Parser) ParseWithConditionalComments(sql string) (Statement, []ConditionalComment, error) {
+	tokenizer, err := p.parse(sql)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tokenizer.ParseTree, tokenizer.ConditionalComments, nil
+}
+
+func (p *Parser) parse(sql string) (*Tokenizer, error) {
 	tokenizer := p.NewStringTokenizer(sql)
 	if yyParsePooled(tokenizer) != 0 {
 		if tokenizer.partialDDL != nil {
 			if typ, val := tokenizer.Scan(); typ != 0 {
-				return nil, nil, fmt.Errorf("extra characters encountered after end of DDL: '%s'", val)
+				return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.ParseError, "extra characters encountered after end of DDL: '%s'", val)
 			}
 			log.Warningf("ignoring error parsing DDL '%s': %v", sql, tokenizer.LastError)
 			switch x := tokenizer.partialDDL.(type) {
@@ -90,14 +112,17 @@ func (p *Parser) Parse2(sql string) (Statement, BindVars, error) {
 				x.SetFullyParsed(false)
 			}
 			tokenizer.ParseTree = tokenizer.partialDDL
-			return tokenizer.ParseTree, tokenizer.BindVars, nil
+			return tokenizer, nil
 		}
-		return nil, nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, tokenizer.LastError.Error())
+		return nil, tokenizer.LastError
 	}
 	if tokenizer.ParseTree == nil {
-		return nil, nil, ErrEmpty
+		return nil, ErrEmpty
 	}
-	return tokenizer.ParseTree, tokenizer.BindVars, nil
+	if err := p.validateIdentifiers(tokenizer.ParseTree); err != nil {
+		return nil, err
+	}
+	return tokenizer, nil
 }
 
 // ConvertMySQLVersionToCommentVersion converts the MySQL version into comment version format.
@@ -164,6 +189,9 @@ func (p *Parser) ParseStrictDDL(sql string) (Statement, error) {
 	if tokenizer.ParseTree == nil {
 		return nil, ErrEmpty
 	}
+	if err := p.validateIdentifiers(tokenizer.ParseTree); err != nil {
+		return nil, err
+	}
 	return tokenizer.ParseTree, nil
 }
 
@@ -307,16 +335,31 @@ func (p *Parser) SetTruncateErrLen(l int) {
 	p.truncateErrLen = l
 }
 
+// validateIdentifiers checks the identifiers of the DDL statements, if the
+// Parser was created with the ValidateIdentifiers option. A nil Parser doesn't
+// check them.
+func (p *Parser) validateIdentifiers(stmt Statement) error {
+	if p == nil || !p.checkIdentifiers {
+		return nil
+	}
+	return ValidateDDLIdentifiers(stmt)
+}
+
 type Options struct {
 	MySQLServerVersion string
 	TruncateUILen      int
 	TruncateErrLen     int
+	// ValidateIdentifiers makes the parsing of DDL statements fail with the
+	// error of MySQL if they create objects with invalid names. See
+	// ValidateDDLIdentifiers.
+	ValidateIdentifiers bool
 }
 
 type Parser struct {
-	version        string
-	truncateUILen  int
-	truncateErrLen int
+	version          string
+	truncateUILen    int
+	truncateErrLen   int
+	checkIdentifiers bool
 }
 
 func New(opts Options) (*Parser, error) {
@@ -328,9 +371,10 @@ func New(opts Options) (*Parser, error) {
 		return nil, err
 	}
 	return &Parser{
-		version:        convVersion,
-		truncateUILen:  opts.TruncateUILen,
-		truncateErrLen: opts.TruncateErrLen,
+		version:          convVersion,
+		truncateUILen:    opts.TruncateUILen,
+		truncateErrLen:   opts.TruncateErrLen,
+		checkIdentifiers: opts.ValidateIdentifiers,
 	}, nil
 }
 
@@ -74,3 +74,16 @@ func TestEmptyErrorAndComments(t *testing.T) {
 		})
 	}
 }
+
+// TestNilParser checks that a nil Parser can still parse, without validating
+// the identifiers, as some callers don't set one up.
+func TestNilParser(t *testing.T) {
+	var parser *Parser
+	stmt, err := parser.Parse("select * from t1 where in_keyrange('-80')")
+	require.NoError(t, err)
+	require.Equal(t, "select * from t1 where in_keyrange('-80')", String(stmt))
+
+	stmt, err = parser.ParseStrictDDL("create table t1 (id bigint primary key)")
+	require.NoError(t, err)
+	require.IsType(t, &CreateTable{}, stmt)
+}
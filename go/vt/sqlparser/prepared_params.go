@@ -0,0 +1,173 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ParamContext is the kind of place where a parameter of a prepared statement
+// is used, which determines how its value is interpreted.
+type ParamContext int8
+
+const (
+	// ParamContextOther is any other expression, e.g. the argument of a function.
+	ParamContextOther ParamContext = iota
+	// ParamContextComparison is a value compared to a column, e.g. `col = ?` or
+	// `col BETWEEN ? AND ?`.
+	ParamContextComparison
+	// ParamContextInList is an element of the list of an IN comparison with a
+	// column, e.g. `col IN (?, ?)`.
+	ParamContextInList
+	// ParamContextInsertValue is a value inserted into a column.
+	ParamContextInsertValue
+	// ParamContextAssignment is a value assigned to a column by an UPDATE or an
+	// ON DUPLICATE KEY UPDATE.
+	ParamContextAssignment
+	// ParamContextLimit is the row count or the offset of a LIMIT clause.
+	ParamContextLimit
+	// ParamContextSelectExpr is returned as is by a SELECT.
+	ParamContextSelectExpr
+)
+
+// String returns the name of the context.
+func (c ParamContext) String() string {
+	switch c {
+	case ParamContextComparison:
+		return "comparison"
+	case ParamContextInList:
+		return "in list"
+	case ParamContextInsertValue:
+		return "insert value"
+	case ParamContextAssignment:
+		return "assignment"
+	case ParamContextLimit:
+		return "limit"
+	case ParamContextSelectExpr:
+		return "select expression"
+	default:
+		return "other"
+	}
+}
+
+// PreparedParam is a parameter of a prepared statement, i.e. a `?` of the query
+// of a PREPARE, which the tokenizer turns into the bind variable :v<position>.
+type PreparedParam struct {
+	// Position is the position of the parameter in the query, starting at 1,
+	// which is also the position of its value in the USING clause of EXECUTE.
+	Position int
+	// Name is the name of the bind variable of the parameter.
+	Name    string
+	Context ParamContext
+	// Column is the column that the value is compared to, inserted into or
+	// assigned to, if any.
+	Column *ColName
+}
+
+// PreparedParams returns the parameters of a prepared statement, in the order
+// of their position, along with the context in which each of them is used.
+func PreparedParams(stmt Statement) []PreparedParam {
+	var params []PreparedParam
+	index := make(map[*Argument]int)
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if arg, ok := node.(*Argument); ok {
+			if pos, ok := preparedParamPosition(arg.Name); ok {
+				index[arg] = len(params)
+				params = append(params, PreparedParam{Position: pos, Name: arg.Name})
+			}
+		}
+		return true, nil
+	}, stmt)
+	if len(params) == 0 {
+		return nil
+	}
+
+	set := func(expr Expr, context ParamContext, col *ColName) {
+		if arg, ok := expr.(*Argument); ok {
+			if i, ok := index[arg]; ok {
+				params[i].Context = context
+				params[i].Column = col
+			}
+		}
+	}
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *ComparisonExpr:
+			left, val := node.Left, node.Right
+			if _, ok := left.(*ColName); !ok {
+				left, val = val, left
+			}
+			col, ok := left.(*ColName)
+			if !ok {
+				break
+			}
+			if tuple, ok := val.(ValTuple); ok && (node.Operator == InOp || node.Operator == NotInOp) {
+				for _, expr := range tuple {
+					set(expr, ParamContextInList, col)
+				}
+				break
+			}
+			set(val, ParamContextComparison, col)
+		case *BetweenExpr:
+			if col, ok := node.Left.(*ColName); ok {
+				set(node.From, ParamContextComparison, col)
+				set(node.To, ParamContextComparison, col)
+			}
+		case *Insert:
+			if values, ok := node.Rows.(Values); ok {
+				for _, row := range values {
+					for i, expr := range row {
+						var col *ColName
+						if i < len(node.Columns) {
+							col = &ColName{Name: node.Columns[i]}
+						}
+						set(expr, ParamContextInsertValue, col)
+					}
+				}
+			}
+		case *UpdateExpr:
+			set(node.Expr, ParamContextAssignment, node.Name)
+		case *Limit:
+			set(node.Offset, ParamContextLimit, nil)
+			set(node.Rowcount, ParamContextLimit, nil)
+		case *AliasedExpr:
+			set(node.Expr, ParamContextSelectExpr, nil)
+		}
+		return true, nil
+	}, stmt)
+
+	slices.SortFunc(params, func(a, b PreparedParam) int {
+		return a.Position - b.Position
+	})
+	return params
+}
+
+// preparedParamPosition returns the position of the parameter of a prepared
+// statement that has the bind variable name, i.e. N for vN.
+func preparedParamPosition(name string) (int, bool) {
+	digits, ok := strings.CutPrefix(name, "v")
+	if !ok {
+		return 0, false
+	}
+	pos, err := strconv.Atoi(digits)
+	if err != nil || pos < 1 || digits[0] == '+' {
+		return 0, false
+	}
+	return pos, true
+}
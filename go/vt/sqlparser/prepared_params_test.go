@@ -0,0 +1,71 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreparedParams(t *testing.T) {
+	testCases := []struct {
+		query  string
+		params []string
+	}{{
+		query:  "select * from t where a = ? and ? < b",
+		params: []string{"v1 comparison a", "v2 comparison b"},
+	}, {
+		query:  "select ?, length(?) from t where t.a in (?, ?) and b between ? and ? limit ?, ?",
+		params: []string{"v1 select expression", "v2 other", "v3 in list t.a", "v4 in list t.a", "v5 comparison b", "v6 comparison b", "v7 limit", "v8 limit"},
+	}, {
+		query:  "insert into t(a, b) values (?, ?), (1, ?) on duplicate key update c = ?",
+		params: []string{"v1 insert value a", "v2 insert value b", "v3 insert value b", "v4 assignment c"},
+	}, {
+		query:  "insert into t values (?)",
+		params: []string{"v1 insert value"},
+	}, {
+		query:  "update t set a = ?, b = b + ? where id = ? order by c limit ?",
+		params: []string{"v1 assignment a", "v2 other", "v3 comparison id", "v4 limit"},
+	}, {
+		query:  "delete from t where id = :v2 and c = ?",
+		params: []string{"v1 comparison c", "v2 comparison id"},
+	}, {
+		query: "select * from t where a = :name and b = 1",
+	}}
+	parser := NewTestParser()
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			stmt, err := parser.Parse(tc.query)
+			require.NoError(t, err)
+
+			var got []string
+			for i, param := range PreparedParams(stmt) {
+				assert.Equal(t, fmt.Sprintf("v%d", param.Position), param.Name)
+				assert.Equal(t, i+1, param.Position)
+				desc := param.Name + " " + param.Context.String()
+				if param.Column != nil {
+					desc += " " + String(param.Column)
+				}
+				got = append(got, desc)
+			}
+			assert.Equal(t, tc.params, got)
+		})
+	}
+}
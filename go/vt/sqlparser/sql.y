@@ -133,6 +133,11 @@ func markBindVariable(yylex yyLexer, bvar string) {
   windowDefinitions WindowDefinitions
   namedWindow *NamedWindow
   namedWindows NamedWindows
+  loadPriority  LoadPriority
+  loadDuplicate LoadDuplicate
+  loadSource    LoadSource
+  loadFields    *LoadFields
+  loadLines     *LoadLines
 
   whens         []*When
   columnDefinitions []*ColumnDefinition
@@ -255,7 +260,7 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %token <str> DISTINCT AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK UNLOCK KEYS DO CALL
 %left <str> ALL ANY SOME
 %token <str> DISTINCTROW PARSER GENERATED ALWAYS
-%token <str> OUTFILE S3 DATA LOAD LINES TERMINATED ESCAPED ENCLOSED
+%token <str> OUTFILE S3 DATA LOAD LINES TERMINATED ESCAPED ENCLOSED INFILE CONCURRENT
 %token <str> DUMPFILE CSV HEADER MANIFEST OVERWRITE STARTING OPTIONALLY
 %token <str> VALUES LAST_INSERT_ID
 %token <str> NEXT VALUE SHARE MODE
@@ -571,6 +576,16 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %type <intPtr> length_opt
 %type <integer> func_datetime_precision
 %type <columnCharset> charset_opt
+%type <loadPriority> load_priority_opt
+%type <boolean> load_local_opt
+%type <loadDuplicate> load_duplicate_opt
+%type <loadSource> load_s3_source_opt
+%type <loadFields> load_fields_opt load_fields_opt_list
+%type <loadLines> load_lines_opt load_lines_opt_list
+%type <literal> load_ignore_lines_opt
+%type <exprs> load_column_list_opt load_column_list
+%type <expr> load_column
+%type <updateExprs> load_set_opt
 %type <str> collate_opt
 %type <boolean> binary_opt
 %type <LengthScaleOption> double_length_opt float_length_opt decimal_length_opt
@@ -717,9 +732,183 @@ do_statement:
   }
 
 load_statement:
-  LOAD DATA skip_to_end
+  LOAD DATA load_priority_opt load_local_opt INFILE STRING load_duplicate_opt INTO TABLE table_name opt_partition_clause charset_opt load_fields_opt load_lines_opt load_ignore_lines_opt load_column_list_opt load_set_opt
   {
-    $$ = &Load{}
+    $$ = &Load{Priority: $3, Local: $4, Source: InfileLoad, FileName: $6, Duplicate: $7, Table: $10, Partitions: $11, Charset: $12, Fields: $13, Lines: $14, IgnoreLines: $15, Columns: $16, SetExprs: $17}
+  }
+| LOAD DATA FROM S3 load_s3_source_opt STRING
+  {
+    $$ = &Load{Source: $5, FileName: $6}
+  }
+| LOAD DATA FROM S3 load_s3_source_opt STRING load_duplicate_opt INTO TABLE table_name opt_partition_clause charset_opt load_fields_opt load_lines_opt load_ignore_lines_opt load_column_list_opt load_set_opt
+  {
+    $$ = &Load{Source: $5, FileName: $6, Duplicate: $7, Table: $10, Partitions: $11, Charset: $12, Fields: $13, Lines: $14, IgnoreLines: $15, Columns: $16, SetExprs: $17}
+  }
+
+load_s3_source_opt:
+  {
+    $$ = S3Load
+  }
+| MANIFEST
+  {
+    $$ = S3ManifestLoad
+  }
+| ID
+  {
+    switch NewIdentifierCI($1).Lowered() {
+    case "file":
+      $$ = S3FileLoad
+    case "prefix":
+      $$ = S3PrefixLoad
+    default:
+      yylex.Error("expecting file, prefix or manifest after s3")
+      return 1
+    }
+  }
+
+load_priority_opt:
+  {
+    $$ = NoLoadPriority
+  }
+| LOW_PRIORITY
+  {
+    $$ = LowPriorityLoad
+  }
+| CONCURRENT
+  {
+    $$ = ConcurrentLoad
+  }
+
+load_local_opt:
+  {
+    $$ = false
+  }
+| LOCAL
+  {
+    $$ = true
+  }
+
+load_duplicate_opt:
+  {
+    $$ = ErrorOnDuplicateLoad
+  }
+| REPLACE
+  {
+    $$ = ReplaceOnDuplicateLoad
+  }
+| IGNORE
+  {
+    $$ = IgnoreOnDuplicateLoad
+  }
+
+load_fields_opt:
+  {
+    $$ = nil
+  }
+| columns_or_fields load_fields_opt_list
+  {
+    $$ = $2
+  }
+
+load_fields_opt_list:
+  {
+    $$ = &LoadFields{}
+  }
+| load_fields_opt_list TERMINATED BY STRING
+  {
+    $1.TerminatedBy = NewStrLiteral($4)
+    $$ = $1
+  }
+| load_fields_opt_list ENCLOSED BY STRING
+  {
+    $1.EnclosedBy = NewStrLiteral($4)
+    $$ = $1
+  }
+| load_fields_opt_list OPTIONALLY ENCLOSED BY STRING
+  {
+    $1.Optionally = true
+    $1.EnclosedBy = NewStrLiteral($5)
+    $$ = $1
+  }
+| load_fields_opt_list ESCAPED BY STRING
+  {
+    $1.EscapedBy = NewStrLiteral($4)
+    $$ = $1
+  }
+
+load_lines_opt:
+  {
+    $$ = nil
+  }
+| LINES load_lines_opt_list
+  {
+    $$ = $2
+  }
+
+load_lines_opt_list:
+  {
+    $$ = &LoadLines{}
+  }
+| load_lines_opt_list STARTING BY STRING
+  {
+    $1.StartingBy = NewStrLiteral($4)
+    $$ = $1
+  }
+| load_lines_opt_list TERMINATED BY STRING
+  {
+    $1.TerminatedBy = NewStrLiteral($4)
+    $$ = $1
+  }
+
+load_ignore_lines_opt:
+  {
+    $$ = nil
+  }
+| IGNORE INTEGRAL LINES
+  {
+    $$ = NewIntLiteral($2)
+  }
+| IGNORE INTEGRAL ROWS
+  {
+    $$ = NewIntLiteral($2)
+  }
+
+load_column_list_opt:
+  {
+    $$ = nil
+  }
+| openb load_column_list closeb
+  {
+    $$ = $2
+  }
+
+load_column_list:
+  load_column
+  {
+    $$ = Exprs{$1}
+  }
+| load_column_list ',' load_column
+  {
+    $$ = append($1, $3)
+  }
+
+load_column:
+  column_name
+  {
+    $$ = $1
+  }
+| user_defined_variable
+  {
+    $$ = $1
+  }
+
+load_set_opt:
+  {
+    $$ = nil
+  }
+| SET update_list
+  {
+    $$ = $2
   }
 
 with_clause:
@@ -941,15 +1130,15 @@ insert_statement:
     ins.OnDup = OnDup($7)
     $$ = ins
   }
-| insert_or_replace comment_opt ignore_opt into_table_name opt_partition_clause SET update_list on_dup_opt
+| insert_or_replace comment_opt ignore_opt into_table_name opt_partition_clause SET update_list row_alias_opt on_dup_opt
   {
     cols := make(Columns, 0, len($7))
-    vals := make(ValTuple, 0, len($8))
+    vals := make(ValTuple, 0, len($7))
     for _, updateList := range $7 {
       cols = append(cols, updateList.Name.Name)
       vals = append(vals, updateList.Expr)
     }
-    $$ = &Insert{Action: $1, Comments: Comments($2).Parsed(), Ignore: $3, Table: getAliasedTableExprFromTableName($4), Partitions: $5, Columns: cols, Rows: Values{vals}, OnDup: OnDup($8)}
+    $$ = &Insert{Action: $1, Comments: Comments($2).Parsed(), Ignore: $3, Table: getAliasedTableExprFromTableName($4), Partitions: $5, Columns: cols, Rows: Values{vals}, RowAlias: $8, OnDup: OnDup($9)}
   }
 
 insert_or_replace:
@@ -4157,7 +4346,7 @@ show_statement:
   {
     $$ = &ShowThrottledApps{}
   }
-| SHOW VITESS_REPLICATION_STATUS like_opt
+| SHOW VITESS_REPLICATION_STATUS like_or_where_opt
   {
     $$ = &Show{&ShowBasic{Command: VitessReplicationStatus, Filter: $3}}
   }
@@ -4165,21 +4354,21 @@ show_statement:
   {
     $$ = &ShowThrottlerStatus{}
   }
-| SHOW VSCHEMA TABLES
+| SHOW VSCHEMA TABLES like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: VschemaTables}}
+    $$ = &Show{&ShowBasic{Command: VschemaTables, Filter: $4}}
   }
-| SHOW VSCHEMA KEYSPACES
+| SHOW VSCHEMA KEYSPACES like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: VschemaKeyspaces}}
+    $$ = &Show{&ShowBasic{Command: VschemaKeyspaces, Filter: $4}}
   }
-| SHOW VSCHEMA VINDEXES
+| SHOW VSCHEMA VINDEXES like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: VschemaVindexes}}
+    $$ = &Show{&ShowBasic{Command: VschemaVindexes, Filter: $4}}
   }
-| SHOW VSCHEMA VINDEXES from_or_on table_name
+| SHOW VSCHEMA VINDEXES from_or_on table_name like_or_where_opt
   {
-    $$ = &Show{&ShowBasic{Command: VschemaVindexes, Tbl: $5}}
+    $$ = &Show{&ShowBasic{Command: VschemaVindexes, Tbl: $5, Filter: $6}}
   }
 | SHOW WARNINGS
   {
@@ -8282,6 +8471,7 @@ non_reserved_keyword:
 | COMPONENT
 | COMPRESSED
 | COMPRESSION
+| CONCURRENT
 | CONNECTION
 | CONSISTENT
 | COPY
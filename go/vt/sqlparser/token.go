@@ -22,6 +22,9 @@ import (
 	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 const (
@@ -38,6 +41,10 @@ type Tokenizer struct {
 	ParseTree           Statement
 	BindVars            map[string]struct{}
 
+	// ConditionalComments are the MySQL conditional comments, `/*!50700 ... */`,
+	// found in the statement, whether their contents were included or not.
+	ConditionalComments []ConditionalComment
+
 	lastToken      string
 	posVarIndex    int
 	partialDDL     Statement
@@ -85,13 +92,24 @@ func (tkn *Tokenizer) Lex(lval *yySymType) int {
 	return typ
 }
 
-// PositionedErr holds context related to parser errors
+// PositionedErr holds context related to parser errors. It is returned to the
+// MySQL clients as ER_PARSE_ERROR, like the syntax errors of MySQL.
 type PositionedErr struct {
 	Err  string
 	Pos  int
 	Near string
 }
 
+// ErrorState implements vterrors.ErrorWithState.
+func (p PositionedErr) ErrorState() vterrors.State {
+	return vterrors.ParseError
+}
+
+// ErrorCode implements vterrors.ErrorWithCode.
+func (p PositionedErr) ErrorCode() vtrpcpb.Code {
+	return vtrpcpb.Code_INVALID_ARGUMENT
+}
+
 func (p PositionedErr) Error() string {
 	if p.Near != "" {
 		return fmt.Sprintf("%s at position %v near '%s'", p.Err, p.Pos, p.Near)
@@ -678,11 +696,25 @@ func (tkn *Tokenizer) scanMySQLSpecificComment() (int, string) {
 		tkn.skip(1)
 	}
 
-	commentVersion, sql := ExtractMysqlComment(tkn.buf[start:tkn.Pos])
+	raw := tkn.buf[start:tkn.Pos]
+	commentVersion, sql := ExtractMysqlComment(raw)
+
+	// Only add the special comment to the tokenizer if the version of MySQL is higher or equal to the comment version
+	included := tkn.parser.version >= commentVersion
+	tkn.ConditionalComments = append(tkn.ConditionalComments, ConditionalComment{
+		Raw:      raw,
+		Version:  commentVersion,
+		SQL:      sql,
+		Included: included,
+	})
 
-	if tkn.parser.version >= commentVersion {
-		// Only add the special comment to the tokenizer if the version of MySQL is higher or equal to the comment version
+	if included {
 		tkn.specialComment = tkn.parser.NewStringTokenizer(sql)
+	} else if tkn.AllowComments {
+		// The comment is excluded for our version of MySQL, but where the grammar
+		// accepts comments we keep it verbatim in the statement, so that it's not
+		// lost when the statement is sent to a MySQL server that may include it.
+		return COMMENT, raw
 	}
 
 	return tkn.Scan()
@@ -708,6 +740,7 @@ func (tkn *Tokenizer) reset() {
 	tkn.ParseTree = nil
 	tkn.partialDDL = nil
 	tkn.specialComment = nil
+	tkn.ConditionalComments = nil
 	tkn.posVarIndex = 0
 	tkn.SkipToEnd = false
 }
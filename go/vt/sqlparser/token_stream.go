@@ -0,0 +1,198 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"errors"
+	"io"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// streamChunkSize is the minimum number of bytes read at once by a StreamTokenizer.
+	streamChunkSize = 64 * 1024
+	// streamLookahead is the number of bytes past the end of a token that must be
+	// in memory to be sure that the token is complete, since the tokenizer peeks
+	// at a few bytes past a token to find where it ends.
+	streamLookahead = 8
+)
+
+var (
+	// ErrTokenTooLarge is returned by a StreamTokenizer when a token is larger than
+	// its StreamLimits.MaxTokenSize.
+	ErrTokenTooLarge = vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.NetPacketTooLarge, "token size above threshold")
+	// ErrStatementTooLarge is returned by a StreamTokenizer when a statement is larger
+	// than its StreamLimits.MaxStatementSize.
+	ErrStatementTooLarge = vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.NetPacketTooLarge, "statement size above threshold")
+)
+
+// StreamLimits are the limits of a StreamTokenizer. The zero value has no limits.
+type StreamLimits struct {
+	// MaxTokenSize is the maximum size in bytes of a single token. A token must be
+	// kept in memory until it's complete, so this bounds the memory used by the
+	// tokenizer, e.g. for a huge string literal.
+	MaxTokenSize int
+	// MaxStatementSize is the maximum size in bytes of a statement, from the end
+	// of the previous one.
+	MaxStatementSize int64
+	// OnToken is called with every token that is scanned, along with the position
+	// where it starts in the input. Returning an error stops the scan, and the
+	// error is returned by Scan. This lets the caller enforce its own limits, e.g.
+	// on the number of rows of an INSERT.
+	OnToken func(typ int, val string, pos int64) error
+}
+
+// StreamTokenizer scans the tokens of SQL statements read from an io.Reader,
+// without reading the whole input in memory: only the token being scanned and
+// the next chunk of the input are kept. This allows handling or rejecting very
+// large statements, like the multi-hundred-MB INSERTs generated by bulk loaders,
+// with a bounded amount of memory.
+//
+// The values of the tokens share memory with the chunks of the input, so keeping
+// a token keeps its chunk in memory.
+type StreamTokenizer struct {
+	r      io.Reader
+	limits StreamLimits
+	tkn    *Tokenizer
+
+	chunkSize int
+	chunk     []byte
+	eof       bool
+	err       error
+
+	// offset is the position in the input of the buffer of tkn
+	offset int64
+	// stmtStart is the position in the input of the current statement
+	stmtStart int64
+	// tokenStart is the position in the input of the last token
+	tokenStart int64
+}
+
+// NewStreamTokenizer creates a new StreamTokenizer for the SQL statements
+// read from r.
+func (p *Parser) NewStreamTokenizer(r io.Reader, limits StreamLimits) *StreamTokenizer {
+	return &StreamTokenizer{
+		r:         r,
+		limits:    limits,
+		tkn:       p.NewStringTokenizer(""),
+		chunkSize: streamChunkSize,
+	}
+}
+
+// Scan returns the next token of the input, like Tokenizer.Scan: a type of 0
+// means that the whole input has been scanned. It returns an error if the input
+// couldn't be read or if a limit was exceeded, after which every call to Scan
+// returns that error.
+func (st *StreamTokenizer) Scan() (int, string, error) {
+	if st.err != nil {
+		return 0, "", st.err
+	}
+	typ, val, err := st.scan()
+	if err != nil {
+		st.err = err
+		return 0, "", err
+	}
+	return typ, val, nil
+}
+
+// Pos returns the position in the input of the start of the last token.
+func (st *StreamTokenizer) Pos() int64 {
+	return st.tokenStart
+}
+
+func (st *StreamTokenizer) scan() (int, string, error) {
+	tkn := st.tkn
+	for {
+		// The tokens of a MySQL specific comment are scanned from the comment,
+		// which is already in memory
+		if tkn.specialComment == nil {
+			tkn.skipBlank()
+			if !st.eof && len(tkn.buf)-tkn.Pos < streamLookahead {
+				if err := st.fill(); err != nil {
+					return 0, "", err
+				}
+				continue
+			}
+		}
+
+		start := tkn.Pos
+		conditionalComments := len(tkn.ConditionalComments)
+		typ, val := tkn.Scan()
+		fromComment := tkn.specialComment != nil
+
+		if !fromComment && !st.eof && tkn.Pos+streamLookahead > len(tkn.buf) {
+			// The token may go on past the end of the buffer: scan it again with
+			// the next chunk of the input
+			if st.limits.MaxTokenSize > 0 && tkn.Pos-start > st.limits.MaxTokenSize {
+				return 0, "", ErrTokenTooLarge
+			}
+			tkn.Pos = start
+			tkn.ConditionalComments = tkn.ConditionalComments[:conditionalComments]
+			if err := st.fill(); err != nil {
+				return 0, "", err
+			}
+			continue
+		}
+
+		if tkn.Pos != start {
+			if st.limits.MaxTokenSize > 0 && tkn.Pos-start > st.limits.MaxTokenSize {
+				return 0, "", ErrTokenTooLarge
+			}
+			st.tokenStart = st.offset + int64(start)
+		}
+		end := st.offset + int64(tkn.Pos)
+		if st.limits.MaxStatementSize > 0 && end-st.stmtStart > st.limits.MaxStatementSize {
+			return 0, "", ErrStatementTooLarge
+		}
+		if st.limits.OnToken != nil {
+			if err := st.limits.OnToken(typ, val, st.tokenStart); err != nil {
+				return 0, "", err
+			}
+		}
+		if typ == ';' {
+			st.stmtStart = end
+		}
+		return typ, val, nil
+	}
+}
+
+// fill reads the next chunk of the input, and drops the part of the buffer that
+// has already been scanned. The chunks grow with the token being scanned, so that
+// scanning a large token doesn't copy it over and over.
+func (st *StreamTokenizer) fill() error {
+	tkn := st.tkn
+	pending := tkn.buf[tkn.Pos:]
+
+	size := max(st.chunkSize, len(pending))
+	if cap(st.chunk) < size {
+		st.chunk = make([]byte, size)
+	}
+	n, err := io.ReadFull(st.r, st.chunk[:size])
+	switch {
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		st.eof = true
+	case err != nil:
+		return err
+	}
+
+	st.offset += int64(tkn.Pos)
+	tkn.buf = pending + string(st.chunk[:n])
+	tkn.Pos = 0
+	return nil
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type scannedToken struct {
+	typ int
+	val string
+}
+
+func TestStreamTokenizer(t *testing.T) {
+	queries := []string{
+		"select * from t where a = 1",
+		"insert into t(a, b) values (1, 'foo'), (2, 'b''ar\\n'), (3, x'ABCD'), (4, b'0101'), (-5.25e-3, N'baz')",
+		"select `a``b`, @x, @@session.sql_mode, :v, ? from dual; select 1;;select 2",
+		"select 1 -- a comment\n, 2 # another one\n, 3 /* and another */ , 4",
+		"select /*!50000 1, */ 2 /*!99999 3, */ /*!50000 */ 4",
+		"select a->'$.b', a->>'$.c', a <=> b, a := 1, 1.e5, .5, 0x1F, 'unterminated",
+		"   \n\t  ",
+		"",
+	}
+	parser := NewTestParser()
+	for _, query := range queries {
+		tkn := parser.NewStringTokenizer(query)
+		var expected []scannedToken
+		for {
+			typ, val := tkn.Scan()
+			expected = append(expected, scannedToken{typ, val})
+			if typ == 0 {
+				break
+			}
+		}
+
+		for _, chunkSize := range []int{1, 2, 3, 5, 8, 13, streamChunkSize} {
+			t.Run(fmt.Sprintf("%s/%d", query, chunkSize), func(t *testing.T) {
+				st := parser.NewStreamTokenizer(strings.NewReader(query), StreamLimits{})
+				st.chunkSize = chunkSize
+				var actual []scannedToken
+				for {
+					typ, val, err := st.Scan()
+					require.NoError(t, err)
+					actual = append(actual, scannedToken{typ, val})
+					if typ == 0 {
+						break
+					}
+				}
+				assert.Equal(t, expected, actual)
+			})
+		}
+	}
+}
+
+func TestStreamTokenizerLargeStatement(t *testing.T) {
+	var query strings.Builder
+	query.WriteString("insert into t(a, b) values ")
+	for i := range 10000 {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		fmt.Fprintf(&query, "(%d, '%s')", i, strings.Repeat("x", i%100))
+	}
+	query.WriteString("; select 1")
+
+	parser := NewTestParser()
+	tuples := 0
+	var positions []int64
+	st := parser.NewStreamTokenizer(strings.NewReader(query.String()), StreamLimits{
+		OnToken: func(typ int, val string, pos int64) error {
+			if typ == '(' {
+				tuples++
+			}
+			if typ == INTEGRAL && val == "9999" {
+				positions = append(positions, pos)
+			}
+			return nil
+		},
+	})
+	st.chunkSize = 1000
+	for {
+		typ, _, err := st.Scan()
+		require.NoError(t, err)
+		if typ == 0 {
+			break
+		}
+	}
+	assert.Equal(t, 10001, tuples)
+	require.Len(t, positions, 1)
+	assert.Equal(t, int64(strings.Index(query.String(), "(9999, ")+1), positions[0])
+
+	// A string literal larger than the chunks is scanned whole
+	large := strings.Repeat("abc", 100000)
+	st = parser.NewStreamTokenizer(strings.NewReader("select '"+large+"' from t"), StreamLimits{})
+	st.chunkSize = 1000
+	for _, expected := range []scannedToken{{SELECT, "select"}, {STRING, large}, {FROM, "from"}, {ID, "t"}, {0, ""}} {
+		typ, val, err := st.Scan()
+		require.NoError(t, err)
+		assert.Equal(t, expected, scannedToken{typ, val})
+	}
+}
+
+func TestStreamTokenizerLimits(t *testing.T) {
+	parser := NewTestParser()
+	scanAll := func(query string, limits StreamLimits) error {
+		st := parser.NewStreamTokenizer(strings.NewReader(query), limits)
+		st.chunkSize = 16
+		for {
+			typ, _, err := st.Scan()
+			if err != nil {
+				// The error is sticky
+				_, _, err2 := st.Scan()
+				assert.Equal(t, err, err2)
+				return err
+			}
+			if typ == 0 {
+				return nil
+			}
+		}
+	}
+
+	query := "select 'aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa' from t; select 1 from t"
+	assert.NoError(t, scanAll(query, StreamLimits{MaxTokenSize: 52, MaxStatementSize: 70}))
+	assert.ErrorIs(t, scanAll(query, StreamLimits{MaxTokenSize: 40}), ErrTokenTooLarge)
+	assert.ErrorIs(t, scanAll(query, StreamLimits{MaxStatementSize: 60}), ErrStatementTooLarge)
+
+	errTooManyRows := errors.New("too many rows")
+	rows := 0
+	err := scanAll("insert into t values (1), (2), (3), (4)", StreamLimits{
+		OnToken: func(typ int, _ string, _ int64) error {
+			if typ == '(' {
+				rows++
+			}
+			if rows > 3 {
+				return errTooManyRows
+			}
+			return nil
+		},
+	})
+	assert.ErrorIs(t, err, errTooManyRows)
+	assert.Equal(t, 4, rows)
+
+	errRead := errors.New("read failed")
+	st := parser.NewStreamTokenizer(&failingReader{data: "select 1 from t where", err: errRead}, StreamLimits{})
+	st.chunkSize = 4
+	for {
+		typ, _, err := st.Scan()
+		if err != nil {
+			assert.ErrorIs(t, err, errRead)
+			break
+		}
+		require.NotZero(t, typ)
+	}
+}
+
+type failingReader struct {
+	data string
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.data == "" {
+		return 0, r.err
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
@@ -16,6 +16,10 @@ limitations under the License.
 
 package sqlparser
 
+import (
+	"vitess.io/vitess/go/mysql/collations/charset"
+)
+
 const TruncationText = "[TRUNCATED]"
 
 // GetTruncateErrLen is a function used to read the value of truncateErrLen
@@ -34,7 +38,9 @@ func TruncateQuery(query string, max int) string {
 		max = len(TruncationText) + 1
 	}
 
-	return comments.Leading + sql[:max-(len(TruncationText)+1)] + " " + TruncationText + comments.Trailing
+	// cut the query where it doesn't split a character, e.g. in a string literal
+	sql = string(charset.TruncateGraphemesToSize(charset.Charset_utf8mb4{}, []byte(sql), max-(len(TruncationText)+1)))
+	return comments.Leading + sql + " " + TruncationText + comments.Trailing
 }
 
 // TruncateForUI is used when displaying queries on various Vitess status pages
@@ -38,6 +38,11 @@ func TestTruncateQuery(t *testing.T) {
 			max:   1005,
 			want:  "select * from test where name = 'abc'",
 		},
+		{
+			query: "select * from t where name = '👍🏽' and id = 1",
+			max:   48,
+			want:  "select * from t where name = ' [TRUNCATED]",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(fmt.Sprintf("%s-%d", tt.query, tt.max), func(t *testing.T) {
@@ -0,0 +1,340 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"strings"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// versionRewrite is a rewrite of syntax that is only accepted, or only has
+// the same meaning, in some MySQL versions.
+type versionRewrite struct {
+	// name identifies the rewrite in the results of RewriteForVersion.
+	name string
+	// applies returns whether the rewrite is needed when moving a statement
+	// between the given versions, in comment version format (e.g. "80019").
+	applies func(source, target string) bool
+	// rewrite changes the given node in place if needed for the given target
+	// version, and returns whether it did.
+	rewrite func(node SQLNode, target string) (bool, error)
+}
+
+// versionRewrites contains all the known version rewrites, in the order in
+// which they are applied.
+var versionRewrites = []versionRewrite{
+	{
+		// MySQL 8.0.19 deprecated the display width of integer types, and no longer
+		// reports it in SHOW CREATE TABLE, except for TINYINT(1) and ZEROFILL columns.
+		name:    "integer_display_width",
+		applies: upgradesPast("80019"),
+		rewrite: rewriteIntegerDisplayWidth,
+	},
+	{
+		// Older versions report the default display width of the integer
+		// columns that don't have one, so it's added back when downgrading.
+		name:    "add_integer_display_width",
+		applies: downgradesPast("80019"),
+		rewrite: addIntegerDisplayWidth,
+	},
+	{
+		// MySQL 8.0.19 deprecated YEAR(4); YEAR(2) is not supported since 5.7.5.
+		name:    "year_display_width",
+		applies: upgradesPast("80019"),
+		rewrite: rewriteYearDisplayWidth,
+	},
+	{
+		// Older versions report YEAR columns as YEAR(4).
+		name:    "add_year_display_width",
+		applies: downgradesPast("80019"),
+		rewrite: addYearDisplayWidth,
+	},
+	{
+		// MySQL 8.0.13 introduced expressions as DEFAULT values. Older versions
+		// only accept literals, which is all we can translate them into. Newer
+		// versions accept literal defaults too, so this only applies when
+		// downgrading.
+		name:    "expression_default",
+		applies: downgradesPast("80013"),
+		rewrite: rewriteExpressionDefault,
+	},
+	{
+		// The utf8mb4_0900 family of collations only exists since MySQL 8.0.
+		// The older utf8mb4 collations still exist in 8.0, so this only applies
+		// when downgrading. None of them compares exactly like a utf8mb4_0900
+		// collation, so the rewrite is approximate.
+		name:    "utf8mb4_0900_collation_approximate",
+		applies: downgradesPast("80000"),
+		rewrite: rewriteUtf8mb4_0900Collation,
+	},
+}
+
+// RewriteForVersion translates the version-specific syntax in the given statement,
+// which was written for a MySQL server of sourceVersion, into the syntax that a
+// server of targetVersion accepts with the same meaning. This works both when
+// upgrading and downgrading between versions. Versions are in the same format as
+// --mysql_server_version, e.g. "5.7.9" or "8.0.30".
+//
+// The input statement is never modified. RewriteForVersion returns the rewritten
+// statement and the names of the rewrites that changed it, or an error if the
+// statement uses syntax that cannot be expressed in the target version.
+//
+// The rewrites whose name ends in "_approximate" change the meaning of the
+// statement slightly, e.g. how a column compares its values; the callers that
+// need the exact same meaning should refuse a statement that needed them.
+func RewriteForVersion(stmt Statement, sourceVersion, targetVersion string) (Statement, []string, error) {
+	source, err := ConvertMySQLVersionToCommentVersion(sourceVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err := ConvertMySQLVersionToCommentVersion(targetVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rewrites []*versionRewrite
+	for i := range versionRewrites {
+		if versionRewrites[i].applies(source, target) {
+			rewrites = append(rewrites, &versionRewrites[i])
+		}
+	}
+	if len(rewrites) == 0 {
+		return stmt, nil, nil
+	}
+
+	stmt = CloneStatement(stmt)
+	changed := make([]bool, len(rewrites))
+	_ = Rewrite(stmt, func(cursor *Cursor) bool {
+		if err != nil {
+			return false
+		}
+		for i, rw := range rewrites {
+			var c bool
+			c, err = rw.rewrite(cursor.Node(), targetVersion)
+			if err != nil {
+				return false
+			}
+			changed[i] = changed[i] || c
+		}
+		return true
+	}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var applied []string
+	for i, rw := range rewrites {
+		if changed[i] {
+			applied = append(applied, rw.name)
+		}
+	}
+	return stmt, applied, nil
+}
+
+// upgradesPast returns a versionRewrite.applies func for syntax changes
+// introduced in the given version, when going from an older version
+// to a newer one.
+func upgradesPast(version string) func(source, target string) bool {
+	return func(source, target string) bool {
+		return source < version && target >= version
+	}
+}
+
+// downgradesPast returns a versionRewrite.applies func for syntax introduced
+// in the given version, when going from a newer version to an older one.
+func downgradesPast(version string) func(source, target string) bool {
+	return func(source, target string) bool {
+		return source >= version && target < version
+	}
+}
+
+func rewriteIntegerDisplayWidth(node SQLNode, _ string) (bool, error) {
+	ct, ok := node.(*ColumnType)
+	if !ok || ct.Length == nil || ct.Zerofill {
+		return false, nil
+	}
+	switch keywordVals[strings.ToLower(ct.Type)] {
+	case TINYINT:
+		if *ct.Length == 1 {
+			return false, nil
+		}
+	case SMALLINT, MEDIUMINT, INT, INTEGER, BIGINT:
+	default:
+		return false, nil
+	}
+	ct.Length = nil
+	return true, nil
+}
+
+func rewriteYearDisplayWidth(node SQLNode, _ string) (bool, error) {
+	ct, ok := node.(*ColumnType)
+	if !ok || ct.Length == nil || keywordVals[strings.ToLower(ct.Type)] != YEAR {
+		return false, nil
+	}
+	ct.Length = nil
+	return true, nil
+}
+
+// addIntegerDisplayWidth sets the display width of an integer column without
+// one to the default one of MySQL 5.7, which depends on whether the column is
+// unsigned. ZEROFILL columns are always unsigned.
+func addIntegerDisplayWidth(node SQLNode, _ string) (bool, error) {
+	ct, ok := node.(*ColumnType)
+	if !ok || ct.Length != nil {
+		return false, nil
+	}
+	var signed, unsigned int
+	switch keywordVals[strings.ToLower(ct.Type)] {
+	case TINYINT:
+		signed, unsigned = 4, 3
+	case SMALLINT:
+		signed, unsigned = 6, 5
+	case MEDIUMINT:
+		signed, unsigned = 9, 8
+	case INT, INTEGER:
+		signed, unsigned = 11, 10
+	case BIGINT:
+		signed, unsigned = 20, 20
+	default:
+		return false, nil
+	}
+	if ct.Unsigned || ct.Zerofill {
+		ct.Length = &unsigned
+	} else {
+		ct.Length = &signed
+	}
+	return true, nil
+}
+
+func addYearDisplayWidth(node SQLNode, _ string) (bool, error) {
+	ct, ok := node.(*ColumnType)
+	if !ok || ct.Length != nil || keywordVals[strings.ToLower(ct.Type)] != YEAR {
+		return false, nil
+	}
+	width := 4
+	ct.Length = &width
+	return true, nil
+}
+
+func rewriteExpressionDefault(node SQLNode, target string) (bool, error) {
+	switch node := node.(type) {
+	case *ColumnDefinition:
+		if node.Type == nil || node.Type.Options == nil {
+			return false, nil
+		}
+		opts := node.Type.Options
+		if opts.Default == nil || opts.DefaultLiteral {
+			return false, nil
+		}
+		if !isLiteralDefault(opts.Default) {
+			return false, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot rewrite DEFAULT (%s) of column %s for MySQL version %s: only literal defaults are supported",
+				String(opts.Default), node.Name.String(), target)
+		}
+		opts.DefaultLiteral = true
+		return true, nil
+	case *AlterColumn:
+		if node.DefaultVal == nil || node.DefaultLiteral {
+			return false, nil
+		}
+		if !isLiteralDefault(node.DefaultVal) {
+			return false, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot rewrite DEFAULT (%s) of column %s for MySQL version %s: only literal defaults are supported",
+				String(node.DefaultVal), String(node.Column), target)
+		}
+		node.DefaultLiteral = true
+		return true, nil
+	}
+	return false, nil
+}
+
+// isLiteralDefault returns whether the given DEFAULT value is accepted
+// without parentheses by the MySQL grammar.
+func isLiteralDefault(expr Expr) bool {
+	switch expr := expr.(type) {
+	case *Literal, BoolVal, *NullVal, *CurTimeFuncExpr:
+		return true
+	case *UnaryExpr:
+		_, ok := expr.Expr.(*Literal)
+		return ok && expr.Operator == UMinusOp
+	}
+	return false
+}
+
+func rewriteUtf8mb4_0900Collation(node SQLNode, target string) (bool, error) {
+	switch node := node.(type) {
+	case *ColumnType:
+		if node.Options == nil {
+			return false, nil
+		}
+		collation, ok, err := downgradeUtf8mb4_0900Collation(node.Options.Collate, target)
+		if ok {
+			node.Options.Collate = collation
+		}
+		return ok, err
+	case TableOptions:
+		var changed bool
+		for _, opt := range node {
+			if !strings.EqualFold(opt.Name, "collate") {
+				continue
+			}
+			collation, ok, err := downgradeUtf8mb4_0900Collation(opt.String, target)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				opt.String = collation
+				changed = true
+			}
+		}
+		return changed, nil
+	}
+	return false, nil
+}
+
+// utf8mb4_0900Downgrades maps the utf8mb4_0900 collations to the closest
+// collation before MySQL 8.0, which is not equivalent to it:
+//   - utf8mb4_0900_ai_ci is NO PAD while utf8mb4_general_ci is PAD SPACE, and
+//     general_ci doesn't expand characters like 'ß' or ligatures;
+//   - utf8mb4_0900_as_cs sorts linguistically while utf8mb4_bin sorts by code
+//     point, and is NO PAD while utf8mb4_bin is PAD SPACE;
+//   - utf8mb4_0900_bin is NO PAD while utf8mb4_bin is PAD SPACE.
+//
+// The language-specific ones, and those that are accent-sensitive but
+// case-insensitive, compare in ways that no older collation approximates.
+var utf8mb4_0900Downgrades = map[string]string{
+	"utf8mb4_0900_ai_ci": "utf8mb4_general_ci",
+	"utf8mb4_0900_as_cs": "utf8mb4_bin",
+	"utf8mb4_0900_bin":   "utf8mb4_bin",
+}
+
+// downgradeUtf8mb4_0900Collation returns the pre-8.0 utf8mb4 collation that
+// is closest to the given utf8mb4_0900 collation, and whether the collation
+// was one. It fails for the utf8mb4_0900 collations that no older collation
+// approximates.
+func downgradeUtf8mb4_0900Collation(collation, target string) (string, bool, error) {
+	lower := strings.ToLower(collation)
+	if !strings.HasPrefix(lower, "utf8mb4_") || !strings.Contains(lower, "_0900_") {
+		return collation, false, nil
+	}
+	downgraded, ok := utf8mb4_0900Downgrades[lower]
+	if !ok {
+		return collation, false, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot rewrite COLLATE %s for MySQL version %s: no collation before MySQL 8.0 approximates it",
+			collation, target)
+	}
+	return downgraded, true, nil
+}
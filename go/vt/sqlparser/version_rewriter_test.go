@@ -0,0 +1,158 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteForVersion(t *testing.T) {
+	testcases := []struct {
+		in      string
+		source  string
+		target  string
+		out     string
+		applied []string
+		err     string
+	}{{
+		in:     "create table t (id int(11) not null, b tinyint(1), c tinyint(4), d bigint(20) unsigned, e int(5) zerofill, y year(4))",
+		source: "5.7.9",
+		target: "8.0.30",
+		out:    "create table t (\n\tid int not null,\n\tb tinyint(1),\n\tc tinyint,\n\td bigint unsigned,\n\te int(5) zerofill,\n\ty year\n)",
+		applied: []string{
+			"integer_display_width",
+			"year_display_width",
+		},
+	}, {
+		in:     "alter table t add column a int(11), modify column b smallint(6) not null",
+		source: "5.7.9",
+		target: "8.0.19",
+		out:    "alter table t add column a int, modify column b smallint not null",
+		applied: []string{
+			"integer_display_width",
+		},
+	}, {
+		in:     "create table t (id int(11))",
+		source: "8.0.18",
+		target: "8.0.30",
+		out:    "create table t (\n\tid int\n)",
+		applied: []string{
+			"integer_display_width",
+		},
+	}, {
+		in:     "create table t (id int(11))",
+		source: "8.0.19",
+		target: "8.0.30",
+		out:    "create table t (\n\tid int(11)\n)",
+	}, {
+		in:     "create table t (id int not null, b tinyint(1), c tinyint, d bigint unsigned, e int zerofill, m mediumint, s smallint unsigned, y year)",
+		source: "8.0.30",
+		target: "5.7.9",
+		out:    "create table t (\n\tid int(11) not null,\n\tb tinyint(1),\n\tc tinyint(4),\n\td bigint(20) unsigned,\n\te int(10) zerofill,\n\tm mediumint(9),\n\ts smallint(5) unsigned,\n\ty year(4)\n)",
+		applied: []string{
+			"add_integer_display_width",
+			"add_year_display_width",
+		},
+	}, {
+		in:     "alter table t add column a int, modify column b year",
+		source: "8.0.19",
+		target: "8.0.18",
+		out:    "alter table t add column a int(11), modify column b year(4)",
+		applied: []string{
+			"add_integer_display_width",
+			"add_year_display_width",
+		},
+	}, {
+		in:     "create table t (f float default (1.5), i int default (-1), s varchar(10) default ('x'), ts timestamp default (current_timestamp))",
+		source: "8.0.30",
+		target: "5.7.9",
+		out:    "create table t (\n\tf float default 1.5,\n\ti int(11) default -1,\n\ts varchar(10) default 'x',\n\tts timestamp default current_timestamp()\n)",
+		applied: []string{
+			"add_integer_display_width",
+			"expression_default",
+		},
+	}, {
+		in:     "alter table t alter column f set default (2.5)",
+		source: "8.0.30",
+		target: "5.7.9",
+		out:    "alter table t alter column f set default 2.5",
+		applied: []string{
+			"expression_default",
+		},
+	}, {
+		in:     "create table t (j json default (json_array()))",
+		source: "8.0.30",
+		target: "5.7.9",
+		err:    "cannot rewrite DEFAULT (json_array()) of column j for MySQL version 5.7.9: only literal defaults are supported",
+	}, {
+		in:     "create table t (a varchar(10) collate utf8mb4_0900_ai_ci, b varchar(10) collate utf8mb4_0900_bin) collate utf8mb4_0900_as_cs",
+		source: "8.0.30",
+		target: "5.7.9",
+		out:    "create table t (\n\ta varchar(10) collate utf8mb4_general_ci,\n\tb varchar(10) collate utf8mb4_bin\n) collate utf8mb4_bin",
+		applied: []string{
+			"utf8mb4_0900_collation_approximate",
+		},
+	}, {
+		in:     "create table t (a varchar(10) collate utf8mb4_de_pb_0900_ai_ci)",
+		source: "8.0.30",
+		target: "5.7.9",
+		err:    "cannot rewrite COLLATE utf8mb4_de_pb_0900_ai_ci for MySQL version 5.7.9: no collation before MySQL 8.0 approximates it",
+	}, {
+		in:     "create table t (a varchar(10)) collate utf8mb4_0900_as_ci",
+		source: "8.0.30",
+		target: "5.7.9",
+		err:    "cannot rewrite COLLATE utf8mb4_0900_as_ci for MySQL version 5.7.9: no collation before MySQL 8.0 approximates it",
+	}, {
+		in:     "select a from t where b = 1",
+		source: "5.7.9",
+		target: "8.0.30",
+		out:    "select a from t where b = 1",
+	}}
+
+	parser := NewTestParser()
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			stmt, err := parser.Parse(tc.in)
+			require.NoError(t, err)
+			original := String(stmt)
+
+			rewritten, applied, err := RewriteForVersion(stmt, tc.source, tc.target)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.out, String(rewritten))
+			assert.Equal(t, tc.applied, applied)
+			// the input statement must be left untouched
+			assert.Equal(t, original, String(stmt))
+		})
+	}
+}
+
+func TestRewriteForVersionInvalidVersion(t *testing.T) {
+	stmt, err := NewTestParser().Parse("select 1")
+	require.NoError(t, err)
+
+	_, _, err = RewriteForVersion(stmt, "invalid", "8.0.30")
+	assert.Error(t, err)
+	_, _, err = RewriteForVersion(stmt, "5.7.9", "invalid")
+	assert.Error(t, err)
+}
@@ -73,6 +73,8 @@ var (
 	TxReadOnly                  = SystemVariable{Name: "tx_read_only", IsBoolean: true, Default: off}
 	Workload                    = SystemVariable{Name: "workload", IdentifierAsString: true}
 	QueryTimeout                = SystemVariable{Name: "query_timeout"}
+	MaxShardsPerQuery           = SystemVariable{Name: "max_shards_per_query", Default: off}
+	MaxShardsPerQueryWarnOnly   = SystemVariable{Name: "max_shards_per_query_warn_only", IsBoolean: true, Default: off}
 
 	// Online DDL
 	DDLStrategy      = SystemVariable{Name: "ddl_strategy", IdentifierAsString: true}
@@ -106,6 +108,8 @@ var (
 		ReadAfterWriteTimeOut,
 		SessionTrackGTIDs,
 		QueryTimeout,
+		MaxShardsPerQuery,
+		MaxShardsPerQueryWarnOnly,
 	}
 
 	ReadOnly = []SystemVariable{
@@ -191,6 +195,7 @@ var (
 		{Name: ForeignKeyChecks, IsBoolean: true, SupportSetVar: true},
 		{Name: "group_concat_max_len", SupportSetVar: true},
 		{Name: "information_schema_stats_expiry"},
+		{Name: "lc_time_names"},
 		{Name: "max_heap_table_size", SupportSetVar: true},
 		{Name: "max_seeks_for_key", SupportSetVar: true},
 		{Name: "max_tmp_tables"},
@@ -248,7 +253,6 @@ var (
 		{Name: "div_precision_increment", SupportSetVar: true},
 		{Name: "innodb_lock_wait_timeout"},
 		{Name: "interactive_timeout"},
-		{Name: "lc_time_names"},
 		{Name: "lock_wait_timeout", SupportSetVar: true},
 		{Name: "max_allowed_packet"},
 		{Name: "max_error_count", SupportSetVar: true},
@@ -753,10 +753,30 @@ func (itmc *internalTabletManagerClient) VDiff(ctx context.Context, tablet *topo
 	return nil, fmt.Errorf("VDiff not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) CreateVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.CreateVDiffRequest) (*tabletmanagerdatapb.CreateVDiffResponse, error) {
+	return nil, fmt.Errorf("CreateVDiff not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) ShowVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ShowVDiffRequest) (*tabletmanagerdatapb.ShowVDiffResponse, error) {
+	return nil, fmt.Errorf("ShowVDiff not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) StopVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.StopVDiffRequest) (*tabletmanagerdatapb.StopVDiffResponse, error) {
+	return nil, fmt.Errorf("StopVDiff not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) LockTables(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) AcquireConsistentSnapshot(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.AcquireConsistentSnapshotRequest) (*tabletmanagerdatapb.AcquireConsistentSnapshotResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) ReleaseConsistentSnapshot(ctx context.Context, tablet *topodatapb.Tablet, snapshotID int64) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) UnlockTables(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
@@ -782,6 +802,18 @@ func (itmc *internalTabletManagerClient) GetSchema(
 	return t.tm.GetSchema(ctx, request)
 }
 
+func (itmc *internalTabletManagerClient) GetSchemaHash(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetSchemaHashRequest) (string, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return "", fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.GetSchemaHash(ctx, request)
+}
+
+func (itmc *internalTabletManagerClient) StreamSchemaChanges(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.StreamSchemaChangesRequest) (tmclient.SchemaChangeStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error) {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
@@ -799,6 +831,10 @@ func (itmc *internalTabletManagerClient) GetGlobalStatusVars(ctx context.Context
 	return t.tm.GetGlobalStatusVars(ctx, variables)
 }
 
+func (itmc *internalTabletManagerClient) StreamTabletEvents(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.StreamTabletEventsRequest) (tmclient.TabletEventStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) SetReadOnly(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
@@ -829,6 +865,10 @@ func (itmc *internalTabletManagerClient) ExecuteHook(ctx context.Context, tablet
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) StreamExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (tmclient.HookStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
@@ -846,6 +886,34 @@ func (itmc *internalTabletManagerClient) RunHealthCheck(ctx context.Context, tab
 	return nil
 }
 
+func (itmc *internalTabletManagerClient) PrepareShutdown(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PrepareShutdownRequest) (*tabletmanagerdatapb.PrepareShutdownResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GracefulRestart(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GracefulRestartRequest) (*tabletmanagerdatapb.GracefulRestartResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) PauseTableGC(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PauseTableGCRequest) (*tabletmanagerdatapb.PauseTableGCResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) ResumeTableGC(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ResumeTableGCRequest) (*tabletmanagerdatapb.ResumeTableGCResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) RestoreGCTable(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreGCTableRequest) (*tabletmanagerdatapb.RestoreGCTableResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GetMysqlVariables(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GetMysqlVariablesRequest) (*tabletmanagerdatapb.GetMysqlVariablesResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) SetMysqlVariable(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.SetMysqlVariableRequest) (*tabletmanagerdatapb.SetMysqlVariableResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
@@ -878,6 +946,22 @@ func (itmc *internalTabletManagerClient) ExecuteFetchAsDba(context.Context, *top
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) ExecuteFetchAsDbaAsync(context.Context, *topodatapb.Tablet, *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (string, error) {
+	return "", fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) PollFetchJob(context.Context, *topodatapb.Tablet, *tabletmanagerdatapb.PollFetchJobRequest) (*tabletmanagerdatapb.PollFetchJobResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) CancelFetchJob(context.Context, *topodatapb.Tablet, *tabletmanagerdatapb.CancelFetchJobRequest) (*tabletmanagerdatapb.CancelFetchJobResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) StreamExecuteFetchAsDba(context.Context, *topodatapb.Tablet, *tabletmanagerdatapb.StreamExecuteFetchAsDbaRequest) (tmclient.QueryResultStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) ExecuteMultiFetchAsDba(context.Context, *topodatapb.Tablet, bool, *tabletmanagerdatapb.ExecuteMultiFetchAsDbaRequest) ([]*querypb.QueryResult, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
@@ -902,6 +986,10 @@ func (itmc *internalTabletManagerClient) WaitForPosition(context.Context, *topod
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) StreamWaitForPosition(context.Context, *topodatapb.Tablet, *tabletmanagerdatapb.StreamWaitForPositionRequest) (tmclient.WaitForPositionStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 //
 // VReplication related methods
 //
@@ -954,7 +1042,7 @@ func (itmc *internalTabletManagerClient) PopulateReparentJournal(context.Context
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) DemotePrimary(context.Context, *topodatapb.Tablet) (*replicationdatapb.PrimaryStatus, error) {
+func (itmc *internalTabletManagerClient) DemotePrimary(context.Context, *topodatapb.Tablet, *tabletmanagerdatapb.PrimaryHandoff) (*replicationdatapb.PrimaryStatus, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
@@ -970,7 +1058,7 @@ func (itmc *internalTabletManagerClient) StopReplicationAndGetStatus(context.Con
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) PromoteReplica(context.Context, *topodatapb.Tablet, bool) (string, error) {
+func (itmc *internalTabletManagerClient) PromoteReplica(context.Context, *topodatapb.Tablet, bool, *tabletmanagerdatapb.PrimaryHandoff) (string, error) {
 	return "", fmt.Errorf("not implemented in vtcombo")
 }
 
@@ -1001,6 +1089,10 @@ func (itmc *internalTabletManagerClient) FullStatus(context.Context, *topodatapb
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) SemiSyncStatus(context.Context, *topodatapb.Tablet) (*tabletmanagerdatapb.SemiSyncStatusResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) StopReplication(context.Context, *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
@@ -1021,6 +1113,14 @@ func (itmc *internalTabletManagerClient) GetReplicas(context.Context, *topodatap
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) FlushBinaryLogs(context.Context, *topodatapb.Tablet) (*tabletmanagerdatapb.FlushBinaryLogsResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) PurgeBinaryLogs(context.Context, *topodatapb.Tablet, *tabletmanagerdatapb.PurgeBinaryLogsRequest) (*tabletmanagerdatapb.PurgeBinaryLogsResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) InitReplica(context.Context, *topodatapb.Tablet, *topodatapb.TabletAlias, string, int64, bool) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
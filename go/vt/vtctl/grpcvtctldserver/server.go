@@ -1233,6 +1233,7 @@ func (s *VtctldServer) EmergencyReparentShard(ctx context.Context, req *vtctldat
 			WaitReplicasTimeout:       waitReplicasTimeout,
 			WaitAllTablets:            req.WaitForAllTablets,
 			PreventCrossCellPromotion: req.PreventCrossCellPromotion,
+			FailFastDownTablets:       req.FailFastDownTablets,
 		},
 	)
 
@@ -4508,6 +4509,7 @@ func (s *VtctldServer) ValidateSchemaKeyspace(ctx context.Context, req *vtctldat
 
 	var (
 		referenceSchema *tabletmanagerdatapb.SchemaDefinition
+		referenceHash   string
 		referenceAlias  *topodatapb.TabletAlias
 		m               sync.Mutex
 		wg              sync.WaitGroup
@@ -4546,6 +4548,7 @@ func (s *VtctldServer) ValidateSchemaKeyspace(ctx context.Context, req *vtctldat
 				if err != nil {
 					return
 				}
+				referenceHash = tmutils.SchemaHash(referenceSchema)
 			}
 
 			aliases, err := s.ts.FindAllTabletAliasesInShard(ctx, keyspace, shard)
@@ -4566,11 +4569,16 @@ func (s *VtctldServer) ValidateSchemaKeyspace(ctx context.Context, req *vtctldat
 				aliasWg.Add(1)
 				go func(alias *topodatapb.TabletAlias) {
 					defer aliasWg.Done()
-					replicaSchema, err := schematools.GetSchema(ctx, s.ts, s.tmc, alias, r)
+					// Only the schemas that differ from the reference one are
+					// fetched, which is most often none of them.
+					replicaSchema, err := schematools.GetSchemaIfDifferent(ctx, s.ts, s.tmc, alias, referenceHash, r)
 					if err != nil {
 						aliasErrs.RecordError(fmt.Errorf("GetSchema(%v, nil, %v, %v) failed: %v", alias, req.ExcludeTables, req.IncludeViews, err))
 						return
 					}
+					if replicaSchema == nil {
+						return
+					}
 
 					tmutils.DiffSchema(topoproto.TabletAliasString(referenceAlias), referenceSchema, topoproto.TabletAliasString(alias), replicaSchema, &aliasErrs)
 				}(alias)
@@ -33,6 +33,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/replication"
@@ -41,6 +43,7 @@ import (
 	"vitess.io/vitess/go/test/utils"
 	hk "vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/proto/vttime"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
@@ -13402,6 +13405,16 @@ func TestValidateSchemaKeyspace(t *testing.T) {
 		}
 	}
 
+	setupSchemaHash := func(tablet *topodatapb.TabletAlias, hash string, err error) {
+		tmc.GetSchemaHashResults[topoproto.TabletAliasString(tablet)] = struct {
+			Hash  string
+			Error error
+		}{
+			Hash:  hash,
+			Error: err,
+		}
+	}
+
 	tests := []*struct {
 		name      string
 		req       *vtctldatapb.ValidateSchemaKeyspaceRequest
@@ -13480,10 +13493,69 @@ func TestValidateSchemaKeyspace(t *testing.T) {
 			},
 			shouldErr: false,
 		},
+		{
+			name: "same schema hash",
+			req: &vtctldatapb.ValidateSchemaKeyspaceRequest{
+				Keyspace: "ks1",
+			},
+			expected: &vtctldatapb.ValidateSchemaKeyspaceResponse{
+				Results: []string{},
+				ResultsByShard: map[string]*vtctldatapb.ValidateShardResponse{
+					"-": {Results: []string{}},
+				},
+			},
+			setup: func() {
+				setupSchema(&topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				}, schema1)
+				// the schema of the replica is not fetched when its hash is the same
+				setupSchema(&topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  101,
+				}, nil)
+				setupSchemaHash(&topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  101,
+				}, tmutils.SchemaHash(schema1), nil)
+			},
+			shouldErr: false,
+		},
+		{
+			name: "GetSchemaHash not implemented",
+			req: &vtctldatapb.ValidateSchemaKeyspaceRequest{
+				Keyspace: "ks1",
+			},
+			expected: &vtctldatapb.ValidateSchemaKeyspaceResponse{
+				Results: []string{"zone1-0000000100 has an extra table named not_in_vschema"},
+				ResultsByShard: map[string]*vtctldatapb.ValidateShardResponse{
+					"-": {Results: []string{"zone1-0000000100 has an extra table named not_in_vschema"}},
+				},
+			},
+			setup: func() {
+				setupSchema(&topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				}, schema1)
+				setupSchema(&topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  101,
+				}, schema2)
+				setupSchemaHash(&topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  101,
+				}, "", status.Error(codes.Unimplemented, "method GetSchemaHash not implemented"))
+			},
+			shouldErr: false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			tmc.GetSchemaHashResults = map[string]struct {
+				Hash  string
+				Error error
+			}{}
 			tt.setup()
 			resp, err := vtctld.ValidateSchemaKeyspace(ctx, tt.req)
 			if tt.shouldErr {
@@ -32,6 +32,7 @@ import (
 	hk "vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
@@ -243,6 +244,12 @@ type TabletManagerClient struct {
 		Replicas []string
 		Error    error
 	}
+	// keyed by tablet alias. The hashes of the tablets that have no result
+	// are computed from GetSchemaResults.
+	GetSchemaHashResults map[string]struct {
+		Hash  string
+		Error error
+	}
 	// keyed by tablet alias.
 	GetSchemaDelays map[string]time.Duration
 	// keyed by tablet alias.
@@ -500,7 +507,7 @@ func (fake *TabletManagerClient) ChangeType(ctx context.Context, tablet *topodat
 }
 
 // DemotePrimary is part of the tmclient.TabletManagerClient interface.
-func (fake *TabletManagerClient) DemotePrimary(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.PrimaryStatus, error) {
+func (fake *TabletManagerClient) DemotePrimary(ctx context.Context, tablet *topodatapb.Tablet, handoff *tabletmanagerdatapb.PrimaryHandoff) (*replicationdatapb.PrimaryStatus, error) {
 	if fake.DemotePrimaryResults == nil {
 		return nil, assert.AnError
 	}
@@ -736,6 +743,28 @@ func (fake *TabletManagerClient) GetSchema(ctx context.Context, tablet *topodata
 	return nil, fmt.Errorf("%w: no schemas for %s", assert.AnError, key)
 }
 
+// GetSchemaHash is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) GetSchemaHash(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetSchemaHashRequest) (string, error) {
+	if tablet.Alias == nil {
+		return "", assert.AnError
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.GetSchemaHashResults[key]; ok {
+		return result.Hash, result.Error
+	}
+
+	sd, err := fake.GetSchema(ctx, tablet, &tabletmanagerdatapb.GetSchemaRequest{
+		Tables:        request.Tables,
+		IncludeViews:  request.IncludeViews,
+		ExcludeTables: request.ExcludeTables,
+	})
+	if err != nil {
+		return "", err
+	}
+	return tmutils.SchemaHash(sd), nil
+}
+
 // GetGlobalStatusVars is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) GetGlobalStatusVars(ctx context.Context, tablet *topodatapb.Tablet, variables []string) (map[string]string, error) {
 	if fake.GetGlobalStatusVarsResults == nil {
@@ -884,7 +913,7 @@ func (fake *TabletManagerClient) PopulateReparentJournal(ctx context.Context, ta
 }
 
 // PromoteReplica is part of the tmclient.TabletManagerClient interface.
-func (fake *TabletManagerClient) PromoteReplica(ctx context.Context, tablet *topodatapb.Tablet, semiSync bool) (string, error) {
+func (fake *TabletManagerClient) PromoteReplica(ctx context.Context, tablet *topodatapb.Tablet, semiSync bool, handoff *tabletmanagerdatapb.PrimaryHandoff) (string, error) {
 	if fake.PromoteReplicaResults == nil {
 		return "", assert.AnError
 	}
@@ -60,6 +60,10 @@ type EmergencyReparentOptions struct {
 	WaitAllTablets            bool
 	WaitReplicasTimeout       time.Duration
 	PreventCrossCellPromotion bool
+	// FailFastDownTablets makes the RPCs that stop replication on the tablets
+	// fail immediately for the tablets that were recently reported down (see
+	// tmclient.WithFailFast), instead of waiting for them until the timeout.
+	FailFastDownTablets bool
 
 	// Private options managed internally. We use value passing to avoid leaking
 	// these details back out.
@@ -195,7 +199,11 @@ func (erp *EmergencyReparenter) reparentShardLocked(ctx context.Context, ev *eve
 	}
 
 	// Stop replication on all the tablets and build their status map
-	stoppedReplicationSnapshot, err = stopReplicationAndBuildStatusMaps(ctx, erp.tmc, ev, tabletMap, topo.RemoteOperationTimeout, opts.IgnoreReplicas, opts.NewPrimaryAlias, opts.durability, opts.WaitAllTablets, erp.logger)
+	stopReplicationCtx := ctx
+	if opts.FailFastDownTablets {
+		stopReplicationCtx = tmclient.WithFailFast(ctx)
+	}
+	stoppedReplicationSnapshot, err = stopReplicationAndBuildStatusMaps(stopReplicationCtx, erp.tmc, ev, tabletMap, topo.RemoteOperationTimeout, opts.IgnoreReplicas, opts.NewPrimaryAlias, opts.durability, opts.WaitAllTablets, erp.logger)
 	if err != nil {
 		return vterrors.Wrapf(err, "failed to stop replication and build status maps: %v", err)
 	}
@@ -526,7 +534,7 @@ func (erp *EmergencyReparenter) reparentReplicas(
 			} else {
 				erp.logger.Infof("starting promotion for the new primary - %v", alias)
 				// we call PromoteReplica which changes the tablet type, fixes the semi-sync, set the primary to read-write and flushes the binlogs
-				position, err = erp.tmc.PromoteReplica(primaryCtx, tablet, SemiSyncAckers(opts.durability, tablet) > 0)
+				position, err = erp.tmc.PromoteReplica(primaryCtx, tablet, SemiSyncAckers(opts.durability, tablet) > 0, nil)
 			}
 			if err != nil {
 				return vterrors.Wrapf(err, "primary-elect tablet %v failed to be upgraded to primary: %v", alias, err)
@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/logutil"
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/topo"
@@ -64,6 +65,10 @@ type PlannedReparentOptions struct {
 	WaitReplicasTimeout     time.Duration
 	TolerableReplLag        time.Duration
 	AllowCrossCellPromotion bool
+	// Handoff describes the reparent to the query services of the demoted and
+	// promoted primaries, e.g. to attribute the buffering of the queries to
+	// it. Its Operation defaults to PlannedReparentShard.
+	Handoff *tabletmanagerdatapb.PrimaryHandoff
 
 	// Private options managed internally. We use value-passing semantics to
 	// set these options inside a PlannedReparent without leaking these details
@@ -122,6 +127,8 @@ func (pr *PlannedReparenter) ReparentShard(ctx context.Context, keyspace string,
 		opts.AvoidPrimaryAlias = shardInfo.PrimaryAlias
 	}
 
+	opts.Handoff = primaryHandoff(opts.Handoff)
+
 	startTime := time.Now()
 	ev := &events.Reparent{}
 	defer func() {
@@ -141,6 +148,19 @@ func (pr *PlannedReparenter) ReparentShard(ctx context.Context, keyspace string,
 	return ev, err
 }
 
+// primaryHandoff returns a copy of the given handoff, with its defaults set.
+func primaryHandoff(handoff *tabletmanagerdatapb.PrimaryHandoff) *tabletmanagerdatapb.PrimaryHandoff {
+	if handoff == nil {
+		handoff = &tabletmanagerdatapb.PrimaryHandoff{}
+	} else {
+		handoff = handoff.CloneVT()
+	}
+	if handoff.Operation == "" {
+		handoff.Operation = "PlannedReparentShard"
+	}
+	return handoff
+}
+
 func (pr *PlannedReparenter) getLockAction(opts PlannedReparentOptions) string {
 	return fmt.Sprintf(
 		"PlannedReparentShard(%v, AvoidPrimary = %v)",
@@ -273,7 +293,7 @@ func (pr *PlannedReparenter) performGracefulPromotion(
 	demoteCtx, demoteCancel := context.WithTimeout(ctx, topo.RemoteOperationTimeout)
 	defer demoteCancel()
 
-	primaryStatus, err := pr.tmc.DemotePrimary(demoteCtx, currentPrimary.Tablet)
+	primaryStatus, err := pr.tmc.DemotePrimary(demoteCtx, currentPrimary.Tablet, opts.Handoff)
 	if err != nil {
 		return vterrors.Wrapf(err, "failed to DemotePrimary on current primary %v: %v", currentPrimary.AliasString(), err)
 	}
@@ -376,6 +396,7 @@ func (pr *PlannedReparenter) performPotentialPromotion(
 	shard string,
 	primaryElect *topodatapb.Tablet,
 	tabletMap map[string]*topo.TabletInfo,
+	handoff *tabletmanagerdatapb.PrimaryHandoff,
 ) error {
 	primaryElectAliasStr := topoproto.TabletAliasString(primaryElect.Alias)
 
@@ -424,7 +445,7 @@ func (pr *PlannedReparenter) performPotentialPromotion(
 			// tablet type), that's already in read-only.
 			pr.logger.Infof("demoting tablet %v", alias)
 
-			primaryStatus, err := pr.tmc.DemotePrimary(stopAllCtx, tablet)
+			primaryStatus, err := pr.tmc.DemotePrimary(stopAllCtx, tablet, handoff)
 			if err != nil {
 				rec.RecordError(vterrors.Wrapf(err, "DemotePrimary(%v) failed on contested primary", alias))
 
@@ -599,7 +620,7 @@ func (pr *PlannedReparenter) reparentShardLocked(
 	case currentPrimary == nil && ev.ShardInfo.PrimaryTermStartTime != nil:
 		// Case (2): no clear current primary. Try to find a safe promotion
 		// candidate, and promote to it.
-		err = pr.performPotentialPromotion(ctx, keyspace, shard, ev.NewPrimary, tabletMap)
+		err = pr.performPotentialPromotion(ctx, keyspace, shard, ev.NewPrimary, tabletMap, opts.Handoff)
 		// We need to call `PromoteReplica` when we reparent the tablets.
 		promoteReplicaRequired = true
 	case topoproto.TabletAliasEqual(currentPrimary.Alias, opts.NewPrimaryAlias):
@@ -694,7 +715,7 @@ func (pr *PlannedReparenter) reparentTablets(
 	// If `PromoteReplica` call is required, we should call it and use the position that it returns.
 	if promoteReplicaRequired {
 		// Promote the candidate primary to type:PRIMARY.
-		primaryPosition, err := pr.tmc.PromoteReplica(replCtx, ev.NewPrimary, SemiSyncAckers(opts.durability, ev.NewPrimary) > 0)
+		primaryPosition, err := pr.tmc.PromoteReplica(replCtx, ev.NewPrimary, SemiSyncAckers(opts.durability, ev.NewPrimary) > 0, opts.Handoff)
 		if err != nil {
 			pr.logger.Warningf("primary %v failed to PromoteReplica; cancelling replica reparent attempts", primaryElectAliasStr)
 			replCancel()
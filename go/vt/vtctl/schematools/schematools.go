@@ -21,6 +21,9 @@ import (
 	"fmt"
 	"strings"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
@@ -47,6 +50,40 @@ func GetSchema(ctx context.Context, ts *topo.Server, tmc tmclient.TabletManagerC
 	return sd, nil
 }
 
+// GetSchemaIfDifferent gets the schema from a remote tablet, like GetSchema,
+// only if it differs from a reference schema with the given hash, as computed
+// by tmutils.SchemaHash: the tablet is first asked for the hash of its schema,
+// and nil is returned if it's the same, so that the schemas of many tablets can
+// be compared without transferring all of them. The full schema is fetched
+// from the tablets that don't implement GetSchemaHash yet.
+func GetSchemaIfDifferent(ctx context.Context, ts *topo.Server, tmc tmclient.TabletManagerClient, alias *topodatapb.TabletAlias, referenceHash string, request *tabletmanagerdatapb.GetSchemaRequest) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	ti, err := ts.GetTablet(ctx, alias)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "GetTablet(%v) failed: %v", alias, err)
+	}
+
+	hash, err := tmc.GetSchemaHash(ctx, ti.Tablet, &tabletmanagerdatapb.GetSchemaHashRequest{
+		Tables:        request.Tables,
+		IncludeViews:  request.IncludeViews,
+		ExcludeTables: request.ExcludeTables,
+	})
+	switch {
+	case err == nil:
+		if hash == referenceHash {
+			return nil, nil
+		}
+	case status.Code(err) != codes.Unimplemented:
+		return nil, vterrors.Wrapf(err, "GetSchemaHash(%v, %v) failed: %v", ti.Tablet, request, err)
+	}
+
+	sd, err := tmc.GetSchema(ctx, ti.Tablet, request)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "GetSchema(%v, %v) failed: %v", ti.Tablet, request, err)
+	}
+
+	return sd, nil
+}
+
 // ParseSchemaMigrationStrategy parses the given strategy into the underlying enum type.
 func ParseSchemaMigrationStrategy(name string) (vtctldatapb.SchemaMigration_Strategy, error) {
 	if name == "" {
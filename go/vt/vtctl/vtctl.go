@@ -4106,7 +4106,7 @@ func queryResultForTabletResults(results map[string]*sqltypes.Result) *sqltypes.
 	defaultFields := []*querypb.Field{{
 		Name:    "Tablet",
 		Type:    sqltypes.VarBinary,
-		Charset: collations.CollationBinaryID,
+		Charset: uint32(collations.CollationBinaryID),
 		Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 	}}
 	var row2 []sqltypes.Value
@@ -34,6 +34,9 @@ type Options struct {
 	MySQLServerVersion string
 	TruncateUILen      int
 	TruncateErrLen     int
+	// ValidateIdentifiers makes the parser reject the DDL statements with
+	// identifiers that MySQL would reject.
+	ValidateIdentifiers bool
 }
 
 func New(cfg Options) (*Environment, error) {
@@ -44,6 +47,8 @@ func New(cfg Options) (*Environment, error) {
 		MySQLServerVersion: cfg.MySQLServerVersion,
 		TruncateErrLen:     cfg.TruncateErrLen,
 		TruncateUILen:      cfg.TruncateUILen,
+
+		ValidateIdentifiers: cfg.ValidateIdentifiers,
 	})
 	if err != nil {
 		return nil, err
@@ -96,6 +96,8 @@ var (
 	VT09022 = errorWithoutState("VT09022", vtrpcpb.Code_FAILED_PRECONDITION, "Destination does not have exactly one shard: %v", "Cannot send query to multiple shards.")
 	VT09023 = errorWithoutState("VT09023", vtrpcpb.Code_FAILED_PRECONDITION, "could not map %v to a keyspace id", "Unable to determine the shard for the given row.")
 	VT09024 = errorWithoutState("VT09024", vtrpcpb.Code_FAILED_PRECONDITION, "could not map %v to a unique keyspace id: %v", "Unable to determine the shard for the given row.")
+	VT09025 = errorWithoutState("VT09025", vtrpcpb.Code_FAILED_PRECONDITION, "%s is not allowed: table '%s' has no_scatter set in the VSchema", "The query would be sent to all the shards of the keyspace to access a table that disallows it in the VSchema. Add a predicate on the sharding key, or use the ALLOW_SCATTER directive.")
+	VT09026 = errorWithoutState("VT09026", vtrpcpb.Code_FAILED_PRECONDITION, "%s would be sent to %d shards, more than max_shards_per_query (%d)", "A route of the query would be sent to more shards than the max_shards_per_query setting of the session allows. Add a predicate on the sharding key, or raise max_shards_per_query.")
 
 	VT10001 = errorWithoutState("VT10001", vtrpcpb.Code_ABORTED, "foreign key constraints are not allowed", "Foreign key constraints are not allowed, see https://vitess.io/blog/2021-06-15-online-ddl-why-no-fk/.")
 
@@ -181,6 +183,8 @@ var (
 		VT09022,
 		VT09023,
 		VT09024,
+		VT09025,
+		VT09026,
 		VT10001,
 		VT12001,
 		VT12002,
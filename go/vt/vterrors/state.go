@@ -122,6 +122,18 @@ const (
 
 	VectorConversion
 
+	// invalid identifiers
+	TooLongIdent
+	WrongDbName
+	WrongTableName
+	WrongColumnName
+	InvalidCharacterString
+
+	// parser errors
+	ParseError
+	UnknownCharacterSet
+	UnknownCollation
+
 	// No state should be added below NumOfStates
 	NumOfStates
 )
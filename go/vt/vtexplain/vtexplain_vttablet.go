@@ -289,7 +289,7 @@ func newTabletEnvironment(ddls []sqlparser.DDLStatement, opts *Options, collatio
 		"select unix_timestamp()": {
 			Fields: []*querypb.Field{{
 				Type:    sqltypes.Int64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 				Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG | querypb.MySqlFlag_NUM_FLAG),
 			}},
 			Rows: [][]sqltypes.Value{
@@ -327,7 +327,7 @@ func newTabletEnvironment(ddls []sqlparser.DDLStatement, opts *Options, collatio
 		"select @@autocommit": {
 			Fields: []*querypb.Field{{
 				Type:    sqltypes.Int64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 				Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG | querypb.MySqlFlag_NUM_FLAG),
 			}},
 			Rows: [][]sqltypes.Value{
@@ -337,7 +337,7 @@ func newTabletEnvironment(ddls []sqlparser.DDLStatement, opts *Options, collatio
 		"select @@sql_auto_is_null": {
 			Fields: []*querypb.Field{{
 				Type:    sqltypes.Int64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 				Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG | querypb.MySqlFlag_NUM_FLAG),
 			}},
 			Rows: [][]sqltypes.Value{
@@ -347,63 +347,63 @@ func newTabletEnvironment(ddls []sqlparser.DDLStatement, opts *Options, collatio
 		"set @@session.sql_log_bin = 0": {
 			Fields: []*querypb.Field{{
 				Type:    sqltypes.Uint64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 			}},
 			Rows: [][]sqltypes.Value{},
 		},
 		"create database if not exists `_vt`": {
 			Fields: []*querypb.Field{{
 				Type:    sqltypes.Uint64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 			}},
 			Rows: [][]sqltypes.Value{},
 		},
 		"drop table if exists `_vt`.redo_log_transaction": {
 			Fields: []*querypb.Field{{
 				Type:    sqltypes.Uint64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 			}},
 			Rows: [][]sqltypes.Value{},
 		},
 		"drop table if exists `_vt`.redo_log_statement": {
 			Fields: []*querypb.Field{{
 				Type:    sqltypes.Uint64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 			}},
 			Rows: [][]sqltypes.Value{},
 		},
 		"drop table if exists `_vt`.transaction": {
 			Fields: []*querypb.Field{{
 				Type:    sqltypes.Uint64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 			}},
 			Rows: [][]sqltypes.Value{},
 		},
 		"drop table if exists `_vt`.participant": {
 			Fields: []*querypb.Field{{
 				Type:    sqltypes.Uint64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 			}},
 			Rows: [][]sqltypes.Value{},
 		},
 		"create table if not exists `_vt`.redo_state(\n  dtid varbinary(512),\n  state bigint,\n  time_created bigint,\n  primary key(dtid)\n\t) engine=InnoDB": {
 			Fields: []*querypb.Field{{
 				Type:    sqltypes.Uint64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 			}},
 			Rows: [][]sqltypes.Value{},
 		},
 		"create table if not exists `_vt`.redo_statement(\n  dtid varbinary(512),\n  id bigint,\n  statement mediumblob,\n  primary key(dtid, id)\n\t) engine=InnoDB": {
 			Fields: []*querypb.Field{{
 				Type:    sqltypes.Uint64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 			}},
 			Rows: [][]sqltypes.Value{},
 		},
 		"create table if not exists `_vt`.dt_state(\n  dtid varbinary(512),\n  state bigint,\n  time_created bigint,\n  primary key(dtid)\n\t) engine=InnoDB": {
 			Fields: []*querypb.Field{{
 				Type:    sqltypes.Uint64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 			}},
 			Rows: [][]sqltypes.Value{},
 		},
@@ -411,7 +411,7 @@ func newTabletEnvironment(ddls []sqlparser.DDLStatement, opts *Options, collatio
 
 			Fields: []*querypb.Field{{
 				Type:    sqltypes.Uint64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 			}},
 			Rows: [][]sqltypes.Value{},
 		},
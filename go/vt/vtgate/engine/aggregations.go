@@ -18,7 +18,9 @@ package engine
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
 	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/slice"
@@ -50,6 +52,11 @@ type AggregateParams struct {
 	// not what we use to aggregate at the engine primitive level.
 	OrigOpcode AggregateOpcode
 
+	// OrderBy is the ORDER BY of a GROUP_CONCAT that is evaluated here: its
+	// values are sorted on these columns of the input rows, each with its own
+	// collation, before they are concatenated.
+	OrderBy evalengine.Comparison
+
 	CollationEnv *collations.Environment
 }
 
@@ -79,6 +86,9 @@ func (ap *AggregateParams) String() string {
 	if sqltypes.IsText(ap.Type.Type()) && ap.CollationEnv.IsSupported(ap.Type.Collation()) {
 		keyCol += " COLLATE " + ap.CollationEnv.LookupName(ap.Type.Collation())
 	}
+	if len(ap.OrderBy) > 0 {
+		keyCol += " ORDER BY " + strings.Join(slice.Map(ap.OrderBy, func(obp evalengine.OrderByParams) string { return obp.String() }), ", ")
+	}
 	dispOrigOp := ""
 	if ap.OrigOpcode != AggregateUnassigned && ap.OrigOpcode != ap.Opcode {
 		dispOrigOp = "_" + ap.OrigOpcode.String()
@@ -98,7 +108,7 @@ func (ap *AggregateParams) typ(inputType querypb.Type) querypb.Type {
 
 type aggregator interface {
 	add(row []sqltypes.Value) error
-	finish() sqltypes.Value
+	finish() (sqltypes.Value, error)
 	reset()
 }
 
@@ -134,101 +144,83 @@ func (a *aggregatorDistinct) reset() {
 	a.last = sqltypes.NULL
 }
 
-type aggregatorCount struct {
+// aggregatorFunc evaluates an aggregate function on a column of the input rows
+// using the shared aggregation implementation from the evalengine.
+type aggregatorFunc struct {
 	from     int
-	n        int64
+	agg      evalengine.Aggregator
 	distinct aggregatorDistinct
 }
 
-func (a *aggregatorCount) add(row []sqltypes.Value) error {
+func (a *aggregatorFunc) add(row []sqltypes.Value) error {
 	if row[a.from].IsNull() {
 		return nil
 	}
 	if ret, err := a.distinct.shouldReturn(row); ret {
 		return err
 	}
-	a.n++
-	return nil
+	return a.agg.Accumulate(row[a.from])
 }
 
-func (a *aggregatorCount) finish() sqltypes.Value {
-	return sqltypes.NewInt64(a.n)
+func (a *aggregatorFunc) finish() (sqltypes.Value, error) {
+	return a.agg.Finalize(), nil
 }
 
-func (a *aggregatorCount) reset() {
-	a.n = 0
+func (a *aggregatorFunc) reset() {
+	a.agg.Init()
 	a.distinct.reset()
 }
 
-type aggregatorCountStar struct {
-	n int64
+// aggregatorOrderedGroupConcat evaluates a GROUP_CONCAT with an ORDER BY: the
+// rows of the group are kept until it's complete, and their values are then
+// concatenated in order. The values can come from any number of shards, so
+// they can't be concatenated as they arrive.
+type aggregatorOrderedGroupConcat struct {
+	from  int
+	order evalengine.Comparison
+	agg   evalengine.Aggregator
+	rows  []sqltypes.Row
 }
 
-func (a *aggregatorCountStar) add(_ []sqltypes.Value) error {
-	a.n++
+func (a *aggregatorOrderedGroupConcat) add(row []sqltypes.Value) error {
+	if row[a.from].IsNull() {
+		return nil
+	}
+	a.rows = append(a.rows, row)
 	return nil
 }
 
-func (a *aggregatorCountStar) finish() sqltypes.Value {
-	return sqltypes.NewInt64(a.n)
-}
-
-func (a *aggregatorCountStar) reset() {
-	a.n = 0
-}
-
-type aggregatorMinMax struct {
-	from   int
-	minmax evalengine.MinMax
-}
-
-type aggregatorMin struct {
-	aggregatorMinMax
-}
-
-func (a *aggregatorMin) add(row []sqltypes.Value) (err error) {
-	return a.minmax.Min(row[a.from])
-}
-
-type aggregatorMax struct {
-	aggregatorMinMax
-}
-
-func (a *aggregatorMax) add(row []sqltypes.Value) (err error) {
-	return a.minmax.Max(row[a.from])
-}
-
-func (a *aggregatorMinMax) finish() sqltypes.Value {
-	return a.minmax.Result()
+func (a *aggregatorOrderedGroupConcat) finish() (_ sqltypes.Value, err error) {
+	defer evalengine.PanicHandler(&err)
+	slices.SortStableFunc(a.rows, a.order.Compare)
+	for _, row := range a.rows {
+		if err := a.agg.Accumulate(row[a.from]); err != nil {
+			return sqltypes.NULL, err
+		}
+	}
+	return a.agg.Finalize(), nil
 }
 
-func (a *aggregatorMinMax) reset() {
-	a.minmax.Reset()
+func (a *aggregatorOrderedGroupConcat) reset() {
+	a.agg.Init()
+	a.rows = nil
 }
 
-type aggregatorSum struct {
-	from     int
-	sum      evalengine.Sum
-	distinct aggregatorDistinct
+type aggregatorCountStar struct {
+	n int64
 }
 
-func (a *aggregatorSum) add(row []sqltypes.Value) error {
-	if row[a.from].IsNull() {
-		return nil
-	}
-	if ret, err := a.distinct.shouldReturn(row); ret {
-		return err
-	}
-	return a.sum.Add(row[a.from])
+func (a *aggregatorCountStar) add(_ []sqltypes.Value) error {
+	a.n++
+	return nil
 }
 
-func (a *aggregatorSum) finish() sqltypes.Value {
-	return a.sum.Result()
+func (a *aggregatorCountStar) finish() (sqltypes.Value, error) {
+	return sqltypes.NewInt64(a.n), nil
 }
 
-func (a *aggregatorSum) reset() {
-	a.sum.Reset()
-	a.distinct.reset()
+func (a *aggregatorCountStar) reset() {
+	a.n = 0
 }
 
 type aggregatorScalar struct {
@@ -245,8 +237,8 @@ func (a *aggregatorScalar) add(row []sqltypes.Value) error {
 	return nil
 }
 
-func (a *aggregatorScalar) finish() sqltypes.Value {
-	return a.current
+func (a *aggregatorScalar) finish() (sqltypes.Value, error) {
+	return a.current, nil
 }
 
 func (a *aggregatorScalar) reset() {
@@ -254,39 +246,6 @@ func (a *aggregatorScalar) reset() {
 	a.init = false
 }
 
-type aggregatorGroupConcat struct {
-	from      int
-	type_     sqltypes.Type
-	separator []byte
-
-	concat []byte
-	n      int
-}
-
-func (a *aggregatorGroupConcat) add(row []sqltypes.Value) error {
-	if row[a.from].IsNull() {
-		return nil
-	}
-	if a.n > 0 {
-		a.concat = append(a.concat, a.separator...)
-	}
-	a.concat = append(a.concat, row[a.from].Raw()...)
-	a.n++
-	return nil
-}
-
-func (a *aggregatorGroupConcat) finish() sqltypes.Value {
-	if a.n == 0 {
-		return sqltypes.NULL
-	}
-	return sqltypes.MakeTrusted(a.type_, a.concat)
-}
-
-func (a *aggregatorGroupConcat) reset() {
-	a.n = 0
-	a.concat = nil // not safe to reuse this byte slice as it's returned as MakeTrusted
-}
-
 type aggregatorGtid struct {
 	from   int
 	shards []*binlogdatapb.ShardGtid
@@ -301,9 +260,9 @@ func (a *aggregatorGtid) add(row []sqltypes.Value) error {
 	return nil
 }
 
-func (a *aggregatorGtid) finish() sqltypes.Value {
+func (a *aggregatorGtid) finish() (sqltypes.Value, error) {
 	gtid := binlogdatapb.VGtid{ShardGtids: a.shards}
-	return sqltypes.NewVarChar(gtid.String())
+	return sqltypes.NewVarChar(gtid.String()), nil
 }
 
 func (a *aggregatorGtid) reset() {
@@ -321,12 +280,16 @@ func (a aggregationState) add(row []sqltypes.Value) error {
 	return nil
 }
 
-func (a aggregationState) finish() (row []sqltypes.Value) {
-	row = make([]sqltypes.Value, 0, len(a))
+func (a aggregationState) finish() ([]sqltypes.Value, error) {
+	row := make([]sqltypes.Value, 0, len(a))
 	for _, st := range a {
-		row = append(row, st.finish())
+		v, err := st.finish()
+		if err != nil {
+			return nil, err
+		}
+		row = append(row, v)
 	}
-	return
+	return row, nil
 }
 
 func (a aggregationState) reset() {
@@ -378,56 +341,34 @@ func newAggregation(fields []*querypb.Field, aggregates []*AggregateParams) (agg
 			}
 		}
 
+		var fn evalengine.AggregateFunc
+		params := evalengine.AggregatorParams{
+			Type:         sourceType,
+			Collation:    aggr.Type.Collation(),
+			CollationEnv: aggr.CollationEnv,
+			Values:       aggr.Type.Values(),
+		}
+
 		switch aggr.Opcode {
 		case AggregateCountStar:
 			ag = &aggregatorCountStar{}
 
 		case AggregateCount, AggregateCountDistinct:
-			ag = &aggregatorCount{
-				from: aggr.Col,
-				distinct: aggregatorDistinct{
-					column:       distinct,
-					coll:         aggr.Type.Collation(),
-					collationEnv: aggr.CollationEnv,
-					values:       aggr.Type.Values(),
-				},
-			}
+			fn = evalengine.AggregateCount
 
 		case AggregateSum, AggregateSumDistinct:
-			var sum evalengine.Sum
 			switch aggr.OrigOpcode {
 			case AggregateCount, AggregateCountStar, AggregateCountDistinct:
-				sum = evalengine.NewSumOfCounts()
+				fn = evalengine.AggregateSumOfCounts
 			default:
-				sum = evalengine.NewAggregationSum(sourceType)
-			}
-
-			ag = &aggregatorSum{
-				from: aggr.Col,
-				sum:  sum,
-				distinct: aggregatorDistinct{
-					column:       distinct,
-					coll:         aggr.Type.Collation(),
-					collationEnv: aggr.CollationEnv,
-					values:       aggr.Type.Values(),
-				},
+				fn = evalengine.AggregateSum
 			}
 
 		case AggregateMin:
-			ag = &aggregatorMin{
-				aggregatorMinMax{
-					from:   aggr.Col,
-					minmax: evalengine.NewAggregationMinMax(sourceType, aggr.CollationEnv, aggr.Type.Collation(), aggr.Type.Values()),
-				},
-			}
+			fn = evalengine.AggregateMin
 
 		case AggregateMax:
-			ag = &aggregatorMax{
-				aggregatorMinMax{
-					from:   aggr.Col,
-					minmax: evalengine.NewAggregationMinMax(sourceType, aggr.CollationEnv, aggr.Type.Collation(), aggr.Type.Values()),
-				},
-			}
+			fn = evalengine.AggregateMax
 
 		case AggregateGtid:
 			ag = &aggregatorGtid{from: aggr.Col}
@@ -437,17 +378,37 @@ func newAggregation(fields []*querypb.Field, aggregates []*AggregateParams) (agg
 
 		case AggregateGroupConcat:
 			gcFunc := aggr.Func.(*sqlparser.GroupConcatExpr)
-			separator := []byte(gcFunc.Separator)
-			ag = &aggregatorGroupConcat{
-				from:      aggr.Col,
-				type_:     targetType,
-				separator: separator,
+			fn = evalengine.AggregateGroupConcat
+			params.Separator = []byte(gcFunc.Separator)
+			if len(aggr.OrderBy) > 0 {
+				agg, err := evalengine.NewAggregator(fn, params)
+				if err != nil {
+					return nil, nil, err
+				}
+				ag = &aggregatorOrderedGroupConcat{from: aggr.Col, order: aggr.OrderBy, agg: agg}
 			}
 
 		default:
 			panic("BUG: unexpected Aggregation opcode")
 		}
 
+		if ag == nil {
+			agg, err := evalengine.NewAggregator(fn, params)
+			if err != nil {
+				return nil, nil, err
+			}
+			ag = &aggregatorFunc{
+				from: aggr.Col,
+				agg:  agg,
+				distinct: aggregatorDistinct{
+					column:       distinct,
+					coll:         aggr.Type.Collation(),
+					collationEnv: aggr.CollationEnv,
+					values:       aggr.Type.Values(),
+				},
+			}
+		}
+
 		agstate[aggr.Col] = ag
 		fields[aggr.Col].Type = targetType
 		if aggr.Alias != "" {
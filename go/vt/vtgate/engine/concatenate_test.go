@@ -36,9 +36,9 @@ func r(names, types string, rows ...string) *sqltypes.Result {
 	fields := sqltypes.MakeTestFields(names, types)
 	for _, f := range fields {
 		if sqltypes.IsText(f.Type) {
-			f.Charset = collations.CollationUtf8mb4ID
+			f.Charset = uint32(collations.CollationUtf8mb4ID)
 		} else {
-			f.Charset = collations.CollationBinaryID
+			f.Charset = uint32(collations.CollationBinaryID)
 		}
 		_, flags := sqltypes.TypeToMySQL(f.Type)
 		f.Flags = uint32(flags)
@@ -159,4 +159,7 @@ func addFieldsIfNotEmpty(dml *DML, other map[string]any) {
 		}
 		other["Values"] = s
 	}
+	if dml.ScatterOnNull {
+		other["ScatterOnNull"] = true
+	}
 }
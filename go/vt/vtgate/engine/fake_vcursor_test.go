@@ -48,6 +48,7 @@ import (
 
 var testMaxMemoryRows = 100
 var testIgnoreMaxMemoryRows = false
+var testLimitOffsetMaxBufferedRows = 1000
 
 var _ VCursor = (*noopVCursor)(nil)
 var _ SessionActions = (*noopVCursor)(nil)
@@ -151,6 +152,10 @@ func (t *noopVCursor) SQLMode() string {
 	return config.DefaultSQLMode
 }
 
+func (t *noopVCursor) LCTimeNames() string {
+	return ""
+}
+
 func (t *noopVCursor) ExecutePrimitive(ctx context.Context, primitive Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
 	return primitive.TryExecute(ctx, t, bindVars, wantfields)
 }
@@ -297,6 +302,13 @@ func (t *noopVCursor) GetQueryTimeout(queryTimeoutFromComments int) int {
 	return queryTimeoutFromComments
 }
 
+func (t *noopVCursor) SetMaxShardsPerQuery(int64) {
+}
+
+func (t *noopVCursor) SetMaxShardsPerQueryWarnOnly(context.Context, bool) error {
+	panic("implement me")
+}
+
 func (t *noopVCursor) SetSkipQueryPlanCache(context.Context, bool) error {
 	panic("implement me")
 }
@@ -341,6 +353,10 @@ func (t *noopVCursor) ExceedsMaxMemoryRows(numRows int) bool {
 	return !testIgnoreMaxMemoryRows && numRows > testMaxMemoryRows
 }
 
+func (t *noopVCursor) LimitOffsetMaxBufferedRows() int {
+	return testLimitOffsetMaxBufferedRows
+}
+
 func (t *noopVCursor) GetKeyspace() string {
 	return ""
 }
@@ -61,10 +61,11 @@ func (f *Filter) TryExecute(ctx context.Context, vcursor VCursor, bindVars map[s
 		return nil, err
 	}
 	env := evalengine.NewExpressionEnv(ctx, bindVars, vcursor)
+	predicate := env.Prepare(f.Predicate, result.Fields)
 	var rows [][]sqltypes.Value
 	for _, row := range result.Rows {
 		env.Row = row
-		evalResult, err := env.Evaluate(f.Predicate)
+		evalResult, err := env.Evaluate(predicate)
 		if err != nil {
 			return nil, err
 		}
@@ -73,6 +74,7 @@ func (f *Filter) TryExecute(ctx context.Context, vcursor VCursor, bindVars map[s
 			rows = append(rows, row)
 		}
 	}
+	recordEvalWarnings(vcursor, env)
 	result.Rows = rows
 	return result.Truncate(f.Truncate), nil
 }
@@ -82,14 +84,18 @@ func (f *Filter) TryStreamExecute(ctx context.Context, vcursor VCursor, bindVars
 	var mu sync.Mutex
 
 	env := evalengine.NewExpressionEnv(ctx, bindVars, vcursor)
+	predicate := f.Predicate
 	filter := func(results *sqltypes.Result) error {
 		var rows [][]sqltypes.Value
 
 		mu.Lock()
 		defer mu.Unlock()
+		if results.Fields != nil {
+			predicate = env.Prepare(f.Predicate, results.Fields)
+		}
 		for _, row := range results.Rows {
 			env.Row = row
-			evalResult, err := env.Evaluate(f.Predicate)
+			evalResult, err := env.Evaluate(predicate)
 			if err != nil {
 				return err
 			}
@@ -101,6 +107,7 @@ func (f *Filter) TryStreamExecute(ctx context.Context, vcursor VCursor, bindVars
 				rows = append(rows, row)
 			}
 		}
+		recordEvalWarnings(vcursor, env)
 		results.Rows = rows
 		return callback(results.Truncate(f.Truncate))
 	}
@@ -24,6 +24,7 @@ import (
 	"sync/atomic"
 
 	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/mysql/collations/colldata"
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -82,6 +83,11 @@ type (
 		hasher         vthash.Hasher
 		sqlmode        evalengine.SQLMode
 		values         *evalengine.EnumSetValues
+
+		// equality confirms the matches of textual keys, whose hashes are
+		// computed from the collation, without weighting them. It is nil if
+		// the keys are not compared as text.
+		equality colldata.EqualityComparer
 	}
 
 	probeTableEntry struct {
@@ -265,6 +271,15 @@ func (hj *HashJoin) description() PrimitiveDescription {
 }
 
 func newHashJoinProbeTable(coll collations.ID, typ querypb.Type, lhsKey, rhsKey int, cols []int, values *evalengine.EnumSetValues) *hashJoinProbeTable {
+	var equality colldata.EqualityComparer
+	switch {
+	case sqltypes.IsBinary(typ):
+		equality = colldata.EqualityComparerFor(colldata.Lookup(collations.CollationBinaryID))
+	case sqltypes.IsText(typ):
+		if coll := colldata.Lookup(coll); coll != nil {
+			equality = colldata.EqualityComparerFor(coll)
+		}
+	}
 	return &hashJoinProbeTable{
 		innerMap: map[vthash.Hash]*probeTableEntry{},
 		coll:     coll,
@@ -274,6 +289,7 @@ func newHashJoinProbeTable(coll collations.ID, typ querypb.Type, lhsKey, rhsKey
 		cols:     cols,
 		hasher:   vthash.New(),
 		values:   values,
+		equality: equality,
 	}
 }
 
@@ -313,6 +329,9 @@ func (pt *hashJoinProbeTable) get(rrow sqltypes.Row) (result []sqltypes.Row, err
 	}
 
 	for e := pt.innerMap[hash]; e != nil; e = e.next {
+		if !pt.equal(e.row[pt.lhsKey], val) {
+			continue
+		}
 		e.seen = true
 		result = append(result, joinRows(e.row, rrow, pt.cols))
 	}
@@ -320,6 +339,20 @@ func (pt *hashJoinProbeTable) get(rrow sqltypes.Row) (result []sqltypes.Row, err
 	return
 }
 
+// equal returns whether a left and a right key with the same hash are equal.
+// Only the textual keys, which are hashed as is, are compared: the others are
+// converted to the comparison type to be hashed, so their hashes already tell.
+func (pt *hashJoinProbeTable) equal(left, right sqltypes.Value) bool {
+	if pt.equality == nil || !isTextKey(left) || !isTextKey(right) {
+		return true
+	}
+	return pt.equality.Equal(left.Raw(), right.Raw())
+}
+
+func isTextKey(v sqltypes.Value) bool {
+	return v.IsText() || v.IsBinary()
+}
+
 func (pt *hashJoinProbeTable) notFetched() (rows []sqltypes.Row) {
 	for _, e := range pt.innerMap {
 		for ; e != nil; e = e.next {
@@ -172,3 +172,20 @@ func typeForOffset(i int) evalengine.Type {
 		panic(i)
 	}
 }
+
+func TestHashJoinProbeTableTextKeys(t *testing.T) {
+	coll := collations.MySQL8().LookupByName("utf8mb4_0900_ai_ci")
+	pt := newHashJoinProbeTable(coll, sqltypes.VarChar, 0, 0, []int{-1, 1}, nil)
+	for _, key := range []string{"abc", "ABC", "ábc", "abd"} {
+		require.NoError(t, pt.addLeftRow(sqltypes.Row{sqltypes.NewVarChar(key)}))
+	}
+
+	rows, err := pt.get(sqltypes.Row{sqltypes.NewVarChar("Abc")})
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	// keys with the same hash are only joined if they are equal
+	require.True(t, pt.equal(sqltypes.NewVarChar("abc"), sqltypes.NewVarChar("ÁBC")))
+	require.False(t, pt.equal(sqltypes.NewVarChar("abc"), sqltypes.NewVarChar("abd")))
+	require.True(t, pt.equal(sqltypes.NewInt64(1), sqltypes.NewVarChar("1")))
+}
@@ -63,6 +63,10 @@ func (l *Limit) TryExecute(ctx context.Context, vcursor VCursor, bindVars map[st
 	if err != nil {
 		return nil, err
 	}
+	if streamsShardRows(vcursor, count, offset) {
+		return l.executeAsStream(ctx, vcursor, bindVars, count, offset, wantfields)
+	}
+
 	// When offset is present, we hijack the limit value so we can calculate
 	// the offset in memory from the result of the scatter query with count + offset.
 
@@ -94,7 +98,29 @@ func (l *Limit) TryStreamExecute(ctx context.Context, vcursor VCursor, bindVars
 	if err != nil {
 		return err
 	}
+	return l.streamExecute(ctx, vcursor, bindVars, count, offset, wantfields, callback)
+}
 
+// executeAsStream executes the input as a stream, so the rows of the shards are
+// skipped for the offset as they arrive and only the count rows are buffered.
+// The shards are still asked for count + offset rows each: this bounds the memory
+// used by vtgate, not the rows the shards read and send.
+func (l *Limit) executeAsStream(ctx context.Context, vcursor VCursor, bindVars map[string]*querypb.BindVariable, count, offset int, wantfields bool) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	err := l.streamExecute(ctx, vcursor, bindVars, count, offset, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			result.Fields = qr.Fields
+		}
+		result.Rows = append(result.Rows, qr.Rows...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (l *Limit) streamExecute(ctx context.Context, vcursor VCursor, bindVars map[string]*querypb.BindVariable, count, offset int, wantfields bool, callback func(*sqltypes.Result) error) error {
 	bindVars = copyBindVars(bindVars)
 
 	// When offset is present, we hijack the limit value so we can calculate
@@ -102,7 +128,7 @@ func (l *Limit) TryStreamExecute(ctx context.Context, vcursor VCursor, bindVars
 	bindVars[UpperLimitStr] = sqltypes.Int64BindVariable(int64(count + offset))
 
 	var mu sync.Mutex
-	err = vcursor.StreamExecutePrimitive(ctx, l.Input, bindVars, wantfields, func(qr *sqltypes.Result) error {
+	err := vcursor.StreamExecutePrimitive(ctx, l.Input, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		mu.Lock()
 		defer mu.Unlock()
 		if wantfields && len(qr.Fields) != 0 {
@@ -156,6 +182,21 @@ func (l *Limit) TryStreamExecute(ctx context.Context, vcursor VCursor, bindVars
 	return nil
 }
 
+// streamsShardRows returns true when the rows that the shards are asked for, to
+// apply the offset in vtgate, are more than the limit-offset-max-buffered-rows
+// flag. The count and the offset are the evaluated ones, so the offsets that were
+// normalized into bind variables are checked too. The rows are always buffered in
+// a transaction: the stream is stopped as soon as the count rows are received, and
+// the tablets kill the connection of a stopped stream, and so the transaction.
+func streamsShardRows(vcursor VCursor, count, offset int) bool {
+	if vcursor.Session().InTransaction() {
+		return false
+	}
+	maxBuffered := vcursor.LimitOffsetMaxBufferedRows()
+	// compare against the remainder of the bound, as count+offset can overflow
+	return maxBuffered > 0 && offset > 0 && offset > maxBuffered-count
+}
+
 // GetFields implements the Primitive interface.
 func (l *Limit) GetFields(ctx context.Context, vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	return l.Input.GetFields(ctx, vcursor, bindVars)
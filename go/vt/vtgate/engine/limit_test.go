@@ -19,6 +19,7 @@ package engine
 import (
 	"context"
 	"errors"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -30,6 +31,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
@@ -564,6 +566,59 @@ func TestOffsetStreamExecute(t *testing.T) {
 	}
 }
 
+func TestLimitShardOverFetchPaging(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"int64|varchar",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "a|1", "b|2", "c|3", "d|4", "e|5")},
+	}
+
+	// the offset is a bind variable, like the offsets of the normalized queries
+	l := &Limit{
+		Count:  evalengine.NewLiteralInt(2),
+		Offset: evalengine.NewBindVar("o", evalengine.NewType(sqltypes.Int64, collations.CollationBinaryID)),
+		Input:  fp,
+	}
+
+	defer func(maxBuffered int) { testLimitOffsetMaxBufferedRows = maxBuffered }(testLimitOffsetMaxBufferedRows)
+	testLimitOffsetMaxBufferedRows = 4
+
+	result, err := l.TryExecute(context.Background(), &noopVCursor{}, map[string]*querypb.BindVariable{"o": sqltypes.Int64BindVariable(2)}, true)
+	require.NoError(t, err)
+	fp.ExpectLog(t, []string{`Execute __upper_limit: type:INT64 value:"4" o: type:INT64 value:"2" true`})
+	utils.MustMatch(t, sqltypes.MakeTestResult(fields, "c|3", "d|4"), result)
+
+	// above the bound, the rows are streamed and the offset ones skipped as they
+	// arrive, but the input is still asked for count + offset rows
+	fp = &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "a|1", "b|2", "c|3", "d|4", "e|5")},
+	}
+	l.Input = fp
+	result, err = l.TryExecute(context.Background(), &noopVCursor{}, map[string]*querypb.BindVariable{"o": sqltypes.Int64BindVariable(3)}, true)
+	require.NoError(t, err)
+	fp.ExpectLog(t, []string{`StreamExecute __upper_limit: type:INT64 value:"5" o: type:INT64 value:"3" true`})
+	utils.MustMatch(t, sqltypes.MakeTestResult(fields, "d|4", "e|5"), result)
+
+	// in a transaction, the rows are buffered whatever the bound, since the
+	// tablets would kill the connection of the stream stopped after the count rows
+	fp = &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "a|1", "b|2", "c|3", "d|4", "e|5")},
+	}
+	l.Input = fp
+	result, err = l.TryExecute(context.Background(), &noopVCursor{inTx: true}, map[string]*querypb.BindVariable{"o": sqltypes.Int64BindVariable(3)}, true)
+	require.NoError(t, err)
+	fp.ExpectLog(t, []string{`Execute __upper_limit: type:INT64 value:"5" o: type:INT64 value:"3" true`})
+	utils.MustMatch(t, sqltypes.MakeTestResult(fields, "d|4", "e|5"), result)
+
+	// the count and the offset are not summed, which could overflow for the large
+	// ones and disable the streaming
+	assert.True(t, streamsShardRows(&noopVCursor{}, 2, math.MaxInt))
+	assert.True(t, streamsShardRows(&noopVCursor{}, math.MaxInt, 1))
+	assert.False(t, streamsShardRows(&noopVCursor{}, 2, 2))
+}
+
 func TestLimitGetFields(t *testing.T) {
 	result := sqltypes.MakeTestResult(
 		sqltypes.MakeTestFields(
@@ -71,7 +71,7 @@ func (ms *MemorySort) TryExecute(ctx context.Context, vcursor VCursor, bindVars
 		return nil, err
 	}
 
-	if err = ms.OrderBy.SortResult(result); err != nil {
+	if err = sortResult(evalengine.NewExpressionEnv(ctx, bindVars, vcursor), ms.OrderBy, result); err != nil {
 		return nil, err
 	}
 	if len(result.Rows) > count {
@@ -97,6 +97,11 @@ func (ms *MemorySort) TryStreamExecute(ctx context.Context, vcursor VCursor, bin
 		Compare: ms.OrderBy,
 		Limit:   count,
 	}
+	env := evalengine.NewExpressionEnv(ctx, bindVars, vcursor)
+	// width is the number of columns of the rows before they are extended with
+	// the values of the ORDER BY expressions, or -1 until the first row
+	width := -1
+	var extended bool
 
 	var mu sync.Mutex
 	err = vcursor.StreamExecutePrimitive(ctx, ms.Input, bindVars, wantfields, func(qr *sqltypes.Result) error {
@@ -108,6 +113,16 @@ func (ms *MemorySort) TryStreamExecute(ctx context.Context, vcursor VCursor, bin
 			}
 		}
 		for _, row := range qr.Rows {
+			if width == -1 {
+				width = len(row)
+				sorter.Compare, extended = ms.OrderBy.Extend(width)
+			}
+			if extended {
+				var err error
+				if row, err = ms.OrderBy.ExtendRow(env, row); err != nil {
+					return err
+				}
+			}
 			sorter.Push(row)
 		}
 		if vcursor.ExceedsMaxMemoryRows(sorter.Len()) {
@@ -118,7 +133,39 @@ func (ms *MemorySort) TryStreamExecute(ctx context.Context, vcursor VCursor, bin
 	if err != nil {
 		return err
 	}
-	return cb(&sqltypes.Result{Rows: sorter.Sorted()})
+	rows := sorter.Sorted()
+	if extended {
+		for i, row := range rows {
+			rows[i] = row[:width]
+		}
+	}
+	return cb(&sqltypes.Result{Rows: rows})
+}
+
+// sortResult sorts the rows of the result, evaluating the ORDER BY expressions
+// against each of them if needed.
+func sortResult(env *evalengine.ExpressionEnv, orderBy evalengine.Comparison, result *sqltypes.Result) error {
+	if len(result.Rows) == 0 {
+		return nil
+	}
+	width := len(result.Rows[0])
+	cmp, extended := orderBy.Extend(width)
+	if !extended {
+		return orderBy.SortResult(result)
+	}
+	for i, row := range result.Rows {
+		var err error
+		if result.Rows[i], err = orderBy.ExtendRow(env, row); err != nil {
+			return err
+		}
+	}
+	if err := cmp.SortResult(result); err != nil {
+		return err
+	}
+	for i, row := range result.Rows {
+		result.Rows[i] = row[:width]
+	}
+	return nil
 }
 
 // GetFields satisfies the Primitive interface.
@@ -20,12 +20,15 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/test/utils"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtenv"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
@@ -703,3 +706,80 @@ func TestMemorySortStreamAsync(t *testing.T) {
 [VARBINARY("c") DECIMAL(4)] [VARBINARY("c") DECIMAL(4)] [VARBINARY("c") DECIMAL(4)]]`,
 		qr.Rows))
 }
+
+// orderByLength returns the ORDER BY params of `length()` of the column at the
+// given offset.
+func orderByLength(t *testing.T, offset int, desc bool) evalengine.OrderByParams {
+	expr, err := evalengine.Translate(&sqlparser.FuncExpr{
+		Name:  sqlparser.NewIdentifierCI("length"),
+		Exprs: sqlparser.Exprs{&sqlparser.Offset{V: offset}},
+	}, &evalengine.Config{
+		Environment: vtenv.NewTestEnv(),
+		Collation:   collations.MySQL8().DefaultConnectionCharset(),
+	})
+	require.NoError(t, err)
+	return evalengine.OrderByParams{
+		Expr:            expr,
+		WeightStringCol: -1,
+		Desc:            desc,
+		Type:            evalengine.NewType(sqltypes.Int64, collations.CollationBinaryID),
+		CollationEnv:    collations.MySQL8(),
+	}
+}
+
+func TestMemorySortExpression(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"id|name",
+		"int64|varchar",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|ccc",
+			"2|a",
+			"3|null",
+			"4|bbbb",
+			"5|dd",
+		)},
+	}
+
+	// order by length(name) desc, id: the NULL values come last
+	ms := &MemorySort{
+		OrderBy: []evalengine.OrderByParams{orderByLength(t, 1, true), {
+			WeightStringCol: -1,
+			Col:             0,
+		}},
+		Input: fp,
+	}
+	assert.Equal(t, "length(_vt_column_1) DESC, 0 ASC", ms.description().Other["OrderBy"])
+
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"4|bbbb",
+		"1|ccc",
+		"5|dd",
+		"2|a",
+		"3|null",
+	)
+	result, err := ms.TryExecute(context.Background(), &noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	utils.MustMatch(t, wantResult, result)
+
+	fp.rewind()
+	result, err = wrapStreamExecute(ms, &noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	utils.MustMatch(t, wantResult, result)
+
+	// in ascending order, the NULL values come first
+	fp.rewind()
+	ms.OrderBy[0] = orderByLength(t, 1, false)
+	ms.UpperLimit = evalengine.NewLiteralInt(3)
+	result, err = wrapStreamExecute(ms, &noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	utils.MustMatch(t, sqltypes.MakeTestResult(
+		fields,
+		"3|null",
+		"2|a",
+		"5|dd",
+	), result)
+}
@@ -96,6 +96,30 @@ func (ms *MergeSort) TryStreamExecute(ctx context.Context, vcursor VCursor, bind
 	merge := &evalengine.Merger{
 		Compare: ms.OrderBy,
 	}
+	// width is the number of columns of the rows before they are extended with
+	// the values of the ORDER BY expressions, or -1 until the first row
+	width := -1
+	var extended bool
+	var env *evalengine.ExpressionEnv
+	push := func(row sqltypes.Row, stream int) error {
+		if width == -1 {
+			width = len(row)
+			merge.Compare, extended = ms.OrderBy.Extend(width)
+			if extended {
+				// The environment is only needed to evaluate the expressions,
+				// and some callers, like VDiff, don't have a VCursor
+				env = evalengine.NewExpressionEnv(ctx, bindVars, vcursor)
+			}
+		}
+		if extended {
+			var err error
+			if row, err = ms.OrderBy.ExtendRow(env, row); err != nil {
+				return err
+			}
+		}
+		merge.Push(row, stream)
+		return nil
+	}
 
 	if wantfields {
 		fields, err := ms.getStreamingFields(handles)
@@ -124,7 +148,9 @@ func (ms *MergeSort) TryStreamExecute(ctx context.Context, vcursor VCursor, bind
 				// If so, don't add anything to the heap.
 				continue
 			}
-			merge.Push(row, i)
+			if err := push(row, i); err != nil {
+				return err
+			}
 		case <-ctx.Done():
 			return ctx.Err()
 		}
@@ -137,6 +163,9 @@ func (ms *MergeSort) TryStreamExecute(ctx context.Context, vcursor VCursor, bind
 	// row came from and push it into the heap.
 	for merge.Len() != 0 {
 		row, stream := merge.Pop()
+		if extended {
+			row = row[:width]
+		}
 		if err := callback(&sqltypes.Result{Rows: [][]sqltypes.Value{row}}); err != nil {
 			return err
 		}
@@ -149,7 +178,9 @@ func (ms *MergeSort) TryStreamExecute(ctx context.Context, vcursor VCursor, bind
 				}
 				continue
 			}
-			merge.Push(row, stream)
+			if err := push(row, stream); err != nil {
+				return err
+			}
 		case <-ctx.Done():
 			return ctx.Err()
 		}
@@ -274,6 +274,50 @@ func TestMergeSortDescending(t *testing.T) {
 	utils.MustMatch(t, wantResults, results)
 }
 
+// TestMergeSortExpression tests a merge sort on the value of an
+// expression, i.e. length(col) desc, for which the shards return the rows
+// in that order. The NULL values come last.
+func TestMergeSortExpression(t *testing.T) {
+	idColFields := sqltypes.MakeTestFields("id|col", "int32|varchar")
+	shardResults := []*shardResult{{
+		results: sqltypes.MakeTestStreamingResults(idColFields,
+			"1|aaaa",
+			"---",
+			"2|a",
+		),
+	}, {
+		results: sqltypes.MakeTestStreamingResults(idColFields,
+			"3|bbb",
+			"4|null",
+		),
+	}, {
+		results: sqltypes.MakeTestStreamingResults(idColFields,
+			"5|cc",
+		),
+	}}
+	orderBy := []evalengine.OrderByParams{orderByLength(t, 1, true)}
+
+	var results []*sqltypes.Result
+	err := testMergeSort(shardResults, orderBy, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	wantResults := sqltypes.MakeTestStreamingResults(idColFields,
+		"1|aaaa",
+		"---",
+		"3|bbb",
+		"---",
+		"5|cc",
+		"---",
+		"2|a",
+		"---",
+		"4|null",
+	)
+	utils.MustMatch(t, wantResults, results)
+}
+
 func TestMergeSortEmptyResults(t *testing.T) {
 	idColFields := sqltypes.MakeTestFields("id|col", "int32|varchar")
 	shardResults := []*shardResult{{
@@ -54,6 +54,12 @@ func (code PulloutOpcode) NeedsListArg() bool {
 	return code == PulloutIn || code == PulloutNotIn
 }
 
+// IsExists returns true for EXISTS and NOT EXISTS subqueries, which only
+// check whether the subquery returns any row.
+func (code PulloutOpcode) IsExists() bool {
+	return code == PulloutExists || code == PulloutNotExists
+}
+
 // MarshalJSON serializes the PulloutOpcode as a JSON string.
 // It's used for testing and diagnostics.
 func (code PulloutOpcode) MarshalJSON() ([]byte, error) {
@@ -166,7 +166,11 @@ func (oa *OrderedAggregate) execute(ctx context.Context, vcursor VCursor, bindVa
 		}
 
 		if nextGroup {
-			out.Rows = append(out.Rows, agg.finish())
+			row, err := agg.finish()
+			if err != nil {
+				return nil, err
+			}
+			out.Rows = append(out.Rows, row)
 			agg.reset()
 		}
 
@@ -176,7 +180,11 @@ func (oa *OrderedAggregate) execute(ctx context.Context, vcursor VCursor, bindVa
 	}
 
 	if currentKey != nil {
-		out.Rows = append(out.Rows, agg.finish())
+		row, err := agg.finish()
+		if err != nil {
+			return nil, err
+		}
+		out.Rows = append(out.Rows, row)
 	}
 
 	return out, nil
@@ -271,7 +279,11 @@ func (oa *OrderedAggregate) TryStreamExecute(ctx context.Context, vcursor VCurso
 
 			if nextGroup {
 				// this is a new grouping. let's yield the old one, and start a new
-				if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{agg.finish()}}); err != nil {
+				row, err := agg.finish()
+				if err != nil {
+					return err
+				}
+				if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{row}}); err != nil {
 					return err
 				}
 
@@ -292,7 +304,11 @@ func (oa *OrderedAggregate) TryStreamExecute(ctx context.Context, vcursor VCurso
 	}
 
 	if currentKey != nil {
-		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{agg.finish()}}); err != nil {
+		row, err := agg.finish()
+		if err != nil {
+			return err
+		}
+		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{row}}); err != nil {
 			return err
 		}
 	}
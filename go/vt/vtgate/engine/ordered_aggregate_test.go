@@ -1089,6 +1089,78 @@ func TestGroupConcatWithAggrOnEngine(t *testing.T) {
 	}
 }
 
+// TestGroupConcatWithOrderBy tests group_concat with an order by evaluated on engine,
+// where the rows of a group come from several shards in any order.
+func TestGroupConcatWithOrderBy(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"c1|c2|c3",
+		"int64|varchar|varchar",
+	)
+	outFields := sqltypes.MakeTestFields(
+		"c1|group_concat(c2)",
+		"int64|text",
+	)
+	input := sqltypes.MakeTestResult(fields,
+		"10|x|b", "10|y|A", "10|null|0", "10|z|C", "10|v|a", "10|w|null",
+		"20|null|a",
+		"30|u|a")
+
+	var tcases = []struct {
+		name      string
+		desc      bool
+		expResult *sqltypes.Result
+	}{{
+		name: "ascending",
+		expResult: sqltypes.MakeTestResult(outFields,
+			`10|w,y,v,x,z`,
+			`20|null`,
+			`30|u`),
+	}, {
+		name: "descending",
+		desc: true,
+		expResult: sqltypes.MakeTestResult(outFields,
+			`10|z,x,y,v,w`,
+			`20|null`,
+			`30|u`),
+	}}
+
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			fp := &fakePrimitive{results: []*sqltypes.Result{input}}
+			agp := NewAggregateParam(AggregateGroupConcat, 1, "group_concat(c2)", collations.MySQL8())
+			agp.Func = &sqlparser.GroupConcatExpr{Separator: ","}
+			agp.OrderBy = evalengine.Comparison{{
+				Col:             2,
+				WeightStringCol: -1,
+				Desc:            tcase.desc,
+				Type:            evalengine.NewType(sqltypes.VarChar, collations.CollationUtf8mb4ID),
+				CollationEnv:    collations.MySQL8(),
+			}}
+			oa := &OrderedAggregate{
+				Aggregates:          []*AggregateParams{agp},
+				GroupByKeys:         []*GroupByParams{{KeyCol: 0}},
+				TruncateColumnCount: 2,
+				Input:               fp,
+			}
+			qr, err := oa.TryExecute(context.Background(), &noopVCursor{}, nil, false)
+			require.NoError(t, err)
+			utils.MustMatch(t, tcase.expResult, qr)
+
+			fp.rewind()
+			results := &sqltypes.Result{}
+			err = oa.TryStreamExecute(context.Background(), &noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+				if qr.Fields != nil {
+					results.Fields = qr.Fields
+				}
+				results.Rows = append(results.Rows, qr.Rows...)
+				return nil
+			})
+			require.NoError(t, err)
+			utils.MustMatch(t, tcase.expResult, results)
+		})
+	}
+}
+
 // TestGroupConcat tests group_concat with partial aggregation on engine.
 func TestGroupConcat(t *testing.T) {
 	fields := sqltypes.MakeTestFields(
@@ -56,6 +56,9 @@ type (
 		// if the max memory rows override directive is set to true
 		ExceedsMaxMemoryRows(numRows int) bool
 
+		// LimitOffsetMaxBufferedRows returns the limit-offset-max-buffered-rows flag value.
+		LimitOffsetMaxBufferedRows() int
+
 		Execute(ctx context.Context, method string, query string, bindVars map[string]*querypb.BindVariable, rollbackOnError bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error)
 		AutocommitApproval() bool
 
@@ -91,6 +94,7 @@ type (
 		Environment() *vtenv.Environment
 		TimeZone() *time.Location
 		SQLMode() string
+		LCTimeNames() string
 
 		ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, lockFuncType sqlparser.LockingFuncType) (*sqltypes.Result, error)
 
@@ -215,6 +219,14 @@ type (
 		// SetQueryTimeout sets the query timeout
 		SetQueryTimeout(queryTimeout int64)
 
+		// SetMaxShardsPerQuery sets the maximum number of shards that a route
+		// of a query can be sent to, 0 meaning no limit
+		SetMaxShardsPerQuery(maxShards int64)
+
+		// SetMaxShardsPerQueryWarnOnly sets whether the queries that exceed
+		// the maximum number of shards only record a warning instead of failing
+		SetMaxShardsPerQueryWarnOnly(context.Context, bool) error
+
 		// InTransaction returns true if the session has already opened transaction or
 		// will start a transaction on the query execution.
 		InTransaction() bool
@@ -62,19 +62,25 @@ func (p *Projection) TryExecute(ctx context.Context, vcursor VCursor, bindVars m
 	}
 
 	env := evalengine.NewExpressionEnv(ctx, bindVars, vcursor)
+	exprs := p.prepare(env, result.Fields)
 	var resultRows []sqltypes.Row
+	// the DECIMAL values of the rows share buf
+	var buf []byte
 	for _, row := range result.Rows {
-		resultRow := make(sqltypes.Row, 0, len(p.Exprs))
+		resultRow := make(sqltypes.Row, 0, len(exprs))
 		env.Row = row
-		for _, exp := range p.Exprs {
+		for _, exp := range exprs {
 			c, err := env.Evaluate(exp)
 			if err != nil {
 				return nil, err
 			}
-			resultRow = append(resultRow, c.Value(vcursor.ConnCollation()))
+			var value sqltypes.Value
+			value, buf = c.AppendValue(buf, vcursor.ConnCollation())
+			resultRow = append(resultRow, value)
 		}
 		resultRows = append(resultRows, resultRow)
 	}
+	recordEvalWarnings(vcursor, env)
 	if wantfields {
 		result.Fields, err = p.evalFields(env, result.Fields, vcursor.ConnCollation())
 		if err != nil {
@@ -91,10 +97,14 @@ func (p *Projection) TryStreamExecute(ctx context.Context, vcursor VCursor, bind
 	var once sync.Once
 	var fields []*querypb.Field
 	var mu sync.Mutex
+	exprs := p.Exprs
 	return vcursor.StreamExecutePrimitive(ctx, p.Input, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		var err error
 		mu.Lock()
 		defer mu.Unlock()
+		if qr.Fields != nil {
+			exprs = p.prepare(env, qr.Fields)
+		}
 		if wantfields {
 			once.Do(func() {
 				fields, err = p.evalFields(env, qr.Fields, vcursor.ConnCollation())
@@ -112,18 +122,23 @@ func (p *Projection) TryStreamExecute(ctx context.Context, vcursor VCursor, bind
 			return err
 		}
 		resultRows := make([]sqltypes.Row, 0, len(qr.Rows))
+		// the DECIMAL values of the rows share buf
+		var buf []byte
 		for _, r := range qr.Rows {
-			resultRow := make(sqltypes.Row, 0, len(p.Exprs))
+			resultRow := make(sqltypes.Row, 0, len(exprs))
 			env.Row = r
-			for _, exp := range p.Exprs {
+			for _, exp := range exprs {
 				c, err := env.Evaluate(exp)
 				if err != nil {
 					return err
 				}
-				resultRow = append(resultRow, c.Value(vcursor.ConnCollation()))
+				var value sqltypes.Value
+				value, buf = c.AppendValue(buf, vcursor.ConnCollation())
+				resultRow = append(resultRow, value)
 			}
 			resultRows = append(resultRows, resultRow)
 		}
+		recordEvalWarnings(vcursor, env)
 		qr.Rows = resultRows
 		return callback(qr)
 	})
@@ -175,6 +190,16 @@ func (p *Projection) evalFields(env *evalengine.ExpressionEnv, infields []*query
 	return fields, nil
 }
 
+// prepare returns the expressions to evaluate for the rows of a result with the given
+// fields, see evalengine.ExpressionEnv.Prepare.
+func (p *Projection) prepare(env *evalengine.ExpressionEnv, fields []*querypb.Field) []evalengine.Expr {
+	exprs := make([]evalengine.Expr, 0, len(p.Exprs))
+	for _, expr := range p.Exprs {
+		exprs = append(exprs, env.Prepare(expr, fields))
+	}
+	return exprs
+}
+
 // Inputs implements the Primitive interface
 func (p *Projection) Inputs() ([]Primitive, []map[string]any) {
 	return []Primitive{p.Input}, nil
@@ -198,3 +223,13 @@ func (p *Projection) description() PrimitiveDescription {
 		},
 	}
 }
+
+// recordEvalWarnings records the warnings raised while evaluating expressions
+// in the session, so that they are returned by SHOW WARNINGS like they would be
+// by MySQL.
+func recordEvalWarnings(vcursor VCursor, env *evalengine.ExpressionEnv) {
+	for _, warning := range env.Warnings() {
+		vcursor.Session().RecordWarning(warning)
+	}
+	env.ClearWarnings()
+}
@@ -76,6 +76,52 @@ func TestMultiply(t *testing.T) {
 	assert.Equal(t, "[[UINT64(6)] [UINT64(0)] [UINT64(2)]]", fmt.Sprintf("%v", qr.Rows))
 }
 
+func TestProjectionWarnings(t *testing.T) {
+	expr := &sqlparser.BinaryExpr{
+		Operator: sqlparser.DivOp,
+		Left:     &sqlparser.Offset{V: 0},
+		Right:    &sqlparser.Offset{V: 1},
+	}
+	evalExpr, err := evalengine.Translate(expr, &evalengine.Config{
+		Environment: vtenv.NewTestEnv(),
+		Collation:   collations.MySQL8().DefaultConnectionCharset(),
+	})
+	require.NoError(t, err)
+	input := func() Primitive {
+		return &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("a|b", "int64|int64"),
+				"3|2",
+				"1|0",
+				"4|0",
+			)},
+		}
+	}
+	proj := &Projection{
+		Cols:       []string{"apa"},
+		Exprs:      []evalengine.Expr{evalExpr},
+		Input:      input(),
+		noTxNeeded: noTxNeeded{},
+	}
+	want := []*querypb.QueryWarning{
+		{Code: 1365, Message: "Division by 0"},
+		{Code: 1365, Message: "Division by 0"},
+	}
+
+	vc := &loggingVCursor{}
+	qr, err := proj.TryExecute(context.Background(), vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "[[DECIMAL(1.5000)] [NULL] [NULL]]", fmt.Sprintf("%v", qr.Rows))
+	vc.ExpectWarnings(t, want)
+
+	vc.Rewind()
+	proj.Input = input()
+	qr, err = wrapStreamExecute(proj, vc, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, "[[DECIMAL(1.5000)] [NULL] [NULL]]", fmt.Sprintf("%v", qr.Rows))
+	vc.ExpectWarnings(t, want)
+}
+
 func TestProjectionStreaming(t *testing.T) {
 	expr := &sqlparser.BinaryExpr{
 		Operator: sqlparser.MultOp,
@@ -227,7 +227,7 @@ func (route *Route) executeShards(
 		return result, nil
 	}
 
-	return route.sort(result)
+	return route.sort(evalengine.NewExpressionEnv(ctx, bindVars, vcursor), result)
 }
 
 func filterOutNilErrors(errs []error) []error {
@@ -378,13 +378,13 @@ func (route *Route) GetFields(ctx context.Context, vcursor VCursor, bindVars map
 	return qr.Truncate(route.TruncateColumnCount), nil
 }
 
-func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
+func (route *Route) sort(env *evalengine.ExpressionEnv, in *sqltypes.Result) (*sqltypes.Result, error) {
 	// Since Result is immutable, we make a copy.
 	// The copy can be shallow because we won't be changing
 	// the contents of any row.
 	out := in.ShallowCopy()
 
-	if err := route.OrderBy.SortResult(out); err != nil {
+	if err := sortResult(env, route.OrderBy, out); err != nil {
 		return nil, err
 	}
 	return out.Truncate(route.TruncateColumnCount), nil
@@ -406,6 +406,9 @@ func (route *Route) description() PrimitiveDescription {
 		}
 		other["Values"] = formattedValues
 	}
+	if route.ScatterOnNull {
+		other["ScatterOnNull"] = true
+	}
 	if len(route.SysTableTableSchema) != 0 {
 		sysTabSchema := "["
 		for idx, tableSchema := range route.SysTableTableSchema {
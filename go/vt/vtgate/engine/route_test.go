@@ -261,6 +261,46 @@ func TestSelectEqualUnique(t *testing.T) {
 	expectResult(t, result, defaultSelectResult)
 }
 
+func TestSelectEqualScatterOnNull(t *testing.T) {
+	vindex, _ := vindexes.CreateVindex("hash", "", nil)
+	sel := NewRoute(
+		Equal,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex.(vindexes.SingleColumn)
+	sel.Values = []evalengine.Expr{
+		evalengine.NewBindVar("v", evalengine.Type{}),
+	}
+	sel.ScatterOnNull = true
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	result, err := sel.TryExecute(context.Background(), vc, map[string]*querypb.BindVariable{"v": sqltypes.Int64BindVariable(1)}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard ks.-20: dummy_select {v: type:INT64 value:"1"} false false`,
+	})
+	expectResult(t, result, defaultSelectResult)
+
+	// A NULL value goes to all the shards.
+	vc.Rewind()
+	result, err = sel.TryExecute(context.Background(), vc, map[string]*querypb.BindVariable{"v": sqltypes.NullBindVariable}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.-20: dummy_select {v: } ks.20-: dummy_select {v: } false false`,
+	})
+	expectResult(t, result, defaultSelectResult)
+}
+
 func TestSelectNone(t *testing.T) {
 	vindex, _ := vindexes.CreateVindex("hash", "", nil)
 	sel := NewRoute(
@@ -952,6 +992,39 @@ func TestRouteSort(t *testing.T) {
 	require.EqualError(t, err, `cannot compare strings, collation is unknown or unsupported (collation ID: 0)`)
 }
 
+func TestRouteSortExpression(t *testing.T) {
+	sel := NewRoute(
+		Scatter,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.OrderBy = []evalengine.OrderByParams{orderByLength(t, 1, true)}
+
+	fields := sqltypes.MakeTestFields(
+		"id|name",
+		"int64|varchar",
+	)
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "1|ccc", "2|a", "3|bbbb", "4|dd"),
+		},
+	}
+	result, err := sel.TryExecute(context.Background(), vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	expectResult(t, result, sqltypes.MakeTestResult(
+		fields,
+		"3|bbbb",
+		"1|ccc",
+		"4|dd",
+		"2|a",
+	))
+}
+
 func TestRouteSortWeightStrings(t *testing.T) {
 	sel := NewRoute(
 		Unsharded,
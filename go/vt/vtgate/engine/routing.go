@@ -119,6 +119,12 @@ type RoutingParameters struct {
 
 	// Values specifies the vindex values to use for routing.
 	Values []evalengine.Expr
+
+	// ScatterOnNull sends the Equal queries to all the shards instead of none
+	// when the value is NULL. It's set for the optional filters that use the
+	// vindex column as their fallback, e.g. `id = coalesce(:id, id)`, which
+	// are true in any row when the bind variable is NULL.
+	ScatterOnNull bool
 }
 
 func (code Opcode) IsSingleShard() bool {
@@ -337,7 +343,11 @@ func (rp *RoutingParameters) equal(ctx context.Context, vcursor VCursor, bindVar
 	if err != nil {
 		return nil, nil, err
 	}
-	rss, _, err := resolveShards(ctx, vcursor, rp.Vindex.(vindexes.SingleColumn), rp.Keyspace, []sqltypes.Value{value.Value(vcursor.ConnCollation())})
+	val := value.Value(vcursor.ConnCollation())
+	if rp.ScatterOnNull && val.IsNull() {
+		return rp.byDestination(ctx, vcursor, bindVars, key.DestinationAllShards{})
+	}
+	rss, _, err := resolveShards(ctx, vcursor, rp.Vindex.(vindexes.SingleColumn), rp.Keyspace, []sqltypes.Value{val})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -96,9 +96,13 @@ func (sa *ScalarAggregate) TryExecute(ctx context.Context, vcursor VCursor, bind
 		}
 	}
 
+	row, err := agg.finish()
+	if err != nil {
+		return nil, err
+	}
 	out := &sqltypes.Result{
 		Fields: fields,
-		Rows:   [][]sqltypes.Value{agg.finish()},
+		Rows:   [][]sqltypes.Value{row},
 	}
 	return out.Truncate(sa.TruncateColumnCount), nil
 }
@@ -146,7 +150,11 @@ func (sa *ScalarAggregate) TryStreamExecute(ctx context.Context, vcursor VCursor
 		return err
 	}
 
-	return cb(&sqltypes.Result{Rows: [][]sqltypes.Value{agg.finish()}})
+	row, err := agg.finish()
+	if err != nil {
+		return err
+	}
+	return cb(&sqltypes.Result{Rows: [][]sqltypes.Value{row}})
 }
 
 // Inputs implements the Primitive interface
@@ -500,6 +500,17 @@ func (svss *SysVarSetAware) Execute(ctx context.Context, vcursor VCursor, env *e
 			return err
 		}
 		vcursor.Session().SetQueryTimeout(queryTimeout)
+	case sysvars.MaxShardsPerQuery.Name:
+		maxShards, err := svss.evalAsInt64(env, vcursor)
+		if err != nil {
+			return err
+		}
+		if maxShards < 0 {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "variable 'max_shards_per_query' can't be set to the value of '%d'", maxShards)
+		}
+		vcursor.Session().SetMaxShardsPerQuery(maxShards)
+	case sysvars.MaxShardsPerQueryWarnOnly.Name:
+		err = svss.setBoolSysVar(ctx, env, vcursor.Session().SetMaxShardsPerQueryWarnOnly)
 	case sysvars.SessionEnableSystemSettings.Name:
 		err = svss.setBoolSysVar(ctx, env, vcursor.Session().SetSessionEnableSystemSettings)
 	case sysvars.Charset.Name, sysvars.Names.Name:
@@ -97,16 +97,21 @@ func (v *VExplain) TryStreamExecute(ctx context.Context, vcursor VCursor, bindVa
 	return callback(result)
 }
 
+// vexplainResults lists, for the types of VEXPLAIN that run the statement, how
+// the result is built from the queries that were logged while it ran.
+var vexplainResults = map[sqlparser.VExplainType]func(v *VExplain, ctx context.Context, vcursor VCursor) (*sqltypes.Result, error){
+	sqlparser.QueriesVExplainType: func(_ *VExplain, _ context.Context, vcursor VCursor) (*sqltypes.Result, error) {
+		return convertToVExplainQueriesResult(vcursor.Session().GetVExplainLogs()), nil
+	},
+	sqlparser.AllVExplainType: (*VExplain).convertToVExplainAllResult,
+}
+
 func (v *VExplain) convertToResult(ctx context.Context, vcursor VCursor) (*sqltypes.Result, error) {
-	switch v.Type {
-	case sqlparser.QueriesVExplainType:
-		result := convertToVExplainQueriesResult(vcursor.Session().GetVExplainLogs())
-		return result, nil
-	case sqlparser.AllVExplainType:
-		return v.convertToVExplainAllResult(ctx, vcursor)
-	default:
+	convert, ok := vexplainResults[v.Type]
+	if !ok {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "Unknown type of VExplain plan")
 	}
+	return convert(v, ctx, vcursor)
 }
 
 func (v *VExplain) convertToVExplainAllResult(ctx context.Context, vcursor VCursor) (*sqltypes.Result, error) {
@@ -70,6 +70,15 @@ func (s *aggregationSumCount) Add(value sqltypes.Value) error {
 	return nil
 }
 
+func (s *aggregationSumCount) merge(other *aggregationSumCount) error {
+	result := s.n + other.n
+	if (result > s.n) != (other.n > 0) {
+		return dataOutOfRangeError(s.n, other.n, "BIGINT", "+")
+	}
+	s.n = result
+	return nil
+}
+
 func (s *aggregationSumCount) Result() sqltypes.Value {
 	return sqltypes.NewInt64(s.n)
 }
@@ -151,6 +160,34 @@ func (s *aggregationInt) Max(value sqltypes.Value) error {
 	return nil
 }
 
+func (s *aggregationInt) decimal() decimal.Decimal {
+	if s.dec.IsInitialized() {
+		return s.dec
+	}
+	return decimal.NewFromInt(s.current)
+}
+
+func (s *aggregationInt) mergeSum(other *aggregationInt) {
+	if !other.init {
+		return
+	}
+	if !s.init {
+		s.current, s.dec, s.init = other.current, other.dec, true
+		return
+	}
+	if s.dec.IsInitialized() || other.dec.IsInitialized() {
+		s.dec = s.decimal().Add(other.decimal())
+		return
+	}
+
+	result := s.current + other.current
+	if (result > s.current) != (other.current > 0) {
+		s.dec = decimal.NewFromInt(s.current).Add(decimal.NewFromInt(other.current))
+	} else {
+		s.current = result
+	}
+}
+
 func (s *aggregationInt) Result() sqltypes.Value {
 	if !s.init {
 		return sqltypes.NULL
@@ -205,7 +242,7 @@ func (s *aggregationUint) Add(value sqltypes.Value) error {
 	}
 
 	result := s.current + n
-	if false {
+	if result < s.current {
 		s.dec = decimal.NewFromUint(s.current).Add(decimal.NewFromUint(n))
 	} else {
 		s.current = result
@@ -244,6 +281,34 @@ func (s *aggregationUint) Max(value sqltypes.Value) error {
 	return nil
 }
 
+func (s *aggregationUint) decimal() decimal.Decimal {
+	if s.dec.IsInitialized() {
+		return s.dec
+	}
+	return decimal.NewFromUint(s.current)
+}
+
+func (s *aggregationUint) mergeSum(other *aggregationUint) {
+	if !other.init {
+		return
+	}
+	if !s.init {
+		s.current, s.dec, s.init = other.current, other.dec, true
+		return
+	}
+	if s.dec.IsInitialized() || other.dec.IsInitialized() {
+		s.dec = s.decimal().Add(other.decimal())
+		return
+	}
+
+	result := s.current + other.current
+	if result < s.current {
+		s.dec = decimal.NewFromUint(s.current).Add(decimal.NewFromUint(other.current))
+	} else {
+		s.current = result
+	}
+}
+
 func (s *aggregationUint) Result() sqltypes.Value {
 	if !s.init {
 		return sqltypes.NULL
@@ -317,6 +382,14 @@ func (s *aggregationFloat) Max(value sqltypes.Value) error {
 	return nil
 }
 
+func (s *aggregationFloat) mergeSum(other *aggregationFloat) {
+	if !other.init {
+		return
+	}
+	s.current += other.current
+	s.init = true
+}
+
 func (s *aggregationFloat) Result() sqltypes.Value {
 	if !s.init {
 		return sqltypes.NULL
@@ -356,68 +429,120 @@ func (s *aggregationSumAny) Result() sqltypes.Value {
 // aggregationDecimal implements SUM, MIN and MAX aggregations for the DECIMAL type.
 // The return of all aggregations is always DECIMAL, except when no values have been
 // aggregated, where the return is NULL.
+//
+// The parsed values are buffered and folded in batches with the slice helpers of the
+// decimal package, instead of comparing or adding them one at a time: the first value
+// of the buffer is the partial result of the batches folded so far. Like in MySQL, a
+// SUM that doesn't fit in a DECIMAL is clamped to the largest DECIMAL of its scale.
 type aggregationDecimal struct {
-	dec  decimal.Decimal
-	prec int32
+	values []decimal.Decimal
+	fold   decimalFold
+	prec   int32
 }
 
-func (s *aggregationDecimal) Add(value sqltypes.Value) error {
-	if value.IsNull() {
-		return nil
+// decimalFoldBatch is the amount of values that are buffered by aggregationDecimal
+// before they are folded into a partial result.
+const decimalFoldBatch = 64
+
+type decimalFold int8
+
+const (
+	decimalSum decimalFold = iota
+	decimalMin
+	decimalMax
+)
+
+func (f decimalFold) apply(values []decimal.Decimal) decimal.Decimal {
+	var d decimal.Decimal
+	switch f {
+	case decimalMin:
+		d, _ = decimal.MinOf(values)
+	case decimalMax:
+		d, _ = decimal.MaxOf(values)
+	default:
+		d = decimal.SumOf(values)
 	}
+	return d
+}
+
+func (s *aggregationDecimal) accumulate(value sqltypes.Value, fold decimalFold) (decimal.Decimal, error) {
 	dec, err := decimal.NewFromMySQL(value.Raw())
 	if err != nil {
-		return err
+		return decimal.Decimal{}, err
 	}
-	if !s.dec.IsInitialized() {
-		s.dec = dec
-		s.prec = -dec.Exponent()
-	} else {
-		s.dec = s.dec.Add(dec)
-		s.prec = max(s.prec, -dec.Exponent())
+	s.fold = fold
+	s.values = append(s.values, dec)
+	if len(s.values) >= decimalFoldBatch {
+		s.flush()
 	}
-	return nil
+	return dec, nil
 }
 
-func (s *aggregationDecimal) Min(value sqltypes.Value) error {
+// flush folds the buffered values into their partial result, which is left as the
+// only value of the buffer.
+func (s *aggregationDecimal) flush() {
+	if len(s.values) <= 1 {
+		return
+	}
+	s.values[0] = s.fold.apply(s.values)
+	s.values = s.values[:1]
+}
+
+func (s *aggregationDecimal) Add(value sqltypes.Value) error {
 	if value.IsNull() {
 		return nil
 	}
-	dec, err := decimal.NewFromMySQL(value.Raw())
+	dec, err := s.accumulate(value, decimalSum)
 	if err != nil {
 		return err
 	}
-	if !s.dec.IsInitialized() || dec.Cmp(s.dec) < 0 {
-		s.dec = dec
-		s.prec = -dec.Exponent()
-	}
+	s.prec = max(s.prec, -dec.Exponent())
 	return nil
 }
 
-func (s *aggregationDecimal) Max(value sqltypes.Value) error {
+func (s *aggregationDecimal) Min(value sqltypes.Value) error {
 	if value.IsNull() {
 		return nil
 	}
-	dec, err := decimal.NewFromMySQL(value.Raw())
-	if err != nil {
-		return err
+	_, err := s.accumulate(value, decimalMin)
+	return err
+}
+
+func (s *aggregationDecimal) Max(value sqltypes.Value) error {
+	if value.IsNull() {
+		return nil
 	}
-	if !s.dec.IsInitialized() || dec.Cmp(s.dec) > 0 {
-		s.dec = dec
-		s.prec = -dec.Exponent()
+	_, err := s.accumulate(value, decimalMax)
+	return err
+}
+
+func (s *aggregationDecimal) mergeSum(other *aggregationDecimal) {
+	if len(other.values) == 0 {
+		return
 	}
-	return nil
+	s.fold = decimalSum
+	s.values = append(s.values, other.values...)
+	s.prec = max(s.prec, other.prec)
+	s.flush()
 }
 
 func (s *aggregationDecimal) Result() sqltypes.Value {
-	if !s.dec.IsInitialized() {
+	if len(s.values) == 0 {
 		return sqltypes.NULL
 	}
-	return sqltypes.MakeTrusted(sqltypes.Decimal, s.dec.FormatMySQL(s.prec))
+	s.flush()
+	prec := s.prec
+	if s.fold == decimalSum {
+		decimal.ClampInPlace(s.values, decimal.MyMaxPrecision-prec, prec)
+	} else {
+		// MIN and MAX return one of the values, with its own scale.
+		prec = -s.values[0].Exponent()
+	}
+	return sqltypes.MakeTrusted(sqltypes.Decimal, s.values[0].FormatMySQL(prec))
 }
 
 func (s *aggregationDecimal) Reset() {
-	s.dec = decimal.Decimal{}
+	s.values = s.values[:0]
 	s.prec = 0
 }
 
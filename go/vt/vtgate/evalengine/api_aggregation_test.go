@@ -18,11 +18,13 @@ package evalengine
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/mysql/decimal"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/test/utils"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -170,3 +172,33 @@ func TestMinMax(t *testing.T) {
 		})
 	}
 }
+
+func TestAggregationDecimal(t *testing.T) {
+	// More values than decimalFoldBatch, so that they're folded more than once.
+	var values []sqltypes.Value
+	for i := 1; i <= 3*decimalFoldBatch; i++ {
+		values = append(values, sqltypes.NewDecimal(strconv.Itoa(i)+".5"), NULL)
+	}
+	values = append(values, sqltypes.NewDecimal("0.25"))
+
+	sum := NewAggregationSum(sqltypes.Decimal)
+	minimum := NewAggregationMinMax(sqltypes.Decimal, collations.MySQL8(), collations.Unknown, nil)
+	maximum := NewAggregationMinMax(sqltypes.Decimal, collations.MySQL8(), collations.Unknown, nil)
+	for _, v := range values {
+		require.NoError(t, sum.Add(v))
+		require.NoError(t, minimum.Min(v))
+		require.NoError(t, maximum.Max(v))
+	}
+	utils.MustMatch(t, sqltypes.NewDecimal("18624.25"), sum.Result())
+	utils.MustMatch(t, sqltypes.NewDecimal("0.25"), minimum.Result())
+	utils.MustMatch(t, sqltypes.NewDecimal("192.5"), maximum.Result())
+
+	sum.Reset()
+	utils.MustMatch(t, sqltypes.NULL, sum.Result())
+
+	// A sum with more digits than a DECIMAL can hold is clamped, like in MySQL.
+	nines := strings.Repeat("9", decimal.MyMaxPrecision-1) + ".9"
+	require.NoError(t, sum.Add(sqltypes.NewDecimal(nines)))
+	require.NoError(t, sum.Add(sqltypes.NewDecimal("1")))
+	utils.MustMatch(t, sqltypes.NewDecimal(nines), sum.Result())
+}
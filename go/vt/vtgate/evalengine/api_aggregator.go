@@ -0,0 +1,429 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/mysql/collations/charset"
+	"vitess.io/vitess/go/mysql/collations/colldata"
+	"vitess.io/vitess/go/mysql/decimal"
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// AggregateFunc is an aggregate function that can be evaluated with an Aggregator.
+type AggregateFunc int
+
+const (
+	// AggregateCount is COUNT(expr), which counts the non-NULL values.
+	AggregateCount AggregateFunc = iota
+	// AggregateCountStar is COUNT(*), which counts all the values, including NULLs.
+	AggregateCountStar
+	// AggregateSum is SUM(expr).
+	AggregateSum
+	// AggregateSumOfCounts sums the partial results of COUNT() from different
+	// result sets; unlike SUM(), its result is always an INT64 and it's 0 when
+	// there are no values.
+	AggregateSumOfCounts
+	// AggregateAvg is AVG(expr).
+	AggregateAvg
+	// AggregateMin is MIN(expr).
+	AggregateMin
+	// AggregateMax is MAX(expr).
+	AggregateMax
+	// AggregateGroupConcat is GROUP_CONCAT(expr SEPARATOR sep).
+	AggregateGroupConcat
+)
+
+func (f AggregateFunc) String() string {
+	switch f {
+	case AggregateCount:
+		return "count"
+	case AggregateCountStar:
+		return "count_star"
+	case AggregateSum:
+		return "sum"
+	case AggregateSumOfCounts:
+		return "sum_count"
+	case AggregateAvg:
+		return "avg"
+	case AggregateMin:
+		return "min"
+	case AggregateMax:
+		return "max"
+	case AggregateGroupConcat:
+		return "group_concat"
+	default:
+		return "unknown"
+	}
+}
+
+// Aggregator evaluates an aggregate function over a group of values.
+//
+// Init resets the Aggregator so it can aggregate a new group, Accumulate adds a value
+// of the group, and Finalize returns the result of the aggregation. Merge adds the
+// partial aggregation of another Aggregator to this one, so that a group can be
+// aggregated in several parts (e.g. one per shard or per worker) and combined
+// afterwards; both Aggregators must have been created with the same function and
+// parameters.
+//
+// Like in MySQL, NULL values are ignored by all the aggregate functions except
+// COUNT(*).
+type Aggregator interface {
+	Init()
+	Accumulate(value sqltypes.Value) error
+	Merge(other Aggregator) error
+	Finalize() sqltypes.Value
+}
+
+// AggregatorParams are the parameters used to create an Aggregator.
+type AggregatorParams struct {
+	// Type is the type of the values that will be aggregated.
+	Type sqltypes.Type
+	// Collation is the collation of the values that will be aggregated; it's used
+	// to compare textual values in MIN() and MAX().
+	Collation    collations.ID
+	CollationEnv *collations.Environment
+	// Values are the possible values of ENUM and SET columns.
+	Values *EnumSetValues
+	// Separator is the separator between the values of a GROUP_CONCAT(), in the
+	// utf8mb4 encoding of the query; it's converted to the charset of Collation.
+	Separator []byte
+}
+
+// NewAggregator returns an initialized Aggregator for the given aggregate function.
+func NewAggregator(fn AggregateFunc, params AggregatorParams) (Aggregator, error) {
+	switch fn {
+	case AggregateCount:
+		return &aggregatorCount{}, nil
+	case AggregateCountStar:
+		return &aggregatorCount{star: true}, nil
+	case AggregateSum:
+		return &aggregatorSum{sum: NewAggregationSum(params.Type)}, nil
+	case AggregateSumOfCounts:
+		return &aggregatorSum{sum: NewSumOfCounts()}, nil
+	case AggregateAvg:
+		if sqltypes.IsIntegral(params.Type) || sqltypes.IsDecimal(params.Type) {
+			return &aggregatorAvgDecimal{}, nil
+		}
+		return &aggregatorAvg{sum: NewAggregationSum(params.Type)}, nil
+	case AggregateMin:
+		return &aggregatorMinMax{minmax: NewAggregationMinMax(params.Type, params.CollationEnv, params.Collation, params.Values)}, nil
+	case AggregateMax:
+		return &aggregatorMinMax{minmax: NewAggregationMinMax(params.Type, params.CollationEnv, params.Collation, params.Values), max: true}, nil
+	case AggregateGroupConcat:
+		separator, err := groupConcatSeparator(params.Separator, params.Type, params.Collation)
+		if err != nil {
+			return nil, err
+		}
+		return &aggregatorGroupConcat{typ: groupConcatType(params.Type), separator: separator}, nil
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unsupported aggregate function: %v", fn)
+	}
+}
+
+func errMismatchedAggregators(a, b Aggregator) error {
+	return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "cannot merge aggregators of different kinds: %T and %T", a, b)
+}
+
+type aggregatorCount struct {
+	n    int64
+	star bool
+}
+
+func (a *aggregatorCount) Init() {
+	a.n = 0
+}
+
+func (a *aggregatorCount) Accumulate(value sqltypes.Value) error {
+	if a.star || !value.IsNull() {
+		a.n++
+	}
+	return nil
+}
+
+func (a *aggregatorCount) Merge(other Aggregator) error {
+	o, ok := other.(*aggregatorCount)
+	if !ok {
+		return errMismatchedAggregators(a, other)
+	}
+	a.n += o.n
+	return nil
+}
+
+func (a *aggregatorCount) Finalize() sqltypes.Value {
+	return sqltypes.NewInt64(a.n)
+}
+
+type aggregatorSum struct {
+	sum Sum
+}
+
+func (a *aggregatorSum) Init() {
+	a.sum.Reset()
+}
+
+func (a *aggregatorSum) Accumulate(value sqltypes.Value) error {
+	return a.sum.Add(value)
+}
+
+func (a *aggregatorSum) Merge(other Aggregator) error {
+	o, ok := other.(*aggregatorSum)
+	if !ok {
+		return errMismatchedAggregators(a, other)
+	}
+	return mergeSum(a.sum, o.sum)
+}
+
+func (a *aggregatorSum) Finalize() sqltypes.Value {
+	return a.sum.Result()
+}
+
+// mergeSum adds the partial sum in src to dst. Both sums must have been created
+// for the same type, so the partial results are added without losing precision.
+func mergeSum(dst, src Sum) error {
+	switch dst := dst.(type) {
+	case *aggregationSumCount:
+		if src, ok := src.(*aggregationSumCount); ok {
+			return dst.merge(src)
+		}
+	case *aggregationInt:
+		if src, ok := src.(*aggregationInt); ok {
+			dst.mergeSum(src)
+			return nil
+		}
+	case *aggregationUint:
+		if src, ok := src.(*aggregationUint); ok {
+			dst.mergeSum(src)
+			return nil
+		}
+	case *aggregationSumAny:
+		if src, ok := src.(*aggregationSumAny); ok {
+			dst.mergeSum(&src.aggregationFloat)
+			return nil
+		}
+	case *aggregationFloat:
+		if src, ok := src.(*aggregationFloat); ok {
+			dst.mergeSum(src)
+			return nil
+		}
+	case *aggregationDecimal:
+		if src, ok := src.(*aggregationDecimal); ok {
+			dst.mergeSum(src)
+			return nil
+		}
+	}
+	return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "cannot merge sums of different types: %T and %T", dst, src)
+}
+
+// aggregatorAvg implements AVG() as a SUM() and a COUNT() of the values.
+// Matching MySQL's behavior, the average of any values other than integral and
+// DECIMAL values is a FLOAT64.
+type aggregatorAvg struct {
+	sum Sum
+	n   int64
+}
+
+func (a *aggregatorAvg) Init() {
+	a.sum.Reset()
+	a.n = 0
+}
+
+func (a *aggregatorAvg) Accumulate(value sqltypes.Value) error {
+	if value.IsNull() {
+		return nil
+	}
+	if err := a.sum.Add(value); err != nil {
+		return err
+	}
+	a.n++
+	return nil
+}
+
+func (a *aggregatorAvg) Merge(other Aggregator) error {
+	o, ok := other.(*aggregatorAvg)
+	if !ok {
+		return errMismatchedAggregators(a, other)
+	}
+	if err := mergeSum(a.sum, o.sum); err != nil {
+		return err
+	}
+	a.n += o.n
+	return nil
+}
+
+func (a *aggregatorAvg) Finalize() sqltypes.Value {
+	if a.n == 0 {
+		return sqltypes.NULL
+	}
+	f, _ := a.sum.Result().ToFloat64()
+	return sqltypes.NewFloat64(f / float64(a.n))
+}
+
+// aggregatorAvgDecimal implements AVG() for integral and DECIMAL values, whose
+// average is a DECIMAL with 4 more decimal digits than the values
+// (div_precision_increment). The sum of the values is kept exact, including when
+// merging partial aggregations, and it's only divided and rounded in Finalize.
+type aggregatorAvgDecimal struct {
+	avg decimal.Avg
+}
+
+func (a *aggregatorAvgDecimal) Init() {
+	a.avg.Reset()
+}
+
+func (a *aggregatorAvgDecimal) Accumulate(value sqltypes.Value) error {
+	if value.IsNull() {
+		return nil
+	}
+	dec, err := decimal.NewFromMySQL(value.Raw())
+	if err != nil {
+		return err
+	}
+	a.avg.Add(dec)
+	return nil
+}
+
+func (a *aggregatorAvgDecimal) Merge(other Aggregator) error {
+	o, ok := other.(*aggregatorAvgDecimal)
+	if !ok {
+		return errMismatchedAggregators(a, other)
+	}
+	a.avg.Merge(&o.avg)
+	return nil
+}
+
+func (a *aggregatorAvgDecimal) Finalize() sqltypes.Value {
+	avg, ok := a.avg.Result(divPrecisionIncrement)
+	if !ok {
+		return sqltypes.NULL
+	}
+	return sqltypes.MakeTrusted(sqltypes.Decimal, avg.FormatMySQL(a.avg.Scale()+divPrecisionIncrement))
+}
+
+type aggregatorMinMax struct {
+	minmax MinMax
+	max    bool
+}
+
+func (a *aggregatorMinMax) Init() {
+	a.minmax.Reset()
+}
+
+func (a *aggregatorMinMax) Accumulate(value sqltypes.Value) error {
+	if a.max {
+		return a.minmax.Max(value)
+	}
+	return a.minmax.Min(value)
+}
+
+func (a *aggregatorMinMax) Merge(other Aggregator) error {
+	o, ok := other.(*aggregatorMinMax)
+	if !ok || o.max != a.max {
+		return errMismatchedAggregators(a, other)
+	}
+	// The partial result of MIN() and MAX() is one of the aggregated values,
+	// so it can be accumulated like any other value.
+	return a.Accumulate(o.minmax.Result())
+}
+
+func (a *aggregatorMinMax) Finalize() sqltypes.Value {
+	return a.minmax.Result()
+}
+
+// groupConcatType returns the type of the result of GROUP_CONCAT() for values
+// of the given type.
+func groupConcatType(typ sqltypes.Type) sqltypes.Type {
+	switch {
+	case typ == sqltypes.Unknown:
+		return sqltypes.Unknown
+	case sqltypes.IsBinary(typ):
+		return sqltypes.Blob
+	default:
+		return sqltypes.Text
+	}
+}
+
+// groupConcatSeparator converts the separator of a GROUP_CONCAT() to the charset
+// of the values it's concatenated with, so that the result is in a single charset,
+// like in MySQL. The separator of binary values is kept as is.
+func groupConcatSeparator(separator []byte, typ sqltypes.Type, collation collations.ID) ([]byte, error) {
+	if len(separator) == 0 || sqltypes.IsBinary(typ) || collation == collations.Unknown || collation == collations.CollationBinaryID {
+		return separator, nil
+	}
+	coll := colldata.Lookup(collation)
+	if coll == nil {
+		return separator, nil
+	}
+	if _, utf8mb4 := coll.Charset().(charset.Charset_utf8mb4); utf8mb4 {
+		return separator, nil
+	}
+	converted, err := charset.ConvertFromUTF8(nil, coll.Charset(), separator)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot convert the GROUP_CONCAT separator to %s: %v", coll.Charset().Name(), err)
+	}
+	return converted, nil
+}
+
+type aggregatorGroupConcat struct {
+	typ       sqltypes.Type
+	separator []byte
+
+	concat []byte
+	n      int
+}
+
+func (a *aggregatorGroupConcat) Init() {
+	a.n = 0
+	a.concat = nil // not safe to reuse this byte slice as it's returned as MakeTrusted
+}
+
+func (a *aggregatorGroupConcat) Accumulate(value sqltypes.Value) error {
+	if value.IsNull() {
+		return nil
+	}
+	if a.n > 0 {
+		a.concat = append(a.concat, a.separator...)
+	}
+	a.concat = append(a.concat, value.Raw()...)
+	a.n++
+	return nil
+}
+
+func (a *aggregatorGroupConcat) Merge(other Aggregator) error {
+	o, ok := other.(*aggregatorGroupConcat)
+	if !ok {
+		return errMismatchedAggregators(a, other)
+	}
+	if o.n == 0 {
+		return nil
+	}
+	if a.n > 0 {
+		a.concat = append(a.concat, a.separator...)
+	}
+	a.concat = append(a.concat, o.concat...)
+	a.n += o.n
+	return nil
+}
+
+func (a *aggregatorGroupConcat) Finalize() sqltypes.Value {
+	if a.n == 0 {
+		return sqltypes.NULL
+	}
+	return sqltypes.MakeTrusted(a.typ, a.concat)
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+)
+
+func TestAggregator(t *testing.T) {
+	tcases := []struct {
+		name     string
+		fn       AggregateFunc
+		params   AggregatorParams
+		values   []sqltypes.Value
+		expected sqltypes.Value
+	}{
+		{
+			name:     "count",
+			fn:       AggregateCount,
+			values:   []sqltypes.Value{NewInt64(1), NULL, NewInt64(2)},
+			expected: NewInt64(2),
+		},
+		{
+			name:     "count no values",
+			fn:       AggregateCount,
+			expected: NewInt64(0),
+		},
+		{
+			name:     "count star",
+			fn:       AggregateCountStar,
+			values:   []sqltypes.Value{NewInt64(1), NULL, NewInt64(2)},
+			expected: NewInt64(3),
+		},
+		{
+			name:     "sum int64",
+			fn:       AggregateSum,
+			params:   AggregatorParams{Type: sqltypes.Int64},
+			values:   []sqltypes.Value{NewInt64(1), NULL, NewInt64(2), NewInt64(3)},
+			expected: sqltypes.NewDecimal("6"),
+		},
+		{
+			name:     "sum int64 overflow",
+			fn:       AggregateSum,
+			params:   AggregatorParams{Type: sqltypes.Int64},
+			values:   []sqltypes.Value{NewInt64(math.MaxInt64), NewInt64(1), NewInt64(1)},
+			expected: sqltypes.NewDecimal("9223372036854775809"),
+		},
+		{
+			name:     "sum uint64 overflow",
+			fn:       AggregateSum,
+			params:   AggregatorParams{Type: sqltypes.Uint64},
+			values:   []sqltypes.Value{sqltypes.NewUint64(math.MaxUint64), sqltypes.NewUint64(1), sqltypes.NewUint64(1)},
+			expected: sqltypes.NewDecimal("18446744073709551617"),
+		},
+		{
+			name:     "sum decimal",
+			fn:       AggregateSum,
+			params:   AggregatorParams{Type: sqltypes.Decimal},
+			values:   []sqltypes.Value{sqltypes.NewDecimal("1.5"), sqltypes.NewDecimal("2.25"), sqltypes.NewDecimal("-0.125")},
+			expected: sqltypes.NewDecimal("3.625"),
+		},
+		{
+			name:     "sum float",
+			fn:       AggregateSum,
+			params:   AggregatorParams{Type: sqltypes.Float64},
+			values:   []sqltypes.Value{sqltypes.NewFloat64(1.5), sqltypes.NewFloat64(2)},
+			expected: sqltypes.NewFloat64(3.5),
+		},
+		{
+			name:     "sum no values",
+			fn:       AggregateSum,
+			params:   AggregatorParams{Type: sqltypes.Int64},
+			values:   []sqltypes.Value{NULL},
+			expected: sqltypes.NULL,
+		},
+		{
+			name:     "sum of counts",
+			fn:       AggregateSumOfCounts,
+			values:   []sqltypes.Value{NewInt64(2), NewInt64(3), NULL},
+			expected: NewInt64(5),
+		},
+		{
+			name:     "avg int64",
+			fn:       AggregateAvg,
+			params:   AggregatorParams{Type: sqltypes.Int64},
+			values:   []sqltypes.Value{NewInt64(1), NULL, NewInt64(2)},
+			expected: sqltypes.NewDecimal("1.5000"),
+		},
+		{
+			name:     "avg decimal",
+			fn:       AggregateAvg,
+			params:   AggregatorParams{Type: sqltypes.Decimal},
+			values:   []sqltypes.Value{sqltypes.NewDecimal("1.50"), sqltypes.NewDecimal("2.25")},
+			expected: sqltypes.NewDecimal("1.875000"),
+		},
+		{
+			name:     "avg decimal rounds once",
+			fn:       AggregateAvg,
+			params:   AggregatorParams{Type: sqltypes.Decimal},
+			values:   []sqltypes.Value{sqltypes.NewDecimal("-0.00001"), sqltypes.NewDecimal("-0.00001"), sqltypes.NewDecimal("0.00000")},
+			expected: sqltypes.NewDecimal("-0.000006667"),
+		},
+		{
+			name:     "avg uint64",
+			fn:       AggregateAvg,
+			params:   AggregatorParams{Type: sqltypes.Uint64},
+			values:   []sqltypes.Value{sqltypes.NewUint64(18446744073709551615), sqltypes.NewUint64(18446744073709551614), sqltypes.NewUint64(1)},
+			expected: sqltypes.NewDecimal("12297829382473034410.0000"),
+		},
+		{
+			name:     "avg float",
+			fn:       AggregateAvg,
+			params:   AggregatorParams{Type: sqltypes.Float64},
+			values:   []sqltypes.Value{sqltypes.NewFloat64(1), sqltypes.NewFloat64(2)},
+			expected: sqltypes.NewFloat64(1.5),
+		},
+		{
+			name:     "avg no values",
+			fn:       AggregateAvg,
+			params:   AggregatorParams{Type: sqltypes.Int64},
+			expected: sqltypes.NULL,
+		},
+		{
+			name: "min with collation",
+			fn:   AggregateMin,
+			params: AggregatorParams{
+				Type:         sqltypes.VarChar,
+				Collation:    getCollationID("utf8mb4_0900_ai_ci"),
+				CollationEnv: collations.MySQL8(),
+			},
+			values:   []sqltypes.Value{sqltypes.NewVarChar("b"), NULL, sqltypes.NewVarChar("A"), sqltypes.NewVarChar("c")},
+			expected: sqltypes.NewVarChar("A"),
+		},
+		{
+			name: "max with collation",
+			fn:   AggregateMax,
+			params: AggregatorParams{
+				Type:         sqltypes.VarChar,
+				Collation:    getCollationID("utf8mb4_hu_0900_ai_ci"),
+				CollationEnv: collations.MySQL8(),
+			},
+			values:   []sqltypes.Value{sqltypes.NewVarChar("cukor"), sqltypes.NewVarChar("csak"), sqltypes.NewVarChar("c")},
+			expected: sqltypes.NewVarChar("csak"),
+		},
+		{
+			name:     "max int64",
+			fn:       AggregateMax,
+			params:   AggregatorParams{Type: sqltypes.Int64},
+			values:   []sqltypes.Value{NewInt64(3), NewInt64(-1), NULL, NewInt64(2)},
+			expected: NewInt64(3),
+		},
+		{
+			name:     "group_concat",
+			fn:       AggregateGroupConcat,
+			params:   AggregatorParams{Type: sqltypes.VarChar, Separator: []byte(",")},
+			values:   []sqltypes.Value{sqltypes.NewVarChar("a"), NULL, sqltypes.NewVarChar("b"), sqltypes.NewVarChar("c")},
+			expected: sqltypes.MakeTrusted(sqltypes.Text, []byte("a,b,c")),
+		},
+		{
+			name:     "group_concat binary",
+			fn:       AggregateGroupConcat,
+			params:   AggregatorParams{Type: sqltypes.VarBinary, Separator: []byte("|")},
+			values:   []sqltypes.Value{sqltypes.NewVarBinary("a"), sqltypes.NewVarBinary("b")},
+			expected: sqltypes.MakeTrusted(sqltypes.Blob, []byte("a|b")),
+		},
+		{
+			name: "group_concat latin1",
+			fn:   AggregateGroupConcat,
+			params: AggregatorParams{
+				Type:      sqltypes.VarChar,
+				Collation: getCollationID("latin1_swedish_ci"),
+				Separator: []byte("·"),
+			},
+			values:   []sqltypes.Value{sqltypes.NewVarChar("\xe9t\xe9"), sqltypes.NewVarChar("hiver")},
+			expected: sqltypes.MakeTrusted(sqltypes.Text, []byte("\xe9t\xe9\xb7hiver")),
+		},
+		{
+			name:     "group_concat no values",
+			fn:       AggregateGroupConcat,
+			params:   AggregatorParams{Type: sqltypes.VarChar, Separator: []byte(",")},
+			values:   []sqltypes.Value{NULL},
+			expected: sqltypes.NULL,
+		},
+	}
+
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			agg, err := NewAggregator(tcase.fn, tcase.params)
+			require.NoError(t, err)
+
+			for _, v := range tcase.values {
+				require.NoError(t, agg.Accumulate(v))
+			}
+			utils.MustMatch(t, tcase.expected, agg.Finalize())
+
+			// aggregating the same group again after Init must yield the same result
+			agg.Init()
+			for _, v := range tcase.values {
+				require.NoError(t, agg.Accumulate(v))
+			}
+			utils.MustMatch(t, tcase.expected, agg.Finalize())
+
+			// splitting the group in two partial aggregations and merging them
+			// must yield the same result, wherever the group is split
+			for split := 0; split <= len(tcase.values); split++ {
+				left, err := NewAggregator(tcase.fn, tcase.params)
+				require.NoError(t, err)
+				right, err := NewAggregator(tcase.fn, tcase.params)
+				require.NoError(t, err)
+
+				for _, v := range tcase.values[:split] {
+					require.NoError(t, left.Accumulate(v))
+				}
+				for _, v := range tcase.values[split:] {
+					require.NoError(t, right.Accumulate(v))
+				}
+				require.NoError(t, left.Merge(right))
+				utils.MustMatch(t, tcase.expected, left.Finalize(), fmt.Sprintf("split at %d", split))
+			}
+		})
+	}
+}
+
+func TestAggregatorMergeMismatch(t *testing.T) {
+	count, err := NewAggregator(AggregateCount, AggregatorParams{})
+	require.NoError(t, err)
+	sum, err := NewAggregator(AggregateSum, AggregatorParams{Type: sqltypes.Int64})
+	require.NoError(t, err)
+	sumDecimal, err := NewAggregator(AggregateSum, AggregatorParams{Type: sqltypes.Decimal})
+	require.NoError(t, err)
+	minimum, err := NewAggregator(AggregateMin, AggregatorParams{Type: sqltypes.Int64})
+	require.NoError(t, err)
+	maximum, err := NewAggregator(AggregateMax, AggregatorParams{Type: sqltypes.Int64})
+	require.NoError(t, err)
+
+	require.Error(t, count.Merge(sum))
+	require.Error(t, sum.Merge(sumDecimal))
+	require.Error(t, minimum.Merge(maximum))
+}
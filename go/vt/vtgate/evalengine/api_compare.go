@@ -27,6 +27,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 )
 
@@ -172,7 +173,15 @@ type (
 		// WeightStringCol is the weight_string column that will be used for sorting.
 		// It is set to -1 if such a column is not added to the query
 		WeightStringCol int
-		Desc            bool
+		// Desc reverses the ordering. As in MySQL, NULL values come first in
+		// ascending order and last in descending order.
+		Desc bool
+
+		// Expr, when set, is the expression to order by instead of the column
+		// Col. It is evaluated against the rows, which must be extended with
+		// Comparison.ExtendRow before they are sorted, and its Type is the type
+		// of its values.
+		Expr Expr
 
 		// Type for knowing if the collation is relevant
 		Type Type
@@ -191,7 +200,9 @@ type (
 // String returns a string. Used for plan descriptions
 func (obp *OrderByParams) String() string {
 	val := strconv.Itoa(obp.Col)
-	if obp.WeightStringCol != -1 && obp.WeightStringCol != obp.Col {
+	if obp.Expr != nil {
+		val = sqlparser.String(obp.Expr)
+	} else if obp.WeightStringCol != -1 && obp.WeightStringCol != obp.Col {
 		val = fmt.Sprintf("(%s|%d)", val, obp.WeightStringCol)
 	}
 	if obp.Desc {
@@ -238,6 +249,10 @@ func (obp *OrderByParams) Compare(r1, r2 []sqltypes.Value) int {
 func (cmp Comparison) tinyWeighters(fields []*querypb.Field) []tinyWeighter {
 	weights := make([]tinyWeighter, 0, len(cmp))
 	for _, c := range cmp {
+		if c.Col >= len(fields) {
+			// the value of an expression, which has no field
+			continue
+		}
 		if apply := TinyWeighter(fields[c.Col], c.Type.Collation()); apply != nil {
 			weights = append(weights, tinyWeighter{c.Col, apply})
 		}
@@ -258,6 +273,48 @@ func (cmp Comparison) ApplyTinyWeights(out *sqltypes.Result) {
 	}
 }
 
+// Extend returns the comparison to use for rows of the given width once they
+// have been extended with ExtendRow, and true, or the comparison itself and
+// false if it doesn't order by any expression.
+func (cmp Comparison) Extend(width int) (Comparison, bool) {
+	var extended Comparison
+	for i, c := range cmp {
+		if c.Expr == nil {
+			continue
+		}
+		if extended == nil {
+			extended = slices.Clone(cmp)
+		}
+		extended[i].Col = width
+		extended[i].WeightStringCol = -1
+		width++
+	}
+	if extended == nil {
+		return cmp, false
+	}
+	return extended, true
+}
+
+// ExtendRow returns a copy of the row with the values of the expressions of
+// the comparison, evaluated against the row, appended to it. This way the
+// expressions are evaluated once per row rather than once per comparison.
+func (cmp Comparison) ExtendRow(env *ExpressionEnv, row sqltypes.Row) (sqltypes.Row, error) {
+	extended := make(sqltypes.Row, len(row), len(row)+len(cmp))
+	copy(extended, row)
+	env.Row = row
+	for _, c := range cmp {
+		if c.Expr == nil {
+			continue
+		}
+		res, err := env.Evaluate(c.Expr)
+		if err != nil {
+			return nil, err
+		}
+		extended = append(extended, res.Value(c.Type.Collation()))
+	}
+	return extended, nil
+}
+
 func (cmp Comparison) Compare(a, b sqltypes.Row) int {
 	for _, c := range cmp {
 		if cmp := c.Compare(a, b); cmp != 0 {
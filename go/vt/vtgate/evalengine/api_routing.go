@@ -0,0 +1,231 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// RoutingColumn analyzes an expression that is compared for equality with a
+// value, and returns the column that must itself be equal to the value for the
+// comparison to be true, if there's one. This lets the planner route the
+// comparisons of vindex columns that are wrapped in functions that don't change
+// their value, e.g. `nullif(id, 0) = :id`, to the shards of the value, like it
+// does for `id = :id`.
+//
+// The column can be wrapped in:
+//
+//   - a CAST or a CONVERT that is the identity for the type of the column, e.g.
+//     `cast(id as signed)` for a BIGINT column.
+//   - a NULLIF, an IF, an IFNULL with a NULL fallback or a CASE whose results
+//     are either NULL or the column, since NULL is never equal to any value.
+//
+// The columns of the expression must have been translated with their types for
+// the casts to be analyzed.
+func RoutingColumn(expr Expr) (*Column, bool) {
+	return routingColumn(expr.IR())
+}
+
+func routingColumn(ir IR) (*Column, bool) {
+	switch ir := ir.(type) {
+	case *Column:
+		return ir, true
+	case *ConvertExpr:
+		col, ok := routingColumn(ir.Inner)
+		if !ok || !isIdentityConversion(ir, col) {
+			return nil, false
+		}
+		return col, true
+	case *CaseExpr:
+		var col *Column
+		results := make([]IR, 0, len(ir.cases)+1)
+		for _, wt := range ir.cases {
+			results = append(results, wt.then)
+		}
+		results = append(results, ir.Else)
+		for _, result := range results {
+			if isNullLiteral(result) {
+				continue
+			}
+			c, ok := routingColumn(result)
+			if !ok || (col != nil && c.Offset != col.Offset) {
+				return nil, false
+			}
+			col = c
+		}
+		return col, col != nil
+	default:
+		return nil, false
+	}
+}
+
+// isIdentityConversion returns whether the conversion returns the values of the
+// column unchanged.
+func isIdentityConversion(conv *ConvertExpr, col *Column) bool {
+	switch conv.Type {
+	case "SIGNED", "SIGNED INTEGER":
+		return sqltypes.IsSigned(col.Type)
+	case "UNSIGNED", "UNSIGNED INTEGER":
+		return sqltypes.IsUnsigned(col.Type)
+	case "CHAR":
+		return conv.Length == nil && sqltypes.IsText(col.Type) && conv.Collation == col.Collation.Collation
+	case "BINARY":
+		return conv.Length == nil && sqltypes.IsBinary(col.Type)
+	default:
+		return false
+	}
+}
+
+func isNullLiteral(ir IR) bool {
+	if ir == nil {
+		return true
+	}
+	lit, ok := ir.(*Literal)
+	return ok && lit.inner == nil
+}
+
+// RoutingValue analyzes an expression that a column is compared with for
+// equality, and returns the value that the column must be equal to when the
+// expression uses the column itself as its fallback, like the optional filters
+// of parameterized queries do, e.g. `id = coalesce(:id, id)`. The references to
+// the column are replaced with NULL in the returned value: the comparison can
+// be true in any row when the value is NULL, and only in the rows where the
+// column is equal to the value otherwise. The planner routes the comparison to
+// the shards of the value, or to all the shards when it's NULL.
+//
+// The column can only be used:
+//
+//   - as the last argument of a COALESCE or an IFNULL.
+//   - as a result of an IF or a CASE whose conditions don't use it.
+//   - as the first argument of a NULLIF, or in a CAST or a CONVERT, which all
+//     return NULL for NULL.
+func RoutingValue(expr sqlparser.Expr, isColumn func(*sqlparser.ColName) bool) (sqlparser.Expr, bool) {
+	if col, ok := expr.(*sqlparser.ColName); ok && isColumn(col) {
+		// The comparison with the column itself doesn't need routing.
+		return nil, false
+	}
+	rv := routingValue{isColumn: isColumn}
+	value, ok := rv.rewrite(expr)
+	if !ok || !rv.found {
+		return nil, false
+	}
+	return value, true
+}
+
+type routingValue struct {
+	isColumn func(*sqlparser.ColName) bool
+	found    bool
+}
+
+// rewrite returns the expression with the column replaced with NULL, or false
+// if the column is used where a NULL doesn't make the expression NULL.
+func (rv *routingValue) rewrite(expr sqlparser.Expr) (sqlparser.Expr, bool) {
+	if !rv.usesColumn(expr) {
+		return expr, true
+	}
+	switch expr := expr.(type) {
+	case *sqlparser.ColName:
+		rv.found = true
+		return &sqlparser.NullVal{}, true
+	case *sqlparser.CastExpr:
+		if expr.Array {
+			return nil, false
+		}
+		inner, ok := rv.rewrite(expr.Expr)
+		if !ok {
+			return nil, false
+		}
+		return &sqlparser.CastExpr{Expr: inner, Type: expr.Type}, true
+	case *sqlparser.ConvertExpr:
+		inner, ok := rv.rewrite(expr.Expr)
+		if !ok {
+			return nil, false
+		}
+		return &sqlparser.ConvertExpr{Expr: inner, Type: expr.Type}, true
+	case *sqlparser.CaseExpr:
+		if rv.usesColumn(expr.Expr) {
+			return nil, false
+		}
+		rewritten := &sqlparser.CaseExpr{Expr: expr.Expr}
+		for _, when := range expr.Whens {
+			if rv.usesColumn(when.Cond) {
+				return nil, false
+			}
+			val, ok := rv.rewrite(when.Val)
+			if !ok {
+				return nil, false
+			}
+			rewritten.Whens = append(rewritten.Whens, &sqlparser.When{Cond: when.Cond, Val: val})
+		}
+		if expr.Else != nil {
+			val, ok := rv.rewrite(expr.Else)
+			if !ok {
+				return nil, false
+			}
+			rewritten.Else = val
+		}
+		return rewritten, true
+	case *sqlparser.FuncExpr:
+		if !expr.Qualifier.IsEmpty() {
+			return nil, false
+		}
+		// The positions of the arguments that can use the column.
+		var positions []int
+		switch method := expr.Name.Lowered(); {
+		case method == "coalesce" && len(expr.Exprs) > 0, method == "ifnull" && len(expr.Exprs) == 2:
+			positions = []int{len(expr.Exprs) - 1}
+		case method == "if" && len(expr.Exprs) == 3:
+			positions = []int{1, 2}
+		case method == "nullif" && len(expr.Exprs) == 2:
+			positions = []int{0}
+		default:
+			return nil, false
+		}
+		args := make(sqlparser.Exprs, len(expr.Exprs))
+		copy(args, expr.Exprs)
+		for _, pos := range positions {
+			arg, ok := rv.rewrite(args[pos])
+			if !ok {
+				return nil, false
+			}
+			args[pos] = arg
+		}
+		for _, arg := range args {
+			if rv.usesColumn(arg) {
+				return nil, false
+			}
+		}
+		return &sqlparser.FuncExpr{Name: expr.Name, Exprs: args}, true
+	default:
+		return nil, false
+	}
+}
+
+func (rv *routingValue) usesColumn(expr sqlparser.Expr) bool {
+	if expr == nil {
+		return false
+	}
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok && rv.isColumn(col) {
+			found = true
+		}
+		return !found, nil
+	}, expr)
+	return found
+}
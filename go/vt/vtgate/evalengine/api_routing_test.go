@@ -0,0 +1,132 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtenv"
+)
+
+func TestRoutingColumn(t *testing.T) {
+	fields := FieldResolver{
+		{Name: "id", Type: sqltypes.Int64, Charset: uint32(collations.CollationBinaryID)},
+		{Name: "uid", Type: sqltypes.Uint64, Charset: uint32(collations.CollationBinaryID)},
+		{Name: "name", Type: sqltypes.VarChar, Charset: uint32(collations.CollationUtf8mb4ID)},
+		{Name: "col", Type: sqltypes.Int64, Charset: uint32(collations.CollationBinaryID)},
+	}
+	testCases := []struct {
+		expr   string
+		column string
+	}{
+		{"id", "id"},
+		{"cast(id as signed)", "id"},
+		{"cast(uid as unsigned)", "uid"},
+		{"convert(name, char)", "name"},
+		{"nullif(id, 0)", "id"},
+		{"nullif(cast(id as signed), :v)", "id"},
+		{"if(col = 1, id, null)", "id"},
+		{"case when col = 1 then id when col = 2 then null end", "id"},
+		{"ifnull(id, null)", "id"},
+		{"cast(id as unsigned)", ""},
+		{"cast(uid as signed)", ""},
+		{"cast(name as char(2))", ""},
+		{"cast(name as char character set latin1)", ""},
+		{"coalesce(id, 0)", ""},
+		{"ifnull(id, 1)", ""},
+		{"if(col = 1, id, col)", ""},
+		{"case when col = 1 then null end", ""},
+		{"id + 0", ""},
+		{"1", ""},
+	}
+	venv := vtenv.NewTestEnv()
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			stmt, err := venv.Parser().ParseExpr(tc.expr)
+			require.NoError(t, err)
+			expr, err := Translate(stmt, &Config{
+				ResolveColumn: fields.Column,
+				ResolveType:   fields.Type,
+				Collation:     collations.CollationUtf8mb4ID,
+				Environment:   venv,
+				NoCompilation: true,
+			})
+			require.NoError(t, err)
+
+			col, ok := RoutingColumn(expr)
+			if tc.column == "" {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, tc.column, fields[col.Offset].Name)
+		})
+	}
+}
+
+func TestRoutingValue(t *testing.T) {
+	testCases := []struct {
+		expr  string
+		value string
+	}{
+		{"coalesce(:v, id)", "coalesce(:v, null)"},
+		{"coalesce(:a, :b, id)", "coalesce(:a, :b, null)"},
+		{"ifnull(:v, id)", "ifnull(:v, null)"},
+		{"coalesce(:a, ifnull(:b, id))", "coalesce(:a, ifnull(:b, null))"},
+		{"cast(coalesce(:v, id) as unsigned)", "cast(coalesce(:v, null) as unsigned)"},
+		{"coalesce(cast(:v as unsigned), t.id)", "coalesce(cast(:v as unsigned), null)"},
+		{"if(:v is null, id, :v)", "if(:v is null, null, :v)"},
+		{"case when :v is null then id else :v end", "case when :v is null then null else :v end"},
+		{"case :k when 1 then :v else id end", "case :k when 1 then :v else null end"},
+		{"nullif(coalesce(:v, id), 0)", "nullif(coalesce(:v, null), 0)"},
+		{"id", ""},
+		{"coalesce(:v, 1)", ""},
+		{"coalesce(id, :v)", ""},
+		{"coalesce(:v, id, 1)", ""},
+		{"ifnull(id, :v)", ""},
+		{"if(id > 0, :v, id)", ""},
+		{"case id when 1 then :v else id end", ""},
+		{"nullif(:v, id)", ""},
+		{"coalesce(:v, id + 1)", ""},
+		{"coalesce(:v, id) + 1", ""},
+		{"coalesce(:v, other)", ""},
+	}
+	venv := vtenv.NewTestEnv()
+	isColumn := func(col *sqlparser.ColName) bool {
+		return col.Name.EqualString("id")
+	}
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			stmt, err := venv.Parser().ParseExpr(tc.expr)
+			require.NoError(t, err)
+
+			value, ok := RoutingValue(stmt, isColumn)
+			if tc.value == "" {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, tc.value, sqlparser.String(value))
+		})
+	}
+}
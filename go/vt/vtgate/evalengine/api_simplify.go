@@ -0,0 +1,225 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// SimplifyExpr returns a copy of the given expression where the constant
+// subexpressions have been folded into literals, the same way Translate folds
+// them, so that the planner sees their values: e.g. `id = 1 + 2` is `id = 3`.
+// Only the values that can be written back as a literal of the same type are
+// folded: integers, decimals, booleans, NULL, and strings in the collation of
+// the literals of cfg, so that `concat('a', 'b')` compares the same way as 'ab'.
+// Expressions that raise a warning when they are evaluated are left alone.
+//
+// The logical operators with a constant operand that decides their result are
+// simplified too when the other operand is a column or a literal: `x AND FALSE`
+// is FALSE and `x OR TRUE` is TRUE. The original expression is not modified.
+func SimplifyExpr(e sqlparser.Expr, cfg *Config) sqlparser.Expr {
+	return newASTSimplifier(cfg).simplify(e, false)
+}
+
+// SimplifyPredicate is like SimplifyExpr for an expression that is only used
+// for its truth value, e.g. a WHERE clause. Since `x AND TRUE` and `x OR FALSE`
+// have the same truth value as x, they are simplified to x, so that tautologies
+// like `1 = 1` disappear from the predicate.
+func SimplifyPredicate(e sqlparser.Expr, cfg *Config) sqlparser.Expr {
+	return newASTSimplifier(cfg).simplify(e, true)
+}
+
+type astSimplifier struct {
+	cfg Config
+}
+
+func newASTSimplifier(cfg *Config) *astSimplifier {
+	s := &astSimplifier{cfg: *cfg}
+	// The columns are never constant, so there is no need to resolve them, and
+	// the folded expressions are never evaluated again
+	s.cfg.ResolveColumn = nil
+	s.cfg.ResolveType = nil
+	s.cfg.NoConstantFolding = false
+	s.cfg.NoCompilation = true
+	return s
+}
+
+func (s *astSimplifier) simplify(e sqlparser.Expr, predicate bool) sqlparser.Expr {
+	pre := func(node, _ sqlparser.SQLNode) bool {
+		// The subqueries are planned on their own
+		_, isSubquery := node.(*sqlparser.Subquery)
+		return !isSubquery
+	}
+	post := func(cursor *sqlparser.CopyOnWriteCursor) {
+		expr, ok := cursor.Node().(sqlparser.Expr)
+		if !ok {
+			return
+		}
+
+		// The operands of the logical operators are only used for their truth value
+		boolean := predicate && cursor.Parent() == nil
+		switch cursor.Parent().(type) {
+		case *sqlparser.AndExpr, *sqlparser.OrExpr, *sqlparser.XorExpr, *sqlparser.NotExpr:
+			boolean = true
+		}
+
+		switch node := expr.(type) {
+		case *sqlparser.AndExpr:
+			if simplified := s.logical(node.Left, node.Right, boolFalse, boolean); simplified != nil {
+				cursor.Replace(simplified)
+				return
+			}
+		case *sqlparser.OrExpr:
+			if simplified := s.logical(node.Left, node.Right, boolTrue, boolean); simplified != nil {
+				cursor.Replace(simplified)
+				return
+			}
+		}
+		if folded := s.fold(expr); folded != nil {
+			cursor.Replace(folded)
+		}
+	}
+	return sqlparser.CopyOnRewrite(e, pre, post, nil).(sqlparser.Expr)
+}
+
+// logical simplifies a logical operator whose result is absorbing when one of
+// its operands has that truth value. MySQL still evaluates the other operand,
+// so it's only dropped when its evaluation can't fail. The other truth value is
+// the identity of the operator, which can only be removed when the result is
+// used for its truth value. It returns nil if the operator can't be simplified.
+func (s *astSimplifier) logical(left, right sqlparser.Expr, absorbing boolean, boolean bool) sqlparser.Expr {
+	lt, lconst := s.truthValue(left)
+	rt, rconst := s.truthValue(right)
+	switch {
+	case lconst && lt == absorbing && cannotFail(right), rconst && rt == absorbing && cannotFail(left):
+		return sqlparser.BoolVal(absorbing == boolTrue)
+	case boolean && lconst && lt == absorbing.not():
+		return right
+	case boolean && rconst && rt == absorbing.not():
+		return left
+	default:
+		return nil
+	}
+}
+
+// truthValue returns the truth value of a literal.
+func (s *astSimplifier) truthValue(e sqlparser.Expr) (boolean, bool) {
+	if !isASTLiteral(e) {
+		return boolNULL, false
+	}
+	translated, err := Translate(e, &s.cfg)
+	if err != nil {
+		return boolNULL, false
+	}
+	lit, ok := translated.(*Literal)
+	if !ok {
+		return boolNULL, false
+	}
+	return evalIsTruthy(lit.inner), true
+}
+
+// fold returns the literal with the value of a constant expression, or nil if
+// the expression is not constant or its value has no equivalent literal.
+func (s *astSimplifier) fold(e sqlparser.Expr) sqlparser.Expr {
+	if isASTLiteral(e) {
+		return nil
+	}
+	translated, err := Translate(e, &s.cfg)
+	if err != nil {
+		return nil
+	}
+	lit, ok := translated.(*Literal)
+	if !ok {
+		return nil
+	}
+	return s.astLiteral(lit)
+}
+
+func (s *astSimplifier) astLiteral(lit *Literal) sqlparser.Expr {
+	switch v := lit.inner.(type) {
+	case nil:
+		return &sqlparser.NullVal{}
+	case *evalInt64:
+		switch {
+		case v == evalBoolTrue || v == evalBoolFalse:
+			return sqlparser.BoolVal(v == evalBoolTrue)
+		case v.bitLiteral || v.i == math.MinInt64:
+			return nil
+		case v.i < 0:
+			return negative(sqlparser.NewIntLiteral(strconv.FormatInt(-v.i, 10)))
+		default:
+			return sqlparser.NewIntLiteral(strconv.FormatInt(v.i, 10))
+		}
+	case *evalUint64:
+		// The smaller values would be read back as signed integers
+		if v.hexLiteral || v.u <= math.MaxInt64 {
+			return nil
+		}
+		return sqlparser.NewIntLiteral(strconv.FormatUint(v.u, 10))
+	case *evalDecimal:
+		// A decimal without a fractional part would be read back as an integer
+		raw := v.ToRawBytes()
+		if bytes.IndexByte(raw, '.') < 0 {
+			return nil
+		}
+		if raw[0] == '-' {
+			return negative(sqlparser.NewDecimalLiteral(string(raw[1:])))
+		}
+		return sqlparser.NewDecimalLiteral(string(raw))
+	case *evalBytes:
+		// A string literal always has the collation of the literals, and it's
+		// sent to MySQL in the utf8mb4 encoding of the query
+		if !v.isVarChar() || v.col.Collation != s.cfg.Collation || v.col.Coercibility != collations.CoerceCoercible {
+			return nil
+		}
+		if s.cfg.Environment == nil || s.cfg.Environment.CollationEnv().LookupCharsetName(v.col.Collation) != "utf8mb4" {
+			return nil
+		}
+		return sqlparser.NewStrLiteral(string(v.bytes))
+	default:
+		return nil
+	}
+}
+
+func negative(lit *sqlparser.Literal) sqlparser.Expr {
+	return &sqlparser.UnaryExpr{Operator: sqlparser.UMinusOp, Expr: lit}
+}
+
+// cannotFail returns whether evaluating an expression can never raise an error.
+func cannotFail(e sqlparser.Expr) bool {
+	_, isCol := e.(*sqlparser.ColName)
+	return isCol || isASTLiteral(e)
+}
+
+// isASTLiteral returns whether an expression is already a literal, as folded
+// by an astSimplifier.
+func isASTLiteral(e sqlparser.Expr) bool {
+	switch e := e.(type) {
+	case *sqlparser.Literal, sqlparser.BoolVal, *sqlparser.NullVal:
+		return true
+	case *sqlparser.UnaryExpr:
+		_, isLit := e.Expr.(*sqlparser.Literal)
+		return isLit && e.Operator == sqlparser.UMinusOp
+	default:
+		return false
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtenv"
+)
+
+func TestSimplifyExpr(t *testing.T) {
+	testCases := []struct {
+		expr, simplified, predicate string
+	}{
+		{"id = 1 + 2", "id = 3", "id = 3"},
+		{"id = -1 - 2", "id = -3", "id = -3"},
+		{"id = 1.5 + 2.25", "id = 3.75", "id = 3.75"},
+		{"id = 0.5 * 2", "id = 1.0", "id = 1.0"},
+		{"id = 1.5 * 2 - 4", "id = -1.0", "id = -1.0"},
+		{"id in (1 + 1, 2 + 2)", "id in (2, 4)", "id in (2, 4)"},
+		{"id = 18446744073709551615 - 0", "id = 18446744073709551615", "id = 18446744073709551615"},
+		{"name = concat('a', 'b')", "name = 'ab'", "name = 'ab'"},
+		{"name = lower('ABC')", "name = 'abc'", "name = 'abc'"},
+		{"name = 'a' collate utf8mb4_bin", "`name` = 'a' collate utf8mb4_bin", "`name` = 'a' collate utf8mb4_bin"},
+		{"name = concat('a', 'b' collate utf8mb4_bin)", "`name` = concat('a', 'b' collate utf8mb4_bin)", "`name` = concat('a', 'b' collate utf8mb4_bin)"},
+		{"name = _latin1 'a'", "`name` = _latin1 'a'", "`name` = _latin1 'a'"},
+		{"id = 1.0e0 + 1", "id = 1.0e0 + 1", "id = 1.0e0 + 1"},
+		{"id = cast(1 as unsigned)", "id = cast(1 as unsigned)", "id = cast(1 as unsigned)"},
+		{"'a' = 'A'", "true", "true"},
+		{"id = 1 + null", "id = null", "id = null"},
+		{"id = 1 / 0", "id = 1 / 0", "id = 1 / 0"},
+		{"id = :v + 1", "id = :v + 1", "id = :v + 1"},
+		{"id = now()", "id = now()", "id = now()"},
+		{"id in (select 1 + 1 from dual)", "id in (select 1 + 1 from dual)", "id in (select 1 + 1 from dual)"},
+		{"id = 1 and 1 = 1", "id = 1 and true", "id = 1"},
+		{"1 = 1 and id = 1", "true and id = 1", "id = 1"},
+		{"id = 1 or 1 = 0", "id = 1 or false", "id = 1"},
+		{"id and 1 = 0", "false", "false"},
+		{"id or 1 = 1", "true", "true"},
+		{"id = 1 and 1 = 0", "id = 1 and false", "id = 1 and false"},
+		{"id = 1 or 1 = 1", "id = 1 or true", "id = 1 or true"},
+		{"(select id from t) and 1 = 0", "(select id from t) and false", "(select id from t) and false"},
+		{"1 = 1 or (select id from t)", "true or (select id from t)", "true or (select id from t)"},
+		{"id = 1 and null", "id = 1 and null", "id = 1 and null"},
+		{"not (id = 1 and 1 = 1)", "not id = 1", "not id = 1"},
+		{"(id = 1 or 1 = 0) and (id = 2 or 1 = 0)", "id = 1 and id = 2", "id = 1 and id = 2"},
+		{"(id and true) + 1", "(id and true) + 1", "(id and true) + 1"},
+		{"(id or false) xor 1", "id xor 1", "id xor 1"},
+	}
+	venv := vtenv.NewTestEnv()
+	cfg := &Config{
+		Collation:   collations.CollationUtf8mb4ID,
+		Environment: venv,
+	}
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			expr, err := venv.Parser().ParseExpr(tc.expr)
+			require.NoError(t, err)
+			original := sqlparser.String(expr)
+
+			simplified, err := venv.Parser().ParseExpr(tc.simplified)
+			require.NoError(t, err)
+			assert.Equal(t, sqlparser.String(simplified), sqlparser.String(SimplifyExpr(expr, cfg)))
+
+			predicate, err := venv.Parser().ParseExpr(tc.predicate)
+			require.NoError(t, err)
+			assert.Equal(t, sqlparser.String(predicate), sqlparser.String(SimplifyPredicate(expr, cfg)))
+
+			assert.Equal(t, original, sqlparser.String(expr))
+		})
+	}
+}
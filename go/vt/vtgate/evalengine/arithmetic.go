@@ -46,7 +46,11 @@ func addNumericWithError(left, right eval) (eval, error) {
 			return mathAdd_uu(v1.u, v2.u)
 		}
 	case *evalDecimal:
-		return mathAdd_dx(v1, v2), nil
+		// the operands are reported in their original order when out of range
+		if v2.SQLType() != sqltypes.Decimal && evalToNumeric(left, true).SQLType() != sqltypes.Decimal {
+			return mathAdd_xd(v2, v1)
+		}
+		return mathAdd_dx(v1, v2)
 	case *evalFloat:
 		return mathAdd_fx(v1.f, v2)
 	}
@@ -66,7 +70,7 @@ func subtractNumericWithError(left, right eval) (eval, error) {
 		case *evalFloat:
 			return mathSub_xf(v1, v2.f)
 		case *evalDecimal:
-			return mathSub_xd(v1, v2), nil
+			return mathSub_xd(v1, v2)
 		}
 	case *evalUint64:
 		switch v2 := v2.(type) {
@@ -77,7 +81,7 @@ func subtractNumericWithError(left, right eval) (eval, error) {
 		case *evalFloat:
 			return mathSub_xf(v1, v2.f)
 		case *evalDecimal:
-			return mathSub_xd(v1, v2), nil
+			return mathSub_xd(v1, v2)
 		}
 	case *evalFloat:
 		return mathSub_fx(v1.f, v2)
@@ -86,7 +90,7 @@ func subtractNumericWithError(left, right eval) (eval, error) {
 		case *evalFloat:
 			return mathSub_xf(v1, v2.f)
 		default:
-			return mathSub_dx(v1, v2), nil
+			return mathSub_dx(v1, v2)
 		}
 	}
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "invalid arithmetic between: %s %s", evalToSQLValue(v1), evalToSQLValue(v2))
@@ -107,7 +111,10 @@ func multiplyNumericWithError(left, right eval) (eval, error) {
 	case *evalFloat:
 		return mathMul_fx(v1.f, v2)
 	case *evalDecimal:
-		return mathMul_dx(v1, v2), nil
+		if v2.SQLType() != sqltypes.Decimal && evalToNumeric(left, true).SQLType() != sqltypes.Decimal {
+			return mathMul_xd(v2, v1)
+		}
+		return mathMul_dx(v1, v2)
 	}
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "invalid arithmetic between: %s %s", evalToSQLValue(v1), evalToSQLValue(v2))
 }
@@ -318,6 +325,18 @@ func mathAdd_uu0(v1, v2 uint64) (uint64, error) {
 
 var errDecimalOutOfRange = vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "DECIMAL value is out of range")
 
+// coerceDecimalResult limits the result of a decimal operation between v1 and
+// v2 to the precision and scale of MySQL's decimals: the operation is out of
+// range if its integral part has more than MyMaxPrecision digits, and its scale
+// is at most MyMaxScale. Like in MySQL, the fractional digits beyond the scale
+// are kept for the next operations, and only rounded when formatted.
+func coerceDecimalResult(dec decimal.Decimal, length int32, v1, v2 *evalDecimal, sign string) (decimal.Decimal, int32, error) {
+	if dec.IntegralDigits() > decimal.MyMaxPrecision {
+		return decimal.Decimal{}, 0, dataOutOfRangeErrorDecimal(v1.dec, v2.dec, "DECIMAL", sign)
+	}
+	return dec, min(length, decimal.MyMaxScale), nil
+}
+
 func mathAdd_fx(v1 float64, v2 evalNumeric) (*evalFloat, error) {
 	v2f, ok := v2.toFloat()
 	if !ok {
@@ -330,17 +349,22 @@ func mathAdd_ff(v1, v2 float64) *evalFloat {
 	return newEvalFloat(v1 + v2)
 }
 
-func mathAdd_dx(v1 *evalDecimal, v2 evalNumeric) *evalDecimal {
+func mathAdd_dx(v1 *evalDecimal, v2 evalNumeric) (*evalDecimal, error) {
 	return mathAdd_dd(v1, v2.toDecimal(0, 0))
 }
 
-func mathAdd_dd(v1, v2 *evalDecimal) *evalDecimal {
-	return newEvalDecimalWithPrec(v1.dec.Add(v2.dec), max(v1.length, v2.length))
+func mathAdd_xd(v1 evalNumeric, v2 *evalDecimal) (*evalDecimal, error) {
+	return mathAdd_dd(v1.toDecimal(0, 0), v2)
 }
 
-func mathAdd_dd0(v1, v2 *evalDecimal) {
-	v1.dec = v1.dec.Add(v2.dec)
-	v1.length = max(v1.length, v2.length)
+func mathAdd_dd(v1, v2 *evalDecimal) (*evalDecimal, error) {
+	dec, length, err := coerceDecimalResult(v1.dec.Add(v2.dec), max(v1.length, v2.length), v1, v2, "+")
+	return newEvalDecimalWithPrec(dec, length), err
+}
+
+func mathAdd_dd0(v1, v2 *evalDecimal) (err error) {
+	v1.dec, v1.length, err = coerceDecimalResult(v1.dec.Add(v2.dec), max(v1.length, v2.length), v1, v2, "+")
+	return err
 }
 
 func mathSub_ii(v1, v2 int64) (*evalInt64, error) {
@@ -417,21 +441,22 @@ func mathSub_ff(v1, v2 float64) *evalFloat {
 	return newEvalFloat(v1 - v2)
 }
 
-func mathSub_dx(v1 *evalDecimal, v2 evalNumeric) *evalDecimal {
+func mathSub_dx(v1 *evalDecimal, v2 evalNumeric) (*evalDecimal, error) {
 	return mathSub_dd(v1, v2.toDecimal(0, 0))
 }
 
-func mathSub_xd(v1 evalNumeric, v2 *evalDecimal) *evalDecimal {
+func mathSub_xd(v1 evalNumeric, v2 *evalDecimal) (*evalDecimal, error) {
 	return mathSub_dd(v1.toDecimal(0, 0), v2)
 }
 
-func mathSub_dd(v1, v2 *evalDecimal) *evalDecimal {
-	return newEvalDecimalWithPrec(v1.dec.Sub(v2.dec), max(v1.length, v2.length))
+func mathSub_dd(v1, v2 *evalDecimal) (*evalDecimal, error) {
+	dec, length, err := coerceDecimalResult(v1.dec.Sub(v2.dec), max(v1.length, v2.length), v1, v2, "-")
+	return newEvalDecimalWithPrec(dec, length), err
 }
 
-func mathSub_dd0(v1, v2 *evalDecimal) {
-	v1.dec = v1.dec.Sub(v2.dec)
-	v1.length = max(v1.length, v2.length)
+func mathSub_dd0(v1, v2 *evalDecimal) (err error) {
+	v1.dec, v1.length, err = coerceDecimalResult(v1.dec.Sub(v2.dec), max(v1.length, v2.length), v1, v2, "-")
+	return err
 }
 
 func mathMul_ii(v1, v2 int64) (*evalInt64, error) {
@@ -490,17 +515,22 @@ func mathMul_ff(v1, v2 float64) *evalFloat {
 	return newEvalFloat(v1 * v2)
 }
 
-func mathMul_dx(v1 *evalDecimal, v2 evalNumeric) *evalDecimal {
+func mathMul_dx(v1 *evalDecimal, v2 evalNumeric) (*evalDecimal, error) {
 	return mathMul_dd(v1, v2.toDecimal(0, 0))
 }
 
-func mathMul_dd(v1, v2 *evalDecimal) *evalDecimal {
-	return newEvalDecimalWithPrec(v1.dec.Mul(v2.dec), v1.length+v2.length)
+func mathMul_xd(v1 evalNumeric, v2 *evalDecimal) (*evalDecimal, error) {
+	return mathMul_dd(v1.toDecimal(0, 0), v2)
 }
 
-func mathMul_dd0(v1, v2 *evalDecimal) {
-	v1.dec = v1.dec.Mul(v2.dec)
-	v1.length = v1.length + v2.length
+func mathMul_dd(v1, v2 *evalDecimal) (*evalDecimal, error) {
+	dec, length, err := coerceDecimalResult(v1.dec.Mul(v2.dec), v1.length+v2.length, v1, v2, "*")
+	return newEvalDecimalWithPrec(dec, length), err
+}
+
+func mathMul_dd0(v1, v2 *evalDecimal) (err error) {
+	v1.dec, v1.length, err = coerceDecimalResult(v1.dec.Mul(v2.dec), v1.length+v2.length, v1, v2, "*")
+	return err
 }
 
 const divPrecisionIncrement = 4
@@ -513,12 +543,16 @@ func mathDiv_dd(v1, v2 *evalDecimal, incrPrecision int32) (eval, error) {
 	if v2.dec.IsZero() {
 		return nil, nil
 	}
-	return newEvalDecimalWithPrec(v1.dec.Div(v2.dec, incrPrecision), v1.length+incrPrecision), nil
+	dec, length, err := coerceDecimalResult(v1.dec.Div(v2.dec, incrPrecision), v1.length+incrPrecision, v1, v2, "/")
+	if err != nil {
+		return nil, err
+	}
+	return newEvalDecimalWithPrec(dec, length), nil
 }
 
-func mathDiv_dd0(v1, v2 *evalDecimal, incrPrecision int32) {
-	v1.dec = v1.dec.Div(v2.dec, incrPrecision)
-	v1.length = v1.length + incrPrecision
+func mathDiv_dd0(v1, v2 *evalDecimal, incrPrecision int32) (err error) {
+	v1.dec, v1.length, err = coerceDecimalResult(v1.dec.Div(v2.dec, incrPrecision), v1.length+incrPrecision, v1, v2, "/")
+	return err
 }
 
 func mathDiv_fx(v1 float64, v2 evalNumeric) (eval, error) {
@@ -102,10 +102,14 @@ func NewTypeEx(t sqltypes.Type, collation collations.ID, nullable bool, size, sc
 	}
 }
 
+// NewTypeFromField returns the type of the values of a field. The collation of
+// the fields without a charset is the one of their type, as returned by
+// collations.CollationForField, e.g. binary for the numeric fields, instead of
+// the Unknown collation.
 func NewTypeFromField(f *querypb.Field) Type {
 	return Type{
 		typ:       f.Type,
-		collation: collations.ID(f.Charset),
+		collation: collations.CollationForField(f),
 		nullable:  f.Flags&uint32(querypb.MySqlFlag_NOT_NULL_FLAG) == 0,
 		init:      true,
 		size:      int32(f.ColumnLength),
@@ -223,6 +227,21 @@ func (c *compiler) compile(expr IR) (*CompiledExpr, error) {
 	return &CompiledExpr{code: c.asm.ins, ir: expr, stack: c.asm.stack.max, typed: ct}, nil
 }
 
+// compileNumericTruncation raises the warnings for the arguments of an arithmetic
+// operator that are strings which can't be fully converted to a number of type typ.
+func (c *compiler) compileNumericTruncation(lt, rt ctype, typ string) {
+	if isTextNumber(lt) {
+		c.asm.Warn_truncatedNumber(2, typ)
+	}
+	if isTextNumber(rt) {
+		c.asm.Warn_truncatedNumber(1, typ)
+	}
+}
+
+func isTextNumber(ct ctype) bool {
+	return sqltypes.IsTextOrBinary(ct.Type) && ct.Flag&(flagHex|flagBit) == 0
+}
+
 func (c *compiler) compileToNumeric(ct ctype, offset int, fallback sqltypes.Type, preciseDatetime bool) ctype {
 	if sqltypes.IsNumber(ct.Type) {
 		return ct
@@ -237,6 +256,10 @@ func (c *compiler) compileToNumeric(ct ctype, offset int, fallback sqltypes.Type
 			return ctype{Type: sqltypes.Int64, Flag: ct.Flag, Col: collationNumeric}
 		}
 	}
+	if ct.Type == sqltypes.Bit {
+		c.asm.Convert_bitColumn(offset)
+		return ctype{Type: sqltypes.Uint64, Flag: ct.Flag, Col: collationNumeric}
+	}
 
 	if sqltypes.IsDateOrTime(ct.Type) {
 		if preciseDatetime {
@@ -266,6 +289,14 @@ func (c *compiler) compileToNumeric(ct ctype, offset int, fallback sqltypes.Type
 	return ctype{Type: sqltypes.Float64, Flag: ct.Flag, Col: collationNumeric}
 }
 
+// compileTemporalToNumeric is the compiled version of evalTemporalToNumeric.
+func (c *compiler) compileTemporalToNumeric(ct ctype, offset int) ctype {
+	if sqltypes.IsDateOrTime(ct.Type) {
+		return c.compileToNumeric(ct, offset, sqltypes.Float64, true)
+	}
+	return ct
+}
+
 func (c *compiler) compileToInt64(ct ctype, offset int) ctype {
 	switch ct.Type {
 	case sqltypes.Int64:
@@ -300,6 +331,8 @@ func (c *compiler) compileToBitwiseUint64(ct ctype, offset int) ctype {
 		c.asm.Convert_iu(offset)
 	case sqltypes.Decimal:
 		c.asm.Convert_dbit(offset)
+	case sqltypes.Bit:
+		c.asm.Convert_bitColumn(offset)
 	// TODO: specialization
 	default:
 		c.asm.Convert_xu(offset)
@@ -110,7 +110,7 @@ func (asm *assembler) Add_dd() {
 	asm.emit(func(env *ExpressionEnv) int {
 		l := env.vm.stack[env.vm.sp-2].(*evalDecimal)
 		r := env.vm.stack[env.vm.sp-1].(*evalDecimal)
-		mathAdd_dd0(l, r)
+		env.vm.err = mathAdd_dd0(l, r)
 		env.vm.sp--
 		return 1
 	}, "ADD DECIMAL(SP-2), DECIMAL(SP-1)")
@@ -556,6 +556,28 @@ func (asm *assembler) CmpNum_dd() {
 	}, "CMP DECIMAL(SP-2), DECIMAL(SP-1)")
 }
 
+// CmpNum_dd_literal compares two decimals, one of which is a literal that has
+// been prepared for the comparison when compiling the expression.
+func (asm *assembler) CmpNum_dd_literal(literal *decimal.Prepared, left bool) {
+	asm.adjustStack(-2)
+
+	if left {
+		asm.emit(func(env *ExpressionEnv) int {
+			r := env.vm.stack[env.vm.sp-1].(*evalDecimal)
+			env.vm.sp -= 2
+			env.vm.flags.cmp = literal.Cmp(r.dec)
+			return 1
+		}, "CMP DECIMAL(%s), DECIMAL(SP-1)", literal.Decimal().String())
+		return
+	}
+	asm.emit(func(env *ExpressionEnv) int {
+		l := env.vm.stack[env.vm.sp-2].(*evalDecimal)
+		env.vm.sp -= 2
+		env.vm.flags.cmp = -literal.Cmp(l.dec)
+		return 1
+	}, "CMP DECIMAL(SP-2), DECIMAL(%s)", literal.Decimal().String())
+}
+
 func (asm *assembler) CmpNum_fd(left, right int) {
 	asm.adjustStack(-2)
 
@@ -900,6 +922,17 @@ func (asm *assembler) Convert_bit(offset int) {
 	}, "CONV VARBINARY(SP-%d), BIT", offset)
 }
 
+func (asm *assembler) Convert_bitColumn(offset int) {
+	asm.emit(func(env *ExpressionEnv) int {
+		var ok bool
+		env.vm.stack[env.vm.sp-offset], ok = env.vm.stack[env.vm.sp-offset].(*evalBytes).toNumericBitColumn()
+		if !ok {
+			env.vm.err = errDeoptimize
+		}
+		return 1
+	}, "CONV BIT(SP-%d), UINT64", offset)
+}
+
 func (asm *assembler) Convert_Ti(offset int) {
 	asm.emit(func(env *ExpressionEnv) int {
 		v := env.vm.stack[env.vm.sp-offset].(*evalTemporal)
@@ -1182,6 +1215,54 @@ func (asm *assembler) Convert_xT(offset, prec int) {
 	}, "CONV (SP-%d), TIME", offset)
 }
 
+// Cast_xD is Convert_xD for CAST, which raises a warning for the values
+// that can't be converted.
+func (asm *assembler) Cast_xD(offset int, allowZero bool) {
+	asm.emit(func(env *ExpressionEnv) int {
+		arg := env.vm.stack[env.vm.sp-offset]
+		d := evalToDate(arg, env.now, allowZero)
+		if d == nil {
+			env.warnIncorrectTemporal(arg, sqltypes.Date)
+			env.vm.stack[env.vm.sp-offset] = nil
+		} else {
+			env.vm.stack[env.vm.sp-offset] = d
+		}
+		return 1
+	}, "CAST (SP-%d), DATE", offset)
+}
+
+// Cast_xDT is Convert_xDT for CAST, which raises a warning for the values
+// that can't be converted.
+func (asm *assembler) Cast_xDT(offset, prec int, allowZero bool) {
+	asm.emit(func(env *ExpressionEnv) int {
+		arg := env.vm.stack[env.vm.sp-offset]
+		dt := evalToDateTime(arg, prec, env.now, allowZero)
+		if dt == nil {
+			env.warnIncorrectTemporal(arg, sqltypes.Datetime)
+			env.vm.stack[env.vm.sp-offset] = nil
+		} else {
+			env.vm.stack[env.vm.sp-offset] = dt
+		}
+		return 1
+	}, "CAST (SP-%d), DATETIME", offset)
+}
+
+// Cast_xT is Convert_xT for CAST, which raises a warning for the values
+// that can't be converted.
+func (asm *assembler) Cast_xT(offset, prec int) {
+	asm.emit(func(env *ExpressionEnv) int {
+		arg := env.vm.stack[env.vm.sp-offset]
+		t := evalToTime(arg, prec)
+		if t == nil {
+			env.warnIncorrectTemporal(arg, sqltypes.Time)
+			env.vm.stack[env.vm.sp-offset] = nil
+		} else {
+			env.vm.stack[env.vm.sp-offset] = t
+		}
+		return 1
+	}, "CAST (SP-%d), TIME", offset)
+}
+
 func (asm *assembler) Convert_tp(offset, prec int) {
 	asm.emit(func(env *ExpressionEnv) int {
 		arg := env.vm.stack[env.vm.sp-offset].(*evalTemporal)
@@ -1200,7 +1281,7 @@ func (asm *assembler) Div_dd() {
 		if r.dec.IsZero() {
 			env.vm.stack[env.vm.sp-2] = nil
 		} else {
-			mathDiv_dd0(l, r, divPrecisionIncrement)
+			env.vm.err = mathDiv_dd0(l, r, divPrecisionIncrement)
 		}
 		env.vm.sp--
 		return 1
@@ -1942,7 +2023,7 @@ func (asm *assembler) Fn_TRUNCATE_d() {
 		}
 
 		if r.i == 0 {
-			d.dec = d.dec.Truncate(0)
+			d.dec = d.dec.TruncTowardZero(0)
 			d.length = 0
 			env.vm.sp--
 			return 1
@@ -1956,7 +2037,7 @@ func (asm *assembler) Fn_TRUNCATE_d() {
 		if digit > d.length {
 			digit = d.length
 		}
-		rounded := d.dec.Truncate(int32(r.i))
+		rounded := d.dec.TruncTowardZero(int32(r.i))
 		if rounded.IsZero() {
 			d.dec = decimal.Zero
 			d.length = 0
@@ -2075,6 +2156,48 @@ func (asm *assembler) Fn_FROM_BASE64(t sqltypes.Type) {
 	}, "FN FROM_BASE64 VARCHAR(SP-1)")
 }
 
+func (asm *assembler) Fn_COMPRESS(t sqltypes.Type) {
+	asm.emit(func(env *ExpressionEnv) int {
+		str := env.vm.stack[env.vm.sp-1].(*evalBytes)
+		str.tt = int16(t)
+		str.bytes = mysqlCompress(str.bytes)
+		str.col = collationBinary
+		return 1
+	}, "FN COMPRESS VARBINARY(SP-1)")
+}
+
+func (asm *assembler) Fn_UNCOMPRESS() {
+	asm.emit(func(env *ExpressionEnv) int {
+		str := env.vm.stack[env.vm.sp-1].(*evalBytes)
+
+		uncompressed, ok := mysqlUncompress(env, str.bytes)
+		if !ok {
+			env.vm.stack[env.vm.sp-1] = nil
+			return 1
+		}
+		str.tt = int16(sqltypes.Blob)
+		str.bytes = uncompressed
+		str.col = collationBinary
+		return 1
+	}, "FN UNCOMPRESS VARBINARY(SP-1)")
+}
+
+func (asm *assembler) Fn_UNCOMPRESSED_LENGTH() {
+	asm.emit(func(env *ExpressionEnv) int {
+		str := env.vm.stack[env.vm.sp-1].(*evalBytes)
+
+		var size uint32
+		if len(str.bytes) > 0 {
+			var ok bool
+			if size, ok = mysqlUncompressedLength(str.bytes); !ok {
+				warnZlibDataError(env)
+			}
+		}
+		env.vm.stack[env.vm.sp-1] = env.vm.arena.newEvalInt64(int64(size))
+		return 1
+	}, "FN UNCOMPRESSED_LENGTH VARBINARY(SP-1)")
+}
+
 func (asm *assembler) Fn_HEX_c(t sqltypes.Type, col collations.TypedCollation) {
 	asm.emit(func(env *ExpressionEnv) int {
 		arg := env.vm.stack[env.vm.sp-1].(*evalBytes)
@@ -2508,6 +2631,47 @@ func (asm *assembler) Fn_ELT(args int, tt sqltypes.Type, tc collations.TypedColl
 	}, "FN ELT INT64(SP-%d) VARCHAR(SP-%d)...VARCHAR(SP-1)", args, args-1)
 }
 
+func (asm *assembler) Fn_MAKE_SET(args int, tt sqltypes.Type, tc collations.TypedCollation) {
+	asm.adjustStack(-args)
+	asm.emit(func(env *ExpressionEnv) int {
+		bits := env.vm.stack[env.vm.sp-args-1].(*evalInt64)
+
+		var buf []byte
+		buf, env.vm.err = makeSet(uint64(bits.i), env.vm.stack[env.vm.sp-args:env.vm.sp], tc.Collation)
+		env.vm.stack[env.vm.sp-args-1] = env.vm.arena.newEvalRaw(buf, tt, tc)
+		env.vm.sp -= args
+		return 1
+	}, "FN MAKE_SET INT64(SP-%d) VARCHAR(SP-%d)...VARCHAR(SP-1)", args+1, args)
+}
+
+func (asm *assembler) Fn_EXPORT_SET(args int, tt sqltypes.Type, tc collations.TypedCollation) {
+	asm.adjustStack(-args + 1)
+	asm.emit(func(env *ExpressionEnv) int {
+		bits := evalToInt64(env.vm.stack[env.vm.sp-args])
+
+		on, off, sep, n, err := exportSetArgs(env.vm.stack[env.vm.sp-args:env.vm.sp], tc.Collation)
+		if err != nil {
+			env.vm.err = err
+		} else {
+			env.vm.stack[env.vm.sp-args] = env.vm.arena.newEvalRaw(exportSet(uint64(bits.i), on, off, sep, n), tt, tc)
+		}
+		env.vm.sp -= args - 1
+		return 1
+	}, "FN EXPORT_SET INT64(SP-%d) VARCHAR(SP-%d)...(SP-1)", args, args-1)
+}
+
+func (asm *assembler) Fn_FIND_IN_SET(col collations.ID) {
+	coll := colldata.Lookup(col)
+	asm.adjustStack(-1)
+	asm.emit(func(env *ExpressionEnv) int {
+		var str, strlist []byte
+		str, strlist, env.vm.err = findInSetArgs(env.vm.stack[env.vm.sp-2], env.vm.stack[env.vm.sp-1], col)
+		env.vm.stack[env.vm.sp-2] = env.vm.arena.newEvalInt64(findInSet(str, strlist, coll))
+		env.vm.sp--
+		return 1
+	}, "FN FIND_IN_SET VARCHAR(SP-2) VARCHAR(SP-1)")
+}
+
 func (asm *assembler) Fn_INSERT(col collations.TypedCollation) {
 	asm.adjustStack(-3)
 
@@ -2653,6 +2817,23 @@ func (asm *assembler) Fn_MULTICMP_i(args int, lessThan bool) {
 	}, "FN MULTICMP INT64(SP-%d)...INT64(SP-1)", args)
 }
 
+func (asm *assembler) Fn_MULTICMP_temporal(args int, lessThan bool) {
+	asm.adjustStack(-(args - 1))
+
+	asm.emit(func(env *ExpressionEnv) int {
+		x := env.vm.stack[env.vm.sp-args].(*evalTemporal)
+		for sp := env.vm.sp - args + 1; sp < env.vm.sp; sp++ {
+			y := env.vm.stack[sp].(*evalTemporal)
+			if lessThan == (compareDates(y, x) < 0) {
+				x = y
+			}
+		}
+		env.vm.stack[env.vm.sp-args] = x
+		env.vm.sp -= args - 1
+		return 1
+	}, "FN MULTICMP TEMPORAL(SP-%d)...TEMPORAL(SP-1)", args)
+}
+
 func (asm *assembler) Fn_MULTICMP_u(args int, lessThan bool) {
 	asm.adjustStack(-(args - 1))
 
@@ -3226,7 +3407,7 @@ func (asm *assembler) Mul_dd() {
 	asm.emit(func(env *ExpressionEnv) int {
 		l := env.vm.stack[env.vm.sp-2].(*evalDecimal)
 		r := env.vm.stack[env.vm.sp-1].(*evalDecimal)
-		mathMul_dd0(l, r)
+		env.vm.err = mathMul_dd0(l, r)
 		env.vm.sp--
 		return 1
 	}, "MUL DECIMAL(SP-2), DECIMAL(SP-1)")
@@ -3485,7 +3666,7 @@ func (asm *assembler) Sub_dd() {
 	asm.emit(func(env *ExpressionEnv) int {
 		l := env.vm.stack[env.vm.sp-2].(*evalDecimal)
 		r := env.vm.stack[env.vm.sp-1].(*evalDecimal)
-		mathSub_dd0(l, r)
+		env.vm.err = mathSub_dd0(l, r)
 		env.vm.sp--
 		return 1
 	}, "SUB DECIMAL(SP-2), DECIMAL(SP-1)")
@@ -3695,6 +3876,7 @@ func (asm *assembler) Fn_SHA2(col collations.TypedCollation) {
 			s := sha512.Sum512(arg.bytes)
 			sum = s[:]
 		default:
+			warnSHA2BitLength(env)
 			env.vm.stack[env.vm.sp-2] = nil
 			env.vm.sp--
 			return 1
@@ -3739,14 +3921,51 @@ func (asm *assembler) Fn_DATE_FORMAT(col collations.TypedCollation) {
 		l := env.vm.stack[env.vm.sp-2].(*evalTemporal)
 		r := env.vm.stack[env.vm.sp-1].(*evalBytes)
 
-		var d []byte
-		d, env.vm.err = datetime.Format(r.string(), l.dt, l.prec)
-		env.vm.stack[env.vm.sp-2] = env.vm.arena.newEvalText(d, col)
+		var locale *datetime.Locale
+		locale, env.vm.err = env.formatLocale(r.string())
+		if env.vm.err != nil {
+			return 0
+		}
+		d, ok, err := datetime.FormatLocale(r.string(), l.dt, l.prec, locale)
+		if err != nil {
+			env.vm.err = err
+			return 0
+		}
+		if ok {
+			env.vm.stack[env.vm.sp-2] = env.vm.arena.newEvalText(d, col)
+		} else {
+			env.vm.stack[env.vm.sp-2] = nil
+		}
 		env.vm.sp--
 		return 1
 	}, "FN DATE_FORMAT DATETIME(SP-2), VARBINARY(SP-1)")
 }
 
+func (asm *assembler) Fn_TIME_FORMAT(col collations.TypedCollation) {
+	asm.adjustStack(-1)
+	asm.emit(func(env *ExpressionEnv) int {
+		if env.vm.stack[env.vm.sp-2] == nil {
+			env.vm.sp--
+			return 1
+		}
+		l := env.vm.stack[env.vm.sp-2].(*evalTemporal)
+		r := env.vm.stack[env.vm.sp-1].(*evalBytes)
+
+		d, ok, err := datetime.FormatTime(r.string(), l.dt.Time, l.prec)
+		if err != nil {
+			env.vm.err = err
+			return 0
+		}
+		if ok {
+			env.vm.stack[env.vm.sp-2] = env.vm.arena.newEvalText(d, col)
+		} else {
+			env.vm.stack[env.vm.sp-2] = nil
+		}
+		env.vm.sp--
+		return 1
+	}, "FN TIME_FORMAT TIME(SP-2), VARBINARY(SP-1)")
+}
+
 func (asm *assembler) Fn_CONVERT_TZ() {
 	asm.adjustStack(-2)
 	asm.emit(func(env *ExpressionEnv) int {
@@ -4064,14 +4283,17 @@ func (asm *assembler) Fn_MONTHNAME(col collations.TypedCollation) {
 			return 1
 		}
 		arg := env.vm.stack[env.vm.sp-1].(*evalTemporal)
-		m := arg.dt.Date.Month()
-		if m < 1 || m > 12 {
+		var locale *datetime.Locale
+		locale, env.vm.err = env.timeLocale()
+		if env.vm.err != nil {
+			return 0
+		}
+		m, ok := locale.MonthName(arg.dt)
+		if !ok {
 			env.vm.stack[env.vm.sp-1] = nil
 			return 1
 		}
-
-		mb := hack.StringBytes(time.Month(arg.dt.Date.Month()).String())
-		env.vm.stack[env.vm.sp-1] = env.vm.arena.newEvalText(mb, col)
+		env.vm.stack[env.vm.sp-1] = env.vm.arena.newEvalText(hack.StringBytes(m), col)
 		return 1
 	}, "FN MONTHNAME DATE(SP-1)")
 }
@@ -5088,6 +5310,49 @@ func (asm *assembler) Fn_REGEXP_REPLACE_slow(merged collations.TypedCollation, f
 	}, "FN REGEXP_REPLACE_SLOW VARCHAR(SP-2), VARCHAR(SP-1)")
 }
 
+func (asm *assembler) Fn_POINT() {
+	asm.adjustStack(-1)
+	asm.emit(func(env *ExpressionEnv) int {
+		x := env.vm.stack[env.vm.sp-2].(*evalFloat)
+		y := env.vm.stack[env.vm.sp-1].(*evalFloat)
+		if !validCoordinate(x.f) || !validCoordinate(y.f) {
+			env.vm.err = errInvalidGISData("point")
+			return 1
+		}
+		env.vm.stack[env.vm.sp-2] = newEvalGeometry(newGeometryPoint(sridCartesian, x.f, y.f))
+		env.vm.sp--
+		return 1
+	}, "FN POINT FLOAT64(SP-2) FLOAT64(SP-1)")
+}
+
+func (asm *assembler) Fn_POINT_COORDINATE(fn string, y bool) {
+	asm.emit(func(env *ExpressionEnv) int {
+		env.vm.stack[env.vm.sp-1], env.vm.err = pointCoordinate(env.vm.stack[env.vm.sp-1], fn, y)
+		return 1
+	}, "FN %s GEOMETRY(SP-1)", fn)
+}
+
+func (asm *assembler) Fn_ST_ASTEXT(col collations.TypedCollation) {
+	asm.emit(func(env *ExpressionEnv) int {
+		env.vm.stack[env.vm.sp-1], env.vm.err = stAsText(env.vm.stack[env.vm.sp-1], col)
+		return 1
+	}, "FN ST_ASTEXT GEOMETRY(SP-1)")
+}
+
+func (asm *assembler) Fn_ST_DISTANCE_SPHERE(args int) {
+	asm.adjustStack(1 - args)
+	asm.emit(func(env *ExpressionEnv) int {
+		radius := float64(earthRadius)
+		if args == 3 {
+			radius = env.vm.stack[env.vm.sp-1].(*evalFloat).f
+		}
+		p1, p2 := env.vm.stack[env.vm.sp-args], env.vm.stack[env.vm.sp-args+1]
+		env.vm.stack[env.vm.sp-args], env.vm.err = stDistanceSphere(p1, p2, radius)
+		env.vm.sp -= args - 1
+		return 1
+	}, "FN ST_DISTANCE_SPHERE GEOMETRY(SP-%d) GEOMETRY(SP-%d)", args, args-1)
+}
+
 func (asm *assembler) Introduce(offset int, t sqltypes.Type, col collations.TypedCollation) {
 	asm.emit(func(env *ExpressionEnv) int {
 		var arg *evalBytes
@@ -5098,3 +5363,21 @@ func (asm *assembler) Introduce(offset int, t sqltypes.Type, col collations.Type
 		return 1
 	}, "INTRODUCE (SP-1)")
 }
+
+func (asm *assembler) Warn_divisionByZero() {
+	asm.emit(func(env *ExpressionEnv) int {
+		// the arguments were not NULL, so the division returned NULL
+		// because it was a division by zero
+		if env.vm.stack[env.vm.sp-1] == nil {
+			env.warnDivisionByZero()
+		}
+		return 1
+	}, "WARN DIVISION BY ZERO (SP-1)")
+}
+
+func (asm *assembler) Warn_truncatedNumber(offset int, typ string) {
+	asm.emit(func(env *ExpressionEnv) int {
+		env.warnTruncatedNumber(env.vm.stack[env.vm.sp-offset], typ)
+		return 1
+	}, "WARN TRUNCATED %s (SP-%d)", typ, offset)
+}
@@ -127,6 +127,66 @@ func (asm *assembler) PushBVar_vector(key string) {
 	}, "PUSH VECTOR(:%q)", key)
 }
 
+func push_geometry(env *ExpressionEnv, raw []byte) int {
+	env.vm.stack[env.vm.sp] = newEvalGeometry(raw)
+	env.vm.sp++
+	return 1
+}
+
+func (asm *assembler) PushColumn_geometry(offset int) {
+	asm.adjustStack(1)
+	asm.emit(func(env *ExpressionEnv) int {
+		col := env.Row[offset]
+		if col.IsNull() {
+			return push_null(env)
+		}
+		return push_geometry(env, col.Raw())
+	}, "PUSH GEOMETRY(:%d)", offset)
+}
+
+func (asm *assembler) PushBVar_geometry(key string) {
+	asm.adjustStack(1)
+
+	asm.emit(func(env *ExpressionEnv) int {
+		var bvar *querypb.BindVariable
+		bvar, env.vm.err = env.lookupBindVar(key)
+		if env.vm.err != nil {
+			return 0
+		}
+		return push_geometry(env, bvar.Value)
+	}, "PUSH GEOMETRY(:%q)", key)
+}
+
+func push_bit(env *ExpressionEnv, raw []byte) int {
+	env.vm.stack[env.vm.sp] = newEvalBitColumn(raw)
+	env.vm.sp++
+	return 1
+}
+
+func (asm *assembler) PushColumn_bit(offset int) {
+	asm.adjustStack(1)
+	asm.emit(func(env *ExpressionEnv) int {
+		col := env.Row[offset]
+		if col.IsNull() {
+			return push_null(env)
+		}
+		return push_bit(env, col.Raw())
+	}, "PUSH BIT(:%d)", offset)
+}
+
+func (asm *assembler) PushBVar_bit(key string) {
+	asm.adjustStack(1)
+
+	asm.emit(func(env *ExpressionEnv) int {
+		var bvar *querypb.BindVariable
+		bvar, env.vm.err = env.lookupBindVar(key)
+		if env.vm.err != nil {
+			return 0
+		}
+		return push_bit(env, bvar.Value)
+	}, "PUSH BIT(:%q)", key)
+}
+
 func push_d(env *ExpressionEnv, raw []byte) int {
 	var dec decimal.Decimal
 	dec, env.vm.err = decimal.NewFromMySQL(raw)
@@ -32,9 +32,12 @@ func (c *compiler) compileFn_rounding(arg0 IR, asm_ins_f, asm_ins_d func()) (cty
 	}
 
 	skip := c.compileNullCheck1(arg)
+	arg = c.compileTemporalToNumeric(arg, 1)
 
 	convt := ctype{Type: arg.Type, Col: collationNumeric, Flag: arg.Flag}
 	switch arg.Type {
+	case sqltypes.Int64:
+		// No-op for temporals without fractional seconds.
 	case sqltypes.Float64:
 		asm_ins_f()
 	case sqltypes.Decimal:
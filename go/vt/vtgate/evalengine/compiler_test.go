@@ -18,7 +18,9 @@ package evalengine_test
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"testing"
@@ -28,14 +30,27 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/mysql/collations/colldata"
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtenv"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/evalengine/testcases"
 )
 
+// makeGeometryPoint returns a Point in the internal format of MySQL, with its
+// coordinates in the longitude-latitude order for a geographic SRS.
+func makeGeometryPoint(srid uint32, x, y float64) sqltypes.Value {
+	raw := binary.LittleEndian.AppendUint32(nil, srid)
+	raw = append(raw, 1)
+	raw = binary.LittleEndian.AppendUint32(raw, 1)
+	raw = binary.LittleEndian.AppendUint64(raw, math.Float64bits(x))
+	raw = binary.LittleEndian.AppendUint64(raw, math.Float64bits(y))
+	return sqltypes.MakeTrusted(sqltypes.Geometry, raw)
+}
+
 func makeFields(values []sqltypes.Value) (fields []*querypb.Field) {
 	for i, v := range values {
 		field := &querypb.Field{
@@ -176,6 +191,16 @@ func TestCompilerSingle(t *testing.T) {
 			values:     []sqltypes.Value{sqltypes.NewInt64(1)},
 			result:     "INT64(2)",
 		},
+		{
+			expression: "cast(column0 as decimal(4, 2)) * 100",
+			values:     []sqltypes.Value{sqltypes.NewVarChar("1.255")},
+			result:     "DECIMAL(126.00)",
+		},
+		{
+			expression: "cast(column0 as decimal(4, 2))",
+			values:     []sqltypes.Value{sqltypes.NewVarChar("-999.999")},
+			result:     "DECIMAL(-99.99)",
+		},
 		{
 			expression: "1 + column0",
 			values:     []sqltypes.Value{sqltypes.NewFloat64(1)},
@@ -341,6 +366,18 @@ func TestCompilerSingle(t *testing.T) {
 			expression: `UNHEX('f')`,
 			result:     `VARBINARY("\x0f")`,
 		},
+		{
+			expression: `HEX(COMPRESS('hello world'))`,
+			result:     `VARCHAR("0B000000789CCB48CDC9C95728CF2FCA4901001A0B045D")`,
+		},
+		{
+			expression: `UNCOMPRESS(x'0B000000789CCB48CDC9C95728CF2FCA4901001A0B045D')`,
+			result:     `BLOB("hello world")`,
+		},
+		{
+			expression: `UNCOMPRESS(x'05000000789CCB48CDC9C95728CF2FCA4901001A0B045D')`,
+			result:     `NULL`,
+		},
 		{
 			expression: `STRCMP(1234, '12_4')`,
 			result:     `INT64(-1)`,
@@ -433,6 +470,58 @@ func TestCompilerSingle(t *testing.T) {
 			expression: `CONCAT_WS("😊😂🤢", date '2000-01-01', _latin1 0xFF)`,
 			result:     `VARCHAR("2000-01-01😊😂🤢ÿ")`,
 		},
+		{
+			expression: `0.1234567890123456789 * 0.1234567890123456789`,
+			result:     `DECIMAL(0.015241578753238836750190519988)`,
+		},
+		{
+			expression: `0.123456789012345678901234567 / 3`,
+			result:     `DECIMAL(0.041152263004115226300411522333)`,
+		},
+		{
+			expression: `MAKE_SET(1 | 4, 'hello', 'nice', 'world')`,
+			result:     `VARCHAR("hello,world")`,
+		},
+		{
+			expression: `MAKE_SET(1 | 4, 'hello', 'nice', NULL, 'world')`,
+			result:     `VARCHAR("hello")`,
+		},
+		{
+			expression: `MAKE_SET(-1, 'a', _latin1 0xFF)`,
+			result:     `VARCHAR("a,ÿ")`,
+		},
+		{
+			expression: `MAKE_SET(NULL, 'a')`,
+			result:     `NULL`,
+		},
+		{
+			expression: `EXPORT_SET(5, 'Y', 'N', ',', 4)`,
+			result:     `VARCHAR("Y,N,Y,N")`,
+		},
+		{
+			expression: `EXPORT_SET(6, '1', '0', '', -1)`,
+			result:     `VARCHAR("0110000000000000000000000000000000000000000000000000000000000000")`,
+		},
+		{
+			expression: `EXPORT_SET(6, '1', NULL)`,
+			result:     `NULL`,
+		},
+		{
+			expression: `FIND_IN_SET('B', 'a,b,c,d')`,
+			result:     `INT64(2)`,
+		},
+		{
+			expression: `FIND_IN_SET('', 'a,,b')`,
+			result:     `INT64(2)`,
+		},
+		{
+			expression: `FIND_IN_SET('a,b', 'a,b')`,
+			result:     `INT64(0)`,
+		},
+		{
+			expression: `FIND_IN_SET(_binary 'B', 'a,b')`,
+			result:     `INT64(0)`,
+		},
 		{
 			expression: `concat('test', _latin1 0xff)`,
 			result:     `VARCHAR("testÿ")`,
@@ -653,6 +742,56 @@ func TestCompilerSingle(t *testing.T) {
 			expression: `1 * unix_timestamp(CONVERT_TZ('2004-01-01 12:00:00.10','+00:00','+10:00'))`,
 			result:     `DECIMAL(1072990800.10)`,
 		},
+		{
+			expression: `CONVERT_TZ('2023-07-01 12:00:00', 'Europe/Madrid', 'America/New_York')`,
+			result:     `DATETIME("2023-07-01 06:00:00")`,
+		},
+		{
+			expression: `CONVERT_TZ('2023-03-12 02:30:00', 'America/New_York', 'UTC')`,
+			result:     `DATETIME("2023-03-12 07:00:00")`,
+		},
+		{
+			expression: `CONVERT_TZ('2023-03-26 02:30:00.25', 'Europe/Madrid', '+00:00')`,
+			result:     `DATETIME("2023-03-26 01:00:00.00")`,
+		},
+		{
+			expression: `CONVERT_TZ('2023-01-01 00:00:00', '+00:00', '+5:30')`,
+			result:     `DATETIME("2023-01-01 05:30:00")`,
+		},
+		{
+			expression: `CONVERT_TZ('2023-01-01 00:00:00', '+00:00', '+5:')`,
+			result:     `NULL`,
+		},
+		{
+			expression: "EXP(column0)",
+			values:     []sqltypes.Value{sqltypes.NewDecimal("1.0000000000000000000000000001")},
+			result:     `FLOAT64(2.718281828459045)`,
+		},
+		{
+			expression: "EXP(column0)",
+			values:     []sqltypes.Value{sqltypes.NewDecimal("710.5")},
+			result:     `NULL`,
+		},
+		{
+			expression: "LN(column0)",
+			values:     []sqltypes.Value{sqltypes.NewDecimal("10.000000000000000000001")},
+			result:     `FLOAT64(2.302585092994046)`,
+		},
+		{
+			expression: "LN(column0)",
+			values:     []sqltypes.Value{sqltypes.NewDecimal("0.000")},
+			result:     `NULL`,
+		},
+		{
+			expression: "LOG10(column0)",
+			values:     []sqltypes.Value{sqltypes.NewDecimal("1000.000")},
+			result:     `FLOAT64(3)`,
+		},
+		{
+			expression: "LOG(2.000, column0)",
+			values:     []sqltypes.Value{sqltypes.NewDecimal("1024.0")},
+			result:     `FLOAT64(10)`,
+		},
 		{
 			expression: `1 * unix_timestamp('2004-01-01 12:00:00.10')`,
 			result:     `DECIMAL(1072954800.10)`,
@@ -723,6 +862,183 @@ func TestCompilerSingle(t *testing.T) {
 			expression: `cast(_utf32 0x0000FF as binary)`,
 			result:     `VARBINARY("\x00\x00\x00\xff")`,
 		},
+		{
+			expression: `column0 & 4`,
+			values:     []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Bit, []byte{0x05})},
+			result:     `UINT64(4)`,
+		},
+		{
+			expression: `column0 | column1`,
+			values:     []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Bit, []byte{0x01, 0x00}), sqltypes.MakeTrusted(sqltypes.Bit, []byte{0x01})},
+			result:     `UINT64(257)`,
+		},
+		{
+			expression: `column0 ^ 0xff`,
+			values:     []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Bit, []byte{0x0f})},
+			result:     `UINT64(240)`,
+		},
+		{
+			expression: `column0 << 4`,
+			values:     []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Bit, []byte{0x0f})},
+			result:     `UINT64(240)`,
+		},
+		{
+			expression: `column0 >> 1`,
+			values:     []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Bit, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})},
+			result:     `UINT64(9223372036854775807)`,
+		},
+		{
+			expression: `bit_count(column0)`,
+			values:     []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Bit, []byte{0x01, 0x07})},
+			result:     `INT64(4)`,
+		},
+		{
+			expression: `column0 + 1`,
+			values:     []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Bit, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe})},
+			result:     `UINT64(18446744073709551615)`,
+		},
+		{
+			expression: `column0 = 5`,
+			values:     []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Bit, []byte{0x05})},
+			result:     `INT64(1)`,
+		},
+		{
+			expression: `column0 & 1 and 1`,
+			values:     []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Bit, []byte{0x02})},
+			result:     `INT64(0)`,
+		},
+		{
+			expression: `concat(column0, '')`,
+			values:     []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Bit, []byte("ab"))},
+			result:     `VARBINARY("ab")`,
+		},
+		{
+			expression: `column0 = 1234.500`,
+			values:     []sqltypes.Value{sqltypes.NewDecimal("1234.5")},
+			result:     `INT64(1)`,
+		},
+		{
+			expression: `column0 < 1234.500`,
+			values:     []sqltypes.Value{sqltypes.NewDecimal("1234.49")},
+			result:     `INT64(1)`,
+		},
+		{
+			expression: `1234.500 < column0`,
+			values:     []sqltypes.Value{sqltypes.NewDecimal("1234.49")},
+			result:     `INT64(0)`,
+		},
+		{
+			expression: `-0.5 >= column0`,
+			values:     []sqltypes.Value{sqltypes.NewDecimal("-0.50000")},
+			result:     `INT64(1)`,
+		},
+		{
+			expression: `column0 <=> 184467440737095516150.0`,
+			values:     []sqltypes.Value{sqltypes.NewDecimal("184467440737095516150")},
+			result:     `INT64(1)`,
+		},
+		{
+			expression: `column0 > 0.000`,
+			values:     []sqltypes.Value{sqltypes.NULL},
+			result:     `NULL`,
+		},
+		{
+			expression: `truncate(-1234.567, column0)`,
+			values:     []sqltypes.Value{sqltypes.NewInt64(-2)},
+			result:     `DECIMAL(-1200)`,
+		},
+		{
+			expression: `truncate(-1.999, column0)`,
+			values:     []sqltypes.Value{sqltypes.NewInt64(1)},
+			result:     `DECIMAL(-1.9)`,
+		},
+		{
+			expression: `st_astext(point(1.5, -2))`,
+			result:     `VARCHAR("POINT(1.5 -2)")`,
+		},
+		{
+			expression: `st_x(point(column0, 2)) + st_y(point(1, column0))`,
+			values:     []sqltypes.Value{sqltypes.NewInt64(3)},
+			result:     `FLOAT64(6)`,
+		},
+		{
+			expression: `st_x(point(column0, 2))`,
+			values:     []sqltypes.Value{sqltypes.NULL},
+			result:     `NULL`,
+		},
+		{
+			expression: `st_x(column0)`,
+			values:     []sqltypes.Value{makeGeometryPoint(4326, 10, 45)},
+			result:     `FLOAT64(45)`,
+		},
+		{
+			expression: `st_y(column0)`,
+			values:     []sqltypes.Value{makeGeometryPoint(4326, 10, 45)},
+			result:     `FLOAT64(10)`,
+		},
+		{
+			expression: `st_astext(column0)`,
+			values:     []sqltypes.Value{makeGeometryPoint(4326, 10, 45)},
+			result:     `VARCHAR("POINT(45 10)")`,
+		},
+		{
+			expression: `st_distance_sphere(point(0, 0), point(0, 1))`,
+			result:     `FLOAT64(111194.68229846345)`,
+		},
+		{
+			expression: `st_distance_sphere(column0, column1, 1)`,
+			values:     []sqltypes.Value{makeGeometryPoint(4326, 0, 0), makeGeometryPoint(4326, 180, 0)},
+			result:     `FLOAT64(3.141592653589793)`,
+		},
+		{
+			expression: `st_distance_sphere(column0, column1) < 1000`,
+			values:     []sqltypes.Value{makeGeometryPoint(4326, -3.7038, 40.4168), makeGeometryPoint(4326, -3.6883, 40.4153)},
+			result:     `INT64(0)`,
+		},
+		{
+			expression: `st_distance_sphere(point(0, 0), point(0, 1), column0)`,
+			values:     []sqltypes.Value{sqltypes.NULL},
+			result:     `NULL`,
+		},
+		{
+			expression: `-column0`,
+			values:     []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2000-01-01 11:22:33"))},
+			result:     `INT64(-20000101112233)`,
+		},
+		{
+			expression: `-TIME'11:22:33.5'`,
+			result:     `DECIMAL(-112233.5)`,
+		},
+		{
+			expression: `abs(column0)`,
+			values:     []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Time, []byte("-11:22:33"))},
+			result:     `INT64(112233)`,
+		},
+		{
+			expression: `abs(TIME'-11:22:33.5')`,
+			result:     `DECIMAL(112233.5)`,
+		},
+		{
+			expression: `ceil(DATE'2000-01-02')`,
+			result:     `INT64(20000102)`,
+		},
+		{
+			expression: `floor(TIMESTAMP'2000-01-01 11:22:33.5')`,
+			result:     `INT64(20000101112233)`,
+		},
+		{
+			expression: `round(TIME'11:22:33.5')`,
+			result:     `DECIMAL(112234)`,
+		},
+		{
+			expression: `round(column0, -2)`,
+			values:     []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Date, []byte("2000-01-02"))},
+			result:     `INT64(20000100)`,
+		},
+		{
+			expression: `truncate(TIMESTAMP'2000-01-01 11:22:33.56', 1)`,
+			result:     `DECIMAL(20000101112233.5)`,
+		},
 	}
 
 	tz, _ := time.LoadLocation("Europe/Madrid")
@@ -925,3 +1241,395 @@ func TestCompilerNonConstant(t *testing.T) {
 		})
 	}
 }
+
+func TestCompilerWarnings(t *testing.T) {
+	var testCases = []struct {
+		expression string
+		result     string
+		warnings   []*querypb.QueryWarning
+	}{
+		{
+			expression: "1 + 1",
+			result:     "INT64(2)",
+		},
+		{
+			expression: "1 / 0",
+			result:     "NULL",
+			warnings:   []*querypb.QueryWarning{{Code: 1365, Message: "Division by 0"}},
+		},
+		{
+			expression: "1 % 0",
+			result:     "NULL",
+			warnings:   []*querypb.QueryWarning{{Code: 1365, Message: "Division by 0"}},
+		},
+		{
+			expression: "'12abc' + 1",
+			result:     "FLOAT64(13)",
+			warnings:   []*querypb.QueryWarning{{Code: 1292, Message: "Truncated incorrect DOUBLE value: '12abc'"}},
+		},
+		{
+			expression: "'3x' div 1",
+			result:     "INT64(3)",
+			warnings:   []*querypb.QueryWarning{{Code: 1292, Message: "Truncated incorrect DECIMAL value: '3x'"}},
+		},
+		{
+			expression: "'1x' / '0y'",
+			result:     "NULL",
+			warnings: []*querypb.QueryWarning{
+				{Code: 1292, Message: "Truncated incorrect DOUBLE value: '1x'"},
+				{Code: 1292, Message: "Truncated incorrect DOUBLE value: '0y'"},
+				{Code: 1365, Message: "Division by 0"},
+			},
+		},
+		{
+			expression: "CAST('2024-13-01' AS DATE)",
+			result:     "NULL",
+			warnings:   []*querypb.QueryWarning{{Code: 1292, Message: "Incorrect datetime value: '2024-13-01'"}},
+		},
+		{
+			expression: "CAST('2024-01-01 25:00:00' AS DATETIME)",
+			result:     "NULL",
+			warnings:   []*querypb.QueryWarning{{Code: 1292, Message: "Incorrect datetime value: '2024-01-01 25:00:00'"}},
+		},
+		{
+			expression: "CAST('abc' AS TIME)",
+			result:     "NULL",
+			warnings:   []*querypb.QueryWarning{{Code: 1292, Message: "Truncated incorrect time value: 'abc'"}},
+		},
+		{
+			expression: "CAST('2024-01-01' AS DATE)",
+			result:     `DATE("2024-01-01")`,
+		},
+	}
+
+	venv := vtenv.NewTestEnv()
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			expr, err := venv.Parser().ParseExpr(tc.expression)
+			require.NoError(t, err)
+
+			cfg := &evalengine.Config{
+				Collation:   collations.CollationUtf8mb4ID,
+				Environment: venv,
+			}
+
+			converted, err := evalengine.Translate(expr, cfg)
+			require.NoError(t, err)
+
+			env := evalengine.EmptyExpressionEnv(venv)
+			expected, err := env.EvaluateAST(converted)
+			require.NoError(t, err)
+			require.Equal(t, tc.result, expected.String())
+			require.Equal(t, tc.warnings, env.Warnings())
+
+			env.ClearWarnings()
+			res, err := env.Evaluate(converted)
+			require.NoError(t, err)
+			require.Equal(t, tc.result, res.String())
+			require.Equal(t, tc.warnings, env.Warnings())
+		})
+	}
+}
+
+func TestCompilerPrepare(t *testing.T) {
+	var testCases = []struct {
+		expression string
+		fields     []*querypb.Field
+		rows       [][]sqltypes.Value
+	}{
+		{
+			expression: "column0 + column1 > 10",
+			fields: []*querypb.Field{
+				{Name: "column0", Type: sqltypes.Int64, Charset: uint32(collations.CollationBinaryID)},
+				{Name: "column1", Type: sqltypes.Decimal, Charset: uint32(collations.CollationBinaryID)},
+			},
+			rows: [][]sqltypes.Value{
+				{sqltypes.NewInt64(1), sqltypes.NewDecimal("2.5")},
+				{sqltypes.NewInt64(10), sqltypes.NewDecimal("0.5")},
+				{sqltypes.NULL, sqltypes.NewDecimal("20")},
+			},
+		},
+		{
+			expression: "concat(column0, '-', column1 * 2)",
+			fields: []*querypb.Field{
+				{Name: "column0", Type: sqltypes.VarChar, Charset: uint32(collations.CollationUtf8mb4ID)},
+				{Name: "column1", Type: sqltypes.Int64, Charset: uint32(collations.CollationBinaryID)},
+			},
+			rows: [][]sqltypes.Value{
+				{sqltypes.NewVarChar("foo"), sqltypes.NewInt64(1)},
+				{sqltypes.NewVarChar("Bar"), sqltypes.NULL},
+				{sqltypes.NULL, sqltypes.NewInt64(3)},
+			},
+		},
+		{
+			expression: "column0 in (1, 2, 3)",
+			fields: []*querypb.Field{
+				{Name: "column0", Type: sqltypes.Int64, Charset: uint32(collations.CollationBinaryID)},
+			},
+			rows: [][]sqltypes.Value{
+				{sqltypes.NewInt64(2)},
+				{sqltypes.NewInt64(4)},
+				{sqltypes.NULL},
+			},
+		},
+	}
+
+	venv := vtenv.NewTestEnv()
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			expr, err := venv.Parser().ParseExpr(tc.expression)
+			require.NoError(t, err)
+
+			// Without a type resolver the types of the columns are unknown when translating
+			fields := evalengine.FieldResolver(tc.fields)
+			cfg := &evalengine.Config{
+				ResolveColumn: fields.Column,
+				Collation:     collations.CollationUtf8mb4ID,
+				Environment:   venv,
+			}
+
+			converted, err := evalengine.Translate(expr, cfg)
+			require.NoError(t, err)
+			require.IsType(t, &evalengine.UntypedExpr{}, converted)
+
+			env := evalengine.EmptyExpressionEnv(venv)
+			require.Equal(t, converted, env.Prepare(converted, nil))
+
+			prepared := env.Prepare(converted, tc.fields)
+			require.IsType(t, &evalengine.CompiledExpr{}, prepared)
+			require.Equal(t, prepared, env.Prepare(prepared, tc.fields))
+
+			for _, row := range tc.rows {
+				env.Row = row
+				expected, err := env.EvaluateAST(converted)
+				require.NoError(t, err)
+
+				res, err := env.Evaluate(prepared)
+				require.NoError(t, err)
+				require.Equal(t, expected.String(), res.String())
+			}
+		})
+	}
+}
+
+func TestCompilerDecimalOutOfRange(t *testing.T) {
+	nines := strings.Repeat("9", 65)
+	var testCases = []struct {
+		expression string
+		err        string
+	}{
+		{
+			expression: nines + " + 1",
+			err:        "DECIMAL value is out of range in '(" + nines + " + 1)'",
+		},
+		{
+			expression: "-" + nines + " - 1",
+			err:        "DECIMAL value is out of range in '(-" + nines + " - 1)'",
+		},
+		{
+			expression: nines + " * 10",
+			err:        "DECIMAL value is out of range in '(" + nines + " * 10)'",
+		},
+		{
+			expression: nines + " / 0.1",
+			err:        "DECIMAL value is out of range in '(" + nines + " / 0.1)'",
+		},
+	}
+
+	venv := vtenv.NewTestEnv()
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			expr, err := venv.Parser().ParseExpr(tc.expression)
+			require.NoError(t, err)
+
+			cfg := &evalengine.Config{
+				Collation:         collations.CollationUtf8mb4ID,
+				Environment:       venv,
+				NoConstantFolding: true,
+			}
+			converted, err := evalengine.Translate(expr, cfg)
+			require.NoError(t, err)
+
+			env := evalengine.EmptyExpressionEnv(venv)
+			_, err = env.EvaluateAST(converted)
+			require.EqualError(t, err, tc.err)
+			_, err = env.Evaluate(converted)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+// fallbackCollation implements an unsupported collation with a supported one.
+type fallbackCollation struct {
+	colldata.Collation
+	id   collations.ID
+	name string
+}
+
+func (c *fallbackCollation) ID() collations.ID {
+	return c.id
+}
+
+func (c *fallbackCollation) Name() string {
+	return c.name
+}
+
+func TestCompilerFallbackCollation(t *testing.T) {
+	venv := vtenv.NewTestEnv()
+	evaluate := func(expression string) (sqltypes.Value, error) {
+		expr, err := venv.Parser().ParseExpr(expression)
+		require.NoError(t, err)
+
+		cfg := &evalengine.Config{
+			Collation:         collations.CollationUtf8mb4ID,
+			Environment:       venv,
+			NoConstantFolding: true,
+		}
+		converted, err := evalengine.Translate(expr, cfg)
+		if err != nil {
+			return sqltypes.Value{}, err
+		}
+
+		env := evalengine.EmptyExpressionEnv(venv)
+		expected, err := env.EvaluateAST(converted)
+		if err != nil {
+			return sqltypes.Value{}, err
+		}
+		res, err := env.Evaluate(converted)
+		require.NoError(t, err)
+		require.Equal(t, expected.String(), res.String())
+		return res.Value(collations.CollationUtf8mb4ID), nil
+	}
+
+	_, err := evaluate("_latin1'A' COLLATE latin1_german2_ci = _latin1'a'")
+	require.EqualError(t, err, "Unknown collation: 'latin1_german2_ci'")
+	require.Equal(t, vterrors.UnknownCollation, vterrors.ErrState(err))
+
+	colldata.SetFallback(func(id collations.ID, name string) (colldata.Collation, error) {
+		return &fallbackCollation{Collation: colldata.Lookup(collations.CollationLatin1Swedish), id: id, name: name}, nil
+	})
+	defer colldata.SetFallback(nil)
+
+	res, err := evaluate("_latin1'A' COLLATE latin1_german2_ci = _latin1'a'")
+	require.NoError(t, err)
+	require.Equal(t, "INT64(1)", res.String())
+
+	res, err = evaluate("_latin1'A' COLLATE latin1_german2_ci")
+	require.NoError(t, err)
+	require.Equal(t, `VARCHAR("A")`, res.String())
+
+	_, err = evaluate("'A' COLLATE latin1_german2_ci")
+	require.EqualError(t, err, "COLLATION 'latin1_german2_ci' is not valid for CHARACTER SET 'utf8mb4'")
+
+	_, err = evaluate("'A' COLLATE latin1_klingon_ci")
+	require.EqualError(t, err, "Unknown collation: 'latin1_klingon_ci'")
+}
+
+// localeVCursor is a VCursor with the given lc_time_names.
+type localeVCursor struct {
+	evalengine.VCursor
+	lcTimeNames string
+}
+
+func (vc *localeVCursor) LCTimeNames() string {
+	return vc.lcTimeNames
+}
+
+func TestCompilerTimeLocale(t *testing.T) {
+	venv := vtenv.NewTestEnv()
+	evaluate := func(expression, lcTimeNames string) (sqltypes.Value, error) {
+		expr, err := venv.Parser().ParseExpr(expression)
+		require.NoError(t, err)
+
+		fields := evalengine.FieldResolver(makeFields([]sqltypes.Value{sqltypes.NewDate("2024-03-04")}))
+		cfg := &evalengine.Config{
+			ResolveColumn:     fields.Column,
+			ResolveType:       fields.Type,
+			Collation:         collations.CollationUtf8mb4ID,
+			Environment:       venv,
+			NoConstantFolding: true,
+		}
+		converted, err := evalengine.Translate(expr, cfg)
+		require.NoError(t, err)
+
+		vc := &localeVCursor{VCursor: evalengine.NewEmptyVCursor(venv, time.UTC), lcTimeNames: lcTimeNames}
+		env := evalengine.NewExpressionEnv(context.Background(), nil, vc)
+		env.Row = []sqltypes.Value{sqltypes.NewDate("2024-03-04")}
+		expected, err := env.EvaluateAST(converted)
+		if err != nil {
+			return sqltypes.Value{}, err
+		}
+		res, err := env.Evaluate(converted)
+		require.NoError(t, err)
+		require.Equal(t, expected.String(), res.String())
+		return res.Value(collations.CollationUtf8mb4ID), nil
+	}
+
+	testCases := []struct {
+		expression  string
+		lcTimeNames string
+		result      string
+	}{
+		{`DATE_FORMAT(column0, '%W %e %M %Y')`, "", `VARCHAR("Monday 4 March 2024")`},
+		{`DATE_FORMAT(column0, '%W %e %M %Y')`, "en_US", `VARCHAR("Monday 4 March 2024")`},
+		{`DATE_FORMAT(column0, '%W %e %M %Y')`, "de_DE", `VARCHAR("Montag 4 März 2024")`},
+		{`DATE_FORMAT(column0, '%a %e %b')`, "es_ES", `VARCHAR("lun 4 mar")`},
+		{`DATE_FORMAT('0000-00-00', '%M')`, "fr_FR", `NULL`},
+		{`MONTHNAME(column0)`, "it_IT", `VARCHAR("marzo")`},
+		{`FROM_UNIXTIME(0, '%M')`, "nl_NL", `VARCHAR("januari")`},
+		{`TIME_FORMAT('100:01:02', '%H %h %i %s %p')`, "de_DE", `VARCHAR("100 04 01 02 AM")`},
+		{`TIME_FORMAT('-01:02:03', '%T')`, "", `VARCHAR("-01:02:03")`},
+		{`TIME_FORMAT('01:02:03', '%M')`, "", `NULL`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.expression+" "+tc.lcTimeNames, func(t *testing.T) {
+			res, err := evaluate(tc.expression, tc.lcTimeNames)
+			require.NoError(t, err)
+			require.Equal(t, tc.result, res.String())
+		})
+	}
+
+	// the locales that Vitess doesn't support can only format the dates without names
+	res, err := evaluate(`DATE_FORMAT(column0, '%Y-%m-%d')`, "tlh_KL")
+	require.NoError(t, err)
+	require.Equal(t, `VARCHAR("2024-03-04")`, res.String())
+	_, err = evaluate(`DATE_FORMAT(column0, '%M')`, "tlh_KL")
+	require.EqualError(t, err, "expr cannot be evaluated, not supported: unsupported lc_time_names 'tlh_KL'")
+	_, err = evaluate(`MONTHNAME(column0)`, "tlh_KL")
+	require.Error(t, err)
+
+	// the names are formatted for the session, so they are not folded when planning
+	for _, expression := range []string{`DATE_FORMAT('2024-03-04', '%W')`, `MONTHNAME('2024-03-04')`, `FROM_UNIXTIME(0, '%b')`} {
+		expr, err := venv.Parser().ParseExpr(expression)
+		require.NoError(t, err)
+		converted, err := evalengine.Translate(expr, &evalengine.Config{Collation: collations.CollationUtf8mb4ID, Environment: venv})
+		require.NoError(t, err)
+		_, folded := converted.(*evalengine.Literal)
+		require.False(t, folded, expression)
+	}
+	expr, err := venv.Parser().ParseExpr(`DATE_FORMAT('2024-03-04', '%Y')`)
+	require.NoError(t, err)
+	converted, err := evalengine.Translate(expr, &evalengine.Config{Collation: collations.CollationUtf8mb4ID, Environment: venv})
+	require.NoError(t, err)
+	_, folded := converted.(*evalengine.Literal)
+	require.True(t, folded)
+}
+
+func TestNewTypeFromField(t *testing.T) {
+	testCases := []struct {
+		field     *querypb.Field
+		collation collations.ID
+	}{
+		{&querypb.Field{Type: sqltypes.VarChar, Charset: uint32(collations.CollationUtf8mb3ID)}, collations.CollationUtf8mb3ID},
+		{&querypb.Field{Type: sqltypes.Int64, Charset: uint32(collations.CollationBinaryID)}, collations.CollationBinaryID},
+		// the fields without a charset get the collation of their type
+		{&querypb.Field{Type: sqltypes.VarChar}, collations.Unknown},
+		{&querypb.Field{Type: sqltypes.Int64}, collations.CollationBinaryID},
+		{&querypb.Field{Type: sqltypes.VarBinary}, collations.CollationBinaryID},
+		{&querypb.Field{Type: sqltypes.TypeJSON}, collations.CollationUtf8mb4ID},
+	}
+	for _, tc := range testCases {
+		typ := evalengine.NewTypeFromField(tc.field)
+		require.Equal(t, tc.collation, typ.Collation(), tc.field.String())
+	}
+}
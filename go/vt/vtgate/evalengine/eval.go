@@ -161,6 +161,14 @@ func evalIsTruthy(e eval) boolean {
 			}
 			return makeboolean(bit.i != 0)
 		}
+		if e.isBitColumn() {
+			bit, ok := e.toNumericBitColumn()
+			if !ok {
+				// overflow
+				return makeboolean(true)
+			}
+			return makeboolean(bit.u != 0)
+		}
 		f, _ := fastparse.ParseFloat64(e.string())
 		return makeboolean(f != 0.0)
 	case *evalJSON:
@@ -396,6 +404,10 @@ func valueToEval(value sqltypes.Value, collation collations.TypedCollation, valu
 		return newEvalSet(value.Raw(), values), nil
 	case tt == sqltypes.Vector:
 		return newEvalVector(value.Raw()), nil
+	case tt == sqltypes.Geometry:
+		return newEvalGeometry(value.Raw()), nil
+	case tt == sqltypes.Bit:
+		return newEvalBitColumn(value.Raw()), nil
 	case sqltypes.IsText(tt):
 		if tt == sqltypes.HexNum {
 			raw, err := parseHexNumber(value.Raw())
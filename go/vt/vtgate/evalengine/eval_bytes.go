@@ -53,6 +53,14 @@ func newEvalBytesBit(raw []byte) eval {
 	return &evalBytes{tt: int16(sqltypes.VarBinary), flag: flagBit, col: collationBinary, bytes: raw}
 }
 
+// newEvalBitColumn creates a new evalBytes for the value of a BIT(n) column.
+// Unlike bit literals, these values keep their sqltypes.Bit type: they behave
+// as binary strings in string context, and as unsigned 64-bit integers in
+// numeric context.
+func newEvalBitColumn(raw []byte) *evalBytes {
+	return newEvalRaw(sqltypes.Bit, raw, collationBinary)
+}
+
 func newEvalBinary(raw []byte) *evalBytes {
 	return newEvalRaw(sqltypes.VarBinary, raw, collationBinary)
 }
@@ -134,6 +142,10 @@ func (e *evalBytes) isHexOrBitLiteral() bool {
 	return e.isHexLiteral() || e.isBitLiteral()
 }
 
+func (e *evalBytes) isBitColumn() bool {
+	return e.SQLType() == sqltypes.Bit
+}
+
 func (e *evalBytes) isVarChar() bool {
 	return e.SQLType() == sqltypes.VarChar
 }
@@ -226,3 +238,11 @@ func (e *evalBytes) toNumericBit() (*evalInt64, bool) {
 	bit.bitLiteral = true
 	return bit, true
 }
+
+func (e *evalBytes) toNumericBitColumn() (*evalUint64, bool) {
+	var number [8]byte
+	if !e.parseNumericBytes(&number) {
+		return nil, false
+	}
+	return newEvalUint64(binary.BigEndian.Uint64(number[:])), true
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"encoding/binary"
+	"math"
+
+	"vitess.io/vitess/go/mysql/format"
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// geometryType is the type of a geometry in its WKB encoding.
+type geometryType uint32
+
+const (
+	geometryPoint geometryType = iota + 1
+	geometryLineString
+	geometryPolygon
+	geometryMultiPoint
+	geometryMultiLineString
+	geometryMultiPolygon
+	geometryCollection
+)
+
+func (t geometryType) String() string {
+	switch t {
+	case geometryPoint:
+		return "POINT"
+	case geometryLineString:
+		return "LINESTRING"
+	case geometryPolygon:
+		return "POLYGON"
+	case geometryMultiPoint:
+		return "MULTIPOINT"
+	case geometryMultiLineString:
+		return "MULTILINESTRING"
+	case geometryMultiPolygon:
+		return "MULTIPOLYGON"
+	case geometryCollection:
+		return "GEOMETRYCOLLECTION"
+	default:
+		return "GEOMETRY"
+	}
+}
+
+// The spatial reference systems that are known to the evalengine: the SRID 0
+// of the Cartesian plane, which is the default of MySQL 8.0, the WGS 84 SRID
+// of the geographic coordinates, and its Pseudo-Mercator projection.
+const (
+	sridCartesian   = 0
+	sridWGS84       = 4326
+	sridWebMercator = 3857
+	earthRadius     = 6370986
+)
+
+// geometry is a geometry value decoded from the internal format of MySQL,
+// which is the little-endian SRID of the geometry followed by its WKB.
+// Like in MySQL, the coordinates of a geographic geometry are stored in the
+// longitude-latitude order, whatever the axis order of its SRS.
+type geometry struct {
+	srid uint32
+	geom geometryShape
+}
+
+// geometryShape is a geometry without its SRID.
+type geometryShape struct {
+	typ geometryType
+	// points are the point of a Point, or the points of a LineString.
+	points []geometryCoords
+	// parts are the rings of a Polygon, which are LineStrings, or the members
+	// of a Multi* geometry or of a GeometryCollection.
+	parts []geometryShape
+}
+
+type geometryCoords struct {
+	x, y float64
+}
+
+func newEvalGeometry(raw []byte) *evalBytes {
+	return newEvalRaw(sqltypes.Geometry, raw, collationBinary)
+}
+
+// newGeometryPoint returns the internal format of a Point.
+func newGeometryPoint(srid uint32, x, y float64) []byte {
+	raw := make([]byte, 0, 25)
+	raw = binary.LittleEndian.AppendUint32(raw, srid)
+	raw = append(raw, 1)
+	raw = binary.LittleEndian.AppendUint32(raw, uint32(geometryPoint))
+	raw = binary.LittleEndian.AppendUint64(raw, math.Float64bits(x))
+	raw = binary.LittleEndian.AppendUint64(raw, math.Float64bits(y))
+	return raw
+}
+
+func errInvalidGISData(fn string) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid GIS data provided to function %s.", fn)
+}
+
+// evalToGeometry decodes the geometry of the argument of a spatial function.
+// Like in MySQL, any binary string in the internal format is a geometry.
+func evalToGeometry(e eval, fn string) (*geometry, error) {
+	var raw []byte
+	switch e := e.(type) {
+	case *evalBytes:
+		raw = e.bytes
+	default:
+		return nil, errInvalidGISData(fn)
+	}
+	if len(raw) < 4 {
+		return nil, errInvalidGISData(fn)
+	}
+	r := wkbReader{b: raw[4:]}
+	g := &geometry{srid: binary.LittleEndian.Uint32(raw)}
+	if !r.readGeometry(&g.geom, 0) || len(r.b) > 0 {
+		return nil, errInvalidGISData(fn)
+	}
+	return g, nil
+}
+
+// checkSRS returns an error if the SRS of the geometry isn't known to the
+// evalengine: as the axis order and the kind of an SRS depend on its
+// definition, the geometries of other SRSs can't be evaluated at vtgate.
+func (g *geometry) checkSRS(fn string) error {
+	switch g.srid {
+	case sridCartesian, sridWGS84, sridWebMercator:
+		return nil
+	default:
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s is not supported for the spatial reference system with SRID %d", fn, g.srid)
+	}
+}
+
+// geographic returns whether the geometry has a geographic SRS, which has
+// the latitude-longitude axis order.
+func (g *geometry) geographic() bool {
+	return g.srid == sridWGS84
+}
+
+// point returns the coordinates of a Point in its SRS axis order.
+func (g *geometry) point(fn string) (float64, float64, error) {
+	if g.geom.typ != geometryPoint {
+		return 0, 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "POINT value is a geometry of unexpected type %s in %s.", g.geom.typ, fn)
+	}
+	p := g.geom.points[0]
+	if g.geographic() {
+		return p.y, p.x, nil
+	}
+	return p.x, p.y, nil
+}
+
+// wkbReader decodes WKB geometries. Each geometry has its own byte order.
+type wkbReader struct {
+	b []byte
+}
+
+// maxGeometryDepth bounds the nesting of the GeometryCollections.
+const maxGeometryDepth = 32
+
+func (r *wkbReader) readUint32(order binary.ByteOrder) (uint32, bool) {
+	if len(r.b) < 4 {
+		return 0, false
+	}
+	v := order.Uint32(r.b)
+	r.b = r.b[4:]
+	return v, true
+}
+
+func (r *wkbReader) readCount(order binary.ByteOrder, minSize int) (int, bool) {
+	n, ok := r.readUint32(order)
+	// every element takes at least minSize bytes, so a larger count is invalid
+	// and must not be allocated
+	if !ok || uint64(n)*uint64(minSize) > uint64(len(r.b)) {
+		return 0, false
+	}
+	return int(n), true
+}
+
+func (r *wkbReader) readPoints(order binary.ByteOrder, minPoints int) ([]geometryCoords, bool) {
+	n, ok := r.readCount(order, 16)
+	if !ok || n < minPoints {
+		return nil, false
+	}
+	points := make([]geometryCoords, n)
+	for i := range points {
+		points[i].x = math.Float64frombits(order.Uint64(r.b))
+		points[i].y = math.Float64frombits(order.Uint64(r.b[8:]))
+		r.b = r.b[16:]
+		if !validCoordinate(points[i].x) || !validCoordinate(points[i].y) {
+			return nil, false
+		}
+	}
+	return points, true
+}
+
+func validCoordinate(f float64) bool {
+	return !math.IsNaN(f) && !math.IsInf(f, 0)
+}
+
+func (r *wkbReader) readGeometry(g *geometryShape, depth int) bool {
+	if len(r.b) < 5 || depth > maxGeometryDepth {
+		return false
+	}
+	var order binary.ByteOrder
+	switch r.b[0] {
+	case 0:
+		order = binary.BigEndian
+	case 1:
+		order = binary.LittleEndian
+	default:
+		return false
+	}
+	g.typ = geometryType(order.Uint32(r.b[1:]))
+	r.b = r.b[5:]
+
+	var ok bool
+	switch g.typ {
+	case geometryPoint:
+		if len(r.b) < 16 {
+			return false
+		}
+		p := geometryCoords{
+			x: math.Float64frombits(order.Uint64(r.b)),
+			y: math.Float64frombits(order.Uint64(r.b[8:])),
+		}
+		r.b = r.b[16:]
+		g.points = []geometryCoords{p}
+		return validCoordinate(p.x) && validCoordinate(p.y)
+	case geometryLineString:
+		g.points, ok = r.readPoints(order, 2)
+		return ok
+	case geometryPolygon:
+		n, ok := r.readCount(order, 4)
+		if !ok || n == 0 {
+			return false
+		}
+		g.parts = make([]geometryShape, n)
+		for i := range g.parts {
+			g.parts[i].typ = geometryLineString
+			if g.parts[i].points, ok = r.readPoints(order, 4); !ok {
+				return false
+			}
+		}
+		return true
+	case geometryMultiPoint, geometryMultiLineString, geometryMultiPolygon, geometryCollection:
+		n, ok := r.readCount(order, 5)
+		if !ok || (n == 0 && g.typ != geometryCollection) {
+			return false
+		}
+		g.parts = make([]geometryShape, n)
+		for i := range g.parts {
+			if !r.readGeometry(&g.parts[i], depth+1) {
+				return false
+			}
+			if g.typ != geometryCollection && g.parts[i].typ != g.typ-3 {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// appendWKT appends the WKT of the geometry in the format of MySQL 8.0, with
+// the coordinates in the axis order of its SRS.
+func (g *geometry) appendWKT(buf []byte) []byte {
+	return g.geom.appendWKT(buf, g.geographic(), true)
+}
+
+func (g *geometryShape) appendWKT(buf []byte, swapXY, tagged bool) []byte {
+	if tagged {
+		buf = append(buf, g.typ.String()...)
+	}
+	switch g.typ {
+	case geometryPoint, geometryLineString:
+		buf = append(buf, '(')
+		for i, p := range g.points {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			x, y := p.x, p.y
+			if swapXY {
+				x, y = y, x
+			}
+			buf = append(buf, format.FormatFloat(x)...)
+			buf = append(buf, ' ')
+			buf = append(buf, format.FormatFloat(y)...)
+		}
+		return append(buf, ')')
+	default:
+		if g.typ == geometryCollection && len(g.parts) == 0 {
+			return append(buf, " EMPTY"...)
+		}
+		buf = append(buf, '(')
+		for i := range g.parts {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			// only the members of a GeometryCollection are tagged with their type
+			buf = g.parts[i].appendWKT(buf, swapXY, g.typ == geometryCollection)
+		}
+		return append(buf, ')')
+	}
+}
+
+// distanceSphere returns the distance between two points on a sphere of
+// the given radius, with the haversine formula that MySQL uses.
+func distanceSphere(p1, p2 geometryCoords, radius float64) float64 {
+	lon1, lat1 := p1.x*math.Pi/180, p1.y*math.Pi/180
+	lon2, lat2 := p2.x*math.Pi/180, p2.y*math.Pi/180
+	dlat := math.Sin((lat2 - lat1) / 2)
+	dlon := math.Sin((lon2 - lon1) / 2)
+	a := dlat*dlat + math.Cos(lat1)*math.Cos(lat2)*dlon*dlon
+	return 2 * radius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
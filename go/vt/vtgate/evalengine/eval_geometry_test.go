@@ -0,0 +1,180 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func wkbHeader(order byte, typ geometryType) []byte {
+	if order == 0 {
+		return binary.BigEndian.AppendUint32([]byte{0}, uint32(typ))
+	}
+	return binary.LittleEndian.AppendUint32([]byte{1}, uint32(typ))
+}
+
+func wkbUint32(n int) []byte {
+	return binary.LittleEndian.AppendUint32(nil, uint32(n))
+}
+
+func wkbCoords(coords ...float64) []byte {
+	var b []byte
+	for _, c := range coords {
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(c))
+	}
+	return b
+}
+
+func wkbPoint(x, y float64) []byte {
+	return append(wkbHeader(1, geometryPoint), wkbCoords(x, y)...)
+}
+
+func wkbLineString(coords ...float64) []byte {
+	b := append(wkbHeader(1, geometryLineString), wkbUint32(len(coords)/2)...)
+	return append(b, wkbCoords(coords...)...)
+}
+
+func wkbPolygon(rings ...[]float64) []byte {
+	b := append(wkbHeader(1, geometryPolygon), wkbUint32(len(rings))...)
+	for _, ring := range rings {
+		b = append(b, wkbUint32(len(ring)/2)...)
+		b = append(b, wkbCoords(ring...)...)
+	}
+	return b
+}
+
+func wkbMulti(typ geometryType, members ...[]byte) []byte {
+	b := append(wkbHeader(1, typ), wkbUint32(len(members))...)
+	for _, m := range members {
+		b = append(b, m...)
+	}
+	return b
+}
+
+func withSRID(srid uint32, wkb []byte) *evalBytes {
+	return newEvalGeometry(append(binary.LittleEndian.AppendUint32(nil, srid), wkb...))
+}
+
+func TestGeometryWKT(t *testing.T) {
+	square := []float64{0, 0, 10, 0, 10, 10, 0, 10, 0, 0}
+	hole := []float64{2, 2, 4, 2, 4, 4, 2, 2}
+	bigEndianPoint := binary.BigEndian.AppendUint64(wkbHeader(0, geometryPoint), math.Float64bits(1.5))
+	bigEndianPoint = binary.BigEndian.AppendUint64(bigEndianPoint, math.Float64bits(-2))
+
+	tcs := []struct {
+		name string
+		srid uint32
+		wkb  []byte
+		wkt  string
+	}{
+		{name: "point", wkb: wkbPoint(1, 2), wkt: "POINT(1 2)"},
+		{name: "big endian point", wkb: bigEndianPoint, wkt: "POINT(1.5 -2)"},
+		{name: "geographic point", srid: sridWGS84, wkb: wkbPoint(-3.7038, 40.4168), wkt: "POINT(40.4168 -3.7038)"},
+		{name: "projected point", srid: sridWebMercator, wkb: wkbPoint(1e20, 0.1), wkt: "POINT(1e20 0.1)"},
+		{name: "linestring", wkb: wkbLineString(0, 0, 1, 1, 2, 0.5), wkt: "LINESTRING(0 0,1 1,2 0.5)"},
+		{name: "polygon", wkb: wkbPolygon(square, hole), wkt: "POLYGON((0 0,10 0,10 10,0 10,0 0),(2 2,4 2,4 4,2 2))"},
+		{name: "multipoint", wkb: wkbMulti(geometryMultiPoint, wkbPoint(1, 1), wkbPoint(2, 2)), wkt: "MULTIPOINT((1 1),(2 2))"},
+		{
+			name: "multilinestring",
+			wkb:  wkbMulti(geometryMultiLineString, wkbLineString(0, 0, 1, 1), wkbLineString(2, 2, 3, 3)),
+			wkt:  "MULTILINESTRING((0 0,1 1),(2 2,3 3))",
+		},
+		{
+			name: "multipolygon",
+			wkb:  wkbMulti(geometryMultiPolygon, wkbPolygon(square), wkbPolygon(hole)),
+			wkt:  "MULTIPOLYGON(((0 0,10 0,10 10,0 10,0 0)),((2 2,4 2,4 4,2 2)))",
+		},
+		{
+			name: "geometrycollection",
+			wkb:  wkbMulti(geometryCollection, wkbPoint(1, 1), wkbMulti(geometryMultiPoint, wkbPoint(2, 2)), wkbMulti(geometryCollection)),
+			wkt:  "GEOMETRYCOLLECTION(POINT(1 1),MULTIPOINT((2 2)),GEOMETRYCOLLECTION EMPTY)",
+		},
+		{name: "empty geometrycollection", wkb: wkbMulti(geometryCollection), wkt: "GEOMETRYCOLLECTION EMPTY"},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			g, err := evalToGeometry(withSRID(tc.srid, tc.wkb), "st_astext")
+			require.NoError(t, err)
+			assert.Equal(t, tc.srid, g.srid)
+			assert.Equal(t, tc.wkt, string(g.appendWKT(nil)))
+		})
+	}
+}
+
+func TestGeometryInvalidWKB(t *testing.T) {
+	point := wkbPoint(1, 2)
+	tcs := []struct {
+		name string
+		raw  []byte
+	}{
+		{name: "no srid", raw: []byte{0, 0}},
+		{name: "no wkb", raw: wkbUint32(0)},
+		{name: "truncated point", raw: append(wkbUint32(0), point[:len(point)-1]...)},
+		{name: "trailing bytes", raw: append(append(wkbUint32(0), point...), 0)},
+		{name: "invalid byte order", raw: append(wkbUint32(0), append([]byte{2}, point[1:]...)...)},
+		{name: "invalid type", raw: append(wkbUint32(0), wkbMulti(8)...)},
+		{name: "nan coordinate", raw: append(wkbUint32(0), wkbPoint(math.NaN(), 0)...)},
+		{name: "single point linestring", raw: append(wkbUint32(0), wkbLineString(0, 0)...)},
+		{name: "short polygon ring", raw: append(wkbUint32(0), wkbPolygon([]float64{0, 0, 1, 1, 0, 0})...)},
+		{name: "empty multipoint", raw: append(wkbUint32(0), wkbMulti(geometryMultiPoint)...)},
+		{name: "multipoint of linestrings", raw: append(wkbUint32(0), wkbMulti(geometryMultiPoint, wkbLineString(0, 0, 1, 1))...)},
+		{name: "huge count", raw: append(wkbUint32(0), append(wkbHeader(1, geometryLineString), 0xff, 0xff, 0xff, 0xff)...)},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := evalToGeometry(newEvalGeometry(tc.raw), "st_astext")
+			assert.EqualError(t, err, "Invalid GIS data provided to function st_astext.")
+		})
+	}
+
+	_, err := evalToGeometry(newEvalInt64(1), "st_x")
+	assert.EqualError(t, err, "Invalid GIS data provided to function st_x.")
+}
+
+func TestSpatialFunctionErrors(t *testing.T) {
+	line := withSRID(0, wkbLineString(0, 0, 1, 1))
+
+	_, err := pointCoordinate(line, "st_x", false)
+	assert.EqualError(t, err, "POINT value is a geometry of unexpected type LINESTRING in st_x.")
+
+	_, err = pointCoordinate(withSRID(2000, wkbPoint(1, 2)), "st_y", true)
+	assert.EqualError(t, err, "st_y is not supported for the spatial reference system with SRID 2000")
+
+	_, err = stDistanceSphere(withSRID(0, wkbPoint(0, 0)), withSRID(sridWGS84, wkbPoint(0, 0)), earthRadius)
+	assert.EqualError(t, err, "Binary geometry function st_distance_sphere given two geometries of different srids: 0 and 4326, which should have been identical.")
+
+	_, err = stDistanceSphere(withSRID(sridWebMercator, wkbPoint(0, 0)), withSRID(sridWebMercator, wkbPoint(0, 0)), earthRadius)
+	assert.EqualError(t, err, "st_distance_sphere(POINT, POINT) has not been implemented for projected spatial reference systems.")
+
+	_, err = stDistanceSphere(line, withSRID(0, wkbPoint(0, 0)), earthRadius)
+	assert.EqualError(t, err, "Calling geometry function st_distance_sphere with unsupported types of arguments.")
+
+	_, err = stDistanceSphere(withSRID(0, wkbPoint(0, 0)), withSRID(0, wkbPoint(0, 0)), 0)
+	assert.EqualError(t, err, "Invalid radius provided to function st_distance_sphere: Radius must be greater than zero.")
+
+	_, err = stDistanceSphere(withSRID(0, wkbPoint(-180, 0)), withSRID(0, wkbPoint(0, 0)), earthRadius)
+	assert.EqualError(t, err, "Longitude -180.000000 is out of range in function st_distance_sphere. It must be within (-180.000000, 180.000000].")
+
+	// the latitude of a geographic point is its Y coordinate in the internal format
+	_, err = stDistanceSphere(withSRID(sridWGS84, wkbPoint(0, 0)), withSRID(sridWGS84, wkbPoint(0, 91)), earthRadius)
+	assert.EqualError(t, err, "Latitude 91.000000 is out of range in function st_distance_sphere. It must be within [-90.000000, 90.000000].")
+}
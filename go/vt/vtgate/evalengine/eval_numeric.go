@@ -131,6 +131,14 @@ func evalToNumeric(e eval, preciseDatetime bool) evalNumeric {
 			}
 			return bit
 		}
+		if e.isBitColumn() {
+			bit, ok := e.toNumericBitColumn()
+			if !ok {
+				// overflow
+				return newEvalFloat(0)
+			}
+			return bit
+		}
 		f, _ := fastparse.ParseFloat64(e.string())
 		return &evalFloat{f: f}
 	case *evalJSON:
@@ -166,6 +174,16 @@ func evalToNumeric(e eval, preciseDatetime bool) evalNumeric {
 	}
 }
 
+// evalTemporalToNumeric converts a temporal argument of a numeric function to
+// the number MySQL uses for it: an integer, or a decimal when the temporal has
+// fractional seconds. Any other argument is returned as-is.
+func evalTemporalToNumeric(e eval) eval {
+	if t, ok := e.(*evalTemporal); ok {
+		return evalToNumeric(t, true)
+	}
+	return e
+}
+
 func evalToFloat(e eval) (*evalFloat, bool) {
 	switch e := e.(type) {
 	case *evalFloat:
@@ -197,6 +215,14 @@ func evalToFloat(e eval) (*evalFloat, bool) {
 			}
 			return f, true
 		}
+		if e.isBitColumn() {
+			bit, ok := e.toNumericBitColumn()
+			if !ok {
+				// overflow
+				return newEvalFloat(0), false
+			}
+			return bit.toFloat()
+		}
 		val, err := fastparse.ParseFloat64(e.string())
 		return &evalFloat{f: val}, err == nil
 	case *evalJSON:
@@ -247,6 +273,21 @@ func evalToDecimal(e eval, m, d int32) *evalDecimal {
 			}
 			return bit.toDecimal(m, d)
 		}
+		if e.isBitColumn() {
+			bit, ok := e.toNumericBitColumn()
+			if !ok {
+				// overflow
+				return newEvalDecimal(decimal.Zero, m, d)
+			}
+			return bit.toDecimal(m, d)
+		}
+		if m != 0 {
+			// the strings in MySQL format are rounded and clamped to the
+			// precision and scale while they are parsed
+			if dec, _, _, err := decimal.ParseForColumn(e.bytes, m, d); err == nil {
+				return newEvalDecimalWithPrec(dec, d)
+			}
+		}
 		dec, _ := decimal.NewFromString(e.string())
 		return newEvalDecimal(dec, m, d)
 	case *evalJSON:
@@ -317,6 +358,14 @@ func evalToInt64(e eval) *evalInt64 {
 			}
 			return bit
 		}
+		if e.isBitColumn() {
+			bit, ok := e.toNumericBitColumn()
+			if !ok {
+				// overflow
+				return newEvalInt64(0)
+			}
+			return bit.toInt64()
+		}
 		i, _ := fastparse.ParseInt64(e.string(), 10)
 		return newEvalInt64(i)
 	case *evalJSON:
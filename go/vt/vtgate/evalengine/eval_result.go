@@ -22,6 +22,7 @@ import (
 	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/mysql/collations/charset"
 	"vitess.io/vitess/go/mysql/collations/colldata"
+	"vitess.io/vitess/go/mysql/decimal"
 	"vitess.io/vitess/go/sqltypes"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -52,6 +53,31 @@ func (er EvalResult) Value(id collations.ID) sqltypes.Value {
 	return sqltypes.MakeTrusted(str.SQLType(), dst)
 }
 
+// decimalBufferSize is the size of the buffers that AppendValue allocates for
+// the DECIMAL results.
+const decimalBufferSize = 1024
+
+// AppendValue is like Value, but a DECIMAL result is formatted by appending it to
+// buf, and the returned value points into the extended buffer, which is returned
+// too. If buf doesn't have room for the result, a new buffer is allocated instead,
+// with room for many more. The DECIMAL results of many rows can then share the
+// same buffers, instead of each of them being allocated. The bytes already in buf
+// are never modified, and it is returned unchanged for the other results.
+func (er EvalResult) AppendValue(buf []byte, id collations.ID) (sqltypes.Value, []byte) {
+	dec, ok := er.v.(*evalDecimal)
+	if !ok {
+		return er.Value(id), buf
+	}
+	// A DECIMAL has at most MyMaxPrecision digits, a sign, a period and a
+	// leading zero.
+	if cap(buf)-len(buf) < decimal.MyMaxPrecision+3 {
+		buf = make([]byte, 0, decimalBufferSize)
+	}
+	start := len(buf)
+	buf = dec.dec.AppendFormat(buf, dec.length)
+	return sqltypes.MakeTrusted(sqltypes.Decimal, buf[start:len(buf):len(buf)]), buf
+}
+
 func (er EvalResult) Collation() collations.ID {
 	return evalCollation(er.v).Collation
 }
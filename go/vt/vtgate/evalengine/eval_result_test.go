@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/mysql/decimal"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+var decimalResults = []EvalResult{
+	{v: newEvalDecimal(decimal.RequireFromString("1234567890.1234"), 14, 4)},
+	{v: newEvalDecimal(decimal.RequireFromString("-0.5"), 14, 4)},
+	{v: newEvalDecimal(decimal.RequireFromString("42"), 10, 0)},
+	{v: newEvalDecimal(decimal.RequireFromString("99999999999999999999999999999999999.999999999999999999999999999999"), 65, 30)},
+}
+
+func TestEvalResultAppendValue(t *testing.T) {
+	coll := collations.CollationUtf8mb4ID
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "prefix"...)
+
+	var values []sqltypes.Value
+	for _, er := range decimalResults {
+		var v sqltypes.Value
+		v, buf = er.AppendValue(buf, coll)
+		assert.Equal(t, er.Value(coll), v)
+		values = append(values, v)
+	}
+	assert.Equal(t, "prefix", string(buf[:6]))
+
+	// Appending to a value must not overwrite the next one.
+	_ = append(values[0].Raw(), "overwritten"...)
+	assert.Equal(t, decimalResults[1].Value(coll), values[1])
+
+	// The results of the other types are returned with Value, and the buffer
+	// is returned unchanged.
+	er := EvalResult{v: newEvalInt64(42)}
+	v, out := er.AppendValue(buf, coll)
+	assert.Equal(t, sqltypes.NewInt64(42), v)
+	assert.Equal(t, len(buf), len(out))
+}
+
+func BenchmarkEvalResultDecimalValue(b *testing.B) {
+	coll := collations.CollationUtf8mb4ID
+
+	b.Run("Value", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, er := range decimalResults {
+				_ = er.Value(coll)
+			}
+		}
+	})
+
+	b.Run("AppendValue", func(b *testing.B) {
+		b.ReportAllocs()
+		var buf []byte
+		for i := 0; i < b.N; i++ {
+			for _, er := range decimalResults {
+				_, buf = er.AppendValue(buf, coll)
+			}
+		}
+	})
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package evalengine
 
 import (
+	"vitess.io/vitess/go/mysql/decimal"
 	"vitess.io/vitess/go/sqltypes"
 )
 
@@ -63,7 +64,20 @@ func (b *ArithmeticExpr) eval(env *ExpressionEnv) (eval, error) {
 	if right == nil || err != nil {
 		return nil, err
 	}
-	return b.Op.eval(left, right)
+
+	typ := "DOUBLE"
+	if _, ok := b.Op.(*opArithIntDiv); ok {
+		typ = "DECIMAL"
+	}
+	env.warnTruncatedNumber(left, typ)
+	env.warnTruncatedNumber(right, typ)
+
+	result, err := b.Op.eval(left, right)
+	if result == nil && err == nil {
+		// the division operators return NULL when dividing by zero
+		env.warnDivisionByZero()
+	}
+	return result, err
 }
 
 func (b *ArithmeticExpr) compile(c *compiler) (ctype, error) {
@@ -90,6 +104,7 @@ func (op *opArithAdd) compile(c *compiler, left, right IR) (ctype, error) {
 	swap := false
 	skip2 := c.compileNullCheck1r(rt)
 
+	c.compileNumericTruncation(lt, rt, "DOUBLE")
 	lt = c.compileToNumeric(lt, 2, sqltypes.Float64, true)
 	rt = c.compileToNumeric(rt, 1, sqltypes.Float64, true)
 	lt, rt, swap = c.compileNumericPriority(lt, rt)
@@ -150,6 +165,7 @@ func (op *opArithSub) compile(c *compiler, left, right IR) (ctype, error) {
 	}
 
 	skip2 := c.compileNullCheck1r(rt)
+	c.compileNumericTruncation(lt, rt, "DOUBLE")
 	lt = c.compileToNumeric(lt, 2, sqltypes.Float64, true)
 	rt = c.compileToNumeric(rt, 1, sqltypes.Float64, true)
 
@@ -240,6 +256,7 @@ func (op *opArithMul) compile(c *compiler, left, right IR) (ctype, error) {
 
 	swap := false
 	skip2 := c.compileNullCheck1r(rt)
+	c.compileNumericTruncation(lt, rt, "DOUBLE")
 	lt = c.compileToNumeric(lt, 2, sqltypes.Float64, true)
 	rt = c.compileToNumeric(rt, 1, sqltypes.Float64, true)
 	lt, rt, swap = c.compileNumericPriority(lt, rt)
@@ -273,8 +290,8 @@ func (op *opArithMul) compile(c *compiler, left, right IR) (ctype, error) {
 		}
 		c.asm.Mul_dd()
 		ct.Type = sqltypes.Decimal
-		ct.Size = lt.Size + rt.Size
-		ct.Scale = lt.Scale + rt.Scale
+		ct.Size = min(lt.Size+rt.Size, decimal.MyMaxPrecision)
+		ct.Scale = min(lt.Scale+rt.Scale, decimal.MyMaxScale)
 	}
 
 	c.asm.jumpDestination(skip1, skip2)
@@ -300,6 +317,7 @@ func (op *opArithDiv) compile(c *compiler, left, right IR) (ctype, error) {
 	}
 	skip2 := c.compileNullCheck1r(rt)
 
+	c.compileNumericTruncation(lt, rt, "DOUBLE")
 	lt = c.compileToNumeric(lt, 2, sqltypes.Float64, true)
 	rt = c.compileToNumeric(rt, 1, sqltypes.Float64, true)
 
@@ -314,9 +332,10 @@ func (op *opArithDiv) compile(c *compiler, left, right IR) (ctype, error) {
 		c.compileToDecimal(lt, 2)
 		c.compileToDecimal(rt, 1)
 		c.asm.Div_dd()
-		ct.Size = lt.Size + divPrecisionIncrement
-		ct.Scale = lt.Scale + divPrecisionIncrement
+		ct.Size = min(lt.Size+divPrecisionIncrement, decimal.MyMaxPrecision)
+		ct.Scale = min(lt.Scale+divPrecisionIncrement, decimal.MyMaxScale)
 	}
+	c.asm.Warn_divisionByZero()
 	c.asm.jumpDestination(skip1, skip2)
 	return ct, nil
 }
@@ -340,6 +359,7 @@ func (op *opArithIntDiv) compile(c *compiler, left, right IR) (ctype, error) {
 	}
 
 	skip2 := c.compileNullCheck1r(rt)
+	c.compileNumericTruncation(lt, rt, "DECIMAL")
 	lt = c.compileToNumeric(lt, 2, sqltypes.Decimal, true)
 	rt = c.compileToNumeric(rt, 1, sqltypes.Decimal, true)
 
@@ -403,6 +423,7 @@ func (op *opArithIntDiv) compile(c *compiler, left, right IR) (ctype, error) {
 			c.asm.IntDiv_di()
 		}
 	}
+	c.asm.Warn_divisionByZero()
 	c.asm.jumpDestination(skip1, skip2)
 	return ct, nil
 }
@@ -426,6 +447,7 @@ func (op *opArithMod) compile(c *compiler, left, right IR) (ctype, error) {
 	}
 
 	skip2 := c.compileNullCheck1r(rt)
+	c.compileNumericTruncation(lt, rt, "DOUBLE")
 	lt = c.compileToNumeric(lt, 2, sqltypes.Float64, true)
 	rt = c.compileToNumeric(rt, 1, sqltypes.Float64, true)
 
@@ -484,6 +506,7 @@ func (op *opArithMod) compile(c *compiler, left, right IR) (ctype, error) {
 		c.asm.Mod_ff()
 	}
 
+	c.asm.Warn_divisionByZero()
 	c.asm.jumpDestination(skip1, skip2)
 	return ct, nil
 }
@@ -496,7 +519,7 @@ func (n *NegateExpr) eval(env *ExpressionEnv) (eval, error) {
 	if e == nil {
 		return nil, nil
 	}
-	return evalToNumeric(e, false).negate(), nil
+	return evalToNumeric(e, true).negate(), nil
 }
 
 func (expr *NegateExpr) compile(c *compiler) (ctype, error) {
@@ -506,7 +529,7 @@ func (expr *NegateExpr) compile(c *compiler) (ctype, error) {
 	}
 
 	skip := c.compileNullCheck1(arg)
-	arg = c.compileToNumeric(arg, 1, sqltypes.Float64, false)
+	arg = c.compileToNumeric(arg, 1, sqltypes.Float64, true)
 	var neg sqltypes.Type
 
 	switch arg.Type {
@@ -162,6 +162,10 @@ func (bvar *BindVariable) compile(c *compiler) (ctype, error) {
 		c.asm.PushBVar_time(bvar.Key)
 	case tt == sqltypes.Vector:
 		c.asm.PushBVar_vector(bvar.Key)
+	case tt == sqltypes.Geometry:
+		c.asm.PushBVar_geometry(bvar.Key)
+	case tt == sqltypes.Bit:
+		c.asm.PushBVar_bit(bvar.Key)
 	default:
 		return ctype{}, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "Type is not supported: %s", tt)
 	}
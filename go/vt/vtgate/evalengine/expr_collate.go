@@ -44,7 +44,7 @@ var collationBinary = collations.TypedCollation{
 }
 
 var collationJSON = collations.TypedCollation{
-	Collation:    46, // utf8mb4_bin
+	Collation:    collations.CollationUtf8mb4BinID,
 	Coercibility: collations.CoerceImplicit,
 	Repertoire:   collations.RepertoireUnicode,
 }
@@ -76,7 +76,7 @@ func (c *Column) typeof(env *ExpressionEnv) (ctype, error) {
 
 		return ctype{
 			Type:  field.Type,
-			Col:   typedCoercionCollation(field.Type, collations.ID(field.Charset)),
+			Col:   typedCoercionCollation(field.Type, collations.CollationForField(field)),
 			Flag:  f,
 			Size:  int32(field.ColumnLength),
 			Scale: int32(field.Decimals),
@@ -153,6 +153,10 @@ func (column *Column) compile(c *compiler) (ctype, error) {
 		c.asm.PushColumn_time(column.Offset)
 	case tt == sqltypes.Vector:
 		c.asm.PushColumn_vector(column.Offset)
+	case tt == sqltypes.Geometry:
+		c.asm.PushColumn_geometry(column.Offset)
+	case tt == sqltypes.Bit:
+		c.asm.PushColumn_bit(column.Offset)
 	default:
 		return ctype{}, vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "Type is not supported: %s", tt)
 	}
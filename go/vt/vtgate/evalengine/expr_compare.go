@@ -21,6 +21,7 @@ import (
 
 	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/mysql/collations/colldata"
+	"vitess.io/vitess/go/mysql/decimal"
 	"vitess.io/vitess/go/sqltypes"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -350,6 +351,19 @@ func (expr *ComparisonExpr) compileAsTuple(c *compiler) (ctype, error) {
 	return ctype{Type: sqltypes.Int64, Flag: flagNullable | flagIsBoolean, Col: collationNumeric}, nil
 }
 
+// decimalLiteral returns the value of expr if it's a decimal literal.
+func decimalLiteral(expr IR) (decimal.Decimal, bool) {
+	lit, ok := expr.(*Literal)
+	if !ok {
+		return decimal.Decimal{}, false
+	}
+	dec, ok := lit.inner.(*evalDecimal)
+	if !ok {
+		return decimal.Decimal{}, false
+	}
+	return dec.dec, true
+}
+
 func (expr *ComparisonExpr) compile(c *compiler) (ctype, error) {
 	lt, err := expr.Left.compile(c)
 	if err != nil {
@@ -396,6 +410,18 @@ func (expr *ComparisonExpr) compile(c *compiler) (ctype, error) {
 			return ctype{}, err
 		}
 	case compareAsSameNumericType(lt.Type, rt.Type) || compareAsDecimal(lt.Type, rt.Type):
+		if lt.Type == sqltypes.Decimal && rt.Type == sqltypes.Decimal {
+			// A decimal literal is usually compared with the values of many
+			// rows, so it's prepared once for all its comparisons.
+			if dec, ok := decimalLiteral(expr.Right); ok {
+				c.asm.CmpNum_dd_literal(dec.Prepare(), false)
+				break
+			}
+			if dec, ok := decimalLiteral(expr.Left); ok {
+				c.asm.CmpNum_dd_literal(dec.Prepare(), true)
+				break
+			}
+		}
 		swapped = c.compareNumericTypes(lt, rt)
 	case compareAsDateAndString(lt.Type, rt.Type):
 		c.asm.CmpDateString()
@@ -565,7 +591,19 @@ func (expr *InExpr) compile(c *compiler) (ctype, error) {
 		return ctype{}, nil
 	}
 
-	switch rhs := expr.Right.(type) {
+	right := expr.Right
+	if lit, ok := right.(*Literal); ok {
+		// a tuple of constants is folded into a single literal when translating
+		if tuple, ok := lit.inner.(*evalTuple); ok {
+			rhs := make(TupleExpr, 0, len(tuple.t))
+			for _, e := range tuple.t {
+				rhs = append(rhs, &Literal{inner: e})
+			}
+			right = rhs
+		}
+	}
+
+	switch rhs := right.(type) {
 	case TupleExpr:
 		var rt ctype
 		if table := expr.compileTable(lhs, rhs); table != nil {
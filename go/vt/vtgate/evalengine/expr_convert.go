@@ -129,11 +129,13 @@ func (c *ConvertExpr) eval(env *ExpressionEnv) (eval, error) {
 		if dt := evalToDateTime(e, p, env.now, env.sqlmode.AllowZeroDate()); dt != nil {
 			return dt, nil
 		}
+		env.warnIncorrectTemporal(e, sqltypes.Datetime)
 		return nil, nil
 	case "DATE":
 		if d := evalToDate(e, env.now, env.sqlmode.AllowZeroDate()); d != nil {
 			return d, nil
 		}
+		env.warnIncorrectTemporal(e, sqltypes.Date)
 		return nil, nil
 	case "TIME":
 		p := ptr.Unwrap(c.Length, 0)
@@ -143,6 +145,7 @@ func (c *ConvertExpr) eval(env *ExpressionEnv) (eval, error) {
 		if t := evalToTime(e, p); t != nil {
 			return t, nil
 		}
+		env.warnIncorrectTemporal(e, sqltypes.Time)
 		return nil, nil
 	case "YEAR":
 		return nil, c.returnUnsupportedError()
@@ -221,21 +224,36 @@ func (conv *ConvertExpr) compile(c *compiler) (ctype, error) {
 		}
 
 	case "DATE":
-		convt = c.compileToDate(arg, 1)
+		if arg.Type == sqltypes.Date {
+			convt = arg
+		} else {
+			c.asm.Cast_xD(1, c.sqlmode.AllowZeroDate())
+			convt = ctype{Type: sqltypes.Date, Col: collationBinary, Flag: flagNullable}
+		}
 
 	case "DATETIME":
 		p := ptr.Unwrap(conv.Length, 0)
 		if p > 6 {
 			return ctype{}, c.unsupported(conv)
 		}
-		convt = c.compileToDateTime(arg, 1, p)
+		if arg.Type == sqltypes.Datetime {
+			convt = c.compileToDateTime(arg, 1, p)
+		} else {
+			c.asm.Cast_xDT(1, p, c.sqlmode.AllowZeroDate())
+			convt = ctype{Type: sqltypes.Datetime, Size: int32(p), Col: collationBinary, Flag: flagNullable}
+		}
 
 	case "TIME":
 		p := ptr.Unwrap(conv.Length, 0)
 		if p > 6 {
 			return ctype{}, c.unsupported(conv)
 		}
-		convt = c.compileToTime(arg, 1, p)
+		if arg.Type == sqltypes.Time {
+			convt = c.compileToTime(arg, 1, p)
+		} else {
+			c.asm.Cast_xT(1, p)
+			convt = ctype{Type: sqltypes.Time, Size: int32(p), Col: collationBinary, Flag: flagNullable}
+		}
 
 	default:
 		return ctype{}, c.unsupported(conv)
@@ -18,22 +18,32 @@ package evalengine
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/mysql/config"
 	"vitess.io/vitess/go/mysql/datetime"
+	"vitess.io/vitess/go/mysql/fastparse"
+	"vitess.io/vitess/go/mysql/sqlerror"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/callerid"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vtenv"
+	"vitess.io/vitess/go/vt/vterrors"
 )
 
 type VCursor interface {
 	TimeZone() *time.Location
 	GetKeyspace() string
 	SQLMode() string
+	// LCTimeNames returns the lc_time_names of the session, or an empty
+	// string for the default locale.
+	LCTimeNames() string
 	Environment() *vtenv.Environment
 }
 
@@ -53,9 +63,66 @@ type (
 		user         *querypb.VTGateCallerID
 		sqlmode      SQLMode
 		collationEnv *collations.Environment
+		warnings     []*querypb.QueryWarning
 	}
 )
 
+// maxWarnings is the maximum number of warnings kept by an ExpressionEnv,
+// like the default value of max_error_count in MySQL.
+const maxWarnings = 1024
+
+// warn records a warning for the current evaluation. Like MySQL, expressions
+// that can't be evaluated because of invalid data, such as a division by zero
+// or a string that isn't a number, return NULL or a truncated value and raise
+// a warning instead of failing the query.
+func (env *ExpressionEnv) warn(code sqlerror.ErrorCode, format string, args ...any) {
+	if len(env.warnings) >= maxWarnings {
+		return
+	}
+	env.warnings = append(env.warnings, &querypb.QueryWarning{
+		Code:    uint32(code),
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (env *ExpressionEnv) warnDivisionByZero() {
+	env.warn(sqlerror.ERDivisionByZero, "Division by 0")
+}
+
+// warnTruncatedNumber raises a warning if e is a string that can't be fully
+// converted to a number of type typ, e.g. '12abc'.
+func (env *ExpressionEnv) warnTruncatedNumber(e eval, typ string) {
+	b, ok := e.(*evalBytes)
+	if !ok || b.isHexOrBitLiteral() || b.isBitColumn() {
+		return
+	}
+	if _, err := fastparse.ParseFloat64(b.string()); err != nil && !errors.Is(err, strconv.ErrRange) {
+		env.warn(sqlerror.ERTruncatedWrongValue, "Truncated incorrect %s value: '%s'", typ, b.string())
+	}
+}
+
+// warnIncorrectTemporal raises the warning for a value that can't be converted
+// to a temporal type, e.g. '2024-13-01' to a DATE.
+func (env *ExpressionEnv) warnIncorrectTemporal(e eval, tt sqltypes.Type) {
+	v := evalToSQLValue(e).ToString()
+	if tt == sqltypes.Time {
+		env.warn(sqlerror.ERTruncatedWrongValue, "Truncated incorrect time value: '%s'", v)
+		return
+	}
+	env.warn(sqlerror.ERTruncatedWrongValue, "Incorrect datetime value: '%s'", v)
+}
+
+// Warnings returns the warnings raised by the evaluations with this ExpressionEnv
+// since the last call to ClearWarnings.
+func (env *ExpressionEnv) Warnings() []*querypb.QueryWarning {
+	return env.warnings
+}
+
+// ClearWarnings discards the warnings raised by the previous evaluations.
+func (env *ExpressionEnv) ClearWarnings() {
+	env.warnings = nil
+}
+
 func (env *ExpressionEnv) time(utc bool) datetime.DateTime {
 	if utc {
 		return datetime.NewDateTimeFromStd(env.now.UTC())
@@ -86,6 +153,30 @@ func (env *ExpressionEnv) currentTimezone() *time.Location {
 	return env.vc.TimeZone()
 }
 
+// timeLocale returns the locale of the names of the months and days, from the
+// lc_time_names of the session. It must only be resolved for the expressions
+// that format such names, so that a session with an lc_time_names that is not
+// supported can still evaluate all the others.
+func (env *ExpressionEnv) timeLocale() (*datetime.Locale, error) {
+	name := env.vc.LCTimeNames()
+	if name == "" {
+		return datetime.LocaleEnUS, nil
+	}
+	if locale := datetime.LookupLocale(name); locale != nil {
+		return locale, nil
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: unsupported lc_time_names '%s'", ErrEvaluatedExprNotSupported, name)
+}
+
+// formatLocale returns the locale to format a date with the given format, which
+// is only resolved if the format has the names of months or days.
+func (env *ExpressionEnv) formatLocale(format string) (*datetime.Locale, error) {
+	if !datetime.Localized(format) {
+		return datetime.LocaleEnUS, nil
+	}
+	return env.timeLocale()
+}
+
 func (env *ExpressionEnv) Evaluate(expr Expr) (EvalResult, error) {
 	if p, ok := expr.(*CompiledExpr); ok {
 		return env.EvaluateVM(p)
@@ -94,6 +185,33 @@ func (env *ExpressionEnv) Evaluate(expr Expr) (EvalResult, error) {
 	return EvalResult{v: e, collationEnv: env.collationEnv}, err
 }
 
+// Prepare returns the expression to evaluate for all the rows of a result with the given
+// fields. An UntypedExpr, which could not be compiled ahead of time because the types of
+// its columns were unknown, is compiled for the types of the fields, so that its rows are
+// evaluated by the VM instead of walking the expression tree for every one of them. The
+// expression is returned as is if it's already compiled, if the fields are unknown, or if
+// it cannot be compiled for them.
+func (env *ExpressionEnv) Prepare(expr Expr, fields []*querypb.Field) Expr {
+	untyped, ok := expr.(*UntypedExpr)
+	if !ok || len(fields) == 0 {
+		return expr
+	}
+
+	// The types must come from the fields only: the types of the values of a single
+	// row are not the types of the whole result, e.g. when the row has NULL values.
+	savedFields, savedRow := env.Fields, env.Row
+	env.Fields, env.Row = fields, nil
+	defer func() {
+		env.Fields, env.Row = savedFields, savedRow
+	}()
+
+	compiled, err := untyped.Compile(env)
+	if err != nil {
+		return expr
+	}
+	return compiled
+}
+
 func (env *ExpressionEnv) EvaluateAST(expr Expr) (EvalResult, error) {
 	e, err := expr.eval(env)
 	return EvalResult{v: e, collationEnv: env.collationEnv}, err
@@ -141,6 +259,10 @@ func (e *emptyVCursor) SQLMode() string {
 	return config.DefaultSQLMode
 }
 
+func (e *emptyVCursor) LCTimeNames() string {
+	return ""
+}
+
 func NewEmptyVCursor(env *vtenv.Environment, tz *time.Location) VCursor {
 	return &emptyVCursor{env: env, tz: tz}
 }
@@ -18,6 +18,7 @@ package evalengine
 
 import (
 	"bytes"
+	"time"
 
 	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/mysql/collations/charset"
@@ -101,6 +102,7 @@ func getMultiComparisonFunc(args []eval) multiComparisonFunc {
 		decimals  int
 		text      int
 		binary    int
+		temporal  int
 	)
 
 	/*
@@ -110,6 +112,9 @@ func getMultiComparisonFunc(args []eval) multiComparisonFunc {
 		If the arguments comprise a mix of numbers and strings, they are compared as strings.
 		If any argument is a nonbinary (character) string, the arguments are compared as nonbinary strings.
 		In all other cases, the arguments are compared as binary strings.
+
+		Temporal arguments are only compared as temporal values when all the arguments are temporal
+		(see compareAllTemporal); when mixed with other types they are compared as strings.
 	*/
 
 	for _, arg := range args {
@@ -128,6 +133,8 @@ func getMultiComparisonFunc(args []eval) multiComparisonFunc {
 			floats++
 		case *evalDecimal:
 			decimals++
+		case *evalTemporal:
+			temporal++
 		case *evalBytes:
 			switch arg.SQLType() {
 			case sqltypes.Text, sqltypes.VarChar:
@@ -138,6 +145,10 @@ func getMultiComparisonFunc(args []eval) multiComparisonFunc {
 		}
 	}
 
+	if temporal > 0 && text == 0 {
+		binary += temporal
+	}
+
 	if integersI+integersU == len(args) {
 		if integersI == len(args) {
 			return compareAllInteger_i
@@ -275,11 +286,72 @@ func compareAllBinary(_ *collations.Environment, args []eval, cmp int) (eval, er
 	return newEvalBinary(candidateB), nil
 }
 
+// multiComparisonTemporalType returns the type that GREATEST/LEAST return when all
+// the given temporal types are compared: the common type if they are all the same,
+// and DATETIME otherwise.
+func multiComparisonTemporalType(types []sqltypes.Type) sqltypes.Type {
+	tt := types[0]
+	if tt == sqltypes.Timestamp {
+		tt = sqltypes.Datetime
+	}
+	for _, t := range types[1:] {
+		if t == sqltypes.Timestamp {
+			t = sqltypes.Datetime
+		}
+		if t != tt {
+			return sqltypes.Datetime
+		}
+	}
+	return tt
+}
+
+func allTemporal(args []eval) bool {
+	for _, arg := range args {
+		if _, ok := arg.(*evalTemporal); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func compareAllTemporal(args []eval, cmp int, now time.Time) (eval, error) {
+	types := make([]sqltypes.Type, 0, len(args))
+	var prec uint8
+	for _, arg := range args {
+		tt := arg.(*evalTemporal)
+		types = append(types, tt.t)
+		prec = max(prec, tt.prec)
+	}
+
+	convert := func(e *evalTemporal) *evalTemporal {
+		switch multiComparisonTemporalType(types) {
+		case sqltypes.Date:
+			return e
+		case sqltypes.Time:
+			return e.toTime(int(prec))
+		default:
+			return e.toDateTime(int(prec), now)
+		}
+	}
+
+	x := convert(args[0].(*evalTemporal))
+	for _, arg := range args[1:] {
+		y := convert(arg.(*evalTemporal))
+		if (cmp < 0) == (compareDates(y, x) < 0) {
+			x = y
+		}
+	}
+	return x, nil
+}
+
 func (call *builtinMultiComparison) eval(env *ExpressionEnv) (eval, error) {
 	args, err := call.args(env)
 	if err != nil {
 		return nil, err
 	}
+	if allTemporal(args) {
+		return compareAllTemporal(args, call.cmp, env.now)
+	}
 	return getMultiComparisonFunc(args)(env.collationEnv, args, call.cmp)
 }
 
@@ -312,14 +384,44 @@ func (call *builtinMultiComparison) compile_d(c *compiler, args []ctype) (ctype,
 	return ctype{Type: sqltypes.Decimal, Flag: f, Col: collationNumeric, Size: size, Scale: scale}, nil
 }
 
+func (call *builtinMultiComparison) compile_temporal(c *compiler, args []ctype) (ctype, error) {
+	var f typeFlag
+	var prec int32
+	types := make([]sqltypes.Type, 0, len(args))
+	for _, tt := range args {
+		f |= nullableFlags(tt.Flag)
+		prec = max(prec, tt.Size)
+		types = append(types, tt.Type)
+	}
+
+	t := multiComparisonTemporalType(types)
+	for i, tt := range args {
+		switch t {
+		case sqltypes.Time:
+			c.compileToTime(tt, len(args)-i, int(prec))
+		case sqltypes.Datetime:
+			c.compileToDateTime(tt, len(args)-i, int(prec))
+		}
+	}
+	c.asm.Fn_MULTICMP_temporal(len(args), call.cmp < 0)
+	return ctype{Type: t, Flag: f, Col: collationBinary, Size: prec}, nil
+}
+
+// multiComparisonTypes counts the arguments of GREATEST or LEAST of each type
+// that decides how they are compared.
+type multiComparisonTypes struct {
+	signed   int
+	unsigned int
+	floats   int
+	decimals int
+	text     int
+	binary   int
+	temporal int
+}
+
 func (call *builtinMultiComparison) compile(c *compiler) (ctype, error) {
 	var (
-		signed   int
-		unsigned int
-		floats   int
-		decimals int
-		text     int
-		binary   int
+		types    multiComparisonTypes
 		args     []ctype
 		nullable bool
 	)
@@ -333,28 +435,32 @@ func (call *builtinMultiComparison) compile(c *compiler) (ctype, error) {
 		In all other cases, the arguments are compared as binary strings.
 	*/
 
-	for _, expr := range call.Arguments {
+	skips := make([]*jump, 0, len(call.Arguments))
+	for i, expr := range call.Arguments {
 		tt, err := expr.compile(c)
 		if err != nil {
 			return ctype{}, err
 		}
 
 		args = append(args, tt)
+		skips = append(skips, c.compileNullCheckArg(tt, i))
 
 		nullable = nullable || tt.nullable()
 		switch tt.Type {
 		case sqltypes.Int64:
-			signed++
+			types.signed++
 		case sqltypes.Uint64:
-			unsigned++
+			types.unsigned++
 		case sqltypes.Float64:
-			floats++
+			types.floats++
 		case sqltypes.Decimal:
-			decimals++
+			types.decimals++
 		case sqltypes.Text, sqltypes.VarChar:
-			text++
+			types.text++
 		case sqltypes.Blob, sqltypes.Binary, sqltypes.VarBinary:
-			binary++
+			types.binary++
+		case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp, sqltypes.Time:
+			types.temporal++
 		case sqltypes.Null:
 			nullable = true
 		default:
@@ -366,32 +472,46 @@ func (call *builtinMultiComparison) compile(c *compiler) (ctype, error) {
 	if nullable {
 		f |= flagNullable
 	}
-	if signed+unsigned == len(args) {
-		if signed == len(args) {
+	if types.temporal > 0 && types.text == 0 && types.temporal < len(args) {
+		types.binary += types.temporal
+	}
+
+	// Any NULL argument skips the comparison, and is its result.
+	ct, err := call.compile_args(c, args, f, types)
+	c.asm.jumpDestination(skips...)
+	return ct, err
+}
+
+func (call *builtinMultiComparison) compile_args(c *compiler, args []ctype, f typeFlag, types multiComparisonTypes) (ctype, error) {
+	if types.temporal == len(args) {
+		return call.compile_temporal(c, args)
+	}
+	if types.signed+types.unsigned == len(args) {
+		if types.signed == len(args) {
 			c.asm.Fn_MULTICMP_i(len(args), call.cmp < 0)
 			return ctype{Type: sqltypes.Int64, Flag: f, Col: collationNumeric}, nil
 		}
-		if unsigned == len(args) {
+		if types.unsigned == len(args) {
 			c.asm.Fn_MULTICMP_u(len(args), call.cmp < 0)
 			return ctype{Type: sqltypes.Uint64, Flag: f, Col: collationNumeric}, nil
 		}
 		return call.compile_d(c, args)
 	}
-	if binary > 0 || text > 0 {
-		if text > 0 {
+	if types.binary > 0 || types.text > 0 {
+		if types.text > 0 {
 			return call.compile_c(c, args)
 		}
 		c.asm.Fn_MULTICMP_b(len(args), call.cmp < 0)
 		return ctype{Type: sqltypes.VarBinary, Flag: f, Col: collationBinary}, nil
 	} else {
-		if floats > 0 {
+		if types.floats > 0 {
 			for i, tt := range args {
 				c.compileToFloat(tt, len(args)-i)
 			}
 			c.asm.Fn_MULTICMP_f(len(args), call.cmp < 0)
 			return ctype{Type: sqltypes.Float64, Flag: f, Col: collationNumeric}, nil
 		}
-		if decimals > 0 {
+		if types.decimals > 0 {
 			return call.compile_d(c, args)
 		}
 	}
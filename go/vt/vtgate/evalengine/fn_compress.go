@@ -0,0 +1,256 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+
+	"vitess.io/vitess/go/mysql/sqlerror"
+	mysqlzlib "vitess.io/vitess/go/mysql/zlib"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+type (
+	builtinCompress struct {
+		CallExpr
+	}
+
+	builtinUncompress struct {
+		CallExpr
+	}
+
+	builtinUncompressedLength struct {
+		CallExpr
+	}
+)
+
+var _ IR = (*builtinCompress)(nil)
+var _ IR = (*builtinUncompress)(nil)
+var _ IR = (*builtinUncompressedLength)(nil)
+
+// MySQL compresses a string into the length of the string, as a 4 bytes
+// little-endian integer whose 2 highest bits are always unset, followed by
+// the string compressed with zlib.
+const mysqlCompressedLengthMask = 0x3FFFFFFF
+
+// maxUncompressedLength is the length above which UNCOMPRESS considers that
+// the length of the uncompressed data is corrupted. MySQL compares it with
+// max_allowed_packet, whose default value we use.
+const maxUncompressedLength = 64 * 1024 * 1024
+
+// mysqlCompress compresses in like MySQL's COMPRESS, with the same output.
+func mysqlCompress(in []byte) []byte {
+	if len(in) == 0 {
+		return []byte{}
+	}
+
+	out := binary.LittleEndian.AppendUint32(make([]byte, 0, 16+len(in)), uint32(len(in))&mysqlCompressedLengthMask)
+	out = mysqlzlib.Compress(out, in)
+	// MySQL appends a '.' to the compressed data that ends with a space, so that
+	// it survives being stored in a CHAR column, which trims trailing spaces.
+	if out[len(out)-1] == ' ' {
+		out = append(out, '.')
+	}
+	return out
+}
+
+// mysqlUncompressedLength returns the uncompressed length stored in the header
+// of in, which must have been compressed with COMPRESS. It returns false if in
+// is too short to have a header.
+func mysqlUncompressedLength(in []byte) (uint32, bool) {
+	if len(in) <= 4 {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint32(in) & mysqlCompressedLengthMask, true
+}
+
+// warnZlibDataError raises the warning of MySQL for the data that was not
+// compressed with COMPRESS.
+func warnZlibDataError(env *ExpressionEnv) {
+	env.warn(sqlerror.ERZlibZDataError, "ZLIB: Input data corrupted")
+}
+
+// mysqlUncompress uncompresses in like MySQL's UNCOMPRESS. If in can't be
+// uncompressed, it returns false and raises the warning that MySQL raises.
+func mysqlUncompress(env *ExpressionEnv, in []byte) ([]byte, bool) {
+	if len(in) == 0 {
+		return []byte{}, true
+	}
+	size, ok := mysqlUncompressedLength(in)
+	if !ok {
+		warnZlibDataError(env)
+		return nil, false
+	}
+	if size > maxUncompressedLength {
+		env.warn(sqlerror.ERTooBigForUncompress, "Uncompressed data size too large; the maximum size is %d (probably, length of uncompressed data was corrupted)", maxUncompressedLength)
+		return nil, false
+	}
+
+	r, err := zlib.NewReader(bytes.NewReader(in[4:]))
+	if err != nil {
+		warnZlibDataError(env)
+		return nil, false
+	}
+	// Read one more byte than expected, to detect the data that doesn't fit.
+	// When the expected length is 0, zlib uncompresses into a scratch byte
+	// instead, and returns an empty string if the data fits in it.
+	limit := int64(size) + 1
+	if size == 0 {
+		limit = 2
+	}
+	var out bytes.Buffer
+	n, err := io.Copy(&out, io.LimitReader(r, limit))
+	switch {
+	case err != nil:
+		warnZlibDataError(env)
+		return nil, false
+	case n <= int64(size):
+		// zlib stops at the end of the compressed stream, and ignores the bytes
+		// that follow it, like the '.' that COMPRESS may append
+		return out.Bytes(), true
+	case size == 0 && n == 1:
+		return []byte{}, true
+	case size == 0:
+		warnZlibDataError(env)
+		return nil, false
+	default:
+		env.warn(sqlerror.ERZlibZBufError, "ZLIB: Not enough room in the output buffer (probably, length of uncompressed data was corrupted)")
+		return nil, false
+	}
+}
+
+func (call *builtinCompress) eval(env *ExpressionEnv) (eval, error) {
+	arg, err := call.arg1(env)
+	if err != nil {
+		return nil, err
+	}
+	if arg == nil {
+		return nil, nil
+	}
+
+	b := evalToBinary(arg)
+	compressed := mysqlCompress(b.bytes)
+	if tt := arg.SQLType(); tt == sqltypes.Blob || tt == sqltypes.Text || tt == sqltypes.TypeJSON {
+		return newEvalRaw(sqltypes.Blob, compressed, collationBinary), nil
+	}
+	return newEvalBinary(compressed), nil
+}
+
+func (call *builtinCompress) compile(c *compiler) (ctype, error) {
+	str, err := call.Arguments[0].compile(c)
+	if err != nil {
+		return ctype{}, err
+	}
+
+	skip := c.compileNullCheck1(str)
+
+	t := sqltypes.VarBinary
+	if str.Type == sqltypes.Blob || str.Type == sqltypes.Text || str.Type == sqltypes.TypeJSON {
+		t = sqltypes.Blob
+	}
+
+	switch {
+	case str.isTextual():
+	default:
+		c.asm.Convert_xb(1, t, nil)
+	}
+
+	c.asm.Fn_COMPRESS(t)
+	c.asm.jumpDestination(skip)
+
+	return ctype{Type: t, Flag: nullableFlags(str.Flag), Col: collationBinary}, nil
+}
+
+func (call *builtinUncompress) eval(env *ExpressionEnv) (eval, error) {
+	arg, err := call.arg1(env)
+	if err != nil {
+		return nil, err
+	}
+	if arg == nil {
+		return nil, nil
+	}
+
+	b := evalToBinary(arg)
+	uncompressed, ok := mysqlUncompress(env, b.bytes)
+	if !ok {
+		return nil, nil
+	}
+	return newEvalRaw(sqltypes.Blob, uncompressed, collationBinary), nil
+}
+
+func (call *builtinUncompress) compile(c *compiler) (ctype, error) {
+	str, err := call.Arguments[0].compile(c)
+	if err != nil {
+		return ctype{}, err
+	}
+
+	skip := c.compileNullCheck1(str)
+
+	switch {
+	case str.isTextual():
+	default:
+		c.asm.Convert_xb(1, sqltypes.Blob, nil)
+	}
+
+	c.asm.Fn_UNCOMPRESS()
+	c.asm.jumpDestination(skip)
+
+	return ctype{Type: sqltypes.Blob, Flag: flagNullable, Col: collationBinary}, nil
+}
+
+func (call *builtinUncompressedLength) eval(env *ExpressionEnv) (eval, error) {
+	arg, err := call.arg1(env)
+	if err != nil {
+		return nil, err
+	}
+	if arg == nil {
+		return nil, nil
+	}
+
+	b := evalToBinary(arg)
+	if len(b.bytes) == 0 {
+		return newEvalInt64(0), nil
+	}
+	size, ok := mysqlUncompressedLength(b.bytes)
+	if !ok {
+		warnZlibDataError(env)
+	}
+	return newEvalInt64(int64(size)), nil
+}
+
+func (call *builtinUncompressedLength) compile(c *compiler) (ctype, error) {
+	str, err := call.Arguments[0].compile(c)
+	if err != nil {
+		return ctype{}, err
+	}
+
+	skip := c.compileNullCheck1(str)
+
+	switch {
+	case str.isTextual():
+	default:
+		c.asm.Convert_xb(1, sqltypes.VarBinary, nil)
+	}
+
+	c.asm.Fn_UNCOMPRESSED_LENGTH()
+	c.asm.jumpDestination(skip)
+
+	return ctype{Type: sqltypes.Int64, Flag: nullableFlags(str.Flag), Col: collationNumeric}, nil
+}
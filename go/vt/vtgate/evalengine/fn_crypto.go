@@ -25,6 +25,7 @@ import (
 	"encoding/hex"
 
 	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/mysql/sqlerror"
 	"vitess.io/vitess/go/sqltypes"
 )
 
@@ -148,6 +149,7 @@ func (call *builtinSHA2) eval(env *ExpressionEnv) (eval, error) {
 		s := sha512.Sum512(b.bytes)
 		sum = s[:]
 	default:
+		warnSHA2BitLength(env)
 		return nil, nil
 	}
 
@@ -189,7 +191,14 @@ func (call *builtinSHA2) compile(c *compiler) (ctype, error) {
 	col := typedCoercionCollation(sqltypes.VarChar, c.collation)
 	c.asm.Fn_SHA2(col)
 	c.asm.jumpDestination(skip1, skip2)
-	return ctype{Type: sqltypes.VarChar, Col: col, Flag: nullableFlags(str.Flag)}, nil
+	// the result is NULL for an unsupported bit length
+	return ctype{Type: sqltypes.VarChar, Col: col, Flag: nullableFlags(str.Flag) | flagNullable}, nil
+}
+
+// warnSHA2BitLength raises the warning of MySQL for an unsupported bit length
+// in SHA2.
+func warnSHA2BitLength(env *ExpressionEnv) {
+	env.warn(sqlerror.ERWrongParametersToNativeFct, "Incorrect parameters in the call to native function 'sha2'")
 }
 
 type builtinRandomBytes struct {
@@ -45,7 +45,7 @@ func (call *builtinCeil) eval(env *ExpressionEnv) (eval, error) {
 		return nil, nil
 	}
 
-	switch num := arg.(type) {
+	switch num := evalTemporalToNumeric(arg).(type) {
 	case *evalInt64, *evalUint64:
 		return num, nil
 	case *evalDecimal:
@@ -81,7 +81,7 @@ func (call *builtinFloor) eval(env *ExpressionEnv) (eval, error) {
 		return nil, nil
 	}
 
-	switch num := arg.(type) {
+	switch num := evalTemporalToNumeric(arg).(type) {
 	case *evalInt64, *evalUint64:
 		return num, nil
 	case *evalDecimal:
@@ -117,7 +117,7 @@ func (call *builtinAbs) eval(env *ExpressionEnv) (eval, error) {
 		return nil, nil
 	}
 
-	switch num := arg.(type) {
+	switch num := evalTemporalToNumeric(arg).(type) {
 	case *evalUint64:
 		return num, nil
 	case *evalInt64:
@@ -148,6 +148,7 @@ func (expr *builtinAbs) compile(c *compiler) (ctype, error) {
 	}
 
 	skip := c.compileNullCheck1(arg)
+	arg = c.compileTemporalToNumeric(arg, 1)
 
 	convt := ctype{Type: arg.Type, Col: collationNumeric, Flag: nullableFlags(arg.Flag)}
 	switch arg.Type {
@@ -443,6 +444,12 @@ func (call *builtinRadians) compile(c *compiler) (ctype, error) {
 	return c.compileFn_math1(call.Arguments[0], c.asm.Fn_RADIANS, 0)
 }
 
+// EXP, LN, LOG, LOG2 and LOG10 are computed with float64s for all their
+// arguments, including the DECIMAL ones, which are converted with evalToFloat
+// first: MySQL computes them in DOUBLE too, so the digits of a DECIMAL beyond
+// the precision of a float64 are ignored, and e.g. EXP(710.5) is NULL. The
+// exact decimal.Decimal.Exp, Ln and Log10 are deliberately not used here.
+
 type builtinExp struct {
 	CallExpr
 }
@@ -932,7 +939,7 @@ func (call *builtinRound) eval(env *ExpressionEnv) (eval, error) {
 		}
 	}
 
-	switch arg := arg.(type) {
+	switch arg := evalTemporalToNumeric(arg).(type) {
 	case *evalInt64:
 		return newEvalInt64(roundSigned(arg.i, round)), nil
 	case *evalUint64:
@@ -999,6 +1006,7 @@ func (expr *builtinRound) compile(c *compiler) (ctype, error) {
 	}
 
 	skip1 := c.compileNullCheck1(arg)
+	arg = c.compileTemporalToNumeric(arg, 1)
 	var skip2 *jump
 
 	if len(expr.Arguments) == 1 {
@@ -1136,7 +1144,7 @@ func (call *builtinTruncate) eval(env *ExpressionEnv) (eval, error) {
 		}
 	}
 
-	switch arg := arg.(type) {
+	switch arg := evalTemporalToNumeric(arg).(type) {
 	case *evalInt64:
 		return newEvalInt64(truncateSigned(arg.i, round)), nil
 	case *evalUint64:
@@ -1154,7 +1162,7 @@ func (call *builtinTruncate) eval(env *ExpressionEnv) (eval, error) {
 			digit = arg.length
 		}
 
-		truncated := arg.dec.Truncate(int32(round))
+		truncated := arg.dec.TruncTowardZero(int32(round))
 		if truncated.IsZero() {
 			return newEvalDecimalWithPrec(decimal.Zero, 0), nil
 		}
@@ -1198,6 +1206,7 @@ func (expr *builtinTruncate) compile(c *compiler) (ctype, error) {
 	}
 
 	skip1 := c.compileNullCheck1(arg)
+	arg = c.compileTemporalToNumeric(arg, 1)
 
 	round, err := expr.Arguments[1].compile(c)
 	if err != nil {
@@ -0,0 +1,254 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	builtinPoint struct {
+		CallExpr
+	}
+
+	builtinPointCoordinate struct {
+		CallExpr
+		y bool
+	}
+
+	builtinSTAsText struct {
+		CallExpr
+		collate collations.ID
+	}
+
+	builtinSTDistanceSphere struct {
+		CallExpr
+	}
+)
+
+var _ IR = (*builtinPoint)(nil)
+var _ IR = (*builtinPointCoordinate)(nil)
+var _ IR = (*builtinSTAsText)(nil)
+var _ IR = (*builtinSTDistanceSphere)(nil)
+
+func (call *builtinPoint) eval(env *ExpressionEnv) (eval, error) {
+	arg1, arg2, err := call.arg2(env)
+	if err != nil {
+		return nil, err
+	}
+	if arg1 == nil || arg2 == nil {
+		return nil, nil
+	}
+
+	x, _ := evalToFloat(arg1)
+	y, _ := evalToFloat(arg2)
+	if !validCoordinate(x.f) || !validCoordinate(y.f) {
+		return nil, errInvalidGISData("point")
+	}
+	return newEvalGeometry(newGeometryPoint(sridCartesian, x.f, y.f)), nil
+}
+
+func (call *builtinPoint) compile(c *compiler) (ctype, error) {
+	arg1, err := call.Arguments[0].compile(c)
+	if err != nil {
+		return ctype{}, err
+	}
+
+	arg2, err := call.Arguments[1].compile(c)
+	if err != nil {
+		return ctype{}, err
+	}
+
+	skip := c.compileNullCheck2(arg1, arg2)
+	c.compileToFloat(arg1, 2)
+	c.compileToFloat(arg2, 1)
+	c.asm.Fn_POINT()
+	c.asm.jumpDestination(skip)
+	return ctype{Type: sqltypes.Geometry, Col: collationBinary, Flag: nullableFlags(arg1.Flag | arg2.Flag)}, nil
+}
+
+// pointCoordinate returns the X or the Y coordinate of a Point, which are its
+// first and second coordinates in the axis order of its SRS: the X coordinate
+// of a WGS 84 point is its latitude.
+func pointCoordinate(arg eval, fn string, y bool) (eval, error) {
+	g, err := evalToGeometry(arg, fn)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.checkSRS(fn); err != nil {
+		return nil, err
+	}
+	px, py, err := g.point(fn)
+	if err != nil {
+		return nil, err
+	}
+	if y {
+		return newEvalFloat(py), nil
+	}
+	return newEvalFloat(px), nil
+}
+
+func (call *builtinPointCoordinate) fn() string {
+	if call.y {
+		return "st_y"
+	}
+	return "st_x"
+}
+
+func (call *builtinPointCoordinate) eval(env *ExpressionEnv) (eval, error) {
+	arg, err := call.arg1(env)
+	if arg == nil || err != nil {
+		return nil, err
+	}
+	return pointCoordinate(arg, call.fn(), call.y)
+}
+
+func (call *builtinPointCoordinate) compile(c *compiler) (ctype, error) {
+	arg, err := call.Arguments[0].compile(c)
+	if err != nil {
+		return ctype{}, err
+	}
+
+	skip := c.compileNullCheck1(arg)
+	c.asm.Fn_POINT_COORDINATE(call.fn(), call.y)
+	c.asm.jumpDestination(skip)
+	return ctype{Type: sqltypes.Float64, Col: collationNumeric, Flag: nullableFlags(arg.Flag)}, nil
+}
+
+func stAsText(arg eval, col collations.TypedCollation) (eval, error) {
+	g, err := evalToGeometry(arg, "st_astext")
+	if err != nil {
+		return nil, err
+	}
+	if err := g.checkSRS("st_astext"); err != nil {
+		return nil, err
+	}
+	return newEvalText(g.appendWKT(nil), col), nil
+}
+
+func (call *builtinSTAsText) eval(env *ExpressionEnv) (eval, error) {
+	arg, err := call.arg1(env)
+	if arg == nil || err != nil {
+		return nil, err
+	}
+	return stAsText(arg, typedCoercionCollation(sqltypes.VarChar, call.collate))
+}
+
+func (call *builtinSTAsText) compile(c *compiler) (ctype, error) {
+	arg, err := call.Arguments[0].compile(c)
+	if err != nil {
+		return ctype{}, err
+	}
+
+	skip := c.compileNullCheck1(arg)
+	col := typedCoercionCollation(sqltypes.VarChar, call.collate)
+	c.asm.Fn_ST_ASTEXT(col)
+	c.asm.jumpDestination(skip)
+	return ctype{Type: sqltypes.VarChar, Col: col, Flag: nullableFlags(arg.Flag)}, nil
+}
+
+// stDistanceSphere returns the distance between two points on a sphere. The
+// points are given in degrees, with their longitude first unless they have a
+// geographic SRS, and they must have the same SRS.
+func stDistanceSphere(arg1, arg2 eval, radius float64) (eval, error) {
+	const fn = "st_distance_sphere"
+	g1, err := evalToGeometry(arg1, fn)
+	if err != nil {
+		return nil, err
+	}
+	g2, err := evalToGeometry(arg2, fn)
+	if err != nil {
+		return nil, err
+	}
+	if g1.srid != g2.srid {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Binary geometry function %s given two geometries of different srids: %d and %d, which should have been identical.", fn, g1.srid, g2.srid)
+	}
+	if err := g1.checkSRS(fn); err != nil {
+		return nil, err
+	}
+	if g1.srid == sridWebMercator {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s(%s, %s) has not been implemented for projected spatial reference systems.", fn, g1.geom.typ, g2.geom.typ)
+	}
+	if g1.geom.typ != geometryPoint || g2.geom.typ != geometryPoint {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Calling geometry function %s with unsupported types of arguments.", fn)
+	}
+	if radius <= 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid radius provided to function %s: Radius must be greater than zero.", fn)
+	}
+
+	p1, p2 := g1.geom.points[0], g2.geom.points[0]
+	for _, p := range []geometryCoords{p1, p2} {
+		if p.x <= -180 || p.x > 180 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Longitude %f is out of range in function %s. It must be within (-180.000000, 180.000000].", p.x, fn)
+		}
+		if p.y < -90 || p.y > 90 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Latitude %f is out of range in function %s. It must be within [-90.000000, 90.000000].", p.y, fn)
+		}
+	}
+	return newEvalFloat(distanceSphere(p1, p2, radius)), nil
+}
+
+func (call *builtinSTDistanceSphere) eval(env *ExpressionEnv) (eval, error) {
+	args, err := call.args(env)
+	if err != nil {
+		return nil, err
+	}
+	for _, arg := range args {
+		if arg == nil {
+			return nil, nil
+		}
+	}
+
+	radius := float64(earthRadius)
+	if len(args) == 3 {
+		r, _ := evalToFloat(args[2])
+		radius = r.f
+	}
+	return stDistanceSphere(args[0], args[1], radius)
+}
+
+func (call *builtinSTDistanceSphere) compile(c *compiler) (ctype, error) {
+	arg1, err := call.Arguments[0].compile(c)
+	if err != nil {
+		return ctype{}, err
+	}
+
+	arg2, err := call.Arguments[1].compile(c)
+	if err != nil {
+		return ctype{}, err
+	}
+
+	var skip *jump
+	flag := arg1.Flag | arg2.Flag
+	if len(call.Arguments) == 3 {
+		radius, err := call.Arguments[2].compile(c)
+		if err != nil {
+			return ctype{}, err
+		}
+		skip = c.compileNullCheck3(arg1, arg2, radius)
+		c.compileToFloat(radius, 1)
+		flag |= radius.Flag
+	} else {
+		skip = c.compileNullCheck2(arg1, arg2)
+	}
+	c.asm.Fn_ST_DISTANCE_SPHERE(len(call.Arguments))
+	c.asm.jumpDestination(skip)
+	return ctype{Type: sqltypes.Float64, Col: collationNumeric, Flag: nullableFlags(flag)}, nil
+}
@@ -41,6 +41,21 @@ type (
 		collate collations.ID
 	}
 
+	builtinMakeSet struct {
+		CallExpr
+		collate collations.ID
+	}
+
+	builtinExportSet struct {
+		CallExpr
+		collate collations.ID
+	}
+
+	builtinFindInSet struct {
+		CallExpr
+		collate collations.ID
+	}
+
 	builtinInsert struct {
 		CallExpr
 		collate collations.ID
@@ -154,6 +169,9 @@ type (
 
 var _ IR = (*builtinField)(nil)
 var _ IR = (*builtinElt)(nil)
+var _ IR = (*builtinMakeSet)(nil)
+var _ IR = (*builtinExportSet)(nil)
+var _ IR = (*builtinFindInSet)(nil)
 var _ IR = (*builtinInsert)(nil)
 var _ IR = (*builtinChangeCase)(nil)
 var _ IR = (*builtinCharLength)(nil)
@@ -467,6 +485,317 @@ func (call *builtinElt) compile(c *compiler) (ctype, error) {
 	return ctype{Type: tt, Col: tc, Flag: flagNullable}, nil
 }
 
+// setSeparator returns the comma that separates the elements of a set, like
+// the ones returned by MAKE_SET, in the charset of the given collation.
+func setSeparator(col collations.ID) []byte {
+	cs := colldata.Lookup(col).Charset()
+	sep := make([]byte, cs.MaxWidth())
+	return sep[:cs.EncodeRune(sep, ',')]
+}
+
+// setSeparatorIndex returns the offset and the length of the first comma in
+// str, which is encoded in the given charset, or -1 if there is none.
+func setSeparatorIndex(cs charset.Charset, str []byte) (int, int) {
+	for i := 0; i < len(str); {
+		r, size := cs.DecodeRune(str[i:])
+		if size < 1 {
+			size = 1
+		}
+		if r == ',' {
+			return i, size
+		}
+		i += size
+	}
+	return -1, 0
+}
+
+// setAggregation returns the type and the collation of the result of the set
+// functions, which are the ones of CONCAT for the given string arguments. The
+// arguments that are NULL are ignored.
+func setAggregation(args []ctype, collate collations.ID, env *collations.Environment) (sqltypes.Type, collations.TypedCollation, error) {
+	var ca collationAggregation
+	tt := sqltypes.VarChar
+
+	for _, arg := range args {
+		if sqltypes.IsNull(arg.Type) {
+			continue
+		}
+		tt = concatSQLType(arg.Type, tt)
+		if err := ca.add(arg.Col, env); err != nil {
+			return 0, collations.TypedCollation{}, err
+		}
+	}
+
+	tc := ca.result()
+	// If we only had numbers or NULLs, we instead fall back to the default
+	// collation instead of using the numeric collation.
+	if tc.Coercibility == collations.CoerceNumeric || tc.Collation == collations.Unknown {
+		tc = typedCoercionCollation(tt, collate)
+	}
+	return tt, tc, nil
+}
+
+// evalSetAggregation is setAggregation for evaluated arguments.
+func evalSetAggregation(args []eval, collate collations.ID, env *collations.Environment) (sqltypes.Type, collations.TypedCollation, error) {
+	types := make([]ctype, 0, len(args))
+	for _, arg := range args {
+		if arg == nil {
+			continue
+		}
+		types = append(types, ctype{Type: arg.SQLType(), Col: evalCollation(arg)})
+	}
+	return setAggregation(types, collate, env)
+}
+
+// makeSet returns the set of the strings whose bit is set in bits, converted
+// to the given collation. The strings that are NULL are skipped.
+func makeSet(bits uint64, strs []eval, col collations.ID) ([]byte, error) {
+	var sep, buf []byte
+	for i, str := range strs {
+		if i >= 64 {
+			break
+		}
+		if bits&(1<<i) == 0 || str == nil {
+			continue
+		}
+		b, err := evalToVarchar(str, col, true)
+		if err != nil {
+			return nil, err
+		}
+		if sep == nil {
+			sep = setSeparator(col)
+		} else {
+			buf = append(buf, sep...)
+		}
+		buf = append(buf, b.bytes...)
+	}
+	return buf, nil
+}
+
+func (call *builtinMakeSet) eval(env *ExpressionEnv) (eval, error) {
+	args, err := call.args(env)
+	if err != nil {
+		return nil, err
+	}
+	if args[0] == nil {
+		return nil, nil
+	}
+
+	tt, tc, err := evalSetAggregation(args[1:], call.collate, env.collationEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := makeSet(uint64(evalToInt64(args[0]).i), args[1:], tc.Collation)
+	if err != nil {
+		return nil, err
+	}
+	return newEvalRaw(tt, buf, tc), nil
+}
+
+func (call *builtinMakeSet) compile(c *compiler) (ctype, error) {
+	bits, err := call.Arguments[0].compile(c)
+	if err != nil {
+		return ctype{}, err
+	}
+	skip := c.compileNullCheck1(bits)
+	_ = c.compileToInt64(bits, 1)
+
+	strs := make([]ctype, 0, len(call.Arguments)-1)
+	for _, arg := range call.Arguments[1:] {
+		str, err := arg.compile(c)
+		if err != nil {
+			return ctype{}, err
+		}
+		strs = append(strs, str)
+	}
+
+	tt, tc, err := setAggregation(strs, call.collate, c.env.CollationEnv())
+	if err != nil {
+		return ctype{}, err
+	}
+
+	c.asm.Fn_MAKE_SET(len(strs), tt, tc)
+	c.asm.jumpDestination(skip)
+
+	return ctype{Type: tt, Col: tc, Flag: bits.Flag & flagNullable}, nil
+}
+
+// exportSet returns the on string for each bit of bits that is set, and the
+// off string for each one that isn't, for its n lowest bits, separated by sep.
+func exportSet(bits uint64, on, off, sep []byte, n uint64) []byte {
+	n = min(n, 64)
+	var buf []byte
+	for i := range n {
+		if i > 0 {
+			buf = append(buf, sep...)
+		}
+		if bits&(1<<i) != 0 {
+			buf = append(buf, on...)
+		} else {
+			buf = append(buf, off...)
+		}
+	}
+	return buf
+}
+
+// exportSetArgs converts the strings of EXPORT_SET to the given collation and
+// returns the number of bits to export. The separator defaults to a comma and
+// the number of bits to 64.
+func exportSetArgs(args []eval, col collations.ID) (on, off, sep []byte, n uint64, err error) {
+	var strs [3][]byte
+	for i, arg := range args[1:min(len(args), 4)] {
+		b, err := evalToVarchar(arg, col, true)
+		if err != nil {
+			return nil, nil, nil, 0, err
+		}
+		strs[i] = b.bytes
+	}
+	if len(args) < 4 {
+		strs[2] = setSeparator(col)
+	}
+
+	n = 64
+	if len(args) == 5 {
+		// Negative numbers of bits are larger than 64 once unsigned.
+		n = uint64(evalToInt64(args[4]).i)
+	}
+	return strs[0], strs[1], strs[2], n, nil
+}
+
+func (call *builtinExportSet) eval(env *ExpressionEnv) (eval, error) {
+	args, err := call.args(env)
+	if err != nil {
+		return nil, err
+	}
+	for _, arg := range args {
+		if arg == nil {
+			return nil, nil
+		}
+	}
+
+	tt, tc, err := evalSetAggregation(args[1:min(len(args), 4)], call.collate, env.collationEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	on, off, sep, n, err := exportSetArgs(args, tc.Collation)
+	if err != nil {
+		return nil, err
+	}
+	return newEvalRaw(tt, exportSet(uint64(evalToInt64(args[0]).i), on, off, sep, n), tc), nil
+}
+
+func (call *builtinExportSet) compile(c *compiler) (ctype, error) {
+	var f typeFlag
+	args := make([]ctype, 0, len(call.Arguments))
+	skips := make([]*jump, 0, len(call.Arguments))
+	for i, arg := range call.Arguments {
+		a, err := arg.compile(c)
+		if err != nil {
+			return ctype{}, err
+		}
+		f |= a.Flag
+		skips = append(skips, c.compileNullCheckArg(a, i))
+		args = append(args, a)
+	}
+
+	tt, tc, err := setAggregation(args[1:min(len(args), 4)], call.collate, c.env.CollationEnv())
+	if err != nil {
+		return ctype{}, err
+	}
+
+	c.asm.Fn_EXPORT_SET(len(args), tt, tc)
+	c.asm.jumpDestination(skips...)
+
+	return ctype{Type: tt, Col: tc, Flag: f & flagNullable}, nil
+}
+
+// findInSet returns the position of str in the set strlist, a list of strings
+// separated by commas, or 0 if the set doesn't contain it. Sets can't contain
+// strings with a comma.
+func findInSet(str, strlist []byte, col colldata.Collation) int64 {
+	cs := col.Charset()
+	if len(strlist) == 0 {
+		return 0
+	}
+	if i, _ := setSeparatorIndex(cs, str); i >= 0 {
+		return 0
+	}
+
+	for pos := int64(1); ; pos++ {
+		i, size := setSeparatorIndex(cs, strlist)
+		if i < 0 {
+			if col.Collate(str, strlist, false) == 0 {
+				return pos
+			}
+			return 0
+		}
+		if col.Collate(str, strlist[:i], false) == 0 {
+			return pos
+		}
+		strlist = strlist[i+size:]
+	}
+}
+
+// findInSetArgs converts the arguments of FIND_IN_SET to their aggregated
+// collation.
+func findInSetArgs(str, strlist eval, col collations.ID) ([]byte, []byte, error) {
+	s, err := evalToVarchar(str, col, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	l, err := evalToVarchar(strlist, col, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.bytes, l.bytes, nil
+}
+
+func (call *builtinFindInSet) eval(env *ExpressionEnv) (eval, error) {
+	str, strlist, err := call.arg2(env)
+	if err != nil {
+		return nil, err
+	}
+	if str == nil || strlist == nil {
+		return nil, nil
+	}
+
+	_, tc, err := evalSetAggregation([]eval{str, strlist}, call.collate, env.collationEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	s, l, err := findInSetArgs(str, strlist, tc.Collation)
+	if err != nil {
+		return nil, err
+	}
+	return newEvalInt64(findInSet(s, l, colldata.Lookup(tc.Collation))), nil
+}
+
+func (call *builtinFindInSet) compile(c *compiler) (ctype, error) {
+	str, err := call.Arguments[0].compile(c)
+	if err != nil {
+		return ctype{}, err
+	}
+	strlist, err := call.Arguments[1].compile(c)
+	if err != nil {
+		return ctype{}, err
+	}
+
+	skip := c.compileNullCheck2(str, strlist)
+
+	_, tc, err := setAggregation([]ctype{str, strlist}, call.collate, c.env.CollationEnv())
+	if err != nil {
+		return ctype{}, err
+	}
+
+	c.asm.Fn_FIND_IN_SET(tc.Collation)
+	c.asm.jumpDestination(skip)
+
+	return ctype{Type: sqltypes.Int64, Col: collationNumeric, Flag: (str.Flag | strlist.Flag) & flagNullable}, nil
+}
+
 func insert(str, newstr *evalBytes, pos, l int) []byte {
 	pos--
 
@@ -1747,7 +2076,8 @@ func concatSQLType(arg sqltypes.Type, tt sqltypes.Type) sqltypes.Type {
 		return tt
 	}
 
-	if sqltypes.IsBinary(arg) {
+	// BIT values are binary strings in string context
+	if sqltypes.IsBinary(arg) || arg == sqltypes.Bit {
 		return sqltypes.VarBinary
 	}
 
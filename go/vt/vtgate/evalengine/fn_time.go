@@ -64,6 +64,11 @@ type (
 		collate collations.ID
 	}
 
+	builtinTimeFormat struct {
+		CallExpr
+		collate collations.ID
+	}
+
 	builtinDate struct {
 		CallExpr
 	}
@@ -187,6 +192,7 @@ var _ IR = (*builtinSysdate)(nil)
 var _ IR = (*builtinCurdate)(nil)
 var _ IR = (*builtinUtcDate)(nil)
 var _ IR = (*builtinDateFormat)(nil)
+var _ IR = (*builtinTimeFormat)(nil)
 var _ IR = (*builtinDate)(nil)
 var _ IR = (*builtinDayOfMonth)(nil)
 var _ IR = (*builtinDayOfWeek)(nil)
@@ -286,6 +292,21 @@ func (call *builtinUtcDate) constant() bool {
 	return false
 }
 
+// localizedFormat returns whether the given format argument may have the names of
+// months or days, which are formatted in the lc_time_names of the session when
+// the expression is evaluated, and so cannot be folded when it's planned.
+func localizedFormat(format IR) bool {
+	lit, ok := format.(*Literal)
+	if !ok {
+		return true
+	}
+	return lit.inner != nil && datetime.Localized(evalToBinary(lit.inner).string())
+}
+
+func (call *builtinDateFormat) constant() bool {
+	return call.CallExpr.constant() && !localizedFormat(call.Arguments[1])
+}
+
 func (b *builtinDateFormat) eval(env *ExpressionEnv) (eval, error) {
 	date, format, err := b.arg2(env)
 	if err != nil {
@@ -306,10 +327,14 @@ func (b *builtinDateFormat) eval(env *ExpressionEnv) (eval, error) {
 	}
 
 	f := evalToBinary(format)
-	d, err := datetime.Format(f.string(), t.dt, t.prec)
+	locale, err := env.formatLocale(f.string())
 	if err != nil {
 		return nil, err
 	}
+	d, ok, err := datetime.FormatLocale(f.string(), t.dt, t.prec, locale)
+	if err != nil || !ok {
+		return nil, err
+	}
 	return newEvalText(d, typedCoercionCollation(sqltypes.VarChar, b.collate)), nil
 }
 
@@ -346,6 +371,60 @@ func (call *builtinDateFormat) compile(c *compiler) (ctype, error) {
 	return ctype{Type: sqltypes.VarChar, Col: col, Flag: arg.Flag | flagNullable}, nil
 }
 
+func (b *builtinTimeFormat) eval(env *ExpressionEnv) (eval, error) {
+	tm, format, err := b.arg2(env)
+	if err != nil {
+		return nil, err
+	}
+	if tm == nil || format == nil {
+		return nil, nil
+	}
+	t := evalToTime(tm, -1)
+	if t == nil {
+		return nil, nil
+	}
+
+	f := evalToBinary(format)
+	d, ok, err := datetime.FormatTime(f.string(), t.dt.Time, t.prec)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return newEvalText(d, typedCoercionCollation(sqltypes.VarChar, b.collate)), nil
+}
+
+func (call *builtinTimeFormat) compile(c *compiler) (ctype, error) {
+	arg, err := call.Arguments[0].compile(c)
+	if err != nil {
+		return ctype{}, err
+	}
+
+	skip1 := c.compileNullCheck1(arg)
+
+	switch arg.Type {
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Time:
+	default:
+		c.asm.Convert_xT(1, -1)
+	}
+
+	format, err := call.Arguments[1].compile(c)
+	if err != nil {
+		return ctype{}, err
+	}
+
+	skip2 := c.compileNullCheck1r(format)
+
+	switch format.Type {
+	case sqltypes.VarChar, sqltypes.VarBinary:
+	default:
+		c.asm.Convert_xb(1, sqltypes.VarBinary, nil)
+	}
+
+	col := typedCoercionCollation(sqltypes.VarChar, c.collation)
+	c.asm.Fn_TIME_FORMAT(col)
+	c.asm.jumpDestination(skip1, skip2)
+	return ctype{Type: sqltypes.VarChar, Col: col, Flag: arg.Flag | flagNullable}, nil
+}
+
 type builtinConvertTz struct {
 	CallExpr
 }
@@ -362,6 +441,17 @@ func convertTz(dt datetime.DateTime, from, to *time.Location) (datetime.DateTime
 	if ts.Unix() < 0 || ts.Unix() >= maxUnixtime {
 		return dt, true
 	}
+	// A time that doesn't exist in the from time zone, because it's skipped by
+	// a DST transition, is converted from the beginning of the gap, like MySQL
+	// does. time.ParseInLocation moves it before or after the gap instead.
+	if cmp := datetime.NewDateTimeFromStd(ts).Compare(dt); cmp != 0 {
+		start, end := ts.ZoneBounds()
+		if cmp < 0 {
+			ts = end
+		} else {
+			ts = start
+		}
+	}
 	return datetime.NewDateTimeFromStd(ts.In(to)), true
 }
 
@@ -597,6 +687,10 @@ func (call *builtinDayOfYear) compile(c *compiler) (ctype, error) {
 
 const maxUnixtime = 32536771200
 
+func (call *builtinFromUnixtime) constant() bool {
+	return call.CallExpr.constant() && (len(call.Arguments) == 1 || !localizedFormat(call.Arguments[1]))
+}
+
 func (b *builtinFromUnixtime) eval(env *ExpressionEnv) (eval, error) {
 	ts, err := b.arg1(env)
 	if err != nil {
@@ -703,10 +797,14 @@ func (b *builtinFromUnixtime) eval(env *ExpressionEnv) (eval, error) {
 		return nil, err
 	}
 	f := evalToBinary(format)
-	d, err := datetime.Format(f.string(), dt.dt, dt.prec)
+	locale, err := env.formatLocale(f.string())
 	if err != nil {
 		return nil, err
 	}
+	d, ok, err := datetime.FormatLocale(f.string(), dt.dt, dt.prec, locale)
+	if err != nil || !ok {
+		return nil, err
+	}
 	return newEvalText(d, typedCoercionCollation(sqltypes.VarChar, b.collate)), nil
 }
 
@@ -1222,6 +1320,10 @@ func (call *builtinMonth) compile(c *compiler) (ctype, error) {
 	return ctype{Type: sqltypes.Int64, Col: collationNumeric, Flag: arg.Flag | flagNullable}, nil
 }
 
+func (call *builtinMonthName) constant() bool {
+	return false
+}
+
 func (b *builtinMonthName) eval(env *ExpressionEnv) (eval, error) {
 	date, err := b.arg1(env)
 	if err != nil {
@@ -1234,12 +1336,15 @@ func (b *builtinMonthName) eval(env *ExpressionEnv) (eval, error) {
 	if d == nil {
 		return nil, nil
 	}
-	m := d.dt.Date.Month()
-	if m < 1 || m > 12 {
+	locale, err := env.timeLocale()
+	if err != nil {
+		return nil, err
+	}
+	m, ok := locale.MonthName(d.dt)
+	if !ok {
 		return nil, nil
 	}
-
-	return newEvalText(hack.StringBytes(time.Month(d.dt.Date.Month()).String()), typedCoercionCollation(sqltypes.VarChar, b.collate)), nil
+	return newEvalText(hack.StringBytes(m), typedCoercionCollation(sqltypes.VarChar, b.collate)), nil
 }
 
 func (call *builtinMonthName) compile(c *compiler) (ctype, error) {
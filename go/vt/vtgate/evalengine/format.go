@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/mysql/collations/colldata"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 )
@@ -218,7 +219,14 @@ func (tuple TupleExpr) format(buf *sqlparser.TrackedBuffer) {
 func (c *CollateExpr) format(buf *sqlparser.TrackedBuffer) {
 	formatExpr(buf, c, c.Inner, true)
 	buf.WriteLiteral(" COLLATE ")
-	buf.WriteString(c.CollationEnv.LookupName(c.TypedCollation.Collation))
+	name := c.CollationEnv.LookupName(c.TypedCollation.Collation)
+	if name == "" {
+		// a collation implemented by the fallback of the collation data
+		if coll := colldata.Lookup(c.TypedCollation.Collation); coll != nil {
+			name = coll.Name()
+		}
+	}
+	buf.WriteString(name)
 }
 
 func (i *IntroducerExpr) format(buf *sqlparser.TrackedBuffer) {
@@ -67,3 +67,66 @@ func BenchmarkCompilerExpressions(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkCompilerPrepare(b *testing.B) {
+	var testCases = []struct {
+		name       string
+		expression string
+		values     []sqltypes.Value
+	}{
+		{"filter_arith", "column0 + column1 > 10", []sqltypes.Value{sqltypes.NewInt64(666), sqltypes.NewDecimal("4.20")}},
+		{"filter_in", "column0 in (1, 2, 3, 4, 5)", []sqltypes.Value{sqltypes.NewInt64(4)}},
+		{"projection_concat", "concat(column0, '-', column1)", []sqltypes.Value{sqltypes.NewVarChar("foo"), sqltypes.NewInt64(1)}},
+	}
+
+	const rows = 1024
+
+	venv := vtenv.NewTestEnv()
+	for _, tc := range testCases {
+		expr, err := venv.Parser().ParseExpr(tc.expression)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		// the types of the columns are only known once the results are received,
+		// like for the filters and projections applied to the results of a scatter
+		fields := makeFields(tc.values)
+		cfg := &evalengine.Config{
+			ResolveColumn: evalengine.FieldResolver(fields).Column,
+			Collation:     collations.CollationUtf8mb4ID,
+			Environment:   venv,
+		}
+
+		translated, err := evalengine.Translate(expr, cfg)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(tc.name+"/eval=ast", func(b *testing.B) {
+			b.ResetTimer()
+			b.ReportAllocs()
+
+			env := evalengine.EmptyExpressionEnv(venv)
+			env.Row = tc.values
+			for n := 0; n < b.N; n++ {
+				for r := 0; r < rows; r++ {
+					_, _ = env.Evaluate(translated)
+				}
+			}
+		})
+
+		b.Run(tc.name+"/eval=prepared", func(b *testing.B) {
+			b.ResetTimer()
+			b.ReportAllocs()
+
+			env := evalengine.EmptyExpressionEnv(venv)
+			env.Row = tc.values
+			for n := 0; n < b.N; n++ {
+				prepared := env.Prepare(translated, fields)
+				for r := 0; r < rows; r++ {
+					_, _ = env.Evaluate(prepared)
+				}
+			}
+		})
+	}
+}
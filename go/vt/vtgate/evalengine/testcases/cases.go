@@ -42,6 +42,8 @@ var Cases = []TestCase{
 	{Run: LargeDecimals},
 	{Run: LargeIntegers},
 	{Run: DecimalClamping},
+	{Run: DecimalLimits},
+	{Run: DecimalDivision},
 	{Run: BitwiseOperatorsUnary},
 	{Run: BitwiseOperators},
 	{Run: WeightString},
@@ -56,6 +58,8 @@ var Cases = []TestCase{
 	{Run: LikeComparison},
 	{Run: StrcmpComparison},
 	{Run: MultiComparisons},
+	{Run: MultiComparisonsTemporal},
+	{Run: BetweenMixedTypes},
 	{Run: IntervalStatement},
 	{Run: IsStatement},
 	{Run: NotStatement},
@@ -65,6 +69,9 @@ var Cases = []TestCase{
 	{Run: InStatement},
 	{Run: FnField},
 	{Run: FnElt},
+	{Run: FnMakeSet},
+	{Run: FnExportSet},
+	{Run: FnFindInSet},
 	{Run: FnInsert},
 	{Run: FnLower},
 	{Run: FnUpper},
@@ -125,8 +132,10 @@ var Cases = []TestCase{
 	{Run: FnMD5},
 	{Run: FnSHA1},
 	{Run: FnSHA2},
+	{Run: FnCompress},
 	{Run: FnRandomBytes},
 	{Run: FnDateFormat},
+	{Run: FnTimeFormat},
 	{Run: FnConvertTz},
 	{Run: FnDate},
 	{Run: FnDayOfMonth},
@@ -451,6 +460,10 @@ func FnExp(yield Query) {
 	for _, num := range inputBitwise {
 		yield(fmt.Sprintf("EXP(%s)", num), nil)
 	}
+
+	for _, num := range inputDecimalMath {
+		yield(fmt.Sprintf("EXP(%s)", num), nil)
+	}
 }
 
 func FnLn(yield Query) {
@@ -461,6 +474,10 @@ func FnLn(yield Query) {
 	for _, num := range inputBitwise {
 		yield(fmt.Sprintf("LN(%s)", num), nil)
 	}
+
+	for _, num := range inputDecimalMath {
+		yield(fmt.Sprintf("LN(%s)", num), nil)
+	}
 }
 
 func FnLog(yield Query) {
@@ -472,6 +489,10 @@ func FnLog(yield Query) {
 		yield(fmt.Sprintf("LOG(%s)", num), nil)
 	}
 
+	for _, num := range inputDecimalMath {
+		yield(fmt.Sprintf("LOG(%s)", num), nil)
+	}
+
 	for _, num1 := range radianInputs {
 		for _, num2 := range radianInputs {
 			yield(fmt.Sprintf("LOG(%s, %s)", num1, num2), nil)
@@ -499,6 +520,10 @@ func FnLog10(yield Query) {
 	for _, num := range inputBitwise {
 		yield(fmt.Sprintf("LOG10(%s)", num), nil)
 	}
+
+	for _, num := range inputDecimalMath {
+		yield(fmt.Sprintf("LOG10(%s)", num), nil)
+	}
 }
 
 func FnMod(yield Query) {
@@ -529,6 +554,10 @@ func FnLog2(yield Query) {
 	for _, num := range inputBitwise {
 		yield(fmt.Sprintf("LOG2(%s)", num), nil)
 	}
+
+	for _, num := range inputDecimalMath {
+		yield(fmt.Sprintf("LOG2(%s)", num), nil)
+	}
 }
 
 func FnPow(yield Query) {
@@ -738,6 +767,33 @@ func FnSHA2(yield Query) {
 	}
 }
 
+func FnCompress(yield Query) {
+	for _, str := range inputConversions {
+		yield(fmt.Sprintf("COMPRESS(%s)", str), nil)
+		yield(fmt.Sprintf("UNCOMPRESS(COMPRESS(%s))", str), nil)
+		yield(fmt.Sprintf("UNCOMPRESSED_LENGTH(COMPRESS(%s))", str), nil)
+	}
+
+	compressed := []string{
+		"NULL",
+		"''",
+		"'abc'",
+		"'abcde'",
+		"REPEAT('vitess ', 1000)",
+		"x'0B000000789CCB48CDC9C95728CF2FCA4901001A0B045D'",
+		"x'05000000789CCB48CDC9C95728CF2FCA4901001A0B045D'",
+		"x'64000000789CCB48CDC9C95728CF2FCA4901001A0B045D'",
+		"x'0B000000789CCB48CDC9C95728CF2FCA4901'",
+		"x'00000000789C4B040000620062'",
+		"x'00000000789C030000000001'",
+		"x'FFFFFFFF789CCB48CDC9C95728CF2FCA4901001A0B045D'",
+	}
+	for _, str := range compressed {
+		yield(fmt.Sprintf("UNCOMPRESS(%s)", str), nil)
+		yield(fmt.Sprintf("UNCOMPRESSED_LENGTH(%s)", str), nil)
+	}
+}
+
 func FnRandomBytes(yield Query) {
 	for _, num := range radianInputs {
 		yield(fmt.Sprintf("LENGTH(RANDOM_BYTES(%s))", num), nil)
@@ -837,6 +893,48 @@ func DecimalClamping(yield Query) {
 	}
 }
 
+func DecimalLimits(yield Query) {
+	var operands = []string{
+		strings.Repeat("9", 65),
+		"-" + strings.Repeat("9", 65),
+		"1" + strings.Repeat("0", 64),
+		strings.Repeat("9", 60) + ".99999",
+		"0.1234567890123456789",
+		"0." + strings.Repeat("9", 30),
+		"0.0001",
+		"0.5",
+		"1",
+		"10",
+	}
+	for _, op := range []string{"+", "-", "*", "/"} {
+		for _, lhs := range operands {
+			for _, rhs := range operands {
+				yield(fmt.Sprintf("%s %s %s", lhs, op, rhs), nil)
+			}
+		}
+	}
+}
+
+func DecimalDivision(yield Query) {
+	var operands = []string{
+		"0.4", "-0.3", "2.5", "8.3", "0.11", "0.00087",
+		"1.000000001", "3.000000000",
+		"1919147056.60",
+		"123456789.000000000000000000000000000001",
+		"123456789.000000000000000000000000000002",
+		"12345678901234567890.123456789012345678901234567890",
+		"3.000000000000000000000000000001",
+	}
+	for _, lhs := range operands {
+		for _, rhs := range operands {
+			yield(fmt.Sprintf("%s / %s", lhs, rhs), nil)
+			// The scale of an intermediate quotient shows in the operations on it
+			yield(fmt.Sprintf("(%s / %s) * 1000000000000", lhs, rhs), nil)
+			yield(fmt.Sprintf("%s / %s / 7", lhs, rhs), nil)
+		}
+	}
+}
+
 func BitwiseOperatorsUnary(yield Query) {
 	for _, op := range []string{"~", "BIT_COUNT"} {
 		for _, rhs := range inputBitwise {
@@ -1194,6 +1292,41 @@ func MultiComparisons(yield Query) {
 	}
 }
 
+func MultiComparisonsTemporal(yield Query) {
+	var temporals = []string{
+		`DATE '2023-01-02'`, `DATE '2024-06-30'`,
+		`TIMESTAMP '2023-01-02 12:34:56'`, `TIMESTAMP '2024-06-30 00:00:00.123'`,
+		`TIME '12:34:56'`, `TIME '-01:00:00'`,
+		`'2023-06-01'`, `20230601`, `NULL`,
+	}
+
+	for _, method := range []string{"LEAST", "GREATEST"} {
+		genSubsets(temporals, 2, func(arg []string) {
+			yield(fmt.Sprintf("%s(%s, %s)", method, arg[0], arg[1]), nil)
+			yield(fmt.Sprintf("%s(%s, %s)", method, arg[1], arg[0]), nil)
+		})
+
+		genSubsets(temporals, 3, func(arg []string) {
+			yield(fmt.Sprintf("%s(%s, %s, %s)", method, arg[0], arg[1], arg[2]), nil)
+		})
+	}
+}
+
+func BetweenMixedTypes(yield Query) {
+	var elems = []string{
+		`NULL`, `0`, `1`, `1.5`, `1e0`, `'1'`, `'abc'`, `_binary '1'`,
+		`DATE '2023-01-02'`, `'2023-01-02'`, `TIME '12:00:00'`,
+	}
+
+	for _, op := range []string{"BETWEEN", "NOT BETWEEN"} {
+		for _, x := range elems {
+			genSubsets(elems, 2, func(arg []string) {
+				yield(fmt.Sprintf("%s %s %s AND %s", x, op, arg[0], arg[1]), nil)
+			})
+		}
+	}
+}
+
 func IntervalStatement(yield Query) {
 	inputs := []string{
 		"-1", "0", "1", "2", "3", "0xFF", "1.1", "1.9", "1.1e0", "1.9e0",
@@ -1426,6 +1559,69 @@ func FnElt(yield Query) {
 	}
 }
 
+func FnMakeSet(yield Query) {
+	for _, s1 := range inputStrings {
+		for _, s2 := range inputStrings {
+			for _, n := range inputBitwise {
+				yield(fmt.Sprintf("MAKE_SET(%s, %s, %s)", n, s1, s2), nil)
+			}
+		}
+	}
+
+	mysqlDocSamples := []string{
+		"MAKE_SET(1, 'a', 'b', 'c')",
+		"MAKE_SET(1 | 4, 'hello', 'nice', 'world')",
+		"MAKE_SET(1 | 4, 'hello', 'nice', NULL, 'world')",
+		"MAKE_SET(0, 'a', 'b', 'c')",
+	}
+
+	for _, q := range mysqlDocSamples {
+		yield(q, nil)
+	}
+}
+
+func FnExportSet(yield Query) {
+	for _, s1 := range inputStrings {
+		for _, s2 := range inputStrings {
+			for _, n := range inputBitwise {
+				yield(fmt.Sprintf("EXPORT_SET(%s, %s, %s)", n, s1, s2), nil)
+			}
+		}
+	}
+
+	for _, sep := range inputStrings {
+		for _, n := range inputBitwise {
+			yield(fmt.Sprintf("EXPORT_SET(5, 'Y', 'N', %s, %s)", sep, n), nil)
+		}
+	}
+
+	mysqlDocSamples := []string{
+		"EXPORT_SET(5, 'Y', 'N', ',', 4)",
+		"EXPORT_SET(6, '1', '0', ',', 10)",
+	}
+
+	for _, q := range mysqlDocSamples {
+		yield(q, nil)
+	}
+}
+
+func FnFindInSet(yield Query) {
+	for _, s1 := range inputStrings {
+		for _, s2 := range inputStrings {
+			yield(fmt.Sprintf("FIND_IN_SET(%s, %s)", s1, s2), nil)
+		}
+	}
+
+	sets := []string{
+		"''", "'a'", "'a,b,c,d'", "'A,B'", "',a'", "'a,'", "'a,,b'", "'ä,a'", "_binary 'a,A'", "_utf16 'a,b'", "'1,2,3'", "NULL",
+	}
+	for _, str := range []string{"''", "'a'", "'b'", "'d'", "'A'", "'a,b'", "_binary 'A'", "2", "NULL"} {
+		for _, set := range sets {
+			yield(fmt.Sprintf("FIND_IN_SET(%s, %s)", str, set), nil)
+		}
+	}
+}
+
 func FnInsert(yield Query) {
 	for _, s := range insertStrings {
 		for _, ns := range insertStrings {
@@ -1871,6 +2067,22 @@ func FnDateFormat(yield Query) {
 	}
 }
 
+func FnTimeFormat(yield Query) {
+	var buf strings.Builder
+	for _, f := range dateFormats {
+		buf.WriteByte('%')
+		buf.WriteByte(f.c)
+		buf.WriteByte(' ')
+	}
+	formats := []string{buf.String(), "%H %k %h %I %l %i %S %s %f %p %r %T %Y %y %m %c %d %e %%"}
+
+	for _, d := range inputConversions {
+		for _, format := range formats {
+			yield(fmt.Sprintf("TIME_FORMAT(%s, %q)", d, format), nil)
+		}
+	}
+}
+
 func FnConvertTz(yield Query) {
 	timezoneInputs := []string{
 		"UTC",
@@ -1897,6 +2109,33 @@ func FnConvertTz(yield Query) {
 			}
 		}
 	}
+
+	// The times around the DST transitions, including the ones skipped and
+	// repeated by them.
+	dstInputs := []string{
+		"'2023-03-12 01:59:59'",
+		"'2023-03-12 02:00:00'",
+		"'2023-03-12 02:30:00.123'",
+		"'2023-03-12 03:00:00'",
+		"'2023-11-05 00:59:59'",
+		"'2023-11-05 01:30:00'",
+		"'2023-11-05 02:00:00'",
+		"'2023-03-26 02:30:00'",
+		"'2023-10-29 02:30:00'",
+	}
+	dstTimezones := []string{
+		"America/New_York",
+		"Europe/Amsterdam",
+		"+5:30",
+		"-6:00",
+	}
+	for _, d := range dstInputs {
+		for _, tzFrom := range dstTimezones {
+			for _, tzTo := range dstTimezones {
+				yield(fmt.Sprintf("CONVERT_TZ(%s, '%s', '%s')", d, tzFrom, tzTo), nil)
+			}
+		}
+	}
 }
 
 func FnDate(yield Query) {
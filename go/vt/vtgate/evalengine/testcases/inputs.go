@@ -76,6 +76,20 @@ var radianInputs = []string{
 	string(format.FormatFloat(math.SmallestNonzeroFloat64)),
 }
 
+// inputDecimalMath are DECIMAL arguments of the exponential and logarithm
+// functions, which MySQL converts to DOUBLE, losing the digits beyond its
+// precision.
+var inputDecimalMath = []string{
+	"0.000",
+	"1000.000",
+	"-2.50",
+	"1.0000000000000000000000000001",
+	"10.000000000000000000001",
+	"12345678901234567890.123456789",
+	"709.78",
+	"710.5",
+}
+
 var inputComparisonElement = []string{
 	"NULL", "-1", "0", "1",
 	`'foo'`, `'bar'`, `'FOO'`, `'BAR'`,
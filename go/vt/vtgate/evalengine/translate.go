@@ -23,6 +23,7 @@ import (
 	"sync"
 
 	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/mysql/collations/colldata"
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -337,7 +338,16 @@ func (ast *astCompiler) translateCollateExpr(collate *sqlparser.CollateExpr) (IR
 	}
 	coll := ast.cfg.Environment.CollationEnv().LookupByName(collate.Collation)
 	if coll == collations.Unknown {
-		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Unknown collation: '%s'", collate.Collation)
+		// the collations that are explicitly asked for can be implemented by
+		// the fallback of the collation data, if any
+		fallback, err := colldata.LookupFallback(ast.cfg.Environment.CollationEnv(), collate.Collation)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "Unknown collation: '%s'", collate.Collation)
+		}
+		if fallback == nil {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.UnknownCollation, "Unknown collation: '%s'", collate.Collation)
+		}
+		coll = fallback.ID()
 	}
 	return &CollateExpr{
 		UnaryExpr: UnaryExpr{expr},
@@ -477,17 +487,71 @@ func (ast *astCompiler) translateCaseExpr(node *sqlparser.CaseExpr) (IR, error)
 	return &result, nil
 }
 
+// staticType returns the type of the given expression if it can be known
+// before evaluation, i.e. for literals and for columns with a resolved type.
+func (ast *astCompiler) staticType(expr sqlparser.Expr) sqltypes.Type {
+	switch expr := expr.(type) {
+	case *sqlparser.Literal:
+		switch expr.Type {
+		case sqlparser.IntVal:
+			return sqltypes.Int64
+		case sqlparser.FloatVal:
+			return sqltypes.Float64
+		case sqlparser.DecimalVal:
+			return sqltypes.Decimal
+		case sqlparser.StrVal:
+			return sqltypes.VarChar
+		}
+	case *sqlparser.ColName:
+		if ast.cfg.ResolveType != nil {
+			if typ, ok := ast.cfg.ResolveType(expr); ok {
+				return typ.Type()
+			}
+		}
+	}
+	return sqltypes.Unknown
+}
+
+// betweenComparesAsDouble returns true when the operands of a BETWEEN expression
+// mix strings and numbers. MySQL aggregates the types of all three operands in that
+// case and compares them all as DOUBLE, instead of using the rules for each pair.
+func (ast *astCompiler) betweenComparesAsDouble(exprs ...sqlparser.Expr) bool {
+	var numeric, text int
+	for _, expr := range exprs {
+		switch typ := ast.staticType(expr); {
+		case sqltypes.IsNumber(typ):
+			numeric++
+		case sqltypes.IsText(typ):
+			text++
+		default:
+			return false
+		}
+	}
+	return numeric > 0 && text > 0
+}
+
 func (ast *astCompiler) translateBetweenExpr(node *sqlparser.BetweenExpr) (IR, error) {
+	left, lower, upper := node.Left, node.From, node.To
+	if ast.betweenComparesAsDouble(left, lower, upper) {
+		asDouble := func(expr sqlparser.Expr) sqlparser.Expr {
+			if sqltypes.IsText(ast.staticType(expr)) {
+				return &sqlparser.CastExpr{Expr: expr, Type: &sqlparser.ConvertType{Type: "double"}}
+			}
+			return expr
+		}
+		left, lower, upper = asDouble(left), asDouble(lower), asDouble(upper)
+	}
+
 	// x BETWEEN a AND b => x >= a AND x <= b
 	from := &sqlparser.ComparisonExpr{
 		Operator: sqlparser.GreaterEqualOp,
-		Left:     node.Left,
-		Right:    node.From,
+		Left:     left,
+		Right:    lower,
 	}
 	to := &sqlparser.ComparisonExpr{
 		Operator: sqlparser.LessEqualOp,
-		Left:     node.Left,
-		Right:    node.To,
+		Left:     left,
+		Right:    upper,
 	}
 
 	if !node.IsBetween {
@@ -741,7 +805,7 @@ func (fields FieldResolver) Type(expr sqlparser.Expr) (Type, bool) {
 		name := expr.CompliantName()
 		for _, f := range fields {
 			if f.Name == name {
-				return NewType(f.Type, collations.ID(f.Charset)), true
+				return NewType(f.Type, collations.CollationForField(f)), true
 			}
 		}
 	}
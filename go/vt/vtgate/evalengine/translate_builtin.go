@@ -288,6 +288,21 @@ func (ast *astCompiler) translateFuncExpr(fn *sqlparser.FuncExpr) (IR, error) {
 			return nil, argError(method)
 		}
 		return &builtinElt{CallExpr: call, collate: ast.cfg.Collation}, nil
+	case "make_set":
+		if len(args) < 2 {
+			return nil, argError(method)
+		}
+		return &builtinMakeSet{CallExpr: call, collate: ast.cfg.Collation}, nil
+	case "export_set":
+		if len(args) < 3 || len(args) > 5 {
+			return nil, argError(method)
+		}
+		return &builtinExportSet{CallExpr: call, collate: ast.cfg.Collation}, nil
+	case "find_in_set":
+		if len(args) != 2 {
+			return nil, argError(method)
+		}
+		return &builtinFindInSet{CallExpr: call, collate: ast.cfg.Collation}, nil
 	case "lower", "lcase":
 		if len(args) != 1 {
 			return nil, argError(method)
@@ -358,6 +373,21 @@ func (ast *astCompiler) translateFuncExpr(fn *sqlparser.FuncExpr) (IR, error) {
 			return nil, argError(method)
 		}
 		return &builtinToBase64{CallExpr: call, collate: ast.cfg.Collation}, nil
+	case "compress":
+		if len(args) != 1 {
+			return nil, argError(method)
+		}
+		return &builtinCompress{CallExpr: call}, nil
+	case "uncompress":
+		if len(args) != 1 {
+			return nil, argError(method)
+		}
+		return &builtinUncompress{CallExpr: call}, nil
+	case "uncompressed_length":
+		if len(args) != 1 {
+			return nil, argError(method)
+		}
+		return &builtinUncompressedLength{CallExpr: call}, nil
 	case "json_depth":
 		if len(args) != 1 {
 			return nil, argError(method)
@@ -385,6 +415,11 @@ func (ast *astCompiler) translateFuncExpr(fn *sqlparser.FuncExpr) (IR, error) {
 			return nil, argError(method)
 		}
 		return &builtinDateFormat{CallExpr: call, collate: ast.cfg.Collation}, nil
+	case "time_format":
+		if len(args) != 2 {
+			return nil, argError(method)
+		}
+		return &builtinTimeFormat{CallExpr: call, collate: ast.cfg.Collation}, nil
 	case "date":
 		if len(args) != 1 {
 			return nil, argError(method)
@@ -548,6 +583,13 @@ func (ast *astCompiler) translateFuncExpr(fn *sqlparser.FuncExpr) (IR, error) {
 			return nil, argError(method)
 		}
 		return &builtinInet6Ntoa{CallExpr: call, collate: ast.cfg.Collation}, nil
+	case "st_distance_sphere":
+		switch len(args) {
+		case 2, 3:
+			return &builtinSTDistanceSphere{CallExpr: call}, nil
+		default:
+			return nil, argError(method)
+		}
 	case "is_ipv4":
 		if len(args) != 1 {
 			return nil, argError(method)
@@ -1105,6 +1147,45 @@ func (ast *astCompiler) translateCallable(call sqlparser.Callable) (IR, error) {
 			CallExpr: cexpr,
 			collate:  coll,
 		}, nil
+	case *sqlparser.PointExpr:
+		args, err := ast.translateFuncArgs([]sqlparser.Expr{call.XCordinate, call.YCordinate})
+		if err != nil {
+			return nil, err
+		}
+		return &builtinPoint{
+			CallExpr: CallExpr{Arguments: args, Method: "POINT"},
+		}, nil
+	case *sqlparser.PointPropertyFuncExpr:
+		if call.ValueToSet != nil {
+			return nil, translateExprNotSupported(call)
+		}
+		arg, err := ast.translateExpr(call.Point)
+		if err != nil {
+			return nil, err
+		}
+		var cexpr = CallExpr{Arguments: []IR{arg}}
+		switch call.Property {
+		case sqlparser.XCordinate:
+			cexpr.Method = "ST_X"
+			return &builtinPointCoordinate{CallExpr: cexpr}, nil
+		case sqlparser.YCordinate:
+			cexpr.Method = "ST_Y"
+			return &builtinPointCoordinate{CallExpr: cexpr, y: true}, nil
+		default:
+			return nil, translateExprNotSupported(call)
+		}
+	case *sqlparser.GeomFormatExpr:
+		if call.FormatType != sqlparser.TextFormat || call.AxisOrderOpt != nil {
+			return nil, translateExprNotSupported(call)
+		}
+		arg, err := ast.translateExpr(call.Geom)
+		if err != nil {
+			return nil, err
+		}
+		return &builtinSTAsText{
+			CallExpr: CallExpr{Arguments: []IR{arg}, Method: "ST_ASTEXT"},
+			collate:  ast.cfg.Collation,
+		}, nil
 	default:
 		return nil, translateExprNotSupported(call)
 	}
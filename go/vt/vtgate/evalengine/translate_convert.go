@@ -49,7 +49,7 @@ func (ast *astCompiler) translateConvertCharset(charset string, binary bool) (co
 	charset = strings.ToLower(charset)
 	collationID := ast.cfg.Environment.CollationEnv().DefaultCollationForCharset(charset)
 	if collationID == collations.Unknown {
-		return collations.Unknown, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Unknown character set: '%s'", charset)
+		return collations.Unknown, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.UnknownCharacterSet, "Unknown character set: '%s'", charset)
 	}
 	if binary {
 		collationID = ast.binaryCollationForCollation(collationID)
@@ -134,14 +134,53 @@ func (c *CallExpr) simplify(env *ExpressionEnv) error {
 
 func simplifyExpr(env *ExpressionEnv, e IR) (IR, error) {
 	if e.constant() {
+		warnings := len(env.warnings)
 		simplified, err := e.eval(env)
 		if err != nil {
 			return nil, err
 		}
-		return &Literal{inner: simplified}, nil
+		// expressions that raise warnings are not folded, so that the warnings
+		// are raised again every time the expression is evaluated
+		if len(env.warnings) == warnings {
+			return &Literal{inner: simplified}, nil
+		}
+		env.warnings = env.warnings[:warnings]
 	}
 	if err := e.simplify(env); err != nil {
 		return nil, err
 	}
+	if logical, ok := e.(*LogicalExpr); ok {
+		if lit := logical.absorbed(); lit != nil {
+			return lit, nil
+		}
+	}
 	return e, nil
 }
+
+// absorbed returns the value of a logical expression with a constant operand
+// that determines its value regardless of the other operand, i.e. `x AND FALSE`
+// or `x OR TRUE`, or nil if it has none. MySQL still evaluates the other operand,
+// so it's only dropped when its evaluation can't fail: a column or a literal.
+func (expr *LogicalExpr) absorbed() *Literal {
+	var absorbing boolean
+	switch expr.op.(type) {
+	case opLogicalAnd:
+		absorbing = boolFalse
+	case opLogicalOr:
+		absorbing = boolTrue
+	default:
+		return nil
+	}
+	operands := [2]IR{expr.Left, expr.Right}
+	for i, operand := range operands {
+		lit, ok := operand.(*Literal)
+		if !ok || evalIsTruthy(lit.inner) != absorbing {
+			continue
+		}
+		switch operands[1-i].(type) {
+		case *Literal, *Column:
+			return &Literal{inner: absorbing.eval()}
+		}
+	}
+	return nil
+}
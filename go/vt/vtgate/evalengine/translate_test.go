@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtenv"
+	"vitess.io/vitess/go/vt/vterrors"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -112,6 +113,12 @@ func TestTranslateSimplification(t *testing.T) {
 		{"2 not between 5 and 20", ok("2 < 5 or 2 > 20"), ok(`1`)},
 		{"json->\"$.c\"", ok("JSON_EXTRACT(`json`, '$.c')"), ok("JSON_EXTRACT(`json`, '$.c')")},
 		{"json->>\"$.c\"", ok("JSON_UNQUOTE(JSON_EXTRACT(`json`, '$.c'))"), ok("JSON_UNQUOTE(JSON_EXTRACT(`json`, '$.c'))")},
+		{"json and 1 = 0", ok("`json` and 1 = 0"), ok("0")},
+		{"1 = 1 or json", ok("1 = 1 or `json`"), ok("1")},
+		{"json and 1 = 1", ok("`json` and 1 = 1"), ok("`json` and 1")},
+		{"null and json", ok("null and `json`"), ok("null and `json`")},
+		{"json_extract(json, '$') and 1 = 0", ok("JSON_EXTRACT(`json`, '$') and 1 = 0"), ok("JSON_EXTRACT(`json`, '$') and 0")},
+		{"1 = 1 or json_extract(json, '$')", ok("1 = 1 or JSON_EXTRACT(`json`, '$')"), ok("1 or JSON_EXTRACT(`json`, '$')")},
 	}
 
 	venv := vtenv.NewTestEnv()
@@ -123,7 +130,7 @@ func TestTranslateSimplification(t *testing.T) {
 			}
 
 			fields := FieldResolver([]*querypb.Field{
-				{Name: "json", Type: sqltypes.TypeJSON, Charset: collations.CollationUtf8mb4ID},
+				{Name: "json", Type: sqltypes.TypeJSON, Charset: uint32(collations.CollationUtf8mb4ID)},
 			})
 
 			cfg := &Config{
@@ -297,6 +304,21 @@ func TestEvaluate(t *testing.T) {
 	}, {
 		expression: "false is not false",
 		expected:   False,
+	}, {
+		expression: "'10' between '9' and 11",
+		expected:   True,
+	}, {
+		expression: "'10' not between '9' and 11",
+		expected:   False,
+	}, {
+		expression: "'10' between '9' and '11'",
+		expected:   False,
+	}, {
+		expression: "greatest(date '2023-01-02', date '2024-06-30')",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2024-06-30")),
+	}, {
+		expression: "least(date '2023-01-02', timestamp '2022-06-30 12:00:00')",
+		expected:   sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2022-06-30 12:00:00")),
 	}}
 
 	venv := vtenv.NewTestEnv()
@@ -379,6 +401,7 @@ func TestTranslationFailures(t *testing.T) {
 	testcases := []struct {
 		expression  string
 		expectedErr string
+		state       vterrors.State
 	}{
 		{
 			expression:  "cast('3.4' as FLOAT)",
@@ -386,6 +409,14 @@ func TestTranslationFailures(t *testing.T) {
 		}, {
 			expression:  "cast('3.4' as FLOAT(3))",
 			expectedErr: "Unsupported type conversion: FLOAT(3)",
+		}, {
+			expression:  "convert('abc' using klingon)",
+			expectedErr: "Unknown character set: 'klingon'",
+			state:       vterrors.UnknownCharacterSet,
+		}, {
+			expression:  "'abc' collate klingon_ci",
+			expectedErr: "Unknown collation: 'klingon_ci'",
+			state:       vterrors.UnknownCollation,
 		},
 	}
 
@@ -401,6 +432,7 @@ func TestTranslationFailures(t *testing.T) {
 				Environment: venv,
 			})
 			require.EqualError(t, err, testcase.expectedErr)
+			require.Equal(t, testcase.state, vterrors.ErrState(err))
 		})
 	}
 
@@ -74,6 +74,7 @@ func (env *ExpressionEnv) EvaluateVM(p *CompiledExpr) (EvalResult, error) {
 	env.vm.arena.reset()
 	env.vm.sp = 0
 	env.vm.err = nil
+	warnings := len(env.warnings)
 	if len(env.vm.stack) < p.stack {
 		env.vm.stack = make([]eval, p.stack)
 	}
@@ -91,6 +92,8 @@ func (env *ExpressionEnv) EvaluateVM(p *CompiledExpr) (EvalResult, error) {
 
 err:
 	if env.vm.err == errDeoptimize {
+		// the AST evaluation raises the warnings again
+		env.warnings = env.warnings[:warnings]
 		e, err := p.ir.eval(env)
 		return EvalResult{v: e, collationEnv: env.collationEnv}, err
 	}
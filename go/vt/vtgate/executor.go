@@ -464,6 +464,10 @@ func (e *Executor) addNeededBindVars(vcursor *vcursorImpl, bindVarNeeds *sqlpars
 			bindVars[key] = sqltypes.BoolBindVariable(session.Autocommit)
 		case sysvars.QueryTimeout.Name:
 			bindVars[key] = sqltypes.Int64BindVariable(session.GetQueryTimeout())
+		case sysvars.MaxShardsPerQuery.Name:
+			bindVars[key] = sqltypes.Int64BindVariable(session.MaxShardsPerQuery)
+		case sysvars.MaxShardsPerQueryWarnOnly.Name:
+			bindVars[key] = sqltypes.BoolBindVariable(session.MaxShardsPerQueryWarnOnly)
 		case sysvars.ClientFoundRows.Name:
 			var v bool
 			ifOptionsExist(session, func(options *querypb.ExecuteOptions) {
@@ -790,13 +794,6 @@ func (e *Executor) showShards(ctx context.Context, filter *sqlparser.ShowFilter,
 			shardFilters = append(shardFilters, func(ks string, shard *topodatapb.ShardReference) bool {
 				return shardLikeRexep.MatchString(topoproto.KeyspaceShardString(ks, shard.Name))
 			})
-
-			return keyspaceFilters, shardFilters
-		}
-
-		if filter.Filter != nil {
-			// TODO build a query planner I guess? lol that should be fun
-			log.Infof("SHOW VITESS_SHARDS where clause %+v. Ignoring this (for now).", filter.Filter)
 		}
 
 		return keyspaceFilters, shardFilters
@@ -869,11 +866,6 @@ func (e *Executor) showTablets(filter *sqlparser.ShowFilter) (*sqltypes.Result,
 			}
 
 			filters = append(filters, f)
-			return filters
-		}
-
-		if filter.Filter != nil {
-			log.Infof("SHOW VITESS_TABLETS where clause: %+v. Ignoring this (for now).", filter.Filter)
 		}
 
 		return filters
@@ -941,7 +933,7 @@ func (e *Executor) showVitessReplicationStatus(ctx context.Context, filter *sqlp
 			}
 
 			// Allow people to filter by Keyspace and Shard using a LIKE clause
-			if filter != nil {
+			if filter != nil && filter.Like != "" {
 				ksFilterRegex := sqlparser.LikeToRegexp(filter.Like)
 				keyspaceShardStr := fmt.Sprintf("%s/%s", ts.Target.Keyspace, ts.Target.Shard)
 				if !ksFilterRegex.MatchString(keyspaceShardStr) {
@@ -263,6 +263,12 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set @@query_timeout = 50, query_timeout = 75",
 		out: &vtgatepb.Session{Autocommit: true, QueryTimeout: 75},
+	}, {
+		in:  "set max_shards_per_query = 4, max_shards_per_query_warn_only = on",
+		out: &vtgatepb.Session{Autocommit: true, MaxShardsPerQuery: 4, MaxShardsPerQueryWarnOnly: true},
+	}, {
+		in:  "set max_shards_per_query = -1",
+		err: "variable 'max_shards_per_query' can't be set to the value of '-1'",
 	}}
 	for i, tcase := range testcases {
 		t.Run(fmt.Sprintf("%d-%s", i, tcase.in), func(t *testing.T) {
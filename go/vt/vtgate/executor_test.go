@@ -658,7 +658,7 @@ func TestExecutorShow(t *testing.T) {
 		qr, err := executor.Execute(ctx, nil, "TestExecute", session, query, nil)
 		require.NoError(t, err)
 		wantqr := &sqltypes.Result{
-			Fields: append(buildVarCharFields("Charset", "Description", "Default collation"), &querypb.Field{Name: "Maxlen", Type: sqltypes.Uint32, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG | querypb.MySqlFlag_NO_DEFAULT_VALUE_FLAG)}),
+			Fields: append(buildVarCharFields("Charset", "Description", "Default collation"), &querypb.Field{Name: "Maxlen", Type: sqltypes.Uint32, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG | querypb.MySqlFlag_NO_DEFAULT_VALUE_FLAG)}),
 			Rows: [][]sqltypes.Value{
 				append(buildVarCharRow(
 					"utf8mb3",
@@ -680,7 +680,7 @@ func TestExecutorShow(t *testing.T) {
 		qr, err := executor.Execute(ctx, nil, "TestExecute", session, query, nil)
 		require.NoError(t, err)
 		wantqr := &sqltypes.Result{
-			Fields:       append(buildVarCharFields("Charset", "Description", "Default collation"), &querypb.Field{Name: "Maxlen", Type: sqltypes.Uint32, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG | querypb.MySqlFlag_NO_DEFAULT_VALUE_FLAG)}),
+			Fields:       append(buildVarCharFields("Charset", "Description", "Default collation"), &querypb.Field{Name: "Maxlen", Type: sqltypes.Uint32, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG | querypb.MySqlFlag_NO_DEFAULT_VALUE_FLAG)}),
 			RowsAffected: 0,
 		}
 
@@ -691,7 +691,7 @@ func TestExecutorShow(t *testing.T) {
 		qr, err := executor.Execute(ctx, nil, "TestExecute", session, query, nil)
 		require.NoError(t, err)
 		wantqr := &sqltypes.Result{
-			Fields: append(buildVarCharFields("Charset", "Description", "Default collation"), &querypb.Field{Name: "Maxlen", Type: sqltypes.Uint32, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG | querypb.MySqlFlag_NO_DEFAULT_VALUE_FLAG)}),
+			Fields: append(buildVarCharFields("Charset", "Description", "Default collation"), &querypb.Field{Name: "Maxlen", Type: sqltypes.Uint32, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG | querypb.MySqlFlag_NO_DEFAULT_VALUE_FLAG)}),
 			Rows: [][]sqltypes.Value{
 				append(buildVarCharRow(
 					"utf8mb3",
@@ -708,7 +708,7 @@ func TestExecutorShow(t *testing.T) {
 		qr, err := executor.Execute(ctx, nil, "TestExecute", session, query, nil)
 		require.NoError(t, err)
 		wantqr := &sqltypes.Result{
-			Fields: append(buildVarCharFields("Charset", "Description", "Default collation"), &querypb.Field{Name: "Maxlen", Type: sqltypes.Uint32, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG | querypb.MySqlFlag_NO_DEFAULT_VALUE_FLAG)}),
+			Fields: append(buildVarCharFields("Charset", "Description", "Default collation"), &querypb.Field{Name: "Maxlen", Type: sqltypes.Uint32, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG | querypb.MySqlFlag_NO_DEFAULT_VALUE_FLAG)}),
 			Rows: [][]sqltypes.Value{
 				append(buildVarCharRow(
 					"utf8mb4",
@@ -763,7 +763,7 @@ func TestExecutorShow(t *testing.T) {
 		require.NoError(t, err)
 		wantqr = &sqltypes.Result{
 			Fields: []*querypb.Field{
-				{Name: "id", Type: sqltypes.Int32, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
+				{Name: "id", Type: sqltypes.Int32, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
 				{Name: "value", Type: sqltypes.VarChar, Charset: uint32(collations.MySQL8().DefaultConnectionCharset())},
 			},
 			Rows: [][]sqltypes.Value{
@@ -876,6 +876,22 @@ func TestExecutorShow(t *testing.T) {
 	}
 	utils.MustMatch(t, wantqr, qr, query)
 
+	query = "show vitess_tablets where keyspace = 'TestExecutor' and shard in ('-20', '20-40') and TabletType = 'PRIMARY'"
+	qr, err = executor.Execute(ctx, nil, "TestExecute", session, query, nil)
+	require.NoError(t, err)
+	wantqr = &sqltypes.Result{
+		Fields: buildVarCharFields("Cell", "Keyspace", "Shard", "TabletType", "State", "Alias", "Hostname", "PrimaryTermStartTime"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow("aa", "TestExecutor", "-20", "PRIMARY", "SERVING", "aa-0000000001", "-20", "1970-01-01T00:00:01Z"),
+			buildVarCharRow("aa", "TestExecutor", "20-40", "PRIMARY", "SERVING", "aa-0000000002", "20-40", "1970-01-01T00:00:01Z"),
+		},
+	}
+	utils.MustMatch(t, wantqr, qr, query)
+
+	query = "show vitess_tablets where garbage = 'PRIMARY'"
+	_, err = executor.Execute(ctx, nil, "TestExecute", session, query, nil)
+	assert.EqualError(t, err, "VT03022: column garbage not found in show vitess_tablets", query)
+
 	query = "show vschema vindexes"
 	qr, err = executor.Execute(ctx, nil, "TestExecute", session, query, nil)
 	require.NoError(t, err)
@@ -966,7 +982,7 @@ func TestExecutorShow(t *testing.T) {
 	wantqr = &sqltypes.Result{
 		Fields: []*querypb.Field{
 			{Name: "Level", Type: sqltypes.VarChar, Charset: uint32(collations.SystemCollation.Collation)},
-			{Name: "Code", Type: sqltypes.Uint16, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
+			{Name: "Code", Type: sqltypes.Uint16, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
 			{Name: "Message", Type: sqltypes.VarChar, Charset: uint32(collations.SystemCollation.Collation)},
 		},
 		Rows: [][]sqltypes.Value{},
@@ -980,7 +996,7 @@ func TestExecutorShow(t *testing.T) {
 	wantqr = &sqltypes.Result{
 		Fields: []*querypb.Field{
 			{Name: "Level", Type: sqltypes.VarChar, Charset: uint32(collations.SystemCollation.Collation)},
-			{Name: "Code", Type: sqltypes.Uint16, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
+			{Name: "Code", Type: sqltypes.Uint16, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
 			{Name: "Message", Type: sqltypes.VarChar, Charset: uint32(collations.SystemCollation.Collation)},
 		},
 		Rows: [][]sqltypes.Value{},
@@ -997,7 +1013,7 @@ func TestExecutorShow(t *testing.T) {
 	wantqr = &sqltypes.Result{
 		Fields: []*querypb.Field{
 			{Name: "Level", Type: sqltypes.VarChar, Charset: uint32(collations.SystemCollation.Collation)},
-			{Name: "Code", Type: sqltypes.Uint16, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
+			{Name: "Code", Type: sqltypes.Uint16, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
 			{Name: "Message", Type: sqltypes.VarChar, Charset: uint32(collations.SystemCollation.Collation)},
 		},
 
@@ -1050,6 +1066,35 @@ func TestExecutorShow(t *testing.T) {
 	}
 	utils.MustMatch(t, wantqr, qr, query)
 
+	query = "show vschema tables like 'n%'"
+	qr, err = executor.Execute(ctx, nil, "TestExecute", session, query, nil)
+	require.NoError(t, err)
+	wantqr = &sqltypes.Result{
+		Fields: buildVarCharFields("Tables"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow("name_lastname_keyspace_id_map"),
+			buildVarCharRow("name_user_map"),
+			buildVarCharRow("nrl_lu_idx"),
+			buildVarCharRow("nv_lu_idx"),
+		},
+	}
+	utils.MustMatch(t, wantqr, qr, query)
+
+	query = "show vschema tables where tables like '%lu_idx' and tables != 'lu_idx'"
+	qr, err = executor.Execute(ctx, nil, "TestExecute", session, query, nil)
+	require.NoError(t, err)
+	wantqr = &sqltypes.Result{
+		Fields: buildVarCharFields("Tables"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow("erl_lu_idx"),
+			buildVarCharRow("nrl_lu_idx"),
+			buildVarCharRow("nv_lu_idx"),
+			buildVarCharRow("srl_lu_idx"),
+			buildVarCharRow("wo_lu_idx"),
+		},
+	}
+	utils.MustMatch(t, wantqr, qr, query)
+
 	query = "show vschema tables"
 	session = NewSafeSession(&vtgatepb.Session{})
 	_, err = executor.Execute(ctx, nil, "TestExecute", session, query, nil)
@@ -1132,6 +1177,11 @@ func TestExecutorUse(t *testing.T) {
 	if err == nil || err.Error() != wantErr {
 		t.Errorf("got: %v, want %v", err, wantErr)
 	}
+	// the syntax errors are returned to the clients as ER_PARSE_ERROR
+	var sqlErr *sqlerror.SQLError
+	require.ErrorAs(t, sqlerror.NewSQLErrorFromError(err), &sqlErr)
+	assert.Equal(t, sqlerror.ERParseError, sqlErr.Number())
+	assert.Equal(t, sqlerror.SSClientError, sqlErr.SQLState())
 
 	_, err = executor.Execute(ctx, nil, "TestExecute", NewSafeSession(&vtgatepb.Session{}), "use UnexistentKeyspace", nil)
 	require.EqualError(t, err, "VT05003: unknown database 'UnexistentKeyspace' in vschema")
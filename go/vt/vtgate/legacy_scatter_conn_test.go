@@ -519,7 +519,7 @@ func TestAppendResult(t *testing.T) {
 	}
 	innerqr2 := &sqltypes.Result{
 		Fields: []*querypb.Field{
-			{Name: "foo", Type: sqltypes.Int8, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
+			{Name: "foo", Type: sqltypes.Int8, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
 		},
 		RowsAffected: 1,
 		InsertID:     1,
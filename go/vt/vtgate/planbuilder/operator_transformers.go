@@ -257,6 +257,7 @@ func transformSubQuery(ctx *plancontext.PlanningContext, op *operators.SubQuery)
 		Left:  outer,
 		Right: inner,
 		Vars:  op.Vars,
+		Anti:  op.FilterType == opcode.PulloutNotExists,
 	}, nil
 }
 
@@ -321,6 +322,17 @@ func transformAggregator(ctx *plancontext.PlanningContext, op *operators.Aggrega
 		aggrParam.OrigOpcode = aggr.OriginalOpCode
 		aggrParam.WCol = aggr.WSOffset
 		aggrParam.Type = aggr.GetTypeCollation(ctx)
+		for idx, order := range aggr.OrderOffsets {
+			gcOrder := aggr.Func.(*sqlparser.GroupConcatExpr).OrderBy[idx]
+			typ, _ := ctx.TypeForExpr(aggr.OrderByExpr(gcOrder))
+			aggrParam.OrderBy = append(aggrParam.OrderBy, evalengine.OrderByParams{
+				Col:             order,
+				WeightStringCol: aggr.OrderWSOffsets[idx],
+				Desc:            gcOrder.Direction == sqlparser.DescOrder,
+				Type:            typ,
+				CollationEnv:    ctx.VSchema.Environment().CollationEnv(),
+			})
+		}
 		aggregates = append(aggregates, aggrParam)
 	}
 
@@ -385,6 +397,7 @@ func createMemorySort(ctx *plancontext.PlanningContext, src engine.Primitive, or
 			Col:             ordering.Offset[idx],
 			WeightStringCol: ordering.WOffset[idx],
 			Desc:            order.Inner.Direction == sqlparser.DescOrder,
+			Expr:            ordering.Exprs[idx],
 			Type:            typ,
 			CollationEnv:    ctx.VSchema.Environment().CollationEnv(),
 		})
@@ -541,7 +554,8 @@ func newRoutingParams(ctx *plancontext.PlanningContext, opCode engine.Opcode) *e
 
 type queryHints struct {
 	scatterErrorsAsWarnings,
-	multiShardAutocommit bool
+	multiShardAutocommit,
+	allowScatter bool
 	queryTimeout int
 }
 
@@ -556,10 +570,26 @@ func getHints(cmt *sqlparser.ParsedComments) *queryHints {
 	return &queryHints{
 		scatterErrorsAsWarnings: scatterAsWarns,
 		multiShardAutocommit:    multiShardAutoCommit,
+		allowScatter:            directives.IsSet(sqlparser.DirectiveAllowScatter),
 		queryTimeout:            timeout,
 	}
 }
 
+// checkNoScatter fails the scatter routes that access a table that has
+// no_scatter set in the VSchema, unless the query has the ALLOW_SCATTER directive.
+func checkNoScatter(op *operators.Route, hints *queryHints) error {
+	if op.Routing.OpCode() != engine.Scatter || (hints != nil && hints.allowScatter) {
+		return nil
+	}
+	return operators.Visit(op, func(inner operators.Operator) error {
+		tbl, ok := inner.(*operators.Table)
+		if !ok || tbl.VTable == nil || !tbl.VTable.NoScatter {
+			return nil
+		}
+		return vterrors.VT09025(fmt.Sprintf("scatter route on keyspace '%s'", op.Routing.Keyspace().Name), tbl.VTable.Name.String())
+	})
+}
+
 func transformRoutePlan(ctx *plancontext.PlanningContext, op *operators.Route) (engine.Primitive, error) {
 	stmt, dmlOp, err := operators.ToSQL(ctx, op.Source)
 	if err != nil {
@@ -572,6 +602,9 @@ func transformRoutePlan(ctx *plancontext.PlanningContext, op *operators.Route) (
 	}
 
 	hints := getHints(op.Comments)
+	if err := checkNoScatter(op, hints); err != nil {
+		return nil, err
+	}
 	switch stmt := stmt.(type) {
 	case sqlparser.SelectStatement:
 		if op.Lock != sqlparser.NoLock {
@@ -603,6 +636,7 @@ func buildRoutePrimitive(ctx *plancontext.PlanningContext, op *operators.Route,
 			Col:             order.Offset,
 			WeightStringCol: order.WOffset,
 			Desc:            order.Direction == sqlparser.DescOrder,
+			Expr:            order.Expr,
 			Type:            typ,
 			CollationEnv:    ctx.VSchema.Environment().CollationEnv(),
 		})
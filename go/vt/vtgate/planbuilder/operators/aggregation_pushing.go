@@ -149,6 +149,12 @@ func pushAggregationThroughRoute(
 	aggregator *Aggregator,
 	route *Route,
 ) (Operator, *ApplyResult) {
+	if slices.ContainsFunc(aggregator.Aggregations, func(aggr Aggr) bool { return aggr.orderedGroupConcat() != nil }) {
+		// the values concatenated by the shards would be ordered within each shard only,
+		// so the aggregation is evaluated at the vtgate level over the rows of all shards
+		return nil, nil
+	}
+
 	// Create a new aggregator to be placed below the route.
 	aggrBelowRoute := aggregator.SplitAggregatorBelowOperators(ctx, route.Inputs())
 	aggrBelowRoute.Aggregations = nil
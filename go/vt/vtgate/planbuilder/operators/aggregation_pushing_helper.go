@@ -134,8 +134,8 @@ func (ab *aggBuilder) handleAggr(ctx *plancontext.PlanningContext, aggr Aggr) er
 		return ab.handlePushThroughAggregation(ctx, aggr)
 	case opcode.AggregateGroupConcat:
 		f := aggr.Func.(*sqlparser.GroupConcatExpr)
-		if f.Distinct || len(f.OrderBy) > 0 {
-			panic(vterrors.VT12001("cannot evaluate group concat with distinct or order by"))
+		if f.Distinct && len(f.OrderBy) > 0 {
+			panic(vterrors.VT12001("cannot evaluate group concat with distinct and order by"))
 		}
 		// this needs special handling, currently aborting the push of function
 		// and later will try pushing the column instead.
@@ -527,6 +527,25 @@ func (a *Aggregator) pushRemainingGroupingColumnsAndWeightStrings(ctx *planconte
 		offset := a.internalAddColumn(ctx, aeWrap(weightStringFor(arg)), false)
 		a.Aggregations[idx].WSOffset = offset
 	}
+	for idx, aggr := range a.Aggregations {
+		orderBy := aggr.orderedGroupConcat()
+		if len(orderBy) == 0 || aggr.OrderOffsets != nil {
+			continue
+		}
+		offsets := make([]int, 0, len(orderBy))
+		wsOffsets := make([]int, 0, len(orderBy))
+		for _, order := range orderBy {
+			expr := aggr.OrderByExpr(order)
+			offsets = append(offsets, a.internalAddColumn(ctx, aeWrap(expr), false))
+			wsOffset := -1
+			if ctx.NeedsWeightString(expr) {
+				wsOffset = a.internalAddColumn(ctx, aeWrap(weightStringFor(expr)), false)
+			}
+			wsOffsets = append(wsOffsets, wsOffset)
+		}
+		a.Aggregations[idx].OrderOffsets = offsets
+		a.Aggregations[idx].OrderWSOffsets = wsOffsets
+	}
 }
 
 func (a *Aggregator) setTruncateColumnCount(offset int) {
@@ -82,8 +82,10 @@ func addWherePredsToSubQueryBuilder(ctx *plancontext.PlanningContext, expr sqlpa
 		if subq != nil {
 			continue
 		}
-		op = op.AddPredicate(ctx, expr)
-		addColumnEquality(ctx, expr)
+		for _, pred := range sargablePredicates(ctx, expr) {
+			op = op.AddPredicate(ctx, pred)
+			addColumnEquality(ctx, pred)
+		}
 	}
 	return op
 }
@@ -48,8 +48,18 @@ func checkValid(op Operator) {
 		CheckValid()
 	}
 
+	// the inputs of the joins with predicates that can end up on the RHS of the
+	// join are not checked, since they still get predicates from the join when
+	// it gets planned
+	joined := map[Operator]bool{}
 	_ = Visit(op, func(this Operator) error {
-		if chk, ok := this.(checkable); ok {
+		if join, ok := this.(*Join); ok && join.Predicate != nil {
+			joined[join.RHS] = true
+			if join.JoinType.IsCommutative() {
+				joined[join.LHS] = true
+			}
+		}
+		if chk, ok := this.(checkable); ok && !joined[this] {
 			chk.CheckValid()
 		}
 		return nil
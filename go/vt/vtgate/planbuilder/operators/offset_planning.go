@@ -21,6 +21,7 @@ import (
 
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 )
@@ -95,6 +96,43 @@ func useOffsets(ctx *plancontext.PlanningContext, expr sqlparser.Expr, op Operat
 	return rewritten.(sqlparser.Expr)
 }
 
+// evalOnInput translates an expression to be evaluated on the rows of the operator
+// by the vtgate. It returns nil if the operator already returns the expression, if
+// it needs a value the operator does not return, or if the vtgate can't evaluate it.
+func evalOnInput(ctx *plancontext.PlanningContext, expr sqlparser.Expr, op Operator) evalengine.Expr {
+	if op.FindCol(ctx, expr, false) >= 0 {
+		return nil
+	}
+
+	var exprOffset *sqlparser.Offset
+	missing := false
+	found := func(e sqlparser.Expr, offset int) { exprOffset = sqlparser.NewOffset(offset, e) }
+	notFound := func(sqlparser.Expr) { missing = true }
+
+	visitor := getOffsetRewritingVisitor(ctx, op.FindCol, found, notFound)
+	up := func(cursor *sqlparser.CopyOnWriteCursor) {
+		if exprOffset != nil {
+			cursor.Replace(exprOffset)
+			exprOffset = nil
+		}
+	}
+
+	rewritten := sqlparser.CopyOnRewrite(expr, visitor, up, ctx.SemTable.CopySemanticInfo)
+	if missing {
+		return nil
+	}
+
+	eexpr, err := evalengine.Translate(rewritten.(sqlparser.Expr), &evalengine.Config{
+		ResolveType: ctx.TypeForExpr,
+		Collation:   ctx.SemTable.Collation,
+		Environment: ctx.VSchema.Environment(),
+	})
+	if err != nil {
+		return nil
+	}
+	return eexpr
+}
+
 func findAggregatorInSource(op Operator) *Aggregator {
 	// we'll just loop through the inputs until we find the aggregator
 	for {
@@ -22,6 +22,7 @@ import (
 
 	"vitess.io/vitess/go/slice"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
 )
 
@@ -29,6 +30,10 @@ type Ordering struct {
 	Source  Operator
 	Offset  []int
 	WOffset []int
+	// Exprs holds, for each ordering, the expression the vtgate evaluates on
+	// the rows of the source to sort them, or nil if it sorts on the column
+	// at Offset
+	Exprs []evalengine.Expr
 
 	Order         []OrderBy
 	ResultColumns int
@@ -39,6 +44,7 @@ func (o *Ordering) Clone(inputs []Operator) Operator {
 		Source:        inputs[0],
 		Offset:        slices.Clone(o.Offset),
 		WOffset:       slices.Clone(o.WOffset),
+		Exprs:         slices.Clone(o.Exprs),
 		Order:         slices.Clone(o.Order),
 		ResultColumns: o.ResultColumns,
 	}
@@ -83,6 +89,16 @@ func (o *Ordering) GetOrdering(*plancontext.PlanningContext) []OrderBy {
 
 func (o *Ordering) planOffsets(ctx *plancontext.PlanningContext) Operator {
 	for _, order := range o.Order {
+		if !ctx.NeedsWeightString(order.SimplifiedExpr) {
+			if expr := evalOnInput(ctx, order.SimplifiedExpr, o.Source); expr != nil {
+				o.Offset = append(o.Offset, -1)
+				o.WOffset = append(o.WOffset, -1)
+				o.Exprs = append(o.Exprs, expr)
+				continue
+			}
+		}
+		o.Exprs = append(o.Exprs, nil)
+
 		offset := o.Source.AddColumn(ctx, true, false, aeWrap(order.SimplifiedExpr))
 		o.Offset = append(o.Offset, offset)
 
@@ -70,6 +70,9 @@ func PlanQuery(ctx *plancontext.PlanningContext, stmt sqlparser.Statement) (resu
 	op = compact(ctx, op)
 	checkValid(op)
 	op = planQuery(ctx, op)
+	// the joined vindex functions get their value from the join predicates
+	// during planning, so we check them again once the joins are planned
+	checkValid(op)
 
 	_, isRoute := op.(*Route)
 	if !isRoute && ctx.SemTable.NotSingleRouteErr != nil {
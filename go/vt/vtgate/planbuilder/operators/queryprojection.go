@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -82,6 +83,11 @@ type (
 		ColOffset int // Offset for the column being aggregated
 		WSOffset  int // Offset for the weight string of the column
 
+		// Offsets of the ORDER BY expressions of a GROUP_CONCAT that is evaluated at the vtgate level,
+		// and of their weight strings (-1 if not needed)
+		OrderOffsets   []int
+		OrderWSOffsets []int
+
 		SubQueryExpression []*SubQuery // Subqueries associated with this aggregation
 
 		PushedDown bool // Whether the aggregation has been pushed down to the next layer
@@ -92,6 +98,32 @@ func (aggr Aggr) NeedsWeightString(ctx *plancontext.PlanningContext) bool {
 	return aggr.OpCode.NeedsComparableValues() && ctx.NeedsWeightString(aggr.Func.GetArg())
 }
 
+// orderedGroupConcat returns the ORDER BY of a GROUP_CONCAT. Its values have to be
+// sorted before they are concatenated, so it can't be evaluated by concatenating
+// the results of several shards.
+func (aggr Aggr) orderedGroupConcat() sqlparser.OrderBy {
+	f, ok := aggr.Func.(*sqlparser.GroupConcatExpr)
+	if !ok || aggr.OpCode != opcode.AggregateGroupConcat || f.Distinct {
+		return nil
+	}
+	return f.OrderBy
+}
+
+// OrderByExpr returns the expression of an ORDER BY of a GROUP_CONCAT. As in MySQL,
+// an integer literal refers to an argument of the function by its position.
+func (aggr Aggr) OrderByExpr(order *sqlparser.Order) sqlparser.Expr {
+	lit, ok := order.Expr.(*sqlparser.Literal)
+	if !ok || lit.Type != sqlparser.IntVal {
+		return order.Expr
+	}
+	args := aggr.Func.GetArgs()
+	num, err := strconv.Atoi(lit.Val)
+	if err != nil || num < 1 || num > len(args) {
+		panic(vterrors.VT03014(lit.Val, "order clause"))
+	}
+	return args[num-1]
+}
+
 func (aggr Aggr) GetTypeCollation(ctx *plancontext.PlanningContext) evalengine.Type {
 	if aggr.Func == nil {
 		return evalengine.NewUnknownType()
@@ -100,6 +132,10 @@ func (aggr Aggr) GetTypeCollation(ctx *plancontext.PlanningContext) evalengine.T
 	case opcode.AggregateMin, opcode.AggregateMax, opcode.AggregateSumDistinct, opcode.AggregateCountDistinct:
 		typ, _ := ctx.TypeForExpr(aggr.Func.GetArg())
 		return typ
+	case opcode.AggregateGroupConcat:
+		// the collation of the values is needed to convert the separator to their charset
+		typ, _ := ctx.TypeForExpr(aggr.Func.GetArg())
+		return typ
 
 	}
 	return evalengine.Type{}
@@ -54,7 +54,9 @@ type (
 		AST sqlparser.Expr
 		// Offset and WOffset will contain the offset to the column (and the weightstring column). -1 if it's missing
 		Offset, WOffset int
-		Direction       sqlparser.OrderDirection
+		// Expr, if set, is evaluated on the rows to merge them instead of reading the column at Offset
+		Expr      evalengine.Expr
+		Direction sqlparser.OrderDirection
 	}
 
 	// VindexPlusPredicates is a struct used to store all the predicates that the vindex can be used to query
@@ -77,6 +79,9 @@ type (
 		OpCode      engine.Opcode
 		FoundVindex vindexes.Vindex
 		Cost        Cost
+		// ScatterOnNull is set when the value is NULL for the rows of all the shards,
+		// see engine.RoutingParameters.ScatterOnNull
+		ScatterOnNull bool
 	}
 
 	// Cost is used to make it easy to compare the Cost of two plans with each other
@@ -121,6 +126,15 @@ func UpdateRoutingLogic(ctx *plancontext.PlanningContext, expr sqlparser.Expr, r
 	}
 	nr := &NoneRouting{keyspace: ks}
 
+	// The simplified predicate is only used to route the query: the predicate
+	// sent to MySQL is the original one, since folding e.g. a CAST changes the
+	// collation and the type of the comparison
+	expr = simplifyPredicate(ctx, expr)
+	if expr == sqlparser.BoolVal(true) {
+		// a tautology doesn't filter anything
+		return r
+	}
+
 	if isConstantFalse(ctx.VSchema.Environment(), expr, ctx.VSchema.ConnCollation()) {
 		return nr
 	}
@@ -163,6 +177,16 @@ func UpdateRoutingLogic(ctx *plancontext.PlanningContext, expr sqlparser.Expr, r
 	return exit()
 }
 
+// simplifyPredicate folds the constant subexpressions of a predicate and removes its
+// tautologies, so that e.g. `id = 1 + 1 AND 1 = 1` is routed like `id = 2`, and
+// `id = 5 OR 1 = 0` can be routed to the shard of id 5.
+func simplifyPredicate(ctx *plancontext.PlanningContext, expr sqlparser.Expr) sqlparser.Expr {
+	return evalengine.SimplifyPredicate(expr, &evalengine.Config{
+		Collation:   ctx.SemTable.Collation,
+		Environment: ctx.VSchema.Environment(),
+	})
+}
+
 // isConstantFalse checks whether this predicate can be evaluated at plan-time. If it returns `false` or `null`,
 // we know that the query will not return anything, and this can be used to produce better plans
 func isConstantFalse(env *vtenv.Environment, expr sqlparser.Expr, collation collations.ID) bool {
@@ -240,13 +264,14 @@ func copyOption(orig *VindexOption) *VindexOption {
 		colsSeen[k] = v
 	}
 	vo := &VindexOption{
-		Values:      values,
-		ColsSeen:    colsSeen,
-		ValueExprs:  valueExprs,
-		Predicates:  predicates,
-		OpCode:      orig.OpCode,
-		FoundVindex: orig.FoundVindex,
-		Cost:        orig.Cost,
+		Values:        values,
+		ColsSeen:      colsSeen,
+		ValueExprs:    valueExprs,
+		Predicates:    predicates,
+		OpCode:        orig.OpCode,
+		FoundVindex:   orig.FoundVindex,
+		Cost:          orig.Cost,
+		ScatterOnNull: orig.ScatterOnNull,
 	}
 	return vo
 }
@@ -789,6 +814,18 @@ func (r *Route) planOffsets(ctx *plancontext.PlanningContext) Operator {
 		if isSpecialOrderBy(order) {
 			continue
 		}
+		if !ctx.NeedsWeightString(order.SimplifiedExpr) {
+			if expr := evalOnInput(ctx, order.SimplifiedExpr, r); expr != nil {
+				r.Ordering = append(r.Ordering, RouteOrdering{
+					AST:       order.Inner.Expr,
+					Offset:    -1,
+					WOffset:   -1,
+					Expr:      expr,
+					Direction: order.Inner.Direction,
+				})
+				continue
+			}
+		}
 		offset := r.AddColumn(ctx, true, false, aeWrap(order.SimplifiedExpr))
 
 		o := RouteOrdering{
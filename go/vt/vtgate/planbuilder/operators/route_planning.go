@@ -287,12 +287,28 @@ func requiresSwitchingSides(ctx *plancontext.PlanningContext, op Operator) (requ
 	return
 }
 
+// needsLookupValue returns true if the given operator is a vindex function
+// that has no value to look up yet. It can only get one from a join predicate,
+// as a bind variable, which requires it to be on the RHS of the join.
+func needsLookupValue(op Operator) bool {
+	vindex, isVindex := op.(*Vindex)
+	return isVindex && vindex.OpCode == engine.VindexNone
+}
+
 func mergeOrJoin(ctx *plancontext.PlanningContext, lhs, rhs Operator, joinPredicates []sqlparser.Expr, joinType sqlparser.JoinType) (Operator, *ApplyResult) {
 	newPlan := mergeJoinInputs(ctx, lhs, rhs, joinPredicates, newJoinMerge(joinPredicates, joinType))
 	if newPlan != nil {
 		return newPlan, Rewrote("merge routes into single operator")
 	}
 
+	if len(joinPredicates) > 0 && needsLookupValue(lhs) && !needsLookupValue(rhs) && joinType.IsCommutative() {
+		join := NewApplyJoin(ctx, Clone(rhs), Clone(lhs), nil, joinType)
+		for _, pred := range joinPredicates {
+			join.AddJoinPredicate(ctx, pred)
+		}
+		return join, Rewrote("logical join to applyJoin, switching side to feed the vindex function")
+	}
+
 	if len(joinPredicates) > 0 && requiresSwitchingSides(ctx, rhs) {
 		if !joinType.IsCommutative() || requiresSwitchingSides(ctx, lhs) {
 			// we can't switch sides, so let's see if we can use a HashJoin to solve it
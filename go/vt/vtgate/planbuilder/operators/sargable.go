@@ -0,0 +1,275 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operators
+
+import (
+	"vitess.io/vitess/go/mysql/datetime"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+)
+
+// sargablePredicates rewrites a predicate that can't be used to look up a column,
+// because the column is wrapped in an expression, into one or more predicates on
+// the bare column, so that they can be used to pick a vindex and narrow down the
+// route. This is common in queries generated by ORMs, e.g. `DATE(col) = '2024-01-01'`
+// or `col + 0 = 5`.
+//
+// The rewrite only happens when it's provably equivalent, which depends on the type
+// of the column, so columns without a known type are left alone. The result must be
+// ANDed together; if nothing could be rewritten, the original predicate is returned.
+func sargablePredicates(ctx *plancontext.PlanningContext, expr sqlparser.Expr) []sqlparser.Expr {
+	cmp, ok := expr.(*sqlparser.ComparisonExpr)
+	if !ok {
+		return []sqlparser.Expr{expr}
+	}
+
+	if preds := sargableDateComparison(ctx, cmp); preds != nil {
+		return preds
+	}
+
+	if !sargableArithmeticOperator(cmp.Operator) {
+		return []sqlparser.Expr{expr}
+	}
+	left, leftChanged := stripArithmeticIdentity(ctx, cmp.Left)
+	right, rightChanged := stripArithmeticIdentity(ctx, cmp.Right)
+	if !leftChanged && !rightChanged {
+		return []sqlparser.Expr{expr}
+	}
+	return []sqlparser.Expr{&sqlparser.ComparisonExpr{
+		Operator: cmp.Operator,
+		Modifier: cmp.Modifier,
+		Left:     left,
+		Right:    right,
+		Escape:   cmp.Escape,
+	}}
+}
+
+// sargableArithmeticOperator returns true if the result of the comparison only depends
+// on the numeric values that are compared, and not on how they are formatted.
+func sargableArithmeticOperator(op sqlparser.ComparisonExprOperator) bool {
+	switch op {
+	case sqlparser.EqualOp, sqlparser.NotEqualOp, sqlparser.NullSafeEqualOp,
+		sqlparser.LessThanOp, sqlparser.LessEqualOp, sqlparser.GreaterThanOp, sqlparser.GreaterEqualOp,
+		sqlparser.InOp, sqlparser.NotInOp:
+		return true
+	default:
+		return false
+	}
+}
+
+// stripArithmeticIdentity removes the additions and subtractions of 0 and the
+// multiplications by 1 from a numeric column: `col + 0`, `0 + col`, `col - 0`,
+// `col * 1` and `1 * col` all have the same value as `col`. This is not true for
+// columns of other types, which are converted to numbers by the arithmetic.
+func stripArithmeticIdentity(ctx *plancontext.PlanningContext, expr sqlparser.Expr) (sqlparser.Expr, bool) {
+	bin, ok := expr.(*sqlparser.BinaryExpr)
+	if !ok {
+		return expr, false
+	}
+
+	var inner sqlparser.Expr
+	switch bin.Operator {
+	case sqlparser.PlusOp:
+		if isIntLiteral(bin.Right, "0") {
+			inner = bin.Left
+		} else if isIntLiteral(bin.Left, "0") {
+			inner = bin.Right
+		}
+	case sqlparser.MinusOp:
+		if isIntLiteral(bin.Right, "0") {
+			inner = bin.Left
+		}
+	case sqlparser.MultOp:
+		if isIntLiteral(bin.Right, "1") {
+			inner = bin.Left
+		} else if isIntLiteral(bin.Left, "1") {
+			inner = bin.Right
+		}
+	}
+	if inner == nil {
+		return expr, false
+	}
+
+	inner, _ = stripArithmeticIdentity(ctx, inner)
+	if !isColumnOfType(ctx, inner, sqltypes.IsNumber) {
+		return expr, false
+	}
+	return inner, true
+}
+
+// sargableDateComparison rewrites a comparison between `DATE(col)` and a date literal,
+// where col is a DATE, DATETIME or TIMESTAMP column, into a range of values of col:
+// e.g. `DATE(col) = '2024-01-01'` is `col >= '2024-01-01' AND col < '2024-01-02'`.
+// It returns nil if the comparison can't be rewritten.
+func sargableDateComparison(ctx *plancontext.PlanningContext, cmp *sqlparser.ComparisonExpr) []sqlparser.Expr {
+	op := cmp.Operator
+	fn, ok := cmp.Left.(*sqlparser.FuncExpr)
+	lit := cmp.Right
+	if !ok {
+		// The literal is on the left side, so the comparison is flipped
+		fn, ok = cmp.Right.(*sqlparser.FuncExpr)
+		if !ok {
+			return nil
+		}
+		lit = cmp.Left
+		switch op {
+		case sqlparser.LessThanOp:
+			op = sqlparser.GreaterThanOp
+		case sqlparser.LessEqualOp:
+			op = sqlparser.GreaterEqualOp
+		case sqlparser.GreaterThanOp:
+			op = sqlparser.LessThanOp
+		case sqlparser.GreaterEqualOp:
+			op = sqlparser.LessEqualOp
+		}
+	}
+
+	if !fn.Qualifier.IsEmpty() || !fn.Name.EqualString("date") || len(fn.Exprs) != 1 {
+		return nil
+	}
+	col := fn.Exprs[0]
+	if !isColumnOfType(ctx, col, sqltypes.IsDate) {
+		return nil
+	}
+	if arg, ok := lit.(*sqlparser.Argument); ok {
+		return sargableDateArgument(cmp, op, col, arg)
+	}
+	day, next, ok := parseDateLiteral(lit)
+	if !ok {
+		return nil
+	}
+
+	compare := func(op sqlparser.ComparisonExprOperator, date datetime.Date) sqlparser.Expr {
+		return &sqlparser.ComparisonExpr{
+			Operator: op,
+			Left:     col,
+			Right:    sqlparser.NewStrLiteral(string(date.Format())),
+		}
+	}
+
+	switch op {
+	case sqlparser.EqualOp:
+		return []sqlparser.Expr{compare(sqlparser.GreaterEqualOp, day), compare(sqlparser.LessThanOp, next)}
+	case sqlparser.LessThanOp:
+		return []sqlparser.Expr{compare(sqlparser.LessThanOp, day)}
+	case sqlparser.LessEqualOp:
+		return []sqlparser.Expr{compare(sqlparser.LessThanOp, next)}
+	case sqlparser.GreaterThanOp:
+		return []sqlparser.Expr{compare(sqlparser.GreaterEqualOp, next)}
+	case sqlparser.GreaterEqualOp:
+		return []sqlparser.Expr{compare(sqlparser.GreaterEqualOp, day)}
+	default:
+		return nil
+	}
+}
+
+// sargableDateArgument rewrites a comparison between `DATE(col)` and an argument,
+// like the ones of the normalized queries, which is op once the comparison is
+// flipped so that `DATE(col)` is on the left side. The value of the argument is only
+// known at execution time, so it can't be checked to be a date like a literal. The
+// range of values of col is computed from `DATE(:arg)` instead, which contains all
+// the values the comparison is true for, and the comparison is kept to only keep
+// the values it is really true for: e.g. `DATE(col) = :day` is
+// `col >= DATE(:day) AND col < DATE(:day) + INTERVAL 1 DAY AND DATE(col) = :day`.
+// It returns nil if the comparison can't be rewritten.
+func sargableDateArgument(cmp *sqlparser.ComparisonExpr, op sqlparser.ComparisonExprOperator, col sqlparser.Expr, arg *sqlparser.Argument) []sqlparser.Expr {
+	// Only the arguments that can hold a date are used; a number would be compared
+	// with the date as a number.
+	if arg.Type != sqltypes.Unknown && !sqltypes.IsText(arg.Type) && !sqltypes.IsDate(arg.Type) {
+		return nil
+	}
+
+	day := func() sqlparser.Expr {
+		return &sqlparser.FuncExpr{
+			Name:  sqlparser.NewIdentifierCI("date"),
+			Exprs: sqlparser.Exprs{sqlparser.Clone(arg)},
+		}
+	}
+	next := func() sqlparser.Expr {
+		return &sqlparser.IntervalDateExpr{
+			Syntax:   sqlparser.IntervalDateExprBinaryAdd,
+			Date:     day(),
+			Interval: sqlparser.NewIntLiteral("1"),
+			Unit:     datetime.IntervalDay,
+		}
+	}
+	compare := func(op sqlparser.ComparisonExprOperator, bound sqlparser.Expr) sqlparser.Expr {
+		return &sqlparser.ComparisonExpr{
+			Operator: op,
+			Left:     col,
+			Right:    bound,
+		}
+	}
+
+	switch op {
+	case sqlparser.EqualOp:
+		return []sqlparser.Expr{compare(sqlparser.GreaterEqualOp, day()), compare(sqlparser.LessThanOp, next()), cmp}
+	case sqlparser.LessThanOp, sqlparser.LessEqualOp:
+		return []sqlparser.Expr{compare(sqlparser.LessThanOp, next()), cmp}
+	case sqlparser.GreaterThanOp:
+		return []sqlparser.Expr{compare(sqlparser.GreaterEqualOp, next()), cmp}
+	case sqlparser.GreaterEqualOp:
+		return []sqlparser.Expr{compare(sqlparser.GreaterEqualOp, day()), cmp}
+	default:
+		return nil
+	}
+}
+
+// parseDateLiteral returns the date of a string or DATE literal, and the day after it.
+// Zero dates, dates with zero parts, invalid dates and the last supported day are
+// rejected, since they can't be turned into a range of values.
+func parseDateLiteral(expr sqlparser.Expr) (day, next datetime.Date, ok bool) {
+	lit, isLit := expr.(*sqlparser.Literal)
+	if !isLit || (lit.Type != sqlparser.StrVal && lit.Type != sqlparser.DateVal) {
+		return day, next, false
+	}
+	day, ok = datetime.ParseDate(lit.Val)
+	if !ok || day.Year() == 0 || day.Month() == 0 || day.Day() == 0 {
+		return day, next, false
+	}
+	// Going through the day number also rejects invalid dates, like February 30th
+	daynr := datetime.MysqlDayNumber(day.Year(), day.Month(), day.Day())
+	if datetime.DateFromDayNumber(daynr) != day {
+		return day, next, false
+	}
+	next = datetime.DateFromDayNumber(daynr + 1)
+	if next.IsZero() {
+		return day, next, false
+	}
+	return day, next, true
+}
+
+func isIntLiteral(expr sqlparser.Expr, val string) bool {
+	lit, ok := expr.(*sqlparser.Literal)
+	return ok && lit.Type == sqlparser.IntVal && lit.Val == val
+}
+
+// isColumnOfType returns true if expr is a column whose type is known and matches is.
+// YEAR and BIT columns never match, even though they are integral types: they are
+// compared with their literals differently than the result of an arithmetic on them,
+// e.g. `y = 5` matches the year 2005, while `y + 0 = 5` doesn't.
+func isColumnOfType(ctx *plancontext.PlanningContext, expr sqlparser.Expr, is func(sqltypes.Type) bool) bool {
+	if _, ok := expr.(*sqlparser.ColName); !ok {
+		return false
+	}
+	typ, found := ctx.SemTable.TypeForExpr(expr)
+	if !found || typ.Type() == sqltypes.Year || typ.Type() == sqltypes.Bit {
+		return false
+	}
+	return is(typ.Type())
+}
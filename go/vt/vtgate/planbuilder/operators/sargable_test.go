@@ -0,0 +1,146 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+func TestSargablePredicates(t *testing.T) {
+	columnTypes := map[string]sqltypes.Type{
+		"id":       sqltypes.Int64,
+		"price":    sqltypes.Decimal,
+		"name":     sqltypes.VarChar,
+		"birthday": sqltypes.Date,
+		"created":  sqltypes.Datetime,
+		"updated":  sqltypes.Timestamp,
+		"y":        sqltypes.Year,
+		"flags":    sqltypes.Bit,
+	}
+
+	tests := []struct {
+		expr     string
+		expected []string
+	}{
+		// arithmetic identities on numeric columns
+		{"id + 0 = 5", []string{"id = 5"}},
+		{"0 + id = 5", []string{"id = 5"}},
+		{"id - 0 in (1, 2)", []string{"id in (1, 2)"}},
+		{"5 = id * 1", []string{"5 = id"}},
+		{"1 * id + 0 > 5", []string{"id > 5"}},
+		{"price * 1 <= 3.5", []string{"price <= 3.5"}},
+		{"id + 0 = other + 0", []string{"id = other + 0"}},
+		// not identities, or not equivalent
+		{"id + 1 = 5", []string{"id + 1 = 5"}},
+		{"id - 0 like '5%'", []string{"id - 0 like '5%'"}},
+		{"name + 0 = 5", []string{"`name` + 0 = 5"}},
+		{"unknown + 0 = 5", []string{"`unknown` + 0 = 5"}},
+		{"y + 0 = 5", []string{"y + 0 = 5"}},
+		{"flags + 0 = 5", []string{"flags + 0 = 5"}},
+		// DATE() on date columns
+		{"date(created) = '2024-02-28'", []string{"created >= '2024-02-28'", "created < '2024-02-29'"}},
+		{"date(updated) = '2024-12-31'", []string{"updated >= '2024-12-31'", "updated < '2025-01-01'"}},
+		{"date(birthday) < '2024-01-01'", []string{"birthday < '2024-01-01'"}},
+		{"date(created) <= '2024-01-01'", []string{"created < '2024-01-02'"}},
+		{"date(created) > '2024-01-01'", []string{"created >= '2024-01-02'"}},
+		{"date(created) >= date'2024-01-01'", []string{"created >= '2024-01-01'"}},
+		{"'2024-01-01' > date(created)", []string{"created < '2024-01-01'"}},
+		{"'2024-01-01' = date(created)", []string{"created >= '2024-01-01'", "created < '2024-01-02'"}},
+		// DATE() that can't be rewritten
+		{"date(created) != '2024-01-01'", []string{"date(created) != '2024-01-01'"}},
+		{"date(created) = '2024-02-30'", []string{"date(created) = '2024-02-30'"}},
+		{"date(created) = '2024-01-01 10:00:00'", []string{"date(created) = '2024-01-01 10:00:00'"}},
+		{"date(created) = '0000-00-00'", []string{"date(created) = '0000-00-00'"}},
+		// DATE() compared with arguments, whose values are only known at execution time
+		{"date(created) = :day", []string{"created >= date(:day)", "created < date(:day) + interval 1 day", "date(created) = :day"}},
+		{"date(birthday) < :day", []string{"birthday < date(:day) + interval 1 day", "date(birthday) < :day"}},
+		{"date(created) <= :day", []string{"created < date(:day) + interval 1 day", "date(created) <= :day"}},
+		{"date(created) > :day", []string{"created >= date(:day) + interval 1 day", "date(created) > :day"}},
+		{"date(updated) >= :day", []string{"updated >= date(:day)", "date(updated) >= :day"}},
+		{":day > date(created)", []string{"created < date(:day) + interval 1 day", ":day > date(created)"}},
+		{"date(created) != :day", []string{"date(created) != :day"}},
+		{"date(name) = :day", []string{"date(`name`) = :day"}},
+		{"date(name) = '2024-01-01'", []string{"date(`name`) = '2024-01-01'"}},
+		{"date(unknown) = '2024-01-01'", []string{"date(`unknown`) = '2024-01-01'"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := sqlparser.NewTestParser().ParseExpr(tt.expr)
+			require.NoError(t, err)
+
+			ctx := &plancontext.PlanningContext{SemTable: semantics.EmptySemTable()}
+			_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+				if col, ok := node.(*sqlparser.ColName); ok {
+					if typ, ok := columnTypes[col.Name.String()]; ok {
+						ctx.SemTable.ExprTypes[col] = evalengine.NewType(typ, collations.Unknown)
+					}
+				}
+				return true, nil
+			}, expr)
+
+			var preds []string
+			for _, pred := range sargablePredicates(ctx, expr) {
+				preds = append(preds, sqlparser.String(pred))
+			}
+			assert.Equal(t, tt.expected, preds)
+		})
+	}
+}
+
+func TestSargableDateArgumentTypes(t *testing.T) {
+	col := sqlparser.NewColName("created")
+	ctx := &plancontext.PlanningContext{SemTable: semantics.EmptySemTable()}
+	ctx.SemTable.ExprTypes[col] = evalengine.NewType(sqltypes.Datetime, collations.Unknown)
+
+	tests := []struct {
+		typ       sqltypes.Type
+		rewritten bool
+	}{
+		{sqltypes.Unknown, true},
+		{sqltypes.VarChar, true},
+		{sqltypes.Date, true},
+		{sqltypes.Datetime, true},
+		{sqltypes.Int64, false},
+		{sqltypes.Decimal, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ.String(), func(t *testing.T) {
+			cmp := &sqlparser.ComparisonExpr{
+				Operator: sqlparser.EqualOp,
+				Left:     &sqlparser.FuncExpr{Name: sqlparser.NewIdentifierCI("date"), Exprs: sqlparser.Exprs{col}},
+				Right:    sqlparser.NewTypedArgument("day", tt.typ),
+			}
+			preds := sargablePredicates(ctx, cmp)
+			if tt.rewritten {
+				assert.Len(t, preds, 3)
+			} else {
+				assert.Equal(t, []sqlparser.Expr{cmp}, preds)
+			}
+		})
+	}
+}
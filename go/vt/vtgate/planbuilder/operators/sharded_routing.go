@@ -168,6 +168,7 @@ func (tr *ShardedRouting) UpdateRoutingParams(_ *plancontext.PlanningContext, rp
 	if tr.Selected != nil {
 		rp.Vindex = tr.Selected.FoundVindex
 		rp.Values = tr.Selected.Values
+		rp.ScatterOnNull = tr.Selected.ScatterOnNull
 	}
 }
 
@@ -230,6 +231,10 @@ func (tr *ShardedRouting) searchForNewVindexes(ctx *plancontext.PlanningContext,
 	case *sqlparser.IsExpr:
 		found := tr.planIsExpr(ctx, node)
 		newVindexFound = newVindexFound || found
+
+	case *sqlparser.OrExpr:
+		found := tr.planOptionalOrExpr(ctx, node)
+		newVindexFound = newVindexFound || found
 	}
 
 	return nil, newVindexFound
@@ -491,19 +496,134 @@ func (tr *ShardedRouting) planEqualOp(ctx *plancontext.PlanningContext, node *sq
 	if !ok {
 		column, ok = node.Right.(*sqlparser.ColName)
 		if !ok {
-			// either the LHS or RHS have to be a column to be useful for the vindex
-			return false
+			// either the LHS or RHS have to be a column to be useful for the vindex,
+			// possibly wrapped in functions that don't change its value
+			return tr.planWrappedEqualOp(ctx, node)
 		}
 		vdValue = node.Left
 	}
 	val := makeEvalEngineExpr(ctx, vdValue)
 	if val == nil {
-		return false
+		return tr.planOptionalEqualOp(ctx, node, column, vdValue)
 	}
 
 	return tr.haveMatchingVindex(ctx, node, vdValue, column, val, equalOrEqualUnique, justTheVindex)
 }
 
+// planOptionalEqualOp plans the optional filters of parameterized queries that
+// compare the column with a value that uses the column itself as its fallback,
+// e.g. `id = coalesce(:id, id)`. See evalengine.RoutingValue.
+func (tr *ShardedRouting) planOptionalEqualOp(ctx *plancontext.PlanningContext, node *sqlparser.ComparisonExpr, column *sqlparser.ColName, vdValue sqlparser.Expr) bool {
+	vdValue, ok := evalengine.RoutingValue(vdValue, func(col *sqlparser.ColName) bool {
+		return ctx.SemTable.EqualsExprWithDeps(col, column)
+	})
+	if !ok {
+		return false
+	}
+	return tr.planEqualOrScatterOp(ctx, node, column, vdValue)
+}
+
+// planOptionalOrExpr plans the optional filters written as `:id is null or id = :id`,
+// which are the same as `id = coalesce(:id, id)`.
+func (tr *ShardedRouting) planOptionalOrExpr(ctx *plancontext.PlanningContext, node *sqlparser.OrExpr) bool {
+	for _, sides := range [][2]sqlparser.Expr{{node.Left, node.Right}, {node.Right, node.Left}} {
+		isNull, ok := sides[0].(*sqlparser.IsExpr)
+		if !ok || isNull.Right != sqlparser.IsNullOp {
+			continue
+		}
+		cmp, ok := sides[1].(*sqlparser.ComparisonExpr)
+		if !ok || cmp.Operator != sqlparser.EqualOp {
+			continue
+		}
+		column, vdValue := cmp.Left, cmp.Right
+		if !ctx.SemTable.EqualsExprWithDeps(vdValue, isNull.Left) {
+			column, vdValue = vdValue, column
+		}
+		col, ok := column.(*sqlparser.ColName)
+		if !ok || !ctx.SemTable.EqualsExprWithDeps(vdValue, isNull.Left) {
+			continue
+		}
+		return tr.planEqualOrScatterOp(ctx, node, col, vdValue)
+	}
+	return false
+}
+
+// planEqualOrScatterOp plans a predicate that is true in the rows where the
+// column is equal to the value, or in any row when the value is NULL. It's
+// routed to the shards of the value, or to all the shards when it's NULL.
+func (tr *ShardedRouting) planEqualOrScatterOp(ctx *plancontext.PlanningContext, node sqlparser.Expr, column *sqlparser.ColName, vdValue sqlparser.Expr) bool {
+	val := makeEvalEngineExpr(ctx, vdValue)
+	if val == nil {
+		return false
+	}
+	// The lookup vindexes are planned with their own primitive, which doesn't
+	// scatter on NULL.
+	opcode := func(vindex *vindexes.ColumnVindex) engine.Opcode {
+		if _, ok := vindex.Vindex.(vindexes.Lookup); ok {
+			return engine.Scatter
+		}
+		return engine.Equal
+	}
+
+	newVindexFound := false
+	for _, v := range tr.VindexPreds {
+		if !ctx.SemTable.DirectDeps(column).IsSolvedBy(v.TableID) {
+			continue
+		}
+		if _, ok := v.ColVindex.Vindex.(vindexes.SingleColumn); !ok {
+			continue
+		}
+		if tr.processSingleColumnVindex(node, vdValue, column, val, opcode, justTheVindex, v, false) {
+			v.Options[len(v.Options)-1].ScatterOnNull = true
+			newVindexFound = true
+		}
+	}
+	return newVindexFound
+}
+
+// planWrappedEqualOp plans the comparison of a value with a column wrapped in
+// functions that don't change its value, e.g. `nullif(id, 0) = :id`, which
+// can only be true in the rows where the column is equal to the value.
+func (tr *ShardedRouting) planWrappedEqualOp(ctx *plancontext.PlanningContext, node *sqlparser.ComparisonExpr) bool {
+	for _, sides := range [][2]sqlparser.Expr{{node.Left, node.Right}, {node.Right, node.Left}} {
+		wrapped, vdValue := sides[0], sides[1]
+		column := routingColumn(ctx, wrapped)
+		if column == nil {
+			continue
+		}
+		val := makeEvalEngineExpr(ctx, vdValue)
+		if val == nil {
+			continue
+		}
+		return tr.haveMatchingVindex(ctx, node, vdValue, column, val, equalOrEqualUnique, justTheVindex)
+	}
+	return false
+}
+
+// routingColumn returns the column wrapped in the given expression whose value
+// is that of the expression, if any. See evalengine.RoutingColumn.
+func routingColumn(ctx *plancontext.PlanningContext, expr sqlparser.Expr) *sqlparser.ColName {
+	var columns []*sqlparser.ColName
+	ee, err := evalengine.Translate(expr, &evalengine.Config{
+		ResolveColumn: func(col *sqlparser.ColName) (int, error) {
+			columns = append(columns, col)
+			return len(columns) - 1, nil
+		},
+		ResolveType:   ctx.TypeForExpr,
+		Collation:     ctx.SemTable.Collation,
+		Environment:   ctx.VSchema.Environment(),
+		NoCompilation: true,
+	})
+	if err != nil {
+		return nil
+	}
+	col, ok := evalengine.RoutingColumn(ee)
+	if !ok {
+		return nil
+	}
+	return columns[col.Offset]
+}
+
 func (tr *ShardedRouting) planCompositeInOpRecursive(
 	ctx *plancontext.PlanningContext,
 	cmp *sqlparser.ComparisonExpr,
@@ -207,13 +207,22 @@ func (sq *SubQuery) GetMergePredicates() []sqlparser.Expr {
 	return sq.Predicates
 }
 
+// negated returns true for NOT EXISTS and NOT IN subqueries, which keep the
+// rows of the outer query for which the subquery finds no match.
+func (sq *SubQuery) negated() bool {
+	return sq.FilterType == opcode.PulloutNotExists || sq.FilterType == opcode.PulloutNotIn
+}
+
 func (sq *SubQuery) settle(ctx *plancontext.PlanningContext, outer Operator) Operator {
 	// We can allow uncorrelated queries even when subquery isn't the top level construct,
 	// like if its underneath an Aggregator, because they will be pulled out and run separately.
 	if !sq.TopLevel && sq.correlated {
 		panic(subqueryNotAtTopErr)
 	}
-	if sq.correlated && sq.FilterType != opcode.PulloutExists {
+	if sq.correlated && !sq.FilterType.IsExists() {
+		panic(correlatedSubqueryErr)
+	}
+	if sq.correlated && sq.usesDerivedTableColumns(ctx, outer) {
 		panic(correlatedSubqueryErr)
 	}
 	if sq.IsArgument {
@@ -227,6 +236,37 @@ func (sq *SubQuery) settle(ctx *plancontext.PlanningContext, outer Operator) Ope
 	return sq.settleFilter(ctx, outer)
 }
 
+// usesDerivedTableColumns returns true if the predicates of the subquery use
+// columns of tables that are inside a derived table of the outer query. These
+// columns are not visible from the outer query, so they can't be passed to the
+// subquery, which can happen when the subquery was written inside the derived
+// table and the derived table was merged with the outer query.
+func (sq *SubQuery) usesDerivedTableColumns(ctx *plancontext.PlanningContext, outer Operator) bool {
+	var hidden semantics.TableSet
+	_ = Visit(outer, func(op Operator) error {
+		switch op := op.(type) {
+		case *Horizon:
+			if op.IsDerived() {
+				hidden = hidden.Merge(TableID(op.Source))
+			}
+		case *Projection:
+			if op.DT != nil {
+				hidden = hidden.Merge(TableID(op.Source))
+			}
+		}
+		return nil
+	})
+	if hidden.IsEmpty() {
+		return false
+	}
+	for _, pred := range sq.Predicates {
+		if ctx.SemTable.RecursiveDeps(pred).IsOverlapping(hidden) {
+			return true
+		}
+	}
+	return false
+}
+
 var correlatedSubqueryErr = vterrors.VT12001("correlated subquery is only supported for EXISTS")
 var subqueryNotAtTopErr = vterrors.VT12001("unmergable subquery can not be inside complex expression")
 
@@ -241,9 +281,12 @@ func (sq *SubQuery) addLimit() {
 
 func (sq *SubQuery) settleFilter(ctx *plancontext.PlanningContext, outer Operator) Operator {
 	if len(sq.Predicates) > 0 {
-		if sq.FilterType != opcode.PulloutExists {
+		if !sq.FilterType.IsExists() {
 			panic(correlatedSubqueryErr)
 		}
+		// the subquery is evaluated for each row of the outer query, which is
+		// kept or not depending on whether the subquery returned any row, so
+		// NOT EXISTS becomes an anti-join
 		sq.addLimit()
 		return outer
 	}
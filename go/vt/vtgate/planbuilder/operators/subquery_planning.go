@@ -640,6 +640,11 @@ func (s *subqueryRouteMerger) merge(ctx *plancontext.PlanningContext, inner, out
 			ResultColumns: outer.ResultColumns,
 		}
 	}
+	if _, outerSharded := outer.Routing.(*ShardedRouting); outerSharded && s.subq.negated() {
+		// the outer query keeps the rows for which the subquery finds nothing, so
+		// the predicates of the subquery can't restrict the shards of the outer query
+		r = outer.Routing
+	}
 	_, isSharded := r.(*ShardedRouting)
 	var src Operator
 	if isSharded {
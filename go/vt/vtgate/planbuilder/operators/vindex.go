@@ -141,6 +141,12 @@ func (v *Vindex) AddPredicate(ctx *plancontext.PlanningContext, expr sqlparser.E
 		if comparison.Operator != sqlparser.EqualOp && comparison.Operator != sqlparser.InOp {
 			panic(vterrors.VT09018(wrongWhereCond + " (not equality)"))
 		}
+		if _, isCol := comparison.Right.(*sqlparser.ColName); isCol && comparison.Operator == sqlparser.EqualOp {
+			// <val> = id, e.g. the join predicates pushed with their value as a bind variable
+			if _, isCol := comparison.Left.(*sqlparser.ColName); !isCol {
+				comparison = &sqlparser.ComparisonExpr{Operator: sqlparser.EqualOp, Left: comparison.Right, Right: comparison.Left}
+			}
+		}
 		colname, ok := comparison.Left.(*sqlparser.ColName)
 		if !ok {
 			panic(vterrors.VT09018(wrongWhereCond + " (lhs is not a column)"))
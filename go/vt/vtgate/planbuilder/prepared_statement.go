@@ -18,7 +18,6 @@ package planbuilder
 
 import (
 	"context"
-	"regexp"
 	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -29,9 +28,6 @@ import (
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
 )
 
-// regexParams checks that argument names are in the form v1, v2, v3...
-var regexParams = regexp.MustCompile(`^v\d+`)
-
 func prepareStmt(ctx context.Context, vschema plancontext.VSchema, pStmt *sqlparser.PrepareStmt) (*planResult, error) {
 	stmtName := pStmt.Name.Lowered()
 	vschema.ClearPrepareData(stmtName)
@@ -58,10 +54,9 @@ func prepareStmt(ctx context.Context, vschema plancontext.VSchema, pStmt *sqlpar
 		return nil, err
 	}
 
-	count := countArguments(stmt)
 	vschema.StorePrepareData(stmtName, &vtgatepb.PrepareData{
 		PrepareStatement: sqlparser.String(stmt),
-		ParamsCount:      count,
+		ParamsCount:      int32(len(sqlparser.PreparedParams(stmt))),
 	})
 
 	return &planResult{
@@ -70,19 +65,6 @@ func prepareStmt(ctx context.Context, vschema plancontext.VSchema, pStmt *sqlpar
 	}, nil
 }
 
-func countArguments(stmt sqlparser.Statement) (paramsCount int32) {
-	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
-		switch node := node.(type) {
-		case *sqlparser.Argument:
-			if regexParams.MatchString(node.Name) {
-				paramsCount++
-			}
-		}
-		return true, nil
-	}, stmt)
-	return
-}
-
 func fetchUDVValue(vschema plancontext.VSchema, udv string) (string, error) {
 	bv := vschema.GetUDV(udv)
 	if bv == nil {
@@ -35,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	popcode "vitess.io/vitess/go/vt/vtgate/engine/opcode"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
@@ -117,17 +118,28 @@ func buildShowBasicPlan(show *sqlparser.ShowBasic, vschema plancontext.VSchema)
 		return buildPluginsPlan()
 	case sqlparser.Engines:
 		return buildEnginesPlan()
-	case sqlparser.VitessReplicationStatus, sqlparser.VitessShards, sqlparser.VitessTablets, sqlparser.VitessVariables:
+	case sqlparser.VitessVariables:
 		return &engine.ShowExec{
 			Command:    show.Command,
 			ShowFilter: show.Filter,
 		}, nil
+	case sqlparser.VitessReplicationStatus, sqlparser.VitessShards, sqlparser.VitessTablets:
+		// vtgate applies the LIKE filters of these statements itself, while
+		// their WHERE filters are evaluated over their results.
+		var like *sqlparser.ShowFilter
+		if show.Filter != nil && show.Filter.Like != "" {
+			like = show.Filter
+		}
+		return buildShowFilterPlan(show, vschema, &engine.ShowExec{
+			Command:    show.Command,
+			ShowFilter: like,
+		})
 	case sqlparser.VitessTarget:
 		return buildShowTargetPlan(vschema)
 	case sqlparser.VschemaTables:
-		return buildVschemaTablesPlan(vschema)
+		return buildVschemaTablesPlan(show, vschema)
 	case sqlparser.VschemaKeyspaces:
-		return buildVschemaKeyspacesPlan(vschema)
+		return buildVschemaKeyspacesPlan(show, vschema)
 	case sqlparser.VschemaVindexes:
 		return buildVschemaVindexesPlan(show, vschema)
 	}
@@ -143,7 +155,7 @@ func buildShowTargetPlan(vschema plancontext.VSchema) (engine.Primitive, error)
 
 func buildCharsetPlan(show *sqlparser.ShowBasic) (engine.Primitive, error) {
 	fields := buildVarCharFields("Charset", "Description", "Default collation")
-	maxLenField := &querypb.Field{Name: "Maxlen", Type: sqltypes.Uint32, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG | querypb.MySqlFlag_NO_DEFAULT_VALUE_FLAG)}
+	maxLenField := &querypb.Field{Name: "Maxlen", Type: sqltypes.Uint32, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG | querypb.MySqlFlag_NO_DEFAULT_VALUE_FLAG)}
 	fields = append(fields, maxLenField)
 	cs, err := generateCharsetRows(show.Filter)
 	if err != nil {
@@ -603,7 +615,7 @@ func buildWarnings() (engine.Primitive, error) {
 	f := func(sa engine.SessionActions) (*sqltypes.Result, error) {
 		fields := []*querypb.Field{
 			{Name: "Level", Type: sqltypes.VarChar, Charset: uint32(collations.SystemCollation.Collation)},
-			{Name: "Code", Type: sqltypes.Uint16, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
+			{Name: "Code", Type: sqltypes.Uint16, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
 			{Name: "Message", Type: sqltypes.VarChar, Charset: uint32(collations.SystemCollation.Collation)},
 		}
 
@@ -653,7 +665,7 @@ func buildEnginesPlan() (engine.Primitive, error) {
 		buildVarCharFields("Engine", "Support", "Comment", "Transactions", "XA", "Savepoints")), nil
 }
 
-func buildVschemaKeyspacesPlan(vschema plancontext.VSchema) (engine.Primitive, error) {
+func buildVschemaKeyspacesPlan(show *sqlparser.ShowBasic, vschema plancontext.VSchema) (engine.Primitive, error) {
 	vs := vschema.GetVSchema()
 	var rows [][]sqltypes.Value
 	for ksName, ks := range vs.Keyspaces {
@@ -670,10 +682,10 @@ func buildVschemaKeyspacesPlan(vschema plancontext.VSchema) (engine.Primitive, e
 		rows = append(rows, row)
 	}
 
-	return engine.NewRowsPrimitive(rows, buildVarCharFields("Keyspace", "Sharded", "Foreign Key", "Comment")), nil
+	return buildShowFilterPlan(show, vschema, engine.NewRowsPrimitive(rows, buildVarCharFields(showColumns(show)...)))
 }
 
-func buildVschemaTablesPlan(vschema plancontext.VSchema) (engine.Primitive, error) {
+func buildVschemaTablesPlan(show *sqlparser.ShowBasic, vschema plancontext.VSchema) (engine.Primitive, error) {
 	vs := vschema.GetVSchema()
 	ks, err := vschema.DefaultKeyspace()
 	if err != nil {
@@ -695,7 +707,7 @@ func buildVschemaTablesPlan(vschema plancontext.VSchema) (engine.Primitive, erro
 		rows[i] = buildVarCharRow(v)
 	}
 
-	return engine.NewRowsPrimitive(rows, buildVarCharFields("Tables")), nil
+	return buildShowFilterPlan(show, vschema, engine.NewRowsPrimitive(rows, buildVarCharFields(showColumns(show)...)))
 }
 
 func buildVschemaVindexesPlan(show *sqlparser.ShowBasic, vschema plancontext.VSchema) (engine.Primitive, error) {
@@ -739,9 +751,7 @@ func buildVschemaVindexesPlan(show *sqlparser.ShowBasic, vschema plancontext.VSc
 			}
 		}
 
-		return engine.NewRowsPrimitive(rows,
-			buildVarCharFields("Columns", "Name", "Type", "Params", "Owner"),
-		), nil
+		return buildShowFilterPlan(show, vschema, engine.NewRowsPrimitive(rows, buildVarCharFields(showColumns(show)...)))
 	}
 
 	// For the query interface to be stable we need to sort
@@ -770,8 +780,97 @@ func buildVschemaVindexesPlan(show *sqlparser.ShowBasic, vschema plancontext.VSc
 			rows = append(rows, buildVarCharRow(ksName, vindexName, vindex.GetType(), strings.Join(params, "; "), vindex.GetOwner()))
 		}
 	}
-	return engine.NewRowsPrimitive(rows,
-		buildVarCharFields("Keyspace", "Name", "Type", "Params", "Owner"),
-	), nil
+	return buildShowFilterPlan(show, vschema, engine.NewRowsPrimitive(rows, buildVarCharFields(showColumns(show)...)))
+}
+
+// showFilter describes the results of a SHOW statement that vtgate answers
+// itself, so that its filter can be evaluated over them.
+type showFilter struct {
+	// columns are the names of the columns of the results.
+	columns []string
+	// like is the column that the LIKE pattern of the statement is matched
+	// against, if vtgate doesn't apply it while building the results.
+	like string
+}
+
+// showFilters lists the SHOW statements whose filters are evaluated over their
+// results. The columns of the statements executed by the vtgate executor must
+// match the fields of the results that it builds.
+var showFilters = map[sqlparser.ShowCommandType]showFilter{
+	sqlparser.VitessReplicationStatus: {columns: []string{"Keyspace", "Shard", "TabletType", "Alias", "Hostname", "ReplicationSource", "ReplicationHealth", "ReplicationLag", "ThrottlerStatus"}},
+	sqlparser.VitessShards:            {columns: []string{"Shards"}},
+	sqlparser.VitessTablets:           {columns: []string{"Cell", "Keyspace", "Shard", "TabletType", "State", "Alias", "Hostname", "PrimaryTermStartTime"}},
+	sqlparser.VschemaKeyspaces:        {columns: []string{"Keyspace", "Sharded", "Foreign Key", "Comment"}, like: "Keyspace"},
+	sqlparser.VschemaTables:           {columns: []string{"Tables"}, like: "Tables"},
+	sqlparser.VschemaVindexes:         {columns: []string{"Keyspace", "Name", "Type", "Params", "Owner"}, like: "Name"},
+}
+
+// vschemaTableVindexesFilter describes the results of SHOW VSCHEMA VINDEXES
+// FROM <table>.
+var vschemaTableVindexesFilter = showFilter{columns: []string{"Columns", "Name", "Type", "Params", "Owner"}, like: "Name"}
+
+func showFilterFor(show *sqlparser.ShowBasic) showFilter {
+	if show.Command == sqlparser.VschemaVindexes && !show.Tbl.IsEmpty() {
+		return vschemaTableVindexesFilter
+	}
+	return showFilters[show.Command]
+}
+
+func showColumns(show *sqlparser.ShowBasic) []string {
+	return showFilterFor(show).columns
+}
+
+// buildShowFilterPlan filters the results of the input with the LIKE or WHERE
+// filter of the SHOW statement. The WHERE filter can refer to any column of the
+// results by its name, e.g. SHOW VITESS_TABLETS WHERE TabletType = 'PRIMARY'.
+func buildShowFilterPlan(show *sqlparser.ShowBasic, vschema plancontext.VSchema, input engine.Primitive) (engine.Primitive, error) {
+	if show.Filter == nil {
+		return input, nil
+	}
+	filter := showFilterFor(show)
+	predicate := show.Filter.Filter
+	if show.Filter.Like != "" {
+		if filter.like == "" {
+			return input, nil
+		}
+		predicate = &sqlparser.ComparisonExpr{
+			Operator: sqlparser.LikeOp,
+			Left:     sqlparser.NewColName(filter.like),
+			Right:    sqlparser.NewStrLiteral(show.Filter.Like),
+		}
+	}
 
+	fields := buildVarCharFields(filter.columns...)
+	resolve := func(col *sqlparser.ColName) (int, error) {
+		for i, column := range filter.columns {
+			if col.Name.EqualString(column) {
+				return i, nil
+			}
+		}
+		return 0, vterrors.VT03022(sqlparser.String(col), "show "+strings.TrimSpace(show.Command.ToString()))
+	}
+	expr, err := evalengine.Translate(predicate, &evalengine.Config{
+		ResolveColumn: resolve,
+		ResolveType: func(expr sqlparser.Expr) (evalengine.Type, bool) {
+			col, ok := expr.(*sqlparser.ColName)
+			if !ok {
+				return evalengine.Type{}, false
+			}
+			offset, err := resolve(col)
+			if err != nil {
+				return evalengine.Type{}, false
+			}
+			return evalengine.NewType(fields[offset].Type, collations.CollationForField(fields[offset])), true
+		},
+		Collation:   vschema.ConnCollation(),
+		Environment: vschema.Environment(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &engine.Filter{
+		Predicate:    expr,
+		ASTPredicate: predicate,
+		Input:        input,
+	}, nil
 }
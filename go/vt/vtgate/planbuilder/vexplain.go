@@ -32,14 +32,30 @@ import (
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
+// vexplainType describes how a type of VEXPLAIN is planned.
+type vexplainType struct {
+	// runsQueries is true if the statement is executed, so that the queries
+	// that it sends to the shards are logged, and false if only its plan is
+	// described.
+	runsQueries bool
+}
+
+// vexplainTypes lists the types of VEXPLAIN that vtgate plans.
+var vexplainTypes = map[sqlparser.VExplainType]vexplainType{
+	sqlparser.QueriesVExplainType: {runsQueries: true},
+	sqlparser.AllVExplainType:     {runsQueries: true},
+	sqlparser.PlanVExplainType:    {runsQueries: false},
+}
+
 func buildVExplainPlan(ctx context.Context, vexplainStmt *sqlparser.VExplainStmt, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema, enableOnlineDDL, enableDirectDDL bool) (*planResult, error) {
-	switch vexplainStmt.Type {
-	case sqlparser.QueriesVExplainType, sqlparser.AllVExplainType:
+	typ, ok := vexplainTypes[vexplainStmt.Type]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected vtexplain type: %s", vexplainStmt.Type.ToString())
+	}
+	if typ.runsQueries {
 		return buildVExplainLoggingPlan(ctx, vexplainStmt, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
-	case sqlparser.PlanVExplainType:
-		return buildVExplainVtgatePlan(ctx, vexplainStmt.Statement, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
 	}
-	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected vtexplain type: %s", vexplainStmt.Type.ToString())
+	return buildVExplainVtgatePlan(ctx, vexplainStmt.Statement, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
 }
 
 func explainTabPlan(explain *sqlparser.ExplainTab, vschema plancontext.VSchema) (*planResult, error) {
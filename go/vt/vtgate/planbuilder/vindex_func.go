@@ -51,7 +51,7 @@ func SupplyProjection(eVindexFunc *engine.VindexFunc, expr *sqlparser.AliasedExp
 	eVindexFunc.Fields = append(eVindexFunc.Fields, &querypb.Field{
 		Name:    expr.ColumnName(),
 		Type:    querypb.Type_VARBINARY,
-		Charset: collations.CollationBinaryID,
+		Charset: uint32(collations.CollationBinaryID),
 		Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 	})
 	eVindexFunc.Cols = append(eVindexFunc.Cols, enum)
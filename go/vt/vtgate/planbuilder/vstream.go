@@ -42,53 +42,63 @@ func buildVStreamPlan(stmt *sqlparser.VStream, vschema plancontext.VSchema) (*pl
 	if dest == nil {
 		dest = key.DestinationAllShards{}
 	}
-	var pos string
+	vstream := &engine.VStream{
+		Keyspace:          table.Keyspace,
+		TargetDestination: dest,
+		TableName:         table.Name.CompliantName(),
+		Limit:             defaultLimit,
+	}
 	if stmt.Where != nil {
-		pos, err = getVStreamStartPos(stmt)
-		if err != nil {
-			return nil, err
+		for _, predicate := range sqlparser.SplitAndExpression(nil, stmt.Where.Expr) {
+			if err := applyVStreamFilter(vstream, predicate); err != nil {
+				return nil, err
+			}
 		}
 	}
-	limit := defaultLimit
 	if stmt.Limit != nil {
 		count, ok := stmt.Limit.Rowcount.(*sqlparser.Literal)
 		if ok {
-			limit, _ = strconv.Atoi(count.Val)
+			vstream.Limit, _ = strconv.Atoi(count.Val)
 		}
 	}
+	return newPlanResult(vstream), nil
+}
 
-	return newPlanResult(&engine.VStream{
-		Keyspace:          table.Keyspace,
-		TargetDestination: dest,
-		TableName:         table.Name.CompliantName(),
-		Position:          pos,
-		Limit:             limit,
-	}), nil
+// vstreamFilter is a predicate of the WHERE clause of VSTREAM, which sets an
+// option of the stream rather than filtering its rows.
+type vstreamFilter struct {
+	// operator is the comparison of the column with the value of the option.
+	operator sqlparser.ComparisonExprOperator
+	// apply sets the option to the value of the predicate.
+	apply func(vstream *engine.VStream, val *sqlparser.Literal)
 }
 
-func getVStreamStartPos(stmt *sqlparser.VStream) (string, error) {
-	var colName, pos string
-	if stmt.Where != nil {
-		switch v := stmt.Where.Expr.(type) {
-		case *sqlparser.ComparisonExpr:
-			if v.Operator == sqlparser.GreaterThanOp {
-				switch c := v.Left.(type) {
-				case *sqlparser.ColName:
-					switch val := v.Right.(type) {
-					case *sqlparser.Literal:
-						pos = val.Val
-					}
-					colName = strings.ToLower(c.Name.String())
-					if colName != "pos" {
-						return "", vterrors.VT03017()
-					}
-				}
-			} else {
-				return "", vterrors.VT03017()
-			}
-		default:
-			return "", vterrors.VT03017()
-		}
+// vstreamFilters lists the columns that the WHERE clause of VSTREAM can compare,
+// e.g. VSTREAM * FROM t WHERE pos > '<position>' streams from the position.
+var vstreamFilters = map[string]vstreamFilter{
+	"pos": {
+		operator: sqlparser.GreaterThanOp,
+		apply:    func(vstream *engine.VStream, val *sqlparser.Literal) { vstream.Position = val.Val },
+	},
+}
+
+func applyVStreamFilter(vstream *engine.VStream, predicate sqlparser.Expr) error {
+	cmp, ok := predicate.(*sqlparser.ComparisonExpr)
+	if !ok {
+		return vterrors.VT03017()
+	}
+	col, ok := cmp.Left.(*sqlparser.ColName)
+	if !ok {
+		return vterrors.VT03017()
+	}
+	filter, ok := vstreamFilters[strings.ToLower(col.Name.String())]
+	if !ok || cmp.Operator != filter.operator {
+		return vterrors.VT03017()
+	}
+	val, ok := cmp.Right.(*sqlparser.Literal)
+	if !ok {
+		return vterrors.VT03017()
 	}
-	return pos, nil
+	filter.apply(vstream, val)
+	return nil
 }
@@ -561,6 +561,9 @@ func (session *SafeSession) HasSystemVariables() (found bool) {
 	return
 }
 
+// TimeZone returns the time zone stored in system_variables map in the session,
+// or nil if it wasn't set, or if it's the SYSTEM time zone of MySQL, which is
+// unknown to vtgate.
 func (session *SafeSession) TimeZone() *time.Location {
 	session.mu.Lock()
 	tz, ok := session.SystemVariables["time_zone"]
@@ -569,10 +572,28 @@ func (session *SafeSession) TimeZone() *time.Location {
 	if !ok {
 		return nil
 	}
+	// The value is stored as a SQL literal, e.g. 'Europe/Amsterdam'.
+	tz = strings.Trim(tz, "'")
+	if strings.EqualFold(tz, "SYSTEM") {
+		return nil
+	}
 	loc, _ := datetime.ParseTimeZone(tz)
 	return loc
 }
 
+// LCTimeNames returns the lc_time_names stored in system_variables map in the
+// session, or an empty string if it wasn't set.
+func (session *SafeSession) LCTimeNames() string {
+	session.mu.Lock()
+	lc, ok := session.SystemVariables["lc_time_names"]
+	session.mu.Unlock()
+
+	if !ok {
+		return ""
+	}
+	return strings.Trim(lc, "'")
+}
+
 // ForeignKeyChecks returns the foreign_key_checks stored in system_variables map in the session.
 func (session *SafeSession) ForeignKeyChecks() *bool {
 	session.mu.Lock()
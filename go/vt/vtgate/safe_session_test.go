@@ -80,6 +80,18 @@ func TestTimeZone(t *testing.T) {
 			tz:   "+02:00",
 			want: "UTC+02:00",
 		},
+		{
+			tz:   "'Europe/Amsterdam'",
+			want: "Europe/Amsterdam",
+		},
+		{
+			tz:   "'-6:00'",
+			want: "UTC-6:00",
+		},
+		{
+			tz:   "'SYSTEM'",
+			want: (*time.Location)(nil).String(),
+		},
 		{
 			tz:   "foo",
 			want: (*time.Location)(nil).String(),
@@ -98,3 +110,11 @@ func TestTimeZone(t *testing.T) {
 		})
 	}
 }
+
+func TestLCTimeNames(t *testing.T) {
+	session := NewSafeSession(&vtgatepb.Session{})
+	assert.Equal(t, "", session.LCTimeNames())
+
+	session.SetSystemVariable("lc_time_names", "'de_DE'")
+	assert.Equal(t, "de_DE", session.LCTimeNames())
+}
@@ -127,6 +127,12 @@ func TestInsertBindingColNameErrorCases(t *testing.T) {
 	}, {
 		"insert into t3(uid, name) values (1,'foo') as new(x, y, z) on duplicate key update textcol = x + y",
 		"VT03033: In definition of view, derived table or common table expression, SELECT list and column names list have different column counts",
+	}, {
+		"insert into t2 values (1,'foo','bar') as t2 on duplicate key update textcol = t2.name",
+		"Not unique table/alias: 't2'",
+	}, {
+		"insert into t2 set uid = 1 as t2",
+		"Not unique table/alias: 't2'",
 	}}
 	for _, tc := range tcases {
 		t.Run(tc.query, func(t *testing.T) {
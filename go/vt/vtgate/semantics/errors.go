@@ -48,7 +48,6 @@ type (
 	QualifiedOrderInUnionError     struct{ Table string }
 	BuggyError                     struct{ Msg string }
 	UnsupportedConstruct           struct{ errString string }
-	AmbiguousColumnError           struct{ Column string }
 	SubqueryColumnCountError       struct{ Expected int }
 	ColumnsMissingInSchemaError    struct{}
 	CantUseMultipleVindexHints     struct{ Table string }
@@ -76,6 +75,10 @@ type (
 		Column string
 		Clause string
 	}
+	AmbiguousColumnError struct {
+		Column string
+		col    *sqlparser.ColName
+	}
 )
 
 func eprintf(e error, format string, args ...any) string {
@@ -89,7 +92,7 @@ func eprintf(e error, format string, args ...any) string {
 }
 
 func newAmbiguousColumnError(name *sqlparser.ColName) error {
-	return &AmbiguousColumnError{Column: sqlparser.String(name)}
+	return &AmbiguousColumnError{Column: sqlparser.String(name), col: name}
 }
 
 // Specific error implementations follow
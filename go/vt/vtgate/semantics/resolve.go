@@ -0,0 +1,150 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package semantics
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/mysql/sqlerror"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// ColumnBinding is a column reference of a query, and the table that it binds to.
+type ColumnBinding struct {
+	Column *sqlparser.ColName
+	// Table is the table expression of the FROM clause that the column belongs to,
+	// i.e. a table, a derived table or a common table expression, with the alias
+	// that it has in the query.
+	Table *sqlparser.AliasedTableExpr
+}
+
+// ResolveColumns resolves the column references of the statement the same way
+// the planner of vtgate does, and returns the table that each of them binds to,
+// in the order in which sqlparser.Walk visits them. The statement is not modified: the columns are
+// those of the copy that was analyzed, in which e.g. `*` is expanded when the
+// columns of the tables are known. The columns that don't belong to a single
+// table, such as the aliases of the select expressions used in ORDER BY, are
+// skipped.
+//
+// The unknown and ambiguous columns, which can only be detected when the
+// columns of the tables are known, are returned as *sqlerror.SQLError with the
+// code and message of MySQL, i.e. ER_BAD_FIELD_ERROR and ER_NON_UNIQ_ERROR.
+// The other errors of the analysis are returned as they are.
+func ResolveColumns(statement sqlparser.Statement, currentDb string, si SchemaInformation) ([]ColumnBinding, error) {
+	statement = sqlparser.Clone(statement)
+	st, err := AnalyzeStrict(statement, currentDb, si)
+	if err != nil {
+		return nil, resolutionError(statement, err)
+	}
+
+	var bindings []ColumnBinding
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		col, ok := node.(*sqlparser.ColName)
+		if !ok {
+			return true, nil
+		}
+		ti, err := st.TableInfoFor(st.DirectDeps(col))
+		if err != nil {
+			return true, nil
+		}
+		if tbl := ti.GetAliasedTableExpr(); tbl != nil {
+			bindings = append(bindings, ColumnBinding{Column: col, Table: tbl})
+		}
+		return true, nil
+	}, statement)
+	return bindings, nil
+}
+
+// resolutionError returns the MySQL error of a column that can't be resolved.
+func resolutionError(statement sqlparser.Statement, err error) error {
+	var notFound ColumnNotFoundError
+	if errors.As(err, &notFound) {
+		return sqlerror.NewSQLError(sqlerror.ERBadFieldError, sqlerror.SSBadFieldError,
+			"Unknown column '%s' in '%s'", sqlparser.String(notFound.Column), clauseOf(statement, notFound.Column))
+	}
+	var notFoundInClause *ColumnNotFoundClauseError
+	if errors.As(err, &notFoundInClause) {
+		return sqlerror.NewSQLError(sqlerror.ERBadFieldError, sqlerror.SSBadFieldError,
+			"Unknown column '%s' in '%s'", notFoundInClause.Column, notFoundInClause.Clause)
+	}
+	var ambiguous *AmbiguousColumnError
+	if errors.As(err, &ambiguous) {
+		return sqlerror.NewSQLError(sqlerror.ERNonUniq, sqlerror.SSConstraintViolation,
+			"Column '%s' in %s is ambiguous", ambiguous.Column, clauseOf(statement, ambiguous.col))
+	}
+	return err
+}
+
+// clauseOf returns the clause of the innermost query of the statement that has
+// the column, as MySQL names it in its errors.
+func clauseOf(statement sqlparser.Statement, col *sqlparser.ColName) string {
+	clause := "field list"
+	if col == nil {
+		return clause
+	}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		var clauses []namedClause
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			clauses = []namedClause{
+				{"field list", node.SelectExprs},
+				{"on clause", sqlparser.TableExprs(node.From)},
+				{"where clause", node.Where},
+				{"group statement", node.GroupBy},
+				{"having clause", node.Having},
+				{"order clause", node.OrderBy},
+			}
+		case *sqlparser.Union:
+			clauses = []namedClause{{"order clause", node.OrderBy}}
+		case *sqlparser.Update:
+			clauses = []namedClause{
+				{"field list", node.Exprs},
+				{"where clause", node.Where},
+				{"order clause", node.OrderBy},
+			}
+		case *sqlparser.Delete:
+			clauses = []namedClause{
+				{"where clause", node.Where},
+				{"order clause", node.OrderBy},
+			}
+		}
+		// the inner queries are visited after the outer ones, so the clause of
+		// the innermost query wins
+		for _, c := range clauses {
+			if containsNode(c.node, col) {
+				clause = c.name
+			}
+		}
+		return true, nil
+	}, statement)
+	return clause
+}
+
+type namedClause struct {
+	name string
+	node sqlparser.SQLNode
+}
+
+func containsNode(root, target sqlparser.SQLNode) (found bool) {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if node == target {
+			found = true
+		}
+		return !found, nil
+	}, root)
+	return found
+}
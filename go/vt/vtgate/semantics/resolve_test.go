@@ -0,0 +1,99 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package semantics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/sqlerror"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestResolveColumns(t *testing.T) {
+	testCases := []struct {
+		query    string
+		bindings []string
+	}{{
+		query:    "select uid, t2.name from t2 where textcol = 'x'",
+		bindings: []string{"uid -> t2", "t2.`name` -> t2", "textcol -> t2"},
+	}, {
+		query:    "select a.uid, b.uid from t2 as a join t3 as b on a.name = b.name",
+		bindings: []string{"a.`name` -> t2 as a", "b.`name` -> t3 as b", "a.uid -> t2 as a", "b.uid -> t3 as b"},
+	}, {
+		query:    "select x.id from (select id from t1) as x",
+		bindings: []string{"id -> t1", "x.id -> (select id from t1) as x"},
+	}, {
+		query:    "select uid from t2 where exists (select 1 from t3 where t3.name = t2.name)",
+		bindings: []string{"uid -> t2", "t3.`name` -> t3", "t2.`name` -> t2"},
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			stmt, err := sqlparser.NewTestParser().Parse(tc.query)
+			require.NoError(t, err)
+			query := sqlparser.String(stmt)
+
+			bindings, err := ResolveColumns(stmt, "d", fakeSchemaInfo())
+			require.NoError(t, err)
+			var got []string
+			for _, b := range bindings {
+				got = append(got, sqlparser.String(b.Column)+" -> "+sqlparser.String(b.Table))
+			}
+			assert.Equal(t, tc.bindings, got)
+			// the statement is not modified
+			assert.Equal(t, query, sqlparser.String(stmt))
+		})
+	}
+}
+
+func TestResolveColumnsErrors(t *testing.T) {
+	testCases := []struct {
+		query string
+		code  sqlerror.ErrorCode
+		err   string
+	}{{
+		query: "select foo from t2",
+		code:  sqlerror.ERBadFieldError,
+		err:   "Unknown column 'foo' in 'field list' (errno 1054) (sqlstate 42S22)",
+	}, {
+		query: "select uid from t2 where t2.foo = 1",
+		code:  sqlerror.ERBadFieldError,
+		err:   "Unknown column 't2.foo' in 'where clause' (errno 1054) (sqlstate 42S22)",
+	}, {
+		query: "select uid from t2 where uid in (select 1 from t3 order by foo)",
+		code:  sqlerror.ERBadFieldError,
+		err:   "Unknown column 'foo' in 'order clause' (errno 1054) (sqlstate 42S22)",
+	}, {
+		query: "select t2.uid from t2 join t3 on t2.name = t3.name where uid = 1",
+		code:  sqlerror.ERNonUniq,
+		err:   "Column 'uid' in where clause is ambiguous (errno 1052) (sqlstate 23000)",
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			stmt, err := sqlparser.NewTestParser().Parse(tc.query)
+			require.NoError(t, err)
+
+			_, err = ResolveColumns(stmt, "d", fakeSchemaInfo())
+			require.EqualError(t, err, tc.err)
+			sqlErr, ok := err.(*sqlerror.SQLError)
+			require.True(t, ok)
+			assert.Equal(t, tc.code, sqlErr.Number())
+		})
+	}
+}
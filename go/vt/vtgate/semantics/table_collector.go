@@ -178,6 +178,13 @@ func (tc *tableCollector) visitUnion(union *sqlparser.Union) error {
 
 func (tc *tableCollector) visitRowAlias(ins *sqlparser.Insert, rowAlias *sqlparser.RowAlias) error {
 	origTableInfo := tc.Tables[0]
+	origName, err := origTableInfo.Name()
+	if err != nil {
+		return err
+	}
+	if rowAlias.TableName.String() == origName.Name.String() {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.NonUniqTable, "Not unique table/alias: '%s'", rowAlias.TableName.String())
+	}
 
 	colNames, types, err := tc.getColumnNamesAndTypes(ins, rowAlias, origTableInfo)
 	if err != nil {
@@ -219,6 +219,10 @@ func (vc *vcursorImpl) TimeZone() *time.Location {
 	return vc.safeSession.TimeZone()
 }
 
+func (vc *vcursorImpl) LCTimeNames() string {
+	return vc.safeSession.LCTimeNames()
+}
+
 func (vc *vcursorImpl) SQLMode() string {
 	// TODO: Implement return the current sql_mode.
 	// This is currently hardcoded to the default in MySQL 8.0.
@@ -236,6 +240,11 @@ func (vc *vcursorImpl) ExceedsMaxMemoryRows(numRows int) bool {
 	return !vc.ignoreMaxMemoryRows && numRows > maxMemoryRows
 }
 
+// LimitOffsetMaxBufferedRows returns the limit-offset-max-buffered-rows flag value.
+func (vc *vcursorImpl) LimitOffsetMaxBufferedRows() int {
+	return limitOffsetMaxBufferedRows
+}
+
 // SetIgnoreMaxMemoryRows sets the ignoreMaxMemoryRows value.
 func (vc *vcursorImpl) SetIgnoreMaxMemoryRows(ignoreMaxMemoryRows bool) {
 	vc.ignoreMaxMemoryRows = ignoreMaxMemoryRows
@@ -575,6 +584,9 @@ const txRollback = "Rollback Transaction"
 
 // ExecuteMultiShard is part of the engine.VCursor interface.
 func (vc *vcursorImpl) ExecuteMultiShard(ctx context.Context, primitive engine.Primitive, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, rollbackOnError, canAutocommit bool) (*sqltypes.Result, []error) {
+	if err := vc.checkMaxShards(primitive, rss); err != nil {
+		return nil, []error{err}
+	}
 	noOfShards := len(rss)
 	atomic.AddUint64(&vc.logStats.ShardQueries, uint64(noOfShards))
 	err := vc.markSavepoint(ctx, rollbackOnError && (noOfShards > 1), map[string]*querypb.BindVariable{})
@@ -590,6 +602,9 @@ func (vc *vcursorImpl) ExecuteMultiShard(ctx context.Context, primitive engine.P
 
 // StreamExecuteMulti is the streaming version of ExecuteMultiShard.
 func (vc *vcursorImpl) StreamExecuteMulti(ctx context.Context, primitive engine.Primitive, query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, rollbackOnError bool, autocommit bool, callback func(reply *sqltypes.Result) error) []error {
+	if err := vc.checkMaxShards(primitive, rss); err != nil {
+		return []error{err}
+	}
 	noOfShards := len(rss)
 	atomic.AddUint64(&vc.logStats.ShardQueries, uint64(noOfShards))
 	err := vc.markSavepoint(ctx, rollbackOnError && (noOfShards > 1), map[string]*querypb.BindVariable{})
@@ -603,6 +618,28 @@ func (vc *vcursorImpl) StreamExecuteMulti(ctx context.Context, primitive engine.
 	return errs
 }
 
+// checkMaxShards fails the route of a query that would be sent to more
+// shards than the max_shards_per_query setting of the session allows, or only
+// records a warning if max_shards_per_query_warn_only is set.
+func (vc *vcursorImpl) checkMaxShards(primitive engine.Primitive, rss []*srvtopo.ResolvedShard) error {
+	maxShards := vc.safeSession.GetMaxShardsPerQuery()
+	if maxShards <= 0 || int64(len(rss)) <= maxShards {
+		return nil
+	}
+	operator := "query"
+	if primitive != nil {
+		desc := engine.PrimitiveToPlanDescription(primitive)
+		operator = fmt.Sprintf("%s (%s) on keyspace '%s' for table %s", desc.OperatorType, desc.Variant, primitive.GetKeyspaceName(), primitive.GetTableName())
+	}
+	err := vterrors.VT09026(operator, len(rss), maxShards)
+	if !vc.safeSession.GetMaxShardsPerQueryWarnOnly() {
+		return err
+	}
+	sqlErr := sqlerror.NewSQLErrorFromError(err).(*sqlerror.SQLError)
+	vc.safeSession.RecordWarning(&querypb.QueryWarning{Code: uint32(sqlErr.Num), Message: err.Error()})
+	return nil
+}
+
 // ExecuteLock is for executing advisory lock statements.
 func (vc *vcursorImpl) ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, lockFuncType sqlparser.LockingFuncType) (*sqltypes.Result, error) {
 	query.Sql = vc.marginComments.Leading + query.Sql + vc.marginComments.Trailing
@@ -849,6 +886,17 @@ func (vc *vcursorImpl) SetQueryTimeout(maxExecutionTime int64) {
 	vc.safeSession.QueryTimeout = maxExecutionTime
 }
 
+// SetMaxShardsPerQuery implements the SessionActions interface
+func (vc *vcursorImpl) SetMaxShardsPerQuery(maxShards int64) {
+	vc.safeSession.MaxShardsPerQuery = maxShards
+}
+
+// SetMaxShardsPerQueryWarnOnly implements the SessionActions interface
+func (vc *vcursorImpl) SetMaxShardsPerQueryWarnOnly(_ context.Context, warnOnly bool) error {
+	vc.safeSession.MaxShardsPerQueryWarnOnly = warnOnly
+	return nil
+}
+
 // GetQueryTimeout implements the SessionActions interface
 // The priority of adding query timeouts -
 // 1. Query timeout comment directive.
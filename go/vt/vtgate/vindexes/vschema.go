@@ -29,8 +29,10 @@ import (
 
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/mysql/collations/colldata"
 	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
@@ -110,6 +112,9 @@ type Table struct {
 	Columns                 []Column               `json:"columns,omitempty"`
 	Pinned                  []byte                 `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                   `json:"column_list_authoritative,omitempty"`
+	// NoScatter rejects the plans that scatter over all the shards of the
+	// keyspace to access the table, unless the ALLOW_SCATTER directive is used.
+	NoScatter bool `json:"no_scatter,omitempty"`
 	// ReferencedBy is an inverse mapping of tables in other keyspaces that
 	// reference this table via Source.
 	//
@@ -229,13 +234,22 @@ func (col *Column) MarshalJSON() ([]byte, error) {
 func (col *Column) ToEvalengineType(collationEnv *collations.Environment) evalengine.Type {
 	var collation collations.ID
 	if sqltypes.IsText(col.Type) {
-		collation, _ = collationEnv.LookupID(col.CollationName)
+		// The spellings that LookupAlias accepts were checked, and surfaced,
+		// when the vschema was built.
+		collation, _, _ = collationEnv.LookupAlias(col.CollationName)
 	} else {
 		collation = collations.CollationForType(col.Type, collationEnv.DefaultConnectionCharset())
 	}
 	return evalengine.NewTypeEx(col.Type, collation, col.Nullable, col.Size, col.Scale, ptr.Of(evalengine.EnumSetValues(col.Values)))
 }
 
+// Normalizer returns the Normalizer for the values of the column, which functional
+// vindexes can use to map the values that are equal in the collation of the column
+// to the same keyspace ID.
+func (col *Column) Normalizer(collationEnv *collations.Environment) (*colldata.Normalizer, error) {
+	return colldata.NewNormalizer(collationEnv, col.Type, col.CollationName)
+}
+
 // KeyspaceSchema contains the schema(table) for a keyspace.
 type KeyspaceSchema struct {
 	Keyspace        *Keyspace
@@ -608,6 +622,7 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			Name:                    sqlparser.NewIdentifierCS(tname),
 			Keyspace:                keyspace,
 			ColumnListAuthoritative: table.ColumnListAuthoritative,
+			NoScatter:               table.NoScatter,
 		}
 		switch table.Type {
 		case "":
@@ -685,6 +700,16 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 						"could not parse the '%s' column's default expression '%s' for table '%s'", col.Name, col.Default, tname)
 				}
 			}
+			if col.CollationName != "" && sqltypes.IsText(col.Type) {
+				_, note, err := collations.MySQL8().LookupAlias(col.CollationName)
+				if err != nil {
+					return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+						"invalid collation for the '%s' column of table '%s': %v", col.Name, tname, err)
+				}
+				if note != "" {
+					log.Warningf("collation of the '%s' column of table '%s': %s", col.Name, tname, note)
+				}
+			}
 			nullable := true
 			if col.Nullable != nil {
 				nullable = *col.Nullable
@@ -30,6 +30,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/test/utils"
 	"vitess.io/vitess/go/vt/key"
@@ -487,6 +488,32 @@ func TestColumnMarshal(t *testing.T) {
 	}
 }
 
+func TestColumnToEvalengineTypeCollation(t *testing.T) {
+	env := collations.MySQL8()
+	tests := []struct {
+		collationName string
+		want          string
+	}{
+		{"utf8mb4_bin", "utf8mb4_bin"},
+		{"UTF8MB4_BIN", "utf8mb4_bin"},
+		{"utf8_general_ci", "utf8mb3_general_ci"},
+		// a charset name is not a collation name
+		{"latin1", ""},
+		{"unknown_collation", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.collationName, func(t *testing.T) {
+			col := Column{
+				Name:          sqlparser.NewIdentifierCI("col1"),
+				Type:          sqltypes.VarChar,
+				CollationName: test.collationName,
+			}
+			typ := col.ToEvalengineType(env)
+			assert.Equal(t, test.want, env.LookupName(typ.Collation()))
+		})
+	}
+}
+
 func TestVSchemaForeignKeys(t *testing.T) {
 	good := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -594,6 +621,28 @@ func TestVSchemaColumnsFail(t *testing.T) {
 	require.EqualError(t, got.Keyspaces["unsharded"].Error, "duplicate column name 'c1' for table: t1")
 }
 
+func TestVSchemaColumnCollation(t *testing.T) {
+	vschema := func(collationName string) *vschemapb.SrvVSchema {
+		return &vschemapb.SrvVSchema{
+			Keyspaces: map[string]*vschemapb.Keyspace{
+				"unsharded": {
+					Tables: map[string]*vschemapb.Table{
+						"t1": {
+							Columns: []*vschemapb.Column{{
+								Name:          "c1",
+								Type:          sqltypes.VarChar,
+								CollationName: collationName}}}}}}}
+	}
+
+	for _, collationName := range []string{"utf8mb4_bin", "UTF8MB4_BIN", "utf8_general_ci"} {
+		got := BuildVSchema(vschema(collationName), sqlparser.NewTestParser())
+		require.NoError(t, got.Keyspaces["unsharded"].Error, collationName)
+	}
+
+	got := BuildVSchema(vschema("latin1"), sqlparser.NewTestParser())
+	require.EqualError(t, got.Keyspaces["unsharded"].Error, `invalid collation for the 'c1' column of table 't1': unknown collation: "latin1"`)
+}
+
 func TestVSchemaPinned(t *testing.T) {
 	good := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
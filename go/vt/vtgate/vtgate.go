@@ -73,6 +73,12 @@ var (
 	maxPayloadSize  int
 	warnPayloadSize int
 
+	// limitOffsetMaxBufferedRows bounds the rows that vtgate buffers to apply the
+	// OFFSET of a query: above this LIMIT+OFFSET row count, the rows of the shards
+	// are streamed outside of transactions, and the OFFSET ones skipped as they
+	// arrive. Zero disables the bound.
+	limitOffsetMaxBufferedRows int
+
 	noScatter          bool
 	enableShardRouting bool
 
@@ -127,6 +133,7 @@ func registerFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&streamBufferSize, "stream_buffer_size", streamBufferSize, "the number of bytes sent from vtgate for each stream call. It's recommended to keep this value in sync with vttablet's query-server-config-stream-buffer-size.")
 	fs.Int64Var(&queryPlanCacheMemory, "gate_query_cache_memory", queryPlanCacheMemory, "gate server query cache size in bytes, maximum amount of memory to be cached. vtgate analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
 	fs.IntVar(&maxMemoryRows, "max_memory_rows", maxMemoryRows, "Maximum number of rows that will be held in memory for intermediate results as well as the final result.")
+	fs.IntVar(&limitOffsetMaxBufferedRows, "limit-offset-max-buffered-rows", limitOffsetMaxBufferedRows, "If set, bounds the memory used by vtgate for the queries with an OFFSET: above this LIMIT+OFFSET row count, the rows of the shards are streamed and the OFFSET ones skipped as they arrive, instead of being buffered. Every shard still returns LIMIT+OFFSET rows. The rows are always buffered in a transaction. Disabled by default.")
 	fs.IntVar(&warnMemoryRows, "warn_memory_rows", warnMemoryRows, "Warning threshold for in-memory results. A row count higher than this amount will cause the VtGateWarnings.ResultsExceeded counter to be incremented.")
 	fs.StringVar(&defaultDDLStrategy, "ddl_strategy", defaultDDLStrategy, "Set default strategy for DDL statements. Override with @@ddl_strategy session variable")
 	fs.StringVar(&dbDDLPlugin, "dbddl_plugin", dbDDLPlugin, "controls how to handle CREATE/DROP DATABASE. use it if you are using your own database provisioning service")
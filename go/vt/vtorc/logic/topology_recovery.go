@@ -238,6 +238,7 @@ func runEmergencyReparentOp(ctx context.Context, analysisEntry *inst.Replication
 			WaitReplicasTimeout:       time.Duration(config.Config.WaitReplicasTimeoutSeconds) * time.Second,
 			PreventCrossCellPromotion: config.Config.PreventCrossDataCenterPrimaryFailover,
 			WaitAllTablets:            waitForAllTablets,
+			FailFastDownTablets:       config.Config.FailFastDownTablets,
 		},
 	)
 	if err != nil {
@@ -98,6 +98,21 @@ func (client *FakeTabletManagerClient) VDiff(ctx context.Context, tablet *topoda
 	return nil, nil
 }
 
+// CreateVDiff is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) CreateVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.CreateVDiffRequest) (*tabletmanagerdatapb.CreateVDiffResponse, error) {
+	return &tabletmanagerdatapb.CreateVDiffResponse{VdiffUuid: req.VdiffUuid}, nil
+}
+
+// ShowVDiff is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ShowVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ShowVDiffRequest) (*tabletmanagerdatapb.ShowVDiffResponse, error) {
+	return &tabletmanagerdatapb.ShowVDiffResponse{}, nil
+}
+
+// StopVDiff is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) StopVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.StopVDiffRequest) (*tabletmanagerdatapb.StopVDiffResponse, error) {
+	return &tabletmanagerdatapb.StopVDiffResponse{}, nil
+}
+
 //
 // Various read-only methods
 //
@@ -118,11 +133,44 @@ func (client *FakeTabletManagerClient) ExecuteHook(ctx context.Context, tablet *
 	return &hr, nil
 }
 
+type finishedHookStream struct {
+	done bool
+}
+
+func (s *finishedHookStream) Recv() (*tabletmanagerdatapb.StreamExecuteHookResponse, error) {
+	if s.done {
+		return nil, io.EOF
+	}
+	s.done = true
+	return &tabletmanagerdatapb.StreamExecuteHookResponse{Finished: true}, nil
+}
+
+// StreamExecuteHook is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) StreamExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (tmclient.HookStream, error) {
+	return &finishedHookStream{}, nil
+}
+
+type closedSchemaChangeStream struct{}
+
+func (closedSchemaChangeStream) Recv() (*tabletmanagerdatapb.StreamSchemaChangesResponse, error) {
+	return nil, io.EOF
+}
+
+// StreamSchemaChanges is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) StreamSchemaChanges(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.StreamSchemaChangesRequest) (tmclient.SchemaChangeStream, error) {
+	return closedSchemaChangeStream{}, nil
+}
+
 // GetSchema is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetSchemaRequest) (*tabletmanagerdatapb.SchemaDefinition, error) {
 	return client.tmc.GetSchema(ctx, tablet, request)
 }
 
+// GetSchemaHash is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetSchemaHash(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetSchemaHashRequest) (string, error) {
+	return client.tmc.GetSchemaHash(ctx, tablet, request)
+}
+
 // GetPermissions is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error) {
 	return &tabletmanagerdatapb.Permissions{}, nil
@@ -133,6 +181,27 @@ func (client *FakeTabletManagerClient) GetGlobalStatusVars(ctx context.Context,
 	return make(map[string]string), nil
 }
 
+type closedTabletEventStream struct{}
+
+func (closedTabletEventStream) Recv() (*tabletmanagerdatapb.StreamTabletEventsResponse, error) {
+	return nil, io.EOF
+}
+
+// StreamTabletEvents is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) StreamTabletEvents(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.StreamTabletEventsRequest) (tmclient.TabletEventStream, error) {
+	return closedTabletEventStream{}, nil
+}
+
+// AcquireConsistentSnapshot is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) AcquireConsistentSnapshot(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.AcquireConsistentSnapshotRequest) (*tabletmanagerdatapb.AcquireConsistentSnapshotResponse, error) {
+	return &tabletmanagerdatapb.AcquireConsistentSnapshotResponse{}, nil
+}
+
+// ReleaseConsistentSnapshot is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ReleaseConsistentSnapshot(ctx context.Context, tablet *topodatapb.Tablet, snapshotID int64) error {
+	return nil
+}
+
 // LockTables is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) LockTables(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return nil
@@ -172,6 +241,41 @@ func (client *FakeTabletManagerClient) RunHealthCheck(ctx context.Context, table
 	return nil
 }
 
+// PrepareShutdown is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) PrepareShutdown(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PrepareShutdownRequest) (*tabletmanagerdatapb.PrepareShutdownResponse, error) {
+	return &tabletmanagerdatapb.PrepareShutdownResponse{Ready: true}, nil
+}
+
+// GracefulRestart is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GracefulRestart(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GracefulRestartRequest) (*tabletmanagerdatapb.GracefulRestartResponse, error) {
+	return &tabletmanagerdatapb.GracefulRestartResponse{}, nil
+}
+
+// PauseTableGC is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) PauseTableGC(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PauseTableGCRequest) (*tabletmanagerdatapb.PauseTableGCResponse, error) {
+	return &tabletmanagerdatapb.PauseTableGCResponse{}, nil
+}
+
+// ResumeTableGC is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ResumeTableGC(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ResumeTableGCRequest) (*tabletmanagerdatapb.ResumeTableGCResponse, error) {
+	return &tabletmanagerdatapb.ResumeTableGCResponse{}, nil
+}
+
+// RestoreGCTable is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) RestoreGCTable(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreGCTableRequest) (*tabletmanagerdatapb.RestoreGCTableResponse, error) {
+	return &tabletmanagerdatapb.RestoreGCTableResponse{TableName: req.RestoreAs}, nil
+}
+
+// GetMysqlVariables is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetMysqlVariables(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GetMysqlVariablesRequest) (*tabletmanagerdatapb.GetMysqlVariablesResponse, error) {
+	return &tabletmanagerdatapb.GetMysqlVariablesResponse{}, nil
+}
+
+// SetMysqlVariable is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetMysqlVariable(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.SetMysqlVariableRequest) (*tabletmanagerdatapb.SetMysqlVariableResponse, error) {
+	return &tabletmanagerdatapb.SetMysqlVariableResponse{}, nil
+}
+
 // ReloadSchema is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error {
 	return nil
@@ -197,6 +301,43 @@ func (client *FakeTabletManagerClient) ExecuteFetchAsDba(ctx context.Context, ta
 	return &querypb.QueryResult{}, nil
 }
 
+// ExecuteFetchAsDbaAsync is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ExecuteFetchAsDbaAsync(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (string, error) {
+	return "", nil
+}
+
+// PollFetchJob is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) PollFetchJob(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PollFetchJobRequest) (*tabletmanagerdatapb.PollFetchJobResponse, error) {
+	return &tabletmanagerdatapb.PollFetchJobResponse{
+		JobId:  req.JobId,
+		State:  tabletmanagerdatapb.PollFetchJobResponse_COMPLETE,
+		Result: &querypb.QueryResult{},
+	}, nil
+}
+
+// CancelFetchJob is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) CancelFetchJob(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.CancelFetchJobRequest) (*tabletmanagerdatapb.CancelFetchJobResponse, error) {
+	return &tabletmanagerdatapb.CancelFetchJobResponse{}, nil
+}
+
+// emptyQueryResultStream is a QueryResultStream of a query that returned nothing.
+type emptyQueryResultStream struct {
+	done bool
+}
+
+func (stream *emptyQueryResultStream) Recv() (*querypb.QueryResult, error) {
+	if stream.done {
+		return nil, io.EOF
+	}
+	stream.done = true
+	return &querypb.QueryResult{}, nil
+}
+
+// StreamExecuteFetchAsDba is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) StreamExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.StreamExecuteFetchAsDbaRequest) (tmclient.QueryResultStream, error) {
+	return &emptyQueryResultStream{}, nil
+}
+
 // FakeTabletManagerClient is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) ExecuteMultiFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, req *tabletmanagerdatapb.ExecuteMultiFetchAsDbaRequest) ([]*querypb.QueryResult, error) {
 	return []*querypb.QueryResult{}, nil
@@ -226,6 +367,11 @@ func (client *FakeTabletManagerClient) FullStatus(ctx context.Context, tablet *t
 	return &replicationdatapb.FullStatus{}, nil
 }
 
+// SemiSyncStatus is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SemiSyncStatus(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.SemiSyncStatusResponse, error) {
+	return &tabletmanagerdatapb.SemiSyncStatusResponse{}, nil
+}
+
 // StopReplication is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) StopReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return nil
@@ -256,6 +402,16 @@ func (client *FakeTabletManagerClient) GetReplicas(ctx context.Context, tablet *
 	return nil, nil
 }
 
+// FlushBinaryLogs is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) FlushBinaryLogs(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.FlushBinaryLogsResponse, error) {
+	return &tabletmanagerdatapb.FlushBinaryLogsResponse{}, nil
+}
+
+// PurgeBinaryLogs is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) PurgeBinaryLogs(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.PurgeBinaryLogsRequest) (*tabletmanagerdatapb.PurgeBinaryLogsResponse, error) {
+	return &tabletmanagerdatapb.PurgeBinaryLogsResponse{}, nil
+}
+
 // InitReplica is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) InitReplica(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, replicationPosition string, timeCreatedNS int64, semiSync bool) error {
 	return nil
@@ -286,6 +442,26 @@ func (client *FakeTabletManagerClient) WaitForPosition(ctx context.Context, tabl
 	return nil
 }
 
+// reachedWaitForPositionStream is a WaitForPositionStream that reached its
+// position right away.
+type reachedWaitForPositionStream struct {
+	position string
+	done     bool
+}
+
+func (stream *reachedWaitForPositionStream) Recv() (*tabletmanagerdatapb.StreamWaitForPositionResponse, error) {
+	if stream.done {
+		return nil, io.EOF
+	}
+	stream.done = true
+	return &tabletmanagerdatapb.StreamWaitForPositionResponse{Position: stream.position, Reached: true}, nil
+}
+
+// StreamWaitForPosition is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) StreamWaitForPosition(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.StreamWaitForPositionRequest) (tmclient.WaitForPositionStream, error) {
+	return &reachedWaitForPositionStream{position: req.Position}, nil
+}
+
 // VReplicationExec is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
 	// This result satisfies 'select pos from _vt.vreplication...' called from split clone unit tests in go/vt/worker.
@@ -321,7 +497,7 @@ func (client *FakeTabletManagerClient) PopulateReparentJournal(ctx context.Conte
 }
 
 // DemotePrimary is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) DemotePrimary(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.PrimaryStatus, error) {
+func (client *FakeTabletManagerClient) DemotePrimary(ctx context.Context, tablet *topodatapb.Tablet, handoff *tabletmanagerdatapb.PrimaryHandoff) (*replicationdatapb.PrimaryStatus, error) {
 	return nil, nil
 }
 
@@ -341,7 +517,7 @@ func (client *FakeTabletManagerClient) StopReplicationAndGetStatus(ctx context.C
 }
 
 // PromoteReplica is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) PromoteReplica(ctx context.Context, tablet *topodatapb.Tablet, semiSync bool) (string, error) {
+func (client *FakeTabletManagerClient) PromoteReplica(ctx context.Context, tablet *topodatapb.Tablet, semiSync bool, handoff *tabletmanagerdatapb.PrimaryHandoff) (string, error) {
 	return "", nil
 }
 
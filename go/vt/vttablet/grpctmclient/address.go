@@ -0,0 +1,172 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/netutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The address families of --tablet_manager_grpc_address_families.
+const (
+	addressFamilyIPv4 = "ipv4"
+	addressFamilyIPv6 = "ipv6"
+)
+
+// addressFamilies is the value of --tablet_manager_grpc_address_families.
+var addressFamilies addressFamiliesFlag
+
+// lookupNetIP resolves the hostnames of the tablets when they are dialed with
+// --tablet_manager_grpc_address_families.
+var lookupNetIP = net.DefaultResolver.LookupNetIP
+
+// tabletHost returns the host of the tablet manager of a tablet. The hostname
+// of a tablet can be an IP address, and an IPv6 one may be bracketed in the
+// tablet record: the brackets are removed so that it isn't bracketed twice.
+func tabletHost(tablet *topodatapb.Tablet) string {
+	host := strings.TrimSpace(tablet.GetHostname())
+	if len(host) > 1 && host[0] == '[' && host[len(host)-1] == ']' {
+		host = host[1 : len(host)-1]
+	}
+	return host
+}
+
+// tabletAddr returns the address to dial the tablet manager of a tablet at, in
+// the host:port format, with an IPv6 host in brackets. A tablet without a
+// hostname can't be dialed, as its address would be the one of the local host.
+func tabletAddr(tablet *topodatapb.Tablet) (string, error) {
+	host := tabletHost(tablet)
+	if host == "" {
+		return "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "tablet %v has no hostname to dial its tablet manager at", topoproto.TabletAliasString(tablet.GetAlias()))
+	}
+	return netutil.JoinHostPort(host, tablet.GetPortMap()["grpc"]), nil
+}
+
+// addressFamilyDialOption returns the dial option that dials the tablets in the
+// order of preference of --tablet_manager_grpc_address_families, or nil if it's
+// not set. The hostname of a tablet can resolve to addresses of several families
+// when only some of them are reachable. The certificate of the tablet is still
+// validated against its hostname, as the address that is dialed doesn't change
+// the authority of the connection.
+func addressFamilyDialOption() grpc.DialOption {
+	families := addressFamilies.families
+	if len(families) == 0 {
+		return nil
+	}
+	return grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		return dialAddressFamilies(ctx, addr, families)
+	})
+}
+
+// dialAddressFamilies dials the addresses that the host of addr resolves to, in
+// the order of preference of the families, until one of them answers. An IP
+// address is dialed as is, whatever its family.
+func dialAddressFamilies(ctx context.Context, addr string, families []string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	var dialer net.Dialer
+	if _, err := netip.ParseAddr(host); err == nil {
+		return dialer.DialContext(ctx, "tcp", addr)
+	}
+
+	ips, err := lookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil, err
+	}
+	ips = orderByAddressFamily(ips, families)
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("%v has no %v address", host, strings.Join(families, " or "))
+	}
+	var errs []error
+	for _, ip := range ips {
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(ip.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, errors.Join(errs...)
+}
+
+// orderByAddressFamily returns the addresses of the given families, in their
+// order of preference.
+func orderByAddressFamily(ips []netip.Addr, families []string) []netip.Addr {
+	ordered := make([]netip.Addr, 0, len(ips))
+	for _, family := range families {
+		for _, ip := range ips {
+			if addressFamily(ip) == family {
+				ordered = append(ordered, ip)
+			}
+		}
+	}
+	return ordered
+}
+
+func addressFamily(ip netip.Addr) string {
+	if ip.Unmap().Is4() {
+		return addressFamilyIPv4
+	}
+	return addressFamilyIPv6
+}
+
+// addressFamiliesFlag implements pflag.Value for a comma-separated list of
+// address families, in their order of preference.
+type addressFamiliesFlag struct {
+	families []string
+}
+
+func (f *addressFamiliesFlag) String() string {
+	return strings.Join(f.families, ",")
+}
+
+func (f *addressFamiliesFlag) Set(value string) error {
+	var families []string
+	for _, family := range strings.Split(value, ",") {
+		family = strings.ToLower(strings.TrimSpace(family))
+		switch {
+		case family == "":
+			continue
+		case family != addressFamilyIPv4 && family != addressFamilyIPv6:
+			return fmt.Errorf("invalid address family %q, expected %v or %v", family, addressFamilyIPv4, addressFamilyIPv6)
+		case slices.Contains(families, family):
+			return fmt.Errorf("address family %q is listed more than once", family)
+		}
+		families = append(families, family)
+	}
+	f.families = families
+	return nil
+}
+
+func (f *addressFamiliesFlag) Type() string {
+	return "strings"
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"vitess.io/vitess/go/vt/tlstest"
+	"vitess.io/vitess/go/vt/vttablet/grpctmserver"
+	"vitess.io/vitess/go/vt/vttablet/tmrpctest"
+	"vitess.io/vitess/go/vt/vttls"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestTabletAddr(t *testing.T) {
+	tcs := []struct {
+		hostname string
+		addr     string
+	}{
+		{hostname: "tablet-101.example.com", addr: "tablet-101.example.com:15999"},
+		{hostname: "10.0.0.1", addr: "10.0.0.1:15999"},
+		{hostname: "2001:db8::1", addr: "[2001:db8::1]:15999"},
+		{hostname: "[2001:db8::1]", addr: "[2001:db8::1]:15999"},
+		{hostname: "fe80::1%eth0", addr: "[fe80::1%eth0]:15999"},
+		{hostname: " ::1 ", addr: "[::1]:15999"},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.hostname, func(t *testing.T) {
+			addr, err := tabletAddr(&topodatapb.Tablet{Hostname: tc.hostname, PortMap: map[string]int32{"grpc": 15999}})
+			require.NoError(t, err)
+			assert.Equal(t, tc.addr, addr)
+		})
+	}
+
+	_, err := tabletAddr(&topodatapb.Tablet{
+		Alias:   &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+		PortMap: map[string]int32{"grpc": 15999},
+	})
+	assert.ErrorContains(t, err, "tablet zone1-0000000101 has no hostname")
+}
+
+func TestAddressFamiliesFlag(t *testing.T) {
+	var f addressFamiliesFlag
+	require.NoError(t, f.Set("IPv6, ipv4"))
+	assert.Equal(t, []string{addressFamilyIPv6, addressFamilyIPv4}, f.families)
+	assert.Equal(t, "ipv6,ipv4", f.String())
+	require.NoError(t, f.Set(""))
+	assert.Empty(t, f.families)
+
+	assert.ErrorContains(t, f.Set("ipv4,ipx"), `invalid address family "ipx"`)
+	assert.ErrorContains(t, f.Set("ipv4,ipv6,ipv4"), `address family "ipv4" is listed more than once`)
+}
+
+func TestOrderByAddressFamily(t *testing.T) {
+	ips := []netip.Addr{
+		netip.MustParseAddr("10.0.0.1"),
+		netip.MustParseAddr("2001:db8::1"),
+		netip.MustParseAddr("::ffff:10.0.0.2"),
+		netip.MustParseAddr("2001:db8::2"),
+	}
+	assert.Equal(t, []netip.Addr{ips[1], ips[3], ips[0], ips[2]}, orderByAddressFamily(ips, []string{addressFamilyIPv6, addressFamilyIPv4}))
+	assert.Equal(t, []netip.Addr{ips[0], ips[2]}, orderByAddressFamily(ips, []string{addressFamilyIPv4}))
+	assert.Empty(t, orderByAddressFamily(ips[1:2], []string{addressFamilyIPv4}))
+}
+
+// TestDialIPv6WithTLS dials a tablet manager listening on the IPv6 loopback
+// over TLS, by its address and by a hostname that also resolves to IPv4.
+func TestDialIPv6WithTLS(t *testing.T) {
+	lis, err := net.Listen("tcp", "[::1]:0")
+	if err != nil {
+		t.Skipf("IPv6 is not available: %v", err)
+	}
+	defer lis.Close()
+
+	// The certificate of the server is valid for localhost, 127.0.0.1 and ::1.
+	certs := tlstest.CreateClientServerCertPairs(t.TempDir())
+	config, err := vttls.ServerConfig(certs.ServerCert, certs.ServerKey, "", "", "", tls.VersionTLS12)
+	require.NoError(t, err)
+	s := grpc.NewServer(grpc.Creds(credentials.NewTLS(config)))
+	grpctmserver.RegisterForTest(s, tmrpctest.NewFakeRPCTM(t))
+	go s.Serve(lis)
+	defer s.Stop()
+
+	oldCA, oldName, oldFamilies, oldLookup := ca, name, addressFamilies, lookupNetIP
+	defer func() {
+		ca, name, addressFamilies, lookupNetIP = oldCA, oldName, oldFamilies, oldLookup
+	}()
+	ca, name = certs.ServerCA, ""
+	lookupNetIP = func(ctx context.Context, network, host string) ([]netip.Addr, error) {
+		if host != "localhost" {
+			return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+		}
+		return []netip.Addr{netip.MustParseAddr("127.0.0.1"), netip.MustParseAddr("::1")}, nil
+	}
+
+	port := int32(lis.Addr().(*net.TCPAddr).Port)
+	ping := func(hostname string) error {
+		client := NewClient()
+		defer client.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return client.Ping(ctx, &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+			Hostname: hostname,
+			PortMap:  map[string]int32{"grpc": port},
+		})
+	}
+
+	// The certificate is validated against the IP address.
+	for _, hostname := range []string{"::1", "[::1]"} {
+		assert.NoError(t, ping(hostname), hostname)
+	}
+
+	// The hostname is dialed at its IPv6 address, and the certificate is still
+	// validated against the hostname.
+	require.NoError(t, addressFamilies.Set("ipv6,ipv4"))
+	assert.NoError(t, ping("localhost"))
+
+	// Nothing listens on the IPv4 address.
+	require.NoError(t, addressFamilies.Set("ipv4"))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	conn, err := dialAddressFamilies(ctx, net.JoinHostPort("localhost", strconv.Itoa(int(port))), addressFamilies.families)
+	if err == nil {
+		conn.Close()
+	}
+	assert.Error(t, err)
+}
@@ -27,9 +27,7 @@ import (
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc"
 
-	"vitess.io/vitess/go/netutil"
 	"vitess.io/vitess/go/stats"
-	"vitess.io/vitess/go/vt/grpcclient"
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
@@ -79,6 +77,7 @@ type cachedConnDialer struct {
 	evictSorted  bool
 	connWaitSema *semaphore.Weighted
 	capacity     int
+	tracker      *inflightTracker
 }
 
 var dialerStats = struct {
@@ -96,13 +95,15 @@ var dialerStats = struct {
 // NewCachedConnClient returns a grpc Client that caches connections to the
 // different tablets.
 func NewCachedConnClient(capacity int) *Client {
+	tracker := &inflightTracker{}
 	dialer := &cachedConnDialer{
 		conns:        make(map[string]*cachedConn, capacity),
 		evict:        make([]*cachedConn, 0, capacity),
 		connWaitSema: semaphore.NewWeighted(int64(capacity)),
 		capacity:     capacity,
+		tracker:      tracker,
 	}
-	return &Client{dialer}
+	return &Client{dialer: dialer, tracker: tracker, quotas: newRPCQuotaLimiter(rpcQuotas.specs, rpcQuotaMaxWaiting)}
 }
 
 var _ dialer = (*cachedConnDialer)(nil)
@@ -121,8 +122,15 @@ func (dialer *cachedConnDialer) sortEvictionsLocked() {
 }
 
 func (dialer *cachedConnDialer) dial(ctx context.Context, tablet *topodatapb.Tablet) (tabletmanagerservicepb.TabletManagerClient, io.Closer, error) {
+	if err := tmclient.CheckFailFast(ctx, tablet); err != nil {
+		return nil, nil, err
+	}
+
 	start := time.Now()
-	addr := getTabletAddr(tablet)
+	addr, err := tabletAddr(tablet)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	if client, closer, found, err := dialer.tryFromCache(addr, &dialer.m); found {
 		dialerStats.DialTimings.Add("cache_fast", time.Since(start))
@@ -143,7 +151,7 @@ func (dialer *cachedConnDialer) dial(ctx context.Context, tablet *topodatapb.Tab
 			dialer.connWaitSema.Release(1)
 			return client, closer, err
 		}
-		return dialer.newdial(ctx, addr)
+		return dialer.newdial(ctx, addr, tablet)
 	}
 
 	defer func() {
@@ -156,7 +164,7 @@ func (dialer *cachedConnDialer) dial(ctx context.Context, tablet *topodatapb.Tab
 			dialerStats.DialTimeouts.Add(1)
 			return nil, nil, ctx.Err()
 		default:
-			if client, closer, found, err := dialer.pollOnce(ctx, addr); found {
+			if client, closer, found, err := dialer.pollOnce(ctx, addr, tablet); found {
 				return client, closer, err
 			}
 		}
@@ -204,7 +212,7 @@ func (dialer *cachedConnDialer) tryFromCache(addr string, locker sync.Locker) (c
 //
 // It returns a TabletManagerClient impl, an io.Closer, a flag to indicate
 // whether the dial() poll loop should exit, and an error.
-func (dialer *cachedConnDialer) pollOnce(ctx context.Context, addr string) (client tabletmanagerservicepb.TabletManagerClient, closer io.Closer, found bool, err error) {
+func (dialer *cachedConnDialer) pollOnce(ctx context.Context, addr string, tablet *topodatapb.Tablet) (client tabletmanagerservicepb.TabletManagerClient, closer io.Closer, found bool, err error) {
 	dialer.m.Lock()
 
 	if client, closer, found, err := dialer.tryFromCache(addr, nil); found {
@@ -222,10 +230,10 @@ func (dialer *cachedConnDialer) pollOnce(ctx context.Context, addr string) (clie
 
 	dialer.evict = dialer.evict[1:]
 	delete(dialer.conns, conn.addr)
-	conn.cc.Close()
+	closeTablet(conn.cc)
 	dialer.m.Unlock()
 
-	client, closer, err = dialer.newdial(ctx, addr)
+	client, closer, err = dialer.newdial(ctx, addr, tablet)
 	return client, closer, true, err
 }
 
@@ -235,15 +243,16 @@ func (dialer *cachedConnDialer) pollOnce(ctx context.Context, addr string) (clie
 // newdial calls.
 //
 // It returns the three-tuple of client-interface, closer, and error that the
-// main dial func returns.
-func (dialer *cachedConnDialer) newdial(ctx context.Context, addr string) (tabletmanagerservicepb.TabletManagerClient, io.Closer, error) {
-	opt, err := grpcclient.SecureDialOption(cert, key, ca, crl, name)
+// main dial func returns. The tablet is used to pick the credentials of the
+// connection.
+func (dialer *cachedConnDialer) newdial(ctx context.Context, addr string, tablet *topodatapb.Tablet) (tabletmanagerservicepb.TabletManagerClient, io.Closer, error) {
+	opt, err := tabletDialOption(tablet)
 	if err != nil {
 		dialer.connWaitSema.Release(1)
 		return nil, nil, err
 	}
 
-	cc, err := grpcclient.DialContext(ctx, addr, grpcclient.FailFast(false), opt)
+	cc, err := dialTablet(ctx, addr, tablet.GetAlias().GetCell(), connKindCached, dialer.tracker, opt)
 	if err != nil {
 		dialer.connWaitSema.Release(1)
 		return nil, nil, err
@@ -257,7 +266,7 @@ func (dialer *cachedConnDialer) newdial(ctx context.Context, addr string) (table
 		// this is not great, but shouldn't happen often (if at all), so we're going to
 		// close this connection and reuse the existing one. by doing this, we can keep
 		// the actual Dial out of the global lock and significantly increase throughput
-		cc.Close()
+		closeTablet(cc)
 		dialer.connWaitSema.Release(1)
 		return dialer.redialLocked(conn)
 	}
@@ -326,13 +335,9 @@ func (dialer *cachedConnDialer) Close() {
 	defer dialer.m.Unlock()
 
 	for _, conn := range dialer.evict {
-		conn.cc.Close()
+		closeTablet(conn.cc)
 		delete(dialer.conns, conn.addr)
 		dialer.connWaitSema.Release(1)
 	}
 	dialer.evict = make([]*cachedConn, 0, dialer.capacity)
 }
-
-func getTabletAddr(tablet *topodatapb.Tablet) string {
-	return netutil.JoinHostPort(tablet.Hostname, int32(tablet.PortMap["grpc"]))
-}
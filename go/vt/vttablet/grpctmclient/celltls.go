@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/grpcclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// cellTLSConfigs holds the credentials to use to connect to the tablets of each
+// cell, from --tablet_manager_grpc_cell_tls_config.
+var cellTLSConfigs cellTLSWatcher
+
+// cellTLS is the credentials to use to connect to the tablets of a cell. The
+// empty fields default to the --tablet_manager_grpc_{cert,key,ca,crl} flags.
+type cellTLS struct {
+	Cert string `json:"cert"`
+	Key  string `json:"key"`
+	CA   string `json:"ca"`
+	CRL  string `json:"crl"`
+}
+
+// tabletDialOption returns the dial option with the credentials to use to
+// connect to the given tablet.
+func tabletDialOption(tablet *topodatapb.Tablet) (grpc.DialOption, error) {
+	creds, err := cellTLSConfigs.forCell(tablet.GetAlias().GetCell())
+	if err != nil {
+		return nil, err
+	}
+	return grpcclient.SecureDialOption(creds.Cert, creds.Key, creds.CA, creds.CRL, tabletServerName(tablet))
+}
+
+// cellTLSWatcher loads the credentials of each cell from a JSON file, which
+// maps the cells to their cellTLS, the first time they are needed. It then
+// reloads them whenever the file changes, keeping the previous ones if the
+// file becomes invalid.
+type cellTLSWatcher struct {
+	path string
+
+	once    sync.Once
+	err     error
+	configs atomic.Pointer[map[string]cellTLS]
+	watcher *fsnotify.Watcher
+}
+
+// forCell returns the credentials to use to connect to the tablets of cell.
+func (w *cellTLSWatcher) forCell(cell string) (cellTLS, error) {
+	creds := cellTLS{Cert: cert, Key: key, CA: ca, CRL: crl}
+	if w.path == "" {
+		return creds, nil
+	}
+	w.once.Do(func() {
+		w.err = w.start()
+	})
+	if w.err != nil {
+		return cellTLS{}, w.err
+	}
+
+	override, ok := (*w.configs.Load())[cell]
+	if !ok {
+		return creds, nil
+	}
+	if override.Cert != "" {
+		creds.Cert = override.Cert
+	}
+	if override.Key != "" {
+		creds.Key = override.Key
+	}
+	if override.CA != "" {
+		creds.CA = override.CA
+	}
+	if override.CRL != "" {
+		creds.CRL = override.CRL
+	}
+	return creds, nil
+}
+
+func (w *cellTLSWatcher) start() error {
+	if err := w.load(); err != nil {
+		return err
+	}
+	watcher, err := watchConfigFile(w.path, "tablet manager cell TLS config", w.load)
+	if err != nil {
+		return err
+	}
+	w.watcher = watcher
+	return nil
+}
+
+func (w *cellTLSWatcher) load() error {
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		return fmt.Errorf("failed to read the tablet manager cell TLS config: %w", err)
+	}
+	var configs map[string]cellTLS
+	if err := json.Unmarshal(data, &configs); err != nil {
+		return fmt.Errorf("failed to parse the tablet manager cell TLS config %v: %w", w.path, err)
+	}
+	w.configs.Store(&configs)
+	return nil
+}
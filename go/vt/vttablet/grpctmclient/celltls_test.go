@@ -0,0 +1,86 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCellTLSWatcher(t *testing.T) {
+	oldCert, oldKey, oldCA, oldCRL := cert, key, ca, crl
+	defer func() {
+		cert, key, ca, crl = oldCert, oldKey, oldCA, oldCRL
+	}()
+	cert, key, ca, crl = "global.pem", "global.key", "global-ca.pem", ""
+
+	global := cellTLS{Cert: "global.pem", Key: "global.key", CA: "global-ca.pem"}
+
+	// Without a config file, the global flags are used.
+	w := &cellTLSWatcher{}
+	creds, err := w.forCell("zone1")
+	require.NoError(t, err)
+	assert.Equal(t, global, creds)
+
+	path := filepath.Join(t.TempDir(), "cell_tls.json")
+	w = &cellTLSWatcher{path: path}
+	_, err = w.forCell("zone1")
+	assert.ErrorContains(t, err, "failed to read the tablet manager cell TLS config")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"zone1": {"cert": "zone1.pem", "key": "zone1.key"},
+		"zone2": {"ca": "zone2-ca.pem", "crl": "zone2.crl"}
+	}`), 0o644))
+	w = &cellTLSWatcher{path: path}
+	defer func() {
+		if w.watcher != nil {
+			w.watcher.Close()
+		}
+	}()
+
+	creds, err = w.forCell("zone1")
+	require.NoError(t, err)
+	assert.Equal(t, cellTLS{Cert: "zone1.pem", Key: "zone1.key", CA: "global-ca.pem"}, creds)
+	creds, err = w.forCell("zone2")
+	require.NoError(t, err)
+	assert.Equal(t, cellTLS{Cert: "global.pem", Key: "global.key", CA: "zone2-ca.pem", CRL: "zone2.crl"}, creds)
+	creds, err = w.forCell("zone3")
+	require.NoError(t, err)
+	assert.Equal(t, global, creds)
+
+	// The file is reloaded when it changes.
+	require.NoError(t, os.WriteFile(path, []byte(`{"zone3": {"cert": "zone3.pem", "key": "zone3.key"}}`), 0o644))
+	require.Eventually(t, func() bool {
+		creds, err := w.forCell("zone3")
+		return err == nil && creds.Cert == "zone3.pem"
+	}, 5*time.Second, 10*time.Millisecond)
+	creds, err = w.forCell("zone1")
+	require.NoError(t, err)
+	assert.Equal(t, global, creds)
+
+	// An invalid file doesn't replace the previous config.
+	require.NoError(t, os.WriteFile(path, []byte(`{"zone1": `), 0o644))
+	time.Sleep(100 * time.Millisecond)
+	creds, err = w.forCell("zone3")
+	require.NoError(t, err)
+	assert.Equal(t, cellTLS{Cert: "zone3.pem", Key: "zone3.key", CA: "global-ca.pem"}, creds)
+}
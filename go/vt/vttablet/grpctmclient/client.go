@@ -26,15 +26,14 @@ import (
 	"github.com/spf13/pflag"
 	"google.golang.org/grpc"
 
-	"vitess.io/vitess/go/netutil"
 	"vitess.io/vitess/go/vt/callerid"
-	"vitess.io/vitess/go/vt/grpcclient"
 	"vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
@@ -43,6 +42,7 @@ import (
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	tabletmanagerservicepb "vitess.io/vitess/go/vt/proto/tabletmanagerservice"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 type DialPoolGroup int
@@ -64,12 +64,22 @@ var (
 )
 
 func registerFlags(fs *pflag.FlagSet) {
-	fs.IntVar(&concurrency, "tablet_manager_grpc_concurrency", concurrency, "concurrency to use to talk to a vttablet server for performance-sensitive RPCs (like ExecuteFetchAs{Dba,App}, CheckThrottler and FullStatus)")
+	fs.IntVar(&concurrency, "tablet_manager_grpc_concurrency", concurrency, "concurrency to use to talk to a vttablet server for performance-sensitive RPCs (like ExecuteFetchAs{Dba,App}, CheckThrottler and FullStatus), and size of the pool of connections to each tablet with --tablet_manager_protocol=grpc-pooled")
 	fs.StringVar(&cert, "tablet_manager_grpc_cert", cert, "the cert to use to connect")
 	fs.StringVar(&key, "tablet_manager_grpc_key", key, "the key to use to connect")
 	fs.StringVar(&ca, "tablet_manager_grpc_ca", ca, "the server ca to use to validate servers when connecting")
 	fs.StringVar(&crl, "tablet_manager_grpc_crl", crl, "the server crl to use to validate server certificates when connecting")
 	fs.StringVar(&name, "tablet_manager_grpc_server_name", name, "the server name to use to validate server certificate")
+	fs.StringVar(&cellTLSConfigs.path, "tablet_manager_grpc_cell_tls_config", cellTLSConfigs.path, "path to a JSON file with the cert, key, ca and crl to use to connect to the tablets of each cell (e.g. {\"zone1\": {\"cert\": \"/certs/zone1.pem\", \"key\": \"/certs/zone1.key\"}}), the --tablet_manager_grpc_{cert,key,ca,crl} flags are used for the cells and fields that are missing. The file is reloaded when it changes, for the new connections")
+	fs.StringVar(&serverNameTag, "tablet_manager_grpc_server_name_tag", serverNameTag, "the tablet tag holding the server name to use to validate the certificate of that tablet, overrides --tablet_manager_grpc_server_name_template and --tablet_manager_grpc_server_name for tablets that have it")
+	fs.StringVar(&serverNameTemplate, "tablet_manager_grpc_server_name_template", serverNameTemplate, "the template of the server name to use to validate the certificate of each tablet, with {cell}, {uid}, {hostname}, {keyspace} and {shard} placeholders (e.g. {uid}.tablets.svc), overrides --tablet_manager_grpc_server_name")
+	fs.Var(&addressFamilies, "tablet_manager_grpc_address_families", "comma-separated address families to dial the tablets at, in their order of preference, when their hostname resolves to addresses of several families (e.g. ipv6,ipv4); the addresses of the other families are not dialed. By default the hostname is dialed as is")
+	fs.DurationVar(&slowRPCThreshold, "tablet_manager_grpc_slow_rpc_threshold", slowRPCThreshold, "log tablet manager RPCs that take longer than this, with their tablet, method, duration and error (0 to disable)")
+	fs.IntVar(&maxRequestSize, "tablet_manager_grpc_max_request_size", maxRequestSize, "reject tablet manager RPCs whose request is larger than this many bytes before sending them, e.g. an ApplySchema with huge SQL statements (0 to disable)")
+	fs.Var(&rpcQuotas, "tablet_manager_grpc_rpc_quotas", "comma-separated rate limits of expensive tablet manager RPCs issued by this process to the tablets of a keyspace or shard, in the <class>:<keyspace>[/<shard>]=<RPCs per second> format (e.g. ExecuteFetchAsDba:commerce=10,GetSchema:commerce/-80=0.5), where the class is ExecuteFetchAsDba (also covering ExecuteMultiFetchAsDba) or GetSchema. The RPCs over a limit wait for their turn")
+	fs.IntVar(&rpcQuotaMaxWaiting, "tablet_manager_grpc_rpc_quota_max_waiting", rpcQuotaMaxWaiting, "the number of tablet manager RPCs that can wait for the same --tablet_manager_grpc_rpc_quotas limit, the others are rejected (0 for no limit)")
+	fs.StringVar(&rpcPolicies.path, "tablet_manager_grpc_rpc_policy_config", rpcPolicies.path, "path to a YAML or JSON file with the deadline, timeout, retries and hedging of the unary tablet manager RPCs, by default, by class (read or write) and by method, which can be overridden for the tablets of each cell (e.g. {\"classes\": {\"read\": {\"timeout\": \"5s\", \"retries\": 2}}, \"cells\": {\"zone2\": {\"default\": {\"timeout\": \"1m\"}}}}). Only the read RPCs and the idempotent write RPCs can be retried, and only the read RPCs can be hedged. The file is reloaded when it changes, for the new RPCs")
+	fs.BoolVar(&enableChannelz, "tablet_manager_grpc_enable_channelz", enableChannelz, "register the gRPC channelz service on the gRPC server, to inspect the connections of the tablet manager clients")
 }
 
 var _binaries = []string{ // binaries that require the flags in this package
@@ -94,6 +104,7 @@ func init() {
 	for _, cmd := range _binaries {
 		servenv.OnParseFor(cmd, registerFlags)
 	}
+	servenv.OnInit(loadRPCPolicies)
 }
 
 type tmc struct {
@@ -112,6 +123,8 @@ type grpcClient struct {
 	mu             sync.Mutex
 	rpcClientMap   map[string]chan *tmc
 	rpcDialPoolMap map[DialPoolGroup]addrTmcMap
+
+	tracker *inflightTracker
 }
 
 type dialer interface {
@@ -139,34 +152,67 @@ type poolDialer interface {
 // The cachedConnDialer keeps connections to up to --tablet_manager_grpc_connpool_size
 // distinct tablets open at any given time, for faster per-RPC call time, and less
 // connection churn.
+//
+// Alternatively, the pooledConnDialer implementation, with
+//
+//	--tablet_manager_protocol "grpc-pooled"
+//
+// sends all the RPCs to a tablet over a pool of up to --tablet_manager_grpc_concurrency
+// shared connections, without limiting the number of tablets. The failing
+// connections are redialed, and the ones that were unused for
+// --tablet_manager_grpc_pool_idle_timeout are closed.
+//
+// The expensive RPCs, like ExecuteFetchAsDba and GetSchema, can be rate limited
+// per keyspace or shard with --tablet_manager_grpc_rpc_quotas. The limits apply
+// to the RPCs issued through each Client.
+//
+// A Client is safe for concurrent use by multiple goroutines. Close closes all
+// the underlying connections immediately, failing any RPCs that are in flight,
+// and the connections that are still being dialed are closed as soon as they
+// come up. Use CloseWithTimeout to let in-flight RPCs finish first.
 type Client struct {
-	dialer dialer
+	dialer  dialer
+	tracker *inflightTracker
+	quotas  *rpcQuotaLimiter
 }
 
 // NewClient returns a new gRPC client.
 func NewClient() *Client {
+	tracker := &inflightTracker{}
 	return &Client{
-		dialer: &grpcClient{},
+		dialer:  &grpcClient{tracker: tracker},
+		tracker: tracker,
+		quotas:  newRPCQuotaLimiter(rpcQuotas.specs, rpcQuotaMaxWaiting),
 	}
 }
 
 // dial returns a client to use
 func (client *grpcClient) dial(ctx context.Context, tablet *topodatapb.Tablet) (tabletmanagerservicepb.TabletManagerClient, io.Closer, error) {
-	addr := netutil.JoinHostPort(tablet.Hostname, int32(tablet.PortMap["grpc"]))
-	opt, err := grpcclient.SecureDialOption(cert, key, ca, crl, name)
+	if err := tmclient.CheckFailFast(ctx, tablet); err != nil {
+		return nil, nil, err
+	}
+	addr, err := tabletAddr(tablet)
+	if err != nil {
+		return nil, nil, err
+	}
+	opt, err := tabletDialOption(tablet)
 	if err != nil {
 		return nil, nil, err
 	}
-	cc, err := grpcclient.DialContext(ctx, addr, grpcclient.FailFast(false), opt)
+	cc, err := dialTablet(ctx, addr, tablet.GetAlias().GetCell(), connKindOneshot, client.tracker, opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return tabletmanagerservicepb.NewTabletManagerClient(cc), cc, nil
+	return tabletmanagerservicepb.NewTabletManagerClient(cc), tabletCloser{cc: cc}, nil
 }
 
-func (client *grpcClient) createTmc(ctx context.Context, addr string, opt grpc.DialOption) (*tmc, error) {
-	cc, err := grpcclient.DialContext(ctx, addr, grpcclient.FailFast(false), opt)
+func (client *grpcClient) createTmc(ctx context.Context, addr string, cell string, kind string, opt grpc.DialOption) (*tmc, error) {
+	// Dialing doesn't block, so it doesn't notice that ctx is done.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	cc, err := dialTablet(ctx, addr, cell, kind, client.tracker, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -177,12 +223,20 @@ func (client *grpcClient) createTmc(ctx context.Context, addr string, opt grpc.D
 }
 
 func (client *grpcClient) dialPool(ctx context.Context, tablet *topodatapb.Tablet) (tabletmanagerservicepb.TabletManagerClient, error) {
-	addr := netutil.JoinHostPort(tablet.Hostname, int32(tablet.PortMap["grpc"]))
-	opt, err := grpcclient.SecureDialOption(cert, key, ca, crl, name)
+	if err := tmclient.CheckFailFast(ctx, tablet); err != nil {
+		return nil, err
+	}
+	addr, err := tabletAddr(tablet)
+	if err != nil {
+		return nil, err
+	}
+	opt, err := tabletDialOption(tablet)
 	if err != nil {
 		return nil, err
 	}
 
+	cell := tablet.GetAlias().GetCell()
+
 	client.mu.Lock()
 	if client.rpcClientMap == nil {
 		client.rpcClientMap = make(map[string]chan *tmc)
@@ -193,25 +247,94 @@ func (client *grpcClient) dialPool(ctx context.Context, tablet *topodatapb.Table
 		client.rpcClientMap[addr] = c
 		client.mu.Unlock()
 
-		for i := 0; i < cap(c); i++ {
-			tm, err := client.createTmc(ctx, addr, opt)
-			if err != nil {
-				return nil, err
-			}
-			c <- tm
+		// Only the first connection is dialed by the caller, so it can use the pool
+		// right away. The other ones are dialed in the background, and are added to
+		// the pool as they come up.
+		tm, err := client.createTmc(ctx, addr, cell, connKindPool, opt)
+		if err != nil {
+			// Drop the pool, failing any caller waiting on it, so that the next
+			// call tries again.
+			client.dropPool(addr, c)
+			return nil, err
 		}
+		if !client.addToPool(addr, c, tm) {
+			return nil, fmt.Errorf("tablet manager client for %v was closed", addr)
+		}
+		go client.fillPool(addr, cell, opt, c)
 	} else {
 		client.mu.Unlock()
 	}
 
-	result := <-c
-	c <- result
+	// The pool may be temporarily empty while its first connection is dialed, or
+	// while other callers hold its connections.
+	var result *tmc
+	select {
+	case result, ok = <-c:
+		if !ok {
+			return nil, fmt.Errorf("failed to dial tablet manager pool for %v", addr)
+		}
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	// The connection is put back right away, as it can be shared. If the pool
+	// was closed in the meantime, it's closed instead, like the ones that were
+	// in the pool.
+	if !client.addToPool(addr, c, result) {
+		return nil, fmt.Errorf("tablet manager client for %v was closed", addr)
+	}
 	return result.client, nil
 }
 
+// fillPool dials the remaining connections of the pool c to addr, until it
+// is full, Close is called or a dial fails. A partial pool is still usable.
+func (client *grpcClient) fillPool(addr string, cell string, opt grpc.DialOption, c chan *tmc) {
+	for i := 1; i < cap(c); i++ {
+		tm, err := client.createTmc(context.Background(), addr, cell, connKindPool, opt)
+		if err != nil {
+			log.Warningf("failed to dial connection %d of the tablet manager pool for %v: %v", i+1, addr, err)
+			return
+		}
+		if !client.addToPool(addr, c, tm) {
+			return
+		}
+	}
+}
+
+// addToPool adds tm to the pool c of addr, or puts it back, unless the pool was
+// closed in the meantime, in which case tm is closed and false is returned.
+// Sending on c only under the mutex is what keeps the pools safe from Close.
+func (client *grpcClient) addToPool(addr string, c chan *tmc, tm *tmc) bool {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if client.rpcClientMap[addr] != c {
+		closeTablet(tm.cc)
+		return false
+	}
+	// This never blocks: there are never more than cap(c) connections in the
+	// pool, including the ones that callers took and are about to put back.
+	c <- tm
+	return true
+}
+
+// dropPool removes the empty pool c of addr, and wakes up its waiting callers.
+func (client *grpcClient) dropPool(addr string, c chan *tmc) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if client.rpcClientMap[addr] == c {
+		delete(client.rpcClientMap, addr)
+		close(c)
+	}
+}
+
 func (client *grpcClient) dialDedicatedPool(ctx context.Context, dialPoolGroup DialPoolGroup, tablet *topodatapb.Tablet) (tabletmanagerservicepb.TabletManagerClient, invalidatorFunc, error) {
-	addr := netutil.JoinHostPort(tablet.Hostname, int32(tablet.PortMap["grpc"]))
-	opt, err := grpcclient.SecureDialOption(cert, key, ca, crl, name)
+	if err := tmclient.CheckFailFast(ctx, tablet); err != nil {
+		return nil, nil, err
+	}
+	addr, err := tabletAddr(tablet)
+	if err != nil {
+		return nil, nil, err
+	}
+	opt, err := tabletDialOption(tablet)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -226,7 +349,7 @@ func (client *grpcClient) dialDedicatedPool(ctx context.Context, dialPoolGroup D
 	}
 	m := client.rpcDialPoolMap[dialPoolGroup]
 	if _, ok := m[addr]; !ok {
-		tm, err := client.createTmc(ctx, addr, opt)
+		tm, err := client.createTmc(ctx, addr, tablet.GetAlias().GetCell(), connKindDedicated, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -236,7 +359,7 @@ func (client *grpcClient) dialDedicatedPool(ctx context.Context, dialPoolGroup D
 		client.mu.Lock()
 		defer client.mu.Unlock()
 		if tm := m[addr]; tm != nil && tm.cc != nil {
-			tm.cc.Close()
+			closeTablet(tm.cc)
 		}
 		delete(m, addr)
 	}
@@ -247,13 +370,26 @@ func (client *grpcClient) dialDedicatedPool(ctx context.Context, dialPoolGroup D
 func (client *grpcClient) Close() {
 	client.mu.Lock()
 	defer client.mu.Unlock()
+	// The connections that callers took from the pools are closed when they are
+	// put back.
 	for _, c := range client.rpcClientMap {
 		close(c)
 		for ch := range c {
-			ch.cc.Close()
+			closeTablet(ch.cc)
 		}
 	}
 	client.rpcClientMap = nil
+	// The connections are removed from their group, so that the invalidators
+	// that are called later don't close them again.
+	for _, m := range client.rpcDialPoolMap {
+		for addr, tm := range m {
+			if tm.cc != nil {
+				closeTablet(tm.cc)
+			}
+			delete(m, addr)
+		}
+	}
+	client.rpcDialPoolMap = nil
 }
 
 //
@@ -314,8 +450,79 @@ func (client *Client) ExecuteHook(ctx context.Context, tablet *topodatapb.Tablet
 	}, nil
 }
 
+type hookStreamAdapter struct {
+	stream tabletmanagerservicepb.TabletManager_StreamExecuteHookClient
+	closer io.Closer
+}
+
+func (e *hookStreamAdapter) Recv() (*tabletmanagerdatapb.StreamExecuteHookResponse, error) {
+	resp, err := e.stream.Recv()
+	if err != nil {
+		e.closer.Close()
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StreamExecuteHook is part of the tmclient.TabletManagerClient interface.
+func (client *Client) StreamExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (tmclient.HookStream, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.StreamExecuteHook(ctx, &tabletmanagerdatapb.ExecuteHookRequest{
+		Name:       hk.Name,
+		Parameters: hk.Parameters,
+		ExtraEnv:   hk.ExtraEnv,
+	})
+	if err != nil {
+		closer.Close()
+		return nil, err
+	}
+	return &hookStreamAdapter{
+		stream: stream,
+		closer: closer,
+	}, nil
+}
+
+// StreamSchemaChanges is part of the tmclient.TabletManagerClient interface.
+func (client *Client) StreamSchemaChanges(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.StreamSchemaChangesRequest) (tmclient.SchemaChangeStream, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.StreamSchemaChanges(ctx, request)
+	if err != nil {
+		closer.Close()
+		return nil, err
+	}
+	return &schemaChangeStreamAdapter{
+		stream: stream,
+		closer: closer,
+	}, nil
+}
+
+type schemaChangeStreamAdapter struct {
+	stream tabletmanagerservicepb.TabletManager_StreamSchemaChangesClient
+	closer io.Closer
+}
+
+func (e *schemaChangeStreamAdapter) Recv() (*tabletmanagerdatapb.StreamSchemaChangesResponse, error) {
+	resp, err := e.stream.Recv()
+	if err != nil {
+		e.closer.Close()
+		return nil, err
+	}
+	return resp, nil
+}
+
 // GetSchema is part of the tmclient.TabletManagerClient interface.
 func (client *Client) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetSchemaRequest) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	if err := client.quotas.wait(ctx, rpcClassGetSchema, tablet); err != nil {
+		return nil, err
+	}
 	c, closer, err := client.dialer.dial(ctx, tablet)
 	if err != nil {
 		return nil, err
@@ -328,6 +535,20 @@ func (client *Client) GetSchema(ctx context.Context, tablet *topodatapb.Tablet,
 	return response.SchemaDefinition, nil
 }
 
+// GetSchemaHash is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetSchemaHash(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetSchemaHashRequest) (string, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return "", err
+	}
+	defer closer.Close()
+	response, err := c.GetSchemaHash(ctx, request)
+	if err != nil {
+		return "", err
+	}
+	return response.SchemaHash, nil
+}
+
 // GetPermissions is part of the tmclient.TabletManagerClient interface.
 func (client *Client) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error) {
 	c, closer, err := client.dialer.dial(ctx, tablet)
@@ -358,6 +579,38 @@ func (client *Client) GetGlobalStatusVars(ctx context.Context, tablet *topodatap
 	return response.GetStatusValues(), nil
 }
 
+// StreamTabletEvents is part of the tmclient.TabletManagerClient interface.
+func (client *Client) StreamTabletEvents(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.StreamTabletEventsRequest) (tmclient.TabletEventStream, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.StreamTabletEvents(ctx, request)
+	if err != nil {
+		closer.Close()
+		return nil, err
+	}
+	return &tabletEventStreamAdapter{
+		stream: stream,
+		closer: closer,
+	}, nil
+}
+
+type tabletEventStreamAdapter struct {
+	stream tabletmanagerservicepb.TabletManager_StreamTabletEventsClient
+	closer io.Closer
+}
+
+func (e *tabletEventStreamAdapter) Recv() (*tabletmanagerdatapb.StreamTabletEventsResponse, error) {
+	resp, err := e.stream.Recv()
+	if err != nil {
+		e.closer.Close()
+		return nil, err
+	}
+	return resp, nil
+}
+
 //
 // Various read-write methods
 //
@@ -420,6 +673,83 @@ func (client *Client) RunHealthCheck(ctx context.Context, tablet *topodatapb.Tab
 	return err
 }
 
+// PrepareShutdown is part of the tmclient.TabletManagerClient interface.
+func (client *Client) PrepareShutdown(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PrepareShutdownRequest) (*tabletmanagerdatapb.PrepareShutdownResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return c.PrepareShutdown(ctx, req)
+}
+
+// GracefulRestart is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GracefulRestart(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GracefulRestartRequest) (*tabletmanagerdatapb.GracefulRestartResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return c.GracefulRestart(ctx, req)
+}
+
+// PauseTableGC is part of the tmclient.TabletManagerClient interface.
+func (client *Client) PauseTableGC(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PauseTableGCRequest) (*tabletmanagerdatapb.PauseTableGCResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return c.PauseTableGC(ctx, req)
+}
+
+// ResumeTableGC is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ResumeTableGC(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ResumeTableGCRequest) (*tabletmanagerdatapb.ResumeTableGCResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return c.ResumeTableGC(ctx, req)
+}
+
+// RestoreGCTable is part of the tmclient.TabletManagerClient interface.
+func (client *Client) RestoreGCTable(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreGCTableRequest) (*tabletmanagerdatapb.RestoreGCTableResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return c.RestoreGCTable(ctx, req)
+}
+
+// GetMysqlVariables is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetMysqlVariables(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GetMysqlVariablesRequest) (*tabletmanagerdatapb.GetMysqlVariablesResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return c.GetMysqlVariables(ctx, req)
+}
+
+// SetMysqlVariable is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetMysqlVariable(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.SetMysqlVariableRequest) (*tabletmanagerdatapb.SetMysqlVariableResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return c.SetMysqlVariable(ctx, req)
+}
+
 // ReloadSchema is part of the tmclient.TabletManagerClient interface.
 func (client *Client) ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error {
 	c, closer, err := client.dialer.dial(ctx, tablet)
@@ -512,6 +842,31 @@ func (client *Client) UnlockTables(ctx context.Context, tablet *topodatapb.Table
 	return err
 }
 
+// AcquireConsistentSnapshot is part of the tmclient.TabletManagerClient interface.
+func (client *Client) AcquireConsistentSnapshot(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.AcquireConsistentSnapshotRequest) (*tabletmanagerdatapb.AcquireConsistentSnapshotResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return c.AcquireConsistentSnapshot(ctx, req)
+}
+
+// ReleaseConsistentSnapshot is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ReleaseConsistentSnapshot(ctx context.Context, tablet *topodatapb.Tablet, snapshotID int64) error {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	_, err = c.ReleaseConsistentSnapshot(ctx, &tabletmanagerdatapb.ReleaseConsistentSnapshotRequest{
+		SnapshotId: snapshotID,
+	})
+	return err
+}
+
 // ExecuteQuery is part of the tmclient.TabletManagerClient interface.
 func (client *Client) ExecuteQuery(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ExecuteQueryRequest) (*querypb.QueryResult, error) {
 	c, closer, err := client.dialer.dial(ctx, tablet)
@@ -539,6 +894,9 @@ func (client *Client) ExecuteQuery(ctx context.Context, tablet *topodatapb.Table
 
 // ExecuteFetchAsDba is part of the tmclient.TabletManagerClient interface.
 func (client *Client) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, req *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (*querypb.QueryResult, error) {
+	if err := client.quotas.wait(ctx, rpcClassExecuteFetchAsDba, tablet); err != nil {
+		return nil, err
+	}
 	var c tabletmanagerservicepb.TabletManagerClient
 	var err error
 	if usePool {
@@ -573,8 +931,104 @@ func (client *Client) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.
 	return response.Result, nil
 }
 
+// ExecuteFetchAsDbaAsync is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ExecuteFetchAsDbaAsync(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (string, error) {
+	if err := client.quotas.wait(ctx, rpcClassExecuteFetchAsDba, tablet); err != nil {
+		return "", err
+	}
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return "", err
+	}
+	defer closer.Close()
+
+	response, err := c.ExecuteFetchAsDba(ctx, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{
+		Query:                   req.Query,
+		DbName:                  topoproto.TabletDbName(tablet),
+		MaxRows:                 req.MaxRows,
+		DisableBinlogs:          req.DisableBinlogs,
+		ReloadSchema:            req.ReloadSchema,
+		DisableForeignKeyChecks: req.DisableForeignKeyChecks,
+		Async:                   true,
+	})
+	if err != nil {
+		return "", err
+	}
+	if response.JobId == "" {
+		// A tablet that doesn't know about async requests ignores the flag and
+		// executes the query before replying.
+		return "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "tablet %v does not support async ExecuteFetchAsDba", topoproto.TabletAliasString(tablet.Alias))
+	}
+	return response.JobId, nil
+}
+
+// PollFetchJob is part of the tmclient.TabletManagerClient interface.
+func (client *Client) PollFetchJob(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PollFetchJobRequest) (*tabletmanagerdatapb.PollFetchJobResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return c.PollFetchJob(ctx, req)
+}
+
+// CancelFetchJob is part of the tmclient.TabletManagerClient interface.
+func (client *Client) CancelFetchJob(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.CancelFetchJobRequest) (*tabletmanagerdatapb.CancelFetchJobResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return c.CancelFetchJob(ctx, req)
+}
+
+// StreamExecuteFetchAsDba is part of the tmclient.TabletManagerClient interface.
+func (client *Client) StreamExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.StreamExecuteFetchAsDbaRequest) (tmclient.QueryResultStream, error) {
+	if err := client.quotas.wait(ctx, rpcClassExecuteFetchAsDba, tablet); err != nil {
+		return nil, err
+	}
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.StreamExecuteFetchAsDba(ctx, &tabletmanagerdatapb.StreamExecuteFetchAsDbaRequest{
+		Query:                   req.Query,
+		DbName:                  topoproto.TabletDbName(tablet),
+		DisableBinlogs:          req.DisableBinlogs,
+		DisableForeignKeyChecks: req.DisableForeignKeyChecks,
+	})
+	if err != nil {
+		closer.Close()
+		return nil, err
+	}
+	return &executeFetchAsDbaStreamAdapter{
+		stream: stream,
+		closer: closer,
+	}, nil
+}
+
+type executeFetchAsDbaStreamAdapter struct {
+	stream tabletmanagerservicepb.TabletManager_StreamExecuteFetchAsDbaClient
+	closer io.Closer
+}
+
+func (e *executeFetchAsDbaStreamAdapter) Recv() (*querypb.QueryResult, error) {
+	resp, err := e.stream.Recv()
+	if err != nil {
+		e.closer.Close()
+		return nil, err
+	}
+	return resp.Result, nil
+}
+
 // ExecuteFetchAsDba is part of the tmclient.TabletManagerClient interface.
 func (client *Client) ExecuteMultiFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, req *tabletmanagerdatapb.ExecuteMultiFetchAsDbaRequest) ([]*querypb.QueryResult, error) {
+	if err := client.quotas.wait(ctx, rpcClassExecuteFetchAsDba, tablet); err != nil {
+		return nil, err
+	}
 	var c tabletmanagerservicepb.TabletManagerClient
 	var err error
 	if usePool {
@@ -710,6 +1164,39 @@ func (client *Client) FullStatus(ctx context.Context, tablet *topodatapb.Tablet)
 	return response.Status, nil
 }
 
+// SemiSyncStatus is part of the tmclient.TabletManagerClient interface.
+// Like FullStatus, it is called by VTOrc for all the tablets of the shards,
+// so it uses a cached client from the dialer pool too.
+func (client *Client) SemiSyncStatus(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.SemiSyncStatusResponse, error) {
+	var c tabletmanagerservicepb.TabletManagerClient
+	var invalidator invalidatorFunc
+	var err error
+	if poolDialer, ok := client.dialer.(poolDialer); ok {
+		c, invalidator, err = poolDialer.dialDedicatedPool(ctx, dialPoolGroupVTOrc, tablet)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c == nil {
+		var closer io.Closer
+		c, closer, err = client.dialer.dial(ctx, tablet)
+		if err != nil {
+			return nil, err
+		}
+		defer closer.Close()
+	}
+
+	response, err := c.SemiSyncStatus(ctx, &tabletmanagerdatapb.SemiSyncStatusRequest{})
+	if err != nil {
+		if invalidator != nil {
+			invalidator()
+		}
+		return nil, err
+	}
+	return response, nil
+}
+
 // PrimaryStatus is part of the tmclient.TabletManagerClient interface.
 func (client *Client) PrimaryStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.PrimaryStatus, error) {
 	c, closer, err := client.dialer.dial(ctx, tablet)
@@ -749,6 +1236,38 @@ func (client *Client) WaitForPosition(ctx context.Context, tablet *topodatapb.Ta
 	return err
 }
 
+// StreamWaitForPosition is part of the tmclient.TabletManagerClient interface.
+func (client *Client) StreamWaitForPosition(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.StreamWaitForPositionRequest) (tmclient.WaitForPositionStream, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.StreamWaitForPosition(ctx, req)
+	if err != nil {
+		closer.Close()
+		return nil, err
+	}
+	return &waitForPositionStreamAdapter{
+		stream: stream,
+		closer: closer,
+	}, nil
+}
+
+type waitForPositionStreamAdapter struct {
+	stream tabletmanagerservicepb.TabletManager_StreamWaitForPositionClient
+	closer io.Closer
+}
+
+func (e *waitForPositionStreamAdapter) Recv() (*tabletmanagerdatapb.StreamWaitForPositionResponse, error) {
+	resp, err := e.stream.Recv()
+	if err != nil {
+		e.closer.Close()
+		return nil, err
+	}
+	return resp, nil
+}
+
 // StopReplication is part of the tmclient.TabletManagerClient interface.
 func (client *Client) StopReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
 	c, closer, err := client.dialer.dial(ctx, tablet)
@@ -819,6 +1338,26 @@ func (client *Client) GetReplicas(ctx context.Context, tablet *topodatapb.Tablet
 	return response.Addrs, nil
 }
 
+// FlushBinaryLogs is part of the tmclient.TabletManagerClient interface.
+func (client *Client) FlushBinaryLogs(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.FlushBinaryLogsResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return c.FlushBinaryLogs(ctx, &tabletmanagerdatapb.FlushBinaryLogsRequest{})
+}
+
+// PurgeBinaryLogs is part of the tmclient.TabletManagerClient interface.
+func (client *Client) PurgeBinaryLogs(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.PurgeBinaryLogsRequest) (*tabletmanagerdatapb.PurgeBinaryLogsResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return c.PurgeBinaryLogs(ctx, request)
+}
+
 //
 // VReplication related methods
 //
@@ -956,6 +1495,39 @@ func (client *Client) VDiff(ctx context.Context, tablet *topodatapb.Tablet, req
 	return response, nil
 }
 
+// CreateVDiff is part of the tmclient.TabletManagerClient interface.
+func (client *Client) CreateVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.CreateVDiffRequest) (*tabletmanagerdatapb.CreateVDiffResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return c.CreateVDiff(ctx, req)
+}
+
+// ShowVDiff is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ShowVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ShowVDiffRequest) (*tabletmanagerdatapb.ShowVDiffResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return c.ShowVDiff(ctx, req)
+}
+
+// StopVDiff is part of the tmclient.TabletManagerClient interface.
+func (client *Client) StopVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.StopVDiffRequest) (*tabletmanagerdatapb.StopVDiffResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return c.StopVDiff(ctx, req)
+}
+
 //
 // Reparenting related functions
 //
@@ -1021,13 +1593,15 @@ func (client *Client) InitReplica(ctx context.Context, tablet *topodatapb.Tablet
 }
 
 // DemotePrimary is part of the tmclient.TabletManagerClient interface.
-func (client *Client) DemotePrimary(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.PrimaryStatus, error) {
+func (client *Client) DemotePrimary(ctx context.Context, tablet *topodatapb.Tablet, handoff *tabletmanagerdatapb.PrimaryHandoff) (*replicationdatapb.PrimaryStatus, error) {
 	c, closer, err := client.dialer.dial(ctx, tablet)
 	if err != nil {
 		return nil, err
 	}
 	defer closer.Close()
-	response, err := c.DemotePrimary(ctx, &tabletmanagerdatapb.DemotePrimaryRequest{})
+	response, err := c.DemotePrimary(ctx, &tabletmanagerdatapb.DemotePrimaryRequest{
+		Handoff: handoff,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1121,7 +1695,7 @@ func (client *Client) StopReplicationAndGetStatus(ctx context.Context, tablet *t
 }
 
 // PromoteReplica is part of the tmclient.TabletManagerClient interface.
-func (client *Client) PromoteReplica(ctx context.Context, tablet *topodatapb.Tablet, semiSync bool) (string, error) {
+func (client *Client) PromoteReplica(ctx context.Context, tablet *topodatapb.Tablet, semiSync bool, handoff *tabletmanagerdatapb.PrimaryHandoff) (string, error) {
 	c, closer, err := client.dialer.dial(ctx, tablet)
 	if err != nil {
 		return "", err
@@ -1130,6 +1704,7 @@ func (client *Client) PromoteReplica(ctx context.Context, tablet *topodatapb.Tab
 
 	response, err := c.PromoteReplica(ctx, &tabletmanagerdatapb.PromoteReplicaRequest{
 		SemiSync: semiSync,
+		Handoff:  handoff,
 	})
 	if err != nil {
 		return "", err
@@ -1259,3 +1834,18 @@ func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.
 func (client *Client) Close() {
 	client.dialer.Close()
 }
+
+// CloseWithTimeout gracefully closes the client: it stops issuing new RPCs, waits
+// up to the given timeout (or until ctx is done) for the RPCs that are in flight
+// to finish, and then closes all the underlying connections. If the in-flight
+// RPCs did not finish in time, the connections are force-closed and an error is
+// returned.
+func (client *Client) CloseWithTimeout(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := client.tracker.drain(ctx)
+	client.dialer.Close()
+	client.tracker.reset()
+	return err
+}
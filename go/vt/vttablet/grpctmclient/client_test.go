@@ -18,6 +18,7 @@ package grpctmclient
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -26,6 +27,8 @@ import (
 	"google.golang.org/grpc/connectivity"
 
 	"vitess.io/vitess/go/netutil"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
@@ -190,3 +193,172 @@ func TestDialPool(t *testing.T) {
 		assert.Contains(t, []connectivity.State{connectivity.Connecting, connectivity.TransientFailure}, cachedTmc.cc.GetState())
 	})
 }
+
+func TestDialPoolContext(t *testing.T) {
+	client := NewClient()
+	defer client.Close()
+	tablet := &topodatapb.Tablet{
+		Hostname: "localhost",
+		PortMap: map[string]int32{
+			"grpc": 15992,
+		},
+	}
+	addr := netutil.JoinHostPort(tablet.Hostname, int32(tablet.PortMap["grpc"]))
+	poolDialer, ok := client.dialer.(poolDialer)
+	require.True(t, ok)
+	rpcClient, ok := client.dialer.(*grpcClient)
+	require.True(t, ok)
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := poolDialer.dialPool(ctx, tablet)
+		assert.ErrorIs(t, err, context.Canceled)
+
+		// the failed pool is dropped, so that the next call dials again
+		rpcClient.mu.Lock()
+		defer rpcClient.mu.Unlock()
+		assert.NotContains(t, rpcClient.rpcClientMap, addr)
+	})
+
+	t.Run("fill", func(t *testing.T) {
+		cli, err := poolDialer.dialPool(context.Background(), tablet)
+		require.NoError(t, err)
+		assert.NotNil(t, cli)
+
+		rpcClient.mu.Lock()
+		ch := rpcClient.rpcClientMap[addr]
+		rpcClient.mu.Unlock()
+		require.NotNil(t, ch)
+
+		// the rest of the pool is dialed in the background
+		assert.Eventually(t, func() bool {
+			return len(ch) == concurrency
+		}, 10*time.Second, 10*time.Millisecond)
+	})
+}
+
+// TestCloseRace closes clients while their pools are dialed and used, and
+// checks that no connection is left open. It's meant to be run with -race.
+func TestCloseRace(t *testing.T) {
+	ctx := context.Background()
+	tablet := &topodatapb.Tablet{
+		Hostname: "localhost",
+		PortMap: map[string]int32{
+			"grpc": 15993,
+		},
+	}
+	addr := netutil.JoinHostPort(tablet.Hostname, int32(tablet.PortMap["grpc"]))
+
+	for i := 0; i < 10; i++ {
+		client := NewClient()
+		poolDialer, ok := client.dialer.(poolDialer)
+		require.True(t, ok)
+
+		// The dials fail once the pools are closed, which is fine.
+		var wg sync.WaitGroup
+		for j := 0; j < 8; j++ {
+			wg.Add(3)
+			go func() {
+				defer wg.Done()
+				_, _ = poolDialer.dialPool(ctx, tablet)
+			}()
+			go func() {
+				defer wg.Done()
+				_, invalidator, err := poolDialer.dialDedicatedPool(ctx, dialPoolGroupThrottler, tablet)
+				if err == nil {
+					invalidator()
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				client.Close()
+			}()
+		}
+		wg.Wait()
+		client.Close()
+
+		rpcClient, ok := client.dialer.(*grpcClient)
+		require.True(t, ok)
+		rpcClient.mu.Lock()
+		assert.Empty(t, rpcClient.rpcClientMap)
+		assert.Empty(t, rpcClient.rpcDialPoolMap)
+		rpcClient.mu.Unlock()
+	}
+
+	// The connections that were being dialed in the background are closed as
+	// soon as they come up.
+	assert.Eventually(t, func() bool {
+		return findConnState(addr) == nil
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+// TestCloseDedicatedPool checks that Close closes the dedicated pools, and
+// that their invalidators can still be called afterwards.
+func TestCloseDedicatedPool(t *testing.T) {
+	ctx := context.Background()
+	client := NewClient()
+	tablet := &topodatapb.Tablet{
+		Hostname: "localhost",
+		PortMap: map[string]int32{
+			"grpc": 15993,
+		},
+	}
+	addr := netutil.JoinHostPort(tablet.Hostname, int32(tablet.PortMap["grpc"]))
+	poolDialer, ok := client.dialer.(poolDialer)
+	require.True(t, ok)
+	rpcClient, ok := client.dialer.(*grpcClient)
+	require.True(t, ok)
+
+	_, invalidator, err := poolDialer.dialDedicatedPool(ctx, dialPoolGroupVTOrc, tablet)
+	require.NoError(t, err)
+	rpcClient.mu.Lock()
+	tm := rpcClient.rpcDialPoolMap[dialPoolGroupVTOrc][addr]
+	rpcClient.mu.Unlock()
+	require.NotNil(t, tm)
+
+	client.Close()
+	assert.Equal(t, connectivity.Shutdown, tm.cc.GetState())
+	invalidator()
+
+	// The client can still be used after Close.
+	_, _, err = poolDialer.dialDedicatedPool(ctx, dialPoolGroupVTOrc, tablet)
+	assert.NoError(t, err)
+	client.Close()
+}
+
+func TestFailFast(t *testing.T) {
+	client := NewClient()
+	defer client.Close()
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Hostname: "localhost",
+		PortMap: map[string]int32{
+			"grpc": 15991,
+		},
+	}
+	tmclient.ReportTabletDown(tablet.Alias, "healthcheck timed out")
+	defer tmclient.ReportTabletUp(tablet.Alias)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	failFastCtx := tmclient.WithFailFast(ctx)
+
+	// every kind of connection fails fast: single-use, pooled and dedicated
+	start := time.Now()
+	err := client.Ping(failFastCtx, tablet)
+	assert.True(t, tmclient.IsErrTabletDown(err), "%v", err)
+	_, err = client.ExecuteFetchAsDba(failFastCtx, tablet, true, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{Query: []byte("select 1")})
+	assert.True(t, tmclient.IsErrTabletDown(err), "%v", err)
+	_, err = client.FullStatus(failFastCtx, tablet)
+	assert.True(t, tmclient.IsErrTabletDown(err), "%v", err)
+	assert.Less(t, time.Since(start), time.Second)
+
+	// the other RPCs are sent, and wait for the tablet
+	shortCtx, shortCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer shortCancel()
+	err = client.Ping(shortCtx, tablet)
+	assert.Error(t, err)
+	assert.False(t, tmclient.IsErrTabletDown(err), "%v", err)
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Instead of each method handling the tablets that run an older version, the
+// compatibility shims of the RPCs are registered in compatShims, and applied by
+// the compat interceptor to the RPCs sent to the tablets whose API level is
+// lower than the one of the shim. The API level of a tablet is negotiated with
+// NegotiateAPILevel the first time it is needed, and cached for apiLevelTTL.
+// The cached levels of the tablets that are no longer talked to are evicted
+// once they expire.
+
+const negotiateAPILevelMethod = "/tabletmanagerservice.TabletManager/NegotiateAPILevel"
+
+// apiLevelTTL is how long the API level of a tablet is cached, after which it
+// is negotiated again, in case the tablet was upgraded or downgraded.
+const apiLevelTTL = time.Minute
+
+// compatShim adapts the messages of an RPC to the tablets whose API level is
+// lower than minAPILevel. Since the API level of a tablet is assumed to be
+// tmclient.LegacyAPILevel when it can't be negotiated, the downgraded requests
+// must still be understood by the tablets that implement minAPILevel.
+type compatShim struct {
+	minAPILevel int32
+	// downgradeRequest, if set, fills the legacy fields of the request. It
+	// returns an error if the request can't be understood by the older
+	// tablets, in which case it isn't sent.
+	downgradeRequest func(request proto.Message) error
+	// upgradeResponse, if set, fills the new fields of the response from the
+	// legacy ones.
+	upgradeResponse func(response proto.Message)
+}
+
+// compatShims are the compatibility shims of the RPCs, by method name. Since
+// the primary handoff is only a hint for the query service, it is dropped from
+// the requests to the tablets that don't expose it, which are demoted and
+// promoted as before.
+var compatShims = map[string][]compatShim{
+	"DemotePrimary": {{
+		minAPILevel:     tmclient.NegotiateAPILevel,
+		upgradeResponse: upgradeDemotePrimaryResponse,
+	}, {
+		minAPILevel: tmclient.PrimaryHandoffAPILevel,
+		downgradeRequest: func(request proto.Message) error {
+			request.(*tabletmanagerdatapb.DemotePrimaryRequest).Handoff = nil
+			return nil
+		},
+	}},
+	"PromoteReplica": {{
+		minAPILevel: tmclient.PrimaryHandoffAPILevel,
+		downgradeRequest: func(request proto.Message) error {
+			request.(*tabletmanagerdatapb.PromoteReplicaRequest).Handoff = nil
+			return nil
+		},
+	}},
+	"ExecuteFetchAsDba": {{
+		minAPILevel:      tmclient.AsyncFetchAPILevel,
+		downgradeRequest: downgradeExecuteFetchAsDbaRequest,
+	}},
+	"GracefulRestart": {{
+		minAPILevel:      tmclient.GracefulRestartAllowPrimaryAPILevel,
+		downgradeRequest: downgradeGracefulRestartRequest,
+	}},
+}
+
+// downgradeExecuteFetchAsDbaRequest refuses the async requests, since the older
+// tablets would run them synchronously instead of returning a job.
+func downgradeExecuteFetchAsDbaRequest(request proto.Message) error {
+	if !request.(*tabletmanagerdatapb.ExecuteFetchAsDbaRequest).Async {
+		return nil
+	}
+	return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the tablet doesn't run ExecuteFetchAsDba asynchronously")
+}
+
+// downgradeGracefulRestartRequest refuses the requests that don't allow
+// restarting a PRIMARY, since the older tablets would restart it anyway.
+func downgradeGracefulRestartRequest(request proto.Message) error {
+	if request.(*tabletmanagerdatapb.GracefulRestartRequest).AllowPrimary {
+		return nil
+	}
+	return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the tablet doesn't refuse to restart a PRIMARY, allow_primary must be set to restart it")
+}
+
+// legacyDemotePrimaryPositionField is the number of the deprecated_position
+// field of DemotePrimaryResponse, which is reserved since the position is
+// returned in primary_status.
+const legacyDemotePrimaryPositionField protowire.Number = 1
+
+// upgradeDemotePrimaryResponse fills the primary status of the responses of
+// the tablets that only return the deprecated position, which is then an
+// unknown field of the response.
+func upgradeDemotePrimaryResponse(response proto.Message) {
+	demoteResponse := response.(*tabletmanagerdatapb.DemotePrimaryResponse)
+	if demoteResponse.PrimaryStatus != nil {
+		return
+	}
+	unknown := demoteResponse.ProtoReflect().GetUnknown()
+	for len(unknown) > 0 {
+		num, typ, n := protowire.ConsumeTag(unknown)
+		if n < 0 {
+			return
+		}
+		unknown = unknown[n:]
+		if num == legacyDemotePrimaryPositionField && typ == protowire.BytesType {
+			position, n := protowire.ConsumeString(unknown)
+			if n < 0 {
+				return
+			}
+			demoteResponse.PrimaryStatus = &replicationdatapb.PrimaryStatus{Position: position}
+			return
+		}
+		n = protowire.ConsumeFieldValue(num, typ, unknown)
+		if n < 0 {
+			return
+		}
+		unknown = unknown[n:]
+	}
+}
+
+type apiLevel struct {
+	level      int32
+	negotiated time.Time
+}
+
+// apiLevels caches the API levels of the tablets, by address. The expired
+// levels are evicted at most once per apiLevelTTL, when a level is cached, so
+// that it only holds the tablets that were talked to recently.
+var apiLevels = struct {
+	mu        sync.Mutex
+	byAddr    map[string]apiLevel
+	lastEvict time.Time
+}{byAddr: make(map[string]apiLevel)}
+
+// compatDialOption installs the interceptor that applies the compatibility
+// shims to the RPCs on a connection. The API level of the tablet is only
+// negotiated for the RPCs that have shims.
+var compatDialOption = grpc.WithChainUnaryInterceptor(compatUnaryInterceptor)
+
+func compatUnaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	shims := compatShims[path.Base(method)]
+	if len(shims) == 0 {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	level := tabletAPILevel(ctx, cc, invoker)
+	for _, shim := range shims {
+		if level < shim.minAPILevel && shim.downgradeRequest != nil {
+			if err := shim.downgradeRequest(req.(proto.Message)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+		return err
+	}
+	for _, shim := range shims {
+		if level < shim.minAPILevel && shim.upgradeResponse != nil {
+			shim.upgradeResponse(reply.(proto.Message))
+		}
+	}
+	return nil
+}
+
+// tabletAPILevel returns the API level of the tablet at the other end of the
+// given connection, negotiating it if it isn't cached. The tablets that don't
+// implement NegotiateAPILevel have the legacy API level, which is also assumed,
+// without being cached, when the negotiation fails.
+func tabletAPILevel(ctx context.Context, cc *grpc.ClientConn, invoker grpc.UnaryInvoker) int32 {
+	addr := cc.Target()
+
+	apiLevels.mu.Lock()
+	cached, ok := apiLevels.byAddr[addr]
+	apiLevels.mu.Unlock()
+	if ok && time.Since(cached.negotiated) < apiLevelTTL {
+		return cached.level
+	}
+
+	request := &tabletmanagerdatapb.NegotiateAPILevelRequest{ApiLevel: tmclient.CurrentAPILevel}
+	response := &tabletmanagerdatapb.NegotiateAPILevelResponse{}
+	level := tmclient.LegacyAPILevel
+	switch err := invoker(ctx, negotiateAPILevelMethod, request, response, cc); {
+	case err == nil:
+		level = response.ApiLevel
+	case status.Code(err) == codes.Unimplemented:
+		// the tablet runs a version from before the negotiation
+	default:
+		log.Warningf("Cannot negotiate the tablet manager API level of tablet %s, assuming the legacy one: %v", addr, err)
+		return level
+	}
+
+	now := time.Now()
+	apiLevels.mu.Lock()
+	defer apiLevels.mu.Unlock()
+	if now.Sub(apiLevels.lastEvict) >= apiLevelTTL {
+		for a, cached := range apiLevels.byAddr {
+			if now.Sub(cached.negotiated) >= apiLevelTTL {
+				delete(apiLevels.byAddr, a)
+			}
+		}
+		apiLevels.lastEvict = now
+	}
+	apiLevels.byAddr[addr] = apiLevel{level: level, negotiated: now}
+	return level
+}
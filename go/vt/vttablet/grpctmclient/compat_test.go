@@ -0,0 +1,235 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	tabletmanagerservicepb "vitess.io/vitess/go/vt/proto/tabletmanagerservice"
+)
+
+type compatTestServer struct {
+	tabletmanagerservicepb.UnimplementedTabletManagerServer
+
+	// apiLevel is the API level returned by NegotiateAPILevel, which is not
+	// implemented if it is negative.
+	apiLevel     int32
+	negotiations atomic.Int32
+	// handoff is the primary handoff of the last DemotePrimary request.
+	handoff *tabletmanagerdatapb.PrimaryHandoff
+}
+
+func (s *compatTestServer) NegotiateAPILevel(ctx context.Context, request *tabletmanagerdatapb.NegotiateAPILevelRequest) (*tabletmanagerdatapb.NegotiateAPILevelResponse, error) {
+	if s.apiLevel < 0 {
+		return nil, status.Error(codes.Unimplemented, "method NegotiateAPILevel not implemented")
+	}
+	s.negotiations.Add(1)
+	return &tabletmanagerdatapb.NegotiateAPILevelResponse{ApiLevel: s.apiLevel}, nil
+}
+
+func (s *compatTestServer) Ping(ctx context.Context, request *tabletmanagerdatapb.PingRequest) (*tabletmanagerdatapb.PingResponse, error) {
+	return &tabletmanagerdatapb.PingResponse{Payload: request.Payload}, nil
+}
+
+// DemotePrimary returns the position in the deprecated field of the response
+// if the server has the legacy API level.
+func (s *compatTestServer) DemotePrimary(ctx context.Context, request *tabletmanagerdatapb.DemotePrimaryRequest) (*tabletmanagerdatapb.DemotePrimaryResponse, error) {
+	s.handoff = request.Handoff
+	response := &tabletmanagerdatapb.DemotePrimaryResponse{}
+	if s.apiLevel >= 1 {
+		response.PrimaryStatus = &replicationdatapb.PrimaryStatus{Position: "MySQL56/00000000-0000-0000-0000-000000000000:1-7"}
+		return response, nil
+	}
+	var legacy []byte
+	legacy = protowire.AppendTag(legacy, 3, protowire.VarintType)
+	legacy = protowire.AppendVarint(legacy, 42)
+	legacy = protowire.AppendTag(legacy, legacyDemotePrimaryPositionField, protowire.BytesType)
+	legacy = protowire.AppendString(legacy, "MySQL56/00000000-0000-0000-0000-000000000000:1-7")
+	response.ProtoReflect().SetUnknown(legacy)
+	return response, nil
+}
+
+func (s *compatTestServer) ExecuteFetchAsDba(ctx context.Context, request *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (*tabletmanagerdatapb.ExecuteFetchAsDbaResponse, error) {
+	return &tabletmanagerdatapb.ExecuteFetchAsDbaResponse{}, nil
+}
+
+func (s *compatTestServer) GracefulRestart(ctx context.Context, request *tabletmanagerdatapb.GracefulRestartRequest) (*tabletmanagerdatapb.GracefulRestartResponse, error) {
+	return &tabletmanagerdatapb.GracefulRestartResponse{}, nil
+}
+
+func startCompatTestServer(t *testing.T, apiLevel int32) (*compatTestServer, tabletmanagerservicepb.TabletManagerClient) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &compatTestServer{apiLevel: apiLevel}
+	server := grpc.NewServer()
+	tabletmanagerservicepb.RegisterTabletManagerServer(server, s)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	cc, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()), compatDialOption)
+	require.NoError(t, err)
+	t.Cleanup(func() { cc.Close() })
+	return s, tabletmanagerservicepb.NewTabletManagerClient(cc)
+}
+
+func TestCompatShims(t *testing.T) {
+	ctx := context.Background()
+	ping := func(t *testing.T, c tabletmanagerservicepb.TabletManagerClient) string {
+		response, err := c.Ping(ctx, &tabletmanagerdatapb.PingRequest{Payload: "payload"})
+		require.NoError(t, err)
+		return response.Payload
+	}
+
+	// without shims, the API level is not negotiated
+	s, c := startCompatTestServer(t, 1)
+	assert.Equal(t, "payload", ping(t, c))
+	assert.Zero(t, s.negotiations.Load())
+
+	defer func() { delete(compatShims, "Ping") }()
+	compatShims["Ping"] = []compatShim{{
+		minAPILevel: 2,
+		downgradeRequest: func(request proto.Message) error {
+			if request.(*tabletmanagerdatapb.PingRequest).Payload == "refused" {
+				return status.Error(codes.FailedPrecondition, "refused")
+			}
+			request.(*tabletmanagerdatapb.PingRequest).Payload = "legacy " + request.(*tabletmanagerdatapb.PingRequest).Payload
+			return nil
+		},
+		upgradeResponse: func(response proto.Message) {
+			response.(*tabletmanagerdatapb.PingResponse).Payload += " upgraded"
+		},
+	}}
+
+	testCases := []struct {
+		name     string
+		apiLevel int32
+		payload  string
+	}{
+		{name: "older tablet", apiLevel: 1, payload: "legacy payload upgraded"},
+		{name: "tablet without negotiation", apiLevel: -1, payload: "legacy payload upgraded"},
+		{name: "up-to-date tablet", apiLevel: 2, payload: "payload"},
+		{name: "newer tablet", apiLevel: 3, payload: "payload"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, c := startCompatTestServer(t, tc.apiLevel)
+			assert.Equal(t, tc.payload, ping(t, c))
+			assert.Equal(t, tc.payload, ping(t, c))
+			if tc.apiLevel >= 0 {
+				// the API level is cached
+				assert.EqualValues(t, 1, s.negotiations.Load())
+			}
+
+			_, err := c.Ping(ctx, &tabletmanagerdatapb.PingRequest{Payload: "refused"})
+			if tc.apiLevel < 2 {
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDemotePrimaryCompat(t *testing.T) {
+	ctx := context.Background()
+	handoff := &tabletmanagerdatapb.PrimaryHandoff{Id: "prs-1", Operation: "PlannedReparentShard"}
+	for _, apiLevel := range []int32{-1, 0, 1, tmclient.PrimaryHandoffAPILevel} {
+		s, c := startCompatTestServer(t, apiLevel)
+		response, err := c.DemotePrimary(ctx, &tabletmanagerdatapb.DemotePrimaryRequest{Handoff: handoff})
+		require.NoError(t, err)
+		require.NotNil(t, response.PrimaryStatus, "API level %d", apiLevel)
+		assert.Equal(t, "MySQL56/00000000-0000-0000-0000-000000000000:1-7", response.PrimaryStatus.Position, "API level %d", apiLevel)
+		if apiLevel < tmclient.PrimaryHandoffAPILevel {
+			assert.Nil(t, s.handoff, "API level %d", apiLevel)
+		} else {
+			assert.Equal(t, handoff.Id, s.handoff.GetId(), "API level %d", apiLevel)
+		}
+	}
+}
+
+func TestAPILevelEviction(t *testing.T) {
+	ctx := context.Background()
+	defer func() { delete(compatShims, "Ping") }()
+	compatShims["Ping"] = []compatShim{{minAPILevel: 2}}
+
+	apiLevels.mu.Lock()
+	apiLevels.byAddr["expired:15999"] = apiLevel{level: 1, negotiated: time.Now().Add(-2 * apiLevelTTL)}
+	apiLevels.byAddr["fresh:15999"] = apiLevel{level: 1, negotiated: time.Now()}
+	apiLevels.lastEvict = time.Time{}
+	apiLevels.mu.Unlock()
+
+	_, c := startCompatTestServer(t, 1)
+	_, err := c.Ping(ctx, &tabletmanagerdatapb.PingRequest{})
+	require.NoError(t, err)
+
+	apiLevels.mu.Lock()
+	defer apiLevels.mu.Unlock()
+	assert.NotContains(t, apiLevels.byAddr, "expired:15999")
+	assert.Contains(t, apiLevels.byAddr, "fresh:15999")
+	delete(apiLevels.byAddr, "fresh:15999")
+}
+
+func TestGracefulRestartCompat(t *testing.T) {
+	ctx := context.Background()
+	for _, apiLevel := range []int32{-1, 1, tmclient.GracefulRestartAllowPrimaryAPILevel} {
+		_, c := startCompatTestServer(t, apiLevel)
+		_, err := c.GracefulRestart(ctx, &tabletmanagerdatapb.GracefulRestartRequest{AllowPrimary: true})
+		assert.NoError(t, err, "API level %d", apiLevel)
+
+		// the older tablets would restart a PRIMARY anyway
+		_, err = c.GracefulRestart(ctx, &tabletmanagerdatapb.GracefulRestartRequest{})
+		if apiLevel < tmclient.GracefulRestartAllowPrimaryAPILevel {
+			assert.ErrorContains(t, err, "allow_primary must be set", "API level %d", apiLevel)
+		} else {
+			assert.NoError(t, err, "API level %d", apiLevel)
+		}
+	}
+}
+
+func TestExecuteFetchAsDbaAsyncCompat(t *testing.T) {
+	ctx := context.Background()
+	for _, apiLevel := range []int32{-1, tmclient.PrimaryHandoffAPILevel, tmclient.AsyncFetchAPILevel} {
+		_, c := startCompatTestServer(t, apiLevel)
+		_, err := c.ExecuteFetchAsDba(ctx, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{Query: []byte("select 1")})
+		assert.NoError(t, err, "API level %d", apiLevel)
+
+		// the older tablets would run the query synchronously
+		_, err = c.ExecuteFetchAsDba(ctx, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{Query: []byte("select 1"), Async: true})
+		if apiLevel < tmclient.AsyncFetchAPILevel {
+			assert.ErrorContains(t, err, "doesn't run ExecuteFetchAsDba asynchronously", "API level %d", apiLevel)
+		} else {
+			assert.NoError(t, err, "API level %d", apiLevel)
+		}
+	}
+}
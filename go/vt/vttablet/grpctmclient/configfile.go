@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+)
+
+// watchConfigFile calls load whenever the config file at path changes, until the
+// returned watcher is closed or the process terminates. The load function must
+// keep the previous config when the file is invalid. The description of the
+// config is used in the logs.
+func watchConfigFile(path string, description string, load func() error) (*fsnotify.Watcher, error) {
+	// The directory is watched rather than the file, so that the file can be
+	// replaced, e.g. by a Kubernetes secret update.
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch %v: %w", path, err)
+	}
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("failed to watch %v: %w", path, err)
+	}
+	servenv.OnTerm(func() { watcher.Close() })
+
+	go func() {
+		for {
+			select {
+			case evt, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Base(evt.Name) != filepath.Base(path) || evt.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+					continue
+				}
+				if err := load(); err != nil {
+					log.Warningf("Failed to reload the %s, keeping the previous one: %v", description, err)
+				} else {
+					log.Infof("Reloaded the %s from %v", description, path)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Errorf("Error watching %v: %v", path, err)
+			}
+		}
+	}()
+	return watcher, nil
+}
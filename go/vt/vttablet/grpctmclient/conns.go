@@ -0,0 +1,232 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	channelz "google.golang.org/grpc/channelz/service"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/servenv"
+)
+
+// The connections of the clients to the tablets are tracked, so that their
+// state can be inspected on /debug/tmclient_conns and with the
+// tabletmanagerclient_connections stats, e.g. to find the connections that
+// are stuck, without restarting the process. The gRPC channelz service can also
+// be registered with --tablet_manager_grpc_enable_channelz, for the details of
+// the connections.
+
+// enableChannelz registers the gRPC channelz service on the gRPC server of the
+// process.
+var enableChannelz bool
+
+// The kinds of the tracked connections, i.e. the dialers that own them.
+const (
+	connKindOneshot   = "oneshot"
+	connKindCached    = "cached"
+	connKindPool      = "pool"
+	connKindDedicated = "dedicated"
+)
+
+// trackedConn records the activity of a connection to a tablet.
+type trackedConn struct {
+	cc      *grpc.ClientConn
+	kind    string
+	created time.Time
+
+	mu            sync.Mutex
+	lastActivity  time.Time
+	lastError     error
+	lastErrorTime time.Time
+}
+
+// ConnState is the state of a connection to a tablet, as returned by
+// ConnStates.
+type ConnState struct {
+	Addr string
+	// Kind is the dialer of the connection: oneshot, cached, pool or dedicated.
+	Kind string
+	// State is the gRPC connectivity state of the connection, e.g. READY or
+	// TRANSIENT_FAILURE.
+	State         string
+	Created       time.Time
+	LastActivity  time.Time
+	LastError     string `json:",omitempty"`
+	LastErrorTime time.Time
+}
+
+// trackedConns are the open connections of the tablet manager clients. The
+// connections are tracked by dialTablet, and forgotten by closeTablet.
+var trackedConns = struct {
+	mu    sync.Mutex
+	conns map[*grpc.ClientConn]*trackedConn
+}{conns: make(map[*grpc.ClientConn]*trackedConn)}
+
+func init() {
+	stats.NewGaugesFuncWithMultiLabels("tabletmanagerclient_connections", "number of connections of the tablet manager clients, by gRPC connectivity state", []string{"state"}, func() map[string]int64 {
+		counts := make(map[string]int64)
+		for _, conn := range ConnStates() {
+			counts[conn.State]++
+		}
+		return counts
+	})
+
+	servenv.HTTPHandleFunc("/debug/tmclient_conns", handleConnStates)
+
+	servenv.OnRun(func() {
+		if enableChannelz && servenv.GRPCServer != nil {
+			channelz.RegisterChannelzServiceToServer(servenv.GRPCServer)
+		}
+	})
+}
+
+// dialTablet dials the tablet manager at addr, of a tablet of the given cell,
+// with the interceptors of this package, and tracks the connection as one of
+// the given kind. The connection must be closed with closeTablet.
+func dialTablet(ctx context.Context, addr string, cell string, kind string, tracker *inflightTracker, opt grpc.DialOption) (*grpc.ClientConn, error) {
+	policyOpt, err := rpcPolicies.dialOption(cell)
+	if err != nil {
+		return nil, err
+	}
+	conn := &trackedConn{kind: kind, created: time.Now()}
+	opts := append(tracker.dialOptions(), opt, rpcStatsDialOption, compatDialOption)
+	// The policies apply to each RPC once the compatibility shims are applied,
+	// so that hedged attempts don't share a request that is being downgraded.
+	if policyOpt != nil {
+		opts = append(opts, policyOpt)
+	}
+	opts = append(opts,
+		grpc.WithChainUnaryInterceptor(conn.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(conn.streamInterceptor),
+	)
+	if familyOpt := addressFamilyDialOption(); familyOpt != nil {
+		opts = append(opts, familyOpt)
+	}
+	cc, err := grpcclient.DialContext(ctx, addr, grpcclient.FailFast(false), opts...)
+	if err != nil {
+		return nil, err
+	}
+	conn.cc = cc
+
+	trackedConns.mu.Lock()
+	defer trackedConns.mu.Unlock()
+	trackedConns.conns[cc] = conn
+	return cc, nil
+}
+
+// closeTablet closes a connection dialed by dialTablet, and forgets it.
+func closeTablet(cc *grpc.ClientConn) error {
+	trackedConns.mu.Lock()
+	delete(trackedConns.conns, cc)
+	trackedConns.mu.Unlock()
+	return cc.Close()
+}
+
+// tabletCloser is the io.Closer of a connection dialed by dialTablet.
+type tabletCloser struct {
+	cc *grpc.ClientConn
+}
+
+// Close is part of the io.Closer interface.
+func (closer tabletCloser) Close() error {
+	return closeTablet(closer.cc)
+}
+
+func (conn *trackedConn) record(err error) {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	conn.lastActivity = time.Now()
+	if err != nil {
+		conn.lastError = err
+		conn.lastErrorTime = conn.lastActivity
+	}
+}
+
+func (conn *trackedConn) unaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	conn.record(nil)
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	conn.record(err)
+	return err
+}
+
+// streamInterceptor only records the start of the streaming RPCs, since they
+// can last as long as their context.
+func (conn *trackedConn) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	conn.record(err)
+	return stream, err
+}
+
+// ConnStates returns the state of the open connections of the tablet manager
+// clients of the process, sorted by address.
+func ConnStates() []ConnState {
+	trackedConns.mu.Lock()
+	conns := make([]*trackedConn, 0, len(trackedConns.conns))
+	for _, conn := range trackedConns.conns {
+		conns = append(conns, conn)
+	}
+	trackedConns.mu.Unlock()
+
+	states := make([]ConnState, 0, len(conns))
+	for _, conn := range conns {
+		conn.mu.Lock()
+		state := ConnState{
+			Addr:          conn.cc.Target(),
+			Kind:          conn.kind,
+			State:         conn.cc.GetState().String(),
+			Created:       conn.created,
+			LastActivity:  conn.lastActivity,
+			LastErrorTime: conn.lastErrorTime,
+		}
+		if conn.lastError != nil {
+			state.LastError = conn.lastError.Error()
+		}
+		conn.mu.Unlock()
+		states = append(states, state)
+	}
+	sort.SliceStable(states, func(i, j int) bool {
+		if states[i].Addr != states[j].Addr {
+			return states[i].Addr < states[j].Addr
+		}
+		return states[i].Created.Before(states[j].Created)
+	})
+	return states
+}
+
+func handleConnStates(w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	b, err := json.MarshalIndent(ConnStates(), "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Write(b)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	tabletmanagerservicepb "vitess.io/vitess/go/vt/proto/tabletmanagerservice"
+)
+
+func findConnState(addr string) *ConnState {
+	for _, state := range ConnStates() {
+		if state.Addr == addr {
+			return &state
+		}
+	}
+	return nil
+}
+
+func TestConnStates(t *testing.T) {
+	ctx := context.Background()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	tabletmanagerservicepb.RegisterTabletManagerServer(server, &compatTestServer{apiLevel: 1})
+	go server.Serve(listener)
+	defer server.Stop()
+	addr := listener.Addr().String()
+
+	cc, err := dialTablet(ctx, addr, "zone1", connKindCached, &inflightTracker{}, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	c := tabletmanagerservicepb.NewTabletManagerClient(cc)
+
+	state := findConnState(addr)
+	require.NotNil(t, state)
+	assert.Equal(t, connKindCached, state.Kind)
+	assert.True(t, state.LastActivity.IsZero())
+
+	_, err = c.Ping(ctx, &tabletmanagerdatapb.PingRequest{Payload: "payload"})
+	require.NoError(t, err)
+	state = findConnState(addr)
+	require.NotNil(t, state)
+	assert.Equal(t, "READY", state.State)
+	assert.False(t, state.LastActivity.Before(state.Created))
+	assert.Empty(t, state.LastError)
+
+	_, err = c.GetPermissions(ctx, &tabletmanagerdatapb.GetPermissionsRequest{})
+	require.Error(t, err)
+	state = findConnState(addr)
+	require.NotNil(t, state)
+	assert.Contains(t, state.LastError, "not implemented")
+	assert.Equal(t, state.LastActivity, state.LastErrorTime)
+
+	// the states are served as JSON
+	w := httptest.NewRecorder()
+	handleConnStates(w, httptest.NewRequest(http.MethodGet, "/debug/tmclient_conns", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var states []ConnState
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &states))
+	var served *ConnState
+	for i := range states {
+		if states[i].Addr == addr {
+			served = &states[i]
+		}
+	}
+	require.NotNil(t, served)
+	assert.Equal(t, state.Kind, served.Kind)
+	assert.Equal(t, state.LastError, served.LastError)
+	assert.True(t, state.LastActivity.Equal(served.LastActivity))
+
+	// the closed connections are forgotten
+	require.NoError(t, closeTablet(cc))
+	trackedConns.mu.Lock()
+	assert.NotContains(t, trackedConns.conns, cc)
+	trackedConns.mu.Unlock()
+	assert.Nil(t, findConnState(addr))
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// errClientDraining is returned for RPCs that are issued after a graceful
+// close of the client has started.
+var errClientDraining = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "tablet manager client is closing")
+
+// inflightTracker keeps count of the RPCs that are currently in flight on the
+// connections of a Client, so that they can be drained before the connections
+// are closed. It is installed on every connection via gRPC interceptors.
+type inflightTracker struct {
+	mu       sync.Mutex
+	draining bool
+	inflight int
+	// idle is non-nil while draining, and is closed once there are no more
+	// RPCs in flight.
+	idle chan struct{}
+}
+
+// begin registers a new RPC, or fails if the client is draining.
+func (t *inflightTracker) begin() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.draining {
+		return errClientDraining
+	}
+	t.inflight++
+	return nil
+}
+
+// done unregisters an RPC that was registered with begin.
+func (t *inflightTracker) done() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inflight--
+	if t.idle != nil && t.inflight == 0 {
+		close(t.idle)
+		t.idle = nil
+	}
+}
+
+// drain stops accepting new RPCs and waits for the ones in flight to finish,
+// or for the context to expire, whichever happens first.
+func (t *inflightTracker) drain(ctx context.Context) error {
+	t.mu.Lock()
+	if t.inflight == 0 {
+		t.draining = true
+		t.mu.Unlock()
+		return nil
+	}
+	if !t.draining {
+		t.draining = true
+		t.idle = make(chan struct{})
+	}
+	idle := t.idle
+	t.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "timed out waiting for in-flight tablet manager RPCs to drain: %v", ctx.Err())
+	}
+}
+
+// reset allows the tracker to accept new RPCs again, once the connections
+// of the client have been closed.
+func (t *inflightTracker) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.draining = false
+	if t.idle != nil {
+		close(t.idle)
+		t.idle = nil
+	}
+}
+
+func (t *inflightTracker) unaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := t.begin(); err != nil {
+		return err
+	}
+	defer t.done()
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func (t *inflightTracker) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := t.begin(); err != nil {
+		return nil, err
+	}
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		t.done()
+		return nil, err
+	}
+	tracked := &trackedStream{ClientStream: stream, tracker: t}
+	// A stream is in flight until it returns an error (including io.EOF) or
+	// until its context is done, whichever happens first.
+	tracked.stop = context.AfterFunc(ctx, tracked.finish)
+	return tracked, nil
+}
+
+// dialOptions returns the gRPC dial options that install the tracker on
+// a connection.
+func (t *inflightTracker) dialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(t.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(t.streamInterceptor),
+	}
+}
+
+type trackedStream struct {
+	grpc.ClientStream
+	tracker *inflightTracker
+	once    sync.Once
+	stop    func() bool
+}
+
+func (s *trackedStream) finish() {
+	s.once.Do(s.tracker.done)
+}
+
+func (s *trackedStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.stop()
+		s.finish()
+	}
+	return err
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager"
+	"vitess.io/vitess/go/vt/vttablet/tmrpctest"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// blockingSleepTM is a fake RPCTM whose Sleep blocks until released.
+type blockingSleepTM struct {
+	tabletmanager.RPCTM
+	started chan struct{}
+	release chan struct{}
+}
+
+func (tm *blockingSleepTM) Sleep(ctx context.Context, duration time.Duration) {
+	tm.started <- struct{}{}
+	<-tm.release
+}
+
+func TestInflightTracker(t *testing.T) {
+	tracker := &inflightTracker{}
+
+	require.NoError(t, tracker.begin())
+	require.NoError(t, tracker.begin())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := tracker.drain(ctx)
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+	assert.Equal(t, errClientDraining, tracker.begin())
+
+	drained := make(chan error)
+	go func() {
+		drained <- tracker.drain(context.Background())
+	}()
+	tracker.done()
+	tracker.done()
+	assert.NoError(t, <-drained)
+
+	tracker.reset()
+	assert.NoError(t, tracker.begin())
+	tracker.done()
+}
+
+func TestCloseWithTimeout(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		newClient func() *Client
+	}{
+		{"grpc", NewClient},
+		{"grpc-cached", func() *Client { return NewCachedConnClient(1) }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tm := &blockingSleepTM{
+				RPCTM:   tmrpctest.NewFakeRPCTM(t),
+				started: make(chan struct{}),
+				release: make(chan struct{}),
+			}
+			addr, shutdown := grpcTestServer(t, tm)
+			defer shutdown()
+
+			tablet := &topodatapb.Tablet{
+				Hostname: addr.IP.String(),
+				PortMap:  map[string]int32{"grpc": int32(addr.Port)},
+			}
+
+			client := tc.newClient()
+
+			sleepErr := make(chan error)
+			go func() {
+				sleepErr <- client.Sleep(context.Background(), tablet, time.Second)
+			}()
+			<-tm.started
+
+			closed := make(chan error)
+			go func() {
+				closed <- client.CloseWithTimeout(context.Background(), 10*time.Second)
+			}()
+
+			// Wait until the client is draining before issuing a new RPC.
+			require.Eventually(t, func() bool {
+				client.tracker.mu.Lock()
+				defer client.tracker.mu.Unlock()
+				return client.tracker.draining
+			}, 5*time.Second, time.Millisecond)
+			err := client.Ping(context.Background(), tablet)
+			assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+
+			close(tm.release)
+			assert.NoError(t, <-sleepErr)
+			assert.NoError(t, <-closed)
+		})
+	}
+}
+
+func TestCloseWithTimeoutExpired(t *testing.T) {
+	tm := &blockingSleepTM{
+		RPCTM:   tmrpctest.NewFakeRPCTM(t),
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	addr, shutdown := grpcTestServer(t, tm)
+	defer shutdown()
+	defer close(tm.release)
+
+	tablet := &topodatapb.Tablet{
+		Hostname: addr.IP.String(),
+		PortMap:  map[string]int32{"grpc": int32(addr.Port)},
+	}
+
+	client := NewCachedConnClient(1)
+	sleepErr := make(chan error)
+	go func() {
+		sleepErr <- client.Sleep(context.Background(), tablet, time.Second)
+	}()
+	<-tm.started
+
+	err := client.CloseWithTimeout(context.Background(), 10*time.Millisecond)
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+	// The in-flight RPC fails once its connection is force-closed.
+	assert.Error(t, <-sleepErr)
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc/connectivity"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	tabletmanagerservicepb "vitess.io/vitess/go/vt/proto/tabletmanagerservice"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// poolIdleTimeout is how long the connections of the "grpc-pooled" clients can
+// stay unused before they are closed.
+var poolIdleTimeout = 5 * time.Minute
+
+func registerPooledClientFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&poolIdleTimeout, "tablet_manager_grpc_pool_idle_timeout", poolIdleTimeout, "with --tablet_manager_protocol=grpc-pooled, close the connections to a tablet that were not used for this long (0 to keep them open until the client is closed)")
+}
+
+func init() {
+	tmclient.RegisterTabletManagerClientFactory("grpc-pooled", func() tmclient.TabletManagerClient {
+		return NewPooledConnClient(concurrency, poolIdleTimeout)
+	})
+
+	for _, cmd := range _binaries {
+		servenv.OnParseFor(cmd, registerPooledClientFlags)
+	}
+}
+
+var pooledDialerStats = struct {
+	ConnReuse     *stats.Counter
+	ConnNew       *stats.Counter
+	ConnUnhealthy *stats.Counter
+	ConnIdle      *stats.Counter
+}{
+	ConnReuse:     stats.NewCounter("tabletmanagerclient_pooledconn_reuse", "number of times a call to dial() of a grpc-pooled client was able to reuse an existing connection"),
+	ConnNew:       stats.NewCounter("tabletmanagerclient_pooledconn_new", "number of times a call to dial() of a grpc-pooled client resulted in dialing a new grpc clientconn"),
+	ConnUnhealthy: stats.NewCounter("tabletmanagerclient_pooledconn_unhealthy", "number of connections of the grpc-pooled clients that were closed because they were failing"),
+	ConnIdle:      stats.NewCounter("tabletmanagerclient_pooledconn_idle", "number of connections of the grpc-pooled clients that were closed because they were idle"),
+}
+
+// pooledConn is a connection of a connPool, shared by the RPCs to its tablet.
+type pooledConn struct {
+	*tmc
+	// refs is the number of RPCs using the connection.
+	refs     int
+	lastUsed time.Time
+}
+
+// healthy returns false if the connection failed to connect to the tablet, or
+// lost it, and is waiting to reconnect.
+func (conn *pooledConn) healthy() bool {
+	switch conn.cc.GetState() {
+	case connectivity.TransientFailure, connectivity.Shutdown:
+		return false
+	}
+	return true
+}
+
+// connPool is the pool of the connections to a tablet.
+type connPool struct {
+	conns []*pooledConn
+}
+
+// pooledConnDialer sends all the RPCs to a tablet over a pool of up to size
+// connections, which are dialed as the RPCs need them and shared by the RPCs:
+// each RPC uses the least busy healthy connection of the pool, and a new
+// connection is only dialed when all the others are in use or failing.
+//
+// The connections that are failing are closed, as soon as no RPC uses them, so
+// that they are dialed again with a fresh backoff and the current credentials,
+// instead of waiting for gRPC to reconnect. The connections that were not used
+// for idleTimeout are closed too.
+type pooledConnDialer struct {
+	m           sync.Mutex
+	pools       map[string]*connPool
+	size        int
+	idleTimeout time.Duration
+	tracker     *inflightTracker
+
+	// sweeping is true while the goroutine that closes the idle and failing
+	// connections is running. It stops when there are no more pools.
+	sweeping bool
+}
+
+// NewPooledConnClient returns a grpc Client that sends all the RPCs to a tablet
+// over a pool of up to size connections, instead of dialing a connection per
+// RPC. The connections that were not used for idleTimeout are closed, unless
+// idleTimeout is 0.
+func NewPooledConnClient(size int, idleTimeout time.Duration) *Client {
+	tracker := &inflightTracker{}
+	dialer := &pooledConnDialer{
+		pools:       make(map[string]*connPool),
+		size:        max(size, 1),
+		idleTimeout: idleTimeout,
+		tracker:     tracker,
+	}
+	return &Client{dialer: dialer, tracker: tracker, quotas: newRPCQuotaLimiter(rpcQuotas.specs, rpcQuotaMaxWaiting)}
+}
+
+var _ dialer = (*pooledConnDialer)(nil)
+
+func (dialer *pooledConnDialer) dial(ctx context.Context, tablet *topodatapb.Tablet) (tabletmanagerservicepb.TabletManagerClient, io.Closer, error) {
+	if err := tmclient.CheckFailFast(ctx, tablet); err != nil {
+		return nil, nil, err
+	}
+	addr, err := tabletAddr(tablet)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Dialing doesn't block, so it doesn't notice that ctx is done.
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	dialer.m.Lock()
+	defer dialer.m.Unlock()
+
+	pool, ok := dialer.pools[addr]
+	if !ok {
+		pool = &connPool{}
+		dialer.pools[addr] = pool
+		dialer.startSweepingLocked()
+	}
+
+	conn := pool.pickLocked()
+	if conn == nil || (conn.refs > 0 || !conn.healthy()) && len(pool.conns) < dialer.size {
+		// The credentials are only needed to dial, and they are cheap to get,
+		// since the TLS configs are cached.
+		opt, err := tabletDialOption(tablet)
+		if err != nil {
+			dialer.dropEmptyPoolLocked(addr, pool)
+			return nil, nil, err
+		}
+		cc, err := dialTablet(ctx, addr, tablet.GetAlias().GetCell(), connKindPool, dialer.tracker, opt)
+		if err != nil {
+			dialer.dropEmptyPoolLocked(addr, pool)
+			return nil, nil, err
+		}
+		pooledDialerStats.ConnNew.Add(1)
+		conn = &pooledConn{tmc: &tmc{cc: cc, client: tabletmanagerservicepb.NewTabletManagerClient(cc)}}
+		pool.conns = append(pool.conns, conn)
+	} else {
+		pooledDialerStats.ConnReuse.Add(1)
+	}
+
+	conn.refs++
+	conn.lastUsed = time.Now()
+	return conn.client, closeFunc(func() error {
+		dialer.m.Lock()
+		defer dialer.m.Unlock()
+		conn.refs--
+		conn.lastUsed = time.Now()
+		return nil
+	}), nil
+}
+
+// pickLocked returns the least busy connection of the pool, preferring the
+// healthy ones, and nil if the pool is empty.
+func (pool *connPool) pickLocked() *pooledConn {
+	var best *pooledConn
+	var bestHealthy bool
+	for _, conn := range pool.conns {
+		healthy := conn.healthy()
+		switch {
+		case best == nil, healthy && !bestHealthy:
+		case healthy == bestHealthy && conn.refs < best.refs:
+		default:
+			continue
+		}
+		best, bestHealthy = conn, healthy
+	}
+	return best
+}
+
+// dropEmptyPoolLocked removes the pool of addr if it has no connections, e.g.
+// because its first dial failed.
+func (dialer *pooledConnDialer) dropEmptyPoolLocked(addr string, pool *connPool) {
+	if len(pool.conns) == 0 && dialer.pools[addr] == pool {
+		delete(dialer.pools, addr)
+	}
+}
+
+// startSweepingLocked starts the goroutine that closes the idle and failing
+// connections, if it isn't running.
+func (dialer *pooledConnDialer) startSweepingLocked() {
+	if dialer.sweeping {
+		return
+	}
+	dialer.sweeping = true
+	go dialer.sweep()
+}
+
+// sweepInterval returns how often the idle and failing connections are closed.
+func (dialer *pooledConnDialer) sweepInterval() time.Duration {
+	const maxInterval = 30 * time.Second
+	if dialer.idleTimeout > 0 && dialer.idleTimeout/2 < maxInterval {
+		return max(dialer.idleTimeout/2, time.Millisecond)
+	}
+	return maxInterval
+}
+
+func (dialer *pooledConnDialer) sweep() {
+	ticker := time.NewTicker(dialer.sweepInterval())
+	defer ticker.Stop()
+	for range ticker.C {
+		if !dialer.sweepOnce(time.Now()) {
+			return
+		}
+	}
+}
+
+// sweepOnce closes the connections that no RPC uses, and that are either
+// failing or were not used for idleTimeout, and removes the pools that are
+// left empty. It returns false, and stops sweeping, if there are no more pools.
+func (dialer *pooledConnDialer) sweepOnce(now time.Time) bool {
+	dialer.m.Lock()
+	defer dialer.m.Unlock()
+
+	for addr, pool := range dialer.pools {
+		conns := pool.conns[:0]
+		for _, conn := range pool.conns {
+			if conn.refs == 0 {
+				if !conn.healthy() {
+					pooledDialerStats.ConnUnhealthy.Add(1)
+					closeTablet(conn.cc)
+					continue
+				}
+				if dialer.idleTimeout > 0 && now.Sub(conn.lastUsed) >= dialer.idleTimeout {
+					pooledDialerStats.ConnIdle.Add(1)
+					closeTablet(conn.cc)
+					continue
+				}
+			}
+			conns = append(conns, conn)
+		}
+		clear(pool.conns[len(conns):])
+		pool.conns = conns
+		if len(pool.conns) == 0 {
+			delete(dialer.pools, addr)
+		}
+	}
+
+	if len(dialer.pools) == 0 {
+		dialer.sweeping = false
+		return false
+	}
+	return true
+}
+
+// Close closes all the pooled connections, ***regardless of whether they are
+// in use***, failing the RPCs that use them, like cachedConnDialer.Close. The
+// dialer can still be used after Close, and dials new connections.
+func (dialer *pooledConnDialer) Close() {
+	dialer.m.Lock()
+	defer dialer.m.Unlock()
+
+	for addr, pool := range dialer.pools {
+		for _, conn := range pool.conns {
+			closeTablet(conn.cc)
+		}
+		delete(dialer.pools, addr)
+	}
+}
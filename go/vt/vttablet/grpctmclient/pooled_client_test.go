@@ -0,0 +1,152 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/connectivity"
+
+	"vitess.io/vitess/go/netutil"
+	"vitess.io/vitess/go/vt/vttablet/tmrpctest"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestPooledConnClient(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	addr, shutdown := grpcTestServer(t, tmrpctest.NewFakeRPCTM(t))
+	defer shutdown()
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "test", Uid: 1},
+		Hostname: addr.IP.String(),
+		PortMap:  map[string]int32{"grpc": int32(addr.Port)},
+	}
+	tabletAddr := netutil.JoinHostPort(tablet.Hostname, tablet.PortMap["grpc"])
+
+	client := NewPooledConnClient(2, time.Minute)
+	defer client.Close()
+	dialer := client.dialer.(*pooledConnDialer)
+
+	poolConns := func() []*pooledConn {
+		dialer.m.Lock()
+		defer dialer.m.Unlock()
+		if pool := dialer.pools[tabletAddr]; pool != nil {
+			return append([]*pooledConn(nil), pool.conns...)
+		}
+		return nil
+	}
+
+	t.Run("sequential RPCs share a connection", func(t *testing.T) {
+		for range 5 {
+			require.NoError(t, client.Ping(ctx, tablet))
+		}
+		assert.Len(t, poolConns(), 1)
+	})
+
+	t.Run("concurrent RPCs use up to size connections", func(t *testing.T) {
+		_, closer1, err := dialer.dial(ctx, tablet)
+		require.NoError(t, err)
+		_, closer2, err := dialer.dial(ctx, tablet)
+		require.NoError(t, err)
+		_, closer3, err := dialer.dial(ctx, tablet)
+		require.NoError(t, err)
+
+		conns := poolConns()
+		require.Len(t, conns, 2)
+		dialer.m.Lock()
+		refs := []int{conns[0].refs, conns[1].refs}
+		dialer.m.Unlock()
+		assert.ElementsMatch(t, []int{1, 2}, refs)
+
+		closer1.Close()
+		closer2.Close()
+		closer3.Close()
+		require.NoError(t, client.Ping(ctx, tablet))
+	})
+
+	t.Run("idle connections are closed", func(t *testing.T) {
+		conns := poolConns()
+		require.Len(t, conns, 2)
+
+		_, closer, err := dialer.dial(ctx, tablet)
+		require.NoError(t, err)
+		// The connection in use is kept, even if it is idle.
+		assert.True(t, dialer.sweepOnce(time.Now().Add(time.Hour)))
+		require.Len(t, poolConns(), 1)
+		closer.Close()
+
+		assert.False(t, dialer.sweepOnce(time.Now().Add(time.Hour)))
+		assert.Empty(t, poolConns())
+		for _, conn := range conns {
+			assert.Equal(t, connectivity.Shutdown, conn.cc.GetState())
+		}
+
+		// The pool is dialed again.
+		require.NoError(t, client.Ping(ctx, tablet))
+		assert.Len(t, poolConns(), 1)
+	})
+
+	t.Run("failing connections are closed", func(t *testing.T) {
+		shutdown()
+		conns := poolConns()
+		require.Len(t, conns, 1)
+		require.Eventually(t, func() bool {
+			conns[0].cc.Connect()
+			return conns[0].cc.GetState() == connectivity.TransientFailure
+		}, 5*time.Second, 10*time.Millisecond)
+
+		assert.False(t, dialer.sweepOnce(time.Now()))
+		assert.Empty(t, poolConns())
+		assert.Equal(t, connectivity.Shutdown, conns[0].cc.GetState())
+	})
+}
+
+func TestPooledConnClientClose(t *testing.T) {
+	ctx := context.Background()
+	tablet := &topodatapb.Tablet{
+		Hostname: "localhost",
+		PortMap:  map[string]int32{"grpc": 15991},
+	}
+
+	client := NewPooledConnClient(4, 0)
+	dialer := client.dialer.(*pooledConnDialer)
+	_, closer, err := dialer.dial(ctx, tablet)
+	require.NoError(t, err)
+
+	dialer.m.Lock()
+	conns := dialer.pools["localhost:15991"].conns
+	dialer.m.Unlock()
+	require.Len(t, conns, 1)
+
+	client.Close()
+	assert.Equal(t, connectivity.Shutdown, conns[0].cc.GetState())
+	// The closers of the connections can still be called after Close.
+	assert.NoError(t, closer.Close())
+
+	// The client can be used again.
+	_, closer, err = dialer.dial(ctx, tablet)
+	require.NoError(t, err)
+	assert.NoError(t, closer.Close())
+	client.Close()
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The classes of expensive RPCs that can be limited with quotas. A class
+// covers all the RPCs that do the same work on the tablet.
+const (
+	// rpcClassExecuteFetchAsDba covers ExecuteFetchAsDba and
+	// ExecuteMultiFetchAsDba.
+	rpcClassExecuteFetchAsDba = "ExecuteFetchAsDba"
+	// rpcClassGetSchema covers GetSchema.
+	rpcClassGetSchema = "GetSchema"
+)
+
+var rpcClasses = []string{rpcClassExecuteFetchAsDba, rpcClassGetSchema}
+
+// rpcQuotas is the value of --tablet_manager_grpc_rpc_quotas.
+var rpcQuotas rpcQuotasFlag
+
+// rpcQuotaMaxWaiting is the number of RPCs that can wait for the same quota
+// at any given time. Zero means no limit.
+var rpcQuotaMaxWaiting = 100
+
+var rpcQuotaStats = struct {
+	Waits    *stats.Timings
+	Waiting  *stats.GaugesWithSingleLabel
+	Rejected *stats.CountersWithSingleLabel
+}{
+	Waits:    stats.NewTimings("tabletmanagerclient_rpc_quota_waits", "time spent by tablet manager RPCs waiting for their quota, by quota", "quota"),
+	Waiting:  stats.NewGaugesWithSingleLabel("tabletmanagerclient_rpc_quota_waiting", "number of tablet manager RPCs currently waiting for their quota, by quota", "quota"),
+	Rejected: stats.NewCountersWithSingleLabel("tabletmanagerclient_rpc_quota_rejected", "number of tablet manager RPCs that were not sent because they couldn't get their quota in time or too many RPCs were already waiting for it, by quota", "quota"),
+}
+
+// rpcQuotaSpec is the rate limit of a class of RPCs to the tablets of a
+// keyspace, or of a single shard if shard is set.
+type rpcQuotaSpec struct {
+	class    string
+	keyspace string
+	shard    string
+	// rate is the number of RPCs per second.
+	rate float64
+}
+
+func (spec rpcQuotaSpec) name() string {
+	if spec.shard == "" {
+		return spec.class + ":" + spec.keyspace
+	}
+	return spec.class + ":" + spec.keyspace + "/" + spec.shard
+}
+
+func (spec rpcQuotaSpec) String() string {
+	return spec.name() + "=" + strconv.FormatFloat(spec.rate, 'f', -1, 64)
+}
+
+// parseRPCQuotaSpec parses a quota in the <class>:<keyspace>[/<shard>]=<rate>
+// format, e.g. ExecuteFetchAsDba:commerce/-80=5.
+func parseRPCQuotaSpec(s string) (rpcQuotaSpec, error) {
+	target, rateStr, ok := strings.Cut(s, "=")
+	if !ok {
+		return rpcQuotaSpec{}, fmt.Errorf("invalid tablet manager RPC quota %q: expected <class>:<keyspace>[/<shard>]=<rate>", s)
+	}
+	class, keyspaceShard, ok := strings.Cut(target, ":")
+	if !ok || keyspaceShard == "" {
+		return rpcQuotaSpec{}, fmt.Errorf("invalid tablet manager RPC quota %q: expected <class>:<keyspace>[/<shard>]=<rate>", s)
+	}
+	if !slices.Contains(rpcClasses, class) {
+		return rpcQuotaSpec{}, fmt.Errorf("invalid tablet manager RPC quota %q: unknown RPC class %q, expected one of %v", s, class, rpcClasses)
+	}
+	spec := rpcQuotaSpec{class: class}
+	spec.keyspace, spec.shard, _ = strings.Cut(keyspaceShard, "/")
+	if spec.keyspace == "" {
+		return rpcQuotaSpec{}, fmt.Errorf("invalid tablet manager RPC quota %q: missing keyspace", s)
+	}
+	rate, err := strconv.ParseFloat(rateStr, 64)
+	if err != nil || rate <= 0 || math.IsInf(rate, 0) {
+		return rpcQuotaSpec{}, fmt.Errorf("invalid tablet manager RPC quota %q: the rate must be a positive number of RPCs per second", s)
+	}
+	spec.rate = rate
+	return spec, nil
+}
+
+// rpcQuotasFlag implements pflag.Value for a comma-separated list of quotas,
+// which can also be given by repeating the flag.
+type rpcQuotasFlag struct {
+	specs []rpcQuotaSpec
+}
+
+func (f *rpcQuotasFlag) String() string {
+	specs := make([]string, 0, len(f.specs))
+	for _, spec := range f.specs {
+		specs = append(specs, spec.String())
+	}
+	return strings.Join(specs, ",")
+}
+
+func (f *rpcQuotasFlag) Set(value string) error {
+	for _, s := range strings.Split(value, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		spec, err := parseRPCQuotaSpec(s)
+		if err != nil {
+			return err
+		}
+		f.specs = append(f.specs, spec)
+	}
+	return nil
+}
+
+func (f *rpcQuotasFlag) Type() string {
+	return "strings"
+}
+
+type rpcQuotaKey struct {
+	class, keyspace, shard string
+}
+
+// rpcQuota limits the rate of a class of RPCs. The RPCs over the rate wait in
+// line for their turn, until their context expires.
+type rpcQuota struct {
+	name    string
+	limiter *rate.Limiter
+	waiting atomic.Int64
+}
+
+// rpcQuotaLimiter enforces the quotas of the RPCs issued through a Client.
+// The quota of a shard takes precedence over that of its keyspace, which is
+// shared by all the other shards of the keyspace.
+type rpcQuotaLimiter struct {
+	quotas     map[rpcQuotaKey]*rpcQuota
+	maxWaiting int64
+}
+
+// newRPCQuotaLimiter returns a limiter for the given quotas, or nil if there
+// are none. Each quota allows bursts of as many RPCs as its rate per second.
+func newRPCQuotaLimiter(specs []rpcQuotaSpec, maxWaiting int) *rpcQuotaLimiter {
+	if len(specs) == 0 {
+		return nil
+	}
+	limiter := &rpcQuotaLimiter{
+		quotas:     make(map[rpcQuotaKey]*rpcQuota, len(specs)),
+		maxWaiting: int64(maxWaiting),
+	}
+	for _, spec := range specs {
+		// the last quota of a class and target wins
+		limiter.quotas[rpcQuotaKey{spec.class, spec.keyspace, spec.shard}] = &rpcQuota{
+			name:    spec.name(),
+			limiter: rate.NewLimiter(rate.Limit(spec.rate), max(1, int(spec.rate))),
+		}
+	}
+	return limiter
+}
+
+// wait waits for the quota of an RPC of the given class to the tablet, if
+// there's one. It fails if the quota can't be obtained before ctx is done, or
+// if too many RPCs are already waiting for it.
+func (l *rpcQuotaLimiter) wait(ctx context.Context, class string, tablet *topodatapb.Tablet) error {
+	if l == nil {
+		return nil
+	}
+	quota, ok := l.quotas[rpcQuotaKey{class, tablet.Keyspace, tablet.Shard}]
+	if !ok {
+		if quota, ok = l.quotas[rpcQuotaKey{class, tablet.Keyspace, ""}]; !ok {
+			return nil
+		}
+	}
+	if quota.limiter.Allow() {
+		return nil
+	}
+
+	waiting := quota.waiting.Add(1)
+	defer quota.waiting.Add(-1)
+	if l.maxWaiting > 0 && waiting > l.maxWaiting {
+		rpcQuotaStats.Rejected.Add(quota.name, 1)
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "tablet manager RPC quota %s exceeded: %d RPCs are already waiting for it", quota.name, l.maxWaiting)
+	}
+
+	rpcQuotaStats.Waiting.Add(quota.name, 1)
+	defer rpcQuotaStats.Waiting.Add(quota.name, -1)
+	start := time.Now()
+	err := quota.limiter.Wait(ctx)
+	rpcQuotaStats.Waits.Add(quota.name, time.Since(start))
+	if err != nil {
+		rpcQuotaStats.Rejected.Add(quota.name, 1)
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "tablet manager RPC quota %s exceeded for tablet %v/%v: %v", quota.name, tablet.Keyspace, tablet.Shard, err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestRPCQuotasFlag(t *testing.T) {
+	var f rpcQuotasFlag
+	require.NoError(t, f.Set("ExecuteFetchAsDba:commerce=10, GetSchema:commerce/-80=0.5"))
+	require.NoError(t, f.Set("GetSchema:customer=1"))
+	assert.Equal(t, []rpcQuotaSpec{
+		{class: rpcClassExecuteFetchAsDba, keyspace: "commerce", rate: 10},
+		{class: rpcClassGetSchema, keyspace: "commerce", shard: "-80", rate: 0.5},
+		{class: rpcClassGetSchema, keyspace: "customer", rate: 1},
+	}, f.specs)
+	assert.Equal(t, "ExecuteFetchAsDba:commerce=10,GetSchema:commerce/-80=0.5,GetSchema:customer=1", f.String())
+
+	for _, invalid := range []string{
+		"ExecuteFetchAsDba:commerce",
+		"ExecuteFetchAsDba=10",
+		"ExecuteFetchAsDba:=10",
+		"ExecuteFetchAsDba:/-80=10",
+		"ApplySchema:commerce=10",
+		"GetSchema:commerce=0",
+		"GetSchema:commerce=-1",
+		"GetSchema:commerce=fast",
+	} {
+		assert.Error(t, f.Set(invalid), invalid)
+	}
+}
+
+func TestRPCQuotaLimiter(t *testing.T) {
+	assert.Nil(t, newRPCQuotaLimiter(nil, 0))
+
+	limiter := newRPCQuotaLimiter([]rpcQuotaSpec{
+		{class: rpcClassGetSchema, keyspace: "commerce", rate: 1},
+		{class: rpcClassGetSchema, keyspace: "commerce", shard: "-80", rate: 1},
+	}, 1)
+	shard1 := &topodatapb.Tablet{Keyspace: "commerce", Shard: "-80"}
+	shard2 := &topodatapb.Tablet{Keyspace: "commerce", Shard: "80-"}
+	shard3 := &topodatapb.Tablet{Keyspace: "commerce", Shard: "c0-"}
+	other := &topodatapb.Tablet{Keyspace: "customer", Shard: "0"}
+
+	short := func() context.Context {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		t.Cleanup(cancel)
+		return ctx
+	}
+
+	// Each quota allows a single RPC per second. The shards without a quota of
+	// their own share that of the keyspace.
+	require.NoError(t, limiter.wait(short(), rpcClassGetSchema, shard1))
+	require.NoError(t, limiter.wait(short(), rpcClassGetSchema, shard2))
+	err := limiter.wait(short(), rpcClassGetSchema, shard3)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err), "%v", err)
+	err = limiter.wait(short(), rpcClassGetSchema, shard1)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err), "%v", err)
+
+	// Other keyspaces and classes are not limited.
+	for range 10 {
+		require.NoError(t, limiter.wait(short(), rpcClassGetSchema, other))
+		require.NoError(t, limiter.wait(short(), rpcClassExecuteFetchAsDba, shard1))
+	}
+
+	// An RPC waits for its turn, but only one of them can wait at a time.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	waited := make(chan error)
+	go func() {
+		waited <- limiter.wait(ctx, rpcClassGetSchema, shard2)
+	}()
+	quota := limiter.quotas[rpcQuotaKey{rpcClassGetSchema, "commerce", ""}]
+	require.Eventually(t, func() bool { return quota.waiting.Load() == 1 }, 5*time.Second, time.Millisecond)
+	err = limiter.wait(context.Background(), rpcClassGetSchema, shard3)
+	assert.ErrorContains(t, err, "1 RPCs are already waiting")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err), "%v", err)
+	assert.NoError(t, <-waited)
+}
+
+func TestClientRPCQuotas(t *testing.T) {
+	client := NewClient()
+	defer client.Close()
+	client.quotas = newRPCQuotaLimiter([]rpcQuotaSpec{
+		{class: rpcClassExecuteFetchAsDba, keyspace: "commerce", rate: 1},
+		{class: rpcClassGetSchema, keyspace: "commerce", rate: 1},
+	}, 0)
+	tablet := &topodatapb.Tablet{
+		Keyspace: "commerce",
+		Shard:    "0",
+		Hostname: "localhost",
+		PortMap:  map[string]int32{"grpc": 15991},
+	}
+
+	// The first RPCs get their quota and fail to reach the tablet, the next
+	// ones don't get their quota in time.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := client.GetSchema(ctx, tablet, &tabletmanagerdatapb.GetSchemaRequest{})
+	assert.NotEqual(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err), "%v", err)
+	_, err = client.GetSchema(ctx, tablet, &tabletmanagerdatapb.GetSchemaRequest{})
+	assert.ErrorContains(t, err, "tablet manager RPC quota GetSchema:commerce exceeded")
+
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = client.ExecuteFetchAsDba(ctx, tablet, false, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{Query: []byte("select 1")})
+	assert.NotEqual(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err), "%v", err)
+	_, err = client.ExecuteMultiFetchAsDba(ctx, tablet, false, &tabletmanagerdatapb.ExecuteMultiFetchAsDbaRequest{Sql: []byte("select 1")})
+	assert.ErrorContains(t, err, "tablet manager RPC quota ExecuteFetchAsDba:commerce exceeded")
+}
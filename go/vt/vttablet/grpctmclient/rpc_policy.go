@@ -0,0 +1,570 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"os"
+	"path"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/yaml2"
+
+	tabletmanagerservicepb "vitess.io/vitess/go/vt/proto/tabletmanagerservice"
+)
+
+// The RPC policies set the deadline, the timeout, the retries and the hedging
+// of the unary tablet manager RPCs. They are read from the YAML or JSON file of
+// --tablet_manager_grpc_rpc_policy_config, e.g.
+//
+//	default:
+//	  timeout: 30s
+//	classes:
+//	  read:
+//	    timeout: 10s
+//	    retries: 2
+//	  write:
+//	    deadline: 2m
+//	    retries: 3
+//	    retry_backoff: 500ms
+//	    max_retry_backoff: 10s
+//	methods:
+//	  FullStatus:
+//	    timeout: 2s
+//	    hedge_after: 500ms
+//	cells:
+//	  zone2:
+//	    default:
+//	      timeout: 1m
+//
+// The policy of an RPC is merged field by field from the default policy, the
+// one of the class of the RPC and the one of its method, and then from the same
+// ones of the cell of the tablet, each of them taking precedence over the
+// previous ones. The file is reloaded when it changes, for the RPCs that start
+// afterwards. The streaming RPCs have no policy.
+//
+// Only the read RPCs can be hedged. The write RPCs can only be retried if they
+// are idempotent, since an attempt that timed out may have been executed by the
+// tablet: the retries set for the write class, or by default, only apply to
+// them.
+
+// The classes of RPCs that policies can be set for.
+const (
+	// rpcPolicyClassRead covers the RPCs that only read the state of the
+	// tablet, which are the only ones that can be retried and hedged.
+	rpcPolicyClassRead = "read"
+	// rpcPolicyClassWrite covers all the other RPCs.
+	rpcPolicyClassWrite = "write"
+)
+
+var rpcPolicyClasses = []string{rpcPolicyClassRead, rpcPolicyClassWrite}
+
+// readRPCs are the RPCs of the read class.
+var readRPCs = map[string]bool{
+	"CheckThrottler":            true,
+	"FullStatus":                true,
+	"GetGlobalStatusVars":       true,
+	"GetMysqlVariables":         true,
+	"GetPermissions":            true,
+	"GetReplicas":               true,
+	"GetSchema":                 true,
+	"GetSchemaHash":             true,
+	"GetThrottlerStatus":        true,
+	"HasVReplicationWorkflows":  true,
+	"NegotiateAPILevel":         true,
+	"Ping":                      true,
+	"PrimaryPosition":           true,
+	"PrimaryStatus":             true,
+	"ReadVReplicationWorkflow":  true,
+	"ReadVReplicationWorkflows": true,
+	"ReplicationStatus":         true,
+	"SemiSyncStatus":            true,
+}
+
+// idempotentRPCs are the RPCs of the write class that have the same effect when
+// they are sent several times, which can be retried. Most of them are used by
+// the reparent operations. A retry of LockTables or UnlockTables fails if the
+// first attempt was executed, since the tables are then already locked or
+// unlocked, and so does a retry of ChangeType to DRAINED, so they aren't
+// retried.
+var idempotentRPCs = map[string]bool{
+	"DemotePrimary":              true,
+	"RefreshState":               true,
+	"ReloadSchema":               true,
+	"ReplicaWasPromoted":         true,
+	"ReplicaWasRestarted":        true,
+	"ResetReplicationParameters": true,
+	"RunHealthCheck":             true,
+	"SetReadOnly":                true,
+	"SetReadWrite":               true,
+	"SetReplicationSource":       true,
+	"StartReplication":           true,
+	"StopReplication":            true,
+	"UndoDemotePrimary":          true,
+	"WaitForPosition":            true,
+}
+
+// retryableRPC returns whether the given RPC can be retried.
+func retryableRPC(method string) bool {
+	return readRPCs[method] || idempotentRPCs[method]
+}
+
+func rpcPolicyClass(method string) string {
+	if readRPCs[method] {
+		return rpcPolicyClassRead
+	}
+	return rpcPolicyClassWrite
+}
+
+const (
+	// defaultRetryBackoff is the delay before the first retry of an RPC whose
+	// policy doesn't set one.
+	defaultRetryBackoff = 100 * time.Millisecond
+	// defaultMaxRetryBackoff is the longest delay between two retries of an RPC
+	// whose policy doesn't set one.
+	defaultMaxRetryBackoff = 5 * time.Second
+	// defaultRetryJitter is the jitter of the delays between the retries of an
+	// RPC whose policy doesn't set one.
+	defaultRetryJitter = 0.2
+)
+
+// rpcPolicies is the policy config of --tablet_manager_grpc_rpc_policy_config.
+var rpcPolicies rpcPolicyWatcher
+
+var rpcPolicyStats = struct {
+	Retries *stats.CountersWithSingleLabel
+	Hedges  *stats.CountersWithSingleLabel
+}{
+	Retries: stats.NewCountersWithSingleLabel("tabletmanagerclient_rpc_retries", "number of tablet manager RPCs that were retried with the policy of --tablet_manager_grpc_rpc_policy_config, by method", "method"),
+	Hedges:  stats.NewCountersWithSingleLabel("tabletmanagerclient_rpc_hedges", "number of hedged tablet manager RPCs that were sent with the policy of --tablet_manager_grpc_rpc_policy_config, by method", "method"),
+}
+
+// policyDuration is a duration in the format of time.ParseDuration, e.g. 1m30s.
+type policyDuration time.Duration
+
+func (d *policyDuration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid duration %s, expected a string like 1m30s", data)
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = policyDuration(parsed)
+	return nil
+}
+
+// rpcPolicy is the policy of some RPCs. The fields that aren't set are taken
+// from the less specific policies.
+type rpcPolicy struct {
+	// Deadline is the time an RPC has to complete, including all its attempts
+	// and the delays between them, which can only shorten the deadline of its
+	// context.
+	Deadline *policyDuration `json:"deadline,omitempty"`
+	// Timeout is the timeout of each attempt of an RPC, which can only shorten
+	// the deadline of its context.
+	Timeout *policyDuration `json:"timeout,omitempty"`
+	// Retries is the number of times an RPC is retried after an attempt fails
+	// because the tablet is unavailable or the attempt timed out.
+	Retries *int `json:"retries,omitempty"`
+	// RetryBackoff is the delay before the first retry, which is doubled for
+	// each of the next ones, up to MaxRetryBackoff.
+	RetryBackoff *policyDuration `json:"retry_backoff,omitempty"`
+	// MaxRetryBackoff is the longest delay between two retries, unlimited if 0.
+	MaxRetryBackoff *policyDuration `json:"max_retry_backoff,omitempty"`
+	// RetryJitter is the fraction of the delays between the retries that is
+	// randomized, from 0 to 1, so that the clients that failed at the same time
+	// don't retry at the same time.
+	RetryJitter *float64 `json:"retry_jitter,omitempty"`
+	// HedgeAfter is the delay after which an attempt that is still in flight is
+	// hedged with a second one. The first of them to succeed wins, and the other
+	// one is canceled.
+	HedgeAfter *policyDuration `json:"hedge_after,omitempty"`
+}
+
+// rpcPolicySet holds the policies of all the RPCs, or of the RPCs to the
+// tablets of a cell.
+type rpcPolicySet struct {
+	Default *rpcPolicy            `json:"default,omitempty"`
+	Classes map[string]*rpcPolicy `json:"classes,omitempty"`
+	Methods map[string]*rpcPolicy `json:"methods,omitempty"`
+}
+
+// rpcPolicyConfig is the content of the policy config file.
+type rpcPolicyConfig struct {
+	rpcPolicySet
+	Cells map[string]*rpcPolicySet `json:"cells,omitempty"`
+}
+
+// parseRPCPolicyConfig parses and validates a policy config in YAML or JSON.
+func parseRPCPolicyConfig(data []byte) (*rpcPolicyConfig, error) {
+	var config rpcPolicyConfig
+	if err := yaml2.UnmarshalStrict(data, &config); err != nil {
+		return nil, err
+	}
+	if err := config.rpcPolicySet.validate(); err != nil {
+		return nil, err
+	}
+	for cell, set := range config.Cells {
+		if set == nil {
+			continue
+		}
+		if err := set.validate(); err != nil {
+			return nil, fmt.Errorf("cell %v: %w", cell, err)
+		}
+	}
+	return &config, nil
+}
+
+func (set *rpcPolicySet) validate() error {
+	if err := set.Default.validate(true, true); err != nil {
+		return fmt.Errorf("default policy: %w", err)
+	}
+	for class, policy := range set.Classes {
+		if !slices.Contains(rpcPolicyClasses, class) {
+			return fmt.Errorf("unknown RPC class %q, expected one of %v", class, rpcPolicyClasses)
+		}
+		// some RPCs of the write class can be retried
+		if err := policy.validate(true, class == rpcPolicyClassRead); err != nil {
+			return fmt.Errorf("policy of the %v class: %w", class, err)
+		}
+	}
+	for method, policy := range set.Methods {
+		if !slices.ContainsFunc(tabletmanagerservicepb.TabletManager_ServiceDesc.Methods, func(desc grpc.MethodDesc) bool {
+			return desc.MethodName == method
+		}) {
+			return fmt.Errorf("unknown unary tablet manager RPC %q", method)
+		}
+		if err := policy.validate(retryableRPC(method), readRPCs[method]); err != nil {
+			return fmt.Errorf("policy of %v: %w", method, err)
+		}
+	}
+	return nil
+}
+
+// validate checks the values of the policy. Only the policies that can apply
+// to the retryable RPCs can retry them, and only the ones that can apply to the
+// read RPCs can hedge them.
+func (p *rpcPolicy) validate(retryable bool, hedgeable bool) error {
+	if p == nil {
+		return nil
+	}
+	for _, d := range []*policyDuration{p.Deadline, p.Timeout, p.RetryBackoff, p.MaxRetryBackoff, p.HedgeAfter} {
+		if d != nil && *d < 0 {
+			return fmt.Errorf("negative duration %v", time.Duration(*d))
+		}
+	}
+	if p.Retries != nil && *p.Retries < 0 {
+		return fmt.Errorf("negative number of retries %d", *p.Retries)
+	}
+	if p.RetryJitter != nil && (*p.RetryJitter < 0 || *p.RetryJitter > 1) {
+		return fmt.Errorf("retry jitter %v out of the [0, 1] range", *p.RetryJitter)
+	}
+	if !retryable && p.Retries != nil && *p.Retries > 0 {
+		return fmt.Errorf("only the RPCs of the %v class and the idempotent ones can be retried", rpcPolicyClassRead)
+	}
+	if !hedgeable && p.HedgeAfter != nil && *p.HedgeAfter > 0 {
+		return fmt.Errorf("only the RPCs of the %v class can be hedged", rpcPolicyClassRead)
+	}
+	return nil
+}
+
+// resolvedRPCPolicy is the policy of an RPC, once merged.
+type resolvedRPCPolicy struct {
+	deadline        time.Duration
+	timeout         time.Duration
+	retries         int
+	retryBackoff    time.Duration
+	maxRetryBackoff time.Duration
+	retryJitter     float64
+	hedgeAfter      time.Duration
+}
+
+func (r *resolvedRPCPolicy) merge(p *rpcPolicy) {
+	if p == nil {
+		return
+	}
+	if p.Deadline != nil {
+		r.deadline = time.Duration(*p.Deadline)
+	}
+	if p.Timeout != nil {
+		r.timeout = time.Duration(*p.Timeout)
+	}
+	if p.Retries != nil {
+		r.retries = *p.Retries
+	}
+	if p.RetryBackoff != nil {
+		r.retryBackoff = time.Duration(*p.RetryBackoff)
+	}
+	if p.MaxRetryBackoff != nil {
+		r.maxRetryBackoff = time.Duration(*p.MaxRetryBackoff)
+	}
+	if p.RetryJitter != nil {
+		r.retryJitter = *p.RetryJitter
+	}
+	if p.HedgeAfter != nil {
+		r.hedgeAfter = time.Duration(*p.HedgeAfter)
+	}
+}
+
+// resolve returns the policy of the given RPC to a tablet of the given cell.
+func (config *rpcPolicyConfig) resolve(cell string, method string) resolvedRPCPolicy {
+	policy := resolvedRPCPolicy{
+		retryBackoff:    defaultRetryBackoff,
+		maxRetryBackoff: defaultMaxRetryBackoff,
+		retryJitter:     defaultRetryJitter,
+	}
+	class := rpcPolicyClass(method)
+	for _, set := range []*rpcPolicySet{&config.rpcPolicySet, config.Cells[cell]} {
+		if set == nil {
+			continue
+		}
+		policy.merge(set.Default)
+		policy.merge(set.Classes[class])
+		policy.merge(set.Methods[method])
+	}
+	// the default and write class policies can't retry the write RPCs that
+	// aren't idempotent, nor hedge any of them
+	if !retryableRPC(method) {
+		policy.retries = 0
+	}
+	if class != rpcPolicyClassRead {
+		policy.hedgeAfter = 0
+	}
+	return policy
+}
+
+// invoke sends an RPC with the policy.
+func (p resolvedRPCPolicy) invoke(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if p.deadline > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.deadline)
+		defer cancel()
+	}
+	name := path.Base(method)
+	for retry := 0; ; retry++ {
+		var err error
+		if p.hedgeAfter > 0 {
+			err = p.hedge(ctx, method, req, reply, cc, invoker, opts...)
+		} else {
+			err = p.attempt(ctx, method, req, reply, cc, invoker, opts...)
+		}
+		if err == nil || retry >= p.retries || !retryable(ctx, err) {
+			return err
+		}
+
+		// don't wait for a retry that can't be sent before the deadline
+		backoff := p.backoff(retry)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= backoff {
+			return err
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+		rpcPolicyStats.Retries.Add(name, 1)
+		if msg, ok := reply.(proto.Message); ok {
+			proto.Reset(msg)
+		}
+	}
+}
+
+// backoff returns the delay before the given retry of an RPC, counting from 0:
+// retryBackoff doubled for each retry, up to maxRetryBackoff, and randomized by
+// up to retryJitter of it in either direction.
+func (p resolvedRPCPolicy) backoff(retry int) time.Duration {
+	limit := p.maxRetryBackoff
+	if limit <= 0 {
+		limit = math.MaxInt64 / 2
+	}
+	backoff := p.retryBackoff
+	for range retry {
+		if backoff >= limit {
+			break
+		}
+		backoff *= 2
+	}
+	backoff = min(backoff, limit)
+	if p.retryJitter > 0 {
+		backoff = time.Duration(float64(backoff) * (1 + p.retryJitter*(2*rand.Float64()-1)))
+	}
+	return backoff
+}
+
+// retryable returns whether an attempt of an RPC that failed with err can be
+// retried: the tablet was unavailable or the attempt timed out, while the
+// context of the RPC is still alive.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p resolvedRPCPolicy) attempt(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// hedge sends an attempt of an RPC, and a second one if the first one is still
+// in flight after hedgeAfter. Each attempt has its own response, and the one of
+// the first attempt to succeed is copied to reply.
+func (p resolvedRPCPolicy) hedge(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	msg, ok := reply.(proto.Message)
+	if !ok {
+		return p.attempt(ctx, method, req, reply, cc, invoker, opts...)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		reply proto.Message
+		err   error
+	}
+	results := make(chan result, 2)
+	send := func() {
+		r := msg.ProtoReflect().New().Interface()
+		err := p.attempt(ctx, method, req, r, cc, invoker, opts...)
+		results <- result{r, err}
+	}
+
+	go send()
+	inflight := 1
+	timer := time.NewTimer(p.hedgeAfter)
+	defer timer.Stop()
+	hedgeC := timer.C
+	for {
+		select {
+		case <-hedgeC:
+			hedgeC = nil
+			rpcPolicyStats.Hedges.Add(path.Base(method), 1)
+			inflight++
+			go send()
+		case res := <-results:
+			inflight--
+			if res.err == nil {
+				proto.Reset(msg)
+				proto.Merge(msg, res.reply)
+				return nil
+			}
+			// the first attempt failed before it was hedged, or both failed
+			if inflight == 0 {
+				return res.err
+			}
+		}
+	}
+}
+
+// rpcPolicyWatcher loads the policy config from its file on startup, or the
+// first time a tablet is dialed by the binaries that don't initialize servenv,
+// and then reloads it whenever the file changes, keeping the previous config if
+// the file becomes invalid.
+type rpcPolicyWatcher struct {
+	path string
+
+	mu      sync.Mutex
+	config  atomic.Pointer[rpcPolicyConfig]
+	watcher *fsnotify.Watcher
+}
+
+// dialOption returns the dial option that installs the interceptor applying
+// the policies to the RPCs on a connection to a tablet of the given cell, or
+// nil if there's no policy config.
+func (w *rpcPolicyWatcher) dialOption(cell string) (grpc.DialOption, error) {
+	if w.path == "" {
+		return nil, nil
+	}
+	if err := w.start(); err != nil {
+		return nil, err
+	}
+	return grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		policy := w.config.Load().resolve(cell, path.Base(method))
+		return policy.invoke(ctx, method, req, reply, cc, invoker, opts...)
+	}), nil
+}
+
+// start loads the config and starts watching its file, unless it's already
+// watched. If it fails, the next call tries again, so that the dials don't fail
+// forever once the file is fixed.
+func (w *rpcPolicyWatcher) start() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.watcher != nil {
+		return nil
+	}
+	if err := w.load(); err != nil {
+		return err
+	}
+	watcher, err := watchConfigFile(w.path, "tablet manager RPC policy config", w.load)
+	if err != nil {
+		return err
+	}
+	w.watcher = watcher
+	return nil
+}
+
+// loadRPCPolicies loads the policy config on startup, so that a missing or
+// invalid file fails the startup rather than the dials of the tablets.
+func loadRPCPolicies() {
+	if rpcPolicies.path == "" {
+		return
+	}
+	if err := rpcPolicies.start(); err != nil {
+		log.Exitf("--tablet_manager_grpc_rpc_policy_config: %v", err)
+	}
+}
+
+func (w *rpcPolicyWatcher) load() error {
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		return fmt.Errorf("failed to read the tablet manager RPC policy config: %w", err)
+	}
+	config, err := parseRPCPolicyConfig(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse the tablet manager RPC policy config %v: %w", w.path, err)
+	}
+	w.config.Store(config)
+	return nil
+}
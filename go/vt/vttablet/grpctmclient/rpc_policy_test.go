@@ -0,0 +1,344 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	tabletmanagerservicepb "vitess.io/vitess/go/vt/proto/tabletmanagerservice"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const pingMethod = "/tabletmanagerservice.TabletManager/Ping"
+
+func TestParseRPCPolicyConfig(t *testing.T) {
+	config, err := parseRPCPolicyConfig([]byte(`
+default:
+  timeout: 30s
+classes:
+  read:
+    timeout: 10s
+    retries: 2
+  write:
+    deadline: 2m
+    retries: 1
+    max_retry_backoff: 1s
+methods:
+  FullStatus:
+    timeout: 2s
+    hedge_after: 500ms
+  SetReplicationSource:
+    timeout: 1m
+cells:
+  zone2:
+    default:
+      timeout: 1m
+    methods:
+      FullStatus:
+        retry_backoff: 1s
+`))
+	require.NoError(t, err)
+
+	tcs := []struct {
+		cell, method string
+		policy       resolvedRPCPolicy
+	}{
+		{"zone1", "Ping", resolvedRPCPolicy{timeout: 10 * time.Second, retries: 2, retryBackoff: defaultRetryBackoff, maxRetryBackoff: defaultMaxRetryBackoff, retryJitter: defaultRetryJitter}},
+		{"zone1", "FullStatus", resolvedRPCPolicy{timeout: 2 * time.Second, retries: 2, retryBackoff: defaultRetryBackoff, maxRetryBackoff: defaultMaxRetryBackoff, retryJitter: defaultRetryJitter, hedgeAfter: 500 * time.Millisecond}},
+		// the retries of the write class only apply to the idempotent RPCs
+		{"zone1", "SetReplicationSource", resolvedRPCPolicy{deadline: 2 * time.Minute, timeout: time.Minute, retries: 1, retryBackoff: defaultRetryBackoff, maxRetryBackoff: time.Second, retryJitter: defaultRetryJitter}},
+		{"zone1", "PromoteReplica", resolvedRPCPolicy{deadline: 2 * time.Minute, timeout: 30 * time.Second, retryBackoff: defaultRetryBackoff, maxRetryBackoff: time.Second, retryJitter: defaultRetryJitter}},
+		// the default policy of the cell overrides the global class and method ones
+		{"zone2", "Ping", resolvedRPCPolicy{timeout: time.Minute, retries: 2, retryBackoff: defaultRetryBackoff, maxRetryBackoff: defaultMaxRetryBackoff, retryJitter: defaultRetryJitter}},
+		{"zone2", "FullStatus", resolvedRPCPolicy{timeout: time.Minute, retries: 2, retryBackoff: time.Second, maxRetryBackoff: defaultMaxRetryBackoff, retryJitter: defaultRetryJitter, hedgeAfter: 500 * time.Millisecond}},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.cell+"/"+tc.method, func(t *testing.T) {
+			assert.Equal(t, tc.policy, config.resolve(tc.cell, tc.method))
+		})
+	}
+
+	// JSON is YAML too, and the retries of the default policy only apply to the
+	// read and idempotent RPCs, and its hedging to the read RPCs.
+	config, err = parseRPCPolicyConfig([]byte(`{"default": {"retries": 3, "hedge_after": "1s"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, resolvedRPCPolicy{retries: 3, retryBackoff: defaultRetryBackoff, maxRetryBackoff: defaultMaxRetryBackoff, retryJitter: defaultRetryJitter, hedgeAfter: time.Second}, config.resolve("", "GetSchema"))
+	assert.Equal(t, resolvedRPCPolicy{retryBackoff: defaultRetryBackoff, maxRetryBackoff: defaultMaxRetryBackoff, retryJitter: defaultRetryJitter}, config.resolve("", "ApplySchema"))
+	assert.Equal(t, resolvedRPCPolicy{retries: 3, retryBackoff: defaultRetryBackoff, maxRetryBackoff: defaultMaxRetryBackoff, retryJitter: defaultRetryJitter}, config.resolve("", "DemotePrimary"))
+
+	errorCases := []struct {
+		config string
+		err    string
+	}{
+		{`{"classes": {"slow": {"timeout": "1s"}}}`, `unknown RPC class "slow"`},
+		{`{"methods": {"Pong": {"timeout": "1s"}}}`, `unknown unary tablet manager RPC "Pong"`},
+		{`{"methods": {"Backup": {"timeout": "1s"}}}`, `unknown unary tablet manager RPC "Backup"`},
+		{`{"methods": {"ApplySchema": {"retries": 1}}}`, "policy of ApplySchema: only the RPCs of the read class and the idempotent ones can be retried"},
+		{`{"methods": {"StopReplication": {"hedge_after": "1s"}}}`, "policy of StopReplication: only the RPCs of the read class can be hedged"},
+		{`{"classes": {"write": {"hedge_after": "1s"}}}`, "policy of the write class: only the RPCs of the read class can be hedged"},
+		{`{"default": {"retry_jitter": 1.5}}`, "default policy: retry jitter 1.5 out of the [0, 1] range"},
+		{`{"default": {"deadline": "-1s"}}`, "default policy: negative duration -1s"},
+		{`{"default": {"timeout": "-1s"}}`, "default policy: negative duration -1s"},
+		{`{"cells": {"zone1": {"default": {"retries": -1}}}}`, "cell zone1: default policy: negative number of retries -1"},
+		{`{"default": {"timeout": 10}}`, "invalid duration 10"},
+		{`{"default": {"timout": "1s"}}`, `unknown field "timout"`},
+	}
+	for _, tc := range errorCases {
+		_, err := parseRPCPolicyConfig([]byte(tc.config))
+		assert.ErrorContains(t, err, tc.err, tc.config)
+	}
+}
+
+func TestRPCPolicyRetries(t *testing.T) {
+	ctx := context.Background()
+	policy := resolvedRPCPolicy{timeout: time.Second, retries: 2, retryBackoff: time.Millisecond}
+
+	var attempts atomic.Int32
+	failing := func(code codes.Code) grpc.UnaryInvoker {
+		return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			attempts.Add(1)
+			deadline, ok := ctx.Deadline()
+			require.True(t, ok)
+			assert.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+			return status.Error(code, "failed")
+		}
+	}
+
+	// the unavailable tablets are retried
+	err := policy.invoke(ctx, pingMethod, &tabletmanagerdatapb.PingRequest{}, &tabletmanagerdatapb.PingResponse{}, nil, failing(codes.Unavailable))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.EqualValues(t, 3, attempts.Load())
+
+	// the other errors are not
+	attempts.Store(0)
+	err = policy.invoke(ctx, pingMethod, &tabletmanagerdatapb.PingRequest{}, &tabletmanagerdatapb.PingResponse{}, nil, failing(codes.FailedPrecondition))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.EqualValues(t, 1, attempts.Load())
+
+	// neither are the RPCs whose context is done
+	attempts.Store(0)
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = policy.invoke(canceledCtx, pingMethod, &tabletmanagerdatapb.PingRequest{}, &tabletmanagerdatapb.PingResponse{}, nil, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		attempts.Add(1)
+		return status.Error(codes.DeadlineExceeded, "timed out")
+	})
+	assert.Error(t, err)
+	assert.EqualValues(t, 1, attempts.Load())
+
+	// a retry that succeeds returns its response
+	attempts.Store(0)
+	reply := &tabletmanagerdatapb.PingResponse{}
+	err = policy.invoke(ctx, pingMethod, &tabletmanagerdatapb.PingRequest{}, reply, nil, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		if attempts.Add(1) == 1 {
+			reply.(*tabletmanagerdatapb.PingResponse).Payload = "partial"
+			return status.Error(codes.Unavailable, "unavailable")
+		}
+		reply.(*tabletmanagerdatapb.PingResponse).Payload = "payload"
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "payload", reply.Payload)
+	assert.EqualValues(t, 2, attempts.Load())
+}
+
+func TestRPCPolicyIdempotentRPCs(t *testing.T) {
+	for method := range idempotentRPCs {
+		assert.Truef(t, slices.ContainsFunc(tabletmanagerservicepb.TabletManager_ServiceDesc.Methods, func(desc grpc.MethodDesc) bool {
+			return desc.MethodName == method
+		}), "unknown unary tablet manager RPC %q", method)
+		assert.Falsef(t, readRPCs[method], "%v is a read RPC", method)
+	}
+	// a retry of these fails if the first attempt was executed
+	for _, method := range []string{"ChangeType", "LockTables", "UnlockTables"} {
+		assert.Falsef(t, retryableRPC(method), "%v can be retried", method)
+	}
+}
+
+func TestRPCPolicyBackoff(t *testing.T) {
+	policy := resolvedRPCPolicy{retryBackoff: 100 * time.Millisecond, maxRetryBackoff: time.Second}
+	var backoffs []time.Duration
+	for retry := range 6 {
+		backoffs = append(backoffs, policy.backoff(retry))
+	}
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}, backoffs)
+
+	// without a maximum, the backoff doesn't overflow
+	policy.maxRetryBackoff = 0
+	assert.Positive(t, policy.backoff(100))
+
+	policy = resolvedRPCPolicy{retryBackoff: time.Second, maxRetryBackoff: time.Minute, retryJitter: 0.5}
+	for range 100 {
+		backoff := policy.backoff(1)
+		assert.GreaterOrEqual(t, backoff, time.Second)
+		assert.LessOrEqual(t, backoff, 3*time.Second)
+	}
+}
+
+func TestRPCPolicyDeadline(t *testing.T) {
+	ctx := context.Background()
+	policy := resolvedRPCPolicy{deadline: 100 * time.Millisecond, retries: 100, retryBackoff: 10 * time.Millisecond, maxRetryBackoff: 10 * time.Millisecond}
+
+	// the retries stop at the deadline, without waiting for a retry that
+	// couldn't be sent before it
+	var attempts atomic.Int32
+	start := time.Now()
+	err := policy.invoke(ctx, pingMethod, &tabletmanagerdatapb.PingRequest{}, &tabletmanagerdatapb.PingResponse{}, nil, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		attempts.Add(1)
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		assert.WithinDuration(t, start.Add(100*time.Millisecond), deadline, 50*time.Millisecond)
+		return status.Error(codes.Unavailable, "unavailable")
+	})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Greater(t, attempts.Load(), int32(1))
+	assert.Less(t, attempts.Load(), int32(100))
+}
+
+func TestRPCPolicyHedging(t *testing.T) {
+	ctx := context.Background()
+	policy := resolvedRPCPolicy{hedgeAfter: 10 * time.Millisecond}
+
+	// the first attempt hangs until it's canceled, and the hedged one wins
+	var attempts atomic.Int32
+	canceled := make(chan struct{})
+	reply := &tabletmanagerdatapb.PingResponse{}
+	err := policy.invoke(ctx, pingMethod, &tabletmanagerdatapb.PingRequest{}, reply, nil, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		if attempts.Add(1) == 1 {
+			<-ctx.Done()
+			close(canceled)
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		reply.(*tabletmanagerdatapb.PingResponse).Payload = "hedged"
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "hedged", reply.Payload)
+	assert.EqualValues(t, 2, attempts.Load())
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the first attempt was not canceled")
+	}
+
+	// an attempt that fails before it's hedged fails the RPC right away
+	attempts.Store(0)
+	err = policy.invoke(ctx, pingMethod, &tabletmanagerdatapb.PingRequest{}, &tabletmanagerdatapb.PingResponse{}, nil, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		attempts.Add(1)
+		return status.Error(codes.Internal, "failed")
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.EqualValues(t, 1, attempts.Load())
+}
+
+func TestRPCPolicyWatcher(t *testing.T) {
+	// Without a config file, there's no policy.
+	w := &rpcPolicyWatcher{}
+	opt, err := w.dialOption("zone1")
+	require.NoError(t, err)
+	assert.Nil(t, opt)
+
+	path := filepath.Join(t.TempDir(), "rpc_policy.yaml")
+	w = &rpcPolicyWatcher{path: path}
+	_, err = w.dialOption("zone1")
+	assert.ErrorContains(t, err, "failed to read the tablet manager RPC policy config")
+
+	// The load is retried by the next dial once the file is fixed.
+	require.NoError(t, os.WriteFile(path, []byte("default:\n  timeout: 5s\n"), 0o644))
+	defer func() {
+		if w.watcher != nil {
+			w.watcher.Close()
+		}
+	}()
+	opt, err = w.dialOption("zone1")
+	require.NoError(t, err)
+	assert.NotNil(t, opt)
+	assert.Equal(t, 5*time.Second, w.config.Load().resolve("zone1", "Ping").timeout)
+
+	// The file is reloaded when it changes. It's replaced rather than
+	// rewritten, so that it isn't reloaded while it's truncated.
+	replaceFile(t, path, "default:\n  timeout: 7s\n")
+	require.Eventually(t, func() bool {
+		return w.config.Load().resolve("zone1", "Ping").timeout == 7*time.Second
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// An invalid file doesn't replace the previous config.
+	replaceFile(t, path, "default:\n  timeout: forever\n")
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 7*time.Second, w.config.Load().resolve("zone1", "Ping").timeout)
+}
+
+// replaceFile atomically replaces the content of the file at path.
+func replaceFile(t *testing.T, path string, content string) {
+	tmp := path + ".tmp"
+	require.NoError(t, os.WriteFile(tmp, []byte(content), 0o644))
+	require.NoError(t, os.Rename(tmp, path))
+}
+
+// TestRPCPolicyClient checks that the policies apply to the RPCs of a client,
+// by retrying the attempts that time out on an unreachable tablet.
+func TestRPCPolicyClient(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rpc_policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+cells:
+  zone1:
+    methods:
+      Ping:
+        timeout: 50ms
+        retries: 2
+        retry_backoff: 1ms
+`), 0o644))
+	rpcPolicies = rpcPolicyWatcher{path: path}
+	defer func() {
+		if rpcPolicies.watcher != nil {
+			rpcPolicies.watcher.Close()
+		}
+		rpcPolicies = rpcPolicyWatcher{}
+	}()
+
+	client := NewClient()
+	defer client.Close()
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Hostname: "localhost",
+		PortMap: map[string]int32{
+			"grpc": 15994,
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	retries := rpcPolicyStats.Retries.Counts()["Ping"]
+	err := client.Ping(ctx, tablet)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Equal(t, retries+2, rpcPolicyStats.Retries.Counts()["Ping"])
+	assert.NoError(t, ctx.Err())
+}
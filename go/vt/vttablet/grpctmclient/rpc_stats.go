@@ -0,0 +1,116 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// slowRPCThreshold is the duration above which tablet manager RPCs are
+// logged. Zero disables the logging.
+var slowRPCThreshold time.Duration
+
+// maxRequestSize is the size in bytes above which tablet manager RPC requests
+// are rejected before they are sent. Zero disables the limit.
+var maxRequestSize int
+
+var rpcStats = struct {
+	Timings          *stats.Timings
+	SlowRPCs         *stats.CountersWithSingleLabel
+	BytesSent        *stats.CountersWithSingleLabel
+	BytesReceived    *stats.CountersWithSingleLabel
+	RejectedRequests *stats.CountersWithSingleLabel
+}{
+	Timings:          stats.NewTimings("tabletmanagerclient_rpc_timings", "latency of unary tablet manager RPCs, by method", "method"),
+	SlowRPCs:         stats.NewCountersWithSingleLabel("tabletmanagerclient_slow_rpcs", "number of tablet manager RPCs that took longer than --tablet_manager_grpc_slow_rpc_threshold, by method", "method"),
+	BytesSent:        stats.NewCountersWithSingleLabel("tabletmanagerclient_rpc_bytes_sent", "size of the requests of unary tablet manager RPCs, by method", "method"),
+	BytesReceived:    stats.NewCountersWithSingleLabel("tabletmanagerclient_rpc_bytes_received", "size of the responses of unary tablet manager RPCs, by method", "method"),
+	RejectedRequests: stats.NewCountersWithSingleLabel("tabletmanagerclient_rejected_requests", "number of tablet manager RPCs that were not sent because their request was larger than --tablet_manager_grpc_max_request_size, by method", "method"),
+}
+
+// rpcStatsDialOption installs the interceptor that records the latency and
+// the request and response sizes of the RPCs on a connection, logs the slow
+// ones, and rejects the requests that are too large. Streaming RPCs are not
+// recorded, since their duration is that of the whole stream.
+var rpcStatsDialOption = grpc.WithChainUnaryInterceptor(rpcStatsUnaryInterceptor)
+
+func rpcStatsUnaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	name := path.Base(method)
+	reqSize := messageSize(req)
+	if err := checkRequestSize(name, cc.Target(), reqSize); err != nil {
+		return err
+	}
+	rpcStats.BytesSent.Add(name, int64(reqSize))
+
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	recordRPC(name, cc.Target(), time.Since(start), err)
+	if err == nil {
+		rpcStats.BytesReceived.Add(name, int64(messageSize(reply)))
+	}
+	return err
+}
+
+// checkRequestSize returns an error if a request of the given size must not be
+// sent to the tablet at the given address. Requests this large would use a lot
+// of memory on both sides, and would most likely be rejected by the tablet
+// anyway, after being sent over the network.
+func checkRequestSize(method string, addr string, size int) error {
+	if maxRequestSize <= 0 || size <= maxRequestSize {
+		return nil
+	}
+	rpcStats.RejectedRequests.Add(method, 1)
+	return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "tablet manager RPC %s to tablet %s not sent: its request is %d bytes, larger than --tablet_manager_grpc_max_request_size (%d bytes)", method, addr, size, maxRequestSize)
+}
+
+// messageSize returns the size in bytes of the encoded message.
+func messageSize(msg any) int {
+	switch msg := msg.(type) {
+	case interface{ SizeVT() int }:
+		return msg.SizeVT()
+	case proto.Message:
+		return proto.Size(msg)
+	default:
+		return 0
+	}
+}
+
+// recordRPC records the latency of an RPC to the tablet at the given address,
+// and logs it if it was slow.
+func recordRPC(method string, addr string, elapsed time.Duration, err error) {
+	rpcStats.Timings.Add(method, elapsed)
+	if slowRPCThreshold <= 0 || elapsed <= slowRPCThreshold {
+		return
+	}
+	rpcStats.SlowRPCs.Add(method, 1)
+	if err != nil {
+		log.Warningf("Slow tablet manager RPC %s to tablet %s took %v and failed: %v", method, addr, elapsed, err)
+		return
+	}
+	log.Warningf("Slow tablet manager RPC %s to tablet %s took %v", method, addr, elapsed)
+}
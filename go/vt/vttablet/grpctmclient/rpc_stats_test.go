@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmrpctest"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestRPCTimings(t *testing.T) {
+	addr, shutdown := grpcTestServer(t, tmrpctest.NewFakeRPCTM(t))
+	defer shutdown()
+
+	tablet := &topodatapb.Tablet{
+		Hostname: addr.IP.String(),
+		PortMap:  map[string]int32{"grpc": int32(addr.Port)},
+	}
+
+	for _, client := range []*Client{NewClient(), NewCachedConnClient(1)} {
+		before := rpcStats.Timings.Counts()["Ping"]
+		require.NoError(t, client.Ping(context.Background(), tablet))
+		assert.Equal(t, before+1, rpcStats.Timings.Counts()["Ping"])
+		client.Close()
+	}
+}
+
+func TestRecordSlowRPC(t *testing.T) {
+	defer func(threshold time.Duration) {
+		slowRPCThreshold = threshold
+	}(slowRPCThreshold)
+
+	before := rpcStats.SlowRPCs.Counts()["TestMethod"]
+
+	slowRPCThreshold = 0
+	recordRPC("TestMethod", "localhost:1", time.Hour, nil)
+	assert.Equal(t, before, rpcStats.SlowRPCs.Counts()["TestMethod"])
+
+	slowRPCThreshold = time.Second
+	recordRPC("TestMethod", "localhost:1", time.Millisecond, nil)
+	assert.Equal(t, before, rpcStats.SlowRPCs.Counts()["TestMethod"])
+	recordRPC("TestMethod", "localhost:1", 2*time.Second, nil)
+	recordRPC("TestMethod", "localhost:1", 2*time.Second, errors.New("test error"))
+	assert.Equal(t, before+2, rpcStats.SlowRPCs.Counts()["TestMethod"])
+}
+
+func TestRPCSizes(t *testing.T) {
+	defer func(size int) {
+		maxRequestSize = size
+	}(maxRequestSize)
+
+	addr, shutdown := grpcTestServer(t, tmrpctest.NewFakeRPCTM(t))
+	defer shutdown()
+
+	tablet := &topodatapb.Tablet{
+		Hostname: addr.IP.String(),
+		PortMap:  map[string]int32{"grpc": int32(addr.Port)},
+	}
+	client := NewClient()
+	defer client.Close()
+
+	// Both the PingRequest and the PingResponse only have a "payload" string.
+	const pingSize = 9
+	sent := rpcStats.BytesSent.Counts()["Ping"]
+	received := rpcStats.BytesReceived.Counts()["Ping"]
+	rejected := rpcStats.RejectedRequests.Counts()["Ping"]
+
+	maxRequestSize = pingSize
+	require.NoError(t, client.Ping(context.Background(), tablet))
+	assert.Equal(t, sent+pingSize, rpcStats.BytesSent.Counts()["Ping"])
+	assert.Equal(t, received+pingSize, rpcStats.BytesReceived.Counts()["Ping"])
+	assert.Equal(t, rejected, rpcStats.RejectedRequests.Counts()["Ping"])
+
+	maxRequestSize = pingSize - 1
+	err := client.Ping(context.Background(), tablet)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.ErrorContains(t, err, "--tablet_manager_grpc_max_request_size")
+	assert.Equal(t, sent+pingSize, rpcStats.BytesSent.Counts()["Ping"])
+	assert.Equal(t, rejected+1, rpcStats.RejectedRequests.Counts()["Ping"])
+}
@@ -0,0 +1,345 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"path"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	tabletmanagerservicepb "vitess.io/vitess/go/vt/proto/tabletmanagerservice"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// AnyMethod can be used instead of the name of an RPC to script the behavior of
+// all the RPCs that don't have a behavior of their own.
+const AnyMethod = "*"
+
+// DefaultScript is the script of the clients created for the "fake-scriptable"
+// protocol, i.e. with --tablet_manager_protocol=fake-scriptable.
+var DefaultScript = NewScript()
+
+func init() {
+	tmclient.RegisterTabletManagerClientFactory("fake-scriptable", func() tmclient.TabletManagerClient {
+		return NewScriptableClient(DefaultScript)
+	})
+}
+
+// ResponseFunc returns the response of an RPC sent to the tablet, or the error
+// that it fails with. The response must have the type of the response of the
+// RPC, and is ignored for the streaming RPCs.
+type ResponseFunc func(ctx context.Context, tablet *topodatapb.Tablet, request proto.Message) (proto.Message, error)
+
+// FixedLatency returns a latency distribution that is always d.
+func FixedLatency(d time.Duration) func() time.Duration {
+	return func() time.Duration { return d }
+}
+
+// UniformLatency returns a latency distribution that is uniform in [low, high).
+func UniformLatency(low, high time.Duration) func() time.Duration {
+	return func() time.Duration {
+		if high <= low {
+			return low
+		}
+		return low + rand.N(high-low)
+	}
+}
+
+// Script holds the behavior of the RPCs of the scriptable clients, which never
+// reach any tablet. It can be changed at any time, e.g. while a reparent is
+// in progress, and applies to the RPCs that start afterwards, except for the
+// partitions which also apply to the RPCs that are already waiting on them.
+//
+// By default the RPCs succeed immediately with an empty response, except for
+// Ping which returns its payload as a real tablet does. Each RPC first waits
+// for its latency, then fails if its tablet is partitioned, and then returns
+// the scripted error or response, in this order. The RPCs are identified by
+// their name in the TabletManager service, e.g. "SetReplicationSource".
+type Script struct {
+	mu          sync.Mutex
+	latencies   map[string]func() time.Duration
+	errors      map[string]error
+	responses   map[string]ResponseFunc
+	partitioned map[string]chan struct{}
+	calls       map[string]int
+}
+
+// NewScript returns a script with the default behavior.
+func NewScript() *Script {
+	s := &Script{}
+	s.Reset()
+	return s
+}
+
+// Reset restores the default behavior of all the RPCs, heals the partitions and
+// forgets the RPCs that were sent.
+func (s *Script) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, healed := range s.partitioned {
+		close(healed)
+	}
+	s.latencies = make(map[string]func() time.Duration)
+	s.errors = make(map[string]error)
+	s.responses = make(map[string]ResponseFunc)
+	s.partitioned = make(map[string]chan struct{})
+	s.calls = make(map[string]int)
+}
+
+// SetLatency sets the distribution of the latency of an RPC, nil for none. The
+// RPC fails with DEADLINE_EXCEEDED if its context expires before the latency
+// has elapsed.
+func (s *Script) SetLatency(method string, latency func() time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if latency == nil {
+		delete(s.latencies, method)
+		return
+	}
+	s.latencies[method] = latency
+}
+
+// SetError makes an RPC fail with the given error, or succeed again if err is
+// nil. Use vterrors to set the code of the error.
+func (s *Script) SetError(method string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		delete(s.errors, method)
+		return
+	}
+	s.errors[method] = err
+}
+
+// SetResponse sets the function that returns the response of an RPC, or
+// restores the default response if fn is nil. The function is not called for
+// the RPCs that fail with the error set by SetError.
+func (s *Script) SetResponse(method string, fn ResponseFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if fn == nil {
+		delete(s.responses, method)
+		return
+	}
+	s.responses[method] = fn
+}
+
+// Partition simulates a network partition between the clients and the given
+// tablets: their RPCs hang until their context expires, and then fail with
+// UNAVAILABLE, unless the partition is healed before that.
+func (s *Script) Partition(aliases ...*topodatapb.TabletAlias) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, alias := range aliases {
+		key := topoproto.TabletAliasString(alias)
+		if _, ok := s.partitioned[key]; !ok {
+			s.partitioned[key] = make(chan struct{})
+		}
+	}
+}
+
+// Heal ends the partition of the given tablets, and lets their pending RPCs
+// proceed.
+func (s *Script) Heal(aliases ...*topodatapb.TabletAlias) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, alias := range aliases {
+		key := topoproto.TabletAliasString(alias)
+		if healed, ok := s.partitioned[key]; ok {
+			close(healed)
+			delete(s.partitioned, key)
+		}
+	}
+}
+
+// Calls returns the number of times an RPC was sent to the given tablet, or to
+// any tablet if alias is nil, since the script was created or reset.
+func (s *Script) Calls(method string, alias *topodatapb.TabletAlias) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if alias == nil {
+		return s.calls[method]
+	}
+	return s.calls[method+"@"+topoproto.TabletAliasString(alias)]
+}
+
+// behavior is the scripted behavior of an RPC sent to a tablet.
+type behavior struct {
+	latency     func() time.Duration
+	partitioned chan struct{}
+	err         error
+	respond     ResponseFunc
+}
+
+// lookup returns the behavior of an RPC sent to the tablet, and records the call.
+func (s *Script) lookup(method string, tablet *topodatapb.Tablet) behavior {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	alias := topoproto.TabletAliasString(tablet.Alias)
+	s.calls[method]++
+	s.calls[method+"@"+alias]++
+
+	b := behavior{partitioned: s.partitioned[alias]}
+	var ok bool
+	if b.latency, ok = s.latencies[method]; !ok {
+		b.latency = s.latencies[AnyMethod]
+	}
+	if b.err, ok = s.errors[method]; !ok {
+		b.err = s.errors[AnyMethod]
+	}
+	if b.respond, ok = s.responses[method]; !ok {
+		b.respond = s.responses[AnyMethod]
+	}
+	return b
+}
+
+// run applies the script to an RPC, and returns its response, if any.
+func (s *Script) run(ctx context.Context, method string, tablet *topodatapb.Tablet, request proto.Message) (proto.Message, error) {
+	b := s.lookup(method, tablet)
+	if b.latency != nil {
+		timer := time.NewTimer(b.latency())
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return nil, vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "%s to tablet %s: %v", method, topoproto.TabletAliasString(tablet.Alias), ctx.Err())
+		}
+	}
+	if b.partitioned != nil {
+		select {
+		case <-b.partitioned:
+		case <-ctx.Done():
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "%s to tablet %s: tablet is unreachable: %v", method, topoproto.TabletAliasString(tablet.Alias), ctx.Err())
+		}
+	}
+	if b.err != nil {
+		return nil, b.err
+	}
+	if b.respond != nil {
+		return b.respond(ctx, tablet, request)
+	}
+	return nil, nil
+}
+
+// scriptableDialer implements dialer with connections that run the script
+// instead of sending the RPCs to the tablets.
+type scriptableDialer struct {
+	script  *Script
+	tracker *inflightTracker
+}
+
+var _ dialer = (*scriptableDialer)(nil)
+
+// NewScriptableClient returns a client whose RPCs behave as set in the script.
+func NewScriptableClient(script *Script) *Client {
+	tracker := &inflightTracker{}
+	return &Client{
+		dialer:  &scriptableDialer{script: script, tracker: tracker},
+		tracker: tracker,
+	}
+}
+
+func (d *scriptableDialer) dial(ctx context.Context, tablet *topodatapb.Tablet) (tabletmanagerservicepb.TabletManagerClient, io.Closer, error) {
+	if err := tmclient.CheckFailFast(ctx, tablet); err != nil {
+		return nil, nil, err
+	}
+	conn := &scriptableConn{dialer: d, tablet: tablet}
+	return tabletmanagerservicepb.NewTabletManagerClient(conn), conn, nil
+}
+
+func (d *scriptableDialer) Close() {}
+
+// scriptableConn implements grpc.ClientConnInterface for a tablet.
+type scriptableConn struct {
+	dialer *scriptableDialer
+	tablet *topodatapb.Tablet
+}
+
+func (conn *scriptableConn) Close() error {
+	return nil
+}
+
+// Invoke is part of the grpc.ClientConnInterface interface.
+func (conn *scriptableConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	if err := conn.dialer.tracker.begin(); err != nil {
+		return err
+	}
+	defer conn.dialer.tracker.done()
+
+	name := path.Base(method)
+	response, err := conn.dialer.script.run(ctx, name, conn.tablet, args.(proto.Message))
+	if err != nil {
+		return err
+	}
+	if response != nil {
+		proto.Merge(reply.(proto.Message), response)
+		return nil
+	}
+	if req, ok := args.(*tabletmanagerdatapb.PingRequest); ok {
+		reply.(*tabletmanagerdatapb.PingResponse).Payload = req.Payload
+	}
+	return nil
+}
+
+// NewStream is part of the grpc.ClientConnInterface interface. The scripted
+// streams end without sending anything.
+func (conn *scriptableConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := conn.dialer.tracker.begin(); err != nil {
+		return nil, err
+	}
+	defer conn.dialer.tracker.done()
+
+	return &scriptableStream{ctx: ctx, conn: conn, method: path.Base(method)}, nil
+}
+
+// scriptableStream runs the script when the request of the RPC is sent.
+type scriptableStream struct {
+	ctx    context.Context
+	conn   *scriptableConn
+	method string
+	err    error
+}
+
+func (s *scriptableStream) Header() (metadata.MD, error) { return nil, nil }
+func (s *scriptableStream) Trailer() metadata.MD         { return nil }
+func (s *scriptableStream) CloseSend() error             { return nil }
+func (s *scriptableStream) Context() context.Context     { return s.ctx }
+
+func (s *scriptableStream) SendMsg(m any) error {
+	_, s.err = s.conn.dialer.script.run(s.ctx, s.method, s.conn.tablet, m.(proto.Message))
+	return nil
+}
+
+func (s *scriptableStream) RecvMsg(m any) error {
+	if s.err != nil {
+		return s.err
+	}
+	return io.EOF
+}
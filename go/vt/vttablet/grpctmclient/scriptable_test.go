@@ -0,0 +1,160 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestScriptableClient(t *testing.T) {
+	ctx := context.Background()
+	primary := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}}
+	replica := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 101}}
+
+	script := NewScript()
+	client := NewScriptableClient(script)
+	defer client.Close()
+
+	// by default the RPCs succeed
+	require.NoError(t, client.Ping(ctx, primary))
+	pos, err := client.PrimaryPosition(ctx, primary)
+	require.NoError(t, err)
+	assert.Empty(t, pos)
+
+	// scripted responses
+	script.SetResponse("PrimaryPosition", func(ctx context.Context, tablet *topodatapb.Tablet, request proto.Message) (proto.Message, error) {
+		return &tabletmanagerdatapb.PrimaryPositionResponse{Position: "pos-" + tablet.Alias.Cell}, nil
+	})
+	pos, err = client.PrimaryPosition(ctx, primary)
+	require.NoError(t, err)
+	assert.Equal(t, "pos-zone1", pos)
+
+	// scripted errors, for a single RPC or for all of them
+	script.SetError("SetReadOnly", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "read only failed"))
+	err = client.SetReadOnly(ctx, primary)
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+	assert.ErrorContains(t, err, "read only failed")
+	require.NoError(t, client.SetReadWrite(ctx, primary))
+
+	script.SetError(AnyMethod, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "boom"))
+	assert.Equal(t, vtrpcpb.Code_INTERNAL, vterrors.Code(client.SetReadWrite(ctx, primary)))
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(client.SetReadOnly(ctx, primary)))
+	script.SetError(AnyMethod, nil)
+	script.SetError("SetReadOnly", nil)
+	require.NoError(t, client.SetReadOnly(ctx, primary))
+
+	// the streams fail with the scripted error, or end without any message
+	stream, err := client.StreamExecuteHook(ctx, primary, &hook.Hook{Name: "test"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+	script.SetError("StreamExecuteHook", vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no hooks"))
+	stream, err = client.StreamExecuteHook(ctx, primary, &hook.Hook{Name: "test"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+
+	assert.Equal(t, 2, script.Calls("PrimaryPosition", nil))
+	assert.Equal(t, 3, script.Calls("SetReadOnly", primary.Alias))
+	assert.Equal(t, 0, script.Calls("SetReadOnly", replica.Alias))
+
+	script.Reset()
+	assert.Equal(t, 0, script.Calls("PrimaryPosition", nil))
+	pos, err = client.PrimaryPosition(ctx, primary)
+	require.NoError(t, err)
+	assert.Empty(t, pos)
+}
+
+func TestScriptableClientLatency(t *testing.T) {
+	tablet := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}}
+	script := NewScript()
+	client := NewScriptableClient(script)
+	defer client.Close()
+
+	script.SetLatency("Ping", UniformLatency(20*time.Millisecond, 30*time.Millisecond))
+	start := time.Now()
+	require.NoError(t, client.Ping(context.Background(), tablet))
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	script.SetLatency(AnyMethod, FixedLatency(time.Hour))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := client.SetReadOnly(ctx, tablet)
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+}
+
+func TestScriptableClientPartition(t *testing.T) {
+	primary := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}}
+	replica := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 101}}
+	script := NewScript()
+	client := NewScriptableClient(script)
+	defer client.Close()
+
+	script.Partition(primary.Alias)
+
+	// the RPCs to the partitioned tablet time out, the others succeed
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := client.Ping(ctx, primary)
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+	require.NoError(t, client.Ping(context.Background(), replica))
+
+	// healing the partition lets the pending RPCs proceed
+	done := make(chan error)
+	go func() {
+		done <- client.Ping(context.Background(), primary)
+	}()
+	assert.Eventually(t, func() bool {
+		return script.Calls("Ping", primary.Alias) == 2
+	}, 5*time.Second, time.Millisecond)
+	script.Heal(primary.Alias)
+	require.NoError(t, <-done)
+}
+
+func TestScriptableProtocol(t *testing.T) {
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	tmclient.RegisterFlags(fs)
+	require.NoError(t, fs.Parse([]string{"--tablet_manager_protocol", "fake-scriptable"}))
+	defer func() {
+		require.NoError(t, fs.Set("tablet_manager_protocol", "grpc"))
+	}()
+
+	tablet := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}}
+	DefaultScript.SetError("Ping", vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "down"))
+	defer DefaultScript.Reset()
+
+	client := tmclient.NewTabletManagerClient()
+	defer client.Close()
+	err := client.Ping(context.Background(), tablet)
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"strconv"
+	"strings"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	// serverNameTag is the tablet tag that holds the server name to use to
+	// validate the certificate of that tablet.
+	serverNameTag string
+	// serverNameTemplate is used to build the server name to use to validate
+	// the certificate of a tablet, when it doesn't have a serverNameTag.
+	serverNameTemplate string
+)
+
+// tabletServerName returns the server name to use to validate the certificate
+// of the given tablet. Deployments that issue certificates per pod DNS name
+// rather than for the hostname stored in the topo can override the name of
+// each tablet with a tag, or build it from a template. Otherwise the static
+// --tablet_manager_grpc_server_name is used.
+func tabletServerName(tablet *topodatapb.Tablet) string {
+	if serverNameTag != "" {
+		if override := tablet.GetTags()[serverNameTag]; override != "" {
+			return override
+		}
+	}
+	if serverNameTemplate != "" {
+		return expandServerNameTemplate(serverNameTemplate, tablet)
+	}
+	return name
+}
+
+// expandServerNameTemplate replaces the {cell}, {uid}, {hostname}, {keyspace}
+// and {shard} placeholders of the template with the values of the tablet.
+func expandServerNameTemplate(template string, tablet *topodatapb.Tablet) string {
+	var cell, uid string
+	if alias := tablet.GetAlias(); alias != nil {
+		cell = alias.Cell
+		uid = strconv.FormatUint(uint64(alias.Uid), 10)
+	}
+
+	r := strings.NewReplacer(
+		"{cell}", cell,
+		"{uid}", uid,
+		"{hostname}", tabletHost(tablet),
+		"{keyspace}", tablet.GetKeyspace(),
+		"{shard}", tablet.GetShard(),
+	)
+	return r.Replace(template)
+}
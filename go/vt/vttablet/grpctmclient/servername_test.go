@@ -0,0 +1,121 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctmclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestTabletServerName(t *testing.T) {
+	oldName, oldTag, oldTemplate := name, serverNameTag, serverNameTemplate
+	defer func() {
+		name, serverNameTag, serverNameTemplate = oldName, oldTag, oldTemplate
+	}()
+
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+		Hostname: "10.0.0.1",
+		Keyspace: "commerce",
+		Shard:    "-80",
+		Tags:     map[string]string{"tls_name": "pod-101.tablets.svc"},
+	}
+	untagged := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 102},
+		Hostname: "10.0.0.2",
+		Keyspace: "commerce",
+		Shard:    "-80",
+	}
+
+	tcs := []struct {
+		name     string
+		static   string
+		tag      string
+		template string
+		tablet   *topodatapb.Tablet
+		expected string
+	}{
+		{
+			name:     "no override",
+			tablet:   tablet,
+			expected: "",
+		},
+		{
+			name:     "static name",
+			static:   "vttablet",
+			tablet:   tablet,
+			expected: "vttablet",
+		},
+		{
+			name:     "tag",
+			static:   "vttablet",
+			tag:      "tls_name",
+			tablet:   tablet,
+			expected: "pod-101.tablets.svc",
+		},
+		{
+			name:     "missing tag falls back to static name",
+			static:   "vttablet",
+			tag:      "tls_name",
+			tablet:   untagged,
+			expected: "vttablet",
+		},
+		{
+			name:     "template",
+			static:   "vttablet",
+			template: "{uid}.tablets.svc",
+			tablet:   tablet,
+			expected: "101.tablets.svc",
+		},
+		{
+			name:     "tag takes precedence over template",
+			tag:      "tls_name",
+			template: "{uid}.tablets.svc",
+			tablet:   tablet,
+			expected: "pod-101.tablets.svc",
+		},
+		{
+			name:     "missing tag falls back to template",
+			tag:      "tls_name",
+			template: "{cell}-{uid}.{keyspace}.{shard}.svc",
+			tablet:   untagged,
+			expected: "zone1-102.commerce.-80.svc",
+		},
+		{
+			name:     "hostname",
+			template: "{hostname}.nip.io",
+			tablet:   untagged,
+			expected: "10.0.0.2.nip.io",
+		},
+		{
+			name:     "bracketed IPv6 hostname",
+			template: "{hostname}",
+			tablet:   &topodatapb.Tablet{Hostname: "[2001:db8::1]"},
+			expected: "2001:db8::1",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			name, serverNameTag, serverNameTemplate = tc.static, tc.tag, tc.template
+			assert.Equal(t, tc.expected, tabletServerName(tc.tablet))
+		})
+	}
+}
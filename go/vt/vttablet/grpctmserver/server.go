@@ -18,10 +18,12 @@ package grpctmserver
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc"
 
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/hook"
@@ -30,6 +32,7 @@ import (
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -61,6 +64,14 @@ func (s *server) Sleep(ctx context.Context, request *tabletmanagerdatapb.SleepRe
 	return response, nil
 }
 
+func (s *server) NegotiateAPILevel(ctx context.Context, request *tabletmanagerdatapb.NegotiateAPILevelRequest) (response *tabletmanagerdatapb.NegotiateAPILevelResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "NegotiateAPILevel", request, response, false /*verbose*/, &err)
+	response = &tabletmanagerdatapb.NegotiateAPILevelResponse{
+		ApiLevel: tmclient.CurrentAPILevel,
+	}
+	return response, nil
+}
+
 func (s *server) ExecuteHook(ctx context.Context, request *tabletmanagerdatapb.ExecuteHookRequest) (response *tabletmanagerdatapb.ExecuteHookResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "ExecuteHook", request, response, true /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -76,6 +87,48 @@ func (s *server) ExecuteHook(ctx context.Context, request *tabletmanagerdatapb.E
 	return response, nil
 }
 
+// hookOutputWriter sends what the hook writes to its stdout or stderr
+// back to the caller.
+type hookOutputWriter struct {
+	mu     *sync.Mutex
+	stream tabletmanagerservicepb.TabletManager_StreamExecuteHookServer
+	stderr bool
+}
+
+func (w *hookOutputWriter) Write(p []byte) (int, error) {
+	response := &tabletmanagerdatapb.StreamExecuteHookResponse{}
+	if w.stderr {
+		response.Stderr = string(p)
+	} else {
+		response.Stdout = string(p)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.stream.Send(response); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *server) StreamExecuteHook(request *tabletmanagerdatapb.ExecuteHookRequest, stream tabletmanagerservicepb.TabletManager_StreamExecuteHookServer) (err error) {
+	ctx := stream.Context()
+	defer s.tm.HandleRPCPanic(ctx, "StreamExecuteHook", request, nil, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+
+	// The hook writes to stdout and stderr concurrently, and a gRPC
+	// stream doesn't support concurrent sends.
+	var mu sync.Mutex
+	hr := s.tm.StreamExecuteHook(ctx, &hook.Hook{
+		Name:       request.Name,
+		Parameters: request.Parameters,
+		ExtraEnv:   request.ExtraEnv,
+	}, &hookOutputWriter{mu: &mu, stream: stream}, &hookOutputWriter{mu: &mu, stream: stream, stderr: true})
+	return stream.Send(&tabletmanagerdatapb.StreamExecuteHookResponse{
+		Finished:   true,
+		ExitStatus: int64(hr.ExitStatus),
+	})
+}
+
 func (s *server) GetSchema(ctx context.Context, request *tabletmanagerdatapb.GetSchemaRequest) (response *tabletmanagerdatapb.GetSchemaResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "GetSchema", request, response, false /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -87,6 +140,21 @@ func (s *server) GetSchema(ctx context.Context, request *tabletmanagerdatapb.Get
 	return response, err
 }
 
+func (s *server) GetSchemaHash(ctx context.Context, request *tabletmanagerdatapb.GetSchemaHashRequest) (response *tabletmanagerdatapb.GetSchemaHashResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetSchemaHash", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetSchemaHashResponse{}
+	response.SchemaHash, err = s.tm.GetSchemaHash(ctx, request)
+	return response, err
+}
+
+func (s *server) StreamSchemaChanges(request *tabletmanagerdatapb.StreamSchemaChangesRequest, stream tabletmanagerservicepb.TabletManager_StreamSchemaChangesServer) (err error) {
+	ctx := stream.Context()
+	defer s.tm.HandleRPCPanic(ctx, "StreamSchemaChanges", request, nil, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.StreamSchemaChanges(ctx, request, stream.Send)
+}
+
 func (s *server) GetPermissions(ctx context.Context, request *tabletmanagerdatapb.GetPermissionsRequest) (response *tabletmanagerdatapb.GetPermissionsResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "GetPermissions", request, response, false /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -109,6 +177,13 @@ func (s *server) GetGlobalStatusVars(ctx context.Context, request *tabletmanager
 	return response, err
 }
 
+func (s *server) StreamTabletEvents(request *tabletmanagerdatapb.StreamTabletEventsRequest, stream tabletmanagerservicepb.TabletManager_StreamTabletEventsServer) (err error) {
+	ctx := stream.Context()
+	defer s.tm.HandleRPCPanic(ctx, "StreamTabletEvents", request, nil, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.StreamTabletEvents(ctx, request, stream.Send)
+}
+
 //
 // Various read-write methods
 //
@@ -149,6 +224,87 @@ func (s *server) RunHealthCheck(ctx context.Context, request *tabletmanagerdatap
 	return response, nil
 }
 
+func (s *server) PrepareShutdown(ctx context.Context, request *tabletmanagerdatapb.PrepareShutdownRequest) (response *tabletmanagerdatapb.PrepareShutdownResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "PrepareShutdown", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	ready, position, err := s.tm.PrepareShutdown(ctx, request.AllowPrimary)
+	if err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.PrepareShutdownResponse{
+		Ready:    ready,
+		Position: position,
+	}, nil
+}
+
+func (s *server) GracefulRestart(ctx context.Context, request *tabletmanagerdatapb.GracefulRestartRequest) (response *tabletmanagerdatapb.GracefulRestartResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GracefulRestart", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	position, err := s.tm.GracefulRestart(ctx, time.Duration(request.ShutdownTimeoutSeconds)*time.Second, request.ReinitConfig, request.MysqldArgs, request.AllowPrimary)
+	if err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.GracefulRestartResponse{
+		Position: position,
+	}, nil
+}
+
+func (s *server) PauseTableGC(ctx context.Context, request *tabletmanagerdatapb.PauseTableGCRequest) (response *tabletmanagerdatapb.PauseTableGCResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "PauseTableGC", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	expiresAt, err := s.tm.PauseTableGC(ctx, request.Requester, time.Duration(request.DurationSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.PauseTableGCResponse{
+		ExpiresAt: protoutil.TimeToProto(expiresAt),
+	}, nil
+}
+
+func (s *server) ResumeTableGC(ctx context.Context, request *tabletmanagerdatapb.ResumeTableGCRequest) (response *tabletmanagerdatapb.ResumeTableGCResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "ResumeTableGC", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ResumeTableGCResponse{}
+	return response, s.tm.ResumeTableGC(ctx, request.Requester)
+}
+
+func (s *server) RestoreGCTable(ctx context.Context, request *tabletmanagerdatapb.RestoreGCTableRequest) (response *tabletmanagerdatapb.RestoreGCTableResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "RestoreGCTable", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	tableName, err := s.tm.RestoreGCTable(ctx, request.TableName, request.RestoreAs)
+	if err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.RestoreGCTableResponse{
+		TableName: tableName,
+	}, nil
+}
+
+func (s *server) GetMysqlVariables(ctx context.Context, request *tabletmanagerdatapb.GetMysqlVariablesRequest) (response *tabletmanagerdatapb.GetMysqlVariablesResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetMysqlVariables", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	variables, err := s.tm.GetMysqlVariables(ctx, request.Names)
+	if err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.GetMysqlVariablesResponse{
+		Variables: variables,
+	}, nil
+}
+
+func (s *server) SetMysqlVariable(ctx context.Context, request *tabletmanagerdatapb.SetMysqlVariableRequest) (response *tabletmanagerdatapb.SetMysqlVariableResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "SetMysqlVariable", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	previous, value, err := s.tm.SetMysqlVariable(ctx, request.Name, request.Value, request.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.SetMysqlVariableResponse{
+		PreviousValue: previous,
+		Value:         value,
+	}, nil
+}
+
 func (s *server) ReloadSchema(ctx context.Context, request *tabletmanagerdatapb.ReloadSchemaRequest) (response *tabletmanagerdatapb.ReloadSchemaResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "ReloadSchema", request, response, false /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -210,6 +366,26 @@ func (s *server) UnlockTables(ctx context.Context, req *tabletmanagerdatapb.Unlo
 	return &tabletmanagerdatapb.UnlockTablesResponse{}, nil
 }
 
+func (s *server) AcquireConsistentSnapshot(ctx context.Context, request *tabletmanagerdatapb.AcquireConsistentSnapshotRequest) (response *tabletmanagerdatapb.AcquireConsistentSnapshotResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "AcquireConsistentSnapshot", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	id, position, err := s.tm.AcquireConsistentSnapshot(ctx, time.Duration(request.LeaseSeconds)*time.Second, request.AllowPrimary)
+	if err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.AcquireConsistentSnapshotResponse{
+		SnapshotId: id,
+		Position:   position,
+	}, nil
+}
+
+func (s *server) ReleaseConsistentSnapshot(ctx context.Context, request *tabletmanagerdatapb.ReleaseConsistentSnapshotRequest) (response *tabletmanagerdatapb.ReleaseConsistentSnapshotResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "ReleaseConsistentSnapshot", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ReleaseConsistentSnapshotResponse{}
+	return response, s.tm.ReleaseConsistentSnapshot(ctx, request.SnapshotId)
+}
+
 func (s *server) ExecuteQuery(ctx context.Context, request *tabletmanagerdatapb.ExecuteQueryRequest) (response *tabletmanagerdatapb.ExecuteQueryResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "ExecuteQuery", request, response, false /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -231,6 +407,14 @@ func (s *server) ExecuteFetchAsDba(ctx context.Context, request *tabletmanagerda
 	defer s.tm.HandleRPCPanic(ctx, "ExecuteFetchAsDba", request, response, false /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.ExecuteFetchAsDbaResponse{}
+	if request.Async {
+		jobID, err := s.tm.ExecuteFetchAsDbaAsync(ctx, request)
+		if err != nil {
+			return nil, vterrors.ToGRPC(err)
+		}
+		response.JobId = jobID
+		return response, nil
+	}
 	qr, err := s.tm.ExecuteFetchAsDba(ctx, request)
 	if err != nil {
 		return nil, vterrors.ToGRPC(err)
@@ -239,6 +423,35 @@ func (s *server) ExecuteFetchAsDba(ctx context.Context, request *tabletmanagerda
 	return response, nil
 }
 
+func (s *server) PollFetchJob(ctx context.Context, request *tabletmanagerdatapb.PollFetchJobRequest) (response *tabletmanagerdatapb.PollFetchJobResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "PollFetchJob", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response, err = s.tm.PollFetchJob(ctx, request)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return response, nil
+}
+
+func (s *server) CancelFetchJob(ctx context.Context, request *tabletmanagerdatapb.CancelFetchJobRequest) (response *tabletmanagerdatapb.CancelFetchJobResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "CancelFetchJob", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response, err = s.tm.CancelFetchJob(ctx, request)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return response, nil
+}
+
+func (s *server) StreamExecuteFetchAsDba(request *tabletmanagerdatapb.StreamExecuteFetchAsDbaRequest, stream tabletmanagerservicepb.TabletManager_StreamExecuteFetchAsDbaServer) (err error) {
+	ctx := stream.Context()
+	defer s.tm.HandleRPCPanic(ctx, "StreamExecuteFetchAsDba", request, nil, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.StreamExecuteFetchAsDba(ctx, request, func(result *querypb.QueryResult) error {
+		return stream.Send(&tabletmanagerdatapb.StreamExecuteFetchAsDbaResponse{Result: result})
+	})
+}
+
 func (s *server) ExecuteMultiFetchAsDba(ctx context.Context, request *tabletmanagerdatapb.ExecuteMultiFetchAsDbaRequest) (response *tabletmanagerdatapb.ExecuteMultiFetchAsDbaResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "ExecuteFetchAsDba", request, response, false /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -301,6 +514,12 @@ func (s *server) FullStatus(ctx context.Context, request *tabletmanagerdatapb.Fu
 	return response, err
 }
 
+func (s *server) SemiSyncStatus(ctx context.Context, request *tabletmanagerdatapb.SemiSyncStatusRequest) (response *tabletmanagerdatapb.SemiSyncStatusResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "SemiSyncStatus", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.SemiSyncStatus(ctx)
+}
+
 func (s *server) PrimaryStatus(ctx context.Context, request *tabletmanagerdatapb.PrimaryStatusRequest) (response *tabletmanagerdatapb.PrimaryStatusResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "PrimaryStatus", request, response, false /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -330,6 +549,13 @@ func (s *server) WaitForPosition(ctx context.Context, request *tabletmanagerdata
 	return response, s.tm.WaitForPosition(ctx, request.Position)
 }
 
+func (s *server) StreamWaitForPosition(request *tabletmanagerdatapb.StreamWaitForPositionRequest, stream tabletmanagerservicepb.TabletManager_StreamWaitForPositionServer) (err error) {
+	ctx := stream.Context()
+	defer s.tm.HandleRPCPanic(ctx, "StreamWaitForPosition", request, nil, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.StreamWaitForPosition(ctx, request, stream.Send)
+}
+
 func (s *server) StopReplication(ctx context.Context, request *tabletmanagerdatapb.StopReplicationRequest) (response *tabletmanagerdatapb.StopReplicationResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "StopReplication", request, response, true /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -373,6 +599,18 @@ func (s *server) GetReplicas(ctx context.Context, request *tabletmanagerdatapb.G
 	return response, err
 }
 
+func (s *server) FlushBinaryLogs(ctx context.Context, request *tabletmanagerdatapb.FlushBinaryLogsRequest) (response *tabletmanagerdatapb.FlushBinaryLogsResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "FlushBinaryLogs", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.FlushBinaryLogs(ctx)
+}
+
+func (s *server) PurgeBinaryLogs(ctx context.Context, request *tabletmanagerdatapb.PurgeBinaryLogsRequest) (response *tabletmanagerdatapb.PurgeBinaryLogsResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "PurgeBinaryLogs", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.PurgeBinaryLogs(ctx, request)
+}
+
 //
 // VReplication related methods
 //
@@ -448,6 +686,24 @@ func (s *server) VDiff(ctx context.Context, request *tabletmanagerdatapb.VDiffRe
 	return response, err
 }
 
+func (s *server) CreateVDiff(ctx context.Context, request *tabletmanagerdatapb.CreateVDiffRequest) (response *tabletmanagerdatapb.CreateVDiffResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "CreateVDiff", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.CreateVDiff(ctx, request)
+}
+
+func (s *server) ShowVDiff(ctx context.Context, request *tabletmanagerdatapb.ShowVDiffRequest) (response *tabletmanagerdatapb.ShowVDiffResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "ShowVDiff", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.ShowVDiff(ctx, request)
+}
+
+func (s *server) StopVDiff(ctx context.Context, request *tabletmanagerdatapb.StopVDiffRequest) (response *tabletmanagerdatapb.StopVDiffResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "StopVDiff", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.StopVDiff(ctx, request)
+}
+
 //
 // Reparenting related functions
 //
@@ -488,7 +744,7 @@ func (s *server) DemotePrimary(ctx context.Context, request *tabletmanagerdatapb
 	defer s.tm.HandleRPCPanic(ctx, "DemotePrimary", request, response, true /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.DemotePrimaryResponse{}
-	status, err := s.tm.DemotePrimary(ctx)
+	status, err := s.tm.DemotePrimary(ctx, request.GetHandoff())
 	if err == nil {
 		response.PrimaryStatus = status
 	}
@@ -546,7 +802,7 @@ func (s *server) PromoteReplica(ctx context.Context, request *tabletmanagerdatap
 	defer s.tm.HandleRPCPanic(ctx, "PromoteReplica", request, response, true /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.PromoteReplicaResponse{}
-	position, err := s.tm.PromoteReplica(ctx, request.GetSemiSync())
+	position, err := s.tm.PromoteReplica(ctx, request.GetSemiSync(), request.GetHandoff())
 	if err == nil {
 		response.Position = position
 	}
@@ -97,7 +97,7 @@ var (
 	maxConcurrentOnlineDDLs = 256
 
 	migrationNextCheckIntervals = []time.Duration{1 * time.Second, 5 * time.Second, 10 * time.Second, 20 * time.Second}
-	maxConstraintNameLength     = 64
+	maxConstraintNameLength     = sqlparser.MaxIdentifierLength
 	cutoverIntervals            = []time.Duration{0, 1 * time.Minute, 5 * time.Minute, 10 * time.Minute, 30 * time.Minute}
 )
 
@@ -1318,6 +1318,9 @@ func (e *Executor) validateAndEditCreateTableStatement(onlineDDL *schema.OnlineD
 		case *sqlparser.ConstraintDefinition:
 			oldName := node.Name.String()
 			newName := e.newConstraintName(onlineDDL, GetConstraintType(node.Details), hashExists, sqlparser.CanonicalString(node.Details), oldName)
+			if err := sqlparser.ValidateIdentifier(newName); err != nil {
+				return false, err
+			}
 			node.Name = sqlparser.NewIdentifierCI(newName)
 			constraintMap[oldName] = newName
 		}
@@ -801,8 +801,8 @@ func getSingleRowResult() *sqltypes.Result {
 // SingleRowResult is returned when there is no pre-stored result.
 var SingleRowResult = &sqltypes.Result{
 	Fields: []*querypb.Field{
-		{Name: "id", Type: sqltypes.Int32, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
-		{Name: "value", Type: sqltypes.VarChar, Charset: collations.CollationUtf8mb4ID},
+		{Name: "id", Type: sqltypes.Int32, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
+		{Name: "value", Type: sqltypes.VarChar, Charset: uint32(collations.CollationUtf8mb4ID)},
 	},
 	InsertID: 0,
 	Rows: [][]sqltypes.Value{{
@@ -815,8 +815,8 @@ var SingleRowResult = &sqltypes.Result{
 // StreamRowResult is SingleRowResult with RowsAffected set to 0.
 var StreamRowResult = &sqltypes.Result{
 	Fields: []*querypb.Field{
-		{Name: "id", Type: sqltypes.Int32, Charset: collations.CollationBinaryID, Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
-		{Name: "value", Type: sqltypes.VarChar, Charset: collations.CollationUtf8mb4ID},
+		{Name: "id", Type: sqltypes.Int32, Charset: uint32(collations.CollationBinaryID), Flags: uint32(querypb.MySqlFlag_NUM_FLAG)},
+		{Name: "value", Type: sqltypes.VarChar, Charset: uint32(collations.CollationUtf8mb4ID)},
 	},
 	Rows: [][]sqltypes.Value{{
 		sqltypes.NewInt32(1),
@@ -399,6 +399,9 @@ func newFakeTMClient() *fakeTMClient {
 }
 
 // Note: ONLY breaks up change.SQL into individual statements and executes it. Does NOT fully implement ApplySchema.
+// Close is a no-op, the fake client is shared by the tablets of the test.
+func (tmc *fakeTMClient) Close() {}
+
 func (tmc *fakeTMClient) ApplySchema(ctx context.Context, tablet *topodatapb.Tablet, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error) {
 	stmts := strings.Split(change.SQL, ";")
 
@@ -19,6 +19,7 @@ package tabletmanager
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"vitess.io/vitess/go/mysql"
@@ -139,6 +140,21 @@ func (tm *TabletManager) ExecuteHook(ctx context.Context, hk *hook.Hook) *hook.H
 	return hk.Execute()
 }
 
+// StreamExecuteHook executes the provided hook locally, and writes its output to
+// stdout and stderr while it runs. Unlike ExecuteHook, the hook is killed if ctx
+// is canceled, as nobody is watching its output anymore.
+func (tm *TabletManager) StreamExecuteHook(ctx context.Context, hk *hook.Hook, stdout, stderr io.Writer) *hook.HookResult {
+	if err := tm.lock(ctx); err != nil {
+		// client gave up
+		return &hook.HookResult{}
+	}
+	defer tm.unlock()
+
+	// Execute the hooks
+	topotools.ConfigureTabletHook(hk, tm.tabletAlias)
+	return hk.ExecuteStreaming(ctx, stdout, stderr)
+}
+
 // RefreshState reload the tablet record from the topo server.
 func (tm *TabletManager) RefreshState(ctx context.Context) error {
 	if err := tm.lock(ctx); err != nil {
@@ -18,6 +18,7 @@ package tabletmanager
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"vitess.io/vitess/go/vt/hook"
@@ -41,12 +42,18 @@ type RPCTM interface {
 
 	GetSchema(ctx context.Context, request *tabletmanagerdatapb.GetSchemaRequest) (*tabletmanagerdatapb.SchemaDefinition, error)
 
+	GetSchemaHash(ctx context.Context, request *tabletmanagerdatapb.GetSchemaHashRequest) (string, error)
+
+	StreamSchemaChanges(ctx context.Context, request *tabletmanagerdatapb.StreamSchemaChangesRequest, send func(*tabletmanagerdatapb.StreamSchemaChangesResponse) error) error
+
 	GetPermissions(ctx context.Context) (*tabletmanagerdatapb.Permissions, error)
 
 	// GetGlobalStatusVars returns the server's global status variables asked for.
 	// An empty/nil variable name parameter slice means you want all of them.
 	GetGlobalStatusVars(ctx context.Context, variables []string) (map[string]string, error)
 
+	StreamTabletEvents(ctx context.Context, request *tabletmanagerdatapb.StreamTabletEventsRequest, send func(*tabletmanagerdatapb.StreamTabletEventsResponse) error) error
+
 	// Various read-write methods
 
 	SetReadOnly(ctx context.Context, rdonly bool) error
@@ -57,10 +64,26 @@ type RPCTM interface {
 
 	ExecuteHook(ctx context.Context, hk *hook.Hook) *hook.HookResult
 
+	StreamExecuteHook(ctx context.Context, hk *hook.Hook, stdout, stderr io.Writer) *hook.HookResult
+
 	RefreshState(ctx context.Context) error
 
 	RunHealthCheck(ctx context.Context)
 
+	PrepareShutdown(ctx context.Context, allowPrimary bool) (bool, string, error)
+
+	GracefulRestart(ctx context.Context, shutdownTimeout time.Duration, reinitConfig bool, mysqldArgs []string, allowPrimary bool) (string, error)
+
+	PauseTableGC(ctx context.Context, requester string, duration time.Duration) (time.Time, error)
+
+	ResumeTableGC(ctx context.Context, requester string) error
+
+	RestoreGCTable(ctx context.Context, tableName string, restoreAs string) (string, error)
+
+	GetMysqlVariables(ctx context.Context, names []string) ([]*tabletmanagerdatapb.MysqlVariable, error)
+
+	SetMysqlVariable(ctx context.Context, name, value, reason string) (string, string, error)
+
 	ReloadSchema(ctx context.Context, waitPosition string) error
 
 	PreflightSchema(ctx context.Context, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error)
@@ -73,10 +96,22 @@ type RPCTM interface {
 
 	UnlockTables(ctx context.Context) error
 
+	AcquireConsistentSnapshot(ctx context.Context, lease time.Duration, allowPrimary bool) (int64, string, error)
+
+	ReleaseConsistentSnapshot(ctx context.Context, id int64) error
+
 	ExecuteQuery(ctx context.Context, req *tabletmanagerdatapb.ExecuteQueryRequest) (*querypb.QueryResult, error)
 
 	ExecuteFetchAsDba(ctx context.Context, req *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (*querypb.QueryResult, error)
 
+	ExecuteFetchAsDbaAsync(ctx context.Context, req *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (string, error)
+
+	PollFetchJob(ctx context.Context, req *tabletmanagerdatapb.PollFetchJobRequest) (*tabletmanagerdatapb.PollFetchJobResponse, error)
+
+	CancelFetchJob(ctx context.Context, req *tabletmanagerdatapb.CancelFetchJobRequest) (*tabletmanagerdatapb.CancelFetchJobResponse, error)
+
+	StreamExecuteFetchAsDba(ctx context.Context, req *tabletmanagerdatapb.StreamExecuteFetchAsDbaRequest, send func(*querypb.QueryResult) error) error
+
 	ExecuteMultiFetchAsDba(ctx context.Context, req *tabletmanagerdatapb.ExecuteMultiFetchAsDbaRequest) ([]*querypb.QueryResult, error)
 
 	ExecuteFetchAsAllPrivs(ctx context.Context, req *tabletmanagerdatapb.ExecuteFetchAsAllPrivsRequest) (*querypb.QueryResult, error)
@@ -90,6 +125,8 @@ type RPCTM interface {
 
 	FullStatus(ctx context.Context) (*replicationdatapb.FullStatus, error)
 
+	SemiSyncStatus(ctx context.Context) (*tabletmanagerdatapb.SemiSyncStatusResponse, error)
+
 	StopReplication(ctx context.Context) error
 
 	StopReplicationMinimum(ctx context.Context, position string, waitTime time.Duration) (string, error)
@@ -100,10 +137,16 @@ type RPCTM interface {
 
 	GetReplicas(ctx context.Context) ([]string, error)
 
+	FlushBinaryLogs(ctx context.Context) (*tabletmanagerdatapb.FlushBinaryLogsResponse, error)
+
+	PurgeBinaryLogs(ctx context.Context, req *tabletmanagerdatapb.PurgeBinaryLogsRequest) (*tabletmanagerdatapb.PurgeBinaryLogsResponse, error)
+
 	PrimaryPosition(ctx context.Context) (string, error)
 
 	WaitForPosition(ctx context.Context, pos string) error
 
+	StreamWaitForPosition(ctx context.Context, req *tabletmanagerdatapb.StreamWaitForPositionRequest, send func(*tabletmanagerdatapb.StreamWaitForPositionResponse) error) error
+
 	// VReplication API
 	CreateVReplicationWorkflow(ctx context.Context, req *tabletmanagerdatapb.CreateVReplicationWorkflowRequest) (*tabletmanagerdatapb.CreateVReplicationWorkflowResponse, error)
 	DeleteVReplicationWorkflow(ctx context.Context, req *tabletmanagerdatapb.DeleteVReplicationWorkflowRequest) (*tabletmanagerdatapb.DeleteVReplicationWorkflowResponse, error)
@@ -117,6 +160,9 @@ type RPCTM interface {
 
 	// VDiff API
 	VDiff(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error)
+	CreateVDiff(ctx context.Context, req *tabletmanagerdatapb.CreateVDiffRequest) (*tabletmanagerdatapb.CreateVDiffResponse, error)
+	ShowVDiff(ctx context.Context, req *tabletmanagerdatapb.ShowVDiffRequest) (*tabletmanagerdatapb.ShowVDiffResponse, error)
+	StopVDiff(ctx context.Context, req *tabletmanagerdatapb.StopVDiffRequest) (*tabletmanagerdatapb.StopVDiffResponse, error)
 
 	// Reparenting related functions
 
@@ -128,7 +174,7 @@ type RPCTM interface {
 
 	InitReplica(ctx context.Context, parent *topodatapb.TabletAlias, replicationPosition string, timeCreatedNS int64, semiSync bool) error
 
-	DemotePrimary(ctx context.Context) (*replicationdatapb.PrimaryStatus, error)
+	DemotePrimary(ctx context.Context, handoff *tabletmanagerdatapb.PrimaryHandoff) (*replicationdatapb.PrimaryStatus, error)
 
 	UndoDemotePrimary(ctx context.Context, semiSync bool) error
 
@@ -142,7 +188,7 @@ type RPCTM interface {
 
 	ReplicaWasRestarted(ctx context.Context, parent *topodatapb.TabletAlias) error
 
-	PromoteReplica(ctx context.Context, semiSync bool) (string, error)
+	PromoteReplica(ctx context.Context, semiSync bool, handoff *tabletmanagerdatapb.PrimaryHandoff) (string, error)
 
 	// Backup / restore related methods
 
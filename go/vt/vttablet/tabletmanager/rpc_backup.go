@@ -29,6 +29,8 @@ import (
 	"vitess.io/vitess/go/vt/mysqlctl/backupstats"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/gc"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -37,6 +39,10 @@ import (
 const (
 	backupModeOnline  = "online"
 	backupModeOffline = "offline"
+
+	// tableGCBackupRequester is the requester of the table GC pause held during backups,
+	// followed by the alias of the tablet that takes the backup
+	tableGCBackupRequester = "backup"
 )
 
 // Backup takes a db backup and sends it to the BackupStorage.
@@ -79,6 +85,17 @@ func (tm *TabletManager) Backup(ctx context.Context, logger logutil.Logger, req
 	// Create the logger: tee to console and source.
 	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
 
+	// Keep the table garbage collector from purging, renaming or dropping tables
+	// while the backup copies the data. The collector runs on the primary of the
+	// shard, which replicates its changes to this tablet.
+	tmc := tmclient.NewTabletManagerClient()
+	defer tmc.Close()
+	resumeTableGC, err := gc.PauseShardPrimary(ctx, tm.TopoServer, tmc, tablet.Keyspace, tablet.Shard, tableGCBackupRequester+":"+topoproto.TabletAliasString(tablet.Alias))
+	if err != nil {
+		l.Warningf("Failed to pause table GC on the shard primary during backup: %v", err)
+	}
+	defer resumeTableGC()
+
 	var originalType topodatapb.TabletType
 	if engine.ShouldDrainForBackup(req) {
 		if err := tm.lock(ctx); err != nil {
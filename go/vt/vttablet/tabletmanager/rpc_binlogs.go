@@ -0,0 +1,307 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql/replication"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// FlushBinaryLogs closes the current binary log of mysqld and opens a new one,
+// which it returns.
+func (tm *TabletManager) FlushBinaryLogs(ctx context.Context) (*tabletmanagerdatapb.FlushBinaryLogsResponse, error) {
+	if err := tm.waitForGrantsToHaveApplied(ctx); err != nil {
+		return nil, err
+	}
+	if err := tm.MysqlDaemon.FlushBinaryLogs(ctx); err != nil {
+		return nil, err
+	}
+	binaryLogs, err := tm.MysqlDaemon.GetBinaryLogs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response := &tabletmanagerdatapb.FlushBinaryLogsResponse{}
+	if len(binaryLogs) > 0 {
+		response.BinaryLog = binaryLogs[len(binaryLogs)-1]
+	}
+	return response, nil
+}
+
+// PurgeBinaryLogs deletes the binary logs of mysqld that are before req.ToLog
+// and/or were last written to before req.Before. Unless req.Force is set, the
+// binary logs holding transactions that req.MinPosition or any of the
+// vreplication streams reading from the shard of the tablet, in any keyspace,
+// don't have yet are kept, and req.MinPosition is required if replicas are connected to mysqld.
+// The binary log in use is always kept, and nothing is purged while a backup
+// is running, since it may need the binary logs.
+func (tm *TabletManager) PurgeBinaryLogs(ctx context.Context, req *tabletmanagerdatapb.PurgeBinaryLogsRequest) (*tabletmanagerdatapb.PurgeBinaryLogsResponse, error) {
+	if req.ToLog == "" && req.Before == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "either a binary log or a time is required to purge binary logs")
+	}
+	if err := tm.waitForGrantsToHaveApplied(ctx); err != nil {
+		return nil, err
+	}
+	tm.mutex.Lock()
+	backupRunning := tm._isBackupRunning
+	tm.mutex.Unlock()
+	if backupRunning {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot purge binary logs while a backup is running on tablet %v", tm.tabletAlias)
+	}
+
+	binaryLogs, err := tm.MysqlDaemon.GetBinaryLogs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(binaryLogs) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "mysqld has no binary logs")
+	}
+
+	// keep is the index of the first binary log that is kept. The last one is
+	// the binary log in use.
+	keep := len(binaryLogs) - 1
+	if req.ToLog != "" {
+		i := slices.Index(binaryLogs, req.ToLog)
+		if i < 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unknown binary log: %v", req.ToLog)
+		}
+		keep = min(keep, i)
+	}
+	if req.Before != nil {
+		if keep, err = tm.binaryLogsWrittenBefore(binaryLogs, keep, protoutil.TimeFromProto(req.Before)); err != nil {
+			return nil, err
+		}
+	}
+	if !req.Force && keep > 0 {
+		if keep, err = tm.binaryLogsSafeToPurge(ctx, binaryLogs, keep, req.MinPosition); err != nil {
+			return nil, err
+		}
+	}
+
+	response := &tabletmanagerdatapb.PurgeBinaryLogsResponse{FirstBinaryLog: binaryLogs[keep]}
+	if keep == 0 {
+		return response, nil
+	}
+	log.Infof("PurgeBinaryLogs: purging binary logs %v to %v", binaryLogs[0], binaryLogs[keep-1])
+	if err := tm.MysqlDaemon.PurgeBinaryLogs(ctx, binaryLogs[keep]); err != nil {
+		return nil, err
+	}
+	response.PurgedBinaryLogs = binaryLogs[:keep]
+	return response, nil
+}
+
+// binaryLogsWrittenBefore returns the index of the first of the binary logs,
+// up to keep, that was last written to at or after the given time. Like MySQL
+// does for PURGE BINARY LOGS BEFORE, it relies on the modification time of the
+// files, so it requires mysqld to run on the host of the tablet: when it is
+// external, the tablet has no my.cnf and the binary logs aren't found.
+func (tm *TabletManager) binaryLogsWrittenBefore(binaryLogs []string, keep int, before time.Time) (int, error) {
+	if tm.Cnf == nil {
+		return 0, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot purge binary logs by time when mysqld is external to the tablet, since their modification times can't be read")
+	}
+	if tm.Cnf.BinLogPath == "" {
+		return 0, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot purge binary logs by time without the path of the binary logs")
+	}
+	dir := filepath.Dir(tm.Cnf.BinLogPath)
+	if _, err := os.Stat(filepath.Join(dir, binaryLogs[len(binaryLogs)-1])); errors.Is(err, fs.ErrNotExist) {
+		return 0, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot purge binary logs by time, binary log %v in use is not in %v: mysqld doesn't seem to run on the host of the tablet",
+			binaryLogs[len(binaryLogs)-1], dir)
+	}
+	for i, binaryLog := range binaryLogs[:keep] {
+		info, err := os.Stat(filepath.Join(dir, binaryLog))
+		if err != nil {
+			return 0, vterrors.Wrapf(err, "cannot read the modification time of binary log %v", binaryLog)
+		}
+		if !info.ModTime().Before(before) {
+			return i, nil
+		}
+	}
+	return keep, nil
+}
+
+// binaryLogsSafeToPurge returns the index of the first of the binary logs, up
+// to keep, that holds transactions needed by the connected replicas or by the
+// vreplication streams that read from the shard of the tablet.
+func (tm *TabletManager) binaryLogsSafeToPurge(ctx context.Context, binaryLogs []string, keep int, minPosition string) (int, error) {
+	var positions []replication.Position
+	if minPosition != "" {
+		pos, err := replication.DecodePosition(minPosition)
+		if err != nil {
+			return 0, vterrors.Wrapf(err, "invalid min position %v", minPosition)
+		}
+		positions = append(positions, pos)
+	} else {
+		replicas, err := mysqlctl.FindReplicas(ctx, tm.MysqlDaemon)
+		if err != nil {
+			return 0, err
+		}
+		if len(replicas) > 0 {
+			return 0, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%d replicas are connected to the tablet, a min position is required to purge binary logs", len(replicas))
+		}
+	}
+	streamPositions, err := tm.vreplicationPositionsFromShard(ctx)
+	if err != nil {
+		return 0, err
+	}
+	positions = append(positions, streamPositions...)
+	if len(positions) == 0 {
+		return keep, nil
+	}
+
+	// The binary logs before binaryLogs[i] only hold transactions that are in
+	// the previous GTIDs of binaryLogs[i], so they can be purged if all the
+	// positions already have them.
+	for i := 1; i <= keep; i++ {
+		previousGTIDs, err := tm.MysqlDaemon.GetPreviousGTIDs(ctx, binaryLogs[i])
+		if err != nil {
+			return 0, vterrors.Wrapf(err, "cannot get the previous GTIDs of binary log %v", binaryLogs[i])
+		}
+		previous, err := replication.ParsePosition(replication.Mysql56FlavorID, previousGTIDs)
+		if err != nil {
+			return 0, vterrors.Wrapf(err, "cannot parse the previous GTIDs of binary log %v", binaryLogs[i])
+		}
+		for _, pos := range positions {
+			if !pos.AtLeast(previous) {
+				return i - 1, nil
+			}
+		}
+	}
+	return keep, nil
+}
+
+// vreplicationPositionsFromShard returns the positions of the vreplication
+// streams that read from the shard of the tablet, which are
+// positions in its binary logs. Those are the streams of the MoveTables,
+// Reshard and Materialize workflows of the other keyspaces and shards, and
+// those of the online DDL migrations of the shard itself, so they are read from
+// the primaries of all the shards. A shard that has no primary, or whose
+// primary can't be reached, fails the check, since its streams are unknown.
+func (tm *TabletManager) vreplicationPositionsFromShard(ctx context.Context) ([]replication.Position, error) {
+	tablet := tm.Tablet()
+	keyspaces, err := tm.TopoServer.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tmc := tmclient.NewTabletManagerClient()
+	defer tmc.Close()
+
+	var positions []replication.Position
+	for _, keyspace := range keyspaces {
+		shards, err := tm.TopoServer.GetShardNames(ctx, keyspace)
+		if err != nil {
+			return nil, err
+		}
+		for _, shard := range shards {
+			si, err := tm.TopoServer.GetShard(ctx, keyspace, shard)
+			if err != nil {
+				return nil, err
+			}
+			if !si.HasPrimary() {
+				return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot check the vreplication streams of shard %v/%v, which has no primary", keyspace, shard)
+			}
+			primary, err := tm.TopoServer.GetTablet(ctx, si.PrimaryAlias)
+			if err != nil {
+				return nil, err
+			}
+			shardPositions, err := tm.vreplicationPositionsOnTablet(ctx, tmc, primary.Tablet, tablet.Keyspace, tablet.Shard)
+			if err != nil {
+				return nil, vterrors.Wrapf(err, "cannot check the vreplication streams of shard %v/%v", keyspace, shard)
+			}
+			positions = append(positions, shardPositions...)
+		}
+	}
+	return positions, nil
+}
+
+// vreplicationPositionsOnTablet returns the positions of the vreplication
+// streams of the given tablet that read from the given shard. The stopped
+// streams are included, since they resume from their position when they are
+// started again, but not those that are done reading from the shard.
+func (tm *TabletManager) vreplicationPositionsOnTablet(ctx context.Context, tmc tmclient.TabletManagerClient, tablet *topodatapb.Tablet, keyspace, shard string) ([]replication.Position, error) {
+	ctx, cancel := context.WithTimeout(ctx, topo.RemoteOperationTimeout)
+	defer cancel()
+	res, err := tmc.ReadVReplicationWorkflows(ctx, tablet, &tabletmanagerdatapb.ReadVReplicationWorkflowsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var positions []replication.Position
+	for _, workflow := range res.Workflows {
+		for _, stream := range workflow.Streams {
+			if stream.Bls.GetKeyspace() != keyspace || stream.Bls.GetShard() != shard {
+				continue
+			}
+			if isDoneReading(stream) {
+				continue
+			}
+			if stream.Pos == "" {
+				return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vreplication stream %v of workflow %v on tablet %v reads from the binary logs of the shard and has no position yet",
+					stream.Id, workflow.Workflow, topoproto.TabletAliasString(tablet.Alias))
+			}
+			position, err := replication.DecodePosition(stream.Pos)
+			if err != nil {
+				return nil, vterrors.Wrapf(err, "invalid position of vreplication stream %v of workflow %v on tablet %v", stream.Id, workflow.Workflow, topoproto.TabletAliasString(tablet.Alias))
+			}
+			positions = append(positions, position)
+		}
+	}
+	return positions, nil
+}
+
+// isDoneReading returns whether the given vreplication stream won't read from
+// its source again: it was stopped either at its stop position or for good, by
+// the frozen workflows whose writes were switched.
+func isDoneReading(stream *tabletmanagerdatapb.ReadVReplicationWorkflowResponse_Stream) bool {
+	if stream.State != binlogdatapb.VReplicationWorkflowState_Stopped {
+		return false
+	}
+	if strings.EqualFold(stream.Message, workflow.Frozen) {
+		return true
+	}
+	if stream.StopPos == "" || stream.Pos == "" {
+		return false
+	}
+	pos, err := replication.DecodePosition(stream.Pos)
+	if err != nil {
+		return false
+	}
+	stopPos, err := replication.DecodePosition(stream.StopPos)
+	if err != nil {
+		return false
+	}
+	return pos.AtLeast(stopPos)
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const binlogsTestUUID = "8bc65c84-3fe4-11ed-a912-257f0fcdd6c9"
+
+// newBinlogsTestTM returns a tablet manager of shard ks/0, in a topo without
+// other shards, whose mysqld has 4 binary logs, the first three of them holding
+// 10 transactions each.
+func newBinlogsTestTM(t *testing.T) (*TabletManager, *mysqlctl.FakeMysqlDaemon) {
+	daemon := mysqlctl.NewFakeMysqlDaemon(fakesqldb.New(t))
+	t.Cleanup(daemon.Close)
+	daemon.BinaryLogs = []string{"vt-bin.000001", "vt-bin.000002", "vt-bin.000003", "vt-bin.000004"}
+	daemon.PreviousGTIDs = map[string]string{
+		"vt-bin.000001": "",
+		"vt-bin.000002": binlogsTestUUID + ":1-10",
+		"vt-bin.000003": binlogsTestUUID + ":1-20",
+		"vt-bin.000004": binlogsTestUUID + ":1-30",
+	}
+	daemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW PROCESSLIST": sqltypes.MakeTestResult(sqltypes.MakeTestFields("Id|User|Host|db|Command|Time|State|Info", "varchar|varchar|varchar|varchar|varchar|varchar|varchar|varchar")),
+	}
+	ts := memorytopo.NewServer(context.Background(), "zone1")
+	t.Cleanup(ts.Close)
+	tm := &TabletManager{
+		BatchCtx:               context.Background(),
+		TopoServer:             ts,
+		MysqlDaemon:            daemon,
+		_waitForGrantsComplete: make(chan struct{}),
+	}
+	close(tm._waitForGrantsComplete)
+	tm.tmState = newTMState(tm, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 1},
+		Keyspace: "ks",
+		Shard:    "0",
+	})
+	return tm, daemon
+}
+
+func TestFlushBinaryLogs(t *testing.T) {
+	tm, daemon := newBinlogsTestTM(t)
+	daemon.ExpectedExecuteSuperQueryList = []string{
+		"FAKE FLUSH BINARY LOGS",
+		"FAKE SHOW BINARY LOGS",
+	}
+	response, err := tm.FlushBinaryLogs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "vt-bin.000004", response.BinaryLog)
+	require.NoError(t, daemon.CheckSuperQueryList())
+}
+
+func TestPurgeBinaryLogs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		request  *tabletmanagerdatapb.PurgeBinaryLogsRequest
+		replicas bool
+		queries  []string
+		purged   []string
+		first    string
+		code     vtrpcpb.Code
+	}{{
+		name:    "no binary log nor time",
+		request: &tabletmanagerdatapb.PurgeBinaryLogsRequest{},
+		code:    vtrpcpb.Code_INVALID_ARGUMENT,
+	}, {
+		name:    "unknown binary log",
+		request: &tabletmanagerdatapb.PurgeBinaryLogsRequest{ToLog: "vt-bin.000042"},
+		queries: []string{"FAKE SHOW BINARY LOGS"},
+		code:    vtrpcpb.Code_INVALID_ARGUMENT,
+	}, {
+		name:    "no replicas",
+		request: &tabletmanagerdatapb.PurgeBinaryLogsRequest{ToLog: "vt-bin.000003"},
+		queries: []string{
+			"FAKE SHOW BINARY LOGS",
+			"FAKE PURGE BINARY LOGS TO 'vt-bin.000003'",
+		},
+		purged: []string{"vt-bin.000001", "vt-bin.000002"},
+		first:  "vt-bin.000003",
+	}, {
+		name:     "replicas without min position",
+		request:  &tabletmanagerdatapb.PurgeBinaryLogsRequest{ToLog: "vt-bin.000003"},
+		replicas: true,
+		queries:  []string{"FAKE SHOW BINARY LOGS"},
+		code:     vtrpcpb.Code_FAILED_PRECONDITION,
+	}, {
+		name:     "replicas behind the requested binary log",
+		request:  &tabletmanagerdatapb.PurgeBinaryLogsRequest{ToLog: "vt-bin.000004", MinPosition: "MySQL56/" + binlogsTestUUID + ":1-15"},
+		replicas: true,
+		queries: []string{
+			"FAKE SHOW BINARY LOGS",
+			"FAKE SHOW BINLOG EVENTS IN 'vt-bin.000002' LIMIT 2",
+			"FAKE SHOW BINLOG EVENTS IN 'vt-bin.000003' LIMIT 2",
+			"FAKE PURGE BINARY LOGS TO 'vt-bin.000002'",
+		},
+		purged: []string{"vt-bin.000001"},
+		first:  "vt-bin.000002",
+	}, {
+		name:    "replicas behind all the binary logs",
+		request: &tabletmanagerdatapb.PurgeBinaryLogsRequest{ToLog: "vt-bin.000004", MinPosition: "MySQL56/" + binlogsTestUUID + ":1-5"},
+		queries: []string{
+			"FAKE SHOW BINARY LOGS",
+			"FAKE SHOW BINLOG EVENTS IN 'vt-bin.000002' LIMIT 2",
+		},
+		first: "vt-bin.000001",
+	}, {
+		name:     "forced",
+		request:  &tabletmanagerdatapb.PurgeBinaryLogsRequest{ToLog: "vt-bin.000004", Force: true},
+		replicas: true,
+		queries: []string{
+			"FAKE SHOW BINARY LOGS",
+			"FAKE PURGE BINARY LOGS TO 'vt-bin.000004'",
+		},
+		purged: []string{"vt-bin.000001", "vt-bin.000002", "vt-bin.000003"},
+		first:  "vt-bin.000004",
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tm, daemon := newBinlogsTestTM(t)
+			if tc.replicas {
+				daemon.FetchSuperQueryMap["SHOW PROCESSLIST"].Rows = sqltypes.MakeTestResult(daemon.FetchSuperQueryMap["SHOW PROCESSLIST"].Fields,
+					"1|vt_repl|127.0.0.1:39410|NULL|Binlog Dump GTID|54|Source has sent all binlog to replica|NULL").Rows
+			}
+			daemon.ExpectedExecuteSuperQueryList = tc.queries
+
+			response, err := tm.PurgeBinaryLogs(context.Background(), tc.request)
+			if tc.code != vtrpcpb.Code_OK {
+				assert.Equal(t, tc.code, vterrors.Code(err), "%v", err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.purged, response.PurgedBinaryLogs)
+				assert.Equal(t, tc.first, response.FirstBinaryLog)
+			}
+			require.NoError(t, daemon.CheckSuperQueryList())
+		})
+	}
+}
+
+func TestPurgeBinaryLogsBefore(t *testing.T) {
+	tm, daemon := newBinlogsTestTM(t)
+	dir := t.TempDir()
+	tm.Cnf = &mysqlctl.Mycnf{BinLogPath: filepath.Join(dir, "vt-bin")}
+	now := time.Now()
+	for i, binaryLog := range daemon.BinaryLogs {
+		path := filepath.Join(dir, binaryLog)
+		require.NoError(t, os.WriteFile(path, nil, 0o600))
+		modTime := now.Add(time.Duration(i-len(daemon.BinaryLogs)) * time.Hour)
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+	daemon.ExpectedExecuteSuperQueryList = []string{
+		"FAKE SHOW BINARY LOGS",
+		"FAKE PURGE BINARY LOGS TO 'vt-bin.000003'",
+	}
+
+	response, err := tm.PurgeBinaryLogs(context.Background(), &tabletmanagerdatapb.PurgeBinaryLogsRequest{
+		Before: protoutil.TimeToProto(now.Add(-150 * time.Minute)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vt-bin.000001", "vt-bin.000002"}, response.PurgedBinaryLogs)
+	require.NoError(t, daemon.CheckSuperQueryList())
+
+	// The binary logs of an external mysqld aren't on the host of the tablet.
+	tm.Cnf.BinLogPath = filepath.Join(t.TempDir(), "vt-bin")
+	daemon.ExpectedExecuteSuperQueryList = []string{"FAKE SHOW BINARY LOGS"}
+	daemon.ExpectedExecuteSuperQueryCurrent = 0
+	_, err = tm.PurgeBinaryLogs(context.Background(), &tabletmanagerdatapb.PurgeBinaryLogsRequest{
+		Before: protoutil.TimeToProto(now),
+	})
+	assert.ErrorContains(t, err, "mysqld doesn't seem to run on the host of the tablet")
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err), "%v", err)
+
+	// Nor is its my.cnf.
+	tm.Cnf = nil
+	daemon.ExpectedExecuteSuperQueryCurrent = 0
+	_, err = tm.PurgeBinaryLogs(context.Background(), &tabletmanagerdatapb.PurgeBinaryLogsRequest{
+		Before: protoutil.TimeToProto(now),
+	})
+	assert.ErrorContains(t, err, "mysqld is external to the tablet")
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err), "%v", err)
+}
+
+func TestPurgeBinaryLogsVReplication(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	keyspace, shard := "ks", "0"
+	tenv := newTestEnv(t, ctx, keyspace, []string{shard})
+	defer tenv.close()
+	sourceTablet := tenv.addTablet(t, 100, keyspace, shard)
+	defer tenv.deleteTablet(sourceTablet.tablet)
+	targetTablet := tenv.addTablet(t, 200, "target", "0")
+	defer tenv.deleteTablet(targetTablet.tablet)
+
+	tm, daemon := newBinlogsTestTM(t)
+	tm.TopoServer = tenv.ts
+	tm.tmState = newTMState(tm, sourceTablet.tablet)
+
+	fields := sqltypes.MakeTestFields(
+		"workflow|id|source|pos|stop_pos|max_tps|max_replication_lag|cell|tablet_types|time_updated|transaction_timestamp|state|message|db_name|rows_copied|tags|time_heartbeat|workflow_type|time_throttled|component_throttled|workflow_sub_type|defer_secondary_keys",
+		"varchar|int64|blob|varchar|varchar|int64|int64|varchar|varchar|int64|int64|varchar|varchar|varchar|int64|varchar|int64|int64|int64|varchar|int64|int64",
+	)
+	stoppedStream := func(workflow string, id int, keyspace, shard, pos, stopPos, message string) string {
+		return fmt.Sprintf(`%s|%d|keyspace:"%s" shard:"%s"|%s|%s|0|0|||0|0|Stopped|%s|%s|0||0|0|0||0|0`, workflow, id, keyspace, shard, pos, stopPos, message, tenv.dbName)
+	}
+	stream := func(workflow string, id int, keyspace, shard, pos string) string {
+		return fmt.Sprintf(`%s|%d|keyspace:"%s" shard:"%s"|%s|NULL|0|0|||0|0|Running||%s|0||0|0|0||0|0`, workflow, id, keyspace, shard, pos, tenv.dbName)
+	}
+	// The streams of the online DDL migrations of the source shard and of the
+	// MoveTables workflows of the target keyspace read from the binary logs of
+	// the tablet, the other stream reads from another keyspace.
+	query := fmt.Sprintf("select workflow, id, source, pos, stop_pos, max_tps, max_replication_lag, cell, tablet_types, time_updated, transaction_timestamp, state, message, db_name, rows_copied, tags, time_heartbeat, workflow_type, time_throttled, component_throttled, workflow_sub_type, defer_secondary_keys, options from _vt.vreplication where db_name = '%s' group by workflow, id order by workflow, id", tenv.dbName)
+	expectSourceStreams := func(migrationPos string) {
+		sourceTablet.vrdbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+		sourceTablet.vrdbClient.ExpectRequest(query, sqltypes.MakeTestResult(fields,
+			stream("migration", 1, keyspace, shard, migrationPos),
+		), nil)
+	}
+	expectTargetStreams := func(moveTablesPos string, moreStreams ...string) {
+		targetTablet.vrdbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+		targetTablet.vrdbClient.ExpectRequest(query, sqltypes.MakeTestResult(fields, append([]string{
+			stream("movetables", 1, keyspace, shard, moveTablesPos),
+			stream("other", 2, "other", "0", "MySQL56/1d14c70c-1d2a-11ee-9ee4-0a3f8d52c9e7:1-3"),
+		}, moreStreams...)...), nil)
+	}
+
+	// The MoveTables workflow of the other keyspace is the furthest behind.
+	expectSourceStreams("MySQL56/" + binlogsTestUUID + ":1-25")
+	expectTargetStreams("MySQL56/" + binlogsTestUUID + ":1-15")
+	daemon.ExpectedExecuteSuperQueryList = []string{
+		"FAKE SHOW BINARY LOGS",
+		"FAKE SHOW BINLOG EVENTS IN 'vt-bin.000002' LIMIT 2",
+		"FAKE SHOW BINLOG EVENTS IN 'vt-bin.000003' LIMIT 2",
+		"FAKE PURGE BINARY LOGS TO 'vt-bin.000002'",
+	}
+	response, err := tm.PurgeBinaryLogs(ctx, &tabletmanagerdatapb.PurgeBinaryLogsRequest{ToLog: "vt-bin.000004"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vt-bin.000001"}, response.PurgedBinaryLogs)
+	require.NoError(t, daemon.CheckSuperQueryList())
+
+	// A stopped stream resumes from its position when it is started again, so
+	// it keeps the binary logs it doesn't have yet, unlike the streams that are
+	// done reading from the shard: the frozen ones and those that reached their
+	// stop position.
+	expectSourceStreams("MySQL56/" + binlogsTestUUID + ":1-25")
+	expectTargetStreams("MySQL56/"+binlogsTestUUID+":1-25",
+		stoppedStream("stopped", 3, keyspace, shard, "MySQL56/"+binlogsTestUUID+":1-15", "NULL", "Stopped by user"),
+		stoppedStream("frozen", 4, keyspace, shard, "MySQL56/"+binlogsTestUUID+":1-5", "NULL", "FROZEN"),
+		stoppedStream("done", 5, keyspace, shard, "MySQL56/"+binlogsTestUUID+":1-5", "MySQL56/"+binlogsTestUUID+":1-5", "Stopped at position"),
+	)
+	daemon.ExpectedExecuteSuperQueryList = []string{
+		"FAKE SHOW BINARY LOGS",
+		"FAKE SHOW BINLOG EVENTS IN 'vt-bin.000002' LIMIT 2",
+		"FAKE SHOW BINLOG EVENTS IN 'vt-bin.000003' LIMIT 2",
+		"FAKE PURGE BINARY LOGS TO 'vt-bin.000002'",
+	}
+	daemon.ExpectedExecuteSuperQueryCurrent = 0
+	response, err = tm.PurgeBinaryLogs(ctx, &tabletmanagerdatapb.PurgeBinaryLogsRequest{ToLog: "vt-bin.000004"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vt-bin.000001"}, response.PurgedBinaryLogs)
+	require.NoError(t, daemon.CheckSuperQueryList())
+
+	// A stream that doesn't have a position yet prevents any purge.
+	expectSourceStreams("MySQL56/" + binlogsTestUUID + ":1-25")
+	expectTargetStreams("")
+	daemon.ExpectedExecuteSuperQueryList = []string{"FAKE SHOW BINARY LOGS"}
+	daemon.ExpectedExecuteSuperQueryCurrent = 0
+	_, err = tm.PurgeBinaryLogs(ctx, &tabletmanagerdatapb.PurgeBinaryLogsRequest{ToLog: "vt-bin.000004"})
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err), "%v", err)
+	sourceTablet.vrdbClient.Wait()
+	targetTablet.vrdbClient.Wait()
+
+	// So does a shard without a primary, whose streams can't be read.
+	expectSourceStreams("MySQL56/" + binlogsTestUUID + ":1-25")
+	_, err = tenv.ts.UpdateShardFields(ctx, "target", "0", func(si *topo.ShardInfo) error {
+		si.PrimaryAlias = nil
+		return nil
+	})
+	require.NoError(t, err)
+	daemon.ExpectedExecuteSuperQueryCurrent = 0
+	_, err = tm.PurgeBinaryLogs(ctx, &tabletmanagerdatapb.PurgeBinaryLogsRequest{ToLog: "vt-bin.000004"})
+	assert.ErrorContains(t, err, "shard target/0, which has no primary")
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err), "%v", err)
+	sourceTablet.vrdbClient.Wait()
+}
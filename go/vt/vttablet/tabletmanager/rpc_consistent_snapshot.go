@@ -0,0 +1,172 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"vitess.io/vitess/go/mysql/replication"
+	"vitess.io/vitess/go/mysql/sqlerror"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	consistentSnapshotLease       = 10 * time.Minute
+	consistentSnapshotLockTimeout = 30 * time.Second
+)
+
+func registerConsistentSnapshotFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&consistentSnapshotLease, "consistent_snapshot_lease", consistentSnapshotLease, "How long to hold a consistent snapshot before releasing it, if the request does not specify a lease")
+	fs.DurationVar(&consistentSnapshotLockTimeout, "consistent_snapshot_lock_timeout", consistentSnapshotLockTimeout, "How long to wait for the tables to be locked when acquiring a consistent snapshot, e.g. behind long running queries, before giving up")
+}
+
+func init() {
+	servenv.OnParseFor("vtcombo", registerConsistentSnapshotFlags)
+	servenv.OnParseFor("vttablet", registerConsistentSnapshotFlags)
+}
+
+// consistentSnapshot is a snapshot acquired with AcquireConsistentSnapshot.
+type consistentSnapshot struct {
+	id int64
+	// conn holds the table locks.
+	conn  *dbconnpool.DBConnection
+	timer *time.Timer
+}
+
+// AcquireConsistentSnapshot locks the tables of the database and returns the
+// ID of the snapshot and its GTID position. The tables are kept locked, so
+// that no transaction can be committed, until the snapshot is released by
+// ReleaseConsistentSnapshot or its lease expires. Meanwhile, the callers can
+// start their own transactions WITH CONSISTENT SNAPSHOT, which all see the
+// data as of the returned position. Only one snapshot can be held at a time.
+// Primaries are refused unless allowPrimary is set, since their shard can't be
+// written to while the tables are locked.
+func (tm *TabletManager) AcquireConsistentSnapshot(ctx context.Context, lease time.Duration, allowPrimary bool) (int64, string, error) {
+	if tablet := tm.Tablet(); tablet.Type == topodatapb.TabletType_PRIMARY && !allowPrimary {
+		return 0, "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "tablet %v is PRIMARY, a consistent snapshot would block the writes to its shard", topoproto.TabletAliasString(tablet.Alias))
+	}
+	if lease <= 0 {
+		lease = consistentSnapshotLease
+	}
+
+	// Don't wait forever behind a snapshot that is being acquired.
+	lockCtx, cancel := context.WithTimeout(ctx, consistentSnapshotLockTimeout)
+	defer cancel()
+	if err := tm.snapshotSema.Acquire(lockCtx, 1); err != nil {
+		return 0, "", vterrors.Errorf(vtrpcpb.Code_ABORTED, "timed out waiting for another consistent snapshot to be acquired or released: %v", err)
+	}
+	defer tm.snapshotSema.Release(1)
+
+	if tm.heldSnapshot != nil {
+		return 0, "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "consistent snapshot %d is already held on this tablet", tm.heldSnapshot.id)
+	}
+
+	conn, err := tm.MysqlDaemon.GetDbaConnection(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+	// FLUSH TABLES WITH READ LOCK and LOCK TABLES wait for the running queries
+	// that use the tables, for up to lock_wait_timeout.
+	timeoutSeconds := max(int64(consistentSnapshotLockTimeout.Seconds()), 1)
+	if _, err := conn.ExecuteFetch(fmt.Sprintf("SET SESSION lock_wait_timeout = %d", timeoutSeconds), 0, false); err != nil {
+		conn.Close()
+		return 0, "", err
+	}
+	// FTWRL is preferable, but we fall back to locking each table, like
+	// LockTables, unless it timed out, since LOCK TABLES would time out too.
+	if _, err := conn.ExecuteFetch("FLUSH TABLES WITH READ LOCK", 0, false); err != nil {
+		if merr, ok := err.(*sqlerror.SQLError); ok && merr.Num == sqlerror.ERLockWaitTimeout {
+			conn.Close()
+			return 0, "", vterrors.Wrapf(err, "timed out after %v waiting for the tables to be locked", consistentSnapshotLockTimeout)
+		}
+		if err := tm.lockTablesUsingLockTables(conn); err != nil {
+			conn.Close()
+			return 0, "", err
+		}
+	}
+	pos, err := conn.PrimaryPosition()
+	if err != nil {
+		closeSnapshotConn(conn)
+		return 0, "", err
+	}
+
+	snapshot := &consistentSnapshot{
+		id:   time.Now().UnixNano(),
+		conn: conn,
+	}
+	log.Infof("[%v] Consistent snapshot %d acquired at position %v", conn.ConnectionID, snapshot.id, pos)
+
+	tm.heldSnapshot = snapshot
+	snapshot.timer = time.AfterFunc(lease, func() {
+		if err := tm.snapshotSema.Acquire(context.Background(), 1); err != nil {
+			return
+		}
+		defer tm.snapshotSema.Release(1)
+
+		// We need the semaphore before we check this field
+		if tm.heldSnapshot == snapshot {
+			log.Warningf("consistent snapshot %d lease expired, releasing it", snapshot.id)
+			tm.releaseConsistentSnapshotHoldingSema()
+		}
+	})
+
+	return snapshot.id, replication.EncodePosition(pos), nil
+}
+
+// ReleaseConsistentSnapshot releases the snapshot with the given ID.
+func (tm *TabletManager) ReleaseConsistentSnapshot(ctx context.Context, id int64) error {
+	lockCtx, cancel := context.WithTimeout(ctx, consistentSnapshotLockTimeout)
+	defer cancel()
+	if err := tm.snapshotSema.Acquire(lockCtx, 1); err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_ABORTED, "timed out waiting for another consistent snapshot to be acquired or released: %v", err)
+	}
+	defer tm.snapshotSema.Release(1)
+
+	if tm.heldSnapshot == nil || tm.heldSnapshot.id != id {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "consistent snapshot %d is not held on this tablet", id)
+	}
+	tm.releaseConsistentSnapshotHoldingSema()
+	return nil
+}
+
+func (tm *TabletManager) releaseConsistentSnapshotHoldingSema() {
+	snapshot := tm.heldSnapshot
+	snapshot.timer.Stop()
+	closeSnapshotConn(snapshot.conn)
+	log.Infof("Consistent snapshot %d released", snapshot.id)
+	tm.heldSnapshot = nil
+}
+
+// closeSnapshotConn releases the table locks held by the given connection,
+// and closes it.
+func closeSnapshotConn(conn *dbconnpool.DBConnection) {
+	if _, err := conn.ExecuteFetch("UNLOCK TABLES", 0, false); err != nil {
+		log.Warningf("[%v] UNLOCK TABLES failed: %v", conn.ConnectionID, err)
+	}
+	conn.Close()
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/mysql/sqlerror"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const snapshotTestUUID = "8bc65c84-3fe4-11ed-a912-257f0fcdd6c9"
+
+// newSnapshotTestTM returns a tablet manager whose mysqld locks its tables
+// at position snapshotTestUUID:1-10.
+func newSnapshotTestTM(t *testing.T) (*TabletManager, *fakesqldb.DB) {
+	db := fakesqldb.New(t)
+	t.Cleanup(db.Close)
+	daemon := mysqlctl.NewFakeMysqlDaemon(db)
+	t.Cleanup(daemon.Close)
+	db.AddQuery("SET SESSION lock_wait_timeout = 30", &sqltypes.Result{})
+	db.AddQuery("FLUSH TABLES WITH READ LOCK", &sqltypes.Result{})
+	db.AddQuery("SELECT @@global.gtid_executed", sqltypes.MakeTestResult(sqltypes.MakeTestFields("@@global.gtid_executed", "varchar"), snapshotTestUUID+":1-10"))
+	db.AddQuery("UNLOCK TABLES", &sqltypes.Result{})
+	tm := &TabletManager{
+		BatchCtx:     context.Background(),
+		MysqlDaemon:  daemon,
+		snapshotSema: semaphore.NewWeighted(1),
+	}
+	tm.tmState = newTMState(tm, &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 1},
+		Type:  topodatapb.TabletType_REPLICA,
+	})
+	return tm, db
+}
+
+func TestConsistentSnapshot(t *testing.T) {
+	ctx := context.Background()
+	tm, db := newSnapshotTestTM(t)
+
+	id, position, err := tm.AcquireConsistentSnapshot(ctx, time.Hour, false)
+	require.NoError(t, err)
+	assert.Equal(t, "MySQL56/"+snapshotTestUUID+":1-10", position)
+	assert.Equal(t, 1, db.GetQueryCalledNum("FLUSH TABLES WITH READ LOCK"))
+
+	// Only one snapshot can be held at a time.
+	_, _, err = tm.AcquireConsistentSnapshot(ctx, time.Hour, false)
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err), "%v", err)
+	assert.Equal(t, 1, db.GetQueryCalledNum("FLUSH TABLES WITH READ LOCK"))
+
+	err = tm.ReleaseConsistentSnapshot(ctx, id+1)
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err), "%v", err)
+	assert.Equal(t, 0, db.GetQueryCalledNum("UNLOCK TABLES"))
+
+	require.NoError(t, tm.ReleaseConsistentSnapshot(ctx, id))
+	assert.Equal(t, 1, db.GetQueryCalledNum("UNLOCK TABLES"))
+	err = tm.ReleaseConsistentSnapshot(ctx, id)
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err), "%v", err)
+
+	// Once released, another snapshot can be acquired.
+	id, _, err = tm.AcquireConsistentSnapshot(ctx, time.Hour, false)
+	require.NoError(t, err)
+	require.NoError(t, tm.ReleaseConsistentSnapshot(ctx, id))
+}
+
+func TestConsistentSnapshotRefusesPrimary(t *testing.T) {
+	ctx := context.Background()
+	tm, db := newSnapshotTestTM(t)
+	tm.tmState.displayState.tablet.Type = topodatapb.TabletType_PRIMARY
+
+	_, _, err := tm.AcquireConsistentSnapshot(ctx, time.Hour, false)
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err), "%v", err)
+	assert.Equal(t, 0, db.GetQueryCalledNum("FLUSH TABLES WITH READ LOCK"))
+
+	id, _, err := tm.AcquireConsistentSnapshot(ctx, time.Hour, true)
+	require.NoError(t, err)
+	require.NoError(t, tm.ReleaseConsistentSnapshot(ctx, id))
+}
+
+func TestConsistentSnapshotLeaseExpired(t *testing.T) {
+	ctx := context.Background()
+	tm, db := newSnapshotTestTM(t)
+
+	id, _, err := tm.AcquireConsistentSnapshot(ctx, 10*time.Millisecond, false)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return db.GetQueryCalledNum("UNLOCK TABLES") == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	err = tm.ReleaseConsistentSnapshot(ctx, id)
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err), "%v", err)
+}
+
+func TestConsistentSnapshotLockTimeout(t *testing.T) {
+	ctx := context.Background()
+	tm, db := newSnapshotTestTM(t)
+
+	// The tables can't be locked behind a long running query.
+	db.AddRejectedQuery("FLUSH TABLES WITH READ LOCK", sqlerror.NewSQLError(sqlerror.ERLockWaitTimeout, sqlerror.SSUnknownSQLState, "Lock wait timeout exceeded; try restarting transaction"))
+	_, _, err := tm.AcquireConsistentSnapshot(ctx, time.Hour, false)
+	assert.ErrorContains(t, err, "timed out after 30s waiting for the tables to be locked")
+	assert.Nil(t, tm.heldSnapshot)
+	db.DeleteRejectedQuery("FLUSH TABLES WITH READ LOCK")
+
+	// Nor can a snapshot be acquired while another one is being acquired.
+	defer func(timeout time.Duration) {
+		consistentSnapshotLockTimeout = timeout
+	}(consistentSnapshotLockTimeout)
+	consistentSnapshotLockTimeout = 10 * time.Millisecond
+	require.NoError(t, tm.snapshotSema.Acquire(ctx, 1))
+	_, _, err = tm.AcquireConsistentSnapshot(ctx, time.Hour, false)
+	assert.Equal(t, vtrpcpb.Code_ABORTED, vterrors.Code(err), "%v", err)
+	tm.snapshotSema.Release(1)
+}
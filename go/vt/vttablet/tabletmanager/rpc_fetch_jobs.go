@@ -0,0 +1,238 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// fetchJobRetention is how long the outcome of a finished async ExecuteFetchAsDba
+// job is kept for PollFetchJob.
+var fetchJobRetention = time.Hour
+
+// maxFinishedFetchJobs bounds the number of finished async ExecuteFetchAsDba jobs
+// whose outcome is kept, since their results can be large. The jobs that
+// finished first are forgotten first.
+var maxFinishedFetchJobs = 100
+
+// maxRunningFetchJobs bounds the number of async ExecuteFetchAsDba jobs running at
+// the same time, each of them holding a DBA connection to mysqld.
+var maxRunningFetchJobs = 10
+
+// killQueryTimeout bounds the time it takes to kill the query of a canceled job.
+const killQueryTimeout = 30 * time.Second
+
+// fetchJob is an async ExecuteFetchAsDba request, running in the background.
+type fetchJob struct {
+	id        string
+	query     []byte
+	startedAt time.Time
+	cancel    context.CancelFunc
+
+	// The following fields are protected by fetchJobs.mu.
+	state       tabletmanagerdatapb.PollFetchJobResponse_State
+	completedAt time.Time
+	result      *querypb.QueryResult
+	err         error
+}
+
+// fetchJobs are the async ExecuteFetchAsDba jobs of the tablet, running or
+// recently finished. The zero value is ready to use.
+type fetchJobs struct {
+	mu   sync.Mutex
+	jobs map[string]*fetchJob
+}
+
+// pruneLocked forgets the jobs that finished more than fetchJobRetention ago,
+// and the oldest finished jobs above maxFinishedFetchJobs.
+func (fj *fetchJobs) pruneLocked(now time.Time) {
+	var finished []*fetchJob
+	for id, job := range fj.jobs {
+		if job.completedAt.IsZero() {
+			continue
+		}
+		if now.Sub(job.completedAt) > fetchJobRetention {
+			delete(fj.jobs, id)
+			continue
+		}
+		finished = append(finished, job)
+	}
+	if len(finished) <= maxFinishedFetchJobs {
+		return
+	}
+	slices.SortFunc(finished, func(a, b *fetchJob) int {
+		return a.completedAt.Compare(b.completedAt)
+	})
+	for _, job := range finished[:len(finished)-maxFinishedFetchJobs] {
+		delete(fj.jobs, job.id)
+	}
+}
+
+// runningLocked returns the number of jobs that are still running.
+func (fj *fetchJobs) runningLocked() int {
+	running := 0
+	for _, job := range fj.jobs {
+		if job.completedAt.IsZero() {
+			running++
+		}
+	}
+	return running
+}
+
+// ExecuteFetchAsDbaAsync starts executing the query of the request in the
+// background, like ExecuteFetchAsDba, and returns the ID of its job right away.
+// The job isn't bound to the RPC: it runs until the query completes or
+// CancelFetchJob is called. No job is started if maxRunningFetchJobs are
+// already running.
+func (tm *TabletManager) ExecuteFetchAsDbaAsync(ctx context.Context, req *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (string, error) {
+	// The job keeps the values of the RPC context, like its caller ID.
+	jobCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	job := &fetchJob{
+		id:        uuid.NewString(),
+		query:     req.Query,
+		startedAt: time.Now(),
+		cancel:    cancel,
+	}
+
+	tm.fetchJobs.mu.Lock()
+	if tm.fetchJobs.jobs == nil {
+		tm.fetchJobs.jobs = make(map[string]*fetchJob)
+	}
+	tm.fetchJobs.pruneLocked(job.startedAt)
+	if running := tm.fetchJobs.runningLocked(); running >= maxRunningFetchJobs {
+		tm.fetchJobs.mu.Unlock()
+		cancel()
+		return "", vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "%d ExecuteFetchAsDba jobs are already running, the maximum", running)
+	}
+	tm.fetchJobs.jobs[job.id] = job
+	tm.fetchJobs.mu.Unlock()
+
+	log.Infof("Starting ExecuteFetchAsDba job %s", job.id)
+	go tm.runFetchJob(jobCtx, job, req)
+	return job.id, nil
+}
+
+func (tm *TabletManager) runFetchJob(ctx context.Context, job *fetchJob, req *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) {
+	defer job.cancel()
+
+	results, err := tm.executeMultiFetchAsDba(
+		ctx,
+		req.DbName,
+		string(req.Query),
+		int(req.MaxRows),
+		req.ReloadSchema,
+		req.DisableBinlogs,
+		req.DisableForeignKeyChecks,
+		true, /* killOnCancel */
+		validateExecuteFetchAsDbaQueries,
+	)
+	if err == nil && len(results) == 0 {
+		err = vterrors.Errorf(vtrpcpb.Code_INTERNAL, "received no query results in ExecuteFetchAsDba. Expected at least 1")
+	}
+
+	tm.fetchJobs.mu.Lock()
+	defer tm.fetchJobs.mu.Unlock()
+	job.completedAt = time.Now()
+	defer tm.fetchJobs.pruneLocked(job.completedAt)
+	switch {
+	case ctx.Err() != nil:
+		job.state = tabletmanagerdatapb.PollFetchJobResponse_CANCELED
+		job.err = vterrors.Errorf(vtrpcpb.Code_CANCELED, "ExecuteFetchAsDba job %s was canceled", job.id)
+	case err != nil:
+		job.state = tabletmanagerdatapb.PollFetchJobResponse_FAILED
+		job.err = err
+	default:
+		job.state = tabletmanagerdatapb.PollFetchJobResponse_COMPLETE
+		job.result = results[0]
+	}
+	log.Infof("ExecuteFetchAsDba job %s is %v after %v", job.id, job.state, job.completedAt.Sub(job.startedAt))
+}
+
+// PollFetchJob returns the state of an async ExecuteFetchAsDba job, and its
+// result once it's complete.
+func (tm *TabletManager) PollFetchJob(ctx context.Context, req *tabletmanagerdatapb.PollFetchJobRequest) (*tabletmanagerdatapb.PollFetchJobResponse, error) {
+	tm.fetchJobs.mu.Lock()
+	defer tm.fetchJobs.mu.Unlock()
+	tm.fetchJobs.pruneLocked(time.Now())
+
+	job, ok := tm.fetchJobs.jobs[req.JobId]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "unknown ExecuteFetchAsDba job %s", req.JobId)
+	}
+	resp := &tabletmanagerdatapb.PollFetchJobResponse{
+		JobId:     job.id,
+		State:     job.state,
+		Query:     job.query,
+		StartedAt: protoutil.TimeToProto(job.startedAt),
+		Result:    job.result,
+		Error:     vterrors.ToVTRPC(job.err),
+	}
+	if !job.completedAt.IsZero() {
+		resp.CompletedAt = protoutil.TimeToProto(job.completedAt)
+	}
+	return resp, nil
+}
+
+// CancelFetchJob stops a running async ExecuteFetchAsDba job, killing its
+// query. Canceling a job that has already finished does nothing.
+func (tm *TabletManager) CancelFetchJob(ctx context.Context, req *tabletmanagerdatapb.CancelFetchJobRequest) (*tabletmanagerdatapb.CancelFetchJobResponse, error) {
+	tm.fetchJobs.mu.Lock()
+	defer tm.fetchJobs.mu.Unlock()
+
+	job, ok := tm.fetchJobs.jobs[req.JobId]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "unknown ExecuteFetchAsDba job %s", req.JobId)
+	}
+	if job.completedAt.IsZero() {
+		log.Infof("Canceling ExecuteFetchAsDba job %s", job.id)
+		job.cancel()
+	}
+	return &tabletmanagerdatapb.CancelFetchJobResponse{}, nil
+}
+
+// killQueryOnDone kills the query running on the MySQL connection with the
+// given ID when ctx is done, until the returned function is called.
+func (tm *TabletManager) killQueryOnDone(ctx context.Context, connID int64) (stop func()) {
+	stopFunc := context.AfterFunc(ctx, func() {
+		killCtx, cancel := context.WithTimeout(context.Background(), killQueryTimeout)
+		defer cancel()
+		conn, err := tm.MysqlDaemon.GetDbaConnection(killCtx)
+		if err != nil {
+			log.Warningf("Failed to get a connection to kill the query of connection %d: %v", connID, err)
+			return
+		}
+		defer conn.Close()
+		if _, err := conn.ExecuteFetch(fmt.Sprintf("kill query %d", connID), 1, false); err != nil {
+			log.Warningf("Failed to kill the query of connection %d: %v", connID, err)
+		}
+	})
+	return func() { stopFunc() }
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/vtenv"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletservermock"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestTabletManager_FetchJobs(t *testing.T) {
+	ctx := context.Background()
+	cp := mysql.ConnParams{}
+	db := fakesqldb.New(t)
+	defer db.Close()
+	daemon := mysqlctl.NewFakeMysqlDaemon(db)
+
+	tm := &TabletManager{
+		MysqlDaemon:            daemon,
+		DBConfigs:              dbconfigs.NewTestDBConfigs(cp, cp, ""),
+		QueryServiceControl:    tabletservermock.NewController(),
+		_waitForGrantsComplete: make(chan struct{}),
+		Env:                    vtenv.NewTestEnv(),
+	}
+	close(tm._waitForGrantsComplete)
+
+	waitForJob := func(t *testing.T, jobID string) *tabletmanagerdatapb.PollFetchJobResponse {
+		var resp *tabletmanagerdatapb.PollFetchJobResponse
+		require.Eventually(t, func() bool {
+			var err error
+			resp, err = tm.PollFetchJob(ctx, &tabletmanagerdatapb.PollFetchJobRequest{JobId: jobID})
+			require.NoError(t, err)
+			return resp.State != tabletmanagerdatapb.PollFetchJobResponse_RUNNING
+		}, 5*time.Second, 10*time.Millisecond)
+		return resp
+	}
+
+	t.Run("complete", func(t *testing.T) {
+		db.AddQuery("select 42", sqltypes.MakeTestResult(sqltypes.MakeTestFields("42", "int64"), "42"))
+		jobID, err := tm.ExecuteFetchAsDbaAsync(ctx, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{
+			Query:   []byte("select 42"),
+			MaxRows: 10,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, jobID)
+
+		resp := waitForJob(t, jobID)
+		assert.Equal(t, tabletmanagerdatapb.PollFetchJobResponse_COMPLETE, resp.State)
+		assert.Equal(t, "select 42", string(resp.Query))
+		assert.NotNil(t, resp.StartedAt)
+		assert.NotNil(t, resp.CompletedAt)
+		assert.Nil(t, resp.Error)
+		require.NotNil(t, resp.Result)
+		assert.Len(t, resp.Result.Rows, 1)
+
+		// Canceling a finished job does nothing.
+		_, err = tm.CancelFetchJob(ctx, &tabletmanagerdatapb.CancelFetchJobRequest{JobId: jobID})
+		require.NoError(t, err)
+		resp = waitForJob(t, jobID)
+		assert.Equal(t, tabletmanagerdatapb.PollFetchJobResponse_COMPLETE, resp.State)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		jobID, err := tm.ExecuteFetchAsDbaAsync(ctx, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{
+			Query: []byte("select unknown"),
+		})
+		require.NoError(t, err)
+
+		resp := waitForJob(t, jobID)
+		assert.Equal(t, tabletmanagerdatapb.PollFetchJobResponse_FAILED, resp.State)
+		assert.Nil(t, resp.Result)
+		require.NotNil(t, resp.Error)
+		assert.Contains(t, resp.Error.Message, "select unknown")
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		release := make(chan struct{})
+		db.AddQuery("select sleep(3600)", &sqltypes.Result{})
+		db.SetBeforeFunc("select sleep(3600)", func() { <-release })
+		// The fake database doesn't kill the query, so the query is released
+		// when it is killed instead.
+		db.AddQueryPatternWithCallback("kill query \\d+", &sqltypes.Result{}, func(string) { close(release) })
+
+		jobID, err := tm.ExecuteFetchAsDbaAsync(ctx, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{
+			Query: []byte("select sleep(3600)"),
+		})
+		require.NoError(t, err)
+
+		resp, err := tm.PollFetchJob(ctx, &tabletmanagerdatapb.PollFetchJobRequest{JobId: jobID})
+		require.NoError(t, err)
+		assert.Equal(t, tabletmanagerdatapb.PollFetchJobResponse_RUNNING, resp.State)
+		assert.Nil(t, resp.CompletedAt)
+
+		// Wait for the query to be running, so that it's killed.
+		require.Eventually(t, func() bool {
+			return db.GetQueryCalledNum("select sleep(3600)") == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		_, err = tm.CancelFetchJob(ctx, &tabletmanagerdatapb.CancelFetchJobRequest{JobId: jobID})
+		require.NoError(t, err)
+
+		resp = waitForJob(t, jobID)
+		assert.Equal(t, tabletmanagerdatapb.PollFetchJobResponse_CANCELED, resp.State)
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, vtrpcpb.Code_CANCELED, resp.Error.Code)
+	})
+
+	t.Run("running jobs are capped", func(t *testing.T) {
+		defer func(limit int) { maxRunningFetchJobs = limit }(maxRunningFetchJobs)
+		maxRunningFetchJobs = 1
+
+		release := make(chan struct{})
+		db.AddQuery("select sleep(60)", &sqltypes.Result{})
+		db.SetBeforeFunc("select sleep(60)", func() { <-release })
+		jobID, err := tm.ExecuteFetchAsDbaAsync(ctx, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{
+			Query: []byte("select sleep(60)"),
+		})
+		require.NoError(t, err)
+
+		_, err = tm.ExecuteFetchAsDbaAsync(ctx, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{
+			Query: []byte("select sleep(60)"),
+		})
+		assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err), "%v", err)
+
+		// Another job can start once the running one is done.
+		close(release)
+		waitForJob(t, jobID)
+		jobID, err = tm.ExecuteFetchAsDbaAsync(ctx, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{
+			Query: []byte("select sleep(60)"),
+		})
+		require.NoError(t, err)
+		waitForJob(t, jobID)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := tm.PollFetchJob(ctx, &tabletmanagerdatapb.PollFetchJobRequest{JobId: "unknown"})
+		assert.ErrorContains(t, err, "unknown ExecuteFetchAsDba job unknown")
+		_, err = tm.CancelFetchJob(ctx, &tabletmanagerdatapb.CancelFetchJobRequest{JobId: "unknown"})
+		assert.ErrorContains(t, err, "unknown ExecuteFetchAsDba job unknown")
+	})
+
+	t.Run("oldest finished jobs are forgotten above the limit", func(t *testing.T) {
+		defer func(limit int) { maxFinishedFetchJobs = limit }(maxFinishedFetchJobs)
+		maxFinishedFetchJobs = 2
+
+		db.AddQuery("select 1", sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64"), "1"))
+		var jobIDs []string
+		for range 3 {
+			jobID, err := tm.ExecuteFetchAsDbaAsync(ctx, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{
+				Query: []byte("select 1"),
+			})
+			require.NoError(t, err)
+			waitForJob(t, jobID)
+			jobIDs = append(jobIDs, jobID)
+		}
+
+		_, err := tm.PollFetchJob(ctx, &tabletmanagerdatapb.PollFetchJobRequest{JobId: jobIDs[0]})
+		assert.ErrorContains(t, err, "unknown ExecuteFetchAsDba job")
+		for _, jobID := range jobIDs[1:] {
+			_, err := tm.PollFetchJob(ctx, &tabletmanagerdatapb.PollFetchJobRequest{JobId: jobID})
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("finished jobs are forgotten", func(t *testing.T) {
+		defer func(retention time.Duration) { fetchJobRetention = retention }(fetchJobRetention)
+		fetchJobRetention = 0
+
+		tm.fetchJobs.mu.Lock()
+		defer tm.fetchJobs.mu.Unlock()
+		tm.fetchJobs.pruneLocked(time.Now().Add(time.Second))
+		assert.Empty(t, tm.fetchJobs.jobs)
+	})
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callinfo"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// settableMysqlGlobalVariables are the dynamic global variables of mysqld that can
+// be changed through SetMysqlVariable. Changing any other variable requires access
+// to mysqld itself.
+var settableMysqlGlobalVariables = []string{
+	"innodb_buffer_pool_size",
+	"innodb_io_capacity",
+	"innodb_io_capacity_max",
+	"long_query_time",
+	"max_connections",
+	"replica_parallel_workers",
+	"slave_parallel_workers",
+	"slow_query_log",
+	"table_open_cache",
+}
+
+var (
+	mysqlVariableNameRegexp    = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+	mysqlNumericVariableRegexp = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+)
+
+func registerMysqlVariablesFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&settableMysqlGlobalVariables, "settable_mysql_global_variables", settableMysqlGlobalVariables, "Global variables of mysqld that can be set with the SetMysqlVariable RPC.")
+}
+
+func init() {
+	servenv.OnParseFor("vtcombo", registerMysqlVariablesFlags)
+	servenv.OnParseFor("vttablet", registerMysqlVariablesFlags)
+}
+
+// GetMysqlVariables returns the current values of the given global variables of
+// mysqld, along with their values in the my.cnf of the tablet. If no name is given,
+// the variables that can be set with SetMysqlVariable are returned.
+func (tm *TabletManager) GetMysqlVariables(ctx context.Context, names []string) ([]*tabletmanagerdatapb.MysqlVariable, error) {
+	if len(names) == 0 {
+		names = settableMysqlGlobalVariables
+	}
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		if !mysqlVariableNameRegexp.MatchString(name) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid variable name: %q", name)
+		}
+		quoted = append(quoted, sqltypes.EncodeStringSQL(strings.ToLower(name)))
+	}
+
+	qr, err := tm.MysqlDaemon.FetchSuperQuery(ctx, fmt.Sprintf("SHOW GLOBAL VARIABLES WHERE Variable_name IN (%s)", strings.Join(quoted, ", ")))
+	if err != nil {
+		return nil, err
+	}
+	variables := make([]*tabletmanagerdatapb.MysqlVariable, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		variable := &tabletmanagerdatapb.MysqlVariable{
+			Name:  row[0].ToString(),
+			Value: row[1].ToString(),
+		}
+		if tm.Cnf != nil {
+			variable.ConfigValue, _ = tm.Cnf.Lookup(variable.Name)
+		}
+		variables = append(variables, variable)
+	}
+	return variables, nil
+}
+
+// SetMysqlVariable sets a dynamic global variable of mysqld, which must be one of
+// --settable_mysql_global_variables, and returns its previous and new values. Every
+// change is logged along with its caller and reason. The change is not persisted
+// to the my.cnf, so it only lasts until mysqld restarts.
+func (tm *TabletManager) SetMysqlVariable(ctx context.Context, name, value, reason string) (string, string, error) {
+	name = strings.ToLower(name)
+	if !isSettableMysqlVariable(name) {
+		return "", "", vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "variable %s cannot be set, see --settable_mysql_global_variables", name)
+	}
+
+	previous, err := tm.getMysqlVariableValue(ctx, name)
+	if err != nil {
+		return "", "", err
+	}
+	// Numeric variables reject quoted values, and the other ones accept them.
+	if !mysqlNumericVariableRegexp.MatchString(value) {
+		value = sqltypes.EncodeStringSQL(value)
+	}
+	if err := tm.MysqlDaemon.ExecuteSuperQueryList(ctx, []string{fmt.Sprintf("SET GLOBAL %s = %s", name, value)}); err != nil {
+		return "", "", err
+	}
+	current, err := tm.getMysqlVariableValue(ctx, name)
+	if err != nil {
+		return "", "", err
+	}
+
+	from := ""
+	if ci, ok := callinfo.FromContext(ctx); ok {
+		from = ci.Text()
+	}
+	log.Infof("SetMysqlVariable: %s changed from %q to %q (from %v, reason: %q)", name, previous, current, from, reason)
+	return previous, current, nil
+}
+
+func (tm *TabletManager) getMysqlVariableValue(ctx context.Context, name string) (string, error) {
+	variables, err := tm.GetMysqlVariables(ctx, []string{name})
+	if err != nil {
+		return "", err
+	}
+	if len(variables) != 1 {
+		return "", vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "unknown variable %s", name)
+	}
+	return variables[0].Value, nil
+}
+
+func isSettableMysqlVariable(name string) bool {
+	for _, settable := range settableMysqlGlobalVariables {
+		if strings.EqualFold(settable, name) {
+			return true
+		}
+	}
+	return false
+}
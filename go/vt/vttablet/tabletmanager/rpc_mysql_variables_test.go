@@ -0,0 +1,77 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl"
+)
+
+func TestMysqlVariables(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.New(t)
+	defer db.Close()
+	daemon := mysqlctl.NewFakeMysqlDaemon(db)
+	daemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW GLOBAL VARIABLES WHERE Variable_name IN ('max_connections', 'read_only')": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar"),
+			"max_connections|500",
+			"read_only|OFF",
+		),
+		"SHOW GLOBAL VARIABLES WHERE Variable_name IN ('max_connections')": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar"),
+			"max_connections|500",
+		),
+		"SHOW GLOBAL VARIABLES WHERE Variable_name IN ('slow_query_log')": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar"),
+			"slow_query_log|OFF",
+		),
+	}
+	tm := &TabletManager{MysqlDaemon: daemon}
+
+	variables, err := tm.GetMysqlVariables(ctx, []string{"MAX_CONNECTIONS", "read_only"})
+	require.NoError(t, err)
+	require.Len(t, variables, 2)
+	assert.Equal(t, "max_connections", variables[0].Name)
+	assert.Equal(t, "500", variables[0].Value)
+	assert.Equal(t, "read_only", variables[1].Name)
+	assert.Equal(t, "OFF", variables[1].Value)
+
+	_, err = tm.GetMysqlVariables(ctx, []string{"max_connections; drop table t"})
+	assert.ErrorContains(t, err, "invalid variable name")
+
+	_, _, err = tm.SetMysqlVariable(ctx, "read_only", "ON", "test")
+	assert.ErrorContains(t, err, "variable read_only cannot be set")
+
+	daemon.ExpectedExecuteSuperQueryList = []string{
+		"SET GLOBAL max_connections = 600",
+		"SET GLOBAL slow_query_log = 'ON'",
+	}
+	previous, _, err := tm.SetMysqlVariable(ctx, "max_connections", "600", "test")
+	require.NoError(t, err)
+	assert.Equal(t, "500", previous)
+	_, _, err = tm.SetMysqlVariable(ctx, "Slow_Query_Log", "ON", "test")
+	require.NoError(t, err)
+	require.NoError(t, daemon.CheckSuperQueryList())
+}
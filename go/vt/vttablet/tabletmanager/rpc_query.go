@@ -22,6 +22,7 @@ import (
 	"vitess.io/vitess/go/constants/sidecar"
 	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -68,6 +69,34 @@ func analyzeExecuteFetchAsDbaMultiQuery(sql string, parser *sqlparser.Parser) (q
 	return queries, parseable, countCreate, allowZeroInDate, nil
 }
 
+// prepareDbaConnection sets the session of a dba connection up for the queries
+// of an ExecuteFetchAsDba request, disabling binlogs and FK checks if requested,
+// and using the database dbName if it exists.
+func prepareDbaConnection(conn *dbconnpool.DBConnection, dbName string, disableBinlogs bool, disableForeignKeyChecks bool) error {
+	// Disable binlogs if necessary.
+	if disableBinlogs {
+		_, err := conn.ExecuteFetch("SET sql_log_bin = OFF", 0, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Disable FK checks if requested.
+	if disableForeignKeyChecks {
+		_, err := conn.ExecuteFetch("SET SESSION foreign_key_checks = OFF", 0, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if dbName != "" {
+		// This execute might fail if db does not exist.
+		// Error is ignored because given query might create this database.
+		_, _ = conn.ExecuteFetch("USE "+sqlescape.EscapeID(dbName), 1, false)
+	}
+	return nil
+}
+
 // ExecuteMultiFetchAsDba will execute the given queries, possibly disabling binlogs and reload schema.
 func (tm *TabletManager) executeMultiFetchAsDba(
 	ctx context.Context,
@@ -77,6 +106,7 @@ func (tm *TabletManager) executeMultiFetchAsDba(
 	reloadSchema bool,
 	disableBinlogs bool,
 	disableForeignKeyChecks bool,
+	killOnCancel bool,
 	validateQueries func(queries []string, countCreate int) error,
 ) ([]*querypb.QueryResult, error) {
 	if err := tm.waitForGrantsToHaveApplied(ctx); err != nil {
@@ -88,27 +118,13 @@ func (tm *TabletManager) executeMultiFetchAsDba(
 		return nil, err
 	}
 	defer conn.Close()
-
-	// Disable binlogs if necessary.
-	if disableBinlogs {
-		_, err := conn.ExecuteFetch("SET sql_log_bin = OFF", 0, false)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// Disable FK checks if requested.
-	if disableForeignKeyChecks {
-		_, err := conn.ExecuteFetch("SET SESSION foreign_key_checks = OFF", 0, false)
-		if err != nil {
-			return nil, err
-		}
+	if killOnCancel {
+		stop := tm.killQueryOnDone(ctx, conn.ID())
+		defer stop()
 	}
 
-	if dbName != "" {
-		// This execute might fail if db does not exist.
-		// Error is ignored because given query might create this database.
-		_, _ = conn.ExecuteFetch("USE "+sqlescape.EscapeID(dbName), 1, false)
+	if err := prepareDbaConnection(conn, dbName, disableBinlogs, disableForeignKeyChecks); err != nil {
+		return nil, err
 	}
 
 	queries, _, countCreate, allowZeroInDate, err := analyzeExecuteFetchAsDbaMultiQuery(sql, tm.Env.Parser())
@@ -184,18 +200,8 @@ func (tm *TabletManager) ExecuteFetchAsDba(ctx context.Context, req *tabletmanag
 		req.ReloadSchema,
 		req.DisableBinlogs,
 		req.DisableForeignKeyChecks,
-		func(queries []string, countCreate int) error {
-			// Up to v19, we allow multi-statement SQL in ExecuteFetchAsDba, but only for the specific case
-			// where all statements are CREATE TABLE or CREATE VIEW. This is to support `ApplySchema --batch-size`.
-			// In v20, we still support multi-statement SQL, but again only if all statements are CREATE TABLE or CREATE VIEW.
-			// We then also add ExecuteMultiFetchAsDba for future use of multiple statements.
-			// In v21 we will not tolerate multi-statement SQL in ExecuteFetchAsDba at all, and
-			// ExecuteMultiFetchAsDba will be the only way to execute multiple statements.
-			if len(queries) > 1 && len(queries) != countCreate {
-				return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "multi statement queries are not supported in ExecuteFetchAsDba unless all are CREATE TABLE or CREATE VIEW")
-			}
-			return nil
-		},
+		false, /* killOnCancel */
+		validateExecuteFetchAsDbaQueries,
 	)
 	if err != nil {
 		return nil, err
@@ -206,6 +212,21 @@ func (tm *TabletManager) ExecuteFetchAsDba(ctx context.Context, req *tabletmanag
 	return results[0], nil
 }
 
+// validateExecuteFetchAsDbaQueries checks the statements of the SQL of an
+// ExecuteFetchAsDba request.
+func validateExecuteFetchAsDbaQueries(queries []string, countCreate int) error {
+	// Up to v19, we allow multi-statement SQL in ExecuteFetchAsDba, but only for the specific case
+	// where all statements are CREATE TABLE or CREATE VIEW. This is to support `ApplySchema --batch-size`.
+	// In v20, we still support multi-statement SQL, but again only if all statements are CREATE TABLE or CREATE VIEW.
+	// We then also add ExecuteMultiFetchAsDba for future use of multiple statements.
+	// In v21 we will not tolerate multi-statement SQL in ExecuteFetchAsDba at all, and
+	// ExecuteMultiFetchAsDba will be the only way to execute multiple statements.
+	if len(queries) > 1 && len(queries) != countCreate {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "multi statement queries are not supported in ExecuteFetchAsDba unless all are CREATE TABLE or CREATE VIEW")
+	}
+	return nil
+}
+
 // ExecuteMultiFetchAsDba will execute the given queries, possibly disabling binlogs and reload schema.
 func (tm *TabletManager) ExecuteMultiFetchAsDba(ctx context.Context, req *tabletmanagerdatapb.ExecuteMultiFetchAsDbaRequest) ([]*querypb.QueryResult, error) {
 	results, err := tm.executeMultiFetchAsDba(
@@ -216,11 +237,62 @@ func (tm *TabletManager) ExecuteMultiFetchAsDba(ctx context.Context, req *tablet
 		req.ReloadSchema,
 		req.DisableBinlogs,
 		req.DisableForeignKeyChecks,
-		nil, // Validation query is not needed for ExecuteMultiFetchAsDba
+		false, /* killOnCancel */
+		nil,   // Validation query is not needed for ExecuteMultiFetchAsDba
 	)
 	return results, err
 }
 
+// streamExecuteFetchAsDbaBufferSize is the size of the rows sent in each
+// response of StreamExecuteFetchAsDba, in bytes.
+const streamExecuteFetchAsDbaBufferSize = 32 * 1024
+
+// StreamExecuteFetchAsDba will execute the given query, possibly disabling
+// binlogs, and send its result as it is read from MySQL, instead of buffering
+// it: the first result only has the fields, and the next ones have the rows.
+// The query is killed if ctx is done before it completes, e.g. when the client
+// goes away.
+func (tm *TabletManager) StreamExecuteFetchAsDba(ctx context.Context, req *tabletmanagerdatapb.StreamExecuteFetchAsDbaRequest, send func(*querypb.QueryResult) error) error {
+	if err := tm.waitForGrantsToHaveApplied(ctx); err != nil {
+		return err
+	}
+	queries, _, _, allowZeroInDate, err := analyzeExecuteFetchAsDbaMultiQuery(string(req.Query), tm.Env.Parser())
+	if err != nil {
+		return err
+	}
+	if len(queries) > 1 {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "multi statement queries are not supported in StreamExecuteFetchAsDba")
+	}
+	uq, err := tm.Env.Parser().ReplaceTableQualifiers(queries[0], sidecar.DefaultName, sidecar.GetName())
+	if err != nil {
+		return err
+	}
+
+	// The connection is closed once the query completes, so its session
+	// doesn't need to be restored.
+	conn, err := tm.MysqlDaemon.GetDbaConnection(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stop := tm.killQueryOnDone(ctx, conn.ID())
+	defer stop()
+
+	if err := prepareDbaConnection(conn, req.DbName, req.DisableBinlogs, req.DisableForeignKeyChecks); err != nil {
+		return err
+	}
+	if allowZeroInDate {
+		if _, err := conn.ExecuteFetch("set @@session.sql_mode=REPLACE(REPLACE(@@session.sql_mode, 'NO_ZERO_DATE', ''), 'NO_ZERO_IN_DATE', '')", 1, false); err != nil {
+			return err
+		}
+	}
+	return conn.ExecuteStreamFetch(uq, func(result *sqltypes.Result) error {
+		return send(sqltypes.ResultToProto3(result))
+	}, func() *sqltypes.Result {
+		return &sqltypes.Result{}
+	}, streamExecuteFetchAsDbaBufferSize)
+}
+
 // ExecuteFetchAsAllPrivs will execute the given query, possibly reloading schema.
 func (tm *TabletManager) ExecuteFetchAsAllPrivs(ctx context.Context, req *tabletmanagerdatapb.ExecuteFetchAsAllPrivsRequest) (*querypb.QueryResult, error) {
 	if err := tm.waitForGrantsToHaveApplied(ctx); err != nil {
@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/vtenv"
 	"vitess.io/vitess/go/vt/vttablet/tabletservermock"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 )
 
@@ -146,3 +147,50 @@ func TestTabletManager_ExecuteFetchAsDba(t *testing.T) {
 		require.Contains(t, got, w)
 	}
 }
+
+func TestTabletManager_StreamExecuteFetchAsDba(t *testing.T) {
+	ctx := context.Background()
+	cp := mysql.ConnParams{}
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQueryPattern("set .*", &sqltypes.Result{})
+	db.AddQueryPattern("use .*", &sqltypes.Result{})
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"), "1|a", "2|b", "3|c")
+	db.AddQuery("select id, name from t", result)
+	daemon := mysqlctl.NewFakeMysqlDaemon(db)
+
+	tm := &TabletManager{
+		MysqlDaemon:            daemon,
+		DBConfigs:              dbconfigs.NewTestDBConfigs(cp, cp, ""),
+		QueryServiceControl:    tabletservermock.NewController(),
+		_waitForGrantsComplete: make(chan struct{}),
+		Env:                    vtenv.NewTestEnv(),
+	}
+	close(tm._waitForGrantsComplete)
+
+	var results []*querypb.QueryResult
+	err := tm.StreamExecuteFetchAsDba(ctx, &tabletmanagerdatapb.StreamExecuteFetchAsDbaRequest{
+		Query:          []byte("select id, name from t"),
+		DbName:         "db",
+		DisableBinlogs: true,
+	}, func(qr *querypb.QueryResult) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Len(t, results[0].Fields, 2)
+	assert.Empty(t, results[0].Rows)
+	assert.Len(t, results[1].Rows, 3)
+	got := strings.Split(db.QueryLog(), ";")
+	for _, w := range []string{"set sql_log_bin = off", "use `db`", "select id, name from t"} {
+		require.Contains(t, got, w)
+	}
+
+	err = tm.StreamExecuteFetchAsDba(ctx, &tabletmanagerdatapb.StreamExecuteFetchAsDbaRequest{
+		Query: []byte("select 1; select 2"),
+	}, func(qr *querypb.QueryResult) error {
+		return nil
+	})
+	assert.ErrorContains(t, err, "multi statement queries are not supported in StreamExecuteFetchAsDba")
+}
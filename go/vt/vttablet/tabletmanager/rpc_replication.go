@@ -34,6 +34,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -176,6 +177,35 @@ func (tm *TabletManager) FullStatus(ctx context.Context) (*replicationdatapb.Ful
 	}, nil
 }
 
+// SemiSyncStatus returns the state of the semi-sync plugin of the tablet.
+func (tm *TabletManager) SemiSyncStatus(ctx context.Context) (*tabletmanagerdatapb.SemiSyncStatusResponse, error) {
+	if err := tm.waitForGrantsToHaveApplied(ctx); err != nil {
+		return nil, err
+	}
+	semiSyncType, err := tm.MysqlDaemon.SemiSyncExtensionLoaded(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if semiSyncType == mysql.SemiSyncTypeOff {
+		return &tabletmanagerdatapb.SemiSyncStatusResponse{}, nil
+	}
+
+	primaryEnabled, replicaEnabled := tm.MysqlDaemon.SemiSyncEnabled(ctx)
+	primaryStatus, replicaStatus := tm.MysqlDaemon.SemiSyncStatus(ctx)
+	timeout, numReplicas := tm.MysqlDaemon.SemiSyncSettings(ctx)
+	return &tabletmanagerdatapb.SemiSyncStatusResponse{
+		PluginLoaded:        true,
+		PrimaryEnabled:      primaryEnabled,
+		ReplicaEnabled:      replicaEnabled,
+		PrimaryStatus:       primaryStatus,
+		ReplicaStatus:       replicaStatus,
+		PrimaryClients:      tm.MysqlDaemon.SemiSyncClients(ctx),
+		PrimaryTimeout:      timeout,
+		WaitForReplicaCount: numReplicas,
+		PrimaryWaitSessions: tm.MysqlDaemon.SemiSyncWaitSessions(ctx),
+	}, nil
+}
+
 // PrimaryStatus returns the replication status for a primary tablet.
 func (tm *TabletManager) PrimaryStatus(ctx context.Context) (*replicationdatapb.PrimaryStatus, error) {
 	if err := tm.waitForGrantsToHaveApplied(ctx); err != nil {
@@ -213,6 +243,79 @@ func (tm *TabletManager) WaitForPosition(ctx context.Context, pos string) error
 	return tm.MysqlDaemon.WaitSourcePos(ctx, mpos)
 }
 
+// defaultWaitForPositionProgressInterval is how often StreamWaitForPosition
+// reports the progress of the tablet when the request doesn't say.
+const defaultWaitForPositionProgressInterval = 10 * time.Second
+
+// StreamWaitForPosition waits until replication reaches the desired position,
+// like WaitForPosition, and sends the position and replication status of the
+// tablet every progress interval meanwhile. The last response, once the
+// position is reached, has Reached set.
+func (tm *TabletManager) StreamWaitForPosition(ctx context.Context, req *tabletmanagerdatapb.StreamWaitForPositionRequest, send func(*tabletmanagerdatapb.StreamWaitForPositionResponse) error) error {
+	log.Infof("StreamWaitForPosition: %v", req.Position)
+	if err := tm.waitForGrantsToHaveApplied(ctx); err != nil {
+		return err
+	}
+	mpos, err := replication.DecodePosition(req.Position)
+	if err != nil {
+		return err
+	}
+	interval, ok, err := protoutil.DurationFromProto(req.ProgressInterval)
+	if err != nil {
+		return err
+	}
+	if !ok || interval <= 0 {
+		interval = defaultWaitForPositionProgressInterval
+	}
+
+	// The wait is stopped if the client goes away, or fails to receive the progress.
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- tm.MysqlDaemon.WaitSourcePos(waitCtx, mpos)
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-done:
+			if err != nil {
+				return err
+			}
+			resp := tm.waitForPositionProgress(ctx)
+			resp.Reached = true
+			return send(resp)
+		case <-ticker.C:
+			if err := send(tm.waitForPositionProgress(ctx)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// waitForPositionProgress returns the current position and replication status
+// of the tablet, as far as they can be read, for StreamWaitForPosition.
+func (tm *TabletManager) waitForPositionProgress(ctx context.Context) *tabletmanagerdatapb.StreamWaitForPositionResponse {
+	resp := &tabletmanagerdatapb.StreamWaitForPositionResponse{}
+	status, err := tm.MysqlDaemon.ReplicationStatus(ctx)
+	if err == nil {
+		resp.Status = replication.ReplicationStatusToProto(status)
+		resp.Position = replication.EncodePosition(status.Position)
+		return resp
+	}
+	if err != mysql.ErrNotReplica {
+		log.Warningf("StreamWaitForPosition: failed to read the replication status: %v", err)
+	}
+	if pos, err := tm.MysqlDaemon.PrimaryPosition(ctx); err == nil {
+		resp.Position = replication.EncodePosition(pos)
+	} else {
+		log.Warningf("StreamWaitForPosition: failed to read the position: %v", err)
+	}
+	return resp
+}
+
 // StopReplication will stop the mysql. Works both when Vitess manages
 // replication or not (using hook if not).
 func (tm *TabletManager) StopReplication(ctx context.Context) error {
@@ -225,7 +328,11 @@ func (tm *TabletManager) StopReplication(ctx context.Context) error {
 	}
 	defer tm.unlock()
 
-	return tm.stopReplicationLocked(ctx)
+	if err := tm.stopReplicationLocked(ctx); err != nil {
+		return err
+	}
+	tm.recordEvent(tabletEventReplication, "stopped replication")
+	return nil
 }
 
 func (tm *TabletManager) stopReplicationLocked(ctx context.Context) error {
@@ -288,7 +395,11 @@ func (tm *TabletManager) StartReplication(ctx context.Context, semiSync bool) er
 	if err := tm.fixSemiSync(ctx, tm.Tablet().Type, semiSyncAction); err != nil {
 		return err
 	}
-	return tm.MysqlDaemon.StartReplication(ctx, tm.hookExtraEnv())
+	if err := tm.MysqlDaemon.StartReplication(ctx, tm.hookExtraEnv()); err != nil {
+		return err
+	}
+	tm.recordEvent(tabletEventReplication, "started replication")
+	return nil
 }
 
 // StartReplicationUntilAfter will start the replication and let it catch up
@@ -334,7 +445,11 @@ func (tm *TabletManager) ResetReplication(ctx context.Context) error {
 	}
 	defer tm.unlock()
 
-	return tm.MysqlDaemon.ResetReplication(ctx)
+	if err := tm.MysqlDaemon.ResetReplication(ctx); err != nil {
+		return err
+	}
+	tm.recordEvent(tabletEventReplication, "reset replication")
+	return nil
 }
 
 // InitPrimary enables writes and returns the replication position.
@@ -461,7 +576,11 @@ func (tm *TabletManager) InitReplica(ctx context.Context, parent *topodatapb.Tab
 	}
 
 	// wait until we get the replicated row, or our context times out
-	return tm.MysqlDaemon.WaitForReparentJournal(ctx, timeCreatedNS)
+	if err := tm.MysqlDaemon.WaitForReparentJournal(ctx, timeCreatedNS); err != nil {
+		return err
+	}
+	tm.recordEvent(tabletEventReplication, "initialized replication from %v", topoproto.TabletAliasString(parent))
+	return nil
 }
 
 // DemotePrimary prepares a PRIMARY tablet to give up leadership to another tablet.
@@ -479,13 +598,24 @@ func (tm *TabletManager) InitReplica(ctx context.Context, parent *topodatapb.Tab
 // or on a tablet that already transitioned to REPLICA.
 //
 // If a step fails in the middle, it will try to undo any changes it made.
-func (tm *TabletManager) DemotePrimary(ctx context.Context) (*replicationdatapb.PrimaryStatus, error) {
+//
+// The handoff, if not nil, is recorded by the query service so that the
+// buffering of the queries during the demotion can be attributed to it.
+func (tm *TabletManager) DemotePrimary(ctx context.Context, handoff *tabletmanagerdatapb.PrimaryHandoff) (*replicationdatapb.PrimaryStatus, error) {
 	log.Infof("DemotePrimary")
 	if err := tm.waitForGrantsToHaveApplied(ctx); err != nil {
 		return nil, err
 	}
+	if handoff != nil {
+		tm.QueryServiceControl.SetPrimaryHandoff(handoff)
+	}
 	// The public version always reverts on partial failure.
-	return tm.demotePrimary(ctx, true /* revertPartialFailure */)
+	primaryStatus, err := tm.demotePrimary(ctx, true /* revertPartialFailure */)
+	if err != nil {
+		return nil, err
+	}
+	tm.recordEvent(tabletEventReplication, "demoted primary")
+	return primaryStatus, nil
 }
 
 // demotePrimary implements DemotePrimary with an additional, private option.
@@ -610,6 +740,7 @@ func (tm *TabletManager) UndoDemotePrimary(ctx context.Context, semiSync bool) e
 	if err := tm.QueryServiceControl.SetServingType(tablet.Type, protoutil.TimeFromProto(tablet.PrimaryTermStartTime).UTC(), true, ""); err != nil {
 		return vterrors.Wrap(err, "SetServingType(serving=true) failed")
 	}
+	tm.recordEvent(tabletEventReplication, "undid primary demotion")
 	return nil
 }
 
@@ -668,7 +799,11 @@ func (tm *TabletManager) SetReplicationSource(ctx context.Context, parentAlias *
 
 	// setReplicationSourceLocked also fixes the semi-sync. In case the tablet type is primary it assumes that it will become a replica if SetReplicationSource
 	// is called, so we always call fixSemiSync with a non-primary tablet type. This will always set the source side replication to false.
-	return tm.setReplicationSourceLocked(ctx, parentAlias, timeCreatedNS, waitPosition, forceStartReplication, semiSyncAction, heartbeatInterval)
+	if err := tm.setReplicationSourceLocked(ctx, parentAlias, timeCreatedNS, waitPosition, forceStartReplication, semiSyncAction, heartbeatInterval); err != nil {
+		return err
+	}
+	tm.recordEvent(tabletEventReplication, "set replication source to %v", topoproto.TabletAliasString(parentAlias))
+	return nil
 }
 
 func (tm *TabletManager) setReplicationSourceSemiSyncNoAction(ctx context.Context, parentAlias *topodatapb.TabletAlias, timeCreatedNS int64, waitPosition string, forceStartReplication bool) error {
@@ -899,8 +1034,9 @@ type StopReplicationAndGetStatusResponse struct {
 	Status *replicationdatapb.StopReplicationStatus
 }
 
-// PromoteReplica makes the current tablet the primary
-func (tm *TabletManager) PromoteReplica(ctx context.Context, semiSync bool) (string, error) {
+// PromoteReplica makes the current tablet the primary. The handoff, if not
+// nil, is recorded by the query service like in DemotePrimary.
+func (tm *TabletManager) PromoteReplica(ctx context.Context, semiSync bool, handoff *tabletmanagerdatapb.PrimaryHandoff) (string, error) {
 	log.Infof("PromoteReplica")
 	if err := tm.waitForGrantsToHaveApplied(ctx); err != nil {
 		return "", err
@@ -910,6 +1046,10 @@ func (tm *TabletManager) PromoteReplica(ctx context.Context, semiSync bool) (str
 	}
 	defer tm.unlock()
 
+	if handoff != nil {
+		tm.QueryServiceControl.SetPrimaryHandoff(handoff)
+	}
+
 	pos, err := tm.MysqlDaemon.Promote(ctx, tm.hookExtraEnv())
 	if err != nil {
 		return "", err
@@ -928,6 +1068,7 @@ func (tm *TabletManager) PromoteReplica(ctx context.Context, semiSync bool) (str
 	if err := tm.changeTypeLocked(ctx, topodatapb.TabletType_PRIMARY, DBActionSetReadWrite, SemiSyncActionNone); err != nil {
 		return "", err
 	}
+	tm.recordEvent(tabletEventReplication, "promoted replica at %v", replication.EncodePosition(pos))
 	return replication.EncodePosition(pos), nil
 }
 
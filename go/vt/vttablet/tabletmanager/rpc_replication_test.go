@@ -21,7 +21,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/mysql/replication"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/mysqlctl"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 )
 
 // TestWaitForGrantsToHaveApplied tests that waitForGrantsToHaveApplied only succeeds after waitForDBAGrants has been called.
@@ -42,3 +52,111 @@ func TestWaitForGrantsToHaveApplied(t *testing.T) {
 	err = tm.waitForGrantsToHaveApplied(secondContext)
 	require.NoError(t, err)
 }
+
+func TestSemiSyncStatus(t *testing.T) {
+	ctx := context.Background()
+	daemon := mysqlctl.NewFakeMysqlDaemon(fakesqldb.New(t))
+	defer daemon.Close()
+	tm := &TabletManager{
+		MysqlDaemon:            daemon,
+		_waitForGrantsComplete: make(chan struct{}),
+	}
+	close(tm._waitForGrantsComplete)
+
+	daemon.SemiSyncPrimaryEnabled = true
+	status, err := tm.SemiSyncStatus(ctx)
+	require.NoError(t, err)
+	utils.MustMatch(t, &tabletmanagerdatapb.SemiSyncStatusResponse{
+		PluginLoaded:        true,
+		PrimaryEnabled:      true,
+		PrimaryStatus:       true,
+		PrimaryTimeout:      10000000,
+		WaitForReplicaCount: 1,
+	}, status)
+
+	daemon.SemiSyncPrimaryEnabled = false
+	daemon.SemiSyncReplicaEnabled = true
+	status, err = tm.SemiSyncStatus(ctx)
+	require.NoError(t, err)
+	utils.MustMatch(t, &tabletmanagerdatapb.SemiSyncStatusResponse{
+		PluginLoaded:        true,
+		ReplicaEnabled:      true,
+		ReplicaStatus:       true,
+		PrimaryTimeout:      10000000,
+		WaitForReplicaCount: 1,
+	}, status)
+}
+
+func TestStreamWaitForPosition(t *testing.T) {
+	ctx := context.Background()
+	daemon := mysqlctl.NewFakeMysqlDaemon(fakesqldb.New(t))
+	defer daemon.Close()
+	tm := &TabletManager{
+		MysqlDaemon:            daemon,
+		_waitForGrantsComplete: make(chan struct{}),
+	}
+	close(tm._waitForGrantsComplete)
+
+	pos, err := replication.DecodePosition("MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-10")
+	require.NoError(t, err)
+	daemon.CurrentPrimaryPosition = pos
+	daemon.ReplicationLagSeconds = 7
+	req := &tabletmanagerdatapb.StreamWaitForPositionRequest{
+		Position:         replication.EncodePosition(pos),
+		ProgressInterval: protoutil.DurationToProto(time.Millisecond),
+	}
+
+	t.Run("progress until the position is reached", func(t *testing.T) {
+		// The wait lasts until two progress responses were sent.
+		release := make(chan struct{})
+		daemon.TimeoutHook = func() error {
+			<-release
+			return nil
+		}
+		defer func() { daemon.TimeoutHook = nil }()
+
+		var resps []*tabletmanagerdatapb.StreamWaitForPositionResponse
+		err := tm.StreamWaitForPosition(ctx, req, func(resp *tabletmanagerdatapb.StreamWaitForPositionResponse) error {
+			resps = append(resps, resp)
+			if len(resps) == 2 {
+				close(release)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, len(resps), 3)
+		for i, resp := range resps {
+			assert.Equal(t, i == len(resps)-1, resp.Reached, "response %d", i)
+			assert.Equal(t, req.Position, resp.Position)
+			require.NotNil(t, resp.Status)
+			assert.EqualValues(t, 7, resp.Status.ReplicationLagSeconds)
+		}
+	})
+
+	t.Run("wait fails", func(t *testing.T) {
+		daemon.TimeoutHook = func() error { return context.DeadlineExceeded }
+		defer func() { daemon.TimeoutHook = nil }()
+
+		err := tm.StreamWaitForPosition(ctx, req, func(resp *tabletmanagerdatapb.StreamWaitForPositionResponse) error {
+			return nil
+		})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("not a replica", func(t *testing.T) {
+		daemon.WaitPrimaryPositions = []replication.Position{pos}
+		daemon.ReplicationStatusError = mysql.ErrNotReplica
+		defer func() { daemon.ReplicationStatusError = nil }()
+
+		var resps []*tabletmanagerdatapb.StreamWaitForPositionResponse
+		err := tm.StreamWaitForPosition(ctx, req, func(resp *tabletmanagerdatapb.StreamWaitForPositionResponse) error {
+			resps = append(resps, resp)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, resps, 1)
+		assert.True(t, resps[0].Reached)
+		assert.Equal(t, req.Position, resps[0].Position)
+		assert.Nil(t, resps[0].Status)
+	})
+}
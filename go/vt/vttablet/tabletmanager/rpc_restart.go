@@ -0,0 +1,146 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"time"
+
+	"vitess.io/vitess/go/mysql/replication"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// PrepareShutdown stops the query service, so that no new queries are
+// accepted, and waits for the in-flight ones to drain. It returns whether the
+// tablet is ready to be restarted, and its GTID position once it stopped
+// serving. Primaries are refused unless allowPrimary is set, since they should
+// be reparented away before they are restarted.
+//
+// The query service stays disabled until the tablet is restarted. If the
+// restart is abandoned, RefreshState makes the tablet serve again according to
+// its tablet type.
+func (tm *TabletManager) PrepareShutdown(ctx context.Context, allowPrimary bool) (bool, string, error) {
+	if err := tm.lock(ctx); err != nil {
+		return false, "", err
+	}
+	defer tm.unlock()
+
+	tablet := tm.Tablet()
+	if tablet.Type == topodatapb.TabletType_PRIMARY && !allowPrimary {
+		return false, "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "tablet %v is PRIMARY, it should be reparented away before it is restarted", topoproto.TabletAliasString(tablet.Alias))
+	}
+
+	// Stopping the query service blocks until the in-flight queries and
+	// transactions are done, or until the shutdown grace period has passed.
+	log.Infof("PrepareShutdown disabling query service")
+	if err := tm.QueryServiceControl.SetServingType(tablet.Type, protoutil.TimeFromProto(tablet.PrimaryTermStartTime).UTC(), false, "preparing for shutdown"); err != nil {
+		return false, "", vterrors.Wrap(err, "SetServingType(serving=false) failed")
+	}
+
+	pos, err := tm.MysqlDaemon.PrimaryPosition(ctx)
+	if err != nil {
+		return false, "", err
+	}
+	return !tm.QueryServiceControl.IsServing(), replication.EncodePosition(pos), nil
+}
+
+// GracefulRestart restarts mysqld under the supervision of the tablet. The query
+// service is stopped, mysqld is shut down, its configuration is regenerated if
+// reinitConfig is set, and it's started again with the extra mysqldArgs. Once
+// mysqld is back up, replication is restarted if it was running before, and the
+// query service is restored according to the tablet state. It returns the GTID
+// position of the tablet once mysqld is back up. Like in PrepareShutdown,
+// primaries are refused unless allowPrimary is set, since their shard can't be
+// written to while mysqld is down.
+func (tm *TabletManager) GracefulRestart(ctx context.Context, shutdownTimeout time.Duration, reinitConfig bool, mysqldArgs []string, allowPrimary bool) (string, error) {
+	if tm.Cnf == nil {
+		return "", vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "mysqld is not managed by this tablet")
+	}
+	if err := tm.lock(ctx); err != nil {
+		return "", err
+	}
+	defer tm.unlock()
+
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = mysqlShutdownTimeout
+	}
+
+	tablet := tm.Tablet()
+	if tablet.Type == topodatapb.TabletType_PRIMARY && !allowPrimary {
+		return "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "tablet %v is PRIMARY, it should be reparented away before it is restarted", topoproto.TabletAliasString(tablet.Alias))
+	}
+
+	wasReplicating := false
+	if tablet.Type != topodatapb.TabletType_PRIMARY {
+		if status, err := tm.MysqlDaemon.ReplicationStatus(ctx); err == nil {
+			wasReplicating = status.Running()
+		}
+	}
+
+	if tm.QueryServiceControl.IsServing() {
+		log.Infof("GracefulRestart disabling query service")
+		if err := tm.QueryServiceControl.SetServingType(tablet.Type, protoutil.TimeFromProto(tablet.PrimaryTermStartTime).UTC(), false, "restarting mysqld"); err != nil {
+			return "", vterrors.Wrap(err, "SetServingType(serving=false) failed")
+		}
+	}
+
+	log.Infof("GracefulRestart shutting down mysqld")
+	if err := tm.MysqlDaemon.Shutdown(ctx, tm.Cnf, true, shutdownTimeout); err != nil {
+		return "", vterrors.Wrap(err, "failed to shut down mysqld")
+	}
+
+	// If the configuration can't be regenerated, mysqld is still started with
+	// the previous one rather than leaving the tablet without mysqld.
+	var reinitErr error
+	if reinitConfig {
+		log.Infof("GracefulRestart regenerating mysqld configuration")
+		reinitErr = tm.MysqlDaemon.ReinitConfig(ctx, tm.Cnf)
+	}
+
+	log.Infof("GracefulRestart starting mysqld")
+	if err := tm.MysqlDaemon.Start(ctx, tm.Cnf, mysqldArgs...); err != nil {
+		return "", vterrors.Wrap(err, "failed to start mysqld")
+	}
+
+	if wasReplicating {
+		if err := tm.MysqlDaemon.StartReplication(ctx, tm.hookExtraEnv()); err != nil {
+			return "", vterrors.Wrap(err, "failed to restart replication")
+		}
+	}
+
+	// Let the tablet state decide whether the query service should be serving
+	// again, like after any other state change.
+	if err := tm.tmState.RefreshFromTopo(ctx); err != nil {
+		return "", err
+	}
+
+	if reinitErr != nil {
+		return "", vterrors.Wrap(reinitErr, "mysqld was restarted with its previous configuration, failed to regenerate it")
+	}
+
+	pos, err := tm.MysqlDaemon.PrimaryPosition(ctx)
+	if err != nil {
+		return "", err
+	}
+	return replication.EncodePosition(pos), nil
+}
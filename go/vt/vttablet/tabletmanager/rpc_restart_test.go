@@ -0,0 +1,78 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestRestartRefusesPrimary(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts := memorytopo.NewServer(ctx, "cell1")
+	tm := newTestTM(t, ts, 1, "ks", "0")
+	defer tm.Stop()
+
+	tm.Cnf = &mysqlctl.Mycnf{}
+	daemon := tm.MysqlDaemon.(*mysqlctl.FakeMysqlDaemon)
+	daemon.Running = true
+	require.NoError(t, tm.tmState.ChangeTabletType(ctx, topodatapb.TabletType_PRIMARY, DBActionNone))
+	require.True(t, tm.QueryServiceControl.IsServing())
+
+	_, _, err := tm.PrepareShutdown(ctx, false)
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+
+	// A primary is left serving with mysqld running.
+	_, err = tm.GracefulRestart(ctx, 0, false, nil, false)
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+	assert.True(t, tm.QueryServiceControl.IsServing())
+	assert.True(t, daemon.Running)
+
+	_, err = tm.GracefulRestart(ctx, 0, false, nil, true)
+	require.NoError(t, err)
+	assert.True(t, daemon.Running)
+}
+
+func TestPrepareShutdownAbandoned(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts := memorytopo.NewServer(ctx, "cell1")
+	tm := newTestTM(t, ts, 1, "ks", "0")
+	defer tm.Stop()
+	require.True(t, tm.QueryServiceControl.IsServing())
+
+	ready, _, err := tm.PrepareShutdown(ctx, false)
+	require.NoError(t, err)
+	assert.True(t, ready)
+	assert.False(t, tm.QueryServiceControl.IsServing())
+
+	// If the restart is abandoned, refreshing the state makes the tablet
+	// serve again.
+	require.NoError(t, tm.RefreshState(ctx))
+	assert.True(t, tm.QueryServiceControl.IsServing())
+}
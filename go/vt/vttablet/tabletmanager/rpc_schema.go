@@ -17,16 +17,23 @@ limitations under the License.
 package tabletmanager
 
 import (
-	"vitess.io/vitess/go/mysql/replication"
-	"vitess.io/vitess/go/vt/vterrors"
-
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
 
+	"vitess.io/vitess/go/mysql/replication"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 // GetSchema returns the schema.
@@ -34,6 +41,151 @@ func (tm *TabletManager) GetSchema(ctx context.Context, request *tabletmanagerda
 	return tm.MysqlDaemon.GetSchema(ctx, topoproto.TabletDbName(tm.Tablet()), request)
 }
 
+// GetSchemaHash returns the hash of the schema, as computed by
+// tmutils.SchemaHash, so that the schema only needs to be sent to the clients
+// when it differs from the one they compare it to.
+func (tm *TabletManager) GetSchemaHash(ctx context.Context, request *tabletmanagerdatapb.GetSchemaHashRequest) (string, error) {
+	sd, err := tm.GetSchema(ctx, &tabletmanagerdatapb.GetSchemaRequest{
+		Tables:        request.Tables,
+		IncludeViews:  request.IncludeViews,
+		ExcludeTables: request.ExcludeTables,
+		// the columns are not part of the hash
+		TableSchemaOnly: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	return tmutils.SchemaHash(sd), nil
+}
+
+// streamSchemaChangesID makes the names of the schema engine notifiers of
+// concurrent StreamSchemaChanges calls unique.
+var streamSchemaChangesID atomic.Int64
+
+// StreamSchemaChanges sends the changes to the schema of the tablet as they
+// are detected by the schema engine, until the context is done. Changes that
+// are detected while the previous ones are being sent are coalesced into the
+// next response.
+func (tm *TabletManager) StreamSchemaChanges(ctx context.Context, request *tabletmanagerdatapb.StreamSchemaChangesRequest, send func(*tabletmanagerdatapb.StreamSchemaChangesResponse) error) error {
+	se := tm.QueryServiceControl.SchemaEngine()
+	if !se.IsOpen() {
+		return vterrors.New(vtrpcpb.Code_UNAVAILABLE, "schema engine is not open")
+	}
+
+	var watched map[string]bool
+	if len(request.Tables) > 0 {
+		watched = make(map[string]bool, len(request.Tables))
+		for _, table := range request.Tables {
+			watched[table] = true
+		}
+	}
+
+	// The notifier is called while the schema engine is locked, so it only
+	// records the changes: they are sent by the loop below.
+	var (
+		mu sync.Mutex
+		// changes tells for every changed table whether it still exists.
+		changes = make(map[string]bool)
+		tables  map[string]*schema.Table
+	)
+	ready := make(chan struct{}, 1)
+	name := fmt.Sprintf("StreamSchemaChanges-%d", streamSchemaChangesID.Add(1))
+	se.RegisterNotifier(name, func(full map[string]*schema.Table, created, altered, dropped []*schema.Table, _ bool) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		record := func(changed []*schema.Table, exists bool) {
+			for _, table := range changed {
+				name := table.Name.String()
+				if watched == nil || watched[name] {
+					changes[name] = exists
+				}
+			}
+		}
+		record(created, true)
+		record(altered, true)
+		record(dropped, false)
+		tables = full
+
+		if len(changes) > 0 {
+			select {
+			case ready <- struct{}{}:
+			default:
+			}
+		}
+	}, false)
+	defer se.UnregisterNotifier(name)
+
+	dbName := topoproto.TabletDbName(tm.Tablet())
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ready:
+		}
+
+		mu.Lock()
+		pending, full := changes, tables
+		changes = make(map[string]bool)
+		mu.Unlock()
+
+		response := &tabletmanagerdatapb.StreamSchemaChangesResponse{
+			SchemaHash: schemaHash(full),
+		}
+		var changed []string
+		for table, exists := range pending {
+			if exists {
+				changed = append(changed, table)
+			} else {
+				response.DroppedTables = append(response.DroppedTables, table)
+			}
+		}
+		slices.Sort(changed)
+		slices.Sort(response.DroppedTables)
+
+		if len(changed) > 0 {
+			sd, err := tm.MysqlDaemon.GetSchema(ctx, dbName, &tabletmanagerdatapb.GetSchemaRequest{
+				Tables:          changed,
+				IncludeViews:    true,
+				TableSchemaOnly: true,
+			})
+			if err != nil {
+				return vterrors.Wrapf(err, "StreamSchemaChanges: cannot get the schema of %v", changed)
+			}
+			response.Tables = sd.TableDefinitions
+		}
+
+		pos, err := tm.MysqlDaemon.PrimaryPosition(ctx)
+		if err != nil {
+			return vterrors.Wrap(err, "StreamSchemaChanges: cannot get the replication position")
+		}
+		response.Position = replication.EncodePosition(pos)
+
+		if err := send(response); err != nil {
+			return err
+		}
+	}
+}
+
+// schemaHash returns a hash of the tables known to the schema engine, which
+// changes whenever a table is created, dropped or renamed, or its columns change.
+func schemaHash(tables map[string]*schema.Table) string {
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(h, "%s\n", name)
+		for _, field := range tables[name].Fields {
+			fmt.Fprintf(h, "\t%s %s %s\n", field.Name, field.Type, field.ColumnType)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 // ReloadSchema will reload the schema
 // This doesn't need the action mutex because periodic schema reloads happen
 // in the background anyway.
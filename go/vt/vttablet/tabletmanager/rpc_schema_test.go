@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestSchemaHash(t *testing.T) {
+	table := func(name string, fields ...*querypb.Field) *schema.Table {
+		return &schema.Table{Name: sqlparser.NewIdentifierCS(name), Fields: fields}
+	}
+	id := &querypb.Field{Name: "id", Type: sqltypes.Int64, ColumnType: "bigint"}
+	val := &querypb.Field{Name: "val", Type: sqltypes.VarChar, ColumnType: "varchar(64)"}
+
+	hash := schemaHash(map[string]*schema.Table{
+		"t1": table("t1", id, val),
+		"t2": table("t2", id),
+	})
+	assert.Equal(t, hash, schemaHash(map[string]*schema.Table{
+		"t2": table("t2", id),
+		"t1": table("t1", id, val),
+	}), "the hash doesn't depend on the order of the tables")
+
+	for _, changed := range []map[string]*schema.Table{
+		{"t1": table("t1", id, val)},
+		{"t1": table("t1", id, val), "t3": table("t3", id)},
+		{"t1": table("t1", id), "t2": table("t2", id)},
+		{"t1": table("t1", id, &querypb.Field{Name: "val", Type: sqltypes.VarChar, ColumnType: "varchar(128)"}), "t2": table("t2", id)},
+	} {
+		assert.NotEqual(t, hash, schemaHash(changed))
+	}
+}
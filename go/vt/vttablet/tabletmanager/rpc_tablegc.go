@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"time"
+)
+
+// PauseTableGC pauses the table garbage collector on behalf of requester, so that
+// no table is purged, renamed or dropped until the pause expires or is released.
+// It returns the time at which the pause expires.
+func (tm *TabletManager) PauseTableGC(ctx context.Context, requester string, duration time.Duration) (time.Time, error) {
+	return tm.QueryServiceControl.PauseTableGC(requester, duration)
+}
+
+// ResumeTableGC releases the pause held by requester on the table garbage collector.
+func (tm *TabletManager) ResumeTableGC(ctx context.Context, requester string) error {
+	tm.QueryServiceControl.ResumeTableGC(requester)
+	return nil
+}
+
+// RestoreGCTable renames a table held by the table garbage collector back to an
+// ordinary name, and returns that name.
+func (tm *TabletManager) RestoreGCTable(ctx context.Context, tableName string, restoreAs string) (string, error) {
+	return tm.QueryServiceControl.RestoreGCTable(ctx, tableName, restoreAs)
+}
@@ -10,3 +10,15 @@ func (tm *TabletManager) VDiff(ctx context.Context, req *tabletmanagerdatapb.VDi
 	resp, err := tm.VDiffEngine.PerformVDiffAction(ctx, req)
 	return resp, err
 }
+
+func (tm *TabletManager) CreateVDiff(ctx context.Context, req *tabletmanagerdatapb.CreateVDiffRequest) (*tabletmanagerdatapb.CreateVDiffResponse, error) {
+	return tm.VDiffEngine.CreateVDiff(ctx, req)
+}
+
+func (tm *TabletManager) ShowVDiff(ctx context.Context, req *tabletmanagerdatapb.ShowVDiffRequest) (*tabletmanagerdatapb.ShowVDiffResponse, error) {
+	return tm.VDiffEngine.ShowVDiff(ctx, req)
+}
+
+func (tm *TabletManager) StopVDiff(ctx context.Context, req *tabletmanagerdatapb.StopVDiffRequest) (*tabletmanagerdatapb.StopVDiffResponse, error) {
+	return tm.VDiffEngine.StopVDiff(ctx, req)
+}
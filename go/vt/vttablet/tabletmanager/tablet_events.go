@@ -0,0 +1,164 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"vitess.io/vitess/go/protoutil"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// The kinds of the tablet events.
+const (
+	tabletEventTabletType   = "tablet_type"
+	tabletEventServingState = "serving_state"
+	tabletEventReplication  = "replication"
+	tabletEventTableGC      = "table_gc"
+)
+
+// tabletEventsBufferSize is the number of recent events kept to resume the
+// streams of the clients that reconnect.
+const tabletEventsBufferSize = 1000
+
+// tabletEvents records the state transitions of the tablet, and lets the
+// clients of StreamTabletEvents follow them. The events are numbered in a
+// stream, which is identified by a random id so that the clients notice
+// when the tablet restarts. The zero value is ready to use.
+type tabletEvents struct {
+	mu       sync.Mutex
+	streamID string
+	sequence uint64
+	// recent holds the last events, with consecutive sequence numbers.
+	recent []*tabletmanagerdatapb.TabletEvent
+	// changed is closed when an event is recorded.
+	changed chan struct{}
+}
+
+func (te *tabletEvents) initLocked() {
+	if te.streamID == "" {
+		te.streamID = uuid.NewString()
+	}
+	if te.changed == nil {
+		te.changed = make(chan struct{})
+	}
+}
+
+// record adds an event to the stream, and wakes up its clients.
+func (te *tabletEvents) record(kind, message string) {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	te.initLocked()
+
+	te.sequence++
+	if len(te.recent) == tabletEventsBufferSize {
+		copy(te.recent, te.recent[1:])
+		te.recent = te.recent[:len(te.recent)-1]
+	}
+	te.recent = append(te.recent, &tabletmanagerdatapb.TabletEvent{
+		Sequence: te.sequence,
+		Time:     protoutil.TimeToProto(time.Now()),
+		Kind:     kind,
+		Message:  message,
+	})
+	close(te.changed)
+	te.changed = make(chan struct{})
+}
+
+// resume returns the id of the stream, and the sequence number after which a
+// client must be sent the events to resume its stream. It returns true if
+// the client can't resume its stream and must resync, in which case it is
+// only sent the events that follow.
+func (te *tabletEvents) resume(streamID string, after uint64) (string, uint64, bool) {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	te.initLocked()
+
+	if streamID != te.streamID || after > te.sequence || te.missedLocked(after) {
+		return te.streamID, te.sequence, true
+	}
+	return te.streamID, after, false
+}
+
+// since returns the events that follow the given sequence number, and a
+// channel that is closed when there are more. If some of them are no longer
+// available, it returns true along with the current sequence number instead.
+func (te *tabletEvents) since(after uint64) ([]*tabletmanagerdatapb.TabletEvent, uint64, <-chan struct{}, bool) {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	te.initLocked()
+
+	if te.missedLocked(after) {
+		return nil, te.sequence, te.changed, true
+	}
+	var events []*tabletmanagerdatapb.TabletEvent
+	if n := te.sequence - after; n > 0 {
+		events = append(events, te.recent[uint64(len(te.recent))-n:]...)
+	}
+	return events, after, te.changed, false
+}
+
+// missedLocked returns whether some of the events that follow the given
+// sequence number have been dropped from the buffer.
+func (te *tabletEvents) missedLocked(after uint64) bool {
+	return len(te.recent) > 0 && te.recent[0].Sequence > after+1
+}
+
+// recordEvent records a state transition of the tablet for StreamTabletEvents.
+func (tm *TabletManager) recordEvent(kind, format string, args ...any) {
+	tm.events.record(kind, fmt.Sprintf(format, args...))
+}
+
+// StreamTabletEvents sends the state transitions of the tablet as they happen,
+// until the context is done. The first response tells whether the client must
+// resync its view of the tablet, i.e. whether the stream of the request can't
+// be resumed from its sequence number. A client that falls behind the events
+// kept by the tablet is asked to resync again.
+func (tm *TabletManager) StreamTabletEvents(ctx context.Context, request *tabletmanagerdatapb.StreamTabletEventsRequest, send func(*tabletmanagerdatapb.StreamTabletEventsResponse) error) error {
+	streamID, after, resync := tm.events.resume(request.StreamId, request.AfterSequence)
+	if err := send(&tabletmanagerdatapb.StreamTabletEventsResponse{StreamId: streamID, Resync: resync}); err != nil {
+		return err
+	}
+
+	for {
+		events, sequence, changed, missed := tm.events.since(after)
+		if missed {
+			if err := send(&tabletmanagerdatapb.StreamTabletEventsResponse{StreamId: streamID, Resync: true}); err != nil {
+				return err
+			}
+		}
+		after = sequence
+		for _, event := range events {
+			if err := send(&tabletmanagerdatapb.StreamTabletEventsResponse{StreamId: streamID, Event: event}); err != nil {
+				return err
+			}
+			after = event.Sequence
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestStreamTabletEvents(t *testing.T) {
+	tm := &TabletManager{}
+	for i := 1; i <= 3; i++ {
+		tm.recordEvent(tabletEventReplication, "event %d", i)
+	}
+
+	stream := func(request *tabletmanagerdatapb.StreamTabletEventsRequest) (<-chan *tabletmanagerdatapb.StreamTabletEventsResponse, context.CancelFunc) {
+		ctx, cancel := context.WithCancel(context.Background())
+		responses := make(chan *tabletmanagerdatapb.StreamTabletEventsResponse, 10)
+		go tm.StreamTabletEvents(ctx, request, func(response *tabletmanagerdatapb.StreamTabletEventsResponse) error {
+			select {
+			case responses <- response:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		return responses, cancel
+	}
+	next := func(responses <-chan *tabletmanagerdatapb.StreamTabletEventsResponse) *tabletmanagerdatapb.StreamTabletEventsResponse {
+		select {
+		case response := <-responses:
+			return response
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for a response")
+			return nil
+		}
+	}
+
+	// A new stream must resync, and is only sent the events that follow.
+	responses, cancel := stream(&tabletmanagerdatapb.StreamTabletEventsRequest{})
+	first := next(responses)
+	assert.True(t, first.Resync)
+	assert.Nil(t, first.Event)
+	streamID := first.StreamId
+	require.NotEmpty(t, streamID)
+	tm.recordEvent(tabletEventTabletType, "event 4")
+	response := next(responses)
+	assert.Equal(t, streamID, response.StreamId)
+	assert.False(t, response.Resync)
+	assert.EqualValues(t, 4, response.Event.Sequence)
+	assert.Equal(t, tabletEventTabletType, response.Event.Kind)
+	assert.Equal(t, "event 4", response.Event.Message)
+	cancel()
+
+	// A stream is resumed after the given sequence number.
+	responses, cancel = stream(&tabletmanagerdatapb.StreamTabletEventsRequest{StreamId: streamID, AfterSequence: 2})
+	assert.False(t, next(responses).Resync)
+	assert.EqualValues(t, 3, next(responses).Event.Sequence)
+	assert.EqualValues(t, 4, next(responses).Event.Sequence)
+	cancel()
+
+	// The stream of another tablet, or of the tablet before a restart, must resync.
+	responses, cancel = stream(&tabletmanagerdatapb.StreamTabletEventsRequest{StreamId: "other", AfterSequence: 2})
+	first = next(responses)
+	assert.True(t, first.Resync)
+	assert.Equal(t, streamID, first.StreamId)
+	tm.recordEvent(tabletEventReplication, "event 5")
+	assert.EqualValues(t, 5, next(responses).Event.Sequence)
+	cancel()
+
+	// The stream must resync once the events that follow its sequence number are dropped.
+	for i := 6; i <= tabletEventsBufferSize+5; i++ {
+		tm.recordEvent(tabletEventReplication, "event %d", i)
+	}
+	responses, cancel = stream(&tabletmanagerdatapb.StreamTabletEventsRequest{StreamId: streamID, AfterSequence: 4})
+	assert.True(t, next(responses).Resync)
+	cancel()
+	responses, cancel = stream(&tabletmanagerdatapb.StreamTabletEventsRequest{StreamId: streamID, AfterSequence: 5})
+	assert.False(t, next(responses).Resync)
+	assert.EqualValues(t, 6, next(responses).Event.Sequence)
+	cancel()
+
+	// So must a client that falls behind.
+	events, sequence, _, missed := tm.events.since(4)
+	assert.True(t, missed)
+	assert.Empty(t, events)
+	assert.EqualValues(t, tabletEventsBufferSize+5, sequence)
+	events, _, _, missed = tm.events.since(tabletEventsBufferSize + 3)
+	assert.False(t, missed)
+	require.Len(t, events, 2)
+	assert.Equal(t, fmt.Sprintf("event %d", tabletEventsBufferSize+5), events[1].Message)
+}
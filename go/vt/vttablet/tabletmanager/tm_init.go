@@ -160,6 +160,13 @@ type TabletManager struct {
 	// tmState manages the TabletManager state.
 	tmState *tmState
 
+	// events records the state transitions of the tablet for
+	// StreamTabletEvents.
+	events tabletEvents
+
+	// fetchJobs are the async ExecuteFetchAsDba jobs.
+	fetchJobs fetchJobs
+
 	// tabletAlias is saved away from tablet for read-only access
 	tabletAlias *topodatapb.TabletAlias
 
@@ -173,6 +180,13 @@ type TabletManager struct {
 	// first before other mutexes.
 	actionSema *semaphore.Weighted
 
+	// snapshotSema is held while a consistent snapshot is acquired or
+	// released, and protects heldSnapshot. It is separate from mutex since
+	// acquiring a snapshot waits for the tables to be locked.
+	snapshotSema *semaphore.Weighted
+	// heldSnapshot is the snapshot held by AcquireConsistentSnapshot, if any.
+	heldSnapshot *consistentSnapshot
+
 	// mutex protects all the following fields (that start with '_'),
 	// only hold the mutex to update the fields, nothing else.
 	mutex sync.Mutex
@@ -353,6 +367,7 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, config *tabletenv.Tabl
 	tm.tabletAlias = tablet.Alias
 	tm.tmState = newTMState(tm, tablet)
 	tm.actionSema = semaphore.NewWeighted(1)
+	tm.snapshotSema = semaphore.NewWeighted(1)
 	tm._waitForGrantsComplete = make(chan struct{})
 
 	tm.baseTabletType = tablet.Type
@@ -382,6 +397,9 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, config *tabletenv.Tabl
 		return vterrors.Wrap(err, "failed to InitDBConfig")
 	}
 	tm.QueryServiceControl.RegisterQueryRuleSource(denyListQueryList)
+	tm.QueryServiceControl.SetTableGCEventListener(func(message string) {
+		tm.recordEvent(tabletEventTableGC, "%s", message)
+	})
 
 	if tm.UpdateStream != nil {
 		tm.UpdateStream.InitDBConfig(tm.DBConfigs)
@@ -78,6 +78,8 @@ type tmState struct {
 	deniedTables    map[topodatapb.TabletType][]string
 	tablet          *topodatapb.Tablet
 	isPublishing    bool
+	// servingState is the serving state last recorded as a tablet event.
+	servingState string
 
 	// displayState contains the current snapshot of the internal state
 	// and has its own mutex.
@@ -184,6 +186,7 @@ func (ts *tmState) ChangeTabletType(ctx context.Context, tabletType topodatapb.T
 	ts.mu.Lock()
 	defer ts.mu.Unlock()
 	log.Infof("Changing Tablet Type: %v for %s", tabletType, ts.tablet.Alias.String())
+	fromType := ts.tablet.Type
 
 	if tabletType == topodatapb.TabletType_PRIMARY {
 		PrimaryTermStartTime := protoutil.TimeToProto(time.Now())
@@ -231,6 +234,9 @@ func (ts *tmState) ChangeTabletType(ctx context.Context, tabletType topodatapb.T
 	s := topoproto.TabletTypeLString(tabletType)
 	statsTabletType.Set(s)
 	statsTabletTypeCount.Add(s, 1)
+	if fromType != tabletType {
+		ts.tm.recordEvent(tabletEventTabletType, "changed from %v to %v", fromType, tabletType)
+	}
 
 	err := ts.updateLocked(ctx)
 	// No need to short circuit. Apply all steps and return error in the end.
@@ -332,10 +338,27 @@ func (ts *tmState) updateLocked(ctx context.Context) error {
 			returnErr = vterrors.Wrapf(err, errStr)
 		}
 	}
+	ts.recordServingStateLocked(reason)
 
 	return returnErr
 }
 
+// recordServingStateLocked records the serving state of the query service as
+// a tablet event if it changed.
+func (ts *tmState) recordServingStateLocked(reason string) {
+	state := fmt.Sprintf("serving as %v", ts.tablet.Type)
+	if !ts.tm.QueryServiceControl.IsServing() {
+		state = "not serving"
+		if reason != "" {
+			state += ": " + reason
+		}
+	}
+	if state != ts.servingState {
+		ts.servingState = state
+		ts.tm.recordEvent(tabletEventServingState, "%s", state)
+	}
+}
+
 func (ts *tmState) canServe(tabletType topodatapb.TabletType) string {
 	if !topo.IsRunningQueryService(tabletType) {
 		return fmt.Sprintf("not a serving tablet type(%v)", tabletType)
@@ -443,3 +443,89 @@ func (vde *Engine) handleDeleteAction(ctx context.Context, dbClient binlogplayer
 
 	return nil
 }
+
+// CreateVDiff creates a vdiff of a workflow and starts it, like PerformVDiffAction
+// does for a create action.
+func (vde *Engine) CreateVDiff(ctx context.Context, req *tabletmanagerdatapb.CreateVDiffRequest) (*tabletmanagerdatapb.CreateVDiffResponse, error) {
+	resp, err := vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{
+		Keyspace:  req.GetKeyspace(),
+		Workflow:  req.GetWorkflow(),
+		Action:    string(CreateAction),
+		VdiffUuid: req.GetVdiffUuid(),
+		Options:   req.GetOptions(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.CreateVDiffResponse{
+		Id:        resp.Id,
+		VdiffUuid: resp.VdiffUuid,
+	}, nil
+}
+
+// ShowVDiff returns the state of a vdiff of a workflow and the progress of each
+// of its tables. If the request has no vdiff UUID, the most recent vdiff of the
+// workflow is shown; an empty response is returned if the workflow has none.
+func (vde *Engine) ShowVDiff(ctx context.Context, req *tabletmanagerdatapb.ShowVDiffRequest) (*tabletmanagerdatapb.ShowVDiffResponse, error) {
+	arg := req.GetVdiffUuid()
+	if arg == "" {
+		arg = LastActionArg
+	}
+	resp, err := vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{
+		Keyspace:  req.GetKeyspace(),
+		Workflow:  req.GetWorkflow(),
+		Action:    string(ShowAction),
+		ActionArg: arg,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newShowVDiffResponse(resp.VdiffUuid, resp.Output), nil
+}
+
+// newShowVDiffResponse builds the response of ShowVDiff from the rows of sqlVDiffSummary,
+// which have one row per table of the vdiff.
+func newShowVDiffResponse(vdiffUUID string, summary *query.QueryResult) *tabletmanagerdatapb.ShowVDiffResponse {
+	resp := &tabletmanagerdatapb.ShowVDiffResponse{}
+	if summary == nil {
+		return resp
+	}
+	resp.VdiffUuid = vdiffUUID
+	for i, row := range sqltypes.Proto3ToResult(summary).Named().Rows {
+		if i == 0 {
+			resp.State = row.AsString("vdiff_state", "")
+			resp.LastError = row.AsString("last_error", "")
+			resp.StartedAt = row.AsString("started_at", "")
+			resp.CompletedAt = row.AsString("completed_at", "")
+		}
+		// The vdiff has no tables until it's initialized.
+		tableName := row.AsString("table_name", "")
+		if tableName == "" {
+			continue
+		}
+		resp.Tables = append(resp.Tables, &tabletmanagerdatapb.VDiffTableProgress{
+			TableName:    tableName,
+			State:        row.AsString("table_state", ""),
+			TableRows:    row.AsInt64("table_rows", 0),
+			RowsCompared: row.AsInt64("rows_compared", 0),
+			HasMismatch:  row.AsBool("has_mismatch", false),
+			Report:       row.AsString("report", ""),
+		})
+	}
+	return resp
+}
+
+// StopVDiff stops a running vdiff of a workflow, like PerformVDiffAction does for
+// a stop action.
+func (vde *Engine) StopVDiff(ctx context.Context, req *tabletmanagerdatapb.StopVDiffRequest) (*tabletmanagerdatapb.StopVDiffResponse, error) {
+	_, err := vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{
+		Keyspace:  req.GetKeyspace(),
+		Workflow:  req.GetWorkflow(),
+		Action:    string(StopAction),
+		VdiffUuid: req.GetVdiffUuid(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.StopVDiffResponse{}, nil
+}
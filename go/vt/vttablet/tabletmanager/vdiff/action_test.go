@@ -253,3 +253,48 @@ func TestPerformVDiffAction(t *testing.T) {
 		require.Equal(t, errCount, globalStats.ErrorCount.Get(), "expected error count %d, got %d", errCount, globalStats.ErrorCount.Get())
 	}
 }
+
+func TestNewShowVDiffResponse(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"vdiff_state|last_error|table_name|uuid|table_state|table_rows|started_at|rows_compared|completed_at|has_mismatch|report",
+		"varbinary|varbinary|varbinary|varchar|varbinary|int64|timestamp|int64|timestamp|int64|json",
+	)
+	vdiffUUID := uuid.New().String()
+
+	require.Equal(t, &tabletmanagerdatapb.ShowVDiffResponse{}, newShowVDiffResponse("", nil))
+
+	// A vdiff that has not been initialized yet has no tables.
+	pending := sqltypes.MakeTestResult(fields,
+		"pending||NULL|"+vdiffUUID+"|NULL|NULL|NULL|NULL|NULL|0|NULL",
+	)
+	require.Equal(t, &tabletmanagerdatapb.ShowVDiffResponse{
+		VdiffUuid: vdiffUUID,
+		State:     "pending",
+	}, newShowVDiffResponse(vdiffUUID, sqltypes.ResultToProto3(pending)))
+
+	started := sqltypes.MakeTestResult(fields,
+		"started||customer|"+vdiffUUID+"|completed|10|2024-05-01 12:30:00|10|NULL|1|{\"MismatchedRows\":1}",
+		"started||order|"+vdiffUUID+"|started|100|2024-05-01 12:30:00|42|NULL|0|NULL",
+	)
+	require.Equal(t, &tabletmanagerdatapb.ShowVDiffResponse{
+		VdiffUuid: vdiffUUID,
+		State:     "started",
+		StartedAt: "2024-05-01 12:30:00",
+		Tables: []*tabletmanagerdatapb.VDiffTableProgress{
+			{
+				TableName:    "customer",
+				State:        "completed",
+				TableRows:    10,
+				RowsCompared: 10,
+				HasMismatch:  true,
+				Report:       `{"MismatchedRows":1}`,
+			},
+			{
+				TableName:    "order",
+				State:        "started",
+				TableRows:    100,
+				RowsCompared: 42,
+			},
+		},
+	}, newShowVDiffResponse(vdiffUUID, sqltypes.ResultToProto3(started)))
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"vitess.io/vitess/go/bytes2"
 	"vitess.io/vitess/go/mysql/collations"
@@ -29,6 +30,7 @@ import (
 	vjson "vitess.io/vitess/go/mysql/json"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
@@ -210,6 +212,10 @@ type TablePlan struct {
 	FieldsToSkip            map[string]bool
 	ConvertCharset          map[string](*binlogdatapb.CharsetConversion)
 	HasExtraSourcePkColumns bool
+	// TextCharsets are the character sets of the text columns of the target table.
+	// They are used to flag binary values from the source which are written to
+	// text columns, but are unlikely to be text in the character set of the column.
+	TextCharsets map[string]charset.Charset
 
 	TablePlanBuilder *tablePlanBuilder
 	// PartialInserts is a dynamically generated cache of insert ParsedQueries, which update only some columns.
@@ -253,6 +259,7 @@ func (tp *TablePlan) applyBulkInsert(sqlbuffer *bytes2.Buffer, rows []*querypb.R
 	sqlbuffer.WriteString(tp.BulkInsertFront.Query)
 	sqlbuffer.WriteString(" values ")
 
+	tp.checkBinaryToTextRows(rows)
 	for i, row := range rows {
 		if i > 0 {
 			sqlbuffer.WriteString(", ")
@@ -318,6 +325,9 @@ func (tp *TablePlan) isOutsidePKRange(bindvars map[string]*querypb.BindVariable,
 // - enum values converted to text via Online DDL
 // - ...any other future possible values
 func (tp *TablePlan) bindFieldVal(field *querypb.Field, val *sqltypes.Value) (*querypb.BindVariable, error) {
+	if tp.isBinaryToText(field) && !val.IsNull() {
+		tp.checkBinaryToText(field, val.Raw())
+	}
 	if conversion, ok := tp.ConvertCharset[field.Name]; ok && !val.IsNull() {
 		// Non-null string value, for which we have a charset conversion instruction
 		fromCollation := tp.CollationEnv.DefaultCollationForCharset(conversion.FromCharset)
@@ -337,6 +347,61 @@ func (tp *TablePlan) bindFieldVal(field *querypb.Field, val *sqltypes.Value) (*q
 	return sqltypes.ValueBindVariable(*val), nil
 }
 
+// minCharsetDetectionConfidence is the confidence below which a binary value written
+// to a text column is considered not to be text in the character set of the column.
+const minCharsetDetectionConfidence = 0.5
+
+var charsetDetectionLog = logutil.NewThrottledLogger("CharsetDetection", 5*time.Second)
+
+// isBinaryToText returns true if the values of the field are binary on the source,
+// and are written to a text column on the target, e.g. when a BLOB column is
+// changed to TEXT. MySQL writes such values as they are, so if they are not text in
+// the character set of the column, they are either rejected or silently corrupted.
+func (tp *TablePlan) isBinaryToText(field *querypb.Field) bool {
+	if len(tp.TextCharsets) == 0 || field.Charset != uint32(collations.CollationBinaryID) || !sqltypes.IsBinary(field.Type) {
+		return false
+	}
+	_, ok := tp.TextCharsets[field.Name]
+	return ok
+}
+
+// checkBinaryToText counts and logs a binary value written to a text column, if it
+// is unlikely to be text in the character set of the column.
+func (tp *TablePlan) checkBinaryToText(field *querypb.Field, raw []byte) {
+	cs := tp.TextCharsets[field.Name]
+	confidence := charset.DetectConfidence(cs, raw)
+	if confidence >= minCharsetDetectionConfidence {
+		return
+	}
+	if tp.Stats != nil {
+		tp.Stats.RiskyCharsetConversions.Add(tp.TargetName, 1)
+	}
+	charsetDetectionLog.Warningf("binary value written to %s.%s is unlikely to be %s text (confidence %.2f), it may be rejected or corrupted",
+		tp.TargetName, field.Name, cs.Name(), confidence)
+}
+
+// checkBinaryToTextRows checks the values of the rows that are written to text
+// columns from binary columns, see checkBinaryToText.
+func (tp *TablePlan) checkBinaryToTextRows(rows []*querypb.Row) {
+	var fields []int
+	for i, field := range tp.Fields {
+		if tp.isBinaryToText(field) {
+			fields = append(fields, i)
+		}
+	}
+	if len(fields) == 0 {
+		return
+	}
+	for _, row := range rows {
+		vals := sqltypes.MakeRowTrusted(tp.Fields, row)
+		for _, i := range fields {
+			if !vals[i].IsNull() {
+				tp.checkBinaryToText(tp.Fields[i], vals[i].Raw())
+			}
+		}
+	}
+}
+
 func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
 	// MakeRowTrusted is needed here because Proto3ToResult is not convenient.
 	var before, after bool
@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 type TestReplicatorPlan struct {
@@ -818,3 +819,69 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 	wantPlan, _ := json.Marshal(want)
 	assert.Equal(t, string(gotPlan), string(wantPlan))
 }
+
+func TestBinaryToTextCharsetDetection(t *testing.T) {
+	colInfos := map[string][]*ColumnInfo{
+		"t1": {
+			{Name: "id", IsPK: true},
+			{Name: "utf8_col", CharSet: "utf8mb4"},
+			{Name: "latin1_col", CharSet: "latin1"},
+		},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select id, utf8_col, latin1_col from t1",
+		}},
+	}
+	stats := binlogplayer.NewStats()
+	defer stats.Stop()
+	plan, err := buildReplicatorPlan(getSource(input), colInfos, nil, stats, collations.MySQL8(), sqlparser.NewTestParser())
+	require.NoError(t, err)
+	tp := plan.TablePlans["t1"]
+	require.Len(t, tp.TextCharsets, 2)
+
+	binaryField := func(name string) *querypb.Field {
+		return &querypb.Field{Name: name, Type: sqltypes.VarBinary, Charset: uint32(collations.CollationBinaryID)}
+	}
+	tp.Fields = []*querypb.Field{
+		{Name: "id", Type: sqltypes.Int64, Charset: uint32(collations.CollationBinaryID)},
+		binaryField("utf8_col"),
+		binaryField("latin1_col"),
+	}
+
+	tcases := []struct {
+		field string
+		value string
+		risky bool
+	}{
+		{field: "utf8_col", value: "plain ascii"},
+		{field: "utf8_col", value: "café"},
+		{field: "utf8_col", value: "caf\xe9", risky: true},
+		{field: "utf8_col", value: "\x00\x01\x02\xff\xfe", risky: true},
+		{field: "latin1_col", value: "caf\xe9"},
+		{field: "latin1_col", value: "café, déjà vu", risky: true},
+		{field: "latin1_col", value: "\x00\x01\x02\x81\x8d", risky: true},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.field+"/"+tcase.value, func(t *testing.T) {
+			before := stats.RiskyCharsetConversions.Counts()["t1"]
+			for _, field := range tp.Fields {
+				if field.Name == tcase.field {
+					val := sqltypes.MakeTrusted(field.Type, []byte(tcase.value))
+					_, err := tp.bindFieldVal(field, &val)
+					require.NoError(t, err)
+				}
+			}
+			risky := stats.RiskyCharsetConversions.Counts()["t1"] > before
+			assert.Equal(t, tcase.risky, risky)
+		})
+	}
+
+	// Text columns on the source are not checked.
+	before := stats.RiskyCharsetConversions.Counts()["t1"]
+	val := sqltypes.MakeTrusted(sqltypes.VarChar, []byte("caf\xe9"))
+	_, err = tp.bindFieldVal(&querypb.Field{Name: "utf8_col", Type: sqltypes.VarChar, Charset: uint32(collations.CollationLatin1Swedish)}, &val)
+	require.NoError(t, err)
+	assert.Equal(t, before, stats.RiskyCharsetConversions.Counts()["t1"])
+}
@@ -543,6 +543,25 @@ func (st *vrStats) register() {
 			}
 			return result
 		})
+
+	stats.NewCountersFuncWithMultiLabels(
+		"VReplicationRiskyCharsetConversions",
+		"vreplication binary values written to text columns that are unlikely to be text in the column's character set, per table per stream",
+		[]string{"source_keyspace", "source_shard", "workflow", "counts", "table"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				for table, count := range ct.blpStats.RiskyCharsetConversions.Counts() {
+					if table == "" {
+						continue
+					}
+					result[ct.source.Keyspace+"."+ct.source.Shard+"."+ct.workflow+"."+fmt.Sprintf("%v", ct.id)+"."+table] = count
+				}
+			}
+			return result
+		})
 }
 
 func (st *vrStats) numControllers() int64 {
@@ -23,6 +23,8 @@ import (
 	"strings"
 
 	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/mysql/collations/charset"
+	"vitess.io/vitess/go/mysql/collations/colldata"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/textutil"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
@@ -353,10 +355,16 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 	bvf := &bindvarFormatter{}
 
 	fieldsToSkip := make(map[string]bool)
+	textCharsets := make(map[string]charset.Charset)
 	for _, colInfo := range tpb.colInfos {
 		if colInfo.IsGenerated {
 			fieldsToSkip[colInfo.Name] = true
 		}
+		if colInfo.CharSet != "" && tpb.collationEnv != nil {
+			if coll := tpb.collationEnv.DefaultCollationForCharset(colInfo.CharSet); coll != collations.Unknown && coll != collations.CollationBinaryID {
+				textCharsets[colInfo.Name] = colldata.Lookup(coll).Charset()
+			}
+		}
 	}
 
 	return &TablePlan{
@@ -373,6 +381,7 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 		PKIndices:               tpb.pkIndices,
 		Stats:                   tpb.stats,
 		FieldsToSkip:            fieldsToSkip,
+		TextCharsets:            textCharsets,
 		HasExtraSourcePkColumns: len(tpb.extraSourcePkCols) > 0,
 		TablePlanBuilder:        tpb,
 		PartialInserts:          make(map[string]*sqlparser.ParsedQuery, 0),
@@ -37,6 +37,7 @@ import (
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/gc"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/throttlerapp"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -249,6 +250,14 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 	vr.throttleUpdatesRateLimiter = timer.NewRateLimiter(time.Second)
 	defer vr.throttleUpdatesRateLimiter.Stop()
 
+	// The table garbage collector of the source is paused during the copy phase.
+	var resumeTableGC func()
+	defer func() {
+		if resumeTableGC != nil {
+			resumeTableGC()
+		}
+	}()
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -274,6 +283,9 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 		}
 		switch {
 		case numTablesToCopy != 0:
+			if resumeTableGC == nil {
+				resumeTableGC = vr.pauseSourceTableGC(ctx)
+			}
 			if err := vr.clearFKCheck(vr.dbClient); err != nil {
 				log.Warningf("Unable to clear FK check %v", err)
 				return err
@@ -307,6 +319,10 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 				return err
 			}
 		default:
+			if resumeTableGC != nil {
+				resumeTableGC()
+				resumeTableGC = nil
+			}
 			if err := vr.resetFKCheckAfterCopy(vr.dbClient); err != nil {
 				log.Warningf("Unable to reset FK check %v", err)
 				return err
@@ -327,6 +343,26 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 	}
 }
 
+// pauseSourceTableGC pauses the table garbage collector of the primary of the
+// source shard, so that it doesn't purge, rename or drop tables while their rows
+// are copied, e.g. by a Reshard or MoveTables workflow. It returns the function
+// that resumes the collector. External sources are not paused.
+func (vr *vreplicator) pauseSourceTableGC(ctx context.Context) func() {
+	if vr.vre.tmClientFactory == nil || vr.source.ExternalMysql != "" {
+		return func() {}
+	}
+	tmc := vr.vre.tmClientFactory()
+	requester := fmt.Sprintf("vreplication:%s:%d", vr.vre.tabletAlias, vr.id)
+	resume, err := gc.PauseShardPrimary(ctx, vr.vre.ts, tmc, vr.source.Keyspace, vr.source.Shard, requester)
+	if err != nil {
+		log.Warningf("Failed to pause the table GC of %s/%s during the copy phase of stream %d: %v", vr.source.Keyspace, vr.source.Shard, vr.id, err)
+	}
+	return func() {
+		resume()
+		tmc.Close()
+	}
+}
+
 // ColumnInfo is used to store charset and collation
 type ColumnInfo struct {
 	Name        string
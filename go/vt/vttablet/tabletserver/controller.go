@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -93,6 +94,26 @@ type Controller interface {
 	// CheckThrottler
 	CheckThrottler(ctx context.Context, appName string, flags *throttle.CheckFlags) *throttle.CheckResult
 	GetThrottlerStatus(ctx context.Context) *throttle.ThrottlerStatus
+
+	// PauseTableGC pauses table garbage collection on behalf of requester, for the given duration.
+	// It returns the time at which the pause expires.
+	PauseTableGC(requester string, duration time.Duration) (time.Time, error)
+
+	// ResumeTableGC releases the pause held by requester on table garbage collection.
+	ResumeTableGC(requester string)
+
+	// RestoreGCTable renames a table held by table garbage collection back to an ordinary name.
+	// It returns the name the table is restored as.
+	RestoreGCTable(ctx context.Context, tableName string, restoreAs string) (string, error)
+
+	// SetTableGCEventListener sets the function that is told about the tables that table garbage
+	// collection renames, drops or restores.
+	SetTableGCEventListener(listener func(message string))
+
+	// SetPrimaryHandoff records the change of primary that the tablet is
+	// taking part in, so that it's exposed along with the state of the query
+	// service.
+	SetPrimaryHandoff(handoff *tabletmanagerdatapb.PrimaryHandoff)
 }
 
 // Ensure TabletServer satisfies Controller interface.
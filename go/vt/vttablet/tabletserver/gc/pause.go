@@ -0,0 +1,133 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// ShardPauseLease is the duration of the pauses held by PauseShardPrimary, which renews them
+// until they are released. It is kept short, so that a requester that goes away without
+// resuming only holds back table garbage collection for a little while.
+var ShardPauseLease = 10 * time.Minute
+
+// PauseShardPrimary pauses the table garbage collector of the primary of the given shard on behalf
+// of requester: the collector only runs on primaries, so a pause taken on any other tablet of the
+// shard would have no effect. The pause is renewed every half lease, on whichever tablet is the
+// primary of the shard at the time, until the returned function is called to resume the collector.
+// The returned function must be called exactly once, even if an error is returned: a primary that
+// can't be paused right away is paused by the next renewal.
+func PauseShardPrimary(ctx context.Context, ts *topo.Server, tmClient tmclient.TabletManagerClient, keyspace, shard, requester string) (resume func(), err error) {
+	p := &shardPause{
+		ts:        ts,
+		tmClient:  tmClient,
+		keyspace:  keyspace,
+		shard:     shard,
+		requester: requester,
+		done:      make(chan struct{}),
+	}
+	err = p.pause(ctx)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		p.renew()
+	}()
+	return func() {
+		close(p.done)
+		wg.Wait()
+		p.resume()
+	}, err
+}
+
+// shardPause is a pause of the table garbage collector of the primary of a shard.
+type shardPause struct {
+	ts        *topo.Server
+	tmClient  tmclient.TabletManagerClient
+	keyspace  string
+	shard     string
+	requester string
+	done      chan struct{}
+
+	// primary is the tablet that was paused last, if any.
+	primary *topodatapb.Tablet
+}
+
+// pause pauses the collector of the current primary of the shard, for ShardPauseLease.
+func (p *shardPause) pause(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, topo.RemoteOperationTimeout)
+	defer cancel()
+	si, err := p.ts.GetShard(ctx, p.keyspace, p.shard)
+	if err != nil {
+		return err
+	}
+	if !si.HasPrimary() {
+		return topo.NewError(topo.NoNode, topoproto.KeyspaceShardString(p.keyspace, p.shard)+" primary")
+	}
+	primary, err := p.ts.GetTablet(ctx, si.PrimaryAlias)
+	if err != nil {
+		return err
+	}
+	if _, err := p.tmClient.PauseTableGC(ctx, primary.Tablet, &tabletmanagerdatapb.PauseTableGCRequest{
+		Requester:       p.requester,
+		DurationSeconds: int64(ShardPauseLease.Seconds()),
+	}); err != nil {
+		return err
+	}
+	// If the shard was reparented, the pause of the former primary expires on its own.
+	p.primary = primary.Tablet
+	return nil
+}
+
+func (p *shardPause) renew() {
+	ticker := time.NewTicker(ShardPauseLease / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+			if err := p.pause(context.Background()); err != nil {
+				log.Warningf("Failed to renew the table GC pause of %s on the primary of %s: %v", p.requester, topoproto.KeyspaceShardString(p.keyspace, p.shard), err)
+			}
+		}
+	}
+}
+
+func (p *shardPause) resume() {
+	if p.primary == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), topo.RemoteOperationTimeout)
+	defer cancel()
+	if _, err := p.tmClient.ResumeTableGC(ctx, p.primary, &tabletmanagerdatapb.ResumeTableGCRequest{
+		Requester: p.requester,
+	}); err != nil {
+		log.Warningf("Failed to resume the table GC of %s on %s, it resumes once the pause expires: %v", p.requester, topoproto.TabletAliasString(p.primary.Alias), err)
+	}
+}
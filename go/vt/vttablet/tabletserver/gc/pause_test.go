@@ -0,0 +1,116 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+type pauseTMClient struct {
+	tmclient.TabletManagerClient
+
+	mu sync.Mutex
+	// paused and resumed are the aliases of the tablets that were paused and resumed
+	paused  []string
+	resumed []string
+}
+
+func (c *pauseTMClient) PauseTableGC(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PauseTableGCRequest) (*tabletmanagerdatapb.PauseTableGCResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.paused = append(c.paused, topoproto.TabletAliasString(tablet.Alias)+" "+req.Requester)
+	return &tabletmanagerdatapb.PauseTableGCResponse{}, nil
+}
+
+func (c *pauseTMClient) ResumeTableGC(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ResumeTableGCRequest) (*tabletmanagerdatapb.ResumeTableGCResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.resumed = append(c.resumed, topoproto.TabletAliasString(tablet.Alias)+" "+req.Requester)
+	return &tabletmanagerdatapb.ResumeTableGCResponse{}, nil
+}
+
+func (c *pauseTMClient) pausedOn() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.paused...)
+}
+
+func TestPauseShardPrimary(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts := memorytopo.NewServer(ctx, "zone1")
+	defer ts.Close()
+
+	defer func(lease time.Duration) {
+		ShardPauseLease = lease
+	}(ShardPauseLease)
+	ShardPauseLease = 20 * time.Millisecond
+
+	for _, uid := range []uint32{100, 101} {
+		tablet := topo.NewTablet(uid, "zone1", "host")
+		tablet.Keyspace = "ks"
+		tablet.Shard = "0"
+		require.NoError(t, ts.CreateTablet(ctx, tablet))
+	}
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, "ks", "0"))
+	setPrimary := func(uid uint32) {
+		_, err := ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+			si.PrimaryAlias = &topodatapb.TabletAlias{Cell: "zone1", Uid: uid}
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("no primary", func(t *testing.T) {
+		tmClient := &pauseTMClient{}
+		resume, err := PauseShardPrimary(ctx, ts, tmClient, "ks", "0", "test")
+		assert.Error(t, err)
+		resume()
+		assert.Empty(t, tmClient.resumed)
+	})
+
+	setPrimary(100)
+	tmClient := &pauseTMClient{}
+	resume, err := PauseShardPrimary(ctx, ts, tmClient, "ks", "0", "test")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"zone1-0000000100 test"}, tmClient.pausedOn())
+
+	// The pause is renewed on the new primary once the shard is reparented.
+	setPrimary(101)
+	assert.Eventually(t, func() bool {
+		paused := tmClient.pausedOn()
+		return paused[len(paused)-1] == "zone1-0000000101 test"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	resume()
+	assert.Equal(t, []string{"zone1-0000000101 test"}, tmClient.resumed)
+}
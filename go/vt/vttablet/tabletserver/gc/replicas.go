@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"vitess.io/vitess/go/mysql/replication"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+)
+
+// verifyReplicas checks that the replicas of the shard have applied everything the
+// primary has executed so far, and in particular the renames that took a table through
+// its GC lifecycle. Dropping a table before the replicas have caught up with those
+// renames may fail replication on a lagging replica, which still has the table in an
+// earlier state. An error means that the drop should be delayed.
+func (collector *TableGC) verifyReplicas(ctx context.Context, tmClient tmclient.TabletManagerClient) error {
+	conn, err := dbconnpool.NewDBConnection(ctx, collector.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	pos, err := conn.PrimaryPosition()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, verifyReplicasTimeout)
+	defer cancel()
+
+	aliases, err := collector.ts.FindAllTabletAliasesInShard(ctx, collector.keyspace, collector.shard)
+	if err != nil {
+		return err
+	}
+	tablets, err := collector.ts.GetTabletMap(ctx, aliases, nil)
+	if err != nil {
+		return err
+	}
+	return waitForReplicas(ctx, tmClient, tablets, replication.EncodePosition(pos))
+}
+
+// waitForReplicas waits for all the replicas among the given tablets to reach the
+// given position, and returns an error if any of them doesn't in time.
+func waitForReplicas(ctx context.Context, tmClient tmclient.TabletManagerClient, tablets map[string]*topo.TabletInfo, pos string) error {
+	var wg sync.WaitGroup
+	var rec concurrency.AllErrorRecorder
+	for _, tablet := range tablets {
+		if !topo.IsReplicaType(tablet.Type) {
+			continue
+		}
+		wg.Add(1)
+		go func(tablet *topo.TabletInfo) {
+			defer wg.Done()
+			if err := tmClient.WaitForPosition(ctx, tablet.Tablet, pos); err != nil {
+				rec.RecordError(fmt.Errorf("replica %s has not reached position %s: %w", topoproto.TabletAliasString(tablet.Alias), pos, err))
+			}
+		}(tablet)
+	}
+	wg.Wait()
+	return rec.Error()
+}
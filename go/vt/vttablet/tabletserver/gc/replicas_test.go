@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+type fakeTMClient struct {
+	tmclient.TabletManagerClient
+
+	mu sync.Mutex
+	// lagging are the aliases of the tablets that don't reach the position
+	lagging map[string]bool
+	waited  []string
+}
+
+func (c *fakeTMClient) WaitForPosition(ctx context.Context, tablet *topodatapb.Tablet, pos string) error {
+	alias := topoproto.TabletAliasString(tablet.Alias)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.waited = append(c.waited, alias)
+	if c.lagging[alias] {
+		return fmt.Errorf("timed out waiting for %s", pos)
+	}
+	return nil
+}
+
+func TestWaitForReplicas(t *testing.T) {
+	tablets := map[string]*topo.TabletInfo{}
+	addTablet := func(uid uint32, tabletType topodatapb.TabletType) {
+		tablet := topo.NewTablet(uid, "zone1", "host")
+		tablet.Type = tabletType
+		tablets[topoproto.TabletAliasString(tablet.Alias)] = &topo.TabletInfo{Tablet: tablet}
+	}
+	addTablet(100, topodatapb.TabletType_PRIMARY)
+	addTablet(101, topodatapb.TabletType_REPLICA)
+	addTablet(102, topodatapb.TabletType_RDONLY)
+	addTablet(103, topodatapb.TabletType_BACKUP)
+
+	pos := "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-615"
+	ctx := context.Background()
+
+	t.Run("replicas caught up", func(t *testing.T) {
+		tmClient := &fakeTMClient{}
+		err := waitForReplicas(ctx, tmClient, tablets, pos)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"zone1-0000000101", "zone1-0000000102"}, tmClient.waited)
+	})
+	t.Run("lagging replica", func(t *testing.T) {
+		tmClient := &fakeTMClient{lagging: map[string]bool{"zone1-0000000102": true}}
+		err := waitForReplicas(ctx, tmClient, tablets, pos)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "replica zone1-0000000102 has not reached position")
+		assert.NotContains(t, err.Error(), "zone1-0000000101")
+	})
+}
@@ -26,8 +26,11 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"vitess.io/vitess/go/constants/sidecar"
 	"vitess.io/vitess/go/mysql/capabilities"
 	"vitess.io/vitess/go/mysql/sqlerror"
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
 
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/dbconnpool"
@@ -41,11 +44,20 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/throttlerapp"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 const (
 	// evacHours is a hard coded, reasonable time for a table to spend in EVAC state
 	evacHours = 72
+
+	// DefaultPauseDuration is how long a pause lasts when requested without a duration
+	DefaultPauseDuration = 1 * time.Hour
+	// MaxPauseDuration caps the duration of a pause, so that a requester that goes away without
+	// resuming cannot hold back table garbage collection indefinitely
+	MaxPauseDuration = 24 * time.Hour
 )
 
 var (
@@ -55,6 +67,8 @@ var (
 	checkTablesReentryMinInterval = 10 * time.Second
 	NextChecksIntervals           = []time.Duration{time.Second, checkTablesReentryMinInterval + 5*time.Second}
 	gcLifecycle                   = "hold,purge,evac,drop"
+	verifyReplicasBeforeDrop      = false
+	verifyReplicasTimeout         = 10 * time.Second
 )
 
 func init() {
@@ -69,6 +83,8 @@ func registerGCFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&purgeReentranceInterval, "gc_purge_check_interval", purgeReentranceInterval, "Interval between purge discovery checks")
 	// gcLifecycle is the sequence of steps the table goes through in the process of getting dropped
 	fs.StringVar(&gcLifecycle, "table_gc_lifecycle", gcLifecycle, "States for a DROP TABLE garbage collection cycle. Default is 'hold,purge,evac,drop', use any subset ('drop' implicitly always included)")
+	fs.BoolVar(&verifyReplicasBeforeDrop, "table_gc_verify_replicas", verifyReplicasBeforeDrop, "Before dropping a table, verify that the replicas have applied the renames of its GC lifecycle, and delay the drop otherwise")
+	fs.DurationVar(&verifyReplicasTimeout, "table_gc_verify_replicas_timeout", verifyReplicasTimeout, "How long to wait for the replicas to catch up with the primary, when verifying them before dropping a table")
 }
 
 var (
@@ -76,6 +92,12 @@ var (
 	sqlShowVtTables = `show full tables like '\_vt\_%'`
 	sqlDropTable    = "drop table if exists `%a`"
 	sqlDropView     = "drop view if exists `%a`"
+
+	sqlRenameTable          = "rename table %s to %s"
+	sqlTableExists          = "select 1 from information_schema.tables where table_schema=database() and table_name=%a"
+	sqlReadDroppedTableName = `select mysql_table from %s.schema_migrations
+		where ddl_action='drop' and migration_status='complete' and replace(migration_uuid, '_', '')=%a
+		order by id desc limit 1`
 )
 
 type gcTable struct {
@@ -121,9 +143,17 @@ type TableGC struct {
 	purgeMutex sync.Mutex
 
 	purgingTables map[string]bool
+
+	pauseMutex sync.Mutex
+	// pauses maps the requesters that paused the collector to the time their pause expires
+	pauses map[string]time.Time
+
 	// lifecycleStates indicates what states a GC table goes through. The user can set
 	// this with --table_gc_lifecycle, such that some states can be skipped.
 	lifecycleStates map[schema.TableGCState]bool
+
+	// eventListener, if set, is told about the tables that the collector renames, drops or restores.
+	eventListener func(message string)
 }
 
 // Status published some status values from the collector
@@ -133,6 +163,11 @@ type Status struct {
 
 	IsOpen bool
 
+	// IsPaused is true when at least one requester holds a pause on the collector
+	IsPaused bool
+	// Pauses maps the requesters that paused the collector to the time their pause expires
+	Pauses map[string]time.Time
+
 	purgingTables []string
 }
 
@@ -150,6 +185,7 @@ func NewTableGC(env tabletenv.Env, ts *topo.Server, lagThrottler *throttle.Throt
 		}),
 
 		purgingTables:    map[string]bool{},
+		pauses:           map[string]time.Time{},
 		checkRequestChan: make(chan bool),
 	}
 
@@ -243,6 +279,178 @@ func (collector *TableGC) RequestChecks() {
 	}
 }
 
+// Pause pauses table garbage collection on behalf of requester, e.g. while a backup copies the data or
+// while a resharding workflow copies tables. No table is purged, transitioned or dropped while any
+// requester holds a pause, so that the collector does not invalidate a snapshot taken meanwhile.
+// A pause is a lease: it expires on its own after the given duration (DefaultPauseDuration if zero,
+// at most MaxPauseDuration), and the requester renews it by calling Pause again.
+// It returns the time at which the pause expires.
+func (collector *TableGC) Pause(requester string, duration time.Duration) (time.Time, error) {
+	if requester == "" {
+		return time.Time{}, fmt.Errorf("TableGC: pause requester must not be empty")
+	}
+	if duration <= 0 {
+		duration = DefaultPauseDuration
+	}
+	if duration > MaxPauseDuration {
+		duration = MaxPauseDuration
+	}
+	expiresAt := time.Now().Add(duration)
+
+	collector.pauseMutex.Lock()
+	collector.pauses[requester] = expiresAt
+	collector.pauseMutex.Unlock()
+	log.Infof("TableGC: paused by %s until %v", requester, expiresAt)
+
+	// Once the lease expires, take a look at whatever was postponed during the pause. If the lease was
+	// renewed or another requester still holds a pause, this is a no-op.
+	time.AfterFunc(duration, collector.requestChecksIfResumed)
+	return expiresAt, nil
+}
+
+// Resume releases the pause held by requester. Table garbage collection resumes once no requester
+// holds a pause anymore.
+func (collector *TableGC) Resume(requester string) {
+	collector.pauseMutex.Lock()
+	_, found := collector.pauses[requester]
+	delete(collector.pauses, requester)
+	collector.pauseMutex.Unlock()
+
+	if found {
+		log.Infof("TableGC: pause by %s released", requester)
+		collector.requestChecksIfResumed()
+	}
+}
+
+// SetEventListener sets the function that is told about the tables that the collector renames,
+// drops or restores. It must be called before Open.
+func (collector *TableGC) SetEventListener(listener func(message string)) {
+	collector.eventListener = listener
+}
+
+func (collector *TableGC) notifyEvent(format string, args ...any) {
+	if collector.eventListener != nil {
+		collector.eventListener(fmt.Sprintf(format, args...))
+	}
+}
+
+// IsPaused returns true when at least one requester holds a pause that has not expired yet.
+func (collector *TableGC) IsPaused() bool {
+	collector.pauseMutex.Lock()
+	defer collector.pauseMutex.Unlock()
+
+	return collector.discardExpiredPauses(time.Now())
+}
+
+// discardExpiredPauses removes the expired pauses, and returns true if any pause is still held.
+// It must be called with pauseMutex held.
+func (collector *TableGC) discardExpiredPauses(now time.Time) (paused bool) {
+	for requester, expiresAt := range collector.pauses {
+		if !now.Before(expiresAt) {
+			log.Infof("TableGC: pause by %s expired", requester)
+			delete(collector.pauses, requester)
+			continue
+		}
+		paused = true
+	}
+	return paused
+}
+
+// requestChecksIfResumed requests table checks, so that the work postponed during a pause is picked up
+// right away rather than in the next check interval.
+func (collector *TableGC) requestChecksIfResumed() {
+	if atomic.LoadInt64(&collector.isOpen) == 0 || collector.IsPaused() {
+		return
+	}
+	log.Info("TableGC: resumed")
+	collector.RequestChecks()
+}
+
+// RestoreTable renames a GC table back to an ordinary name, undoing the DROP TABLE that sent it into the
+// GC lifecycle, e.g. after a table was dropped by mistake. Only the tables whose data is still intact can
+// be restored: those in HOLD state, and those in PURGE state when PURGE is not part of the lifecycle, since
+// their rows are never purged then. If restoreAs is empty, the table is restored to the name it had when it
+// was dropped by an online DDL migration. The restore fails if a table with that name exists.
+// It returns the name the table is restored to.
+func (collector *TableGC) RestoreTable(ctx context.Context, gcTableName string, restoreAs string) (string, error) {
+	if atomic.LoadInt64(&collector.isOpen) == 0 {
+		return "", fmt.Errorf("TableGC: not open")
+	}
+	uuid, err := collector.checkRestorable(gcTableName, restoreAs)
+	if err != nil {
+		return "", err
+	}
+
+	conn, err := collector.pool.Get(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Recycle()
+
+	if restoreAs == "" {
+		parsed := sqlparser.BuildParsedQuery(sqlReadDroppedTableName, sidecar.GetIdentifier(), ":uuid")
+		query, err := parsed.GenerateQuery(map[string]*querypb.BindVariable{"uuid": sqltypes.StringBindVariable(uuid)}, nil)
+		if err != nil {
+			return "", err
+		}
+		res, err := conn.Conn.Exec(ctx, query, 1, false)
+		if err != nil {
+			return "", err
+		}
+		if len(res.Rows) == 0 {
+			return "", fmt.Errorf("TableGC: cannot find the original name of table %s, please provide the name to restore it as", gcTableName)
+		}
+		restoreAs = res.Rows[0][0].ToString()
+	}
+
+	query, err := sqlparser.ParseAndBind(sqlTableExists, sqltypes.StringBindVariable(restoreAs))
+	if err != nil {
+		return "", err
+	}
+	res, err := conn.Conn.Exec(ctx, query, 1, false)
+	if err != nil {
+		return "", err
+	}
+	if len(res.Rows) > 0 {
+		return "", fmt.Errorf("TableGC: cannot restore table %s as %s: table %s already exists", gcTableName, restoreAs, restoreAs)
+	}
+
+	renameStatement := sqlparser.BuildParsedQuery(sqlRenameTable, sqlescape.EscapeID(gcTableName), sqlescape.EscapeID(restoreAs)).Query
+	log.Infof("TableGC: restoring table: %s as %s", gcTableName, restoreAs)
+	if _, err := conn.Conn.Exec(ctx, renameStatement, 1, false); err != nil {
+		return "", err
+	}
+	collector.removePurgingTable(gcTableName)
+	log.Infof("TableGC: restored table: %s as %s", gcTableName, restoreAs)
+	collector.notifyEvent("restored table %s as %s", gcTableName, restoreAs)
+	return restoreAs, nil
+}
+
+// checkRestorable validates that a GC table can be restored as the given name, which may be empty, and
+// returns its UUID.
+func (collector *TableGC) checkRestorable(gcTableName string, restoreAs string) (uuid string, err error) {
+	isGCTable, state, uuid, _, err := schema.AnalyzeGCTableName(gcTableName)
+	if err != nil {
+		return "", err
+	}
+	if !isGCTable {
+		return "", fmt.Errorf("TableGC: %s is not a GC table", gcTableName)
+	}
+	switch state {
+	case schema.HoldTableGCState:
+	case schema.PurgeTableGCState:
+		if collector.lifecycleStates[schema.PurgeTableGCState] {
+			return "", fmt.Errorf("TableGC: cannot restore table %s: its rows may have been purged", gcTableName)
+		}
+	default:
+		return "", fmt.Errorf("TableGC: cannot restore table %s in %s state", gcTableName, state)
+	}
+	if restoreAs != "" && schema.IsInternalOperationTableName(restoreAs) {
+		return "", fmt.Errorf("TableGC: cannot restore table %s as internal table name %s", gcTableName, restoreAs)
+	}
+	return uuid, nil
+}
+
 // operate is the main entry point for the table garbage collector operation and logic.
 func (collector *TableGC) operate(ctx context.Context) {
 
@@ -265,6 +473,12 @@ func (collector *TableGC) operate(ctx context.Context) {
 		go t.TickNow()
 	}
 
+	var tmClient tmclient.TabletManagerClient
+	if verifyReplicasBeforeDrop {
+		tmClient = tmclient.NewTabletManagerClient()
+		defer tmClient.Close()
+	}
+
 	log.Info("TableGC: operating")
 	for {
 		select {
@@ -276,6 +490,10 @@ func (collector *TableGC) operate(ctx context.Context) {
 			// find something new to do.
 			go tableCheckTicker.TickNow()
 		case <-tableCheckTicker.C:
+			if collector.IsPaused() {
+				log.Info("TableGC: paused, skipping table checks")
+				continue
+			}
 			if err := collector.readAndCheckTables(ctx, dropTablesChan, transitionRequestsChan); err != nil {
 				log.Error(err)
 			}
@@ -304,11 +522,28 @@ func (collector *TableGC) operate(ctx context.Context) {
 			}()
 		case dropTable := <-dropTablesChan:
 			log.Infof("TableGC: found %v in dropTablesChan", dropTable.tableName)
+			if collector.IsPaused() {
+				// The table will be found again by the checks that follow the end of the pause
+				log.Infof("TableGC: paused, not dropping %v", dropTable.tableName)
+				continue
+			}
+			if verifyReplicasBeforeDrop {
+				if err := collector.verifyReplicas(ctx, tmClient); err != nil {
+					// The table will be found again by the next checks
+					log.Warningf("TableGC: replicas not verified, delaying the drop of %v: %+v", dropTable.tableName, err)
+					continue
+				}
+			}
 			if err := collector.dropTable(ctx, dropTable.tableName, dropTable.isBaseTable); err != nil {
 				log.Errorf("TableGC: error dropping table %s: %+v", dropTable.tableName, err)
 			}
 		case transition := <-transitionRequestsChan:
 			log.Info("TableGC: transitionRequestsChan, transition=%v", transition)
+			if collector.IsPaused() {
+				// The table will be found again by the checks that follow the end of the pause
+				log.Infof("TableGC: paused, not transitioning %v", transition.fromTableName)
+				continue
+			}
 			if err := collector.transitionTable(ctx, transition); err != nil {
 				log.Errorf("TableGC: error transitioning table %s to %+v: %+v", transition.fromTableName, transition.toGCState, err)
 			}
@@ -493,6 +728,9 @@ func (collector *TableGC) purge(ctx context.Context) (tableName string, err erro
 	}
 	defer collector.purgeReentranceFlag.Store(0)
 
+	if collector.IsPaused() {
+		return "", nil
+	}
 	tableName, found := collector.nextTableToPurge()
 	if !found {
 		// Nothing do do here...
@@ -551,6 +789,11 @@ func (collector *TableGC) purge(ctx context.Context) (tableName string, err erro
 			// cancelled
 			return tableName, err
 		}
+		if collector.IsPaused() {
+			// The table remains in purgingTables, and its purge resumes along with the collector
+			log.Infof("TableGC: paused, purge interrupted for %s", tableName)
+			return "", nil
+		}
 		if _, ok := collector.throttlerClient.ThrottleCheckOKOrWait(ctx); !ok {
 			continue
 		}
@@ -590,6 +833,7 @@ func (collector *TableGC) dropTable(ctx context.Context, tableName string, isBas
 		return err
 	}
 	log.Infof("TableGC: dropped table: %s, isBaseTable: %v", tableName, isBaseTable)
+	collector.notifyEvent("dropped table %s", tableName)
 	return nil
 }
 
@@ -627,6 +871,7 @@ func (collector *TableGC) transitionTable(ctx context.Context, transition *trans
 		return err
 	}
 	log.Infof("TableGC: renamed table: %s", transition.fromTableName)
+	collector.notifyEvent("renamed table %s to %s", transition.fromTableName, toTableName)
 	// Since the table has transitioned, there is a potential for more work on this table or on other tables,
 	// let's kick a check request.
 	collector.RequestChecks()
@@ -704,5 +949,13 @@ func (collector *TableGC) Status() *Status {
 		status.purgingTables = append(status.purgingTables, tableName)
 	}
 
+	collector.pauseMutex.Lock()
+	defer collector.pauseMutex.Unlock()
+	status.IsPaused = collector.discardExpiredPauses(time.Now())
+	status.Pauses = make(map[string]time.Time, len(collector.pauses))
+	for requester, expiresAt := range collector.pauses {
+		status.Pauses[requester] = expiresAt
+	}
+
 	return status
 }
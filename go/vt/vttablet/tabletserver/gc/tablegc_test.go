@@ -500,3 +500,120 @@ func TestCheckTables(t *testing.T) {
 	assert.ElementsMatch(t, expectDropTables, foundDropTables)
 	assert.ElementsMatch(t, expectTransitionRequests, foundTransitionRequests)
 }
+
+func TestPause(t *testing.T) {
+	collector := &TableGC{
+		purgingTables:    make(map[string]bool),
+		pauses:           make(map[string]time.Time),
+		checkRequestChan: make(chan bool),
+	}
+	var err error
+	collector.lifecycleStates, err = schema.ParseGCLifecycle("hold,purge,evac,drop")
+	require.NoError(t, err)
+	assert.False(t, collector.IsPaused())
+
+	_, err = collector.Pause("", time.Minute)
+	assert.Error(t, err)
+	assert.False(t, collector.IsPaused())
+
+	now := time.Now()
+	expiresAt, err := collector.Pause("backup", 0)
+	require.NoError(t, err)
+	assert.WithinDuration(t, now.Add(DefaultPauseDuration), expiresAt, time.Minute)
+	assert.True(t, collector.IsPaused())
+
+	expiresAt, err = collector.Pause("reshard", 100*time.Hour)
+	require.NoError(t, err)
+	assert.WithinDuration(t, now.Add(MaxPauseDuration), expiresAt, time.Minute)
+
+	status := collector.Status()
+	assert.True(t, status.IsPaused)
+	assert.Len(t, status.Pauses, 2)
+
+	// Paused tables are not purged
+	assert.True(t, collector.addPurgingTable("_vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410"))
+	tableName, err := collector.purge(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, tableName)
+
+	collector.Resume("backup")
+	assert.True(t, collector.IsPaused())
+	collector.Resume("unknown")
+	assert.True(t, collector.IsPaused())
+	collector.Resume("reshard")
+	assert.False(t, collector.IsPaused())
+
+	t.Run("expiry", func(t *testing.T) {
+		_, err := collector.Pause("backup", 10*time.Millisecond)
+		require.NoError(t, err)
+		assert.True(t, collector.IsPaused())
+		assert.Eventually(t, func() bool {
+			return !collector.IsPaused()
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Empty(t, collector.Status().Pauses)
+	})
+}
+
+func TestCheckRestorable(t *testing.T) {
+	tcases := []struct {
+		lifecycle string
+		tableName string
+		restoreAs string
+		uuid      string
+		expectErr string
+	}{
+		{
+			lifecycle: "hold,purge,evac,drop",
+			tableName: "_vt_hld_6ace8bcef73211ea87e9f875a4d24e90_20200915120410_",
+			uuid:      "6ace8bcef73211ea87e9f875a4d24e90",
+		},
+		{
+			lifecycle: "hold,purge,evac,drop",
+			tableName: "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+			restoreAs: "t",
+			uuid:      "6ace8bcef73211ea87e9f875a4d24e90",
+		},
+		{
+			lifecycle: "hold,purge,evac,drop",
+			tableName: "_vt_prg_6ace8bcef73211ea87e9f875a4d24e90_20200915120410_",
+			expectErr: "its rows may have been purged",
+		},
+		{
+			lifecycle: "hold,drop",
+			tableName: "_vt_prg_6ace8bcef73211ea87e9f875a4d24e90_20200915120410_",
+			uuid:      "6ace8bcef73211ea87e9f875a4d24e90",
+		},
+		{
+			lifecycle: "hold,drop",
+			tableName: "_vt_evc_6ace8bcef73211ea87e9f875a4d24e90_20200915120410_",
+			expectErr: "in EVAC state",
+		},
+		{
+			lifecycle: "hold,purge,evac,drop",
+			tableName: "t",
+			expectErr: "not a GC table",
+		},
+		{
+			lifecycle: "hold,purge,evac,drop",
+			tableName: "_vt_hld_6ace8bcef73211ea87e9f875a4d24e90_20200915120410_",
+			restoreAs: "_vt_hld_6ace8bcef73211ea87e9f875a4d24e91_20200915120410_",
+			expectErr: "internal table name",
+		},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.tableName, func(t *testing.T) {
+			collector := &TableGC{}
+			var err error
+			collector.lifecycleStates, err = schema.ParseGCLifecycle(tcase.lifecycle)
+			require.NoError(t, err)
+
+			uuid, err := collector.checkRestorable(tcase.tableName, tcase.restoreAs)
+			if tcase.expectErr != "" {
+				assert.ErrorContains(t, err, tcase.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tcase.uuid, uuid)
+		})
+	}
+}
@@ -25,9 +25,11 @@ import (
 
 	"golang.org/x/sync/semaphore"
 
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/log"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/servenv"
@@ -100,6 +102,11 @@ type stateManager struct {
 	reason         string
 	transitionErr  error
 
+	// handoff is the last change of primary that the tablet took part in,
+	// and handoffTime is when the tablet was told about it.
+	handoff     *tabletmanagerdatapb.PrimaryHandoff
+	handoffTime time.Time
+
 	rw *requestsWaiter
 
 	// QueryList does not have an Open or Close.
@@ -801,6 +808,13 @@ func (sm *stateManager) AppendDetails(details []*kv) []*kv {
 			Value: sm.reason,
 		})
 	}
+	if sm.handoff != nil {
+		details = append(details, &kv{
+			Key:   "Primary Handoff",
+			Class: healthyClass,
+			Value: formatPrimaryHandoff(sm.handoff, sm.handoffTime),
+		})
+	}
 	if sm.transitionErr != nil {
 		details = append(details, &kv{
 			Key:   "Transition Error",
@@ -843,6 +857,59 @@ func (sm *stateManager) Target() *querypb.Target {
 	return sm.target.CloneVT()
 }
 
+// SetPrimaryHandoff records the change of primary that the tablet is taking
+// part in.
+func (sm *stateManager) SetPrimaryHandoff(handoff *tabletmanagerdatapb.PrimaryHandoff) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.handoff = handoff
+	sm.handoffTime = time.Now()
+}
+
+// PrimaryHandoff returns the last change of primary that the tablet took part
+// in, if any.
+func (sm *stateManager) PrimaryHandoff() *tabletmanagerdatapb.PrimaryHandoff {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	return sm.handoff
+}
+
+// primaryHandoffVars exports the last change of primary that the tablet took
+// part in, so that the buffering of the queries to the shard can be
+// attributed to it.
+func (sm *stateManager) primaryHandoffVars() map[string]string {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	if sm.handoff == nil {
+		return map[string]string{}
+	}
+	vars := map[string]string{
+		"Id":                  sm.handoff.Id,
+		"Operation":           sm.handoff.Operation,
+		"Reason":              sm.handoff.Reason,
+		"BufferWindowSeconds": fmt.Sprint(sm.handoff.BufferWindowSeconds),
+		"ReceivedTime":        sm.handoffTime.UTC().Format(time.RFC3339),
+	}
+	if sm.handoff.ExpectedCutoverTime != nil {
+		vars["ExpectedCutoverTime"] = protoutil.TimeFromProto(sm.handoff.ExpectedCutoverTime).UTC().Format(time.RFC3339)
+	}
+	return vars
+}
+
+func formatPrimaryHandoff(handoff *tabletmanagerdatapb.PrimaryHandoff, received time.Time) string {
+	s := fmt.Sprintf("%s %s received at %s", handoff.Operation, handoff.Id, received.UTC().Format(time.RFC3339))
+	if handoff.Reason != "" {
+		s += fmt.Sprintf(", reason: %s", handoff.Reason)
+	}
+	if handoff.ExpectedCutoverTime != nil {
+		s += fmt.Sprintf(", expected cutover at %s", protoutil.TimeFromProto(handoff.ExpectedCutoverTime).UTC().Format(time.RFC3339))
+	}
+	if handoff.BufferWindowSeconds > 0 {
+		s += fmt.Sprintf(", buffer window: %ds", handoff.BufferWindowSeconds)
+	}
+	return s
+}
+
 // IsServingString returns the name of the current TabletServer state.
 func (sm *stateManager) IsServingString() string {
 	if sm.IsServing() {
@@ -28,11 +28,13 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtenv"
 
 	"vitess.io/vitess/go/vt/log"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -724,6 +726,42 @@ func TestPanicInWait(t *testing.T) {
 	sm.rw.WaitToBeEmpty()
 }
 
+func TestStateManagerPrimaryHandoff(t *testing.T) {
+	sm := newTestStateManager()
+	defer sm.StopService()
+
+	assert.Nil(t, sm.PrimaryHandoff())
+	assert.Empty(t, sm.primaryHandoffVars())
+
+	handoff := &tabletmanagerdatapb.PrimaryHandoff{
+		Id:                  "prs-1",
+		Operation:           "PlannedReparentShard",
+		Reason:              "maintenance",
+		ExpectedCutoverTime: protoutil.TimeToProto(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+		BufferWindowSeconds: 10,
+	}
+	sm.SetPrimaryHandoff(handoff)
+	assert.True(t, proto.Equal(handoff, sm.PrimaryHandoff()))
+
+	vars := sm.primaryHandoffVars()
+	assert.Equal(t, "prs-1", vars["Id"])
+	assert.Equal(t, "PlannedReparentShard", vars["Operation"])
+	assert.Equal(t, "maintenance", vars["Reason"])
+	assert.Equal(t, "2024-01-02T03:04:05Z", vars["ExpectedCutoverTime"])
+	assert.Equal(t, "10", vars["BufferWindowSeconds"])
+	assert.NotEmpty(t, vars["ReceivedTime"])
+
+	var found bool
+	for _, detail := range sm.AppendDetails(nil) {
+		if detail.Key == "Primary Handoff" {
+			found = true
+			assert.Contains(t, detail.Value, "PlannedReparentShard prs-1 received at ")
+			assert.Contains(t, detail.Value, ", reason: maintenance, expected cutover at 2024-01-02T03:04:05Z, buffer window: 10s")
+		}
+	}
+	assert.True(t, found)
+}
+
 func verifySubcomponent(t *testing.T, order int64, component any, state testState) {
 	tos := component.(orderState)
 	assert.Equal(t, order, tos.Order())
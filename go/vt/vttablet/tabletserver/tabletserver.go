@@ -46,6 +46,7 @@ import (
 	"vitess.io/vitess/go/vt/mysqlctl"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/servenv"
@@ -210,6 +211,7 @@ func NewTabletServer(ctx context.Context, env *vtenv.Environment, name string, c
 	tsv.exporter.NewGaugeFunc("TabletState", "Tablet server state", func() int64 { return int64(tsv.sm.State()) })
 	tsv.checkMysqlGaugeFunc = tsv.exporter.NewGaugeFunc("CheckMySQLRunning", "Check MySQL operation currently in progress", tsv.sm.isCheckMySQLRunning)
 	tsv.exporter.Publish("TabletStateName", stats.StringFunc(tsv.sm.IsServingString))
+	tsv.exporter.Publish("PrimaryHandoff", stats.StringMapFunc(tsv.sm.primaryHandoffVars))
 
 	// TabletServerState exports the same information as the above two stats (TabletState / TabletStateName),
 	// but exported with TabletStateName as a label for Prometheus, which doesn't support exporting strings as stat values.
@@ -227,6 +229,7 @@ func NewTabletServer(ctx context.Context, env *vtenv.Environment, name string, c
 	tsv.registerTwopczHandler()
 	tsv.registerMigrationStatusHandler()
 	tsv.registerThrottlerHandlers()
+	tsv.registerTableGCHandlers()
 	tsv.registerDebugEnvHandler()
 
 	return tsv
@@ -1688,6 +1691,33 @@ func (tsv *TabletServer) GetThrottlerStatus(ctx context.Context) *throttle.Throt
 	return r
 }
 
+// PauseTableGC pauses table garbage collection on behalf of requester, for the given duration.
+func (tsv *TabletServer) PauseTableGC(requester string, duration time.Duration) (time.Time, error) {
+	return tsv.tableGC.Pause(requester, duration)
+}
+
+// ResumeTableGC releases the pause held by requester on table garbage collection.
+func (tsv *TabletServer) ResumeTableGC(requester string) {
+	tsv.tableGC.Resume(requester)
+}
+
+// RestoreGCTable renames a table held by table garbage collection back to an ordinary name.
+func (tsv *TabletServer) RestoreGCTable(ctx context.Context, tableName string, restoreAs string) (string, error) {
+	return tsv.tableGC.RestoreTable(ctx, tableName, restoreAs)
+}
+
+// SetTableGCEventListener sets the function that is told about the tables that table garbage
+// collection renames, drops or restores.
+func (tsv *TabletServer) SetTableGCEventListener(listener func(message string)) {
+	tsv.tableGC.SetEventListener(listener)
+}
+
+// SetPrimaryHandoff records the change of primary that the tablet is taking
+// part in, so that it's exposed along with the state of the query service.
+func (tsv *TabletServer) SetPrimaryHandoff(handoff *tabletmanagerdatapb.PrimaryHandoff) {
+	tsv.sm.SetPrimaryHandoff(handoff)
+}
+
 // HandlePanic is part of the queryservice.QueryService interface
 func (tsv *TabletServer) HandlePanic(err *error) {
 	if x := recover(); x != nil {
@@ -1871,6 +1901,62 @@ func (tsv *TabletServer) registerThrottlerHandlers() {
 	tsv.registerThrottlerThrottleAppHandler()
 }
 
+// registerTableGCHandlers registers the table garbage collector "pause", "resume", "restore" and "status" requests
+func (tsv *TabletServer) registerTableGCHandlers() {
+	tsv.exporter.HandleFunc("/table-gc/pause", func(w http.ResponseWriter, r *http.Request) {
+		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		requester := r.URL.Query().Get("requester")
+		var d time.Duration
+		if durationParam := r.URL.Query().Get("duration"); durationParam != "" {
+			var err error
+			d, err = time.ParseDuration(durationParam)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("not ok: %v", err), http.StatusBadRequest)
+				return
+			}
+		}
+		if _, err := tsv.PauseTableGC(requester, d); err != nil {
+			http.Error(w, fmt.Sprintf("not ok: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(tsv.tableGC.Status())
+	})
+	tsv.exporter.HandleFunc("/table-gc/resume", func(w http.ResponseWriter, r *http.Request) {
+		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		tsv.ResumeTableGC(r.URL.Query().Get("requester"))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(tsv.tableGC.Status())
+	})
+	tsv.exporter.HandleFunc("/table-gc/restore", func(w http.ResponseWriter, r *http.Request) {
+		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		tableName := r.URL.Query().Get("table")
+		restoredAs, err := tsv.RestoreGCTable(r.Context(), tableName, r.URL.Query().Get("restore_as"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("not ok: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"table": tableName, "restored_as": restoredAs})
+	})
+	tsv.exporter.HandleFunc("/table-gc/status", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(tsv.tableGC.Status())
+	})
+}
+
 func (tsv *TabletServer) registerDebugEnvHandler() {
 	tsv.exporter.HandleFunc("/debug/env", func(w http.ResponseWriter, r *http.Request) {
 		debugEnvHandler(tsv, w, r)
@@ -64,6 +64,10 @@ type Plan struct {
 	EnumSetValuesMap map[int](map[int]string)
 
 	env *vtenv.Environment
+
+	// exprEnv evaluates the Expression filters, with the row being filtered.
+	// It's built with the plan and reused for every row.
+	exprEnv *evalengine.ExpressionEnv
 }
 
 // Opcode enumerates the operators supported in a where clause
@@ -86,6 +90,9 @@ const (
 	NotEqual
 	// IsNotNull is used to filter a column if it is NULL
 	IsNotNull
+	// Expression is used to filter a row on a boolean expression evaluated by the
+	// evalengine, like a REGEXP_LIKE() of a column
+	Expression
 )
 
 // Filter contains opcodes for filtering.
@@ -102,6 +109,9 @@ type Filter struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 	KeyRange      *topodatapb.KeyRange
+
+	// Expr is the expression that must be true for the row to match, for Expression.
+	Expr evalengine.Expr
 }
 
 // ColExpr represents a column expression.
@@ -235,6 +245,15 @@ func (plan *Plan) filter(values, result []sqltypes.Value, charsets []collations.
 			if values[filter.ColNum].IsNull() {
 				return false, nil
 			}
+		case Expression:
+			plan.exprEnv.Row = values
+			res, err := plan.exprEnv.Evaluate(filter.Expr)
+			if err != nil {
+				return false, err
+			}
+			if !res.ToBoolean() {
+				return false, nil
+			}
 		default:
 			match, err := compare(filter.Opcode, values[filter.ColNum], filter.Value, plan.env.CollationEnv(), charsets[filter.ColNum])
 			if err != nil {
@@ -519,6 +538,12 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	for _, expr := range exprs {
 		switch expr := expr.(type) {
 		case *sqlparser.ComparisonExpr:
+			if expr.Operator == sqlparser.RegexpOp || expr.Operator == sqlparser.NotRegexpOp {
+				if err := plan.analyzeExpressionFilter(expr); err != nil {
+					return err
+				}
+				continue
+			}
 			opcode, err := getOpcode(expr)
 			if err != nil {
 				return err
@@ -559,6 +584,10 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 				ColNum: colnum,
 				Value:  resolved.Value(plan.env.CollationEnv().DefaultConnectionCharset()),
 			})
+		case *sqlparser.RegexpLikeExpr:
+			if err := plan.analyzeExpressionFilter(expr); err != nil {
+				return err
+			}
 		case *sqlparser.FuncExpr:
 			if !expr.Name.EqualString("in_keyrange") {
 				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
@@ -592,6 +621,48 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	return nil
 }
 
+// analyzeExpressionFilter adds a filter on an expression of the columns of the table
+// and literals, which is evaluated by the evalengine for every row, like MySQL would:
+// this is how the REGEXP operators and REGEXP_LIKE() are supported, with the same
+// ICU syntax and match flags as MySQL, and honoring the collation of the columns.
+func (plan *Plan) analyzeExpressionFilter(expr sqlparser.Expr) error {
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			if !node.Qualifier.IsEmpty() {
+				return false, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(node))
+			}
+			_, err := findColumn(plan.Table, node.Name)
+			return false, err
+		case *sqlparser.Subquery, *sqlparser.Argument:
+			return false, fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+		}
+		return true, nil
+	}, expr)
+	if err != nil {
+		return err
+	}
+
+	fields := evalengine.FieldResolver(plan.Table.Fields)
+	pe, err := evalengine.Translate(expr, &evalengine.Config{
+		ResolveColumn: fields.Column,
+		ResolveType:   fields.Type,
+		Collation:     plan.env.CollationEnv().DefaultConnectionCharset(),
+		Environment:   plan.env,
+	})
+	if err != nil {
+		return err
+	}
+	if plan.exprEnv == nil {
+		plan.exprEnv = evalengine.EmptyExpressionEnv(plan.env)
+	}
+	plan.Filters = append(plan.Filters, Filter{
+		Opcode: Expression,
+		Expr:   pe,
+	})
+	return nil
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -666,7 +737,7 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 			Field: &querypb.Field{
 				Name:    "keyspace_id",
 				Type:    sqltypes.VarBinary,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 				Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 			},
 			Vindex:        cv.Vindex,
@@ -692,7 +763,7 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 				Field: &querypb.Field{
 					Name:    "keyspace_id",
 					Type:    sqltypes.VarBinary,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 				},
 				Vindex:        cv.Vindex,
@@ -730,7 +801,7 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 			Field: &querypb.Field{
 				Name:    "1",
 				Type:    querypb.Type_INT64,
-				Charset: collations.CollationBinaryID,
+				Charset: uint32(collations.CollationBinaryID),
 				Flags:   uint32(querypb.MySqlFlag_NOT_NULL_FLAG | querypb.MySqlFlag_NUM_FLAG),
 			},
 			ColNum:     -1,
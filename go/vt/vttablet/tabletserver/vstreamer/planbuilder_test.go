@@ -179,12 +179,12 @@ func TestPlanBuilder(t *testing.T) {
 		Fields: []*querypb.Field{{
 			Name:    "id",
 			Type:    sqltypes.Int64,
-			Charset: collations.CollationBinaryID,
+			Charset: uint32(collations.CollationBinaryID),
 			Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 		}, {
 			Name:    "val",
 			Type:    sqltypes.VarBinary,
-			Charset: collations.CollationBinaryID,
+			Charset: uint32(collations.CollationBinaryID),
 			Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 		}},
 	}
@@ -194,7 +194,7 @@ func TestPlanBuilder(t *testing.T) {
 		Fields: []*querypb.Field{{
 			Name:    "val",
 			Type:    sqltypes.VarBinary,
-			Charset: collations.CollationBinaryID,
+			Charset: uint32(collations.CollationBinaryID),
 			Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 		}},
 	}
@@ -203,12 +203,12 @@ func TestPlanBuilder(t *testing.T) {
 		Fields: []*querypb.Field{{
 			Name:    "id",
 			Type:    sqltypes.Int64,
-			Charset: collations.CollationBinaryID,
+			Charset: uint32(collations.CollationBinaryID),
 			Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 		}, {
 			Name:    "val",
 			Type:    sqltypes.VarBinary,
-			Charset: collations.CollationBinaryID,
+			Charset: uint32(collations.CollationBinaryID),
 			Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 		}},
 	}
@@ -217,17 +217,17 @@ func TestPlanBuilder(t *testing.T) {
 		Fields: []*querypb.Field{{
 			Name:    "region",
 			Type:    sqltypes.Int64,
-			Charset: collations.CollationBinaryID,
+			Charset: uint32(collations.CollationBinaryID),
 			Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 		}, {
 			Name:    "id",
 			Type:    sqltypes.Int64,
-			Charset: collations.CollationBinaryID,
+			Charset: uint32(collations.CollationBinaryID),
 			Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 		}, {
 			Name:    "val",
 			Type:    sqltypes.VarBinary,
-			Charset: collations.CollationBinaryID,
+			Charset: uint32(collations.CollationBinaryID),
 			Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 		}},
 	}
@@ -246,7 +246,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "id",
 					Type:    sqltypes.Int64,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 				},
 			}, {
@@ -254,7 +254,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "val",
 					Type:    sqltypes.VarBinary,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 				},
 			}},
@@ -269,7 +269,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "id",
 					Type:    sqltypes.Int64,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 				},
 			}, {
@@ -277,7 +277,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "val",
 					Type:    sqltypes.VarBinary,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 				},
 			}},
@@ -300,7 +300,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "id",
 					Type:    sqltypes.Int64,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 				},
 			}, {
@@ -308,7 +308,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "val",
 					Type:    sqltypes.VarBinary,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 				},
 			}},
@@ -323,7 +323,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "id",
 					Type:    sqltypes.Int64,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 				},
 			}, {
@@ -331,7 +331,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "val",
 					Type:    sqltypes.VarBinary,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 				},
 			}},
@@ -346,7 +346,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "val",
 					Type:    sqltypes.VarBinary,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 				},
 			}, {
@@ -354,7 +354,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "id",
 					Type:    sqltypes.Int64,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 				},
 			}},
@@ -369,7 +369,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "val",
 					Type:    sqltypes.VarBinary,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 				},
 			}, {
@@ -377,7 +377,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "id",
 					Type:    sqltypes.Int64,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 				},
 			}},
@@ -400,7 +400,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "val",
 					Type:    sqltypes.VarBinary,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 				},
 			}, {
@@ -408,7 +408,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "id",
 					Type:    sqltypes.Int64,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 				},
 			}},
@@ -431,7 +431,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "val",
 					Type:    sqltypes.VarBinary,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 				},
 			}, {
@@ -439,7 +439,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "id",
 					Type:    sqltypes.Int64,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 				},
 			}},
@@ -465,7 +465,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "val",
 					Type:    sqltypes.VarBinary,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 				},
 			}, {
@@ -473,7 +473,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "id",
 					Type:    sqltypes.Int64,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 				},
 			}},
@@ -496,7 +496,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "val",
 					Type:    sqltypes.VarBinary,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 				},
 			}, {
@@ -504,7 +504,7 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "id",
 					Type:    sqltypes.Int64,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 				},
 			}},
@@ -520,14 +520,14 @@ func TestPlanBuilder(t *testing.T) {
 				Field: &querypb.Field{
 					Name:    "id",
 					Type:    sqltypes.Int64,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_NUM_FLAG),
 				},
 			}, {
 				Field: &querypb.Field{
 					Name:    "keyspace_id",
 					Type:    sqltypes.VarBinary,
-					Charset: collations.CollationBinaryID,
+					Charset: uint32(collations.CollationBinaryID),
 					Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 				},
 				Vindex:        testLocalVSchema.vschema.Keyspaces["ks"].Vindexes["region_vdx"],
@@ -672,12 +672,12 @@ func TestPlanBuilderFilterComparison(t *testing.T) {
 		Fields: []*querypb.Field{{
 			Name:    "id",
 			Type:    sqltypes.Int64,
-			Charset: collations.CollationBinaryID,
+			Charset: uint32(collations.CollationBinaryID),
 			Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG | querypb.MySqlFlag_NUM_FLAG),
 		}, {
 			Name:    "val",
 			Type:    sqltypes.VarBinary,
-			Charset: collations.CollationBinaryID,
+			Charset: uint32(collations.CollationBinaryID),
 			Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG),
 		}},
 	}
@@ -747,6 +747,131 @@ func TestPlanBuilderFilterComparison(t *testing.T) {
 	}
 }
 
+func TestPlanBuilderFilterExpression(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name:    "id",
+			Type:    sqltypes.Int64,
+			Charset: uint32(collations.CollationBinaryID),
+			Flags:   uint32(querypb.MySqlFlag_BINARY_FLAG | querypb.MySqlFlag_NUM_FLAG),
+		}, {
+			Name:    "val",
+			Type:    sqltypes.VarChar,
+			Charset: uint32(collations.MySQL8().DefaultConnectionCharset()),
+		}},
+	}
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("abc")},
+		{sqltypes.NewInt64(2), sqltypes.NewVarChar("ABD")},
+		{sqltypes.NewInt64(3), sqltypes.NewVarChar("xyz")},
+		{sqltypes.NewInt64(4), sqltypes.NULL},
+	}
+	testcases := []struct {
+		inFilter string
+		outIDs   []int64
+		outErr   string
+	}{{
+		inFilter: "select * from t1 where val regexp '^ab'",
+		outIDs:   []int64{1, 2},
+	}, {
+		inFilter: "select * from t1 where val not regexp '^ab'",
+		outIDs:   []int64{3},
+	}, {
+		inFilter: "select * from t1 where regexp_like(val, '^ab', 'c')",
+		outIDs:   []int64{1},
+	}, {
+		inFilter: "select * from t1 where regexp_like(val, '[[:alpha:]]{3}') and id > 1",
+		outIDs:   []int64{2, 3},
+	}, {
+		inFilter: "select * from t1 where regexp_like(val, '(?i)^X')",
+		outIDs:   []int64{3},
+	}, {
+		inFilter: "select * from t1 where regexp_like(t2.val, 'a')",
+		outErr:   "unsupported qualifier for column: t2.val",
+	}, {
+		inFilter: "select * from t1 where regexp_like(foo, 'a')",
+		outErr:   "column foo not found in table t1",
+	}, {
+		inFilter: "select * from t1 where val regexp (select 'a')",
+		outErr:   "unsupported constraint: val regexp (select 'a' from dual)",
+	}}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.inFilter, func(t *testing.T) {
+			plan, err := buildPlan(vtenv.NewTestEnv(), t1, testLocalVSchema, &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.inFilter}},
+			})
+			if tcase.outErr != "" {
+				assert.Nil(t, plan)
+				assert.EqualError(t, err, tcase.outErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var ids []int64
+			charsets := []collations.ID{collations.CollationBinaryID, collations.MySQL8().DefaultConnectionCharset()}
+			result := make([]sqltypes.Value, len(plan.ColExprs))
+			for _, row := range rows {
+				ok, err := plan.filter(row, result, charsets)
+				require.NoError(t, err)
+				if ok {
+					id, err := result[0].ToInt64()
+					require.NoError(t, err)
+					ids = append(ids, id)
+				}
+			}
+			assert.Equal(t, tcase.outIDs, ids)
+		})
+	}
+}
+
+func TestPlanFilterExpressionEnv(t *testing.T) {
+	plan := &Plan{
+		Table: &Table{
+			Name: "t1",
+			Fields: []*querypb.Field{{
+				Name:    "id",
+				Type:    sqltypes.Int64,
+				Charset: uint32(collations.CollationBinaryID),
+			}, {
+				Name:    "val",
+				Type:    sqltypes.VarChar,
+				Charset: uint32(collations.MySQL8().DefaultConnectionCharset()),
+			}},
+		},
+		ColExprs: []ColExpr{{ColNum: 0}},
+		env:      vtenv.NewTestEnv(),
+	}
+	expr, err := sqlparser.NewTestParser().ParseExpr("regexp_like(val, '^ab')")
+	require.NoError(t, err)
+	require.NoError(t, plan.analyzeExpressionFilter(expr))
+
+	// the env is built with the plan, and only its row changes for every filtered row
+	exprEnv := plan.exprEnv
+	require.NotNil(t, exprEnv)
+
+	charsets := []collations.ID{collations.CollationBinaryID, collations.MySQL8().DefaultConnectionCharset()}
+	result := make([]sqltypes.Value, 1)
+	for _, tcase := range []struct {
+		row   []sqltypes.Value
+		match bool
+	}{
+		{row: []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("abc")}, match: true},
+		{row: []sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewVarChar("xyz")}, match: false},
+		{row: []sqltypes.Value{sqltypes.NewInt64(3), sqltypes.NULL}, match: false},
+		{row: []sqltypes.Value{sqltypes.NewInt64(4), sqltypes.NewVarChar("ABD")}, match: true},
+	} {
+		ok, err := plan.filter(tcase.row, result, charsets)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.match, ok, "row %v", tcase.row)
+		if ok {
+			assert.Equal(t, tcase.row[0], result[0])
+		}
+		assert.Same(t, exprEnv, plan.exprEnv)
+	}
+}
+
 func TestCompare(t *testing.T) {
 	type testcase struct {
 		opcode                   Opcode
@@ -155,7 +155,7 @@ func TestVStreamCopyFilterValidations(t *testing.T) {
 
 	tablePKs := []*binlogdatapb.TableLastPK{{
 		TableName: "t1",
-		Lastpk:    getQRFromLastPK([]*query.Field{{Name: "id11", Type: query.Type_INT32, Charset: collations.CollationBinaryID, Flags: uint32(query.MySqlFlag_BINARY_FLAG | query.MySqlFlag_NUM_FLAG)}}, []sqltypes.Value{sqltypes.NewInt32(10)}),
+		Lastpk:    getQRFromLastPK([]*query.Field{{Name: "id11", Type: query.Type_INT32, Charset: uint32(collations.CollationBinaryID), Flags: uint32(query.MySqlFlag_BINARY_FLAG | query.MySqlFlag_NUM_FLAG)}}, []sqltypes.Value{sqltypes.NewInt32(10)}),
 	}}
 	testCases = append(testCases, &TestCase{[]*binlogdatapb.Rule{{Match: "t1"}}, tablePKs, []string{"t1"}, ""})
 
@@ -419,7 +419,7 @@ func getRule(table string) *binlogdatapb.Rule {
 }
 
 func getTablePK(table string, idx int) *binlogdatapb.TableLastPK {
-	fields := []*query.Field{{Name: fmt.Sprintf("id%d1", idx), Type: query.Type_INT32, Charset: collations.CollationBinaryID, Flags: uint32(query.MySqlFlag_BINARY_FLAG | query.MySqlFlag_NUM_FLAG)}}
+	fields := []*query.Field{{Name: fmt.Sprintf("id%d1", idx), Type: query.Type_INT32, Charset: uint32(collations.CollationBinaryID), Flags: uint32(query.MySqlFlag_BINARY_FLAG | query.MySqlFlag_NUM_FLAG)}}
 
 	lastPK := []sqltypes.Value{sqltypes.NewInt32(0)}
 	return &binlogdatapb.TableLastPK{
@@ -84,6 +84,10 @@ type vstreamer struct {
 	pos     replication.Position
 	stopPos string
 
+	// decimals is the buffer that the DECIMAL values of the rows are
+	// formatted into, which is shared by the rows of many events.
+	decimals []byte
+
 	phase string
 	vse   *Engine
 }
@@ -1054,7 +1058,14 @@ func (vs *vstreamer) extractRowAndFilter(plan *streamerPlan, data []byte, dataCo
 			valueIndex++
 			continue
 		}
-		value, l, err := mysqlbinlog.CellValue(data, pos, plan.TableMap.Types[colNum], plan.TableMap.Metadata[colNum], plan.Table.Fields[colNum])
+		var value sqltypes.Value
+		var l int
+		var err error
+		if typ := plan.TableMap.Types[colNum]; typ == mysqlbinlog.TypeNewDecimal {
+			value, vs.decimals, l, err = mysqlbinlog.AppendDecimalCellValue(vs.decimals, data, pos, plan.TableMap.Metadata[colNum])
+		} else {
+			value, l, err = mysqlbinlog.CellValue(data, pos, typ, plan.TableMap.Metadata[colNum], plan.Table.Fields[colNum])
+		}
 		if err != nil {
 			log.Errorf("extractRowAndFilter: %s, table: %s, colNum: %d, fields: %+v, current values: %+v",
 				err, plan.Table.Name, colNum, plan.Table.Fields, values)
@@ -583,7 +583,7 @@ func TestVStreamCopyWithDifferentFilters(t *testing.T) {
 		t1FieldEvent.String(),
 		"gtid",
 		getRowEvent(ts, t1FieldEvent, "insert into t1 values (1, 2)"),
-		getLastPKEvent("t1", "id1", sqltypes.Int32, []sqltypes.Value{sqltypes.NewInt32(1)}, collations.CollationBinaryID, uint32(53251)),
+		getLastPKEvent("t1", "id1", sqltypes.Int32, []sqltypes.Value{sqltypes.NewInt32(1)}, uint32(collations.CollationBinaryID), uint32(53251)),
 		"commit",
 		"begin",
 		getCopyCompletedEvent("t1"),
@@ -591,7 +591,7 @@ func TestVStreamCopyWithDifferentFilters(t *testing.T) {
 		"begin",
 		ts.fieldEvents["t2a"].String(),
 		getRowEvent(ts, ts.fieldEvents["t2a"], "insert into t2a values (1, 4)"),
-		getLastPKEvent("t2a", "id1", sqltypes.Int32, []sqltypes.Value{sqltypes.NewInt32(1)}, collations.CollationBinaryID, uint32(53251)),
+		getLastPKEvent("t2a", "id1", sqltypes.Int32, []sqltypes.Value{sqltypes.NewInt32(1)}, uint32(collations.CollationBinaryID), uint32(53251)),
 		"commit",
 		"begin",
 		getCopyCompletedEvent("t2a"),
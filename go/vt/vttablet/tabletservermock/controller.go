@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -91,6 +92,9 @@ type Controller struct {
 
 	// queryRulesMap has the latest query rules.
 	queryRulesMap map[string]*rules.Rules
+
+	// primaryHandoff is the last handoff given to SetPrimaryHandoff.
+	primaryHandoff *tabletmanagerdatapb.PrimaryHandoff
 }
 
 // NewController returns a mock of tabletserver.Controller
@@ -226,6 +230,40 @@ func (tqsc *Controller) GetThrottlerStatus(ctx context.Context) *throttle.Thrott
 	return nil
 }
 
+// PauseTableGC is part of the tabletserver.Controller interface
+func (tqsc *Controller) PauseTableGC(requester string, duration time.Duration) (time.Time, error) {
+	return time.Now().Add(duration), nil
+}
+
+// ResumeTableGC is part of the tabletserver.Controller interface
+func (tqsc *Controller) ResumeTableGC(requester string) {
+}
+
+// RestoreGCTable is part of the tabletserver.Controller interface
+func (tqsc *Controller) RestoreGCTable(ctx context.Context, tableName string, restoreAs string) (string, error) {
+	return restoreAs, nil
+}
+
+// SetTableGCEventListener is part of the tabletserver.Controller interface
+func (tqsc *Controller) SetTableGCEventListener(listener func(message string)) {
+}
+
+// SetPrimaryHandoff is part of the tabletserver.Controller interface
+func (tqsc *Controller) SetPrimaryHandoff(handoff *tabletmanagerdatapb.PrimaryHandoff) {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+
+	tqsc.primaryHandoff = handoff
+}
+
+// PrimaryHandoff returns the last handoff given to SetPrimaryHandoff.
+func (tqsc *Controller) PrimaryHandoff() *tabletmanagerdatapb.PrimaryHandoff {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+
+	return tqsc.primaryHandoff
+}
+
 // EnterLameduck implements tabletserver.Controller.
 func (tqsc *Controller) EnterLameduck() {
 	tqsc.mu.Lock()
@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tmclient
+
+// The tablets and their clients can run different versions of Vitess, e.g.
+// during an upgrade. The level of the tabletmanager API implemented by a tablet
+// is returned by its NegotiateAPILevel RPC, so that the clients know which
+// fields of the requests and the responses it understands.
+
+const (
+	// LegacyAPILevel is the API level of the tablets that don't implement
+	// NegotiateAPILevel.
+	LegacyAPILevel int32 = 0
+
+	// NegotiateAPILevel is the API level of the tablets that implement
+	// NegotiateAPILevel, and return the primary status of DemotePrimary.
+	NegotiateAPILevel int32 = 1
+
+	// GracefulRestartAllowPrimaryAPILevel is the API level from which
+	// GracefulRestart refuses to restart a PRIMARY unless allow_primary is set.
+	GracefulRestartAllowPrimaryAPILevel int32 = 2
+
+	// PrimaryHandoffAPILevel is the API level from which DemotePrimary and
+	// PromoteReplica expose the primary handoff to the query service.
+	PrimaryHandoffAPILevel int32 = 3
+
+	// AsyncFetchAPILevel is the API level from which ExecuteFetchAsDba runs the
+	// async requests as jobs.
+	AsyncFetchAPILevel int32 = 4
+
+	// CurrentAPILevel is the API level implemented by this version. It must be
+	// increased by the changes to the tabletmanager protos that need the
+	// clients to talk differently to the tablets that don't implement them,
+	// e.g. when a field replaces another one.
+	CurrentAPILevel = AsyncFetchAPILevel
+)
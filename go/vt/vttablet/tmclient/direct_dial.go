@@ -0,0 +1,79 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tmclient
+
+import (
+	"vitess.io/vitess/go/netutil"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The TabletManagerClient RPCs take the record of their tablet, which is
+// usually read from the topo. When the topo is unavailable, the break-glass
+// tooling, e.g. to reparent a shard by hand, can use DirectDialTablet to get a
+// record that only holds the address of the tablet manager to dial.
+//
+// Since this bypasses the topo, and hence the checks that the address is the
+// one of the expected tablet, it must be enabled explicitly with
+// --tablet_manager_allow_direct_dial, and each use is logged with its reason.
+
+// allowDirectDial is whether DirectDialTablet can be used.
+var allowDirectDial = false
+
+var directDials = stats.NewCountersWithSingleLabel(
+	"TabletManagerClientDirectDials",
+	"Number of tablet records created by DirectDialTablet to dial a tablet manager without the topo",
+	"Address")
+
+// DirectDialTablet returns a tablet record with which the TabletManagerClient
+// RPCs dial the tablet manager at addr, the host:port of its gRPC server,
+// without reading the tablet record from the topo. alias is the alias the
+// caller expects the tablet to have, and may be nil if it is not known. reason
+// is logged, with the alias and the address, to audit the use of this bypass.
+//
+// It fails unless --tablet_manager_allow_direct_dial is set. The returned
+// record has no keyspace, shard nor type, so it can't be used with the RPCs
+// or the helpers that need them.
+func DirectDialTablet(alias *topodatapb.TabletAlias, addr string, reason string) (*topodatapb.Tablet, error) {
+	if !allowDirectDial {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot dial tablet %v at %v directly: --tablet_manager_allow_direct_dial is not set", topoproto.TabletAliasString(alias), addr)
+	}
+	if reason == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot dial tablet %v at %v directly: a reason is required", topoproto.TabletAliasString(alias), addr)
+	}
+	host, port, err := netutil.SplitHostPort(addr)
+	if err == nil && (host == "" || port == 0) {
+		err = vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "missing host or port")
+	}
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot dial tablet %v at %v directly: %v", topoproto.TabletAliasString(alias), addr, err)
+	}
+
+	log.Warningf("Dialing tablet %v at %v directly, bypassing the topo: %s", topoproto.TabletAliasString(alias), addr, reason)
+	directDials.Add(addr, 1)
+
+	return &topodatapb.Tablet{
+		Alias:    alias,
+		Hostname: host,
+		PortMap:  map[string]int32{"grpc": int32(port)},
+	}, nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tmclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestDirectDialTablet(t *testing.T) {
+	alias := &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}
+
+	_, err := DirectDialTablet(alias, "10.0.0.1:15999", "topo outage")
+	require.EqualError(t, err, "cannot dial tablet zone1-0000000100 at 10.0.0.1:15999 directly: --tablet_manager_allow_direct_dial is not set")
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+
+	defer func(allow bool) { allowDirectDial = allow }(allowDirectDial)
+	allowDirectDial = true
+
+	_, err = DirectDialTablet(alias, "10.0.0.1:15999", "")
+	require.EqualError(t, err, "cannot dial tablet zone1-0000000100 at 10.0.0.1:15999 directly: a reason is required")
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+
+	for _, addr := range []string{"10.0.0.1", "10.0.0.1:port", ":15999", "10.0.0.1:0", "10.0.0.1:70000"} {
+		_, err = DirectDialTablet(alias, addr, "topo outage")
+		assert.Error(t, err, addr)
+		assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err), addr)
+	}
+
+	dials := directDials.Counts()["10.0.0.1:15999"]
+	tablet, err := DirectDialTablet(alias, "10.0.0.1:15999", "topo outage")
+	require.NoError(t, err)
+	assert.Equal(t, &topodatapb.Tablet{
+		Alias:    alias,
+		Hostname: "10.0.0.1",
+		PortMap:  map[string]int32{"grpc": 15999},
+	}, tablet)
+	assert.Equal(t, dials+1, directDials.Counts()["10.0.0.1:15999"])
+
+	// the alias is optional, and IPv6 addresses are supported
+	tablet, err = DirectDialTablet(nil, "[::1]:15999", "topo outage")
+	require.NoError(t, err)
+	assert.Nil(t, tablet.Alias)
+	assert.Equal(t, "::1", tablet.Hostname)
+	assert.Equal(t, int32(15999), tablet.PortMap["grpc"])
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tmclient
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The tablets that are known to be down, e.g. by the healthcheck, can be
+// reported with ReportTabletDown. The RPCs to these tablets whose context was
+// returned by WithFailFast fail immediately with ErrTabletDown, instead of
+// waiting for the tablet to come up until their deadline. This lets the
+// callers that talk to all the tablets of a shard, like EmergencyReparentShard,
+// make their decisions without waiting for the tablets that are down.
+//
+// A report is only trusted for --tablet_manager_fail_fast_window, or until the
+// tablet is reported up again with ReportTabletUp.
+//
+// The processes without a healthcheck, like vtctld and VTOrc, register the
+// ReportTabletHealth interceptor, so that their own RPCs to the tablets report
+// them down or up.
+
+// ErrTabletDown is the error of the RPCs that failed fast because their tablet
+// was recently reported down. Use IsErrTabletDown to check for it.
+var ErrTabletDown = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "tablet was recently reported down")
+
+// failFastWindow is how long a tablet is considered down after it was reported
+// down.
+var failFastWindow = 30 * time.Second
+
+type tabletDownReport struct {
+	reported time.Time
+	reason   string
+}
+
+var downTablets = struct {
+	mu      sync.Mutex
+	reports map[string]tabletDownReport
+}{reports: make(map[string]tabletDownReport)}
+
+// ReportTabletDown records that the given tablet is down for the given reason.
+func ReportTabletDown(alias *topodatapb.TabletAlias, reason string) {
+	downTablets.mu.Lock()
+	defer downTablets.mu.Unlock()
+	downTablets.reports[topoproto.TabletAliasString(alias)] = tabletDownReport{
+		reported: time.Now(),
+		reason:   reason,
+	}
+}
+
+// ReportTabletUp records that the given tablet is reachable again.
+func ReportTabletUp(alias *topodatapb.TabletAlias) {
+	downTablets.mu.Lock()
+	defer downTablets.mu.Unlock()
+	delete(downTablets.reports, topoproto.TabletAliasString(alias))
+}
+
+// tabletDownReason returns why the given tablet was reported down, and whether
+// it was reported down in the last --tablet_manager_fail_fast_window.
+func tabletDownReason(alias *topodatapb.TabletAlias) (string, bool) {
+	key := topoproto.TabletAliasString(alias)
+
+	downTablets.mu.Lock()
+	defer downTablets.mu.Unlock()
+	report, ok := downTablets.reports[key]
+	if !ok {
+		return "", false
+	}
+	if time.Since(report.reported) > failFastWindow {
+		delete(downTablets.reports, key)
+		return "", false
+	}
+	return report.reason, true
+}
+
+// ReportTabletHealth is an Interceptor that reports the tablet of the RPC down
+// if it couldn't be reached, and up if it answered, even with an error. Nothing
+// is reported for the RPCs that failed fast or whose context is done, since the
+// tablet wasn't given a chance to answer them.
+func ReportTabletHealth(ctx context.Context, method string, tablet *topodatapb.Tablet, invoker Invoker) error {
+	err := invoker(ctx)
+	switch {
+	case IsErrTabletDown(err), ctx.Err() != nil:
+	case isUnreachable(err):
+		ReportTabletDown(tablet.Alias, method+" RPC failed: "+err.Error())
+	default:
+		ReportTabletUp(tablet.Alias)
+	}
+	return err
+}
+
+// isUnreachable returns whether err, which can be a gRPC error, means that the
+// tablet couldn't be reached.
+func isUnreachable(err error) bool {
+	if err == nil {
+		return false
+	}
+	code := vterrors.Code(err)
+	if code == vtrpcpb.Code_UNKNOWN {
+		code = vterrors.Code(vterrors.FromGRPC(err))
+	}
+	return code == vtrpcpb.Code_UNAVAILABLE
+}
+
+type failFastKey struct{}
+
+// WithFailFast returns a context with which the RPCs to the tablets that were
+// recently reported down fail immediately with ErrTabletDown.
+func WithFailFast(ctx context.Context) context.Context {
+	return context.WithValue(ctx, failFastKey{}, true)
+}
+
+// CheckFailFast returns ErrTabletDown if ctx was returned by WithFailFast and
+// the given tablet was recently reported down. It is called by the
+// TabletManagerClient implementations before sending an RPC to the tablet.
+func CheckFailFast(ctx context.Context, tablet *topodatapb.Tablet) error {
+	if failFast, _ := ctx.Value(failFastKey{}).(bool); !failFast {
+		return nil
+	}
+	reason, down := tabletDownReason(tablet.Alias)
+	if !down {
+		return nil
+	}
+	return vterrors.Wrapf(ErrTabletDown, "not sending RPC to tablet %v (%s)", topoproto.TabletAliasString(tablet.Alias), reason)
+}
+
+// IsErrTabletDown returns whether err is, or wraps, ErrTabletDown.
+func IsErrTabletDown(err error) bool {
+	return err != nil && vterrors.UnwrapAll(err) == ErrTabletDown
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tmclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestCheckFailFast(t *testing.T) {
+	tablet := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}}
+	other := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 101}}
+	ctx := context.Background()
+	failFastCtx := WithFailFast(ctx)
+
+	require.NoError(t, CheckFailFast(failFastCtx, tablet))
+
+	ReportTabletDown(tablet.Alias, "healthcheck timed out")
+	defer ReportTabletUp(tablet.Alias)
+
+	err := CheckFailFast(failFastCtx, tablet)
+	require.EqualError(t, err, "not sending RPC to tablet zone1-0000000100 (healthcheck timed out): tablet was recently reported down")
+	assert.True(t, IsErrTabletDown(err))
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+	assert.False(t, IsErrTabletDown(vterrors.New(vtrpcpb.Code_UNAVAILABLE, "tablet was recently reported down")))
+
+	// only the RPCs that fail fast are rejected, and only for that tablet
+	assert.NoError(t, CheckFailFast(ctx, tablet))
+	assert.NoError(t, CheckFailFast(failFastCtx, other))
+
+	ReportTabletUp(tablet.Alias)
+	assert.NoError(t, CheckFailFast(failFastCtx, tablet))
+
+	// the reports expire
+	defer func(window time.Duration) { failFastWindow = window }(failFastWindow)
+	failFastWindow = time.Millisecond
+	ReportTabletDown(tablet.Alias, "healthcheck timed out")
+	time.Sleep(5 * time.Millisecond)
+	assert.NoError(t, CheckFailFast(failFastCtx, tablet))
+}
+
+func TestReportTabletHealth(t *testing.T) {
+	tablet := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}}
+	ctx := context.Background()
+	failFastCtx := WithFailFast(ctx)
+	defer ReportTabletUp(tablet.Alias)
+
+	rpc := func(err error) Invoker {
+		return func(context.Context) error { return err }
+	}
+
+	// the tablet couldn't be reached
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+	err := ReportTabletHealth(ctx, "StopReplicationAndGetStatus", tablet, rpc(unavailable))
+	assert.Equal(t, unavailable, err)
+	err = CheckFailFast(failFastCtx, tablet)
+	require.EqualError(t, err, "not sending RPC to tablet zone1-0000000100 (StopReplicationAndGetStatus RPC failed: rpc error: code = Unavailable desc = connection refused): tablet was recently reported down")
+
+	// failing fast doesn't renew the report
+	reported := downTablets.reports["zone1-0000000100"].reported
+	require.Error(t, ReportTabletHealth(failFastCtx, "Ping", tablet, func(ctx context.Context) error {
+		return CheckFailFast(ctx, tablet)
+	}))
+	assert.Equal(t, reported, downTablets.reports["zone1-0000000100"].reported)
+
+	// nor do the RPCs given up by their caller
+	canceledCtx, cancel := context.WithCancel(failFastCtx)
+	cancel()
+	require.Error(t, ReportTabletHealth(canceledCtx, "Ping", tablet, rpc(context.Canceled)))
+	assert.Error(t, CheckFailFast(failFastCtx, tablet))
+
+	// the tablet answered, even with an error
+	failedPrecondition := vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "not a replica")
+	assert.Equal(t, failedPrecondition, ReportTabletHealth(ctx, "StopReplicationAndGetStatus", tablet, rpc(failedPrecondition)))
+	assert.NoError(t, CheckFailFast(failFastCtx, tablet))
+
+	require.Error(t, ReportTabletHealth(ctx, "Ping", tablet, rpc(vterrors.New(vtrpcpb.Code_UNAVAILABLE, "tablet is shutting down"))))
+	assert.Error(t, CheckFailFast(failFastCtx, tablet))
+	require.NoError(t, ReportTabletHealth(ctx, "Ping", tablet, rpc(nil)))
+	assert.NoError(t, CheckFailFast(failFastCtx, tablet))
+}
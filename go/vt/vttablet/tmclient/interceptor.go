@@ -0,0 +1,823 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tmclient
+
+import (
+	"context"
+	"time"
+
+	"vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// The RPCs of the TabletManagerClients can be wrapped by interceptors, e.g. to
+// log, trace or measure them, or to add credentials to their context. Unlike
+// the gRPC interceptors, they wrap the TabletManagerClient methods, so they
+// work with all the protocols, and they see the tablet of each RPC.
+
+// Invoker makes the RPC intercepted by an Interceptor, with the given context.
+type Invoker func(ctx context.Context) error
+
+// Interceptor intercepts the RPC of the given TabletManagerClient method, e.g.
+// "Ping", to the given tablet. It must call invoker to make the RPC, usually
+// with ctx or a context derived from it, and return its error, unless it fails
+// the RPC without making it.
+//
+// The streaming RPCs are only intercepted until their stream is returned, and
+// Close and CloseWithTimeout aren't intercepted.
+type Interceptor func(ctx context.Context, method string, tablet *topodatapb.Tablet, invoker Invoker) error
+
+var interceptors []Interceptor
+
+// RegisterInterceptor adds an interceptor to the TabletManagerClients created
+// by NewTabletManagerClient, after the ones already registered. Should be
+// called on init(), like RegisterTabletManagerClientFactory.
+func RegisterInterceptor(interceptor Interceptor) {
+	interceptors = append(interceptors, interceptor)
+}
+
+// WithInterceptors returns a TabletManagerClient that makes the RPCs of client
+// through the given interceptors. The first interceptor is the outermost one:
+// it is called first, and its invoker calls the next interceptor, and so on
+// until the last one, whose invoker makes the RPC. client is returned as is if
+// there are no interceptors.
+//
+// The returned client only has the methods of the TabletManagerClient interface
+// and CloseWithTimeout, which is forwarded to client if it is a GracefulCloser.
+// The other methods of client can be reached with Unwrap.
+func WithInterceptors(client TabletManagerClient, interceptors ...Interceptor) TabletManagerClient {
+	if len(interceptors) == 0 {
+		return client
+	}
+	return &interceptedClient{
+		client:       client,
+		interceptors: append([]Interceptor(nil), interceptors...),
+	}
+}
+
+// GracefulCloser is implemented by the TabletManagerClients that can let their
+// in-flight RPCs finish before they are closed, like the gRPC one.
+type GracefulCloser interface {
+	// CloseWithTimeout closes the client once its in-flight RPCs finished, or
+	// once the timeout expired or ctx is done, in which case it returns an error.
+	CloseWithTimeout(ctx context.Context, timeout time.Duration) error
+}
+
+// Unwrap returns the TabletManagerClient that makes the RPCs of client, if it
+// was returned by WithInterceptors, or client itself otherwise.
+func Unwrap(client TabletManagerClient) TabletManagerClient {
+	if intercepted, ok := client.(*interceptedClient); ok {
+		return intercepted.client
+	}
+	return client
+}
+
+// interceptedClient is the TabletManagerClient returned by WithInterceptors.
+type interceptedClient struct {
+	client       TabletManagerClient
+	interceptors []Interceptor
+}
+
+var (
+	_ TabletManagerClient = (*interceptedClient)(nil)
+	_ GracefulCloser      = (*interceptedClient)(nil)
+)
+
+// intercept makes an RPC through the interceptors of the client.
+func (client *interceptedClient) intercept(ctx context.Context, method string, tablet *topodatapb.Tablet, invoker Invoker) error {
+	return client.invoker(0, method, tablet, invoker)(ctx)
+}
+
+// invoker returns the invoker that calls the i-th interceptor of the client,
+// or rpc once all the interceptors were called.
+func (client *interceptedClient) invoker(i int, method string, tablet *topodatapb.Tablet, rpc Invoker) Invoker {
+	if i == len(client.interceptors) {
+		return rpc
+	}
+	return func(ctx context.Context) error {
+		return client.interceptors[i](ctx, method, tablet, client.invoker(i+1, method, tablet, rpc))
+	}
+}
+
+// Ping is part of the TabletManagerClient interface.
+func (client *interceptedClient) Ping(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return client.intercept(ctx, "Ping", tablet, func(ctx context.Context) error {
+		return client.client.Ping(ctx, tablet)
+	})
+}
+
+// GetSchema is part of the TabletManagerClient interface.
+func (client *interceptedClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetSchemaRequest) (resp *tabletmanagerdatapb.SchemaDefinition, err error) {
+	err = client.intercept(ctx, "GetSchema", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.GetSchema(ctx, tablet, request)
+		return err
+	})
+	return resp, err
+}
+
+// GetSchemaHash is part of the TabletManagerClient interface.
+func (client *interceptedClient) GetSchemaHash(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetSchemaHashRequest) (resp string, err error) {
+	err = client.intercept(ctx, "GetSchemaHash", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.GetSchemaHash(ctx, tablet, request)
+		return err
+	})
+	return resp, err
+}
+
+// StreamSchemaChanges is part of the TabletManagerClient interface.
+func (client *interceptedClient) StreamSchemaChanges(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.StreamSchemaChangesRequest) (resp SchemaChangeStream, err error) {
+	err = client.intercept(ctx, "StreamSchemaChanges", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.StreamSchemaChanges(ctx, tablet, request)
+		return err
+	})
+	return resp, err
+}
+
+// GetPermissions is part of the TabletManagerClient interface.
+func (client *interceptedClient) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (resp *tabletmanagerdatapb.Permissions, err error) {
+	err = client.intercept(ctx, "GetPermissions", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.GetPermissions(ctx, tablet)
+		return err
+	})
+	return resp, err
+}
+
+// GetGlobalStatusVars is part of the TabletManagerClient interface.
+func (client *interceptedClient) GetGlobalStatusVars(ctx context.Context, tablet *topodatapb.Tablet, variables []string) (resp map[string]string, err error) {
+	err = client.intercept(ctx, "GetGlobalStatusVars", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.GetGlobalStatusVars(ctx, tablet, variables)
+		return err
+	})
+	return resp, err
+}
+
+// StreamTabletEvents is part of the TabletManagerClient interface.
+func (client *interceptedClient) StreamTabletEvents(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.StreamTabletEventsRequest) (resp TabletEventStream, err error) {
+	err = client.intercept(ctx, "StreamTabletEvents", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.StreamTabletEvents(ctx, tablet, request)
+		return err
+	})
+	return resp, err
+}
+
+// ResetSequences is part of the TabletManagerClient interface.
+func (client *interceptedClient) ResetSequences(ctx context.Context, tablet *topodatapb.Tablet, tables []string) error {
+	return client.intercept(ctx, "ResetSequences", tablet, func(ctx context.Context) error {
+		return client.client.ResetSequences(ctx, tablet, tables)
+	})
+}
+
+// SetReadOnly is part of the TabletManagerClient interface.
+func (client *interceptedClient) SetReadOnly(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return client.intercept(ctx, "SetReadOnly", tablet, func(ctx context.Context) error {
+		return client.client.SetReadOnly(ctx, tablet)
+	})
+}
+
+// SetReadWrite is part of the TabletManagerClient interface.
+func (client *interceptedClient) SetReadWrite(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return client.intercept(ctx, "SetReadWrite", tablet, func(ctx context.Context) error {
+		return client.client.SetReadWrite(ctx, tablet)
+	})
+}
+
+// ChangeType is part of the TabletManagerClient interface.
+func (client *interceptedClient) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType, semiSync bool) error {
+	return client.intercept(ctx, "ChangeType", tablet, func(ctx context.Context) error {
+		return client.client.ChangeType(ctx, tablet, dbType, semiSync)
+	})
+}
+
+// Sleep is part of the TabletManagerClient interface.
+func (client *interceptedClient) Sleep(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) error {
+	return client.intercept(ctx, "Sleep", tablet, func(ctx context.Context) error {
+		return client.client.Sleep(ctx, tablet, duration)
+	})
+}
+
+// ExecuteHook is part of the TabletManagerClient interface.
+func (client *interceptedClient) ExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (resp *hook.HookResult, err error) {
+	err = client.intercept(ctx, "ExecuteHook", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.ExecuteHook(ctx, tablet, hk)
+		return err
+	})
+	return resp, err
+}
+
+// StreamExecuteHook is part of the TabletManagerClient interface.
+func (client *interceptedClient) StreamExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (resp HookStream, err error) {
+	err = client.intercept(ctx, "StreamExecuteHook", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.StreamExecuteHook(ctx, tablet, hk)
+		return err
+	})
+	return resp, err
+}
+
+// RefreshState is part of the TabletManagerClient interface.
+func (client *interceptedClient) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return client.intercept(ctx, "RefreshState", tablet, func(ctx context.Context) error {
+		return client.client.RefreshState(ctx, tablet)
+	})
+}
+
+// RunHealthCheck is part of the TabletManagerClient interface.
+func (client *interceptedClient) RunHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return client.intercept(ctx, "RunHealthCheck", tablet, func(ctx context.Context) error {
+		return client.client.RunHealthCheck(ctx, tablet)
+	})
+}
+
+// PrepareShutdown is part of the TabletManagerClient interface.
+func (client *interceptedClient) PrepareShutdown(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PrepareShutdownRequest) (resp *tabletmanagerdatapb.PrepareShutdownResponse, err error) {
+	err = client.intercept(ctx, "PrepareShutdown", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.PrepareShutdown(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// GracefulRestart is part of the TabletManagerClient interface.
+func (client *interceptedClient) GracefulRestart(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GracefulRestartRequest) (resp *tabletmanagerdatapb.GracefulRestartResponse, err error) {
+	err = client.intercept(ctx, "GracefulRestart", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.GracefulRestart(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// PauseTableGC is part of the TabletManagerClient interface.
+func (client *interceptedClient) PauseTableGC(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PauseTableGCRequest) (resp *tabletmanagerdatapb.PauseTableGCResponse, err error) {
+	err = client.intercept(ctx, "PauseTableGC", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.PauseTableGC(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// ResumeTableGC is part of the TabletManagerClient interface.
+func (client *interceptedClient) ResumeTableGC(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ResumeTableGCRequest) (resp *tabletmanagerdatapb.ResumeTableGCResponse, err error) {
+	err = client.intercept(ctx, "ResumeTableGC", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.ResumeTableGC(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// RestoreGCTable is part of the TabletManagerClient interface.
+func (client *interceptedClient) RestoreGCTable(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreGCTableRequest) (resp *tabletmanagerdatapb.RestoreGCTableResponse, err error) {
+	err = client.intercept(ctx, "RestoreGCTable", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.RestoreGCTable(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// GetMysqlVariables is part of the TabletManagerClient interface.
+func (client *interceptedClient) GetMysqlVariables(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GetMysqlVariablesRequest) (resp *tabletmanagerdatapb.GetMysqlVariablesResponse, err error) {
+	err = client.intercept(ctx, "GetMysqlVariables", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.GetMysqlVariables(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// SetMysqlVariable is part of the TabletManagerClient interface.
+func (client *interceptedClient) SetMysqlVariable(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.SetMysqlVariableRequest) (resp *tabletmanagerdatapb.SetMysqlVariableResponse, err error) {
+	err = client.intercept(ctx, "SetMysqlVariable", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.SetMysqlVariable(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// ReloadSchema is part of the TabletManagerClient interface.
+func (client *interceptedClient) ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error {
+	return client.intercept(ctx, "ReloadSchema", tablet, func(ctx context.Context) error {
+		return client.client.ReloadSchema(ctx, tablet, waitPosition)
+	})
+}
+
+// PreflightSchema is part of the TabletManagerClient interface.
+func (client *interceptedClient) PreflightSchema(ctx context.Context, tablet *topodatapb.Tablet, changes []string) (resp []*tabletmanagerdatapb.SchemaChangeResult, err error) {
+	err = client.intercept(ctx, "PreflightSchema", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.PreflightSchema(ctx, tablet, changes)
+		return err
+	})
+	return resp, err
+}
+
+// ApplySchema is part of the TabletManagerClient interface.
+func (client *interceptedClient) ApplySchema(ctx context.Context, tablet *topodatapb.Tablet, change *tmutils.SchemaChange) (resp *tabletmanagerdatapb.SchemaChangeResult, err error) {
+	err = client.intercept(ctx, "ApplySchema", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.ApplySchema(ctx, tablet, change)
+		return err
+	})
+	return resp, err
+}
+
+// LockTables is part of the TabletManagerClient interface.
+func (client *interceptedClient) LockTables(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return client.intercept(ctx, "LockTables", tablet, func(ctx context.Context) error {
+		return client.client.LockTables(ctx, tablet)
+	})
+}
+
+// UnlockTables is part of the TabletManagerClient interface.
+func (client *interceptedClient) UnlockTables(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return client.intercept(ctx, "UnlockTables", tablet, func(ctx context.Context) error {
+		return client.client.UnlockTables(ctx, tablet)
+	})
+}
+
+// AcquireConsistentSnapshot is part of the TabletManagerClient interface.
+func (client *interceptedClient) AcquireConsistentSnapshot(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.AcquireConsistentSnapshotRequest) (resp *tabletmanagerdatapb.AcquireConsistentSnapshotResponse, err error) {
+	err = client.intercept(ctx, "AcquireConsistentSnapshot", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.AcquireConsistentSnapshot(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// ReleaseConsistentSnapshot is part of the TabletManagerClient interface.
+func (client *interceptedClient) ReleaseConsistentSnapshot(ctx context.Context, tablet *topodatapb.Tablet, snapshotID int64) error {
+	return client.intercept(ctx, "ReleaseConsistentSnapshot", tablet, func(ctx context.Context) error {
+		return client.client.ReleaseConsistentSnapshot(ctx, tablet, snapshotID)
+	})
+}
+
+// ExecuteQuery is part of the TabletManagerClient interface.
+func (client *interceptedClient) ExecuteQuery(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ExecuteQueryRequest) (resp *querypb.QueryResult, err error) {
+	err = client.intercept(ctx, "ExecuteQuery", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.ExecuteQuery(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// ExecuteFetchAsDba is part of the TabletManagerClient interface.
+func (client *interceptedClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, req *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (resp *querypb.QueryResult, err error) {
+	err = client.intercept(ctx, "ExecuteFetchAsDba", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.ExecuteFetchAsDba(ctx, tablet, usePool, req)
+		return err
+	})
+	return resp, err
+}
+
+// ExecuteFetchAsDbaAsync is part of the TabletManagerClient interface.
+func (client *interceptedClient) ExecuteFetchAsDbaAsync(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (resp string, err error) {
+	err = client.intercept(ctx, "ExecuteFetchAsDbaAsync", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.ExecuteFetchAsDbaAsync(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// PollFetchJob is part of the TabletManagerClient interface.
+func (client *interceptedClient) PollFetchJob(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PollFetchJobRequest) (resp *tabletmanagerdatapb.PollFetchJobResponse, err error) {
+	err = client.intercept(ctx, "PollFetchJob", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.PollFetchJob(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// CancelFetchJob is part of the TabletManagerClient interface.
+func (client *interceptedClient) CancelFetchJob(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.CancelFetchJobRequest) (resp *tabletmanagerdatapb.CancelFetchJobResponse, err error) {
+	err = client.intercept(ctx, "CancelFetchJob", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.CancelFetchJob(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// StreamExecuteFetchAsDba is part of the TabletManagerClient interface.
+func (client *interceptedClient) StreamExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.StreamExecuteFetchAsDbaRequest) (resp QueryResultStream, err error) {
+	err = client.intercept(ctx, "StreamExecuteFetchAsDba", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.StreamExecuteFetchAsDba(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// ExecuteMultiFetchAsDba is part of the TabletManagerClient interface.
+func (client *interceptedClient) ExecuteMultiFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, req *tabletmanagerdatapb.ExecuteMultiFetchAsDbaRequest) (resp []*querypb.QueryResult, err error) {
+	err = client.intercept(ctx, "ExecuteMultiFetchAsDba", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.ExecuteMultiFetchAsDba(ctx, tablet, usePool, req)
+		return err
+	})
+	return resp, err
+}
+
+// ExecuteFetchAsAllPrivs is part of the TabletManagerClient interface.
+func (client *interceptedClient) ExecuteFetchAsAllPrivs(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ExecuteFetchAsAllPrivsRequest) (resp *querypb.QueryResult, err error) {
+	err = client.intercept(ctx, "ExecuteFetchAsAllPrivs", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.ExecuteFetchAsAllPrivs(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// ExecuteFetchAsApp is part of the TabletManagerClient interface.
+func (client *interceptedClient) ExecuteFetchAsApp(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, req *tabletmanagerdatapb.ExecuteFetchAsAppRequest) (resp *querypb.QueryResult, err error) {
+	err = client.intercept(ctx, "ExecuteFetchAsApp", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.ExecuteFetchAsApp(ctx, tablet, usePool, req)
+		return err
+	})
+	return resp, err
+}
+
+// PrimaryStatus is part of the TabletManagerClient interface.
+func (client *interceptedClient) PrimaryStatus(ctx context.Context, tablet *topodatapb.Tablet) (resp *replicationdatapb.PrimaryStatus, err error) {
+	err = client.intercept(ctx, "PrimaryStatus", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.PrimaryStatus(ctx, tablet)
+		return err
+	})
+	return resp, err
+}
+
+// ReplicationStatus is part of the TabletManagerClient interface.
+func (client *interceptedClient) ReplicationStatus(ctx context.Context, tablet *topodatapb.Tablet) (resp *replicationdatapb.Status, err error) {
+	err = client.intercept(ctx, "ReplicationStatus", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.ReplicationStatus(ctx, tablet)
+		return err
+	})
+	return resp, err
+}
+
+// FullStatus is part of the TabletManagerClient interface.
+func (client *interceptedClient) FullStatus(ctx context.Context, tablet *topodatapb.Tablet) (resp *replicationdatapb.FullStatus, err error) {
+	err = client.intercept(ctx, "FullStatus", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.FullStatus(ctx, tablet)
+		return err
+	})
+	return resp, err
+}
+
+// SemiSyncStatus is part of the TabletManagerClient interface.
+func (client *interceptedClient) SemiSyncStatus(ctx context.Context, tablet *topodatapb.Tablet) (resp *tabletmanagerdatapb.SemiSyncStatusResponse, err error) {
+	err = client.intercept(ctx, "SemiSyncStatus", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.SemiSyncStatus(ctx, tablet)
+		return err
+	})
+	return resp, err
+}
+
+// StopReplication is part of the TabletManagerClient interface.
+func (client *interceptedClient) StopReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return client.intercept(ctx, "StopReplication", tablet, func(ctx context.Context) error {
+		return client.client.StopReplication(ctx, tablet)
+	})
+}
+
+// StopReplicationMinimum is part of the TabletManagerClient interface.
+func (client *interceptedClient) StopReplicationMinimum(ctx context.Context, tablet *topodatapb.Tablet, stopPos string, waitTime time.Duration) (resp string, err error) {
+	err = client.intercept(ctx, "StopReplicationMinimum", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.StopReplicationMinimum(ctx, tablet, stopPos, waitTime)
+		return err
+	})
+	return resp, err
+}
+
+// StartReplication is part of the TabletManagerClient interface.
+func (client *interceptedClient) StartReplication(ctx context.Context, tablet *topodatapb.Tablet, semiSync bool) error {
+	return client.intercept(ctx, "StartReplication", tablet, func(ctx context.Context) error {
+		return client.client.StartReplication(ctx, tablet, semiSync)
+	})
+}
+
+// StartReplicationUntilAfter is part of the TabletManagerClient interface.
+func (client *interceptedClient) StartReplicationUntilAfter(ctx context.Context, tablet *topodatapb.Tablet, position string, duration time.Duration) error {
+	return client.intercept(ctx, "StartReplicationUntilAfter", tablet, func(ctx context.Context) error {
+		return client.client.StartReplicationUntilAfter(ctx, tablet, position, duration)
+	})
+}
+
+// GetReplicas is part of the TabletManagerClient interface.
+func (client *interceptedClient) GetReplicas(ctx context.Context, tablet *topodatapb.Tablet) (resp []string, err error) {
+	err = client.intercept(ctx, "GetReplicas", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.GetReplicas(ctx, tablet)
+		return err
+	})
+	return resp, err
+}
+
+// FlushBinaryLogs is part of the TabletManagerClient interface.
+func (client *interceptedClient) FlushBinaryLogs(ctx context.Context, tablet *topodatapb.Tablet) (resp *tabletmanagerdatapb.FlushBinaryLogsResponse, err error) {
+	err = client.intercept(ctx, "FlushBinaryLogs", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.FlushBinaryLogs(ctx, tablet)
+		return err
+	})
+	return resp, err
+}
+
+// PurgeBinaryLogs is part of the TabletManagerClient interface.
+func (client *interceptedClient) PurgeBinaryLogs(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.PurgeBinaryLogsRequest) (resp *tabletmanagerdatapb.PurgeBinaryLogsResponse, err error) {
+	err = client.intercept(ctx, "PurgeBinaryLogs", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.PurgeBinaryLogs(ctx, tablet, request)
+		return err
+	})
+	return resp, err
+}
+
+// PrimaryPosition is part of the TabletManagerClient interface.
+func (client *interceptedClient) PrimaryPosition(ctx context.Context, tablet *topodatapb.Tablet) (resp string, err error) {
+	err = client.intercept(ctx, "PrimaryPosition", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.PrimaryPosition(ctx, tablet)
+		return err
+	})
+	return resp, err
+}
+
+// WaitForPosition is part of the TabletManagerClient interface.
+func (client *interceptedClient) WaitForPosition(ctx context.Context, tablet *topodatapb.Tablet, pos string) error {
+	return client.intercept(ctx, "WaitForPosition", tablet, func(ctx context.Context) error {
+		return client.client.WaitForPosition(ctx, tablet, pos)
+	})
+}
+
+// StreamWaitForPosition is part of the TabletManagerClient interface.
+func (client *interceptedClient) StreamWaitForPosition(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.StreamWaitForPositionRequest) (resp WaitForPositionStream, err error) {
+	err = client.intercept(ctx, "StreamWaitForPosition", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.StreamWaitForPosition(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// CreateVReplicationWorkflow is part of the TabletManagerClient interface.
+func (client *interceptedClient) CreateVReplicationWorkflow(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.CreateVReplicationWorkflowRequest) (resp *tabletmanagerdatapb.CreateVReplicationWorkflowResponse, err error) {
+	err = client.intercept(ctx, "CreateVReplicationWorkflow", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.CreateVReplicationWorkflow(ctx, tablet, request)
+		return err
+	})
+	return resp, err
+}
+
+// DeleteVReplicationWorkflow is part of the TabletManagerClient interface.
+func (client *interceptedClient) DeleteVReplicationWorkflow(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.DeleteVReplicationWorkflowRequest) (resp *tabletmanagerdatapb.DeleteVReplicationWorkflowResponse, err error) {
+	err = client.intercept(ctx, "DeleteVReplicationWorkflow", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.DeleteVReplicationWorkflow(ctx, tablet, request)
+		return err
+	})
+	return resp, err
+}
+
+// HasVReplicationWorkflows is part of the TabletManagerClient interface.
+func (client *interceptedClient) HasVReplicationWorkflows(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.HasVReplicationWorkflowsRequest) (resp *tabletmanagerdatapb.HasVReplicationWorkflowsResponse, err error) {
+	err = client.intercept(ctx, "HasVReplicationWorkflows", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.HasVReplicationWorkflows(ctx, tablet, request)
+		return err
+	})
+	return resp, err
+}
+
+// ReadVReplicationWorkflows is part of the TabletManagerClient interface.
+func (client *interceptedClient) ReadVReplicationWorkflows(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.ReadVReplicationWorkflowsRequest) (resp *tabletmanagerdatapb.ReadVReplicationWorkflowsResponse, err error) {
+	err = client.intercept(ctx, "ReadVReplicationWorkflows", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.ReadVReplicationWorkflows(ctx, tablet, request)
+		return err
+	})
+	return resp, err
+}
+
+// ReadVReplicationWorkflow is part of the TabletManagerClient interface.
+func (client *interceptedClient) ReadVReplicationWorkflow(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.ReadVReplicationWorkflowRequest) (resp *tabletmanagerdatapb.ReadVReplicationWorkflowResponse, err error) {
+	err = client.intercept(ctx, "ReadVReplicationWorkflow", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.ReadVReplicationWorkflow(ctx, tablet, request)
+		return err
+	})
+	return resp, err
+}
+
+// UpdateVReplicationWorkflow is part of the TabletManagerClient interface.
+func (client *interceptedClient) UpdateVReplicationWorkflow(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.UpdateVReplicationWorkflowRequest) (resp *tabletmanagerdatapb.UpdateVReplicationWorkflowResponse, err error) {
+	err = client.intercept(ctx, "UpdateVReplicationWorkflow", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.UpdateVReplicationWorkflow(ctx, tablet, request)
+		return err
+	})
+	return resp, err
+}
+
+// UpdateVReplicationWorkflows is part of the TabletManagerClient interface.
+func (client *interceptedClient) UpdateVReplicationWorkflows(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.UpdateVReplicationWorkflowsRequest) (resp *tabletmanagerdatapb.UpdateVReplicationWorkflowsResponse, err error) {
+	err = client.intercept(ctx, "UpdateVReplicationWorkflows", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.UpdateVReplicationWorkflows(ctx, tablet, request)
+		return err
+	})
+	return resp, err
+}
+
+// VReplicationExec is part of the TabletManagerClient interface.
+func (client *interceptedClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (resp *querypb.QueryResult, err error) {
+	err = client.intercept(ctx, "VReplicationExec", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.VReplicationExec(ctx, tablet, query)
+		return err
+	})
+	return resp, err
+}
+
+// VReplicationWaitForPos is part of the TabletManagerClient interface.
+func (client *interceptedClient) VReplicationWaitForPos(ctx context.Context, tablet *topodatapb.Tablet, id int32, pos string) error {
+	return client.intercept(ctx, "VReplicationWaitForPos", tablet, func(ctx context.Context) error {
+		return client.client.VReplicationWaitForPos(ctx, tablet, id, pos)
+	})
+}
+
+// VDiff is part of the TabletManagerClient interface.
+func (client *interceptedClient) VDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.VDiffRequest) (resp *tabletmanagerdatapb.VDiffResponse, err error) {
+	err = client.intercept(ctx, "VDiff", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.VDiff(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// CreateVDiff is part of the TabletManagerClient interface.
+func (client *interceptedClient) CreateVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.CreateVDiffRequest) (resp *tabletmanagerdatapb.CreateVDiffResponse, err error) {
+	err = client.intercept(ctx, "CreateVDiff", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.CreateVDiff(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// ShowVDiff is part of the TabletManagerClient interface.
+func (client *interceptedClient) ShowVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ShowVDiffRequest) (resp *tabletmanagerdatapb.ShowVDiffResponse, err error) {
+	err = client.intercept(ctx, "ShowVDiff", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.ShowVDiff(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// StopVDiff is part of the TabletManagerClient interface.
+func (client *interceptedClient) StopVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.StopVDiffRequest) (resp *tabletmanagerdatapb.StopVDiffResponse, err error) {
+	err = client.intercept(ctx, "StopVDiff", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.StopVDiff(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// ResetReplication is part of the TabletManagerClient interface.
+func (client *interceptedClient) ResetReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return client.intercept(ctx, "ResetReplication", tablet, func(ctx context.Context) error {
+		return client.client.ResetReplication(ctx, tablet)
+	})
+}
+
+// InitPrimary is part of the TabletManagerClient interface.
+func (client *interceptedClient) InitPrimary(ctx context.Context, tablet *topodatapb.Tablet, semiSync bool) (resp string, err error) {
+	err = client.intercept(ctx, "InitPrimary", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.InitPrimary(ctx, tablet, semiSync)
+		return err
+	})
+	return resp, err
+}
+
+// PopulateReparentJournal is part of the TabletManagerClient interface.
+func (client *interceptedClient) PopulateReparentJournal(ctx context.Context, tablet *topodatapb.Tablet, timeCreatedNS int64, actionName string, tabletAlias *topodatapb.TabletAlias, pos string) error {
+	return client.intercept(ctx, "PopulateReparentJournal", tablet, func(ctx context.Context) error {
+		return client.client.PopulateReparentJournal(ctx, tablet, timeCreatedNS, actionName, tabletAlias, pos)
+	})
+}
+
+// InitReplica is part of the TabletManagerClient interface.
+func (client *interceptedClient) InitReplica(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, replicationPosition string, timeCreatedNS int64, semiSync bool) error {
+	return client.intercept(ctx, "InitReplica", tablet, func(ctx context.Context) error {
+		return client.client.InitReplica(ctx, tablet, parent, replicationPosition, timeCreatedNS, semiSync)
+	})
+}
+
+// DemotePrimary is part of the TabletManagerClient interface.
+func (client *interceptedClient) DemotePrimary(ctx context.Context, tablet *topodatapb.Tablet, handoff *tabletmanagerdatapb.PrimaryHandoff) (resp *replicationdatapb.PrimaryStatus, err error) {
+	err = client.intercept(ctx, "DemotePrimary", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.DemotePrimary(ctx, tablet, handoff)
+		return err
+	})
+	return resp, err
+}
+
+// UndoDemotePrimary is part of the TabletManagerClient interface.
+func (client *interceptedClient) UndoDemotePrimary(ctx context.Context, tablet *topodatapb.Tablet, semiSync bool) error {
+	return client.intercept(ctx, "UndoDemotePrimary", tablet, func(ctx context.Context) error {
+		return client.client.UndoDemotePrimary(ctx, tablet, semiSync)
+	})
+}
+
+// ReplicaWasPromoted is part of the TabletManagerClient interface.
+func (client *interceptedClient) ReplicaWasPromoted(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return client.intercept(ctx, "ReplicaWasPromoted", tablet, func(ctx context.Context) error {
+		return client.client.ReplicaWasPromoted(ctx, tablet)
+	})
+}
+
+// ResetReplicationParameters is part of the TabletManagerClient interface.
+func (client *interceptedClient) ResetReplicationParameters(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return client.intercept(ctx, "ResetReplicationParameters", tablet, func(ctx context.Context) error {
+		return client.client.ResetReplicationParameters(ctx, tablet)
+	})
+}
+
+// SetReplicationSource is part of the TabletManagerClient interface.
+func (client *interceptedClient) SetReplicationSource(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, timeCreatedNS int64, waitPosition string, forceStartReplication bool, semiSync bool, heartbeatInterval float64) error {
+	return client.intercept(ctx, "SetReplicationSource", tablet, func(ctx context.Context) error {
+		return client.client.SetReplicationSource(ctx, tablet, parent, timeCreatedNS, waitPosition, forceStartReplication, semiSync, heartbeatInterval)
+	})
+}
+
+// ReplicaWasRestarted is part of the TabletManagerClient interface.
+func (client *interceptedClient) ReplicaWasRestarted(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias) error {
+	return client.intercept(ctx, "ReplicaWasRestarted", tablet, func(ctx context.Context) error {
+		return client.client.ReplicaWasRestarted(ctx, tablet, parent)
+	})
+}
+
+// StopReplicationAndGetStatus is part of the TabletManagerClient interface.
+func (client *interceptedClient) StopReplicationAndGetStatus(ctx context.Context, tablet *topodatapb.Tablet, stopReplicationMode replicationdatapb.StopReplicationMode) (resp *replicationdatapb.StopReplicationStatus, err error) {
+	err = client.intercept(ctx, "StopReplicationAndGetStatus", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.StopReplicationAndGetStatus(ctx, tablet, stopReplicationMode)
+		return err
+	})
+	return resp, err
+}
+
+// PromoteReplica is part of the TabletManagerClient interface.
+func (client *interceptedClient) PromoteReplica(ctx context.Context, tablet *topodatapb.Tablet, semiSync bool, handoff *tabletmanagerdatapb.PrimaryHandoff) (resp string, err error) {
+	err = client.intercept(ctx, "PromoteReplica", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.PromoteReplica(ctx, tablet, semiSync, handoff)
+		return err
+	})
+	return resp, err
+}
+
+// Backup is part of the TabletManagerClient interface.
+func (client *interceptedClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.BackupRequest) (resp logutil.EventStream, err error) {
+	err = client.intercept(ctx, "Backup", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.Backup(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// RestoreFromBackup is part of the TabletManagerClient interface.
+func (client *interceptedClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreFromBackupRequest) (resp logutil.EventStream, err error) {
+	err = client.intercept(ctx, "RestoreFromBackup", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.RestoreFromBackup(ctx, tablet, req)
+		return err
+	})
+	return resp, err
+}
+
+// CheckThrottler is part of the TabletManagerClient interface.
+func (client *interceptedClient) CheckThrottler(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.CheckThrottlerRequest) (resp *tabletmanagerdatapb.CheckThrottlerResponse, err error) {
+	err = client.intercept(ctx, "CheckThrottler", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.CheckThrottler(ctx, tablet, request)
+		return err
+	})
+	return resp, err
+}
+
+// GetThrottlerStatus is part of the TabletManagerClient interface.
+func (client *interceptedClient) GetThrottlerStatus(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetThrottlerStatusRequest) (resp *tabletmanagerdatapb.GetThrottlerStatusResponse, err error) {
+	err = client.intercept(ctx, "GetThrottlerStatus", tablet, func(ctx context.Context) (err error) {
+		resp, err = client.client.GetThrottlerStatus(ctx, tablet, request)
+		return err
+	})
+	return resp, err
+}
+
+// Close is part of the TabletManagerClient interface.
+func (client *interceptedClient) Close() {
+	client.client.Close()
+}
+
+// CloseWithTimeout is part of the GracefulCloser interface. The intercepted
+// client is closed right away if it isn't a GracefulCloser.
+func (client *interceptedClient) CloseWithTimeout(ctx context.Context, timeout time.Duration) error {
+	if closer, ok := client.client.(GracefulCloser); ok {
+		return closer.CloseWithTimeout(ctx, timeout)
+	}
+	client.client.Close()
+	return nil
+}
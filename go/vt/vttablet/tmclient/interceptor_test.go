@@ -0,0 +1,186 @@
+/*
+Copyright 2024 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tmclient
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+type ctxKey struct{}
+
+// interceptorTestClient only implements the RPCs used by the tests.
+type interceptorTestClient struct {
+	TabletManagerClient
+	calls  *[]string
+	closed bool
+}
+
+func (client *interceptorTestClient) PrimaryPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	*client.calls = append(*client.calls, "PrimaryPosition "+ctx.Value(ctxKey{}).(string))
+	return "MySQL56/00000000-0000-0000-0000-000000000001:1-10", nil
+}
+
+func (client *interceptorTestClient) Close() {
+	client.closed = true
+}
+
+func TestWithInterceptors(t *testing.T) {
+	ctx := context.Background()
+	tablet := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}}
+
+	var calls []string
+	record := func(name string) Interceptor {
+		return func(ctx context.Context, method string, tablet *topodatapb.Tablet, invoker Invoker) error {
+			calls = append(calls, name+" before "+method+" "+topoproto.TabletAliasString(tablet.Alias))
+			err := invoker(ctx)
+			calls = append(calls, name+" after "+method)
+			return err
+		}
+	}
+	withValue := func(ctx context.Context, method string, tablet *topodatapb.Tablet, invoker Invoker) error {
+		return invoker(context.WithValue(ctx, ctxKey{}, "value"))
+	}
+
+	fake := &interceptorTestClient{calls: &calls}
+	assert.Same(t, fake, WithInterceptors(fake))
+
+	client := WithInterceptors(fake, record("outer"), withValue, record("inner"))
+	pos, err := client.PrimaryPosition(ctx, tablet)
+	require.NoError(t, err)
+	assert.Equal(t, "MySQL56/00000000-0000-0000-0000-000000000001:1-10", pos)
+	assert.Equal(t, []string{
+		"outer before PrimaryPosition zone1-0000000100",
+		"inner before PrimaryPosition zone1-0000000100",
+		"PrimaryPosition value",
+		"inner after PrimaryPosition",
+		"outer after PrimaryPosition",
+	}, calls)
+
+	// An interceptor can fail the RPC without making it.
+	calls = nil
+	errDenied := errors.New("denied")
+	client = WithInterceptors(fake, func(ctx context.Context, method string, tablet *topodatapb.Tablet, invoker Invoker) error {
+		return errDenied
+	}, record("inner"))
+	_, err = client.PrimaryPosition(ctx, tablet)
+	assert.ErrorIs(t, err, errDenied)
+	assert.Empty(t, calls)
+
+	client.Close()
+	assert.True(t, fake.closed)
+}
+
+// gracefulTestClient is a TabletManagerClient with CloseWithTimeout.
+type gracefulTestClient struct {
+	interceptorTestClient
+	timeout time.Duration
+}
+
+func (client *gracefulTestClient) CloseWithTimeout(ctx context.Context, timeout time.Duration) error {
+	client.timeout = timeout
+	return nil
+}
+
+func TestInterceptedClientConcreteMethods(t *testing.T) {
+	ctx := context.Background()
+	noop := func(ctx context.Context, method string, tablet *topodatapb.Tablet, invoker Invoker) error {
+		return invoker(ctx)
+	}
+
+	graceful := &gracefulTestClient{}
+	client := WithInterceptors(graceful, noop)
+	assert.Same(t, graceful, Unwrap(client))
+	assert.Same(t, graceful, Unwrap(graceful))
+
+	closer, ok := client.(GracefulCloser)
+	require.True(t, ok)
+	require.NoError(t, closer.CloseWithTimeout(ctx, time.Minute))
+	assert.Equal(t, time.Minute, graceful.timeout)
+	assert.False(t, graceful.closed)
+
+	// The clients without CloseWithTimeout are closed right away.
+	fake := &interceptorTestClient{}
+	client = WithInterceptors(fake, noop)
+	require.NoError(t, client.(GracefulCloser).CloseWithTimeout(ctx, time.Minute))
+	assert.True(t, fake.closed)
+}
+
+func TestInterceptedClientMethods(t *testing.T) {
+	// Each RPC is intercepted with the name of its method.
+	var method string
+	errIntercepted := errors.New("intercepted")
+	client := WithInterceptors(nil, func(ctx context.Context, m string, tablet *topodatapb.Tablet, invoker Invoker) error {
+		method = m
+		return errIntercepted
+	})
+
+	value := reflect.ValueOf(client)
+	typ := reflect.TypeOf((*TabletManagerClient)(nil)).Elem()
+	for i := 0; i < typ.NumMethod(); i++ {
+		name := typ.Method(i).Name
+		if name == "Close" {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			fn := value.MethodByName(name)
+			args := []reflect.Value{reflect.ValueOf(context.Background()), reflect.ValueOf(&topodatapb.Tablet{})}
+			for j := len(args); j < fn.Type().NumIn(); j++ {
+				args = append(args, reflect.Zero(fn.Type().In(j)))
+			}
+
+			method = ""
+			out := fn.Call(args)
+			assert.Equal(t, name, method)
+			err, _ := out[len(out)-1].Interface().(error)
+			assert.ErrorIs(t, err, errIntercepted)
+		})
+	}
+}
+
+func TestRegisterInterceptor(t *testing.T) {
+	defer func(protocol string, registered []Interceptor) {
+		tabletManagerProtocol = protocol
+		interceptors = registered
+		delete(tabletManagerClientFactories, "interceptor-test")
+	}(tabletManagerProtocol, interceptors)
+
+	var calls []string
+	RegisterTabletManagerClientFactory("interceptor-test", func() TabletManagerClient {
+		return &interceptorTestClient{calls: &calls}
+	})
+	tabletManagerProtocol = "interceptor-test"
+	RegisterInterceptor(func(ctx context.Context, method string, tablet *topodatapb.Tablet, invoker Invoker) error {
+		calls = append(calls, "interceptor "+method)
+		return invoker(context.WithValue(ctx, ctxKey{}, "value"))
+	})
+
+	client := NewTabletManagerClient()
+	_, err := client.PrimaryPosition(context.Background(), &topodatapb.Tablet{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"interceptor PrimaryPosition", "PrimaryPosition value"}, calls)
+}
@@ -42,6 +42,8 @@ var tabletManagerProtocol = "grpc"
 // exported for tests that need to inject a particular TabletManagerProtocol.
 func RegisterFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&tabletManagerProtocol, "tablet_manager_protocol", tabletManagerProtocol, "Protocol to use to make tabletmanager RPCs to vttablets.")
+	fs.BoolVar(&allowDirectDial, "tablet_manager_allow_direct_dial", allowDirectDial, "allow the break-glass tooling to send tablet manager RPCs to an explicit tablet address, bypassing the topo, e.g. to reparent a shard during a topo outage (each use is logged)")
+	fs.DurationVar(&failFastWindow, "tablet_manager_fail_fast_window", failFastWindow, "how long a tablet reported down (e.g. by the healthcheck) is considered down by the tablet manager RPCs that fail fast, like the ones of EmergencyReparentShard with fail-fast enabled")
 }
 
 func init() {
@@ -71,6 +73,14 @@ type TabletManagerClient interface {
 	// GetSchema asks the remote tablet for its database schema
 	GetSchema(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetSchemaRequest) (*tabletmanagerdatapb.SchemaDefinition, error)
 
+	// GetSchemaHash asks the remote tablet for the hash of its database
+	// schema, as computed by tmutils.SchemaHash
+	GetSchemaHash(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetSchemaHashRequest) (string, error)
+
+	// StreamSchemaChanges streams the changes to the schema of the remote
+	// tablet as they happen. See SchemaChangeStream.
+	StreamSchemaChanges(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.StreamSchemaChangesRequest) (SchemaChangeStream, error)
+
 	// GetPermissions asks the remote tablet for its permissions list
 	GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error)
 
@@ -78,6 +88,10 @@ type TabletManagerClient interface {
 	// An empty/nil variable name parameter slice means you want all of them.
 	GetGlobalStatusVars(ctx context.Context, tablet *topodatapb.Tablet, variables []string) (map[string]string, error)
 
+	// StreamTabletEvents streams the state transitions of the remote tablet
+	// as they happen. See TabletEventStream.
+	StreamTabletEvents(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.StreamTabletEventsRequest) (TabletEventStream, error)
+
 	//
 	// Various read-write methods
 	//
@@ -100,12 +114,46 @@ type TabletManagerClient interface {
 	// ExecuteHook executes the provided hook remotely
 	ExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (*hook.HookResult, error)
 
+	// StreamExecuteHook executes the provided hook remotely, and streams
+	// its output while it runs. See HookStream.
+	StreamExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (HookStream, error)
+
 	// RefreshState asks the remote tablet to reload its tablet record
 	RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error
 
 	// RunHealthCheck asks the remote tablet to run a health check cycle
 	RunHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) error
 
+	// PrepareShutdown asks the remote tablet to stop serving new queries and
+	// to drain the in-flight ones, and reports whether it is ready to be
+	// restarted.
+	PrepareShutdown(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PrepareShutdownRequest) (*tabletmanagerdatapb.PrepareShutdownResponse, error)
+
+	// GracefulRestart asks the remote tablet to restart mysqld, optionally
+	// with a regenerated configuration, and to restore replication and the
+	// query service once mysqld is back up.
+	GracefulRestart(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GracefulRestartRequest) (*tabletmanagerdatapb.GracefulRestartResponse, error)
+
+	// PauseTableGC asks the remote tablet to pause its table garbage collector
+	// on behalf of a requester, until the pause expires or is released.
+	PauseTableGC(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PauseTableGCRequest) (*tabletmanagerdatapb.PauseTableGCResponse, error)
+
+	// ResumeTableGC asks the remote tablet to release the pause of a requester
+	// on its table garbage collector.
+	ResumeTableGC(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ResumeTableGCRequest) (*tabletmanagerdatapb.ResumeTableGCResponse, error)
+
+	// RestoreGCTable asks the remote tablet to rename a table held by its table
+	// garbage collector back to an ordinary name.
+	RestoreGCTable(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreGCTableRequest) (*tabletmanagerdatapb.RestoreGCTableResponse, error)
+
+	// GetMysqlVariables returns the current values of global variables of mysqld
+	// on the remote tablet, along with their values in its my.cnf.
+	GetMysqlVariables(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GetMysqlVariablesRequest) (*tabletmanagerdatapb.GetMysqlVariablesResponse, error)
+
+	// SetMysqlVariable sets a dynamic global variable of mysqld on the remote
+	// tablet. Only the variables allowed by the tablet can be set.
+	SetMysqlVariable(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.SetMysqlVariableRequest) (*tabletmanagerdatapb.SetMysqlVariableResponse, error)
+
 	// ReloadSchema asks the remote tablet to reload its schema
 	ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error
 
@@ -119,6 +167,16 @@ type TabletManagerClient interface {
 
 	UnlockTables(ctx context.Context, tablet *topodatapb.Tablet) error
 
+	// AcquireConsistentSnapshot locks the tables of the tablet, and returns the
+	// ID and GTID position of the snapshot. The tables stay locked, so that the
+	// caller can start its own transactions WITH CONSISTENT SNAPSHOT at that
+	// position, until it's released with ReleaseConsistentSnapshot, or until its
+	// lease expires.
+	AcquireConsistentSnapshot(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.AcquireConsistentSnapshotRequest) (*tabletmanagerdatapb.AcquireConsistentSnapshotResponse, error)
+
+	// ReleaseConsistentSnapshot releases a snapshot acquired with AcquireConsistentSnapshot.
+	ReleaseConsistentSnapshot(ctx context.Context, tablet *topodatapb.Tablet, snapshotID int64) error
+
 	// ExecuteQuery executes a query remotely on the tablet.
 	// req.DbName is ignored in favor of using the tablet's DbName field, and,
 	// if req.CallerId is nil, the effective callerid will be extracted from
@@ -131,6 +189,30 @@ type TabletManagerClient interface {
 	// query faster. Close() should close the pool in that case.
 	ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, req *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (*querypb.QueryResult, error)
 
+	// ExecuteFetchAsDbaAsync starts executing a query remotely using the DBA
+	// pool, like ExecuteFetchAsDba, and returns the ID of its job on the tablet
+	// without waiting for the query to complete. The job runs until the query
+	// completes or CancelFetchJob is called, and PollFetchJob returns its state.
+	// It fails with FAILED_PRECONDITION for the tablets below AsyncFetchAPILevel.
+	// req.DbName is ignored in favor of using the tablet's DbName field.
+	ExecuteFetchAsDbaAsync(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (string, error)
+
+	// PollFetchJob returns the state of a job started by ExecuteFetchAsDbaAsync,
+	// and its result once it's complete. The tablet forgets the jobs an hour
+	// after they finish, or sooner once it has too many finished jobs.
+	PollFetchJob(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.PollFetchJobRequest) (*tabletmanagerdatapb.PollFetchJobResponse, error)
+
+	// CancelFetchJob stops a job started by ExecuteFetchAsDbaAsync, killing its
+	// query.
+	CancelFetchJob(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.CancelFetchJobRequest) (*tabletmanagerdatapb.CancelFetchJobResponse, error)
+
+	// StreamExecuteFetchAsDba executes a single query remotely using a DBA
+	// connection, and streams its result as it is read from MySQL, instead of
+	// buffering it like ExecuteFetchAsDba. The query is killed if ctx is done
+	// before the stream is fully read.
+	// req.DbName is ignored in favor of using the tablet's DbName field.
+	StreamExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.StreamExecuteFetchAsDbaRequest) (QueryResultStream, error)
+
 	// ExecuteFetchAsDba executes multiple queries remotely using the DBA pool.
 	// req.DbName is ignored in favor of using the tablet's DbName field.
 	// If usePool is set, a connection pool may be used to make the
@@ -159,6 +241,9 @@ type TabletManagerClient interface {
 	// FullStatus returns the tablet's mysql replication status.
 	FullStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.FullStatus, error)
 
+	// SemiSyncStatus returns the state of the semi-sync plugin of the tablet.
+	SemiSyncStatus(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.SemiSyncStatusResponse, error)
+
 	// StopReplication stops the mysql replication
 	StopReplication(ctx context.Context, tablet *topodatapb.Tablet) error
 
@@ -175,12 +260,23 @@ type TabletManagerClient interface {
 	// GetReplicas returns the addresses of the replicas
 	GetReplicas(ctx context.Context, tablet *topodatapb.Tablet) ([]string, error)
 
+	// FlushBinaryLogs closes the current binary log and opens a new one
+	FlushBinaryLogs(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.FlushBinaryLogsResponse, error)
+
+	// PurgeBinaryLogs deletes the old binary logs that are no longer needed
+	PurgeBinaryLogs(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.PurgeBinaryLogsRequest) (*tabletmanagerdatapb.PurgeBinaryLogsResponse, error)
+
 	// PrimaryPosition returns the tablet's primary position
 	PrimaryPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error)
 
 	// WaitForPosition waits for the position to be reached
 	WaitForPosition(ctx context.Context, tablet *topodatapb.Tablet, pos string) error
 
+	// StreamWaitForPosition waits for the position to be reached, like
+	// WaitForPosition, and streams the position and replication status of
+	// the remote tablet every req.ProgressInterval meanwhile.
+	StreamWaitForPosition(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.StreamWaitForPositionRequest) (WaitForPositionStream, error)
+
 	//
 	// VReplication related methods
 	//
@@ -196,6 +292,13 @@ type TabletManagerClient interface {
 	VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, tablet *topodatapb.Tablet, id int32, pos string) error
 	VDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error)
+	// CreateVDiff creates a vdiff of a workflow on the remote tablet and starts it.
+	CreateVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.CreateVDiffRequest) (*tabletmanagerdatapb.CreateVDiffResponse, error)
+	// ShowVDiff returns the state of a vdiff of a workflow on the remote tablet,
+	// and the progress of each of its tables.
+	ShowVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.ShowVDiffRequest) (*tabletmanagerdatapb.ShowVDiffResponse, error)
+	// StopVDiff stops a running vdiff of a workflow on the remote tablet.
+	StopVDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.StopVDiffRequest) (*tabletmanagerdatapb.StopVDiffResponse, error)
 
 	//
 	// Reparenting related functions
@@ -221,8 +324,10 @@ type TabletManagerClient interface {
 	InitReplica(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, replicationPosition string, timeCreatedNS int64, semiSync bool) error
 
 	// DemotePrimary tells the soon-to-be-former primary it's going to change,
-	// and it should go read-only and return its current position.
-	DemotePrimary(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.PrimaryStatus, error)
+	// and it should go read-only and return its current position. The handoff,
+	// if not nil, describes the change of primary to the query service of the
+	// tablet.
+	DemotePrimary(ctx context.Context, tablet *topodatapb.Tablet, handoff *tabletmanagerdatapb.PrimaryHandoff) (*replicationdatapb.PrimaryStatus, error)
 
 	// UndoDemotePrimary reverts all changes made by DemotePrimary
 	// To be used if we are unable to promote the chosen new primary
@@ -246,8 +351,9 @@ type TabletManagerClient interface {
 	// current position.
 	StopReplicationAndGetStatus(ctx context.Context, tablet *topodatapb.Tablet, stopReplicationMode replicationdatapb.StopReplicationMode) (*replicationdatapb.StopReplicationStatus, error)
 
-	// PromoteReplica makes the tablet the new primary
-	PromoteReplica(ctx context.Context, tablet *topodatapb.Tablet, semiSync bool) (string, error)
+	// PromoteReplica makes the tablet the new primary. The handoff, if not
+	// nil, describes the change of primary to the query service of the tablet.
+	PromoteReplica(ctx context.Context, tablet *topodatapb.Tablet, semiSync bool, handoff *tabletmanagerdatapb.PrimaryHandoff) (string, error)
 
 	//
 	// Backup / restore related methods
@@ -272,6 +378,54 @@ type TabletManagerClient interface {
 	Close()
 }
 
+// HookStream is the output of a hook executed by StreamExecuteHook.
+type HookStream interface {
+	// Recv returns the output of the hook since the previous call. The
+	// last response has Finished and the exit status of the hook set,
+	// and is followed by io.EOF.
+	Recv() (*tabletmanagerdatapb.StreamExecuteHookResponse, error)
+}
+
+// SchemaChangeStream is the stream of the schema changes of a tablet
+// returned by StreamSchemaChanges.
+type SchemaChangeStream interface {
+	// Recv returns the schema changes detected since the previous call.
+	// It blocks until there are some, and the stream is only closed
+	// when its context is done.
+	Recv() (*tabletmanagerdatapb.StreamSchemaChangesResponse, error)
+}
+
+// TabletEventStream is the stream of the state transitions of a tablet
+// returned by StreamTabletEvents.
+type TabletEventStream interface {
+	// Recv returns the next response of the stream. The first one tells
+	// whether the client must resync its view of the tablet, and the
+	// following ones each have an event, or ask to resync again if the
+	// client fell too far behind. It blocks until there is one, and the
+	// stream is only closed when its context is done. The stream can be
+	// resumed after the last received event with its StreamId and Sequence.
+	Recv() (*tabletmanagerdatapb.StreamTabletEventsResponse, error)
+}
+
+// QueryResultStream is the result of a query returned by
+// StreamExecuteFetchAsDba, in packets.
+type QueryResultStream interface {
+	// Recv returns the next packet of the result. It blocks until there is
+	// one. The first packet only has the fields, and the next ones have the
+	// rows. Recv returns io.EOF once the whole result was received.
+	Recv() (*querypb.QueryResult, error)
+}
+
+// WaitForPositionStream is the progress of the replication of a tablet
+// returned by StreamWaitForPosition.
+type WaitForPositionStream interface {
+	// Recv returns the next progress of the tablet. It blocks until there is
+	// one. The last response of the stream has Reached set, and Recv then
+	// returns io.EOF. An error is returned if the position can't be reached
+	// before the context is done.
+	Recv() (*tabletmanagerdatapb.StreamWaitForPositionResponse, error)
+}
+
 // TabletManagerClientFactory is the factory method to create
 // TabletManagerClient objects.
 type TabletManagerClientFactory func() TabletManagerClient
@@ -287,7 +441,8 @@ func RegisterTabletManagerClientFactory(name string, factory TabletManagerClient
 	tabletManagerClientFactories[name] = factory
 }
 
-// NewTabletManagerClient creates a new TabletManagerClient. Should be
+// NewTabletManagerClient creates a new TabletManagerClient, whose RPCs go
+// through the interceptors registered with RegisterInterceptor. Should be
 // called after flags are parsed.
 func NewTabletManagerClient() TabletManagerClient {
 	f, ok := tabletManagerClientFactories[tabletManagerProtocol]
@@ -295,5 +450,5 @@ func NewTabletManagerClient() TabletManagerClient {
 		log.Exitf("No TabletManagerProtocol registered with name %s", tabletManagerProtocol)
 	}
 
-	return f()
+	return WithInterceptors(f(), interceptors...)
 }
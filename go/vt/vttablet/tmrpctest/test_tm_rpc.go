@@ -42,6 +42,7 @@ import (
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vttimepb "vitess.io/vitess/go/vt/proto/vttime"
 )
 
 // fakeRPCTM implements tabletmanager.RPCTM and fills in all
@@ -340,6 +341,69 @@ func tmRPCTestGetSchemaPanic(ctx context.Context, t *testing.T, client tmclient.
 	expectHandleRPCPanic(t, "GetSchema", false /*verbose*/, err)
 }
 
+var testGetSchemaHashReq = &tabletmanagerdatapb.GetSchemaHashRequest{Tables: testGetSchemaTables, ExcludeTables: testGetSchemaExcludeTables, IncludeViews: true}
+var testGetSchemaHashReply = "3f0f1d2f3e4d5c6b"
+
+func (fra *fakeRPCTM) GetSchemaHash(ctx context.Context, request *tabletmanagerdatapb.GetSchemaHashRequest) (string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetSchemaHash tables", request.Tables, testGetSchemaTables)
+	compare(fra.t, "GetSchemaHash excludeTables", request.ExcludeTables, testGetSchemaExcludeTables)
+	compareBool(fra.t, "GetSchemaHash includeViews", request.IncludeViews)
+	return testGetSchemaHashReply, nil
+}
+
+func tmRPCTestGetSchemaHash(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, err := client.GetSchemaHash(ctx, tablet, testGetSchemaHashReq)
+	compareError(t, "GetSchemaHash", err, result, testGetSchemaHashReply)
+}
+
+func tmRPCTestGetSchemaHashPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetSchemaHash(ctx, tablet, testGetSchemaHashReq)
+	expectHandleRPCPanic(t, "GetSchemaHash", false /*verbose*/, err)
+}
+
+var testStreamSchemaChangesReq = &tabletmanagerdatapb.StreamSchemaChangesRequest{Tables: testGetSchemaTables}
+var testStreamSchemaChangesResponse = &tabletmanagerdatapb.StreamSchemaChangesResponse{
+	Tables:        testGetSchemaReply.TableDefinitions,
+	DroppedTables: []string{"table3"},
+	SchemaHash:    "abcdef",
+	Position:      "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-10",
+}
+
+func (fra *fakeRPCTM) StreamSchemaChanges(ctx context.Context, request *tabletmanagerdatapb.StreamSchemaChangesRequest, send func(*tabletmanagerdatapb.StreamSchemaChangesResponse) error) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "StreamSchemaChanges tables", request.Tables, testGetSchemaTables)
+	return send(testStreamSchemaChangesResponse)
+}
+
+func tmRPCTestStreamSchemaChanges(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StreamSchemaChanges(ctx, tablet, testStreamSchemaChangesReq)
+	if err != nil {
+		t.Fatalf("StreamSchemaChanges failed: %v", err)
+	}
+	resp, err := stream.Recv()
+	compareError(t, "StreamSchemaChanges", err, resp, testStreamSchemaChangesResponse)
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("StreamSchemaChanges stream wasn't closed: %v", err)
+	}
+}
+
+func tmRPCTestStreamSchemaChangesPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StreamSchemaChanges(ctx, tablet, testStreamSchemaChangesReq)
+	if err != nil {
+		t.Fatalf("StreamSchemaChanges failed: %v", err)
+	}
+	resp, err := stream.Recv()
+	if err == nil {
+		t.Fatalf("Unexpected StreamSchemaChanges response: %v", resp)
+	}
+	expectHandleRPCPanic(t, "StreamSchemaChanges", false /*verbose*/, err)
+}
+
 var testGetPermissionsReply = &tabletmanagerdatapb.Permissions{
 	UserPermissions: []*tabletmanagerdatapb.UserPermission{
 		{
@@ -404,6 +468,49 @@ func tmRPCTestGetGlobalStatusVarsPanic(ctx context.Context, t *testing.T, client
 	expectHandleRPCPanic(t, "GetGlobalStatusVars", false /*verbose*/, err)
 }
 
+var testStreamTabletEventsReq = &tabletmanagerdatapb.StreamTabletEventsRequest{StreamId: "stream1", AfterSequence: 12}
+var testStreamTabletEventsResponse = &tabletmanagerdatapb.StreamTabletEventsResponse{
+	StreamId: "stream1",
+	Event: &tabletmanagerdatapb.TabletEvent{
+		Sequence: 13,
+		Time:     &vttimepb.Time{Seconds: 1700000000},
+		Kind:     "tablet_type",
+		Message:  "changed from REPLICA to PRIMARY",
+	},
+}
+
+func (fra *fakeRPCTM) StreamTabletEvents(ctx context.Context, request *tabletmanagerdatapb.StreamTabletEventsRequest, send func(*tabletmanagerdatapb.StreamTabletEventsResponse) error) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "StreamTabletEvents request", request, testStreamTabletEventsReq)
+	return send(testStreamTabletEventsResponse)
+}
+
+func tmRPCTestStreamTabletEvents(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StreamTabletEvents(ctx, tablet, testStreamTabletEventsReq)
+	if err != nil {
+		t.Fatalf("StreamTabletEvents failed: %v", err)
+	}
+	resp, err := stream.Recv()
+	compareError(t, "StreamTabletEvents", err, resp, testStreamTabletEventsResponse)
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("StreamTabletEvents stream wasn't closed: %v", err)
+	}
+}
+
+func tmRPCTestStreamTabletEventsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StreamTabletEvents(ctx, tablet, testStreamTabletEventsReq)
+	if err != nil {
+		t.Fatalf("StreamTabletEvents failed: %v", err)
+	}
+	resp, err := stream.Recv()
+	if err == nil {
+		t.Fatalf("Unexpected StreamTabletEvents response: %v", resp)
+	}
+	expectHandleRPCPanic(t, "StreamTabletEvents", false /*verbose*/, err)
+}
+
 //
 // Various read-write methods
 //
@@ -515,6 +622,53 @@ func tmRPCTestExecuteHookPanic(ctx context.Context, t *testing.T, client tmclien
 	expectHandleRPCPanic(t, "ExecuteHook", true /*verbose*/, err)
 }
 
+func (fra *fakeRPCTM) StreamExecuteHook(ctx context.Context, hk *hook.Hook, stdout, stderr io.Writer) *hook.HookResult {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "StreamExecuteHook hook", hk, testExecuteHookHook)
+	io.WriteString(stdout, testExecuteHookHookResult.Stdout)
+	io.WriteString(stderr, testExecuteHookHookResult.Stderr)
+	return &hook.HookResult{ExitStatus: testExecuteHookHookResult.ExitStatus}
+}
+
+func tmRPCTestStreamExecuteHook(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StreamExecuteHook(ctx, tablet, testExecuteHookHook)
+	if err != nil {
+		t.Fatalf("StreamExecuteHook failed: %v", err)
+	}
+	var stdout, stderr string
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("StreamExecuteHook failed before the hook finished: %v", err)
+		}
+		stdout += resp.Stdout
+		stderr += resp.Stderr
+		if resp.Finished {
+			compare(t, "StreamExecuteHook exit status", int(resp.ExitStatus), testExecuteHookHookResult.ExitStatus)
+			break
+		}
+	}
+	compare(t, "StreamExecuteHook stdout", stdout, testExecuteHookHookResult.Stdout)
+	compare(t, "StreamExecuteHook stderr", stderr, testExecuteHookHookResult.Stderr)
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("StreamExecuteHook stream wasn't closed: %v", err)
+	}
+}
+
+func tmRPCTestStreamExecuteHookPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StreamExecuteHook(ctx, tablet, testExecuteHookHook)
+	if err != nil {
+		t.Fatalf("StreamExecuteHook failed: %v", err)
+	}
+	resp, err := stream.Recv()
+	if err == nil {
+		t.Fatalf("Unexpected StreamExecuteHook response: %v", resp)
+	}
+	expectHandleRPCPanic(t, "StreamExecuteHook", true /*verbose*/, err)
+}
+
 var testRefreshStateCalled = false
 
 func (fra *fakeRPCTM) RefreshState(ctx context.Context) error {
@@ -561,6 +715,201 @@ func tmRPCTestRunHealthCheckPanic(ctx context.Context, t *testing.T, client tmcl
 	expectHandleRPCPanic(t, "RunHealthCheck", false /*verbose*/, err)
 }
 
+var (
+	testPrepareShutdownPosition   = "MySQL56/7b04699f-f5e9-11e8-8b5b-e8ded3a4a8d2:1-20"
+	testGracefulRestartTimeout    = 2 * time.Minute
+	testGracefulRestartMysqldArgs = []string{"--innodb-buffer-pool-size=1G"}
+	testGracefulRestartPosition   = "MySQL56/7b04699f-f5e9-11e8-8b5b-e8ded3a4a8d2:1-21"
+)
+
+func (fra *fakeRPCTM) PrepareShutdown(ctx context.Context, allowPrimary bool) (bool, string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compareBool(fra.t, "PrepareShutdown allowPrimary", allowPrimary)
+	return true, testPrepareShutdownPosition, nil
+}
+
+func tmRPCTestPrepareShutdown(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	resp, err := client.PrepareShutdown(ctx, tablet, &tabletmanagerdatapb.PrepareShutdownRequest{
+		AllowPrimary: true,
+	})
+	compareError(t, "PrepareShutdown", err, resp, &tabletmanagerdatapb.PrepareShutdownResponse{
+		Ready:    true,
+		Position: testPrepareShutdownPosition,
+	})
+}
+
+func tmRPCTestPrepareShutdownPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.PrepareShutdown(ctx, tablet, &tabletmanagerdatapb.PrepareShutdownRequest{})
+	expectHandleRPCPanic(t, "PrepareShutdown", true /*verbose*/, err)
+}
+
+func (fra *fakeRPCTM) GracefulRestart(ctx context.Context, shutdownTimeout time.Duration, reinitConfig bool, mysqldArgs []string, allowPrimary bool) (string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GracefulRestart shutdownTimeout", shutdownTimeout, testGracefulRestartTimeout)
+	compareBool(fra.t, "GracefulRestart reinitConfig", reinitConfig)
+	compare(fra.t, "GracefulRestart mysqldArgs", mysqldArgs, testGracefulRestartMysqldArgs)
+	compareBool(fra.t, "GracefulRestart allowPrimary", allowPrimary)
+	return testGracefulRestartPosition, nil
+}
+
+func tmRPCTestGracefulRestart(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	resp, err := client.GracefulRestart(ctx, tablet, &tabletmanagerdatapb.GracefulRestartRequest{
+		ShutdownTimeoutSeconds: int64(testGracefulRestartTimeout.Seconds()),
+		ReinitConfig:           true,
+		MysqldArgs:             testGracefulRestartMysqldArgs,
+		AllowPrimary:           true,
+	})
+	compareError(t, "GracefulRestart", err, resp, &tabletmanagerdatapb.GracefulRestartResponse{
+		Position: testGracefulRestartPosition,
+	})
+}
+
+func tmRPCTestGracefulRestartPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GracefulRestart(ctx, tablet, &tabletmanagerdatapb.GracefulRestartRequest{})
+	expectHandleRPCPanic(t, "GracefulRestart", true /*verbose*/, err)
+}
+
+var (
+	testTableGCRequester     = "backup"
+	testTableGCPauseDuration = 30 * time.Minute
+	testTableGCExpiresAt     = time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+)
+
+func (fra *fakeRPCTM) PauseTableGC(ctx context.Context, requester string, duration time.Duration) (time.Time, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "PauseTableGC requester", requester, testTableGCRequester)
+	compare(fra.t, "PauseTableGC duration", duration, testTableGCPauseDuration)
+	return testTableGCExpiresAt, nil
+}
+
+func tmRPCTestPauseTableGC(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	resp, err := client.PauseTableGC(ctx, tablet, &tabletmanagerdatapb.PauseTableGCRequest{
+		Requester:       testTableGCRequester,
+		DurationSeconds: int64(testTableGCPauseDuration.Seconds()),
+	})
+	compareError(t, "PauseTableGC", err, resp, &tabletmanagerdatapb.PauseTableGCResponse{
+		ExpiresAt: protoutil.TimeToProto(testTableGCExpiresAt),
+	})
+}
+
+func tmRPCTestPauseTableGCPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.PauseTableGC(ctx, tablet, &tabletmanagerdatapb.PauseTableGCRequest{})
+	expectHandleRPCPanic(t, "PauseTableGC", true /*verbose*/, err)
+}
+
+func (fra *fakeRPCTM) ResumeTableGC(ctx context.Context, requester string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ResumeTableGC requester", requester, testTableGCRequester)
+	return nil
+}
+
+func tmRPCTestResumeTableGC(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	resp, err := client.ResumeTableGC(ctx, tablet, &tabletmanagerdatapb.ResumeTableGCRequest{
+		Requester: testTableGCRequester,
+	})
+	compareError(t, "ResumeTableGC", err, resp, &tabletmanagerdatapb.ResumeTableGCResponse{})
+}
+
+func tmRPCTestResumeTableGCPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.ResumeTableGC(ctx, tablet, &tabletmanagerdatapb.ResumeTableGCRequest{})
+	expectHandleRPCPanic(t, "ResumeTableGC", true /*verbose*/, err)
+}
+
+var (
+	testRestoreGCTableName = "_vt_hld_6ace8bcef73211ea87e9f875a4d24e90_20240501123000_"
+	testRestoreGCTableAs   = "customer"
+)
+
+func (fra *fakeRPCTM) RestoreGCTable(ctx context.Context, tableName string, restoreAs string) (string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "RestoreGCTable tableName", tableName, testRestoreGCTableName)
+	compare(fra.t, "RestoreGCTable restoreAs", restoreAs, testRestoreGCTableAs)
+	return testRestoreGCTableAs, nil
+}
+
+func tmRPCTestRestoreGCTable(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	resp, err := client.RestoreGCTable(ctx, tablet, &tabletmanagerdatapb.RestoreGCTableRequest{
+		TableName: testRestoreGCTableName,
+		RestoreAs: testRestoreGCTableAs,
+	})
+	compareError(t, "RestoreGCTable", err, resp, &tabletmanagerdatapb.RestoreGCTableResponse{
+		TableName: testRestoreGCTableAs,
+	})
+}
+
+func tmRPCTestRestoreGCTablePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.RestoreGCTable(ctx, tablet, &tabletmanagerdatapb.RestoreGCTableRequest{})
+	expectHandleRPCPanic(t, "RestoreGCTable", true /*verbose*/, err)
+}
+
+var (
+	testMysqlVariableNames = []string{"max_connections", "long_query_time"}
+	testMysqlVariables     = []*tabletmanagerdatapb.MysqlVariable{
+		{Name: "max_connections", Value: "500", ConfigValue: "400"},
+		{Name: "long_query_time", Value: "1.000000"},
+	}
+	testSetMysqlVariableReason = "more connections for the batch jobs"
+)
+
+func (fra *fakeRPCTM) GetMysqlVariables(ctx context.Context, names []string) ([]*tabletmanagerdatapb.MysqlVariable, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetMysqlVariables names", names, testMysqlVariableNames)
+	return testMysqlVariables, nil
+}
+
+func tmRPCTestGetMysqlVariables(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	resp, err := client.GetMysqlVariables(ctx, tablet, &tabletmanagerdatapb.GetMysqlVariablesRequest{
+		Names: testMysqlVariableNames,
+	})
+	compareError(t, "GetMysqlVariables", err, resp, &tabletmanagerdatapb.GetMysqlVariablesResponse{
+		Variables: testMysqlVariables,
+	})
+}
+
+func tmRPCTestGetMysqlVariablesPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetMysqlVariables(ctx, tablet, &tabletmanagerdatapb.GetMysqlVariablesRequest{})
+	expectHandleRPCPanic(t, "GetMysqlVariables", false /*verbose*/, err)
+}
+
+func (fra *fakeRPCTM) SetMysqlVariable(ctx context.Context, name, value, reason string) (string, string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SetMysqlVariable name", name, "max_connections")
+	compare(fra.t, "SetMysqlVariable value", value, "600")
+	compare(fra.t, "SetMysqlVariable reason", reason, testSetMysqlVariableReason)
+	return "500", "600", nil
+}
+
+func tmRPCTestSetMysqlVariable(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	resp, err := client.SetMysqlVariable(ctx, tablet, &tabletmanagerdatapb.SetMysqlVariableRequest{
+		Name:   "max_connections",
+		Value:  "600",
+		Reason: testSetMysqlVariableReason,
+	})
+	compareError(t, "SetMysqlVariable", err, resp, &tabletmanagerdatapb.SetMysqlVariableResponse{
+		PreviousValue: "500",
+		Value:         "600",
+	})
+}
+
+func tmRPCTestSetMysqlVariablePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.SetMysqlVariable(ctx, tablet, &tabletmanagerdatapb.SetMysqlVariableRequest{})
+	expectHandleRPCPanic(t, "SetMysqlVariable", true /*verbose*/, err)
+}
+
 var testReloadSchemaCalled = false
 
 func (fra *fakeRPCTM) ReloadSchema(ctx context.Context, waitPosition string) error {
@@ -643,6 +992,57 @@ func tmRPCTestApplySchemaPanic(ctx context.Context, t *testing.T, client tmclien
 	expectHandleRPCPanic(t, "ApplySchema", true /*verbose*/, err)
 }
 
+var (
+	testConsistentSnapshotLease    = 5 * time.Minute
+	testConsistentSnapshotID       = int64(1234)
+	testConsistentSnapshotPosition = "MySQL56/7b04699f-f5e9-11e8-8b5b-e8ded3a4a8d2:1-10"
+)
+
+func (fra *fakeRPCTM) AcquireConsistentSnapshot(ctx context.Context, lease time.Duration, allowPrimary bool) (int64, string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "AcquireConsistentSnapshot lease", lease, testConsistentSnapshotLease)
+	compareBool(fra.t, "AcquireConsistentSnapshot allowPrimary", allowPrimary)
+	return testConsistentSnapshotID, testConsistentSnapshotPosition, nil
+}
+
+func tmRPCTestAcquireConsistentSnapshot(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	resp, err := client.AcquireConsistentSnapshot(ctx, tablet, &tabletmanagerdatapb.AcquireConsistentSnapshotRequest{
+		LeaseSeconds: int64(testConsistentSnapshotLease.Seconds()),
+		AllowPrimary: true,
+	})
+	compareError(t, "AcquireConsistentSnapshot", err, resp, &tabletmanagerdatapb.AcquireConsistentSnapshotResponse{
+		SnapshotId: testConsistentSnapshotID,
+		Position:   testConsistentSnapshotPosition,
+	})
+}
+
+func tmRPCTestAcquireConsistentSnapshotPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.AcquireConsistentSnapshot(ctx, tablet, &tabletmanagerdatapb.AcquireConsistentSnapshotRequest{})
+	expectHandleRPCPanic(t, "AcquireConsistentSnapshot", true /*verbose*/, err)
+}
+
+func (fra *fakeRPCTM) ReleaseConsistentSnapshot(ctx context.Context, id int64) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ReleaseConsistentSnapshot id", id, testConsistentSnapshotID)
+	return nil
+}
+
+func tmRPCTestReleaseConsistentSnapshot(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.ReleaseConsistentSnapshot(ctx, tablet, testConsistentSnapshotID)
+	if err != nil {
+		t.Errorf("ReleaseConsistentSnapshot failed: %v", err)
+	}
+}
+
+func tmRPCTestReleaseConsistentSnapshotPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.ReleaseConsistentSnapshot(ctx, tablet, testConsistentSnapshotID)
+	expectHandleRPCPanic(t, "ReleaseConsistentSnapshot", true /*verbose*/, err)
+}
+
 var testExecuteQueryQuery = []byte("drop table t")
 
 func (fra *fakeRPCTM) ExecuteQuery(ctx context.Context, req *tabletmanagerdatapb.ExecuteQueryRequest) (*querypb.QueryResult, error) {
@@ -796,6 +1196,123 @@ func tmRPCTestExecuteFetchPanic(ctx context.Context, t *testing.T, client tmclie
 	expectHandleRPCPanic(t, "ExecuteFetchAsAllPrivs", false /*verbose*/, err)
 }
 
+var (
+	testFetchJobID   = "d7b4a1c2-7c9e-11ef-9a8e-f875a4d24e90"
+	testPollFetchJob = &tabletmanagerdatapb.PollFetchJobResponse{
+		JobId:  testFetchJobID,
+		State:  tabletmanagerdatapb.PollFetchJobResponse_COMPLETE,
+		Query:  testExecuteFetchQuery,
+		Result: testExecuteFetchResult,
+	}
+)
+
+func (fra *fakeRPCTM) ExecuteFetchAsDbaAsync(ctx context.Context, req *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ExecuteFetchAsDbaAsync query", req.Query, testExecuteFetchQuery)
+	compare(fra.t, "ExecuteFetchAsDbaAsync maxrows", req.MaxRows, testExecuteFetchMaxRows)
+	compareBool(fra.t, "ExecuteFetchAsDbaAsync disableBinlogs", req.DisableBinlogs)
+	compareBool(fra.t, "ExecuteFetchAsDbaAsync async", req.Async)
+	return testFetchJobID, nil
+}
+
+func (fra *fakeRPCTM) PollFetchJob(ctx context.Context, req *tabletmanagerdatapb.PollFetchJobRequest) (*tabletmanagerdatapb.PollFetchJobResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "PollFetchJob job_id", req.JobId, testFetchJobID)
+	return testPollFetchJob, nil
+}
+
+func (fra *fakeRPCTM) CancelFetchJob(ctx context.Context, req *tabletmanagerdatapb.CancelFetchJobRequest) (*tabletmanagerdatapb.CancelFetchJobResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "CancelFetchJob job_id", req.JobId, testFetchJobID)
+	return &tabletmanagerdatapb.CancelFetchJobResponse{}, nil
+}
+
+func tmRPCTestFetchJob(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	jobID, err := client.ExecuteFetchAsDbaAsync(ctx, tablet, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{
+		Query:          testExecuteFetchQuery,
+		MaxRows:        uint64(testExecuteFetchMaxRows),
+		DisableBinlogs: true,
+	})
+	compareError(t, "ExecuteFetchAsDbaAsync", err, jobID, testFetchJobID)
+
+	pollResp, err := client.PollFetchJob(ctx, tablet, &tabletmanagerdatapb.PollFetchJobRequest{JobId: jobID})
+	compareError(t, "PollFetchJob", err, pollResp, testPollFetchJob)
+
+	cancelResp, err := client.CancelFetchJob(ctx, tablet, &tabletmanagerdatapb.CancelFetchJobRequest{JobId: jobID})
+	compareError(t, "CancelFetchJob", err, cancelResp, &tabletmanagerdatapb.CancelFetchJobResponse{})
+}
+
+func tmRPCTestFetchJobPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.ExecuteFetchAsDbaAsync(ctx, tablet, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{
+		Query:          testExecuteFetchQuery,
+		MaxRows:        uint64(testExecuteFetchMaxRows),
+		DisableBinlogs: true,
+	})
+	expectHandleRPCPanic(t, "ExecuteFetchAsDba", false /*verbose*/, err)
+	_, err = client.PollFetchJob(ctx, tablet, &tabletmanagerdatapb.PollFetchJobRequest{JobId: testFetchJobID})
+	expectHandleRPCPanic(t, "PollFetchJob", false /*verbose*/, err)
+	_, err = client.CancelFetchJob(ctx, tablet, &tabletmanagerdatapb.CancelFetchJobRequest{JobId: testFetchJobID})
+	expectHandleRPCPanic(t, "CancelFetchJob", true /*verbose*/, err)
+}
+
+var testStreamExecuteFetchResults = []*querypb.QueryResult{
+	{Fields: testExecuteFetchResult.Fields},
+	{Rows: testExecuteFetchResult.Rows},
+	{Rows: testExecuteFetchResult.Rows},
+}
+
+func (fra *fakeRPCTM) StreamExecuteFetchAsDba(ctx context.Context, req *tabletmanagerdatapb.StreamExecuteFetchAsDbaRequest, send func(*querypb.QueryResult) error) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "StreamExecuteFetchAsDba query", req.Query, testExecuteFetchQuery)
+	compareBool(fra.t, "StreamExecuteFetchAsDba disableBinlogs", req.DisableBinlogs)
+	for _, result := range testStreamExecuteFetchResults {
+		if err := send(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func tmRPCTestStreamExecuteFetch(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StreamExecuteFetchAsDba(ctx, tablet, &tabletmanagerdatapb.StreamExecuteFetchAsDbaRequest{
+		Query:          testExecuteFetchQuery,
+		DisableBinlogs: true,
+	})
+	if err != nil {
+		t.Fatalf("StreamExecuteFetchAsDba failed: %v", err)
+	}
+	for _, want := range testStreamExecuteFetchResults {
+		qr, err := stream.Recv()
+		compareError(t, "StreamExecuteFetchAsDba", err, qr, want)
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("StreamExecuteFetchAsDba stream wasn't closed: %v", err)
+	}
+}
+
+func tmRPCTestStreamExecuteFetchPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StreamExecuteFetchAsDba(ctx, tablet, &tabletmanagerdatapb.StreamExecuteFetchAsDbaRequest{
+		Query:          testExecuteFetchQuery,
+		DisableBinlogs: true,
+	})
+	if err != nil {
+		t.Fatalf("StreamExecuteFetchAsDba failed: %v", err)
+	}
+	qr, err := stream.Recv()
+	if err == nil {
+		t.Fatalf("Unexpected StreamExecuteFetchAsDba result: %v", qr)
+	}
+	expectHandleRPCPanic(t, "StreamExecuteFetchAsDba", false /*verbose*/, err)
+}
+
 //
 // Replication related methods
 //
@@ -857,6 +1374,34 @@ func tmRPCTestFullStatusPanic(ctx context.Context, t *testing.T, client tmclient
 	expectHandleRPCPanic(t, "FullStatus", false /*verbose*/, err)
 }
 
+var testSemiSyncStatus = &tabletmanagerdatapb.SemiSyncStatusResponse{
+	PluginLoaded:        true,
+	PrimaryEnabled:      true,
+	ReplicaEnabled:      true,
+	PrimaryStatus:       true,
+	PrimaryClients:      2,
+	PrimaryTimeout:      10000,
+	WaitForReplicaCount: 1,
+	PrimaryWaitSessions: 3,
+}
+
+func (fra *fakeRPCTM) SemiSyncStatus(ctx context.Context) (*tabletmanagerdatapb.SemiSyncStatusResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testSemiSyncStatus, nil
+}
+
+func tmRPCTestSemiSyncStatus(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	rs, err := client.SemiSyncStatus(ctx, tablet)
+	compareError(t, "SemiSyncStatus", err, rs, testSemiSyncStatus)
+}
+
+func tmRPCTestSemiSyncStatusPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.SemiSyncStatus(ctx, tablet)
+	expectHandleRPCPanic(t, "SemiSyncStatus", false /*verbose*/, err)
+}
+
 var testReplicationPosition = "MariaDB/5-456-890"
 
 func (fra *fakeRPCTM) PrimaryPosition(ctx context.Context) (string, error) {
@@ -870,6 +1415,32 @@ func (fra *fakeRPCTM) WaitForPosition(ctx context.Context, pos string) error {
 	panic("unimplemented")
 }
 
+var (
+	testWaitForPositionProgressInterval = 5 * time.Second
+	testWaitForPositionResponses        = []*tabletmanagerdatapb.StreamWaitForPositionResponse{{
+		Position: "MariaDB/1-345-788",
+		Status:   &replicationdatapb.Status{Position: "MariaDB/1-345-788", ReplicationLagSeconds: 12},
+	}, {
+		Position: testReplicationPosition,
+		Status:   &replicationdatapb.Status{Position: testReplicationPosition},
+		Reached:  true,
+	}}
+)
+
+func (fra *fakeRPCTM) StreamWaitForPosition(ctx context.Context, req *tabletmanagerdatapb.StreamWaitForPositionRequest, send func(*tabletmanagerdatapb.StreamWaitForPositionResponse) error) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "StreamWaitForPosition position", req.Position, testReplicationPosition)
+	compare(fra.t, "StreamWaitForPosition progress interval", req.ProgressInterval, protoutil.DurationToProto(testWaitForPositionProgressInterval))
+	for _, resp := range testWaitForPositionResponses {
+		if err := send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func tmRPCTestPrimaryPosition(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
 	rs, err := client.PrimaryPosition(ctx, tablet)
 	compareError(t, "PrimaryPosition", err, rs, testReplicationPosition)
@@ -880,6 +1451,35 @@ func tmRPCTestPrimaryPositionPanic(ctx context.Context, t *testing.T, client tmc
 	expectHandleRPCPanic(t, "PrimaryPosition", false /*verbose*/, err)
 }
 
+func tmRPCTestStreamWaitForPosition(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StreamWaitForPosition(ctx, tablet, &tabletmanagerdatapb.StreamWaitForPositionRequest{
+		Position:         testReplicationPosition,
+		ProgressInterval: protoutil.DurationToProto(testWaitForPositionProgressInterval),
+	})
+	if err != nil {
+		t.Fatalf("StreamWaitForPosition failed: %v", err)
+	}
+	for _, want := range testWaitForPositionResponses {
+		resp, err := stream.Recv()
+		compareError(t, "StreamWaitForPosition", err, resp, want)
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("StreamWaitForPosition stream wasn't closed: %v", err)
+	}
+}
+
+func tmRPCTestStreamWaitForPositionPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StreamWaitForPosition(ctx, tablet, &tabletmanagerdatapb.StreamWaitForPositionRequest{Position: testReplicationPosition})
+	if err != nil {
+		t.Fatalf("StreamWaitForPosition failed: %v", err)
+	}
+	resp, err := stream.Recv()
+	if err == nil {
+		t.Fatalf("Unexpected StreamWaitForPosition response: %v", resp)
+	}
+	expectHandleRPCPanic(t, "StreamWaitForPosition", true /*verbose*/, err)
+}
+
 var testStopReplicationCalled = false
 
 func (fra *fakeRPCTM) StopReplication(ctx context.Context) error {
@@ -975,6 +1575,53 @@ func tmRPCTestGetReplicasPanic(ctx context.Context, t *testing.T, client tmclien
 	expectHandleRPCPanic(t, "GetReplicas", false /*verbose*/, err)
 }
 
+var testFlushBinaryLogsResponse = &tabletmanagerdatapb.FlushBinaryLogsResponse{BinaryLog: "vt-bin.000043"}
+
+func (fra *fakeRPCTM) FlushBinaryLogs(ctx context.Context) (*tabletmanagerdatapb.FlushBinaryLogsResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testFlushBinaryLogsResponse, nil
+}
+
+func tmRPCTestFlushBinaryLogs(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	response, err := client.FlushBinaryLogs(ctx, tablet)
+	compareError(t, "FlushBinaryLogs", err, response, testFlushBinaryLogsResponse)
+}
+
+func tmRPCTestFlushBinaryLogsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.FlushBinaryLogs(ctx, tablet)
+	expectHandleRPCPanic(t, "FlushBinaryLogs", true /*verbose*/, err)
+}
+
+var testPurgeBinaryLogsRequest = &tabletmanagerdatapb.PurgeBinaryLogsRequest{
+	ToLog:       "vt-bin.000040",
+	MinPosition: "MySQL56/8bc65c84-3fe4-11ed-a912-257f0fcdd6c9:1-42",
+}
+
+var testPurgeBinaryLogsResponse = &tabletmanagerdatapb.PurgeBinaryLogsResponse{
+	PurgedBinaryLogs: []string{"vt-bin.000038", "vt-bin.000039"},
+	FirstBinaryLog:   "vt-bin.000040",
+}
+
+func (fra *fakeRPCTM) PurgeBinaryLogs(ctx context.Context, req *tabletmanagerdatapb.PurgeBinaryLogsRequest) (*tabletmanagerdatapb.PurgeBinaryLogsResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "PurgeBinaryLogs request", req, testPurgeBinaryLogsRequest)
+	return testPurgeBinaryLogsResponse, nil
+}
+
+func tmRPCTestPurgeBinaryLogs(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	response, err := client.PurgeBinaryLogs(ctx, tablet, testPurgeBinaryLogsRequest)
+	compareError(t, "PurgeBinaryLogs", err, response, testPurgeBinaryLogsResponse)
+}
+
+func tmRPCTestPurgeBinaryLogsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.PurgeBinaryLogs(ctx, tablet, testPurgeBinaryLogsRequest)
+	expectHandleRPCPanic(t, "PurgeBinaryLogs", true /*verbose*/, err)
+}
+
 var testVRQuery = "query"
 
 func (fra *fakeRPCTM) VReplicationExec(ctx context.Context, query string) (*querypb.QueryResult, error) {
@@ -1019,6 +1666,91 @@ func tmRPCTestVReplicationWaitForPosPanic(ctx context.Context, t *testing.T, cli
 	expectHandleRPCPanic(t, "VReplicationWaitForPos", true /*verbose*/, err)
 }
 
+var (
+	testVDiffKeyspace = "test_keyspace"
+	testVDiffWorkflow = "test_workflow"
+	testVDiffUUID     = "6ace8bce-f732-11ea-87e9-f875a4d24e90"
+	testShowVDiff     = &tabletmanagerdatapb.ShowVDiffResponse{
+		VdiffUuid: testVDiffUUID,
+		State:     "started",
+		StartedAt: "2024-05-01 12:30:00",
+		Tables: []*tabletmanagerdatapb.VDiffTableProgress{{
+			TableName:    "customer",
+			State:        "started",
+			TableRows:    100,
+			RowsCompared: 42,
+		}},
+	}
+)
+
+func (fra *fakeRPCTM) CreateVDiff(ctx context.Context, req *tabletmanagerdatapb.CreateVDiffRequest) (*tabletmanagerdatapb.CreateVDiffResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "CreateVDiff request", req, &tabletmanagerdatapb.CreateVDiffRequest{
+		Keyspace:  testVDiffKeyspace,
+		Workflow:  testVDiffWorkflow,
+		VdiffUuid: testVDiffUUID,
+	})
+	return &tabletmanagerdatapb.CreateVDiffResponse{Id: 1, VdiffUuid: req.VdiffUuid}, nil
+}
+
+func (fra *fakeRPCTM) ShowVDiff(ctx context.Context, req *tabletmanagerdatapb.ShowVDiffRequest) (*tabletmanagerdatapb.ShowVDiffResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ShowVDiff request", req, &tabletmanagerdatapb.ShowVDiffRequest{
+		Keyspace:  testVDiffKeyspace,
+		Workflow:  testVDiffWorkflow,
+		VdiffUuid: testVDiffUUID,
+	})
+	return testShowVDiff, nil
+}
+
+func (fra *fakeRPCTM) StopVDiff(ctx context.Context, req *tabletmanagerdatapb.StopVDiffRequest) (*tabletmanagerdatapb.StopVDiffResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "StopVDiff request", req, &tabletmanagerdatapb.StopVDiffRequest{
+		Keyspace:  testVDiffKeyspace,
+		Workflow:  testVDiffWorkflow,
+		VdiffUuid: testVDiffUUID,
+	})
+	return &tabletmanagerdatapb.StopVDiffResponse{}, nil
+}
+
+func tmRPCTestVDiffControl(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	createResp, err := client.CreateVDiff(ctx, tablet, &tabletmanagerdatapb.CreateVDiffRequest{
+		Keyspace:  testVDiffKeyspace,
+		Workflow:  testVDiffWorkflow,
+		VdiffUuid: testVDiffUUID,
+	})
+	compareError(t, "CreateVDiff", err, createResp, &tabletmanagerdatapb.CreateVDiffResponse{Id: 1, VdiffUuid: testVDiffUUID})
+
+	showResp, err := client.ShowVDiff(ctx, tablet, &tabletmanagerdatapb.ShowVDiffRequest{
+		Keyspace:  testVDiffKeyspace,
+		Workflow:  testVDiffWorkflow,
+		VdiffUuid: testVDiffUUID,
+	})
+	compareError(t, "ShowVDiff", err, showResp, testShowVDiff)
+
+	stopResp, err := client.StopVDiff(ctx, tablet, &tabletmanagerdatapb.StopVDiffRequest{
+		Keyspace:  testVDiffKeyspace,
+		Workflow:  testVDiffWorkflow,
+		VdiffUuid: testVDiffUUID,
+	})
+	compareError(t, "StopVDiff", err, stopResp, &tabletmanagerdatapb.StopVDiffResponse{})
+}
+
+func tmRPCTestVDiffControlPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.CreateVDiff(ctx, tablet, &tabletmanagerdatapb.CreateVDiffRequest{})
+	expectHandleRPCPanic(t, "CreateVDiff", true /*verbose*/, err)
+	_, err = client.ShowVDiff(ctx, tablet, &tabletmanagerdatapb.ShowVDiffRequest{})
+	expectHandleRPCPanic(t, "ShowVDiff", false /*verbose*/, err)
+	_, err = client.StopVDiff(ctx, tablet, &tabletmanagerdatapb.StopVDiffRequest{})
+	expectHandleRPCPanic(t, "StopVDiff", true /*verbose*/, err)
+}
+
 //
 // Reparenting related functions
 //
@@ -1119,20 +1851,29 @@ func tmRPCTestInitReplicaPanic(ctx context.Context, t *testing.T, client tmclien
 	expectHandleRPCPanic(t, "InitReplica", true /*verbose*/, err)
 }
 
-func (fra *fakeRPCTM) DemotePrimary(ctx context.Context) (*replicationdatapb.PrimaryStatus, error) {
+var testPrimaryHandoff = &tabletmanagerdatapb.PrimaryHandoff{
+	Id:                  "prs-1",
+	Operation:           "PlannedReparentShard",
+	Reason:              "maintenance",
+	ExpectedCutoverTime: &vttimepb.Time{Seconds: 1700000000},
+	BufferWindowSeconds: 10,
+}
+
+func (fra *fakeRPCTM) DemotePrimary(ctx context.Context, handoff *tabletmanagerdatapb.PrimaryHandoff) (*replicationdatapb.PrimaryStatus, error) {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	compare(fra.t, "DemotePrimary handoff", handoff, testPrimaryHandoff)
 	return testPrimaryStatus, nil
 }
 
 func tmRPCTestDemotePrimary(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	PrimaryStatus, err := client.DemotePrimary(ctx, tablet)
+	PrimaryStatus, err := client.DemotePrimary(ctx, tablet, testPrimaryHandoff)
 	compareError(t, "DemotePrimary", err, PrimaryStatus.Position, testPrimaryStatus.Position)
 }
 
 func tmRPCTestDemotePrimaryPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	_, err := client.DemotePrimary(ctx, tablet)
+	_, err := client.DemotePrimary(ctx, tablet, testPrimaryHandoff)
 	expectHandleRPCPanic(t, "DemotePrimary", true /*verbose*/, err)
 }
 
@@ -1274,20 +2015,21 @@ func tmRPCTestStopReplicationAndGetStatusPanic(ctx context.Context, t *testing.T
 	expectHandleRPCPanic(t, "StopReplicationAndGetStatus", true /*verbose*/, err)
 }
 
-func (fra *fakeRPCTM) PromoteReplica(ctx context.Context, semiSync bool) (string, error) {
+func (fra *fakeRPCTM) PromoteReplica(ctx context.Context, semiSync bool, handoff *tabletmanagerdatapb.PrimaryHandoff) (string, error) {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	compare(fra.t, "PromoteReplica handoff", handoff, testPrimaryHandoff)
 	return testReplicationPosition, nil
 }
 
 func tmRPCTestPromoteReplica(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	rp, err := client.PromoteReplica(ctx, tablet, false)
+	rp, err := client.PromoteReplica(ctx, tablet, false, testPrimaryHandoff)
 	compareError(t, "PromoteReplica", err, rp, testReplicationPosition)
 }
 
 func tmRPCTestPromoteReplicaPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	_, err := client.PromoteReplica(ctx, tablet, false)
+	_, err := client.PromoteReplica(ctx, tablet, false, testPrimaryHandoff)
 	expectHandleRPCPanic(t, "PromoteReplica", true /*verbose*/, err)
 }
 
@@ -1422,36 +2164,56 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// Various read-only methods
 	tmRPCTestPing(ctx, t, client, tablet)
 	tmRPCTestGetSchema(ctx, t, client, tablet)
+	tmRPCTestGetSchemaHash(ctx, t, client, tablet)
+	tmRPCTestStreamSchemaChanges(ctx, t, client, tablet)
 	tmRPCTestGetPermissions(ctx, t, client, tablet)
 	tmRPCTestGetGlobalStatusVars(ctx, t, client, tablet)
+	tmRPCTestStreamTabletEvents(ctx, t, client, tablet)
 
 	// Various read-write methods
 	tmRPCTestSetReadOnly(ctx, t, client, tablet)
 	tmRPCTestChangeType(ctx, t, client, tablet)
 	tmRPCTestSleep(ctx, t, client, tablet)
 	tmRPCTestExecuteHook(ctx, t, client, tablet)
+	tmRPCTestStreamExecuteHook(ctx, t, client, tablet)
 	tmRPCTestRefreshState(ctx, t, client, tablet)
 	tmRPCTestRunHealthCheck(ctx, t, client, tablet)
+	tmRPCTestPrepareShutdown(ctx, t, client, tablet)
+	tmRPCTestGracefulRestart(ctx, t, client, tablet)
+	tmRPCTestPauseTableGC(ctx, t, client, tablet)
+	tmRPCTestResumeTableGC(ctx, t, client, tablet)
+	tmRPCTestRestoreGCTable(ctx, t, client, tablet)
+	tmRPCTestGetMysqlVariables(ctx, t, client, tablet)
+	tmRPCTestSetMysqlVariable(ctx, t, client, tablet)
 	tmRPCTestReloadSchema(ctx, t, client, tablet)
 	tmRPCTestPreflightSchema(ctx, t, client, tablet)
 	tmRPCTestApplySchema(ctx, t, client, tablet)
+	tmRPCTestAcquireConsistentSnapshot(ctx, t, client, tablet)
+	tmRPCTestReleaseConsistentSnapshot(ctx, t, client, tablet)
 	tmRPCTestExecuteFetch(ctx, t, client, tablet)
+	tmRPCTestFetchJob(ctx, t, client, tablet)
+	tmRPCTestStreamExecuteFetch(ctx, t, client, tablet)
 
 	// Replication related methods
 	tmRPCTestPrimaryPosition(ctx, t, client, tablet)
+	tmRPCTestStreamWaitForPosition(ctx, t, client, tablet)
 
 	tmRPCTestReplicationStatus(ctx, t, client, tablet)
 	tmRPCTestFullStatus(ctx, t, client, tablet)
+	tmRPCTestSemiSyncStatus(ctx, t, client, tablet)
 	tmRPCTestPrimaryPosition(ctx, t, client, tablet)
 	tmRPCTestStopReplication(ctx, t, client, tablet)
 	tmRPCTestStopReplicationMinimum(ctx, t, client, tablet)
 	tmRPCTestStartReplication(ctx, t, client, tablet)
 	tmRPCTestStartReplicationUntilAfter(ctx, t, client, tablet)
 	tmRPCTestGetReplicas(ctx, t, client, tablet)
+	tmRPCTestFlushBinaryLogs(ctx, t, client, tablet)
+	tmRPCTestPurgeBinaryLogs(ctx, t, client, tablet)
 
 	// VReplication methods
 	tmRPCTestVReplicationExec(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPos(ctx, t, client, tablet)
+	tmRPCTestVDiffControl(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	tmRPCTestResetReplication(ctx, t, client, tablet)
@@ -1483,32 +2245,52 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// Various read-only methods
 	tmRPCTestPingPanic(ctx, t, client, tablet)
 	tmRPCTestGetSchemaPanic(ctx, t, client, tablet)
+	tmRPCTestGetSchemaHashPanic(ctx, t, client, tablet)
+	tmRPCTestStreamSchemaChangesPanic(ctx, t, client, tablet)
 	tmRPCTestGetPermissionsPanic(ctx, t, client, tablet)
 	tmRPCTestGetGlobalStatusVarsPanic(ctx, t, client, tablet)
+	tmRPCTestStreamTabletEventsPanic(ctx, t, client, tablet)
 
 	// Various read-write methods
 	tmRPCTestSetReadOnlyPanic(ctx, t, client, tablet)
 	tmRPCTestChangeTypePanic(ctx, t, client, tablet)
 	tmRPCTestSleepPanic(ctx, t, client, tablet)
 	tmRPCTestExecuteHookPanic(ctx, t, client, tablet)
+	tmRPCTestStreamExecuteHookPanic(ctx, t, client, tablet)
 	tmRPCTestRefreshStatePanic(ctx, t, client, tablet)
 	tmRPCTestRunHealthCheckPanic(ctx, t, client, tablet)
+	tmRPCTestPrepareShutdownPanic(ctx, t, client, tablet)
+	tmRPCTestGracefulRestartPanic(ctx, t, client, tablet)
+	tmRPCTestPauseTableGCPanic(ctx, t, client, tablet)
+	tmRPCTestResumeTableGCPanic(ctx, t, client, tablet)
+	tmRPCTestRestoreGCTablePanic(ctx, t, client, tablet)
+	tmRPCTestGetMysqlVariablesPanic(ctx, t, client, tablet)
+	tmRPCTestSetMysqlVariablePanic(ctx, t, client, tablet)
 	tmRPCTestReloadSchemaPanic(ctx, t, client, tablet)
 	tmRPCTestPreflightSchemaPanic(ctx, t, client, tablet)
 	tmRPCTestApplySchemaPanic(ctx, t, client, tablet)
+	tmRPCTestAcquireConsistentSnapshotPanic(ctx, t, client, tablet)
+	tmRPCTestReleaseConsistentSnapshotPanic(ctx, t, client, tablet)
 	tmRPCTestExecuteFetchPanic(ctx, t, client, tablet)
+	tmRPCTestFetchJobPanic(ctx, t, client, tablet)
+	tmRPCTestStreamExecuteFetchPanic(ctx, t, client, tablet)
 
 	// Replication related methods
 	tmRPCTestPrimaryPositionPanic(ctx, t, client, tablet)
+	tmRPCTestStreamWaitForPositionPanic(ctx, t, client, tablet)
 	tmRPCTestReplicationStatusPanic(ctx, t, client, tablet)
 	tmRPCTestFullStatusPanic(ctx, t, client, tablet)
+	tmRPCTestSemiSyncStatusPanic(ctx, t, client, tablet)
 	tmRPCTestStopReplicationPanic(ctx, t, client, tablet)
 	tmRPCTestStopReplicationMinimumPanic(ctx, t, client, tablet)
 	tmRPCTestStartReplicationPanic(ctx, t, client, tablet)
 	tmRPCTestGetReplicasPanic(ctx, t, client, tablet)
+	tmRPCTestFlushBinaryLogsPanic(ctx, t, client, tablet)
+	tmRPCTestPurgeBinaryLogsPanic(ctx, t, client, tablet)
 	// VReplication methods
 	tmRPCTestVReplicationExecPanic(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPosPanic(ctx, t, client, tablet)
+	tmRPCTestVDiffControlPanic(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	tmRPCTestResetReplicationPanic(ctx, t, client, tablet)
@@ -25,4 +25,7 @@ var (
 	Marshal = yaml.Marshal
 	// Unmarshal unmarshals from YAML.
 	Unmarshal = yaml.Unmarshal
+	// UnmarshalStrict unmarshals from YAML, failing on unknown and duplicate
+	// fields.
+	UnmarshalStrict = yaml.UnmarshalStrict
 )